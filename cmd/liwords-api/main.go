@@ -149,7 +149,8 @@ func main() {
 	}
 	stores := bus.Stores{}
 
-	stores.UserStore = user.NewCache(tmpUserStore)
+	userCache := user.NewCache(tmpUserStore)
+	stores.UserStore = userCache
 
 	stores.SessionStore, err = session.NewDBStore(cfg.DBConnDSN)
 	if err != nil {
@@ -197,6 +198,8 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
+	leaderboardStore := pkgredis.NewRedisLeaderboardStore(redisPool)
+	userCache.SetRatingsListener(leaderboardStore)
 	stores.PresenceStore = pkgredis.NewRedisPresenceStore(redisPool)
 	stores.ChatStore = pkgredis.NewRedisChatStore(redisPool, stores.PresenceStore, stores.TournamentStore)
 
@@ -210,7 +213,8 @@ func main() {
 		cfg.SecretKey, cfg.MailgunKey, cfg.DiscordToken, cfg.ArgonConfig)
 	registrationService := registration.NewRegistrationService(stores.UserStore, cfg.ArgonConfig)
 	gameService := gameplay.NewGameService(stores.UserStore, stores.GameStore)
-	profileService := pkgprofile.NewProfileService(stores.UserStore, pkguser.NewS3Uploader(os.Getenv("AVATAR_UPLOAD_BUCKET")))
+	profileService := pkgprofile.NewProfileService(stores.UserStore, pkguser.NewS3Uploader(os.Getenv("AVATAR_UPLOAD_BUCKET")),
+		leaderboardStore)
 	wordService := words.NewWordService(&cfg.MacondoConfig)
 	autocompleteService := pkguser.NewAutocompleteService(stores.UserStore)
 	socializeService := pkguser.NewSocializeService(stores.UserStore, stores.ChatStore, stores.PresenceStore)
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math"
+	"net/http"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	ipc "github.com/domino14/liwords/rpc/api/proto/ipc"
+	pb "github.com/domino14/liwords/rpc/api/proto/tournament_service"
+)
+
+// A script to print how fair the pairings of a tournament division were.
+
+func GetPairingReport(url string, id string, division string) (*ipc.PairingReport, error) {
+	client := pb.NewTournamentServiceProtobufClient(url, &http.Client{})
+	return client.GetPairingReport(context.Background(), &pb.TournamentDivisionRequest{Id: id, Division: division})
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), `usage of %s:
+
+  params: tournamentId division
+    fetch the pairing report of a division from woogles.io or a compatible site
+    example: XgTRffsq "Division A"
+
+params can be prefixed with these flags:
+`, os.Args[0])
+		flag.PrintDefaults()
+	}
+
+	var urlFlag = flag.String("url", "https://woogles.io", "specify url, -url local for http://localhost")
+	flag.Parse()
+	args := flag.Args()
+
+	if *urlFlag == "local" {
+		*urlFlag = "http://localhost" // compatible with docker-compose
+	}
+
+	if len(args) < 2 {
+		flag.Usage()
+		panic(fmt.Errorf("not enough params"))
+	}
+
+	report, err := GetPairingReport(*urlFlag, args[0], args[1])
+	if err != nil {
+		panic(err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "player\tgames\trepeats\tfirsts\tseconds\tbyes\topp rating\topp rating sd\t")
+	for _, player := range report.Players {
+		// Player IDs look like uuid:username
+		username := player.PlayerId[strings.Index(player.PlayerId, ":")+1:]
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%d\t%.1f\t%.1f\t\n", username, player.Games, player.Repeats,
+			player.Firsts, player.Seconds, player.Byes, player.AverageOpponentRating,
+			math.Sqrt(player.OpponentRatingVariance))
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "round\tmethod\tgames\trepeats\tmismatched\t")
+	for _, round := range report.Rounds {
+		mismatched := "-"
+		if round.PairingMethod == ipc.PairingMethod_SWISS {
+			mismatched = fmt.Sprint(round.MismatchedGames)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%d\t%d\t%s\t\n", round.Round+1, round.PairingMethod, round.Games,
+			round.Repeats, mismatched)
+	}
+	w.Flush()
+}
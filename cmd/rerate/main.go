@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	nats "github.com/nats-io/nats.go"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/liwords/pkg/bus"
+	"github.com/domino14/liwords/pkg/config"
+	"github.com/domino14/liwords/pkg/rerate"
+	cfgstore "github.com/domino14/liwords/pkg/stores/config"
+	"github.com/domino14/liwords/pkg/stores/game"
+	tournamentstore "github.com/domino14/liwords/pkg/stores/tournament"
+	"github.com/domino14/liwords/pkg/stores/user"
+	pb "github.com/domino14/liwords/rpc/api/proto/config_service"
+)
+
+// A command to recompute ratings by replaying every rated game, and to
+// compare them with the current ratings or replace them. It connects to
+// the database directly, with the configuration of the API server, since
+// replaying every game takes too long for an API request.
+
+func Rerate(ctx context.Context, cfg *config.Config, req *pb.RerateRequest, backfill bool) (*pb.RerateResponse, error) {
+	userStore, err := user.NewDBStore(cfg.DBConnDSN)
+	if err != nil {
+		return nil, err
+	}
+	gameStore, err := game.NewDBStore(cfg, userStore)
+	if err != nil {
+		return nil, err
+	}
+	tournamentStore, err := tournamentstore.NewDBStore(cfg, game.NewCache(gameStore))
+	if err != nil {
+		return nil, err
+	}
+
+	if backfill {
+		updated, err := gameStore.BackfillRatingVariants(ctx)
+		if err != nil {
+			return nil, err
+		}
+		log.Info().Int("games", updated).Msg("backfilled-rating-variants")
+	}
+
+	if req.Apply {
+		// Every node caches users, so a game ending while the ratings are
+		// replaced would write its stale ratings back.
+		redisPool := &redis.Pool{
+			MaxIdle:     1,
+			IdleTimeout: 240 * time.Second,
+			Dial:        func() (redis.Conn, error) { return redis.DialURL(cfg.RedisURL) },
+		}
+		defer redisPool.Close()
+		enabled, err := cfgstore.NewRedisConfigStore(redisPool).GamesEnabled(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if enabled {
+			return nil, errors.New("games must be disabled while ratings are replaced")
+		}
+	}
+
+	natsconn, err := nats.Connect(cfg.NatsURL)
+	if err != nil {
+		return nil, err
+	}
+	defer natsconn.Close()
+
+	engine := rerate.NewEngine(userStore, gameStore, tournamentStore, bus.NewRatingsReplacedPublisher(natsconn))
+	return engine.Rerate(ctx, req)
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), `usage of %s:
+
+  params: variant [variant...]
+    replay the rated games of the variants in the database the API server is
+    configured with (DB_HOST, DB_NAME, REDIS_URL, NATS_URL and so on), and
+    print how the ratings would change.
+    example: -backfill NWL20.classic.rapid CSW21.classic.rapid
+
+params can be prefixed with these flags:
+`, os.Args[0])
+		flag.PrintDefaults()
+	}
+
+	var minDifferenceFlag = flag.Float64("mindiff", 1, "only print rating changes of at least this many points")
+	var applyFlag = flag.Bool("apply", false, "replace the current ratings (games must be disabled)")
+	var backfillFlag = flag.Bool("backfill", false, "first fill in the rating variant of games saved before it was stored")
+	var spreadScalingFlag = flag.Int("spreadscaling", 0, "spread that counts as a full win (0 keeps the current constant)")
+	var minimumWinBoostFlag = flag.Float64("minwinboost", 0, "minimum win boost (0 keeps the current constant)")
+	var steepnessFlag = flag.Float64("steepness", 0, "win boost steepness (0 keeps the current constant)")
+	var volatilityDeltaFlag = flag.Float64("voldelta", 0, "volatility delta constraint (0 keeps the current constant)")
+	var maximumVolatilityFlag = flag.Float64("maxvol", 0, "maximum volatility (0 keeps the current constant)")
+	var minimumRDFlag = flag.Int("minrd", 0, "minimum rating deviation (0 keeps the current constant)")
+	var maximumRDFlag = flag.Int("maxrd", 0, "maximum rating deviation (0 keeps the current constant)")
+	var ratingPeriodFlag = flag.Int("ratingperiod", 0, "rating period in seconds (0 keeps the current constant)")
+	flag.Parse()
+	args := flag.Args()
+
+	if len(args) < 1 {
+		flag.Usage()
+		panic(fmt.Errorf("not enough params"))
+	}
+
+	cfg := &config.Config{}
+	// The configuration comes from the same environment variables as
+	// the API server's.
+	err := cfg.Load([]string{})
+	if err != nil {
+		panic(err)
+	}
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+
+	resp, err := Rerate(context.Background(), cfg, &pb.RerateRequest{
+		Variants: args,
+		Parameters: &pb.GlickoParameters{
+			SpreadScaling:             int32(*spreadScalingFlag),
+			MinimumWinBoost:           *minimumWinBoostFlag,
+			Steepness:                 *steepnessFlag,
+			VolatilityDeltaConstraint: *volatilityDeltaFlag,
+			MaximumVolatility:         *maximumVolatilityFlag,
+			MinimumRatingDeviation:    int32(*minimumRDFlag),
+			MaximumRatingDeviation:    int32(*maximumRDFlag),
+			RatingPeriodSeconds:       int32(*ratingPeriodFlag),
+		},
+		MinDifference: *minDifferenceFlag,
+		Apply:         *applyFlag,
+	}, *backfillFlag)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("replayed %d games, skipped %d\n", resp.Games, resp.SkippedGames)
+	if resp.Applied {
+		fmt.Println("the new ratings were applied")
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "user\tvariant\told\tnew\tchange\told rd\tnew rd\t")
+	for _, d := range resp.Differences {
+		fmt.Fprintf(w, "%s\t%s\t%.1f\t%.1f\t%+.1f\t%.1f\t%.1f\t\n", d.UserId, d.Variant,
+			d.OldRating, d.NewRating, d.NewRating-d.OldRating, d.OldRatingDeviation, d.NewRatingDeviation)
+	}
+	w.Flush()
+}
@@ -0,0 +1,15 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS public.tournament_audit_logs (
+    id bigserial PRIMARY KEY,
+    created_at timestamp with time zone,
+    tournament_id text NOT NULL,
+    actor text,
+    action text,
+    division text,
+    payload jsonb,
+    diff jsonb
+);
+CREATE INDEX IF NOT EXISTS idx_tournament_audit_logs_tid ON public.tournament_audit_logs USING btree (tournament_id, created_at);
+
+COMMIT;
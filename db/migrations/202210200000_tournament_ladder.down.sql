@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE tournaments DROP COLUMN IF EXISTS ladder;
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS public.rating_histories (
+    id bigserial PRIMARY KEY,
+    created_at timestamp with time zone,
+    user_uuid character varying(24) NOT NULL,
+    variant text NOT NULL,
+    rating double precision,
+    rating_deviation double precision,
+    volatility double precision,
+    game_id character varying(24)
+);
+CREATE INDEX IF NOT EXISTS idx_rating_histories_user_variant ON public.rating_histories USING btree (user_uuid, variant, created_at);
+
+COMMIT;
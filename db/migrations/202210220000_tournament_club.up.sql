@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE tournaments ADD COLUMN IF NOT EXISTS club jsonb;
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS public.tournament_snapshots (
+    id bigserial PRIMARY KEY,
+    created_at timestamp with time zone,
+    tournament_id text NOT NULL,
+    actor text,
+    label text,
+    state jsonb
+);
+CREATE INDEX IF NOT EXISTS idx_tournament_snapshots_tid ON public.tournament_snapshots USING btree (tournament_id, created_at);
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_tournaments_next_scheduled_at;
+ALTER TABLE tournaments DROP COLUMN IF EXISTS next_scheduled_at;
+
+COMMIT;
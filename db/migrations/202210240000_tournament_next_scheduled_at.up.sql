@@ -0,0 +1,14 @@
+BEGIN;
+
+ALTER TABLE tournaments ADD COLUMN IF NOT EXISTS next_scheduled_at timestamp with time zone;
+CREATE INDEX IF NOT EXISTS idx_tournaments_next_scheduled_at ON public.tournaments USING btree (next_scheduled_at);
+
+-- The scheduler stores the real next scheduled time of these
+-- tournaments the first time that it looks at them.
+UPDATE tournaments SET next_scheduled_at = now()
+WHERE is_finished IS NOT TRUE AND (divisions::text LIKE '%"scheduled_start_time"%'
+    OR divisions::text LIKE '%"check_in_deadline"%'
+    OR ladder::text LIKE '%"deadline"%'
+    OR club::text LIKE '%"session_schedule"%');
+
+COMMIT;
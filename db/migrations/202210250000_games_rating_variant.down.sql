@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_games_rating_variant;
+ALTER TABLE games DROP COLUMN IF EXISTS rating_variant;
+
+COMMIT;
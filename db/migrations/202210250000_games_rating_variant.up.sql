@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE games ADD COLUMN IF NOT EXISTS rating_variant character varying(64);
+CREATE INDEX IF NOT EXISTS idx_games_rating_variant ON public.games USING btree (rating_variant);
+
+-- The rating variant is in the protobuf game request, so the games that
+-- were saved before this column was added are filled in by the rerate
+-- command (-backfill).
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_profiles_ratings_data;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+-- Lets the leaderboards find the players who are rated in a variant.
+CREATE INDEX IF NOT EXISTS idx_profiles_ratings_data ON public.profiles USING gin ((ratings->'Data'));
+
+COMMIT;
@@ -111,6 +111,41 @@ export interface WooglesErrorMap {
   PUZZLE_SET_PUZZLE_VOTE_ID_NOT_FOUND: 1084;
   PUZZLE_SUBMIT_ANSWER_PUZZLE_ATTEMPT_NOT_FOUND: 1085;
   PUZZLE_GET_PUZZLE_UPDATE_ATTEMPT: 1086;
+  TOURNAMENT_INVALID_DOUBLE_ELIMINATION_ROUNDS: 1088;
+  TOURNAMENT_NO_BRACKET: 1089;
+  TOURNAMENT_NEGATIVE_NO_SHOW_GRACE: 1090;
+  TOURNAMENT_REGISTRATION_CLOSED: 1091;
+  TOURNAMENT_REGISTRATION_DEADLINE_PASSED: 1092;
+  TOURNAMENT_ALREADY_REGISTERED: 1093;
+  TOURNAMENT_RATING_OUT_OF_RANGE: 1094;
+  TOURNAMENT_NOT_REGISTERED: 1095;
+  TOURNAMENT_INVALID_RATING_RANGE: 1096;
+  TOURNAMENT_NEGATIVE_MAX_PLAYERS: 1097;
+  TOURNAMENT_CHECK_IN_NOT_OPEN: 1098;
+  TOURNAMENT_CHECK_IN_CLOSED: 1099;
+  TOURNAMENT_NEGATIVE_CHECK_IN_WINDOW: 1100;
+  TOURNAMENT_INVALID_CONTENTION_PAIRING: 1101;
+  TOURNAMENT_INVALID_PAIRING_LAG: 1102;
+  TOURNAMENT_NEGATIVE_CATCH_UP_BYES: 1103;
+  TOURNAMENT_PROMOTION_SESSION_NOT_FINISHED: 1104;
+  TOURNAMENT_PROMOTION_INVALID_NEXT_SESSION: 1105;
+  TOURNAMENT_NEGATIVE_PROMOTIONS: 1106;
+  TOURNAMENT_NOT_A_LADDER: 1107;
+  TOURNAMENT_INVALID_LADDER_CONTROLS: 1108;
+  TOURNAMENT_LADDER_NO_GAME_SETTINGS: 1109;
+  TOURNAMENT_LADDER_CHALLENGE_OUT_OF_RANGE: 1110;
+  TOURNAMENT_LADDER_PLAYER_CHALLENGED: 1111;
+  TOURNAMENT_LADDER_NONEXISTENT_CHALLENGE: 1112;
+  TOURNAMENT_INVALID_ACCELERATION: 1113;
+  TOURNAMENT_RATING_PERIOD_STARTED: 1114;
+  TOURNAMENT_NOT_RATED_AS_PERIOD: 1115;
+  TOURNAMENT_NOT_A_CLUB: 1116;
+  TOURNAMENT_CLUB_MEMBERS_ONLY: 1117;
+  TOURNAMENT_NONEXISTENT_CLUB_MEMBER: 1118;
+  TOURNAMENT_INVALID_CLUB_SESSION_SCHEDULE: 1119;
+  TOURNAMENT_INVALID_CLUB_SESSION_TEMPLATE: 1120;
+  TOURNAMENT_INVALID_PRIZE: 1121;
+  TOURNAMENT_PROMOTION_DUPLICATE_DIVISION: 1122;
 }
 
 export const WooglesError: WooglesErrorMap;
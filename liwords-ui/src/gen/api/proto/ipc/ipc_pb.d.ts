@@ -214,6 +214,11 @@ export interface MessageTypeMap {
   ACTIVE_GAME_ENTRY: 41;
   GAME_META_EVENT: 42;
   PROFILE_UPDATE_EVENT: 43;
+  QUICKPAIR_REQUEST: 44;
+  QUICKPAIR_STATUS: 45;
+  LADDER_CHALLENGE_ACCEPT: 46;
+  LADDER_MESSAGE: 47;
+  LADDER_CHALLENGE_DECLINE: 48;
 }
 
 export const MessageType: MessageTypeMap;
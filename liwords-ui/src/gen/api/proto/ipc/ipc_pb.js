@@ -1360,7 +1360,12 @@ proto.ipc.MessageType = {
   PRESENCE_ENTRY: 40,
   ACTIVE_GAME_ENTRY: 41,
   GAME_META_EVENT: 42,
-  PROFILE_UPDATE_EVENT: 43
+  PROFILE_UPDATE_EVENT: 43,
+  QUICKPAIR_REQUEST: 44,
+  QUICKPAIR_STATUS: 45,
+  LADDER_CHALLENGE_ACCEPT: 46,
+  LADDER_MESSAGE: 47,
+  LADDER_CHALLENGE_DECLINE: 48
 };
 
 goog.object.extend(exports, proto.ipc);
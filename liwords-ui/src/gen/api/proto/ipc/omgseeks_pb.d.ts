@@ -17,6 +17,9 @@ export class MatchUser extends jspb.Message {
   getDisplayName(): string;
   setDisplayName(value: string): void;
 
+  getProvisional(): boolean;
+  setProvisional(value: boolean): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): MatchUser.AsObject;
   static toObject(includeInstance: boolean, msg: MatchUser): MatchUser.AsObject;
@@ -33,6 +36,7 @@ export namespace MatchUser {
     relevantRating: string,
     isAnonymous: boolean,
     displayName: string,
+    provisional: boolean,
   }
 }
 
@@ -178,6 +182,60 @@ export namespace DeclineSeekRequest {
   }
 }
 
+export class QuickpairRequest extends jspb.Message {
+  hasGameRequest(): boolean;
+  clearGameRequest(): void;
+  getGameRequest(): api_proto_ipc_omgwords_pb.GameRequest | undefined;
+  setGameRequest(value?: api_proto_ipc_omgwords_pb.GameRequest): void;
+
+  getMinimumRatingRange(): number;
+  setMinimumRatingRange(value: number): void;
+
+  getMaximumRatingRange(): number;
+  setMaximumRatingRange(value: number): void;
+
+  getCancel(): boolean;
+  setCancel(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): QuickpairRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: QuickpairRequest): QuickpairRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: QuickpairRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): QuickpairRequest;
+  static deserializeBinaryFromReader(message: QuickpairRequest, reader: jspb.BinaryReader): QuickpairRequest;
+}
+
+export namespace QuickpairRequest {
+  export type AsObject = {
+    gameRequest?: api_proto_ipc_omgwords_pb.GameRequest.AsObject,
+    minimumRatingRange: number,
+    maximumRatingRange: number,
+    cancel: boolean,
+  }
+}
+
+export class QuickpairStatus extends jspb.Message {
+  getQueued(): boolean;
+  setQueued(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): QuickpairStatus.AsObject;
+  static toObject(includeInstance: boolean, msg: QuickpairStatus): QuickpairStatus.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: QuickpairStatus, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): QuickpairStatus;
+  static deserializeBinaryFromReader(message: QuickpairStatus, reader: jspb.BinaryReader): QuickpairStatus;
+}
+
+export namespace QuickpairStatus {
+  export type AsObject = {
+    queued: boolean,
+  }
+}
+
 export interface SeekStateMap {
   ABSENT: 0;
   PRESENT: 1;
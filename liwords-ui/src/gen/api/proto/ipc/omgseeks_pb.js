@@ -19,6 +19,8 @@ var api_proto_ipc_omgwords_pb = require('../../../api/proto/ipc/omgwords_pb.js')
 goog.object.extend(proto, api_proto_ipc_omgwords_pb);
 goog.exportSymbol('proto.ipc.DeclineSeekRequest', null, global);
 goog.exportSymbol('proto.ipc.MatchUser', null, global);
+goog.exportSymbol('proto.ipc.QuickpairRequest', null, global);
+goog.exportSymbol('proto.ipc.QuickpairStatus', null, global);
 goog.exportSymbol('proto.ipc.SeekRequest', null, global);
 goog.exportSymbol('proto.ipc.SeekRequests', null, global);
 goog.exportSymbol('proto.ipc.SeekState', null, global);
@@ -128,6 +130,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.ipc.DeclineSeekRequest.displayName = 'proto.ipc.DeclineSeekRequest';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ipc.QuickpairRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ipc.QuickpairRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ipc.QuickpairRequest.displayName = 'proto.ipc.QuickpairRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ipc.QuickpairStatus = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ipc.QuickpairStatus, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ipc.QuickpairStatus.displayName = 'proto.ipc.QuickpairStatus';
+}
 
 
 
@@ -163,7 +207,8 @@ proto.ipc.MatchUser.toObject = function(includeInstance, msg) {
     userId: jspb.Message.getFieldWithDefault(msg, 1, ""),
     relevantRating: jspb.Message.getFieldWithDefault(msg, 2, ""),
     isAnonymous: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
-    displayName: jspb.Message.getFieldWithDefault(msg, 4, "")
+    displayName: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    provisional: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
   };
 
   if (includeInstance) {
@@ -216,6 +261,10 @@ proto.ipc.MatchUser.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {string} */ (reader.readString());
       msg.setDisplayName(value);
       break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setProvisional(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -273,6 +322,13 @@ proto.ipc.MatchUser.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getProvisional();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
 };
 
 
@@ -348,6 +404,24 @@ proto.ipc.MatchUser.prototype.setDisplayName = function(value) {
 };
 
 
+/**
+ * optional bool provisional = 5;
+ * @return {boolean}
+ */
+proto.ipc.MatchUser.prototype.getProvisional = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ipc.MatchUser} returns this
+ */
+proto.ipc.MatchUser.prototype.setProvisional = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
 
 /**
  * List of repeated fields within this message type.
@@ -1377,6 +1451,377 @@ proto.ipc.DeclineSeekRequest.prototype.setRequestId = function(value) {
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ipc.QuickpairRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.QuickpairRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ipc.QuickpairRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.QuickpairRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    gameRequest: (f = msg.getGameRequest()) && api_proto_ipc_omgwords_pb.GameRequest.toObject(includeInstance, f),
+    minimumRatingRange: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    maximumRatingRange: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    cancel: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ipc.QuickpairRequest}
+ */
+proto.ipc.QuickpairRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ipc.QuickpairRequest;
+  return proto.ipc.QuickpairRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ipc.QuickpairRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ipc.QuickpairRequest}
+ */
+proto.ipc.QuickpairRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new api_proto_ipc_omgwords_pb.GameRequest;
+      reader.readMessage(value,api_proto_ipc_omgwords_pb.GameRequest.deserializeBinaryFromReader);
+      msg.setGameRequest(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMinimumRatingRange(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaximumRatingRange(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setCancel(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ipc.QuickpairRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ipc.QuickpairRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ipc.QuickpairRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.QuickpairRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getGameRequest();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      api_proto_ipc_omgwords_pb.GameRequest.serializeBinaryToWriter
+    );
+  }
+  f = message.getMinimumRatingRange();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getMaximumRatingRange();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getCancel();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional GameRequest game_request = 1;
+ * @return {?proto.ipc.GameRequest}
+ */
+proto.ipc.QuickpairRequest.prototype.getGameRequest = function() {
+  return /** @type{?proto.ipc.GameRequest} */ (
+    jspb.Message.getWrapperField(this, api_proto_ipc_omgwords_pb.GameRequest, 1));
+};
+
+
+/**
+ * @param {?proto.ipc.GameRequest|undefined} value
+ * @return {!proto.ipc.QuickpairRequest} returns this
+*/
+proto.ipc.QuickpairRequest.prototype.setGameRequest = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ipc.QuickpairRequest} returns this
+ */
+proto.ipc.QuickpairRequest.prototype.clearGameRequest = function() {
+  return this.setGameRequest(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ipc.QuickpairRequest.prototype.hasGameRequest = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional int32 minimum_rating_range = 2;
+ * @return {number}
+ */
+proto.ipc.QuickpairRequest.prototype.getMinimumRatingRange = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.QuickpairRequest} returns this
+ */
+proto.ipc.QuickpairRequest.prototype.setMinimumRatingRange = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int32 maximum_rating_range = 3;
+ * @return {number}
+ */
+proto.ipc.QuickpairRequest.prototype.getMaximumRatingRange = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.QuickpairRequest} returns this
+ */
+proto.ipc.QuickpairRequest.prototype.setMaximumRatingRange = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional bool cancel = 4;
+ * @return {boolean}
+ */
+proto.ipc.QuickpairRequest.prototype.getCancel = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ipc.QuickpairRequest} returns this
+ */
+proto.ipc.QuickpairRequest.prototype.setCancel = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ipc.QuickpairStatus.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.QuickpairStatus.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ipc.QuickpairStatus} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.QuickpairStatus.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    queued: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ipc.QuickpairStatus}
+ */
+proto.ipc.QuickpairStatus.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ipc.QuickpairStatus;
+  return proto.ipc.QuickpairStatus.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ipc.QuickpairStatus} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ipc.QuickpairStatus}
+ */
+proto.ipc.QuickpairStatus.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setQueued(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ipc.QuickpairStatus.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ipc.QuickpairStatus.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ipc.QuickpairStatus} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.QuickpairStatus.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getQueued();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool queued = 1;
+ * @return {boolean}
+ */
+proto.ipc.QuickpairStatus.prototype.getQueued = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ipc.QuickpairStatus} returns this
+ */
+proto.ipc.QuickpairStatus.prototype.setQueued = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
 /**
  * @enum {number}
  */
@@ -167,6 +167,12 @@ export class TournamentPerson extends jspb.Message {
   getSuspended(): boolean;
   setSuspended(value: boolean): void;
 
+  getCheckedIn(): boolean;
+  setCheckedIn(value: boolean): void;
+
+  getWithdrawalPolicy(): WithdrawalPolicyMap[keyof WithdrawalPolicyMap];
+  setWithdrawalPolicy(value: WithdrawalPolicyMap[keyof WithdrawalPolicyMap]): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): TournamentPerson.AsObject;
   static toObject(includeInstance: boolean, msg: TournamentPerson): TournamentPerson.AsObject;
@@ -182,6 +188,8 @@ export namespace TournamentPerson {
     id: string,
     rating: number,
     suspended: boolean,
+    checkedIn: boolean,
+    withdrawalPolicy: WithdrawalPolicyMap[keyof WithdrawalPolicyMap],
   }
 }
 
@@ -246,6 +254,29 @@ export class RoundControl extends jspb.Message {
   getWinDifferenceRelativeWeight(): number;
   setWinDifferenceRelativeWeight(value: number): void;
 
+  getConsolation(): boolean;
+  setConsolation(value: boolean): void;
+
+  hasScheduledStartTime(): boolean;
+  clearScheduledStartTime(): void;
+  getScheduledStartTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setScheduledStartTime(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  getNoShowGraceSeconds(): number;
+  setNoShowGraceSeconds(value: number): void;
+
+  getFirstsRelativeWeight(): number;
+  setFirstsRelativeWeight(value: number): void;
+
+  getContentionPairing(): boolean;
+  setContentionPairing(value: boolean): void;
+
+  getPairingLag(): number;
+  setPairingLag(value: number): void;
+
+  getAccelerated(): boolean;
+  setAccelerated(value: boolean): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): RoundControl.AsObject;
   static toObject(includeInstance: boolean, msg: RoundControl): RoundControl.AsObject;
@@ -268,6 +299,13 @@ export namespace RoundControl {
     allowOverMaxRepeats: boolean,
     repeatRelativeWeight: number,
     winDifferenceRelativeWeight: number,
+    consolation: boolean,
+    scheduledStartTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    noShowGraceSeconds: number,
+    firstsRelativeWeight: number,
+    contentionPairing: boolean,
+    pairingLag: number,
+    accelerated: boolean,
   }
 }
 
@@ -307,6 +345,40 @@ export class DivisionControls extends jspb.Message {
   getMaximumByePlacement(): number;
   setMaximumByePlacement(value: number): void;
 
+  getOpenRegistration(): boolean;
+  setOpenRegistration(value: boolean): void;
+
+  getRatingFloor(): number;
+  setRatingFloor(value: number): void;
+
+  getRatingCeiling(): number;
+  setRatingCeiling(value: number): void;
+
+  getMaxPlayers(): number;
+  setMaxPlayers(value: number): void;
+
+  hasRegistrationDeadline(): boolean;
+  clearRegistrationDeadline(): void;
+  getRegistrationDeadline(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setRegistrationDeadline(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  getRegistrationRequiresApproval(): boolean;
+  setRegistrationRequiresApproval(value: boolean): void;
+
+  hasCheckInDeadline(): boolean;
+  clearCheckInDeadline(): void;
+  getCheckInDeadline(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setCheckInDeadline(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  getCheckInWindowSeconds(): number;
+  setCheckInWindowSeconds(value: number): void;
+
+  getRemoveUncheckedIn(): boolean;
+  setRemoveUncheckedIn(value: boolean): void;
+
+  getRateAsPeriod(): boolean;
+  setRateAsPeriod(value: boolean): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): DivisionControls.AsObject;
   static toObject(includeInstance: boolean, msg: DivisionControls): DivisionControls.AsObject;
@@ -330,6 +402,16 @@ export namespace DivisionControls {
     gibsonSpread: number,
     minimumPlacement: number,
     maximumByePlacement: number,
+    openRegistration: boolean,
+    ratingFloor: number,
+    ratingCeiling: number,
+    maxPlayers: number,
+    registrationDeadline?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    registrationRequiresApproval: boolean,
+    checkInDeadline?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    checkInWindowSeconds: number,
+    removeUncheckedIn: boolean,
+    rateAsPeriod: boolean,
   }
 }
 
@@ -814,6 +896,800 @@ export namespace TournamentDivisionDeletedResponse {
   }
 }
 
+export class BracketMatch extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getSide(): BracketSideMap[keyof BracketSideMap];
+  setSide(value: BracketSideMap[keyof BracketSideMap]): void;
+
+  getBracketRound(): number;
+  setBracketRound(value: number): void;
+
+  getRound(): number;
+  setRound(value: number): void;
+
+  clearPlayersList(): void;
+  getPlayersList(): Array<string>;
+  setPlayersList(value: Array<string>): void;
+  addPlayers(value: string, index?: number): string;
+
+  getWinner(): string;
+  setWinner(value: string): void;
+
+  getLoser(): string;
+  setLoser(value: string): void;
+
+  getWinnerTo(): string;
+  setWinnerTo(value: string): void;
+
+  getWinnerSlot(): number;
+  setWinnerSlot(value: number): void;
+
+  getLoserTo(): string;
+  setLoserTo(value: string): void;
+
+  getLoserSlot(): number;
+  setLoserSlot(value: number): void;
+
+  clearGamesList(): void;
+  getGamesList(): Array<TournamentGame>;
+  setGamesList(value: Array<TournamentGame>): void;
+  addGames(value?: TournamentGame, index?: number): TournamentGame;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): BracketMatch.AsObject;
+  static toObject(includeInstance: boolean, msg: BracketMatch): BracketMatch.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: BracketMatch, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): BracketMatch;
+  static deserializeBinaryFromReader(message: BracketMatch, reader: jspb.BinaryReader): BracketMatch;
+}
+
+export namespace BracketMatch {
+  export type AsObject = {
+    id: string,
+    side: BracketSideMap[keyof BracketSideMap],
+    bracketRound: number,
+    round: number,
+    playersList: Array<string>,
+    winner: string,
+    loser: string,
+    winnerTo: string,
+    winnerSlot: number,
+    loserTo: string,
+    loserSlot: number,
+    gamesList: Array<TournamentGame.AsObject>,
+  }
+}
+
+export class BracketResponse extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getDivision(): string;
+  setDivision(value: string): void;
+
+  clearMatchesList(): void;
+  getMatchesList(): Array<BracketMatch>;
+  setMatchesList(value: Array<BracketMatch>): void;
+  addMatches(value?: BracketMatch, index?: number): BracketMatch;
+
+  clearPlacementsList(): void;
+  getPlacementsList(): Array<string>;
+  setPlacementsList(value: Array<string>): void;
+  addPlacements(value: string, index?: number): string;
+
+  getChampion(): string;
+  setChampion(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): BracketResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: BracketResponse): BracketResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: BracketResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): BracketResponse;
+  static deserializeBinaryFromReader(message: BracketResponse, reader: jspb.BinaryReader): BracketResponse;
+}
+
+export namespace BracketResponse {
+  export type AsObject = {
+    id: string,
+    division: string,
+    matchesList: Array<BracketMatch.AsObject>,
+    placementsList: Array<string>,
+    champion: string,
+  }
+}
+
+export class PairingWeight extends jspb.Message {
+  getWinDifference(): number;
+  setWinDifference(value: number): void;
+
+  getSpread(): number;
+  setSpread(value: number): void;
+
+  getRepeat(): number;
+  setRepeat(value: number): void;
+
+  getFirsts(): number;
+  setFirsts(value: number): void;
+
+  getTotal(): number;
+  setTotal(value: number): void;
+
+  getContention(): number;
+  setContention(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PairingWeight.AsObject;
+  static toObject(includeInstance: boolean, msg: PairingWeight): PairingWeight.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: PairingWeight, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PairingWeight;
+  static deserializeBinaryFromReader(message: PairingWeight, reader: jspb.BinaryReader): PairingWeight;
+}
+
+export namespace PairingWeight {
+  export type AsObject = {
+    winDifference: number,
+    spread: number,
+    repeat: number,
+    firsts: number,
+    total: number,
+    contention: number,
+  }
+}
+
+export class PlayerContention extends jspb.Message {
+  getPlayerId(): string;
+  setPlayerId(value: string): void;
+
+  getRank(): number;
+  setRank(value: number): void;
+
+  getBestPlace(): number;
+  setBestPlace(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PlayerContention.AsObject;
+  static toObject(includeInstance: boolean, msg: PlayerContention): PlayerContention.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: PlayerContention, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PlayerContention;
+  static deserializeBinaryFromReader(message: PlayerContention, reader: jspb.BinaryReader): PlayerContention;
+}
+
+export namespace PlayerContention {
+  export type AsObject = {
+    playerId: string,
+    rank: number,
+    bestPlace: number,
+  }
+}
+
+export class PairingPreview extends jspb.Message {
+  clearPlayersList(): void;
+  getPlayersList(): Array<string>;
+  setPlayersList(value: Array<string>): void;
+  addPlayers(value: string, index?: number): string;
+
+  getPreviousGames(): number;
+  setPreviousGames(value: number): void;
+
+  getRepeat(): boolean;
+  setRepeat(value: boolean): void;
+
+  getBye(): boolean;
+  setBye(value: boolean): void;
+
+  getGibson(): boolean;
+  setGibson(value: boolean): void;
+
+  hasWeight(): boolean;
+  clearWeight(): void;
+  getWeight(): PairingWeight | undefined;
+  setWeight(value?: PairingWeight): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PairingPreview.AsObject;
+  static toObject(includeInstance: boolean, msg: PairingPreview): PairingPreview.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: PairingPreview, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PairingPreview;
+  static deserializeBinaryFromReader(message: PairingPreview, reader: jspb.BinaryReader): PairingPreview;
+}
+
+export namespace PairingPreview {
+  export type AsObject = {
+    playersList: Array<string>,
+    previousGames: number,
+    repeat: boolean,
+    bye: boolean,
+    gibson: boolean,
+    weight?: PairingWeight.AsObject,
+  }
+}
+
+export class PairingPreviewResponse extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getDivision(): string;
+  setDivision(value: string): void;
+
+  getRound(): number;
+  setRound(value: number): void;
+
+  hasRoundControls(): boolean;
+  clearRoundControls(): void;
+  getRoundControls(): RoundControl | undefined;
+  setRoundControls(value?: RoundControl): void;
+
+  clearPairingsList(): void;
+  getPairingsList(): Array<PairingPreview>;
+  setPairingsList(value: Array<PairingPreview>): void;
+  addPairings(value?: PairingPreview, index?: number): PairingPreview;
+
+  clearGibsonizedPlayersList(): void;
+  getGibsonizedPlayersList(): Array<string>;
+  setGibsonizedPlayersList(value: Array<string>): void;
+  addGibsonizedPlayers(value: string, index?: number): string;
+
+  getTotalWeight(): number;
+  setTotalWeight(value: number): void;
+
+  clearContentionList(): void;
+  getContentionList(): Array<PlayerContention>;
+  setContentionList(value: Array<PlayerContention>): void;
+  addContention(value?: PlayerContention, index?: number): PlayerContention;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PairingPreviewResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: PairingPreviewResponse): PairingPreviewResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: PairingPreviewResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PairingPreviewResponse;
+  static deserializeBinaryFromReader(message: PairingPreviewResponse, reader: jspb.BinaryReader): PairingPreviewResponse;
+}
+
+export namespace PairingPreviewResponse {
+  export type AsObject = {
+    id: string,
+    division: string,
+    round: number,
+    roundControls?: RoundControl.AsObject,
+    pairingsList: Array<PairingPreview.AsObject>,
+    gibsonizedPlayersList: Array<string>,
+    totalWeight: number,
+    contentionList: Array<PlayerContention.AsObject>,
+  }
+}
+
+export class LadderChallenge extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getChallenger(): string;
+  setChallenger(value: string): void;
+
+  getChallenged(): string;
+  setChallenged(value: string): void;
+
+  hasCreatedAt(): boolean;
+  clearCreatedAt(): void;
+  getCreatedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setCreatedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  hasDeadline(): boolean;
+  clearDeadline(): void;
+  getDeadline(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setDeadline(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  getGameId(): string;
+  setGameId(value: string): void;
+
+  hasAcceptedAt(): boolean;
+  clearAcceptedAt(): void;
+  getAcceptedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setAcceptedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): LadderChallenge.AsObject;
+  static toObject(includeInstance: boolean, msg: LadderChallenge): LadderChallenge.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: LadderChallenge, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): LadderChallenge;
+  static deserializeBinaryFromReader(message: LadderChallenge, reader: jspb.BinaryReader): LadderChallenge;
+}
+
+export namespace LadderChallenge {
+  export type AsObject = {
+    id: string,
+    challenger: string,
+    challenged: string,
+    createdAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    deadline?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    gameId: string,
+    acceptedAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+}
+
+export class Ladder extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  clearPlayersList(): void;
+  getPlayersList(): Array<TournamentPerson>;
+  setPlayersList(value: Array<TournamentPerson>): void;
+  addPlayers(value?: TournamentPerson, index?: number): TournamentPerson;
+
+  getMaxChallengeDistance(): number;
+  setMaxChallengeDistance(value: number): void;
+
+  getAcceptSeconds(): number;
+  setAcceptSeconds(value: number): void;
+
+  clearChallengesList(): void;
+  getChallengesList(): Array<LadderChallenge>;
+  setChallengesList(value: Array<LadderChallenge>): void;
+  addChallenges(value?: LadderChallenge, index?: number): LadderChallenge;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Ladder.AsObject;
+  static toObject(includeInstance: boolean, msg: Ladder): Ladder.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: Ladder, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Ladder;
+  static deserializeBinaryFromReader(message: Ladder, reader: jspb.BinaryReader): Ladder;
+}
+
+export namespace Ladder {
+  export type AsObject = {
+    id: string,
+    playersList: Array<TournamentPerson.AsObject>,
+    maxChallengeDistance: number,
+    acceptSeconds: number,
+    challengesList: Array<LadderChallenge.AsObject>,
+  }
+}
+
+export class ClubMember extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getRole(): ClubRoleMap[keyof ClubRoleMap];
+  setRole(value: ClubRoleMap[keyof ClubRoleMap]): void;
+
+  hasJoinedAt(): boolean;
+  clearJoinedAt(): void;
+  getJoinedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setJoinedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ClubMember.AsObject;
+  static toObject(includeInstance: boolean, msg: ClubMember): ClubMember.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ClubMember, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ClubMember;
+  static deserializeBinaryFromReader(message: ClubMember, reader: jspb.BinaryReader): ClubMember;
+}
+
+export namespace ClubMember {
+  export type AsObject = {
+    id: string,
+    role: ClubRoleMap[keyof ClubRoleMap],
+    joinedAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+}
+
+export class Club extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  clearMembersList(): void;
+  getMembersList(): Array<ClubMember>;
+  setMembersList(value: Array<ClubMember>): void;
+  addMembers(value?: ClubMember, index?: number): ClubMember;
+
+  getMembersOnly(): boolean;
+  setMembersOnly(value: boolean): void;
+
+  hasSessionTemplate(): boolean;
+  clearSessionTemplate(): void;
+  getSessionTemplate(): ClubSessionTemplate | undefined;
+  setSessionTemplate(value?: ClubSessionTemplate): void;
+
+  hasSessionSchedule(): boolean;
+  clearSessionSchedule(): void;
+  getSessionSchedule(): ClubSessionSchedule | undefined;
+  setSessionSchedule(value?: ClubSessionSchedule): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Club.AsObject;
+  static toObject(includeInstance: boolean, msg: Club): Club.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: Club, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Club;
+  static deserializeBinaryFromReader(message: Club, reader: jspb.BinaryReader): Club;
+}
+
+export namespace Club {
+  export type AsObject = {
+    id: string,
+    membersList: Array<ClubMember.AsObject>,
+    membersOnly: boolean,
+    sessionTemplate?: ClubSessionTemplate.AsObject,
+    sessionSchedule?: ClubSessionSchedule.AsObject,
+  }
+}
+
+export class ClubSessionTemplateDivision extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  hasControls(): boolean;
+  clearControls(): void;
+  getControls(): DivisionControls | undefined;
+  setControls(value?: DivisionControls): void;
+
+  clearRoundControlsList(): void;
+  getRoundControlsList(): Array<RoundControl>;
+  setRoundControlsList(value: Array<RoundControl>): void;
+  addRoundControls(value?: RoundControl, index?: number): RoundControl;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ClubSessionTemplateDivision.AsObject;
+  static toObject(includeInstance: boolean, msg: ClubSessionTemplateDivision): ClubSessionTemplateDivision.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ClubSessionTemplateDivision, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ClubSessionTemplateDivision;
+  static deserializeBinaryFromReader(message: ClubSessionTemplateDivision, reader: jspb.BinaryReader): ClubSessionTemplateDivision;
+}
+
+export namespace ClubSessionTemplateDivision {
+  export type AsObject = {
+    name: string,
+    controls?: DivisionControls.AsObject,
+    roundControlsList: Array<RoundControl.AsObject>,
+  }
+}
+
+export class ClubSessionTemplate extends jspb.Message {
+  clearDivisionsList(): void;
+  getDivisionsList(): Array<ClubSessionTemplateDivision>;
+  setDivisionsList(value: Array<ClubSessionTemplateDivision>): void;
+  addDivisions(value?: ClubSessionTemplateDivision, index?: number): ClubSessionTemplateDivision;
+
+  hasDefaultClubSettings(): boolean;
+  clearDefaultClubSettings(): void;
+  getDefaultClubSettings(): api_proto_ipc_omgwords_pb.GameRequest | undefined;
+  setDefaultClubSettings(value?: api_proto_ipc_omgwords_pb.GameRequest): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ClubSessionTemplate.AsObject;
+  static toObject(includeInstance: boolean, msg: ClubSessionTemplate): ClubSessionTemplate.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ClubSessionTemplate, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ClubSessionTemplate;
+  static deserializeBinaryFromReader(message: ClubSessionTemplate, reader: jspb.BinaryReader): ClubSessionTemplate;
+}
+
+export namespace ClubSessionTemplate {
+  export type AsObject = {
+    divisionsList: Array<ClubSessionTemplateDivision.AsObject>,
+    defaultClubSettings?: api_proto_ipc_omgwords_pb.GameRequest.AsObject,
+  }
+}
+
+export class ClubSessionSchedule extends jspb.Message {
+  getWeekday(): number;
+  setWeekday(value: number): void;
+
+  getHour(): number;
+  setHour(value: number): void;
+
+  getMinute(): number;
+  setMinute(value: number): void;
+
+  getTimeZone(): string;
+  setTimeZone(value: string): void;
+
+  getLeadHours(): number;
+  setLeadHours(value: number): void;
+
+  hasLastSession(): boolean;
+  clearLastSession(): void;
+  getLastSession(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setLastSession(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ClubSessionSchedule.AsObject;
+  static toObject(includeInstance: boolean, msg: ClubSessionSchedule): ClubSessionSchedule.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ClubSessionSchedule, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ClubSessionSchedule;
+  static deserializeBinaryFromReader(message: ClubSessionSchedule, reader: jspb.BinaryReader): ClubSessionSchedule;
+}
+
+export namespace ClubSessionSchedule {
+  export type AsObject = {
+    weekday: number,
+    hour: number,
+    minute: number,
+    timeZone: string,
+    leadHours: number,
+    lastSession?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+}
+
+export class Prize extends jspb.Message {
+  getType(): PrizeTypeMap[keyof PrizeTypeMap];
+  setType(value: PrizeTypeMap[keyof PrizeTypeMap]): void;
+
+  getDivision(): string;
+  setDivision(value: string): void;
+
+  getPlace(): number;
+  setPlace(value: number): void;
+
+  getRatingFloor(): number;
+  setRatingFloor(value: number): void;
+
+  getRatingCeiling(): number;
+  setRatingCeiling(value: number): void;
+
+  getAmount(): number;
+  setAmount(value: number): void;
+
+  getDescription(): string;
+  setDescription(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Prize.AsObject;
+  static toObject(includeInstance: boolean, msg: Prize): Prize.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: Prize, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Prize;
+  static deserializeBinaryFromReader(message: Prize, reader: jspb.BinaryReader): Prize;
+}
+
+export namespace Prize {
+  export type AsObject = {
+    type: PrizeTypeMap[keyof PrizeTypeMap],
+    division: string,
+    place: number,
+    ratingFloor: number,
+    ratingCeiling: number,
+    amount: number,
+    description: string,
+  }
+}
+
+export class PrizeAward extends jspb.Message {
+  hasPrize(): boolean;
+  clearPrize(): void;
+  getPrize(): Prize | undefined;
+  setPrize(value?: Prize): void;
+
+  getDivision(): string;
+  setDivision(value: string): void;
+
+  clearPlayerIdsList(): void;
+  getPlayerIdsList(): Array<string>;
+  setPlayerIdsList(value: Array<string>): void;
+  addPlayerIds(value: string, index?: number): string;
+
+  getScore(): number;
+  setScore(value: number): void;
+
+  clearAmountsList(): void;
+  getAmountsList(): Array<number>;
+  setAmountsList(value: Array<number>): void;
+  addAmounts(value: number, index?: number): number;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PrizeAward.AsObject;
+  static toObject(includeInstance: boolean, msg: PrizeAward): PrizeAward.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: PrizeAward, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PrizeAward;
+  static deserializeBinaryFromReader(message: PrizeAward, reader: jspb.BinaryReader): PrizeAward;
+}
+
+export namespace PrizeAward {
+  export type AsObject = {
+    prize?: Prize.AsObject,
+    division: string,
+    playerIdsList: Array<string>,
+    score: number,
+    amountsList: Array<number>,
+  }
+}
+
+export class LadderChallengeAccept extends jspb.Message {
+  getTournamentId(): string;
+  setTournamentId(value: string): void;
+
+  getChallengeId(): string;
+  setChallengeId(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): LadderChallengeAccept.AsObject;
+  static toObject(includeInstance: boolean, msg: LadderChallengeAccept): LadderChallengeAccept.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: LadderChallengeAccept, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): LadderChallengeAccept;
+  static deserializeBinaryFromReader(message: LadderChallengeAccept, reader: jspb.BinaryReader): LadderChallengeAccept;
+}
+
+export namespace LadderChallengeAccept {
+  export type AsObject = {
+    tournamentId: string,
+    challengeId: string,
+  }
+}
+
+export class LadderChallengeDecline extends jspb.Message {
+  getTournamentId(): string;
+  setTournamentId(value: string): void;
+
+  getChallengeId(): string;
+  setChallengeId(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): LadderChallengeDecline.AsObject;
+  static toObject(includeInstance: boolean, msg: LadderChallengeDecline): LadderChallengeDecline.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: LadderChallengeDecline, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): LadderChallengeDecline;
+  static deserializeBinaryFromReader(message: LadderChallengeDecline, reader: jspb.BinaryReader): LadderChallengeDecline;
+}
+
+export namespace LadderChallengeDecline {
+  export type AsObject = {
+    tournamentId: string,
+    challengeId: string,
+  }
+}
+
+export class PlayerPairingReport extends jspb.Message {
+  getPlayerId(): string;
+  setPlayerId(value: string): void;
+
+  getGames(): number;
+  setGames(value: number): void;
+
+  getRepeats(): number;
+  setRepeats(value: number): void;
+
+  getFirsts(): number;
+  setFirsts(value: number): void;
+
+  getSeconds(): number;
+  setSeconds(value: number): void;
+
+  getByes(): number;
+  setByes(value: number): void;
+
+  getAverageOpponentRating(): number;
+  setAverageOpponentRating(value: number): void;
+
+  getOpponentRatingVariance(): number;
+  setOpponentRatingVariance(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PlayerPairingReport.AsObject;
+  static toObject(includeInstance: boolean, msg: PlayerPairingReport): PlayerPairingReport.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: PlayerPairingReport, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PlayerPairingReport;
+  static deserializeBinaryFromReader(message: PlayerPairingReport, reader: jspb.BinaryReader): PlayerPairingReport;
+}
+
+export namespace PlayerPairingReport {
+  export type AsObject = {
+    playerId: string,
+    games: number,
+    repeats: number,
+    firsts: number,
+    seconds: number,
+    byes: number,
+    averageOpponentRating: number,
+    opponentRatingVariance: number,
+  }
+}
+
+export class RoundPairingReport extends jspb.Message {
+  getRound(): number;
+  setRound(value: number): void;
+
+  getPairingMethod(): PairingMethodMap[keyof PairingMethodMap];
+  setPairingMethod(value: PairingMethodMap[keyof PairingMethodMap]): void;
+
+  getGames(): number;
+  setGames(value: number): void;
+
+  getRepeats(): number;
+  setRepeats(value: number): void;
+
+  getMismatchedGames(): number;
+  setMismatchedGames(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RoundPairingReport.AsObject;
+  static toObject(includeInstance: boolean, msg: RoundPairingReport): RoundPairingReport.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: RoundPairingReport, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RoundPairingReport;
+  static deserializeBinaryFromReader(message: RoundPairingReport, reader: jspb.BinaryReader): RoundPairingReport;
+}
+
+export namespace RoundPairingReport {
+  export type AsObject = {
+    round: number,
+    pairingMethod: PairingMethodMap[keyof PairingMethodMap],
+    games: number,
+    repeats: number,
+    mismatchedGames: number,
+  }
+}
+
+export class PairingReport extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getDivision(): string;
+  setDivision(value: string): void;
+
+  clearPlayersList(): void;
+  getPlayersList(): Array<PlayerPairingReport>;
+  setPlayersList(value: Array<PlayerPairingReport>): void;
+  addPlayers(value?: PlayerPairingReport, index?: number): PlayerPairingReport;
+
+  clearRoundsList(): void;
+  getRoundsList(): Array<RoundPairingReport>;
+  setRoundsList(value: Array<RoundPairingReport>): void;
+  addRounds(value?: RoundPairingReport, index?: number): RoundPairingReport;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PairingReport.AsObject;
+  static toObject(includeInstance: boolean, msg: PairingReport): PairingReport.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: PairingReport, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PairingReport;
+  static deserializeBinaryFromReader(message: PairingReport, reader: jspb.BinaryReader): PairingReport;
+}
+
+export namespace PairingReport {
+  export type AsObject = {
+    id: string,
+    division: string,
+    playersList: Array<PlayerPairingReport.AsObject>,
+    roundsList: Array<RoundPairingReport.AsObject>,
+  }
+}
+
 export interface TournamentGameResultMap {
   NO_RESULT: 0;
   WIN: 1;
@@ -839,6 +1715,9 @@ export interface PairingMethodMap {
   QUICKPAIR: 7;
   MANUAL: 8;
   TEAM_ROUND_ROBIN: 9;
+  DOUBLE_ELIMINATION: 10;
+  SEEDED_SPLIT: 11;
+  SEEDED_FOLD: 12;
 }
 
 export const PairingMethod: PairingMethodMap;
@@ -851,3 +1730,42 @@ export interface FirstMethodMap {
 
 export const FirstMethod: FirstMethodMap;
 
+export interface WithdrawalPolicyMap {
+  WITHDRAW_DROP: 0;
+  WITHDRAW_FORFEIT: 1;
+}
+
+export const WithdrawalPolicy: WithdrawalPolicyMap;
+
+export interface BracketSideMap {
+  WINNERS_BRACKET: 0;
+  LOSERS_BRACKET: 1;
+  GRAND_FINAL: 2;
+  CONSOLATION_BRACKET: 3;
+}
+
+export const BracketSide: BracketSideMap;
+
+export interface ClubRoleMap {
+  CLUB_MEMBER: 0;
+  CLUB_OFFICER: 1;
+}
+
+export const ClubRole: ClubRoleMap;
+
+export interface PrizeTypeMap {
+  PLACE_PRIZE: 0;
+  CLASS_PRIZE: 1;
+  HIGH_GAME_PRIZE: 2;
+  HIGH_LOSS_PRIZE: 3;
+}
+
+export const PrizeType: PrizeTypeMap;
+
+export interface PrizeTieRuleMap {
+  SPLIT_BY_WINS_AND_SPREAD: 0;
+  SPLIT_BY_WINS: 1;
+}
+
+export const PrizeTieRule: PrizeTieRuleMap;
+
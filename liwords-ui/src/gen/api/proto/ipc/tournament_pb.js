@@ -19,6 +19,15 @@ var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/time
 goog.object.extend(proto, google_protobuf_timestamp_pb);
 var api_proto_ipc_omgwords_pb = require('../../../api/proto/ipc/omgwords_pb.js');
 goog.object.extend(proto, api_proto_ipc_omgwords_pb);
+goog.exportSymbol('proto.ipc.BracketMatch', null, global);
+goog.exportSymbol('proto.ipc.BracketResponse', null, global);
+goog.exportSymbol('proto.ipc.BracketSide', null, global);
+goog.exportSymbol('proto.ipc.Club', null, global);
+goog.exportSymbol('proto.ipc.ClubMember', null, global);
+goog.exportSymbol('proto.ipc.ClubRole', null, global);
+goog.exportSymbol('proto.ipc.ClubSessionSchedule', null, global);
+goog.exportSymbol('proto.ipc.ClubSessionTemplate', null, global);
+goog.exportSymbol('proto.ipc.ClubSessionTemplateDivision', null, global);
 goog.exportSymbol('proto.ipc.DivisionControls', null, global);
 goog.exportSymbol('proto.ipc.DivisionControlsResponse', null, global);
 goog.exportSymbol('proto.ipc.DivisionPairingsDeletedResponse', null, global);
@@ -26,12 +35,27 @@ goog.exportSymbol('proto.ipc.DivisionPairingsResponse', null, global);
 goog.exportSymbol('proto.ipc.DivisionRoundControls', null, global);
 goog.exportSymbol('proto.ipc.FirstMethod', null, global);
 goog.exportSymbol('proto.ipc.FullTournamentDivisions', null, global);
+goog.exportSymbol('proto.ipc.Ladder', null, global);
+goog.exportSymbol('proto.ipc.LadderChallenge', null, global);
+goog.exportSymbol('proto.ipc.LadderChallengeAccept', null, global);
+goog.exportSymbol('proto.ipc.LadderChallengeDecline', null, global);
 goog.exportSymbol('proto.ipc.Pairing', null, global);
 goog.exportSymbol('proto.ipc.PairingMethod', null, global);
+goog.exportSymbol('proto.ipc.PairingPreview', null, global);
+goog.exportSymbol('proto.ipc.PairingPreviewResponse', null, global);
+goog.exportSymbol('proto.ipc.PairingReport', null, global);
+goog.exportSymbol('proto.ipc.PairingWeight', null, global);
+goog.exportSymbol('proto.ipc.PlayerContention', null, global);
+goog.exportSymbol('proto.ipc.PlayerPairingReport', null, global);
 goog.exportSymbol('proto.ipc.PlayerStanding', null, global);
 goog.exportSymbol('proto.ipc.PlayersAddedOrRemovedResponse', null, global);
+goog.exportSymbol('proto.ipc.Prize', null, global);
+goog.exportSymbol('proto.ipc.PrizeAward', null, global);
+goog.exportSymbol('proto.ipc.PrizeTieRule', null, global);
+goog.exportSymbol('proto.ipc.PrizeType', null, global);
 goog.exportSymbol('proto.ipc.ReadyForTournamentGame', null, global);
 goog.exportSymbol('proto.ipc.RoundControl', null, global);
+goog.exportSymbol('proto.ipc.RoundPairingReport', null, global);
 goog.exportSymbol('proto.ipc.RoundStandings', null, global);
 goog.exportSymbol('proto.ipc.TournamentDataResponse', null, global);
 goog.exportSymbol('proto.ipc.TournamentDivisionDataResponse', null, global);
@@ -44,6 +68,7 @@ goog.exportSymbol('proto.ipc.TournamentGameResult', null, global);
 goog.exportSymbol('proto.ipc.TournamentPerson', null, global);
 goog.exportSymbol('proto.ipc.TournamentPersons', null, global);
 goog.exportSymbol('proto.ipc.TournamentRoundStarted', null, global);
+goog.exportSymbol('proto.ipc.WithdrawalPolicy', null, global);
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -506,13 +531,6329 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.ipc.TournamentDivisionDeletedResponse.displayName = 'proto.ipc.TournamentDivisionDeletedResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ipc.BracketMatch = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ipc.BracketMatch.repeatedFields_, null);
+};
+goog.inherits(proto.ipc.BracketMatch, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ipc.BracketMatch.displayName = 'proto.ipc.BracketMatch';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ipc.BracketResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ipc.BracketResponse.repeatedFields_, null);
+};
+goog.inherits(proto.ipc.BracketResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ipc.BracketResponse.displayName = 'proto.ipc.BracketResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ipc.PairingWeight = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ipc.PairingWeight, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ipc.PairingWeight.displayName = 'proto.ipc.PairingWeight';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ipc.PlayerContention = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ipc.PlayerContention, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ipc.PlayerContention.displayName = 'proto.ipc.PlayerContention';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ipc.PairingPreview = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ipc.PairingPreview.repeatedFields_, null);
+};
+goog.inherits(proto.ipc.PairingPreview, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ipc.PairingPreview.displayName = 'proto.ipc.PairingPreview';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ipc.PairingPreviewResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ipc.PairingPreviewResponse.repeatedFields_, null);
+};
+goog.inherits(proto.ipc.PairingPreviewResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ipc.PairingPreviewResponse.displayName = 'proto.ipc.PairingPreviewResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ipc.LadderChallenge = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ipc.LadderChallenge, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ipc.LadderChallenge.displayName = 'proto.ipc.LadderChallenge';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ipc.Ladder = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ipc.Ladder.repeatedFields_, null);
+};
+goog.inherits(proto.ipc.Ladder, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ipc.Ladder.displayName = 'proto.ipc.Ladder';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ipc.ClubMember = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ipc.ClubMember, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ipc.ClubMember.displayName = 'proto.ipc.ClubMember';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ipc.Club = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ipc.Club.repeatedFields_, null);
+};
+goog.inherits(proto.ipc.Club, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ipc.Club.displayName = 'proto.ipc.Club';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ipc.ClubSessionTemplateDivision = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ipc.ClubSessionTemplateDivision.repeatedFields_, null);
+};
+goog.inherits(proto.ipc.ClubSessionTemplateDivision, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ipc.ClubSessionTemplateDivision.displayName = 'proto.ipc.ClubSessionTemplateDivision';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ipc.ClubSessionTemplate = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ipc.ClubSessionTemplate.repeatedFields_, null);
+};
+goog.inherits(proto.ipc.ClubSessionTemplate, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ipc.ClubSessionTemplate.displayName = 'proto.ipc.ClubSessionTemplate';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ipc.ClubSessionSchedule = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ipc.ClubSessionSchedule, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ipc.ClubSessionSchedule.displayName = 'proto.ipc.ClubSessionSchedule';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ipc.Prize = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ipc.Prize, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ipc.Prize.displayName = 'proto.ipc.Prize';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ipc.PrizeAward = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ipc.PrizeAward.repeatedFields_, null);
+};
+goog.inherits(proto.ipc.PrizeAward, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ipc.PrizeAward.displayName = 'proto.ipc.PrizeAward';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ipc.LadderChallengeAccept = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ipc.LadderChallengeAccept, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ipc.LadderChallengeAccept.displayName = 'proto.ipc.LadderChallengeAccept';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ipc.LadderChallengeDecline = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ipc.LadderChallengeDecline, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ipc.LadderChallengeDecline.displayName = 'proto.ipc.LadderChallengeDecline';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ipc.PlayerPairingReport = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ipc.PlayerPairingReport, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ipc.PlayerPairingReport.displayName = 'proto.ipc.PlayerPairingReport';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ipc.RoundPairingReport = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ipc.RoundPairingReport, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ipc.RoundPairingReport.displayName = 'proto.ipc.RoundPairingReport';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ipc.PairingReport = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ipc.PairingReport.repeatedFields_, null);
+};
+goog.inherits(proto.ipc.PairingReport, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ipc.PairingReport.displayName = 'proto.ipc.PairingReport';
+}
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ipc.TournamentGameEndedEvent.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ipc.TournamentGameEndedEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.TournamentGameEndedEvent.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ipc.TournamentGameEndedEvent} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.TournamentGameEndedEvent.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    gameId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    playersList: jspb.Message.toObjectList(msg.getPlayersList(),
+    proto.ipc.TournamentGameEndedEvent.Player.toObject, includeInstance),
+    endReason: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    time: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    round: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    division: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    gameIndex: jspb.Message.getFieldWithDefault(msg, 7, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ipc.TournamentGameEndedEvent}
+ */
+proto.ipc.TournamentGameEndedEvent.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ipc.TournamentGameEndedEvent;
+  return proto.ipc.TournamentGameEndedEvent.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ipc.TournamentGameEndedEvent} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ipc.TournamentGameEndedEvent}
+ */
+proto.ipc.TournamentGameEndedEvent.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setGameId(value);
+      break;
+    case 2:
+      var value = new proto.ipc.TournamentGameEndedEvent.Player;
+      reader.readMessage(value,proto.ipc.TournamentGameEndedEvent.Player.deserializeBinaryFromReader);
+      msg.addPlayers(value);
+      break;
+    case 3:
+      var value = /** @type {!proto.ipc.GameEndReason} */ (reader.readEnum());
+      msg.setEndReason(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTime(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRound(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDivision(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setGameIndex(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ipc.TournamentGameEndedEvent.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ipc.TournamentGameEndedEvent.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ipc.TournamentGameEndedEvent} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.TournamentGameEndedEvent.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getGameId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPlayersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.ipc.TournamentGameEndedEvent.Player.serializeBinaryToWriter
+    );
+  }
+  f = message.getEndReason();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
+      f
+    );
+  }
+  f = message.getTime();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
+  f = message.getRound();
+  if (f !== 0) {
+    writer.writeInt32(
+      5,
+      f
+    );
+  }
+  f = message.getDivision();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getGameIndex();
+  if (f !== 0) {
+    writer.writeInt32(
+      7,
+      f
+    );
+  }
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ipc.TournamentGameEndedEvent.Player.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.TournamentGameEndedEvent.Player.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ipc.TournamentGameEndedEvent.Player} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.TournamentGameEndedEvent.Player.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    username: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    score: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    result: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ipc.TournamentGameEndedEvent.Player}
+ */
+proto.ipc.TournamentGameEndedEvent.Player.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ipc.TournamentGameEndedEvent.Player;
+  return proto.ipc.TournamentGameEndedEvent.Player.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ipc.TournamentGameEndedEvent.Player} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ipc.TournamentGameEndedEvent.Player}
+ */
+proto.ipc.TournamentGameEndedEvent.Player.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUsername(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setScore(value);
+      break;
+    case 3:
+      var value = /** @type {!proto.ipc.TournamentGameResult} */ (reader.readEnum());
+      msg.setResult(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ipc.TournamentGameEndedEvent.Player.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ipc.TournamentGameEndedEvent.Player.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ipc.TournamentGameEndedEvent.Player} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.TournamentGameEndedEvent.Player.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUsername();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getScore();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getResult();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string username = 1;
+ * @return {string}
+ */
+proto.ipc.TournamentGameEndedEvent.Player.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.TournamentGameEndedEvent.Player} returns this
+ */
+proto.ipc.TournamentGameEndedEvent.Player.prototype.setUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int32 score = 2;
+ * @return {number}
+ */
+proto.ipc.TournamentGameEndedEvent.Player.prototype.getScore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.TournamentGameEndedEvent.Player} returns this
+ */
+proto.ipc.TournamentGameEndedEvent.Player.prototype.setScore = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional TournamentGameResult result = 3;
+ * @return {!proto.ipc.TournamentGameResult}
+ */
+proto.ipc.TournamentGameEndedEvent.Player.prototype.getResult = function() {
+  return /** @type {!proto.ipc.TournamentGameResult} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {!proto.ipc.TournamentGameResult} value
+ * @return {!proto.ipc.TournamentGameEndedEvent.Player} returns this
+ */
+proto.ipc.TournamentGameEndedEvent.Player.prototype.setResult = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
+};
+
+
+/**
+ * optional string game_id = 1;
+ * @return {string}
+ */
+proto.ipc.TournamentGameEndedEvent.prototype.getGameId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.TournamentGameEndedEvent} returns this
+ */
+proto.ipc.TournamentGameEndedEvent.prototype.setGameId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * repeated Player players = 2;
+ * @return {!Array<!proto.ipc.TournamentGameEndedEvent.Player>}
+ */
+proto.ipc.TournamentGameEndedEvent.prototype.getPlayersList = function() {
+  return /** @type{!Array<!proto.ipc.TournamentGameEndedEvent.Player>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ipc.TournamentGameEndedEvent.Player, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.ipc.TournamentGameEndedEvent.Player>} value
+ * @return {!proto.ipc.TournamentGameEndedEvent} returns this
+*/
+proto.ipc.TournamentGameEndedEvent.prototype.setPlayersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.ipc.TournamentGameEndedEvent.Player=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.TournamentGameEndedEvent.Player}
+ */
+proto.ipc.TournamentGameEndedEvent.prototype.addPlayers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.ipc.TournamentGameEndedEvent.Player, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ipc.TournamentGameEndedEvent} returns this
+ */
+proto.ipc.TournamentGameEndedEvent.prototype.clearPlayersList = function() {
+  return this.setPlayersList([]);
+};
+
+
+/**
+ * optional GameEndReason end_reason = 3;
+ * @return {!proto.ipc.GameEndReason}
+ */
+proto.ipc.TournamentGameEndedEvent.prototype.getEndReason = function() {
+  return /** @type {!proto.ipc.GameEndReason} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {!proto.ipc.GameEndReason} value
+ * @return {!proto.ipc.TournamentGameEndedEvent} returns this
+ */
+proto.ipc.TournamentGameEndedEvent.prototype.setEndReason = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
+};
+
+
+/**
+ * optional int64 time = 4;
+ * @return {number}
+ */
+proto.ipc.TournamentGameEndedEvent.prototype.getTime = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.TournamentGameEndedEvent} returns this
+ */
+proto.ipc.TournamentGameEndedEvent.prototype.setTime = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional int32 round = 5;
+ * @return {number}
+ */
+proto.ipc.TournamentGameEndedEvent.prototype.getRound = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.TournamentGameEndedEvent} returns this
+ */
+proto.ipc.TournamentGameEndedEvent.prototype.setRound = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional string division = 6;
+ * @return {string}
+ */
+proto.ipc.TournamentGameEndedEvent.prototype.getDivision = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.TournamentGameEndedEvent} returns this
+ */
+proto.ipc.TournamentGameEndedEvent.prototype.setDivision = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional int32 game_index = 7;
+ * @return {number}
+ */
+proto.ipc.TournamentGameEndedEvent.prototype.getGameIndex = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.TournamentGameEndedEvent} returns this
+ */
+proto.ipc.TournamentGameEndedEvent.prototype.setGameIndex = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ipc.TournamentRoundStarted.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.TournamentRoundStarted.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ipc.TournamentRoundStarted} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.TournamentRoundStarted.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    tournamentId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    round: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    gameIndex: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    deadline: (f = msg.getDeadline()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ipc.TournamentRoundStarted}
+ */
+proto.ipc.TournamentRoundStarted.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ipc.TournamentRoundStarted;
+  return proto.ipc.TournamentRoundStarted.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ipc.TournamentRoundStarted} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ipc.TournamentRoundStarted}
+ */
+proto.ipc.TournamentRoundStarted.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTournamentId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDivision(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRound(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setGameIndex(value);
+      break;
+    case 5:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setDeadline(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ipc.TournamentRoundStarted.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ipc.TournamentRoundStarted.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ipc.TournamentRoundStarted} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.TournamentRoundStarted.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTournamentId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDivision();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getRound();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getGameIndex();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+  f = message.getDeadline();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string tournament_id = 1;
+ * @return {string}
+ */
+proto.ipc.TournamentRoundStarted.prototype.getTournamentId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.TournamentRoundStarted} returns this
+ */
+proto.ipc.TournamentRoundStarted.prototype.setTournamentId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string division = 2;
+ * @return {string}
+ */
+proto.ipc.TournamentRoundStarted.prototype.getDivision = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.TournamentRoundStarted} returns this
+ */
+proto.ipc.TournamentRoundStarted.prototype.setDivision = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional int32 round = 3;
+ * @return {number}
+ */
+proto.ipc.TournamentRoundStarted.prototype.getRound = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.TournamentRoundStarted} returns this
+ */
+proto.ipc.TournamentRoundStarted.prototype.setRound = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional int32 game_index = 4;
+ * @return {number}
+ */
+proto.ipc.TournamentRoundStarted.prototype.getGameIndex = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.TournamentRoundStarted} returns this
+ */
+proto.ipc.TournamentRoundStarted.prototype.setGameIndex = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp deadline = 5;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.ipc.TournamentRoundStarted.prototype.getDeadline = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 5));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.ipc.TournamentRoundStarted} returns this
+*/
+proto.ipc.TournamentRoundStarted.prototype.setDeadline = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ipc.TournamentRoundStarted} returns this
+ */
+proto.ipc.TournamentRoundStarted.prototype.clearDeadline = function() {
+  return this.setDeadline(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ipc.TournamentRoundStarted.prototype.hasDeadline = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ipc.ReadyForTournamentGame.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.ReadyForTournamentGame.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ipc.ReadyForTournamentGame} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.ReadyForTournamentGame.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    tournamentId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    round: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    playerId: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    gameIndex: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    unready: jspb.Message.getBooleanFieldWithDefault(msg, 6, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ipc.ReadyForTournamentGame}
+ */
+proto.ipc.ReadyForTournamentGame.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ipc.ReadyForTournamentGame;
+  return proto.ipc.ReadyForTournamentGame.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ipc.ReadyForTournamentGame} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ipc.ReadyForTournamentGame}
+ */
+proto.ipc.ReadyForTournamentGame.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTournamentId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDivision(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRound(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPlayerId(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setGameIndex(value);
+      break;
+    case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setUnready(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ipc.ReadyForTournamentGame.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ipc.ReadyForTournamentGame.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ipc.ReadyForTournamentGame} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.ReadyForTournamentGame.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTournamentId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDivision();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getRound();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getPlayerId();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getGameIndex();
+  if (f !== 0) {
+    writer.writeInt32(
+      5,
+      f
+    );
+  }
+  f = message.getUnready();
+  if (f) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string tournament_id = 1;
+ * @return {string}
+ */
+proto.ipc.ReadyForTournamentGame.prototype.getTournamentId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.ReadyForTournamentGame} returns this
+ */
+proto.ipc.ReadyForTournamentGame.prototype.setTournamentId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string division = 2;
+ * @return {string}
+ */
+proto.ipc.ReadyForTournamentGame.prototype.getDivision = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.ReadyForTournamentGame} returns this
+ */
+proto.ipc.ReadyForTournamentGame.prototype.setDivision = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional int32 round = 3;
+ * @return {number}
+ */
+proto.ipc.ReadyForTournamentGame.prototype.getRound = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.ReadyForTournamentGame} returns this
+ */
+proto.ipc.ReadyForTournamentGame.prototype.setRound = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional string player_id = 4;
+ * @return {string}
+ */
+proto.ipc.ReadyForTournamentGame.prototype.getPlayerId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.ReadyForTournamentGame} returns this
+ */
+proto.ipc.ReadyForTournamentGame.prototype.setPlayerId = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional int32 game_index = 5;
+ * @return {number}
+ */
+proto.ipc.ReadyForTournamentGame.prototype.getGameIndex = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.ReadyForTournamentGame} returns this
+ */
+proto.ipc.ReadyForTournamentGame.prototype.setGameIndex = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional bool unready = 6;
+ * @return {boolean}
+ */
+proto.ipc.ReadyForTournamentGame.prototype.getUnready = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ipc.ReadyForTournamentGame} returns this
+ */
+proto.ipc.ReadyForTournamentGame.prototype.setUnready = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 6, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ipc.TournamentPerson.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.TournamentPerson.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ipc.TournamentPerson} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.TournamentPerson.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    rating: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    suspended: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    checkedIn: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    withdrawalPolicy: jspb.Message.getFieldWithDefault(msg, 5, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ipc.TournamentPerson}
+ */
+proto.ipc.TournamentPerson.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ipc.TournamentPerson;
+  return proto.ipc.TournamentPerson.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ipc.TournamentPerson} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ipc.TournamentPerson}
+ */
+proto.ipc.TournamentPerson.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRating(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuspended(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setCheckedIn(value);
+      break;
+    case 5:
+      var value = /** @type {!proto.ipc.WithdrawalPolicy} */ (reader.readEnum());
+      msg.setWithdrawalPolicy(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ipc.TournamentPerson.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ipc.TournamentPerson.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ipc.TournamentPerson} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.TournamentPerson.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getRating();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getSuspended();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getCheckedIn();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getWithdrawalPolicy();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.ipc.TournamentPerson.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.TournamentPerson} returns this
+ */
+proto.ipc.TournamentPerson.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int32 rating = 2;
+ * @return {number}
+ */
+proto.ipc.TournamentPerson.prototype.getRating = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.TournamentPerson} returns this
+ */
+proto.ipc.TournamentPerson.prototype.setRating = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional bool suspended = 3;
+ * @return {boolean}
+ */
+proto.ipc.TournamentPerson.prototype.getSuspended = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ipc.TournamentPerson} returns this
+ */
+proto.ipc.TournamentPerson.prototype.setSuspended = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * optional bool checked_in = 4;
+ * @return {boolean}
+ */
+proto.ipc.TournamentPerson.prototype.getCheckedIn = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ipc.TournamentPerson} returns this
+ */
+proto.ipc.TournamentPerson.prototype.setCheckedIn = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
+/**
+ * optional WithdrawalPolicy withdrawal_policy = 5;
+ * @return {!proto.ipc.WithdrawalPolicy}
+ */
+proto.ipc.TournamentPerson.prototype.getWithdrawalPolicy = function() {
+  return /** @type {!proto.ipc.WithdrawalPolicy} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {!proto.ipc.WithdrawalPolicy} value
+ * @return {!proto.ipc.TournamentPerson} returns this
+ */
+proto.ipc.TournamentPerson.prototype.setWithdrawalPolicy = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ipc.TournamentPersons.repeatedFields_ = [3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ipc.TournamentPersons.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.TournamentPersons.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ipc.TournamentPersons} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.TournamentPersons.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    personsList: jspb.Message.toObjectList(msg.getPersonsList(),
+    proto.ipc.TournamentPerson.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ipc.TournamentPersons}
+ */
+proto.ipc.TournamentPersons.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ipc.TournamentPersons;
+  return proto.ipc.TournamentPersons.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ipc.TournamentPersons} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ipc.TournamentPersons}
+ */
+proto.ipc.TournamentPersons.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDivision(value);
+      break;
+    case 3:
+      var value = new proto.ipc.TournamentPerson;
+      reader.readMessage(value,proto.ipc.TournamentPerson.deserializeBinaryFromReader);
+      msg.addPersons(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ipc.TournamentPersons.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ipc.TournamentPersons.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ipc.TournamentPersons} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.TournamentPersons.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDivision();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getPersonsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.ipc.TournamentPerson.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.ipc.TournamentPersons.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.TournamentPersons} returns this
+ */
+proto.ipc.TournamentPersons.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string division = 2;
+ * @return {string}
+ */
+proto.ipc.TournamentPersons.prototype.getDivision = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.TournamentPersons} returns this
+ */
+proto.ipc.TournamentPersons.prototype.setDivision = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * repeated TournamentPerson persons = 3;
+ * @return {!Array<!proto.ipc.TournamentPerson>}
+ */
+proto.ipc.TournamentPersons.prototype.getPersonsList = function() {
+  return /** @type{!Array<!proto.ipc.TournamentPerson>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ipc.TournamentPerson, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.ipc.TournamentPerson>} value
+ * @return {!proto.ipc.TournamentPersons} returns this
+*/
+proto.ipc.TournamentPersons.prototype.setPersonsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.ipc.TournamentPerson=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.TournamentPerson}
+ */
+proto.ipc.TournamentPersons.prototype.addPersons = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.ipc.TournamentPerson, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ipc.TournamentPersons} returns this
+ */
+proto.ipc.TournamentPersons.prototype.clearPersonsList = function() {
+  return this.setPersonsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ipc.RoundControl.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.RoundControl.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ipc.RoundControl} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.RoundControl.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pairingMethod: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    firstMethod: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    gamesPerRound: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    round: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    factor: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    initialFontes: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    maxRepeats: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    allowOverMaxRepeats: jspb.Message.getBooleanFieldWithDefault(msg, 8, false),
+    repeatRelativeWeight: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    winDifferenceRelativeWeight: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    consolation: jspb.Message.getBooleanFieldWithDefault(msg, 11, false),
+    scheduledStartTime: (f = msg.getScheduledStartTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    noShowGraceSeconds: jspb.Message.getFieldWithDefault(msg, 13, 0),
+    firstsRelativeWeight: jspb.Message.getFieldWithDefault(msg, 14, 0),
+    contentionPairing: jspb.Message.getBooleanFieldWithDefault(msg, 15, false),
+    pairingLag: jspb.Message.getFieldWithDefault(msg, 16, 0),
+    accelerated: jspb.Message.getBooleanFieldWithDefault(msg, 17, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ipc.RoundControl}
+ */
+proto.ipc.RoundControl.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ipc.RoundControl;
+  return proto.ipc.RoundControl.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ipc.RoundControl} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ipc.RoundControl}
+ */
+proto.ipc.RoundControl.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.ipc.PairingMethod} */ (reader.readEnum());
+      msg.setPairingMethod(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.ipc.FirstMethod} */ (reader.readEnum());
+      msg.setFirstMethod(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setGamesPerRound(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRound(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setFactor(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setInitialFontes(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxRepeats(value);
+      break;
+    case 8:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAllowOverMaxRepeats(value);
+      break;
+    case 9:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRepeatRelativeWeight(value);
+      break;
+    case 10:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setWinDifferenceRelativeWeight(value);
+      break;
+    case 11:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setConsolation(value);
+      break;
+    case 12:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setScheduledStartTime(value);
+      break;
+    case 13:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setNoShowGraceSeconds(value);
+      break;
+    case 14:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setFirstsRelativeWeight(value);
+      break;
+    case 15:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setContentionPairing(value);
+      break;
+    case 16:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPairingLag(value);
+      break;
+    case 17:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAccelerated(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ipc.RoundControl.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ipc.RoundControl.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ipc.RoundControl} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.RoundControl.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPairingMethod();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getFirstMethod();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = message.getGamesPerRound();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getRound();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+  f = message.getFactor();
+  if (f !== 0) {
+    writer.writeInt32(
+      5,
+      f
+    );
+  }
+  f = message.getInitialFontes();
+  if (f !== 0) {
+    writer.writeInt32(
+      6,
+      f
+    );
+  }
+  f = message.getMaxRepeats();
+  if (f !== 0) {
+    writer.writeInt32(
+      7,
+      f
+    );
+  }
+  f = message.getAllowOverMaxRepeats();
+  if (f) {
+    writer.writeBool(
+      8,
+      f
+    );
+  }
+  f = message.getRepeatRelativeWeight();
+  if (f !== 0) {
+    writer.writeInt32(
+      9,
+      f
+    );
+  }
+  f = message.getWinDifferenceRelativeWeight();
+  if (f !== 0) {
+    writer.writeInt32(
+      10,
+      f
+    );
+  }
+  f = message.getConsolation();
+  if (f) {
+    writer.writeBool(
+      11,
+      f
+    );
+  }
+  f = message.getScheduledStartTime();
+  if (f != null) {
+    writer.writeMessage(
+      12,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getNoShowGraceSeconds();
+  if (f !== 0) {
+    writer.writeInt32(
+      13,
+      f
+    );
+  }
+  f = message.getFirstsRelativeWeight();
+  if (f !== 0) {
+    writer.writeInt32(
+      14,
+      f
+    );
+  }
+  f = message.getContentionPairing();
+  if (f) {
+    writer.writeBool(
+      15,
+      f
+    );
+  }
+  f = message.getPairingLag();
+  if (f !== 0) {
+    writer.writeInt32(
+      16,
+      f
+    );
+  }
+  f = message.getAccelerated();
+  if (f) {
+    writer.writeBool(
+      17,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional PairingMethod pairing_method = 1;
+ * @return {!proto.ipc.PairingMethod}
+ */
+proto.ipc.RoundControl.prototype.getPairingMethod = function() {
+  return /** @type {!proto.ipc.PairingMethod} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.ipc.PairingMethod} value
+ * @return {!proto.ipc.RoundControl} returns this
+ */
+proto.ipc.RoundControl.prototype.setPairingMethod = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional FirstMethod first_method = 2;
+ * @return {!proto.ipc.FirstMethod}
+ */
+proto.ipc.RoundControl.prototype.getFirstMethod = function() {
+  return /** @type {!proto.ipc.FirstMethod} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.ipc.FirstMethod} value
+ * @return {!proto.ipc.RoundControl} returns this
+ */
+proto.ipc.RoundControl.prototype.setFirstMethod = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+/**
+ * optional int32 games_per_round = 3;
+ * @return {number}
+ */
+proto.ipc.RoundControl.prototype.getGamesPerRound = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.RoundControl} returns this
+ */
+proto.ipc.RoundControl.prototype.setGamesPerRound = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional int32 round = 4;
+ * @return {number}
+ */
+proto.ipc.RoundControl.prototype.getRound = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.RoundControl} returns this
+ */
+proto.ipc.RoundControl.prototype.setRound = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional int32 factor = 5;
+ * @return {number}
+ */
+proto.ipc.RoundControl.prototype.getFactor = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.RoundControl} returns this
+ */
+proto.ipc.RoundControl.prototype.setFactor = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional int32 initial_fontes = 6;
+ * @return {number}
+ */
+proto.ipc.RoundControl.prototype.getInitialFontes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.RoundControl} returns this
+ */
+proto.ipc.RoundControl.prototype.setInitialFontes = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional int32 max_repeats = 7;
+ * @return {number}
+ */
+proto.ipc.RoundControl.prototype.getMaxRepeats = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.RoundControl} returns this
+ */
+proto.ipc.RoundControl.prototype.setMaxRepeats = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+/**
+ * optional bool allow_over_max_repeats = 8;
+ * @return {boolean}
+ */
+proto.ipc.RoundControl.prototype.getAllowOverMaxRepeats = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ipc.RoundControl} returns this
+ */
+proto.ipc.RoundControl.prototype.setAllowOverMaxRepeats = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 8, value);
+};
+
+
+/**
+ * optional int32 repeat_relative_weight = 9;
+ * @return {number}
+ */
+proto.ipc.RoundControl.prototype.getRepeatRelativeWeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.RoundControl} returns this
+ */
+proto.ipc.RoundControl.prototype.setRepeatRelativeWeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 9, value);
+};
+
+
+/**
+ * optional int32 win_difference_relative_weight = 10;
+ * @return {number}
+ */
+proto.ipc.RoundControl.prototype.getWinDifferenceRelativeWeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.RoundControl} returns this
+ */
+proto.ipc.RoundControl.prototype.setWinDifferenceRelativeWeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 10, value);
+};
+
+
+/**
+ * optional bool consolation = 11;
+ * @return {boolean}
+ */
+proto.ipc.RoundControl.prototype.getConsolation = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 11, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ipc.RoundControl} returns this
+ */
+proto.ipc.RoundControl.prototype.setConsolation = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 11, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp scheduled_start_time = 12;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.ipc.RoundControl.prototype.getScheduledStartTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 12));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.ipc.RoundControl} returns this
+*/
+proto.ipc.RoundControl.prototype.setScheduledStartTime = function(value) {
+  return jspb.Message.setWrapperField(this, 12, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ipc.RoundControl} returns this
+ */
+proto.ipc.RoundControl.prototype.clearScheduledStartTime = function() {
+  return this.setScheduledStartTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ipc.RoundControl.prototype.hasScheduledStartTime = function() {
+  return jspb.Message.getField(this, 12) != null;
+};
+
+
+/**
+ * optional int32 no_show_grace_seconds = 13;
+ * @return {number}
+ */
+proto.ipc.RoundControl.prototype.getNoShowGraceSeconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 13, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.RoundControl} returns this
+ */
+proto.ipc.RoundControl.prototype.setNoShowGraceSeconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 13, value);
+};
+
+
+/**
+ * optional int32 firsts_relative_weight = 14;
+ * @return {number}
+ */
+proto.ipc.RoundControl.prototype.getFirstsRelativeWeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 14, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.RoundControl} returns this
+ */
+proto.ipc.RoundControl.prototype.setFirstsRelativeWeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 14, value);
+};
+
+
+/**
+ * optional bool contention_pairing = 15;
+ * @return {boolean}
+ */
+proto.ipc.RoundControl.prototype.getContentionPairing = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 15, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ipc.RoundControl} returns this
+ */
+proto.ipc.RoundControl.prototype.setContentionPairing = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 15, value);
+};
+
+
+/**
+ * optional int32 pairing_lag = 16;
+ * @return {number}
+ */
+proto.ipc.RoundControl.prototype.getPairingLag = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 16, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.RoundControl} returns this
+ */
+proto.ipc.RoundControl.prototype.setPairingLag = function(value) {
+  return jspb.Message.setProto3IntField(this, 16, value);
+};
+
+
+/**
+ * optional bool accelerated = 17;
+ * @return {boolean}
+ */
+proto.ipc.RoundControl.prototype.getAccelerated = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 17, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ipc.RoundControl} returns this
+ */
+proto.ipc.RoundControl.prototype.setAccelerated = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 17, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ipc.DivisionControls.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.DivisionControls.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ipc.DivisionControls} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.DivisionControls.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    gameRequest: (f = msg.getGameRequest()) && api_proto_ipc_omgwords_pb.GameRequest.toObject(includeInstance, f),
+    suspendedResult: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    suspendedSpread: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    autoStart: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
+    spreadCap: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    gibsonize: jspb.Message.getBooleanFieldWithDefault(msg, 8, false),
+    gibsonSpread: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    minimumPlacement: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    maximumByePlacement: jspb.Message.getFieldWithDefault(msg, 11, 0),
+    openRegistration: jspb.Message.getBooleanFieldWithDefault(msg, 12, false),
+    ratingFloor: jspb.Message.getFieldWithDefault(msg, 13, 0),
+    ratingCeiling: jspb.Message.getFieldWithDefault(msg, 14, 0),
+    maxPlayers: jspb.Message.getFieldWithDefault(msg, 15, 0),
+    registrationDeadline: (f = msg.getRegistrationDeadline()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    registrationRequiresApproval: jspb.Message.getBooleanFieldWithDefault(msg, 17, false),
+    checkInDeadline: (f = msg.getCheckInDeadline()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    checkInWindowSeconds: jspb.Message.getFieldWithDefault(msg, 19, 0),
+    removeUncheckedIn: jspb.Message.getBooleanFieldWithDefault(msg, 20, false),
+    rateAsPeriod: jspb.Message.getBooleanFieldWithDefault(msg, 21, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ipc.DivisionControls}
+ */
+proto.ipc.DivisionControls.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ipc.DivisionControls;
+  return proto.ipc.DivisionControls.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ipc.DivisionControls} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ipc.DivisionControls}
+ */
+proto.ipc.DivisionControls.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDivision(value);
+      break;
+    case 3:
+      var value = new api_proto_ipc_omgwords_pb.GameRequest;
+      reader.readMessage(value,api_proto_ipc_omgwords_pb.GameRequest.deserializeBinaryFromReader);
+      msg.setGameRequest(value);
+      break;
+    case 4:
+      var value = /** @type {!proto.ipc.TournamentGameResult} */ (reader.readEnum());
+      msg.setSuspendedResult(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setSuspendedSpread(value);
+      break;
+    case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAutoStart(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setSpreadCap(value);
+      break;
+    case 8:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setGibsonize(value);
+      break;
+    case 9:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setGibsonSpread(value);
+      break;
+    case 10:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMinimumPlacement(value);
+      break;
+    case 11:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaximumByePlacement(value);
+      break;
+    case 12:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setOpenRegistration(value);
+      break;
+    case 13:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRatingFloor(value);
+      break;
+    case 14:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRatingCeiling(value);
+      break;
+    case 15:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxPlayers(value);
+      break;
+    case 16:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setRegistrationDeadline(value);
+      break;
+    case 17:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRegistrationRequiresApproval(value);
+      break;
+    case 18:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCheckInDeadline(value);
+      break;
+    case 19:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setCheckInWindowSeconds(value);
+      break;
+    case 20:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRemoveUncheckedIn(value);
+      break;
+    case 21:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRateAsPeriod(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ipc.DivisionControls.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ipc.DivisionControls.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ipc.DivisionControls} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.DivisionControls.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDivision();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getGameRequest();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      api_proto_ipc_omgwords_pb.GameRequest.serializeBinaryToWriter
+    );
+  }
+  f = message.getSuspendedResult();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      4,
+      f
+    );
+  }
+  f = message.getSuspendedSpread();
+  if (f !== 0) {
+    writer.writeInt32(
+      5,
+      f
+    );
+  }
+  f = message.getAutoStart();
+  if (f) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
+  f = message.getSpreadCap();
+  if (f !== 0) {
+    writer.writeInt32(
+      7,
+      f
+    );
+  }
+  f = message.getGibsonize();
+  if (f) {
+    writer.writeBool(
+      8,
+      f
+    );
+  }
+  f = message.getGibsonSpread();
+  if (f !== 0) {
+    writer.writeInt32(
+      9,
+      f
+    );
+  }
+  f = message.getMinimumPlacement();
+  if (f !== 0) {
+    writer.writeInt32(
+      10,
+      f
+    );
+  }
+  f = message.getMaximumByePlacement();
+  if (f !== 0) {
+    writer.writeInt32(
+      11,
+      f
+    );
+  }
+  f = message.getOpenRegistration();
+  if (f) {
+    writer.writeBool(
+      12,
+      f
+    );
+  }
+  f = message.getRatingFloor();
+  if (f !== 0) {
+    writer.writeInt32(
+      13,
+      f
+    );
+  }
+  f = message.getRatingCeiling();
+  if (f !== 0) {
+    writer.writeInt32(
+      14,
+      f
+    );
+  }
+  f = message.getMaxPlayers();
+  if (f !== 0) {
+    writer.writeInt32(
+      15,
+      f
+    );
+  }
+  f = message.getRegistrationDeadline();
+  if (f != null) {
+    writer.writeMessage(
+      16,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getRegistrationRequiresApproval();
+  if (f) {
+    writer.writeBool(
+      17,
+      f
+    );
+  }
+  f = message.getCheckInDeadline();
+  if (f != null) {
+    writer.writeMessage(
+      18,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getCheckInWindowSeconds();
+  if (f !== 0) {
+    writer.writeInt32(
+      19,
+      f
+    );
+  }
+  f = message.getRemoveUncheckedIn();
+  if (f) {
+    writer.writeBool(
+      20,
+      f
+    );
+  }
+  f = message.getRateAsPeriod();
+  if (f) {
+    writer.writeBool(
+      21,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.ipc.DivisionControls.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.DivisionControls} returns this
+ */
+proto.ipc.DivisionControls.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string division = 2;
+ * @return {string}
+ */
+proto.ipc.DivisionControls.prototype.getDivision = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.DivisionControls} returns this
+ */
+proto.ipc.DivisionControls.prototype.setDivision = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional GameRequest game_request = 3;
+ * @return {?proto.ipc.GameRequest}
+ */
+proto.ipc.DivisionControls.prototype.getGameRequest = function() {
+  return /** @type{?proto.ipc.GameRequest} */ (
+    jspb.Message.getWrapperField(this, api_proto_ipc_omgwords_pb.GameRequest, 3));
+};
+
+
+/**
+ * @param {?proto.ipc.GameRequest|undefined} value
+ * @return {!proto.ipc.DivisionControls} returns this
+*/
+proto.ipc.DivisionControls.prototype.setGameRequest = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ipc.DivisionControls} returns this
+ */
+proto.ipc.DivisionControls.prototype.clearGameRequest = function() {
+  return this.setGameRequest(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ipc.DivisionControls.prototype.hasGameRequest = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional TournamentGameResult suspended_result = 4;
+ * @return {!proto.ipc.TournamentGameResult}
+ */
+proto.ipc.DivisionControls.prototype.getSuspendedResult = function() {
+  return /** @type {!proto.ipc.TournamentGameResult} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {!proto.ipc.TournamentGameResult} value
+ * @return {!proto.ipc.DivisionControls} returns this
+ */
+proto.ipc.DivisionControls.prototype.setSuspendedResult = function(value) {
+  return jspb.Message.setProto3EnumField(this, 4, value);
+};
+
+
+/**
+ * optional int32 suspended_spread = 5;
+ * @return {number}
+ */
+proto.ipc.DivisionControls.prototype.getSuspendedSpread = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.DivisionControls} returns this
+ */
+proto.ipc.DivisionControls.prototype.setSuspendedSpread = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional bool auto_start = 6;
+ * @return {boolean}
+ */
+proto.ipc.DivisionControls.prototype.getAutoStart = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ipc.DivisionControls} returns this
+ */
+proto.ipc.DivisionControls.prototype.setAutoStart = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 6, value);
+};
+
+
+/**
+ * optional int32 spread_cap = 7;
+ * @return {number}
+ */
+proto.ipc.DivisionControls.prototype.getSpreadCap = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.DivisionControls} returns this
+ */
+proto.ipc.DivisionControls.prototype.setSpreadCap = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+/**
+ * optional bool gibsonize = 8;
+ * @return {boolean}
+ */
+proto.ipc.DivisionControls.prototype.getGibsonize = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ipc.DivisionControls} returns this
+ */
+proto.ipc.DivisionControls.prototype.setGibsonize = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 8, value);
+};
+
+
+/**
+ * optional int32 gibson_spread = 9;
+ * @return {number}
+ */
+proto.ipc.DivisionControls.prototype.getGibsonSpread = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.DivisionControls} returns this
+ */
+proto.ipc.DivisionControls.prototype.setGibsonSpread = function(value) {
+  return jspb.Message.setProto3IntField(this, 9, value);
+};
+
+
+/**
+ * optional int32 minimum_placement = 10;
+ * @return {number}
+ */
+proto.ipc.DivisionControls.prototype.getMinimumPlacement = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.DivisionControls} returns this
+ */
+proto.ipc.DivisionControls.prototype.setMinimumPlacement = function(value) {
+  return jspb.Message.setProto3IntField(this, 10, value);
+};
+
+
+/**
+ * optional int32 maximum_bye_placement = 11;
+ * @return {number}
+ */
+proto.ipc.DivisionControls.prototype.getMaximumByePlacement = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.DivisionControls} returns this
+ */
+proto.ipc.DivisionControls.prototype.setMaximumByePlacement = function(value) {
+  return jspb.Message.setProto3IntField(this, 11, value);
+};
+
+
+/**
+ * optional bool open_registration = 12;
+ * @return {boolean}
+ */
+proto.ipc.DivisionControls.prototype.getOpenRegistration = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 12, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ipc.DivisionControls} returns this
+ */
+proto.ipc.DivisionControls.prototype.setOpenRegistration = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 12, value);
+};
+
+
+/**
+ * optional int32 rating_floor = 13;
+ * @return {number}
+ */
+proto.ipc.DivisionControls.prototype.getRatingFloor = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 13, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.DivisionControls} returns this
+ */
+proto.ipc.DivisionControls.prototype.setRatingFloor = function(value) {
+  return jspb.Message.setProto3IntField(this, 13, value);
+};
+
+
+/**
+ * optional int32 rating_ceiling = 14;
+ * @return {number}
+ */
+proto.ipc.DivisionControls.prototype.getRatingCeiling = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 14, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.DivisionControls} returns this
+ */
+proto.ipc.DivisionControls.prototype.setRatingCeiling = function(value) {
+  return jspb.Message.setProto3IntField(this, 14, value);
+};
+
+
+/**
+ * optional int32 max_players = 15;
+ * @return {number}
+ */
+proto.ipc.DivisionControls.prototype.getMaxPlayers = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 15, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.DivisionControls} returns this
+ */
+proto.ipc.DivisionControls.prototype.setMaxPlayers = function(value) {
+  return jspb.Message.setProto3IntField(this, 15, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp registration_deadline = 16;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.ipc.DivisionControls.prototype.getRegistrationDeadline = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 16));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.ipc.DivisionControls} returns this
+*/
+proto.ipc.DivisionControls.prototype.setRegistrationDeadline = function(value) {
+  return jspb.Message.setWrapperField(this, 16, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ipc.DivisionControls} returns this
+ */
+proto.ipc.DivisionControls.prototype.clearRegistrationDeadline = function() {
+  return this.setRegistrationDeadline(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ipc.DivisionControls.prototype.hasRegistrationDeadline = function() {
+  return jspb.Message.getField(this, 16) != null;
+};
+
+
+/**
+ * optional bool registration_requires_approval = 17;
+ * @return {boolean}
+ */
+proto.ipc.DivisionControls.prototype.getRegistrationRequiresApproval = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 17, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ipc.DivisionControls} returns this
+ */
+proto.ipc.DivisionControls.prototype.setRegistrationRequiresApproval = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 17, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp check_in_deadline = 18;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.ipc.DivisionControls.prototype.getCheckInDeadline = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 18));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.ipc.DivisionControls} returns this
+*/
+proto.ipc.DivisionControls.prototype.setCheckInDeadline = function(value) {
+  return jspb.Message.setWrapperField(this, 18, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ipc.DivisionControls} returns this
+ */
+proto.ipc.DivisionControls.prototype.clearCheckInDeadline = function() {
+  return this.setCheckInDeadline(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ipc.DivisionControls.prototype.hasCheckInDeadline = function() {
+  return jspb.Message.getField(this, 18) != null;
+};
+
+
+/**
+ * optional int32 check_in_window_seconds = 19;
+ * @return {number}
+ */
+proto.ipc.DivisionControls.prototype.getCheckInWindowSeconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 19, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.DivisionControls} returns this
+ */
+proto.ipc.DivisionControls.prototype.setCheckInWindowSeconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 19, value);
+};
+
+
+/**
+ * optional bool remove_unchecked_in = 20;
+ * @return {boolean}
+ */
+proto.ipc.DivisionControls.prototype.getRemoveUncheckedIn = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 20, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ipc.DivisionControls} returns this
+ */
+proto.ipc.DivisionControls.prototype.setRemoveUncheckedIn = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 20, value);
+};
+
+
+/**
+ * optional bool rate_as_period = 21;
+ * @return {boolean}
+ */
+proto.ipc.DivisionControls.prototype.getRateAsPeriod = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 21, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ipc.DivisionControls} returns this
+ */
+proto.ipc.DivisionControls.prototype.setRateAsPeriod = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 21, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ipc.TournamentGame.repeatedFields_ = [1,2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ipc.TournamentGame.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.TournamentGame.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ipc.TournamentGame} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.TournamentGame.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    scoresList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    resultsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+    gameEndReason: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    id: jspb.Message.getFieldWithDefault(msg, 4, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ipc.TournamentGame}
+ */
+proto.ipc.TournamentGame.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ipc.TournamentGame;
+  return proto.ipc.TournamentGame.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ipc.TournamentGame} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ipc.TournamentGame}
+ */
+proto.ipc.TournamentGame.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var values = /** @type {!Array<number>} */ (reader.isDelimited() ? reader.readPackedInt32() : [reader.readInt32()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addScores(values[i]);
+      }
+      break;
+    case 2:
+      var values = /** @type {!Array<!proto.ipc.TournamentGameResult>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addResults(values[i]);
+      }
+      break;
+    case 3:
+      var value = /** @type {!proto.ipc.GameEndReason} */ (reader.readEnum());
+      msg.setGameEndReason(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ipc.TournamentGame.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ipc.TournamentGame.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ipc.TournamentGame} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.TournamentGame.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getScoresList();
+  if (f.length > 0) {
+    writer.writePackedInt32(
+      1,
+      f
+    );
+  }
+  f = message.getResultsList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      2,
+      f
+    );
+  }
+  f = message.getGameEndReason();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
+      f
+    );
+  }
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated int32 scores = 1;
+ * @return {!Array<number>}
+ */
+proto.ipc.TournamentGame.prototype.getScoresList = function() {
+  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<number>} value
+ * @return {!proto.ipc.TournamentGame} returns this
+ */
+proto.ipc.TournamentGame.prototype.setScoresList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {number} value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.TournamentGame} returns this
+ */
+proto.ipc.TournamentGame.prototype.addScores = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ipc.TournamentGame} returns this
+ */
+proto.ipc.TournamentGame.prototype.clearScoresList = function() {
+  return this.setScoresList([]);
+};
+
+
+/**
+ * repeated TournamentGameResult results = 2;
+ * @return {!Array<!proto.ipc.TournamentGameResult>}
+ */
+proto.ipc.TournamentGame.prototype.getResultsList = function() {
+  return /** @type {!Array<!proto.ipc.TournamentGameResult>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.ipc.TournamentGameResult>} value
+ * @return {!proto.ipc.TournamentGame} returns this
+ */
+proto.ipc.TournamentGame.prototype.setResultsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {!proto.ipc.TournamentGameResult} value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.TournamentGame} returns this
+ */
+proto.ipc.TournamentGame.prototype.addResults = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ipc.TournamentGame} returns this
+ */
+proto.ipc.TournamentGame.prototype.clearResultsList = function() {
+  return this.setResultsList([]);
+};
+
+
+/**
+ * optional GameEndReason game_end_reason = 3;
+ * @return {!proto.ipc.GameEndReason}
+ */
+proto.ipc.TournamentGame.prototype.getGameEndReason = function() {
+  return /** @type {!proto.ipc.GameEndReason} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {!proto.ipc.GameEndReason} value
+ * @return {!proto.ipc.TournamentGame} returns this
+ */
+proto.ipc.TournamentGame.prototype.setGameEndReason = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
+};
+
+
+/**
+ * optional string id = 4;
+ * @return {string}
+ */
+proto.ipc.TournamentGame.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.TournamentGame} returns this
+ */
+proto.ipc.TournamentGame.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ipc.Pairing.repeatedFields_ = [1,3,4,5];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ipc.Pairing.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.Pairing.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ipc.Pairing} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.Pairing.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    playersList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    round: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    gamesList: jspb.Message.toObjectList(msg.getGamesList(),
+    proto.ipc.TournamentGame.toObject, includeInstance),
+    outcomesList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
+    readyStatesList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ipc.Pairing}
+ */
+proto.ipc.Pairing.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ipc.Pairing;
+  return proto.ipc.Pairing.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ipc.Pairing} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ipc.Pairing}
+ */
+proto.ipc.Pairing.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var values = /** @type {!Array<number>} */ (reader.isDelimited() ? reader.readPackedInt32() : [reader.readInt32()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addPlayers(values[i]);
+      }
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRound(value);
+      break;
+    case 3:
+      var value = new proto.ipc.TournamentGame;
+      reader.readMessage(value,proto.ipc.TournamentGame.deserializeBinaryFromReader);
+      msg.addGames(value);
+      break;
+    case 4:
+      var values = /** @type {!Array<!proto.ipc.TournamentGameResult>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addOutcomes(values[i]);
+      }
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addReadyStates(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ipc.Pairing.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ipc.Pairing.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ipc.Pairing} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.Pairing.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPlayersList();
+  if (f.length > 0) {
+    writer.writePackedInt32(
+      1,
+      f
+    );
+  }
+  f = message.getRound();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getGamesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.ipc.TournamentGame.serializeBinaryToWriter
+    );
+  }
+  f = message.getOutcomesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      4,
+      f
+    );
+  }
+  f = message.getReadyStatesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated int32 players = 1;
+ * @return {!Array<number>}
+ */
+proto.ipc.Pairing.prototype.getPlayersList = function() {
+  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<number>} value
+ * @return {!proto.ipc.Pairing} returns this
+ */
+proto.ipc.Pairing.prototype.setPlayersList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {number} value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.Pairing} returns this
+ */
+proto.ipc.Pairing.prototype.addPlayers = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ipc.Pairing} returns this
+ */
+proto.ipc.Pairing.prototype.clearPlayersList = function() {
+  return this.setPlayersList([]);
+};
+
+
+/**
+ * optional int32 round = 2;
+ * @return {number}
+ */
+proto.ipc.Pairing.prototype.getRound = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.Pairing} returns this
+ */
+proto.ipc.Pairing.prototype.setRound = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * repeated TournamentGame games = 3;
+ * @return {!Array<!proto.ipc.TournamentGame>}
+ */
+proto.ipc.Pairing.prototype.getGamesList = function() {
+  return /** @type{!Array<!proto.ipc.TournamentGame>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ipc.TournamentGame, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.ipc.TournamentGame>} value
+ * @return {!proto.ipc.Pairing} returns this
+*/
+proto.ipc.Pairing.prototype.setGamesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.ipc.TournamentGame=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.TournamentGame}
+ */
+proto.ipc.Pairing.prototype.addGames = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.ipc.TournamentGame, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ipc.Pairing} returns this
+ */
+proto.ipc.Pairing.prototype.clearGamesList = function() {
+  return this.setGamesList([]);
+};
+
+
+/**
+ * repeated TournamentGameResult outcomes = 4;
+ * @return {!Array<!proto.ipc.TournamentGameResult>}
+ */
+proto.ipc.Pairing.prototype.getOutcomesList = function() {
+  return /** @type {!Array<!proto.ipc.TournamentGameResult>} */ (jspb.Message.getRepeatedField(this, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.ipc.TournamentGameResult>} value
+ * @return {!proto.ipc.Pairing} returns this
+ */
+proto.ipc.Pairing.prototype.setOutcomesList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
+};
+
+
+/**
+ * @param {!proto.ipc.TournamentGameResult} value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.Pairing} returns this
+ */
+proto.ipc.Pairing.prototype.addOutcomes = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ipc.Pairing} returns this
+ */
+proto.ipc.Pairing.prototype.clearOutcomesList = function() {
+  return this.setOutcomesList([]);
+};
+
+
+/**
+ * repeated string ready_states = 5;
+ * @return {!Array<string>}
+ */
+proto.ipc.Pairing.prototype.getReadyStatesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.ipc.Pairing} returns this
+ */
+proto.ipc.Pairing.prototype.setReadyStatesList = function(value) {
+  return jspb.Message.setField(this, 5, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.Pairing} returns this
+ */
+proto.ipc.Pairing.prototype.addReadyStates = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ipc.Pairing} returns this
+ */
+proto.ipc.Pairing.prototype.clearReadyStatesList = function() {
+  return this.setReadyStatesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ipc.PlayerStanding.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.PlayerStanding.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ipc.PlayerStanding} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.PlayerStanding.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    playerId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    wins: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    losses: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    draws: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    spread: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    gibsonized: jspb.Message.getBooleanFieldWithDefault(msg, 6, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ipc.PlayerStanding}
+ */
+proto.ipc.PlayerStanding.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ipc.PlayerStanding;
+  return proto.ipc.PlayerStanding.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ipc.PlayerStanding} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ipc.PlayerStanding}
+ */
+proto.ipc.PlayerStanding.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPlayerId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setWins(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setLosses(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setDraws(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setSpread(value);
+      break;
+    case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setGibsonized(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ipc.PlayerStanding.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ipc.PlayerStanding.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ipc.PlayerStanding} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.PlayerStanding.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPlayerId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getWins();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getLosses();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getDraws();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+  f = message.getSpread();
+  if (f !== 0) {
+    writer.writeInt32(
+      5,
+      f
+    );
+  }
+  f = message.getGibsonized();
+  if (f) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string player_id = 1;
+ * @return {string}
+ */
+proto.ipc.PlayerStanding.prototype.getPlayerId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.PlayerStanding} returns this
+ */
+proto.ipc.PlayerStanding.prototype.setPlayerId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int32 wins = 2;
+ * @return {number}
+ */
+proto.ipc.PlayerStanding.prototype.getWins = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.PlayerStanding} returns this
+ */
+proto.ipc.PlayerStanding.prototype.setWins = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int32 losses = 3;
+ * @return {number}
+ */
+proto.ipc.PlayerStanding.prototype.getLosses = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.PlayerStanding} returns this
+ */
+proto.ipc.PlayerStanding.prototype.setLosses = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional int32 draws = 4;
+ * @return {number}
+ */
+proto.ipc.PlayerStanding.prototype.getDraws = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.PlayerStanding} returns this
+ */
+proto.ipc.PlayerStanding.prototype.setDraws = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional int32 spread = 5;
+ * @return {number}
+ */
+proto.ipc.PlayerStanding.prototype.getSpread = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.PlayerStanding} returns this
+ */
+proto.ipc.PlayerStanding.prototype.setSpread = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional bool gibsonized = 6;
+ * @return {boolean}
+ */
+proto.ipc.PlayerStanding.prototype.getGibsonized = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ipc.PlayerStanding} returns this
+ */
+proto.ipc.PlayerStanding.prototype.setGibsonized = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 6, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ipc.RoundStandings.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ipc.RoundStandings.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.RoundStandings.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ipc.RoundStandings} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.RoundStandings.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    standingsList: jspb.Message.toObjectList(msg.getStandingsList(),
+    proto.ipc.PlayerStanding.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ipc.RoundStandings}
+ */
+proto.ipc.RoundStandings.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ipc.RoundStandings;
+  return proto.ipc.RoundStandings.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ipc.RoundStandings} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ipc.RoundStandings}
+ */
+proto.ipc.RoundStandings.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.ipc.PlayerStanding;
+      reader.readMessage(value,proto.ipc.PlayerStanding.deserializeBinaryFromReader);
+      msg.addStandings(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ipc.RoundStandings.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ipc.RoundStandings.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ipc.RoundStandings} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.RoundStandings.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStandingsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.ipc.PlayerStanding.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated PlayerStanding standings = 1;
+ * @return {!Array<!proto.ipc.PlayerStanding>}
+ */
+proto.ipc.RoundStandings.prototype.getStandingsList = function() {
+  return /** @type{!Array<!proto.ipc.PlayerStanding>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ipc.PlayerStanding, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.ipc.PlayerStanding>} value
+ * @return {!proto.ipc.RoundStandings} returns this
+*/
+proto.ipc.RoundStandings.prototype.setStandingsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.ipc.PlayerStanding=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.PlayerStanding}
+ */
+proto.ipc.RoundStandings.prototype.addStandings = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ipc.PlayerStanding, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ipc.RoundStandings} returns this
+ */
+proto.ipc.RoundStandings.prototype.clearStandingsList = function() {
+  return this.setStandingsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ipc.DivisionPairingsResponse.repeatedFields_ = [3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ipc.DivisionPairingsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.DivisionPairingsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ipc.DivisionPairingsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.DivisionPairingsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    divisionPairingsList: jspb.Message.toObjectList(msg.getDivisionPairingsList(),
+    proto.ipc.Pairing.toObject, includeInstance),
+    divisionStandingsMap: (f = msg.getDivisionStandingsMap()) ? f.toObject(includeInstance, proto.ipc.RoundStandings.toObject) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ipc.DivisionPairingsResponse}
+ */
+proto.ipc.DivisionPairingsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ipc.DivisionPairingsResponse;
+  return proto.ipc.DivisionPairingsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ipc.DivisionPairingsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ipc.DivisionPairingsResponse}
+ */
+proto.ipc.DivisionPairingsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDivision(value);
+      break;
+    case 3:
+      var value = new proto.ipc.Pairing;
+      reader.readMessage(value,proto.ipc.Pairing.deserializeBinaryFromReader);
+      msg.addDivisionPairings(value);
+      break;
+    case 4:
+      var value = msg.getDivisionStandingsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readInt32, jspb.BinaryReader.prototype.readMessage, proto.ipc.RoundStandings.deserializeBinaryFromReader, 0, new proto.ipc.RoundStandings());
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ipc.DivisionPairingsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ipc.DivisionPairingsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ipc.DivisionPairingsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.DivisionPairingsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDivision();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getDivisionPairingsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.ipc.Pairing.serializeBinaryToWriter
+    );
+  }
+  f = message.getDivisionStandingsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(4, writer, jspb.BinaryWriter.prototype.writeInt32, jspb.BinaryWriter.prototype.writeMessage, proto.ipc.RoundStandings.serializeBinaryToWriter);
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.ipc.DivisionPairingsResponse.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.DivisionPairingsResponse} returns this
+ */
+proto.ipc.DivisionPairingsResponse.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string division = 2;
+ * @return {string}
+ */
+proto.ipc.DivisionPairingsResponse.prototype.getDivision = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.DivisionPairingsResponse} returns this
+ */
+proto.ipc.DivisionPairingsResponse.prototype.setDivision = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * repeated Pairing division_pairings = 3;
+ * @return {!Array<!proto.ipc.Pairing>}
+ */
+proto.ipc.DivisionPairingsResponse.prototype.getDivisionPairingsList = function() {
+  return /** @type{!Array<!proto.ipc.Pairing>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ipc.Pairing, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.ipc.Pairing>} value
+ * @return {!proto.ipc.DivisionPairingsResponse} returns this
+*/
+proto.ipc.DivisionPairingsResponse.prototype.setDivisionPairingsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.ipc.Pairing=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.Pairing}
+ */
+proto.ipc.DivisionPairingsResponse.prototype.addDivisionPairings = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.ipc.Pairing, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ipc.DivisionPairingsResponse} returns this
+ */
+proto.ipc.DivisionPairingsResponse.prototype.clearDivisionPairingsList = function() {
+  return this.setDivisionPairingsList([]);
+};
+
+
+/**
+ * map<int32, RoundStandings> division_standings = 4;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<number,!proto.ipc.RoundStandings>}
+ */
+proto.ipc.DivisionPairingsResponse.prototype.getDivisionStandingsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<number,!proto.ipc.RoundStandings>} */ (
+      jspb.Message.getMapField(this, 4, opt_noLazyCreate,
+      proto.ipc.RoundStandings));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.ipc.DivisionPairingsResponse} returns this
+ */
+proto.ipc.DivisionPairingsResponse.prototype.clearDivisionStandingsMap = function() {
+  this.getDivisionStandingsMap().clear();
+  return this;};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ipc.DivisionPairingsDeletedResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.DivisionPairingsDeletedResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ipc.DivisionPairingsDeletedResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.DivisionPairingsDeletedResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    round: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ipc.DivisionPairingsDeletedResponse}
+ */
+proto.ipc.DivisionPairingsDeletedResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ipc.DivisionPairingsDeletedResponse;
+  return proto.ipc.DivisionPairingsDeletedResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ipc.DivisionPairingsDeletedResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ipc.DivisionPairingsDeletedResponse}
+ */
+proto.ipc.DivisionPairingsDeletedResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDivision(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRound(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ipc.DivisionPairingsDeletedResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ipc.DivisionPairingsDeletedResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ipc.DivisionPairingsDeletedResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.DivisionPairingsDeletedResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDivision();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getRound();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.ipc.DivisionPairingsDeletedResponse.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.DivisionPairingsDeletedResponse} returns this
+ */
+proto.ipc.DivisionPairingsDeletedResponse.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string division = 2;
+ * @return {string}
+ */
+proto.ipc.DivisionPairingsDeletedResponse.prototype.getDivision = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.DivisionPairingsDeletedResponse} returns this
+ */
+proto.ipc.DivisionPairingsDeletedResponse.prototype.setDivision = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional int32 round = 3;
+ * @return {number}
+ */
+proto.ipc.DivisionPairingsDeletedResponse.prototype.getRound = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.DivisionPairingsDeletedResponse} returns this
+ */
+proto.ipc.DivisionPairingsDeletedResponse.prototype.setRound = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ipc.PlayersAddedOrRemovedResponse.repeatedFields_ = [4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ipc.PlayersAddedOrRemovedResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.PlayersAddedOrRemovedResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ipc.PlayersAddedOrRemovedResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.PlayersAddedOrRemovedResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    players: (f = msg.getPlayers()) && proto.ipc.TournamentPersons.toObject(includeInstance, f),
+    divisionPairingsList: jspb.Message.toObjectList(msg.getDivisionPairingsList(),
+    proto.ipc.Pairing.toObject, includeInstance),
+    divisionStandingsMap: (f = msg.getDivisionStandingsMap()) ? f.toObject(includeInstance, proto.ipc.RoundStandings.toObject) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ipc.PlayersAddedOrRemovedResponse}
+ */
+proto.ipc.PlayersAddedOrRemovedResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ipc.PlayersAddedOrRemovedResponse;
+  return proto.ipc.PlayersAddedOrRemovedResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ipc.PlayersAddedOrRemovedResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ipc.PlayersAddedOrRemovedResponse}
+ */
+proto.ipc.PlayersAddedOrRemovedResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDivision(value);
+      break;
+    case 3:
+      var value = new proto.ipc.TournamentPersons;
+      reader.readMessage(value,proto.ipc.TournamentPersons.deserializeBinaryFromReader);
+      msg.setPlayers(value);
+      break;
+    case 4:
+      var value = new proto.ipc.Pairing;
+      reader.readMessage(value,proto.ipc.Pairing.deserializeBinaryFromReader);
+      msg.addDivisionPairings(value);
+      break;
+    case 5:
+      var value = msg.getDivisionStandingsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readInt32, jspb.BinaryReader.prototype.readMessage, proto.ipc.RoundStandings.deserializeBinaryFromReader, 0, new proto.ipc.RoundStandings());
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ipc.PlayersAddedOrRemovedResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ipc.PlayersAddedOrRemovedResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ipc.PlayersAddedOrRemovedResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.PlayersAddedOrRemovedResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDivision();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getPlayers();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.ipc.TournamentPersons.serializeBinaryToWriter
+    );
+  }
+  f = message.getDivisionPairingsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.ipc.Pairing.serializeBinaryToWriter
+    );
+  }
+  f = message.getDivisionStandingsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(5, writer, jspb.BinaryWriter.prototype.writeInt32, jspb.BinaryWriter.prototype.writeMessage, proto.ipc.RoundStandings.serializeBinaryToWriter);
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.ipc.PlayersAddedOrRemovedResponse.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.PlayersAddedOrRemovedResponse} returns this
+ */
+proto.ipc.PlayersAddedOrRemovedResponse.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string division = 2;
+ * @return {string}
+ */
+proto.ipc.PlayersAddedOrRemovedResponse.prototype.getDivision = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.PlayersAddedOrRemovedResponse} returns this
+ */
+proto.ipc.PlayersAddedOrRemovedResponse.prototype.setDivision = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional TournamentPersons players = 3;
+ * @return {?proto.ipc.TournamentPersons}
+ */
+proto.ipc.PlayersAddedOrRemovedResponse.prototype.getPlayers = function() {
+  return /** @type{?proto.ipc.TournamentPersons} */ (
+    jspb.Message.getWrapperField(this, proto.ipc.TournamentPersons, 3));
+};
+
+
+/**
+ * @param {?proto.ipc.TournamentPersons|undefined} value
+ * @return {!proto.ipc.PlayersAddedOrRemovedResponse} returns this
+*/
+proto.ipc.PlayersAddedOrRemovedResponse.prototype.setPlayers = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ipc.PlayersAddedOrRemovedResponse} returns this
+ */
+proto.ipc.PlayersAddedOrRemovedResponse.prototype.clearPlayers = function() {
+  return this.setPlayers(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ipc.PlayersAddedOrRemovedResponse.prototype.hasPlayers = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * repeated Pairing division_pairings = 4;
+ * @return {!Array<!proto.ipc.Pairing>}
+ */
+proto.ipc.PlayersAddedOrRemovedResponse.prototype.getDivisionPairingsList = function() {
+  return /** @type{!Array<!proto.ipc.Pairing>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ipc.Pairing, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.ipc.Pairing>} value
+ * @return {!proto.ipc.PlayersAddedOrRemovedResponse} returns this
+*/
+proto.ipc.PlayersAddedOrRemovedResponse.prototype.setDivisionPairingsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.ipc.Pairing=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.Pairing}
+ */
+proto.ipc.PlayersAddedOrRemovedResponse.prototype.addDivisionPairings = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.ipc.Pairing, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ipc.PlayersAddedOrRemovedResponse} returns this
+ */
+proto.ipc.PlayersAddedOrRemovedResponse.prototype.clearDivisionPairingsList = function() {
+  return this.setDivisionPairingsList([]);
+};
+
+
+/**
+ * map<int32, RoundStandings> division_standings = 5;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<number,!proto.ipc.RoundStandings>}
+ */
+proto.ipc.PlayersAddedOrRemovedResponse.prototype.getDivisionStandingsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<number,!proto.ipc.RoundStandings>} */ (
+      jspb.Message.getMapField(this, 5, opt_noLazyCreate,
+      proto.ipc.RoundStandings));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.ipc.PlayersAddedOrRemovedResponse} returns this
+ */
+proto.ipc.PlayersAddedOrRemovedResponse.prototype.clearDivisionStandingsMap = function() {
+  this.getDivisionStandingsMap().clear();
+  return this;};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ipc.DivisionRoundControls.repeatedFields_ = [3,4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ipc.DivisionRoundControls.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.DivisionRoundControls.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ipc.DivisionRoundControls} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.DivisionRoundControls.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    roundControlsList: jspb.Message.toObjectList(msg.getRoundControlsList(),
+    proto.ipc.RoundControl.toObject, includeInstance),
+    divisionPairingsList: jspb.Message.toObjectList(msg.getDivisionPairingsList(),
+    proto.ipc.Pairing.toObject, includeInstance),
+    divisionStandingsMap: (f = msg.getDivisionStandingsMap()) ? f.toObject(includeInstance, proto.ipc.RoundStandings.toObject) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ipc.DivisionRoundControls}
+ */
+proto.ipc.DivisionRoundControls.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ipc.DivisionRoundControls;
+  return proto.ipc.DivisionRoundControls.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ipc.DivisionRoundControls} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ipc.DivisionRoundControls}
+ */
+proto.ipc.DivisionRoundControls.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDivision(value);
+      break;
+    case 3:
+      var value = new proto.ipc.RoundControl;
+      reader.readMessage(value,proto.ipc.RoundControl.deserializeBinaryFromReader);
+      msg.addRoundControls(value);
+      break;
+    case 4:
+      var value = new proto.ipc.Pairing;
+      reader.readMessage(value,proto.ipc.Pairing.deserializeBinaryFromReader);
+      msg.addDivisionPairings(value);
+      break;
+    case 5:
+      var value = msg.getDivisionStandingsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readInt32, jspb.BinaryReader.prototype.readMessage, proto.ipc.RoundStandings.deserializeBinaryFromReader, 0, new proto.ipc.RoundStandings());
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ipc.DivisionRoundControls.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ipc.DivisionRoundControls.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ipc.DivisionRoundControls} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.DivisionRoundControls.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDivision();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getRoundControlsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.ipc.RoundControl.serializeBinaryToWriter
+    );
+  }
+  f = message.getDivisionPairingsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.ipc.Pairing.serializeBinaryToWriter
+    );
+  }
+  f = message.getDivisionStandingsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(5, writer, jspb.BinaryWriter.prototype.writeInt32, jspb.BinaryWriter.prototype.writeMessage, proto.ipc.RoundStandings.serializeBinaryToWriter);
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.ipc.DivisionRoundControls.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.DivisionRoundControls} returns this
+ */
+proto.ipc.DivisionRoundControls.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string division = 2;
+ * @return {string}
+ */
+proto.ipc.DivisionRoundControls.prototype.getDivision = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.DivisionRoundControls} returns this
+ */
+proto.ipc.DivisionRoundControls.prototype.setDivision = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * repeated RoundControl round_controls = 3;
+ * @return {!Array<!proto.ipc.RoundControl>}
+ */
+proto.ipc.DivisionRoundControls.prototype.getRoundControlsList = function() {
+  return /** @type{!Array<!proto.ipc.RoundControl>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ipc.RoundControl, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.ipc.RoundControl>} value
+ * @return {!proto.ipc.DivisionRoundControls} returns this
+*/
+proto.ipc.DivisionRoundControls.prototype.setRoundControlsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.ipc.RoundControl=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.RoundControl}
+ */
+proto.ipc.DivisionRoundControls.prototype.addRoundControls = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.ipc.RoundControl, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ipc.DivisionRoundControls} returns this
+ */
+proto.ipc.DivisionRoundControls.prototype.clearRoundControlsList = function() {
+  return this.setRoundControlsList([]);
+};
+
+
+/**
+ * repeated Pairing division_pairings = 4;
+ * @return {!Array<!proto.ipc.Pairing>}
+ */
+proto.ipc.DivisionRoundControls.prototype.getDivisionPairingsList = function() {
+  return /** @type{!Array<!proto.ipc.Pairing>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ipc.Pairing, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.ipc.Pairing>} value
+ * @return {!proto.ipc.DivisionRoundControls} returns this
+*/
+proto.ipc.DivisionRoundControls.prototype.setDivisionPairingsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.ipc.Pairing=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.Pairing}
+ */
+proto.ipc.DivisionRoundControls.prototype.addDivisionPairings = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.ipc.Pairing, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ipc.DivisionRoundControls} returns this
+ */
+proto.ipc.DivisionRoundControls.prototype.clearDivisionPairingsList = function() {
+  return this.setDivisionPairingsList([]);
+};
+
+
+/**
+ * map<int32, RoundStandings> division_standings = 5;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<number,!proto.ipc.RoundStandings>}
+ */
+proto.ipc.DivisionRoundControls.prototype.getDivisionStandingsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<number,!proto.ipc.RoundStandings>} */ (
+      jspb.Message.getMapField(this, 5, opt_noLazyCreate,
+      proto.ipc.RoundStandings));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.ipc.DivisionRoundControls} returns this
+ */
+proto.ipc.DivisionRoundControls.prototype.clearDivisionStandingsMap = function() {
+  this.getDivisionStandingsMap().clear();
+  return this;};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ipc.DivisionControlsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.DivisionControlsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ipc.DivisionControlsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.DivisionControlsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    divisionControls: (f = msg.getDivisionControls()) && proto.ipc.DivisionControls.toObject(includeInstance, f),
+    divisionStandingsMap: (f = msg.getDivisionStandingsMap()) ? f.toObject(includeInstance, proto.ipc.RoundStandings.toObject) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ipc.DivisionControlsResponse}
+ */
+proto.ipc.DivisionControlsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ipc.DivisionControlsResponse;
+  return proto.ipc.DivisionControlsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ipc.DivisionControlsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ipc.DivisionControlsResponse}
+ */
+proto.ipc.DivisionControlsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDivision(value);
+      break;
+    case 3:
+      var value = new proto.ipc.DivisionControls;
+      reader.readMessage(value,proto.ipc.DivisionControls.deserializeBinaryFromReader);
+      msg.setDivisionControls(value);
+      break;
+    case 4:
+      var value = msg.getDivisionStandingsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readInt32, jspb.BinaryReader.prototype.readMessage, proto.ipc.RoundStandings.deserializeBinaryFromReader, 0, new proto.ipc.RoundStandings());
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ipc.DivisionControlsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ipc.DivisionControlsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ipc.DivisionControlsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.DivisionControlsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDivision();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getDivisionControls();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.ipc.DivisionControls.serializeBinaryToWriter
+    );
+  }
+  f = message.getDivisionStandingsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(4, writer, jspb.BinaryWriter.prototype.writeInt32, jspb.BinaryWriter.prototype.writeMessage, proto.ipc.RoundStandings.serializeBinaryToWriter);
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.ipc.DivisionControlsResponse.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.DivisionControlsResponse} returns this
+ */
+proto.ipc.DivisionControlsResponse.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string division = 2;
+ * @return {string}
+ */
+proto.ipc.DivisionControlsResponse.prototype.getDivision = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.DivisionControlsResponse} returns this
+ */
+proto.ipc.DivisionControlsResponse.prototype.setDivision = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional DivisionControls division_controls = 3;
+ * @return {?proto.ipc.DivisionControls}
+ */
+proto.ipc.DivisionControlsResponse.prototype.getDivisionControls = function() {
+  return /** @type{?proto.ipc.DivisionControls} */ (
+    jspb.Message.getWrapperField(this, proto.ipc.DivisionControls, 3));
+};
+
+
+/**
+ * @param {?proto.ipc.DivisionControls|undefined} value
+ * @return {!proto.ipc.DivisionControlsResponse} returns this
+*/
+proto.ipc.DivisionControlsResponse.prototype.setDivisionControls = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ipc.DivisionControlsResponse} returns this
+ */
+proto.ipc.DivisionControlsResponse.prototype.clearDivisionControls = function() {
+  return this.setDivisionControls(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ipc.DivisionControlsResponse.prototype.hasDivisionControls = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * map<int32, RoundStandings> division_standings = 4;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<number,!proto.ipc.RoundStandings>}
+ */
+proto.ipc.DivisionControlsResponse.prototype.getDivisionStandingsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<number,!proto.ipc.RoundStandings>} */ (
+      jspb.Message.getMapField(this, 4, opt_noLazyCreate,
+      proto.ipc.RoundStandings));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.ipc.DivisionControlsResponse} returns this
+ */
+proto.ipc.DivisionControlsResponse.prototype.clearDivisionStandingsMap = function() {
+  this.getDivisionStandingsMap().clear();
+  return this;};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ipc.TournamentDivisionDataResponse.repeatedFields_ = [7];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ipc.TournamentDivisionDataResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.TournamentDivisionDataResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ipc.TournamentDivisionDataResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.TournamentDivisionDataResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    players: (f = msg.getPlayers()) && proto.ipc.TournamentPersons.toObject(includeInstance, f),
+    standingsMap: (f = msg.getStandingsMap()) ? f.toObject(includeInstance, proto.ipc.RoundStandings.toObject) : [],
+    pairingMapMap: (f = msg.getPairingMapMap()) ? f.toObject(includeInstance, proto.ipc.Pairing.toObject) : [],
+    controls: (f = msg.getControls()) && proto.ipc.DivisionControls.toObject(includeInstance, f),
+    roundControlsList: jspb.Message.toObjectList(msg.getRoundControlsList(),
+    proto.ipc.RoundControl.toObject, includeInstance),
+    currentRound: jspb.Message.getFieldWithDefault(msg, 8, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ipc.TournamentDivisionDataResponse}
+ */
+proto.ipc.TournamentDivisionDataResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ipc.TournamentDivisionDataResponse;
+  return proto.ipc.TournamentDivisionDataResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ipc.TournamentDivisionDataResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ipc.TournamentDivisionDataResponse}
+ */
+proto.ipc.TournamentDivisionDataResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDivision(value);
+      break;
+    case 3:
+      var value = new proto.ipc.TournamentPersons;
+      reader.readMessage(value,proto.ipc.TournamentPersons.deserializeBinaryFromReader);
+      msg.setPlayers(value);
+      break;
+    case 4:
+      var value = msg.getStandingsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readInt32, jspb.BinaryReader.prototype.readMessage, proto.ipc.RoundStandings.deserializeBinaryFromReader, 0, new proto.ipc.RoundStandings());
+         });
+      break;
+    case 5:
+      var value = msg.getPairingMapMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.ipc.Pairing.deserializeBinaryFromReader, "", new proto.ipc.Pairing());
+         });
+      break;
+    case 6:
+      var value = new proto.ipc.DivisionControls;
+      reader.readMessage(value,proto.ipc.DivisionControls.deserializeBinaryFromReader);
+      msg.setControls(value);
+      break;
+    case 7:
+      var value = new proto.ipc.RoundControl;
+      reader.readMessage(value,proto.ipc.RoundControl.deserializeBinaryFromReader);
+      msg.addRoundControls(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setCurrentRound(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ipc.TournamentDivisionDataResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ipc.TournamentDivisionDataResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ipc.TournamentDivisionDataResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.TournamentDivisionDataResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDivision();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getPlayers();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.ipc.TournamentPersons.serializeBinaryToWriter
+    );
+  }
+  f = message.getStandingsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(4, writer, jspb.BinaryWriter.prototype.writeInt32, jspb.BinaryWriter.prototype.writeMessage, proto.ipc.RoundStandings.serializeBinaryToWriter);
+  }
+  f = message.getPairingMapMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(5, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.ipc.Pairing.serializeBinaryToWriter);
+  }
+  f = message.getControls();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.ipc.DivisionControls.serializeBinaryToWriter
+    );
+  }
+  f = message.getRoundControlsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      7,
+      f,
+      proto.ipc.RoundControl.serializeBinaryToWriter
+    );
+  }
+  f = message.getCurrentRound();
+  if (f !== 0) {
+    writer.writeInt32(
+      8,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.ipc.TournamentDivisionDataResponse.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.TournamentDivisionDataResponse} returns this
+ */
+proto.ipc.TournamentDivisionDataResponse.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string division = 2;
+ * @return {string}
+ */
+proto.ipc.TournamentDivisionDataResponse.prototype.getDivision = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.TournamentDivisionDataResponse} returns this
+ */
+proto.ipc.TournamentDivisionDataResponse.prototype.setDivision = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional TournamentPersons players = 3;
+ * @return {?proto.ipc.TournamentPersons}
+ */
+proto.ipc.TournamentDivisionDataResponse.prototype.getPlayers = function() {
+  return /** @type{?proto.ipc.TournamentPersons} */ (
+    jspb.Message.getWrapperField(this, proto.ipc.TournamentPersons, 3));
+};
+
+
+/**
+ * @param {?proto.ipc.TournamentPersons|undefined} value
+ * @return {!proto.ipc.TournamentDivisionDataResponse} returns this
+*/
+proto.ipc.TournamentDivisionDataResponse.prototype.setPlayers = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ipc.TournamentDivisionDataResponse} returns this
+ */
+proto.ipc.TournamentDivisionDataResponse.prototype.clearPlayers = function() {
+  return this.setPlayers(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ipc.TournamentDivisionDataResponse.prototype.hasPlayers = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * map<int32, RoundStandings> standings = 4;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<number,!proto.ipc.RoundStandings>}
+ */
+proto.ipc.TournamentDivisionDataResponse.prototype.getStandingsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<number,!proto.ipc.RoundStandings>} */ (
+      jspb.Message.getMapField(this, 4, opt_noLazyCreate,
+      proto.ipc.RoundStandings));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.ipc.TournamentDivisionDataResponse} returns this
+ */
+proto.ipc.TournamentDivisionDataResponse.prototype.clearStandingsMap = function() {
+  this.getStandingsMap().clear();
+  return this;};
+
+
+/**
+ * map<string, Pairing> pairing_map = 5;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.ipc.Pairing>}
+ */
+proto.ipc.TournamentDivisionDataResponse.prototype.getPairingMapMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.ipc.Pairing>} */ (
+      jspb.Message.getMapField(this, 5, opt_noLazyCreate,
+      proto.ipc.Pairing));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.ipc.TournamentDivisionDataResponse} returns this
+ */
+proto.ipc.TournamentDivisionDataResponse.prototype.clearPairingMapMap = function() {
+  this.getPairingMapMap().clear();
+  return this;};
+
+
+/**
+ * optional DivisionControls controls = 6;
+ * @return {?proto.ipc.DivisionControls}
+ */
+proto.ipc.TournamentDivisionDataResponse.prototype.getControls = function() {
+  return /** @type{?proto.ipc.DivisionControls} */ (
+    jspb.Message.getWrapperField(this, proto.ipc.DivisionControls, 6));
+};
+
+
+/**
+ * @param {?proto.ipc.DivisionControls|undefined} value
+ * @return {!proto.ipc.TournamentDivisionDataResponse} returns this
+*/
+proto.ipc.TournamentDivisionDataResponse.prototype.setControls = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ipc.TournamentDivisionDataResponse} returns this
+ */
+proto.ipc.TournamentDivisionDataResponse.prototype.clearControls = function() {
+  return this.setControls(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ipc.TournamentDivisionDataResponse.prototype.hasControls = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * repeated RoundControl round_controls = 7;
+ * @return {!Array<!proto.ipc.RoundControl>}
+ */
+proto.ipc.TournamentDivisionDataResponse.prototype.getRoundControlsList = function() {
+  return /** @type{!Array<!proto.ipc.RoundControl>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ipc.RoundControl, 7));
+};
+
+
+/**
+ * @param {!Array<!proto.ipc.RoundControl>} value
+ * @return {!proto.ipc.TournamentDivisionDataResponse} returns this
+*/
+proto.ipc.TournamentDivisionDataResponse.prototype.setRoundControlsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 7, value);
+};
+
+
+/**
+ * @param {!proto.ipc.RoundControl=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.RoundControl}
+ */
+proto.ipc.TournamentDivisionDataResponse.prototype.addRoundControls = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 7, opt_value, proto.ipc.RoundControl, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ipc.TournamentDivisionDataResponse} returns this
+ */
+proto.ipc.TournamentDivisionDataResponse.prototype.clearRoundControlsList = function() {
+  return this.setRoundControlsList([]);
+};
+
+
+/**
+ * optional int32 current_round = 8;
+ * @return {number}
+ */
+proto.ipc.TournamentDivisionDataResponse.prototype.getCurrentRound = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.TournamentDivisionDataResponse} returns this
+ */
+proto.ipc.TournamentDivisionDataResponse.prototype.setCurrentRound = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ipc.FullTournamentDivisions.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.FullTournamentDivisions.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ipc.FullTournamentDivisions} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.FullTournamentDivisions.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    divisionsMap: (f = msg.getDivisionsMap()) ? f.toObject(includeInstance, proto.ipc.TournamentDivisionDataResponse.toObject) : [],
+    started: jspb.Message.getBooleanFieldWithDefault(msg, 2, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ipc.FullTournamentDivisions}
+ */
+proto.ipc.FullTournamentDivisions.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ipc.FullTournamentDivisions;
+  return proto.ipc.FullTournamentDivisions.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ipc.FullTournamentDivisions} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ipc.FullTournamentDivisions}
+ */
+proto.ipc.FullTournamentDivisions.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getDivisionsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.ipc.TournamentDivisionDataResponse.deserializeBinaryFromReader, "", new proto.ipc.TournamentDivisionDataResponse());
+         });
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setStarted(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ipc.FullTournamentDivisions.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ipc.FullTournamentDivisions.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ipc.FullTournamentDivisions} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.FullTournamentDivisions.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDivisionsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.ipc.TournamentDivisionDataResponse.serializeBinaryToWriter);
+  }
+  f = message.getStarted();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * map<string, TournamentDivisionDataResponse> divisions = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.ipc.TournamentDivisionDataResponse>}
+ */
+proto.ipc.FullTournamentDivisions.prototype.getDivisionsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.ipc.TournamentDivisionDataResponse>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      proto.ipc.TournamentDivisionDataResponse));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.ipc.FullTournamentDivisions} returns this
+ */
+proto.ipc.FullTournamentDivisions.prototype.clearDivisionsMap = function() {
+  this.getDivisionsMap().clear();
+  return this;};
+
+
+/**
+ * optional bool started = 2;
+ * @return {boolean}
+ */
+proto.ipc.FullTournamentDivisions.prototype.getStarted = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {boolean} value
+ * @return {!proto.ipc.FullTournamentDivisions} returns this
  */
-proto.ipc.TournamentGameEndedEvent.repeatedFields_ = [2];
+proto.ipc.FullTournamentDivisions.prototype.setStarted = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
 
 
 
@@ -529,8 +6870,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ipc.TournamentGameEndedEvent.prototype.toObject = function(opt_includeInstance) {
-  return proto.ipc.TournamentGameEndedEvent.toObject(opt_includeInstance, this);
+proto.ipc.TournamentFinishedResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.TournamentFinishedResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -539,20 +6880,13 @@ proto.ipc.TournamentGameEndedEvent.prototype.toObject = function(opt_includeInst
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ipc.TournamentGameEndedEvent} msg The msg instance to transform.
+ * @param {!proto.ipc.TournamentFinishedResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.TournamentGameEndedEvent.toObject = function(includeInstance, msg) {
+proto.ipc.TournamentFinishedResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    gameId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    playersList: jspb.Message.toObjectList(msg.getPlayersList(),
-    proto.ipc.TournamentGameEndedEvent.Player.toObject, includeInstance),
-    endReason: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    time: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    round: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    division: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    gameIndex: jspb.Message.getFieldWithDefault(msg, 7, 0)
+    id: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -566,23 +6900,23 @@ proto.ipc.TournamentGameEndedEvent.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ipc.TournamentGameEndedEvent}
+ * @return {!proto.ipc.TournamentFinishedResponse}
  */
-proto.ipc.TournamentGameEndedEvent.deserializeBinary = function(bytes) {
+proto.ipc.TournamentFinishedResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ipc.TournamentGameEndedEvent;
-  return proto.ipc.TournamentGameEndedEvent.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ipc.TournamentFinishedResponse;
+  return proto.ipc.TournamentFinishedResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ipc.TournamentGameEndedEvent} msg The message object to deserialize into.
+ * @param {!proto.ipc.TournamentFinishedResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ipc.TournamentGameEndedEvent}
+ * @return {!proto.ipc.TournamentFinishedResponse}
  */
-proto.ipc.TournamentGameEndedEvent.deserializeBinaryFromReader = function(msg, reader) {
+proto.ipc.TournamentFinishedResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -591,32 +6925,7 @@ proto.ipc.TournamentGameEndedEvent.deserializeBinaryFromReader = function(msg, r
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setGameId(value);
-      break;
-    case 2:
-      var value = new proto.ipc.TournamentGameEndedEvent.Player;
-      reader.readMessage(value,proto.ipc.TournamentGameEndedEvent.Player.deserializeBinaryFromReader);
-      msg.addPlayers(value);
-      break;
-    case 3:
-      var value = /** @type {!proto.ipc.GameEndReason} */ (reader.readEnum());
-      msg.setEndReason(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setTime(value);
-      break;
-    case 5:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setRound(value);
-      break;
-    case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDivision(value);
-      break;
-    case 7:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setGameIndex(value);
+      msg.setId(value);
       break;
     default:
       reader.skipField();
@@ -631,9 +6940,9 @@ proto.ipc.TournamentGameEndedEvent.deserializeBinaryFromReader = function(msg, r
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ipc.TournamentGameEndedEvent.prototype.serializeBinary = function() {
+proto.ipc.TournamentFinishedResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ipc.TournamentGameEndedEvent.serializeBinaryToWriter(this, writer);
+  proto.ipc.TournamentFinishedResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -641,62 +6950,37 @@ proto.ipc.TournamentGameEndedEvent.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ipc.TournamentGameEndedEvent} message
+ * @param {!proto.ipc.TournamentFinishedResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.TournamentGameEndedEvent.serializeBinaryToWriter = function(message, writer) {
+proto.ipc.TournamentFinishedResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getGameId();
+  f = message.getId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getPlayersList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      2,
-      f,
-      proto.ipc.TournamentGameEndedEvent.Player.serializeBinaryToWriter
-    );
-  }
-  f = message.getEndReason();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      3,
-      f
-    );
-  }
-  f = message.getTime();
-  if (f !== 0) {
-    writer.writeInt64(
-      4,
-      f
-    );
-  }
-  f = message.getRound();
-  if (f !== 0) {
-    writer.writeInt32(
-      5,
-      f
-    );
-  }
-  f = message.getDivision();
-  if (f.length > 0) {
-    writer.writeString(
-      6,
-      f
-    );
-  }
-  f = message.getGameIndex();
-  if (f !== 0) {
-    writer.writeInt32(
-      7,
-      f
-    );
-  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.ipc.TournamentFinishedResponse.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.TournamentFinishedResponse} returns this
+ */
+proto.ipc.TournamentFinishedResponse.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -716,8 +7000,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ipc.TournamentGameEndedEvent.Player.prototype.toObject = function(opt_includeInstance) {
-  return proto.ipc.TournamentGameEndedEvent.Player.toObject(opt_includeInstance, this);
+proto.ipc.TournamentDataResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.TournamentDataResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -726,15 +7010,19 @@ proto.ipc.TournamentGameEndedEvent.Player.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ipc.TournamentGameEndedEvent.Player} msg The msg instance to transform.
+ * @param {!proto.ipc.TournamentDataResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.TournamentGameEndedEvent.Player.toObject = function(includeInstance, msg) {
+proto.ipc.TournamentDataResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    username: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    score: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    result: jspb.Message.getFieldWithDefault(msg, 3, 0)
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    description: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    executiveDirector: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    directors: (f = msg.getDirectors()) && proto.ipc.TournamentPersons.toObject(includeInstance, f),
+    isStarted: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
+    startTime: (f = msg.getStartTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -748,23 +7036,23 @@ proto.ipc.TournamentGameEndedEvent.Player.toObject = function(includeInstance, m
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ipc.TournamentGameEndedEvent.Player}
+ * @return {!proto.ipc.TournamentDataResponse}
  */
-proto.ipc.TournamentGameEndedEvent.Player.deserializeBinary = function(bytes) {
+proto.ipc.TournamentDataResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ipc.TournamentGameEndedEvent.Player;
-  return proto.ipc.TournamentGameEndedEvent.Player.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ipc.TournamentDataResponse;
+  return proto.ipc.TournamentDataResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ipc.TournamentGameEndedEvent.Player} msg The message object to deserialize into.
+ * @param {!proto.ipc.TournamentDataResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ipc.TournamentGameEndedEvent.Player}
+ * @return {!proto.ipc.TournamentDataResponse}
  */
-proto.ipc.TournamentGameEndedEvent.Player.deserializeBinaryFromReader = function(msg, reader) {
+proto.ipc.TournamentDataResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -773,15 +7061,33 @@ proto.ipc.TournamentGameEndedEvent.Player.deserializeBinaryFromReader = function
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUsername(value);
+      msg.setId(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setScore(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
     case 3:
-      var value = /** @type {!proto.ipc.TournamentGameResult} */ (reader.readEnum());
-      msg.setResult(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDescription(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setExecutiveDirector(value);
+      break;
+    case 5:
+      var value = new proto.ipc.TournamentPersons;
+      reader.readMessage(value,proto.ipc.TournamentPersons.deserializeBinaryFromReader);
+      msg.setDirectors(value);
+      break;
+    case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsStarted(value);
+      break;
+    case 7:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setStartTime(value);
       break;
     default:
       reader.skipField();
@@ -796,9 +7102,9 @@ proto.ipc.TournamentGameEndedEvent.Player.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ipc.TournamentGameEndedEvent.Player.prototype.serializeBinary = function() {
+proto.ipc.TournamentDataResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ipc.TournamentGameEndedEvent.Player.serializeBinaryToWriter(this, writer);
+  proto.ipc.TournamentDataResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -806,233 +7112,227 @@ proto.ipc.TournamentGameEndedEvent.Player.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ipc.TournamentGameEndedEvent.Player} message
+ * @param {!proto.ipc.TournamentDataResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.TournamentGameEndedEvent.Player.serializeBinaryToWriter = function(message, writer) {
+proto.ipc.TournamentDataResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUsername();
+  f = message.getId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getScore();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
   }
-  f = message.getResult();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getDescription();
+  if (f.length > 0) {
+    writer.writeString(
       3,
       f
     );
   }
+  f = message.getExecutiveDirector();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getDirectors();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      proto.ipc.TournamentPersons.serializeBinaryToWriter
+    );
+  }
+  f = message.getIsStarted();
+  if (f) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
+  f = message.getStartTime();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional string username = 1;
+ * optional string id = 1;
  * @return {string}
  */
-proto.ipc.TournamentGameEndedEvent.Player.prototype.getUsername = function() {
+proto.ipc.TournamentDataResponse.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ipc.TournamentGameEndedEvent.Player} returns this
+ * @return {!proto.ipc.TournamentDataResponse} returns this
  */
-proto.ipc.TournamentGameEndedEvent.Player.prototype.setUsername = function(value) {
+proto.ipc.TournamentDataResponse.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional int32 score = 2;
- * @return {number}
+ * optional string name = 2;
+ * @return {string}
  */
-proto.ipc.TournamentGameEndedEvent.Player.prototype.getScore = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.ipc.TournamentDataResponse.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ipc.TournamentGameEndedEvent.Player} returns this
+ * @param {string} value
+ * @return {!proto.ipc.TournamentDataResponse} returns this
  */
-proto.ipc.TournamentGameEndedEvent.Player.prototype.setScore = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.ipc.TournamentDataResponse.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional TournamentGameResult result = 3;
- * @return {!proto.ipc.TournamentGameResult}
+ * optional string description = 3;
+ * @return {string}
  */
-proto.ipc.TournamentGameEndedEvent.Player.prototype.getResult = function() {
-  return /** @type {!proto.ipc.TournamentGameResult} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.ipc.TournamentDataResponse.prototype.getDescription = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * @param {!proto.ipc.TournamentGameResult} value
- * @return {!proto.ipc.TournamentGameEndedEvent.Player} returns this
+ * @param {string} value
+ * @return {!proto.ipc.TournamentDataResponse} returns this
  */
-proto.ipc.TournamentGameEndedEvent.Player.prototype.setResult = function(value) {
-  return jspb.Message.setProto3EnumField(this, 3, value);
+proto.ipc.TournamentDataResponse.prototype.setDescription = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional string game_id = 1;
+ * optional string executive_director = 4;
  * @return {string}
  */
-proto.ipc.TournamentGameEndedEvent.prototype.getGameId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.ipc.TournamentDataResponse.prototype.getExecutiveDirector = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ipc.TournamentGameEndedEvent} returns this
+ * @return {!proto.ipc.TournamentDataResponse} returns this
  */
-proto.ipc.TournamentGameEndedEvent.prototype.setGameId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.ipc.TournamentDataResponse.prototype.setExecutiveDirector = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * repeated Player players = 2;
- * @return {!Array<!proto.ipc.TournamentGameEndedEvent.Player>}
+ * optional TournamentPersons directors = 5;
+ * @return {?proto.ipc.TournamentPersons}
  */
-proto.ipc.TournamentGameEndedEvent.prototype.getPlayersList = function() {
-  return /** @type{!Array<!proto.ipc.TournamentGameEndedEvent.Player>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ipc.TournamentGameEndedEvent.Player, 2));
+proto.ipc.TournamentDataResponse.prototype.getDirectors = function() {
+  return /** @type{?proto.ipc.TournamentPersons} */ (
+    jspb.Message.getWrapperField(this, proto.ipc.TournamentPersons, 5));
 };
 
 
 /**
- * @param {!Array<!proto.ipc.TournamentGameEndedEvent.Player>} value
- * @return {!proto.ipc.TournamentGameEndedEvent} returns this
+ * @param {?proto.ipc.TournamentPersons|undefined} value
+ * @return {!proto.ipc.TournamentDataResponse} returns this
 */
-proto.ipc.TournamentGameEndedEvent.prototype.setPlayersList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 2, value);
-};
-
-
-/**
- * @param {!proto.ipc.TournamentGameEndedEvent.Player=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ipc.TournamentGameEndedEvent.Player}
- */
-proto.ipc.TournamentGameEndedEvent.prototype.addPlayers = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.ipc.TournamentGameEndedEvent.Player, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.ipc.TournamentGameEndedEvent} returns this
- */
-proto.ipc.TournamentGameEndedEvent.prototype.clearPlayersList = function() {
-  return this.setPlayersList([]);
-};
-
-
-/**
- * optional GameEndReason end_reason = 3;
- * @return {!proto.ipc.GameEndReason}
- */
-proto.ipc.TournamentGameEndedEvent.prototype.getEndReason = function() {
-  return /** @type {!proto.ipc.GameEndReason} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
-};
-
-
-/**
- * @param {!proto.ipc.GameEndReason} value
- * @return {!proto.ipc.TournamentGameEndedEvent} returns this
- */
-proto.ipc.TournamentGameEndedEvent.prototype.setEndReason = function(value) {
-  return jspb.Message.setProto3EnumField(this, 3, value);
+proto.ipc.TournamentDataResponse.prototype.setDirectors = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
 };
 
 
 /**
- * optional int64 time = 4;
- * @return {number}
+ * Clears the message field making it undefined.
+ * @return {!proto.ipc.TournamentDataResponse} returns this
  */
-proto.ipc.TournamentGameEndedEvent.prototype.getTime = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.ipc.TournamentDataResponse.prototype.clearDirectors = function() {
+  return this.setDirectors(undefined);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ipc.TournamentGameEndedEvent} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.ipc.TournamentGameEndedEvent.prototype.setTime = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
+proto.ipc.TournamentDataResponse.prototype.hasDirectors = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
 
 
 /**
- * optional int32 round = 5;
- * @return {number}
+ * optional bool is_started = 6;
+ * @return {boolean}
  */
-proto.ipc.TournamentGameEndedEvent.prototype.getRound = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.ipc.TournamentDataResponse.prototype.getIsStarted = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ipc.TournamentGameEndedEvent} returns this
+ * @param {boolean} value
+ * @return {!proto.ipc.TournamentDataResponse} returns this
  */
-proto.ipc.TournamentGameEndedEvent.prototype.setRound = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
+proto.ipc.TournamentDataResponse.prototype.setIsStarted = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 6, value);
 };
 
 
 /**
- * optional string division = 6;
- * @return {string}
+ * optional google.protobuf.Timestamp start_time = 7;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.ipc.TournamentGameEndedEvent.prototype.getDivision = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.ipc.TournamentDataResponse.prototype.getStartTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 7));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ipc.TournamentGameEndedEvent} returns this
- */
-proto.ipc.TournamentGameEndedEvent.prototype.setDivision = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.ipc.TournamentDataResponse} returns this
+*/
+proto.ipc.TournamentDataResponse.prototype.setStartTime = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
 };
 
 
 /**
- * optional int32 game_index = 7;
- * @return {number}
+ * Clears the message field making it undefined.
+ * @return {!proto.ipc.TournamentDataResponse} returns this
  */
-proto.ipc.TournamentGameEndedEvent.prototype.getGameIndex = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+proto.ipc.TournamentDataResponse.prototype.clearStartTime = function() {
+  return this.setStartTime(undefined);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ipc.TournamentGameEndedEvent} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.ipc.TournamentGameEndedEvent.prototype.setGameIndex = function(value) {
-  return jspb.Message.setProto3IntField(this, 7, value);
+proto.ipc.TournamentDataResponse.prototype.hasStartTime = function() {
+  return jspb.Message.getField(this, 7) != null;
 };
 
 
@@ -1052,8 +7352,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ipc.TournamentRoundStarted.prototype.toObject = function(opt_includeInstance) {
-  return proto.ipc.TournamentRoundStarted.toObject(opt_includeInstance, this);
+proto.ipc.TournamentDivisionDeletedResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.TournamentDivisionDeletedResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1062,17 +7362,14 @@ proto.ipc.TournamentRoundStarted.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ipc.TournamentRoundStarted} msg The msg instance to transform.
+ * @param {!proto.ipc.TournamentDivisionDeletedResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.TournamentRoundStarted.toObject = function(includeInstance, msg) {
+proto.ipc.TournamentDivisionDeletedResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    tournamentId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    round: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    gameIndex: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    deadline: (f = msg.getDeadline()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    division: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -1086,23 +7383,23 @@ proto.ipc.TournamentRoundStarted.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ipc.TournamentRoundStarted}
+ * @return {!proto.ipc.TournamentDivisionDeletedResponse}
  */
-proto.ipc.TournamentRoundStarted.deserializeBinary = function(bytes) {
+proto.ipc.TournamentDivisionDeletedResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ipc.TournamentRoundStarted;
-  return proto.ipc.TournamentRoundStarted.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ipc.TournamentDivisionDeletedResponse;
+  return proto.ipc.TournamentDivisionDeletedResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ipc.TournamentRoundStarted} msg The message object to deserialize into.
+ * @param {!proto.ipc.TournamentDivisionDeletedResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ipc.TournamentRoundStarted}
+ * @return {!proto.ipc.TournamentDivisionDeletedResponse}
  */
-proto.ipc.TournamentRoundStarted.deserializeBinaryFromReader = function(msg, reader) {
+proto.ipc.TournamentDivisionDeletedResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1111,25 +7408,12 @@ proto.ipc.TournamentRoundStarted.deserializeBinaryFromReader = function(msg, rea
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setTournamentId(value);
+      msg.setId(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
       msg.setDivision(value);
       break;
-    case 3:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setRound(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setGameIndex(value);
-      break;
-    case 5:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setDeadline(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -1143,9 +7427,9 @@ proto.ipc.TournamentRoundStarted.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ipc.TournamentRoundStarted.prototype.serializeBinary = function() {
+proto.ipc.TournamentDivisionDeletedResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ipc.TournamentRoundStarted.serializeBinaryToWriter(this, writer);
+  proto.ipc.TournamentDivisionDeletedResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1153,65 +7437,43 @@ proto.ipc.TournamentRoundStarted.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ipc.TournamentRoundStarted} message
+ * @param {!proto.ipc.TournamentDivisionDeletedResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.TournamentRoundStarted.serializeBinaryToWriter = function(message, writer) {
+proto.ipc.TournamentDivisionDeletedResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getTournamentId();
+  f = message.getId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getDivision();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getRound();
-  if (f !== 0) {
-    writer.writeInt32(
-      3,
-      f
-    );
-  }
-  f = message.getGameIndex();
-  if (f !== 0) {
-    writer.writeInt32(
-      4,
-      f
-    );
-  }
-  f = message.getDeadline();
-  if (f != null) {
-    writer.writeMessage(
-      5,
-      f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
-    );
-  }
+  f = message.getDivision();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string tournament_id = 1;
+ * optional string id = 1;
  * @return {string}
  */
-proto.ipc.TournamentRoundStarted.prototype.getTournamentId = function() {
+proto.ipc.TournamentDivisionDeletedResponse.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ipc.TournamentRoundStarted} returns this
+ * @return {!proto.ipc.TournamentDivisionDeletedResponse} returns this
  */
-proto.ipc.TournamentRoundStarted.prototype.setTournamentId = function(value) {
+proto.ipc.TournamentDivisionDeletedResponse.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
@@ -1220,93 +7482,27 @@ proto.ipc.TournamentRoundStarted.prototype.setTournamentId = function(value) {
  * optional string division = 2;
  * @return {string}
  */
-proto.ipc.TournamentRoundStarted.prototype.getDivision = function() {
+proto.ipc.TournamentDivisionDeletedResponse.prototype.getDivision = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ipc.TournamentRoundStarted} returns this
+ * @return {!proto.ipc.TournamentDivisionDeletedResponse} returns this
  */
-proto.ipc.TournamentRoundStarted.prototype.setDivision = function(value) {
+proto.ipc.TournamentDivisionDeletedResponse.prototype.setDivision = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
-/**
- * optional int32 round = 3;
- * @return {number}
- */
-proto.ipc.TournamentRoundStarted.prototype.getRound = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.ipc.TournamentRoundStarted} returns this
- */
-proto.ipc.TournamentRoundStarted.prototype.setRound = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
-};
-
-
-/**
- * optional int32 game_index = 4;
- * @return {number}
- */
-proto.ipc.TournamentRoundStarted.prototype.getGameIndex = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.ipc.TournamentRoundStarted} returns this
- */
-proto.ipc.TournamentRoundStarted.prototype.setGameIndex = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
-};
-
-
-/**
- * optional google.protobuf.Timestamp deadline = 5;
- * @return {?proto.google.protobuf.Timestamp}
- */
-proto.ipc.TournamentRoundStarted.prototype.getDeadline = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 5));
-};
-
-
-/**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.ipc.TournamentRoundStarted} returns this
-*/
-proto.ipc.TournamentRoundStarted.prototype.setDeadline = function(value) {
-  return jspb.Message.setWrapperField(this, 5, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.ipc.TournamentRoundStarted} returns this
- */
-proto.ipc.TournamentRoundStarted.prototype.clearDeadline = function() {
-  return this.setDeadline(undefined);
-};
-
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.ipc.TournamentRoundStarted.prototype.hasDeadline = function() {
-  return jspb.Message.getField(this, 5) != null;
-};
-
-
+proto.ipc.BracketMatch.repeatedFields_ = [5,12];
 
 
 
@@ -1323,8 +7519,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ipc.ReadyForTournamentGame.prototype.toObject = function(opt_includeInstance) {
-  return proto.ipc.ReadyForTournamentGame.toObject(opt_includeInstance, this);
+proto.ipc.BracketMatch.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.BracketMatch.toObject(opt_includeInstance, this);
 };
 
 
@@ -1333,18 +7529,25 @@ proto.ipc.ReadyForTournamentGame.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ipc.ReadyForTournamentGame} msg The msg instance to transform.
+ * @param {!proto.ipc.BracketMatch} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.ReadyForTournamentGame.toObject = function(includeInstance, msg) {
+proto.ipc.BracketMatch.toObject = function(includeInstance, msg) {
   var f, obj = {
-    tournamentId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    round: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    playerId: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    gameIndex: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    unready: jspb.Message.getBooleanFieldWithDefault(msg, 6, false)
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    side: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    bracketRound: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    round: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    playersList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f,
+    winner: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    loser: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    winnerTo: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    winnerSlot: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    loserTo: jspb.Message.getFieldWithDefault(msg, 10, ""),
+    loserSlot: jspb.Message.getFieldWithDefault(msg, 11, 0),
+    gamesList: jspb.Message.toObjectList(msg.getGamesList(),
+    proto.ipc.TournamentGame.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -1358,23 +7561,23 @@ proto.ipc.ReadyForTournamentGame.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ipc.ReadyForTournamentGame}
+ * @return {!proto.ipc.BracketMatch}
  */
-proto.ipc.ReadyForTournamentGame.deserializeBinary = function(bytes) {
+proto.ipc.BracketMatch.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ipc.ReadyForTournamentGame;
-  return proto.ipc.ReadyForTournamentGame.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ipc.BracketMatch;
+  return proto.ipc.BracketMatch.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ipc.ReadyForTournamentGame} msg The message object to deserialize into.
+ * @param {!proto.ipc.BracketMatch} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ipc.ReadyForTournamentGame}
+ * @return {!proto.ipc.BracketMatch}
  */
-proto.ipc.ReadyForTournamentGame.deserializeBinaryFromReader = function(msg, reader) {
+proto.ipc.BracketMatch.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1383,27 +7586,52 @@ proto.ipc.ReadyForTournamentGame.deserializeBinaryFromReader = function(msg, rea
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setTournamentId(value);
+      msg.setId(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDivision(value);
+      var value = /** @type {!proto.ipc.BracketSide} */ (reader.readEnum());
+      msg.setSide(value);
       break;
     case 3:
       var value = /** @type {number} */ (reader.readInt32());
-      msg.setRound(value);
+      msg.setBracketRound(value);
       break;
     case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPlayerId(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRound(value);
       break;
     case 5:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setGameIndex(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.addPlayers(value);
       break;
     case 6:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setUnready(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setWinner(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLoser(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setWinnerTo(value);
+      break;
+    case 9:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setWinnerSlot(value);
+      break;
+    case 10:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLoserTo(value);
+      break;
+    case 11:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setLoserSlot(value);
+      break;
+    case 12:
+      var value = new proto.ipc.TournamentGame;
+      reader.readMessage(value,proto.ipc.TournamentGame.deserializeBinaryFromReader);
+      msg.addGames(value);
       break;
     default:
       reader.skipField();
@@ -1418,9 +7646,9 @@ proto.ipc.ReadyForTournamentGame.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ipc.ReadyForTournamentGame.prototype.serializeBinary = function() {
+proto.ipc.BracketMatch.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ipc.ReadyForTournamentGame.serializeBinaryToWriter(this, writer);
+  proto.ipc.BracketMatch.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1428,352 +7656,352 @@ proto.ipc.ReadyForTournamentGame.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ipc.ReadyForTournamentGame} message
+ * @param {!proto.ipc.BracketMatch} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.ReadyForTournamentGame.serializeBinaryToWriter = function(message, writer) {
+proto.ipc.BracketMatch.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getTournamentId();
+  f = message.getId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getDivision();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getSide();
+  if (f !== 0.0) {
+    writer.writeEnum(
       2,
       f
     );
   }
-  f = message.getRound();
+  f = message.getBracketRound();
   if (f !== 0) {
     writer.writeInt32(
       3,
       f
     );
   }
-  f = message.getPlayerId();
+  f = message.getRound();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+  f = message.getPlayersList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      5,
+      f
+    );
+  }
+  f = message.getWinner();
   if (f.length > 0) {
     writer.writeString(
-      4,
+      6,
       f
     );
   }
-  f = message.getGameIndex();
+  f = message.getLoser();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getWinnerTo();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+  f = message.getWinnerSlot();
   if (f !== 0) {
     writer.writeInt32(
-      5,
+      9,
       f
     );
   }
-  f = message.getUnready();
-  if (f) {
-    writer.writeBool(
-      6,
+  f = message.getLoserTo();
+  if (f.length > 0) {
+    writer.writeString(
+      10,
       f
     );
   }
+  f = message.getLoserSlot();
+  if (f !== 0) {
+    writer.writeInt32(
+      11,
+      f
+    );
+  }
+  f = message.getGamesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      12,
+      f,
+      proto.ipc.TournamentGame.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional string tournament_id = 1;
+ * optional string id = 1;
  * @return {string}
  */
-proto.ipc.ReadyForTournamentGame.prototype.getTournamentId = function() {
+proto.ipc.BracketMatch.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ipc.ReadyForTournamentGame} returns this
+ * @return {!proto.ipc.BracketMatch} returns this
  */
-proto.ipc.ReadyForTournamentGame.prototype.setTournamentId = function(value) {
+proto.ipc.BracketMatch.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string division = 2;
- * @return {string}
+ * optional BracketSide side = 2;
+ * @return {!proto.ipc.BracketSide}
  */
-proto.ipc.ReadyForTournamentGame.prototype.getDivision = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.ipc.BracketMatch.prototype.getSide = function() {
+  return /** @type {!proto.ipc.BracketSide} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ipc.ReadyForTournamentGame} returns this
+ * @param {!proto.ipc.BracketSide} value
+ * @return {!proto.ipc.BracketMatch} returns this
  */
-proto.ipc.ReadyForTournamentGame.prototype.setDivision = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.ipc.BracketMatch.prototype.setSide = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
 };
 
 
 /**
- * optional int32 round = 3;
+ * optional int32 bracket_round = 3;
  * @return {number}
  */
-proto.ipc.ReadyForTournamentGame.prototype.getRound = function() {
+proto.ipc.BracketMatch.prototype.getBracketRound = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ipc.ReadyForTournamentGame} returns this
+ * @return {!proto.ipc.BracketMatch} returns this
  */
-proto.ipc.ReadyForTournamentGame.prototype.setRound = function(value) {
+proto.ipc.BracketMatch.prototype.setBracketRound = function(value) {
   return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * optional string player_id = 4;
- * @return {string}
+ * optional int32 round = 4;
+ * @return {number}
  */
-proto.ipc.ReadyForTournamentGame.prototype.getPlayerId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.ipc.BracketMatch.prototype.getRound = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ipc.ReadyForTournamentGame} returns this
+ * @param {number} value
+ * @return {!proto.ipc.BracketMatch} returns this
  */
-proto.ipc.ReadyForTournamentGame.prototype.setPlayerId = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.ipc.BracketMatch.prototype.setRound = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * optional int32 game_index = 5;
- * @return {number}
+ * repeated string players = 5;
+ * @return {!Array<string>}
  */
-proto.ipc.ReadyForTournamentGame.prototype.getGameIndex = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.ipc.BracketMatch.prototype.getPlayersList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.ipc.BracketMatch} returns this
+ */
+proto.ipc.BracketMatch.prototype.setPlayersList = function(value) {
+  return jspb.Message.setField(this, 5, value || []);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ipc.ReadyForTournamentGame} returns this
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.BracketMatch} returns this
  */
-proto.ipc.ReadyForTournamentGame.prototype.setGameIndex = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
+proto.ipc.BracketMatch.prototype.addPlayers = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
 };
 
 
 /**
- * optional bool unready = 6;
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ipc.BracketMatch} returns this
  */
-proto.ipc.ReadyForTournamentGame.prototype.getUnready = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+proto.ipc.BracketMatch.prototype.clearPlayersList = function() {
+  return this.setPlayersList([]);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.ipc.ReadyForTournamentGame} returns this
+ * optional string winner = 6;
+ * @return {string}
  */
-proto.ipc.ReadyForTournamentGame.prototype.setUnready = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 6, value);
+proto.ipc.BracketMatch.prototype.getWinner = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
+/**
+ * @param {string} value
+ * @return {!proto.ipc.BracketMatch} returns this
+ */
+proto.ipc.BracketMatch.prototype.setWinner = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional string loser = 7;
+ * @return {string}
  */
-proto.ipc.TournamentPerson.prototype.toObject = function(opt_includeInstance) {
-  return proto.ipc.TournamentPerson.toObject(opt_includeInstance, this);
+proto.ipc.BracketMatch.prototype.getLoser = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.ipc.TournamentPerson} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {string} value
+ * @return {!proto.ipc.BracketMatch} returns this
  */
-proto.ipc.TournamentPerson.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    rating: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    suspended: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.ipc.BracketMatch.prototype.setLoser = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ipc.TournamentPerson}
+ * optional string winner_to = 8;
+ * @return {string}
  */
-proto.ipc.TournamentPerson.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ipc.TournamentPerson;
-  return proto.ipc.TournamentPerson.deserializeBinaryFromReader(msg, reader);
+proto.ipc.BracketMatch.prototype.getWinnerTo = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.ipc.TournamentPerson} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ipc.TournamentPerson}
+ * @param {string} value
+ * @return {!proto.ipc.BracketMatch} returns this
  */
-proto.ipc.TournamentPerson.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setRating(value);
-      break;
-    case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSuspended(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.ipc.BracketMatch.prototype.setWinnerTo = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional int32 winner_slot = 9;
+ * @return {number}
  */
-proto.ipc.TournamentPerson.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.ipc.TournamentPerson.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.ipc.BracketMatch.prototype.getWinnerSlot = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.ipc.TournamentPerson} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {number} value
+ * @return {!proto.ipc.BracketMatch} returns this
  */
-proto.ipc.TournamentPerson.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getRating();
-  if (f !== 0) {
-    writer.writeInt32(
-      2,
-      f
-    );
-  }
-  f = message.getSuspended();
-  if (f) {
-    writer.writeBool(
-      3,
-      f
-    );
-  }
+proto.ipc.BracketMatch.prototype.setWinnerSlot = function(value) {
+  return jspb.Message.setProto3IntField(this, 9, value);
 };
 
 
 /**
- * optional string id = 1;
+ * optional string loser_to = 10;
  * @return {string}
  */
-proto.ipc.TournamentPerson.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.ipc.BracketMatch.prototype.getLoserTo = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ipc.TournamentPerson} returns this
+ * @return {!proto.ipc.BracketMatch} returns this
  */
-proto.ipc.TournamentPerson.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.ipc.BracketMatch.prototype.setLoserTo = function(value) {
+  return jspb.Message.setProto3StringField(this, 10, value);
 };
 
 
 /**
- * optional int32 rating = 2;
+ * optional int32 loser_slot = 11;
  * @return {number}
  */
-proto.ipc.TournamentPerson.prototype.getRating = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.ipc.BracketMatch.prototype.getLoserSlot = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ipc.TournamentPerson} returns this
+ * @return {!proto.ipc.BracketMatch} returns this
  */
-proto.ipc.TournamentPerson.prototype.setRating = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.ipc.BracketMatch.prototype.setLoserSlot = function(value) {
+  return jspb.Message.setProto3IntField(this, 11, value);
 };
 
 
 /**
- * optional bool suspended = 3;
- * @return {boolean}
+ * repeated TournamentGame games = 12;
+ * @return {!Array<!proto.ipc.TournamentGame>}
  */
-proto.ipc.TournamentPerson.prototype.getSuspended = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+proto.ipc.BracketMatch.prototype.getGamesList = function() {
+  return /** @type{!Array<!proto.ipc.TournamentGame>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ipc.TournamentGame, 12));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.ipc.TournamentPerson} returns this
+ * @param {!Array<!proto.ipc.TournamentGame>} value
+ * @return {!proto.ipc.BracketMatch} returns this
+*/
+proto.ipc.BracketMatch.prototype.setGamesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 12, value);
+};
+
+
+/**
+ * @param {!proto.ipc.TournamentGame=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.TournamentGame}
  */
-proto.ipc.TournamentPerson.prototype.setSuspended = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
+proto.ipc.BracketMatch.prototype.addGames = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 12, opt_value, proto.ipc.TournamentGame, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ipc.BracketMatch} returns this
+ */
+proto.ipc.BracketMatch.prototype.clearGamesList = function() {
+  return this.setGamesList([]);
 };
 
 
@@ -1783,7 +8011,7 @@ proto.ipc.TournamentPerson.prototype.setSuspended = function(value) {
  * @private {!Array<number>}
  * @const
  */
-proto.ipc.TournamentPersons.repeatedFields_ = [3];
+proto.ipc.BracketResponse.repeatedFields_ = [3,4];
 
 
 
@@ -1800,8 +8028,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ipc.TournamentPersons.prototype.toObject = function(opt_includeInstance) {
-  return proto.ipc.TournamentPersons.toObject(opt_includeInstance, this);
+proto.ipc.BracketResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.BracketResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1810,16 +8038,18 @@ proto.ipc.TournamentPersons.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ipc.TournamentPersons} msg The msg instance to transform.
+ * @param {!proto.ipc.BracketResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.TournamentPersons.toObject = function(includeInstance, msg) {
+proto.ipc.BracketResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, ""),
     division: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    personsList: jspb.Message.toObjectList(msg.getPersonsList(),
-    proto.ipc.TournamentPerson.toObject, includeInstance)
+    matchesList: jspb.Message.toObjectList(msg.getMatchesList(),
+    proto.ipc.BracketMatch.toObject, includeInstance),
+    placementsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
+    champion: jspb.Message.getFieldWithDefault(msg, 5, "")
   };
 
   if (includeInstance) {
@@ -1833,23 +8063,23 @@ proto.ipc.TournamentPersons.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ipc.TournamentPersons}
+ * @return {!proto.ipc.BracketResponse}
  */
-proto.ipc.TournamentPersons.deserializeBinary = function(bytes) {
+proto.ipc.BracketResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ipc.TournamentPersons;
-  return proto.ipc.TournamentPersons.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ipc.BracketResponse;
+  return proto.ipc.BracketResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ipc.TournamentPersons} msg The message object to deserialize into.
+ * @param {!proto.ipc.BracketResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ipc.TournamentPersons}
+ * @return {!proto.ipc.BracketResponse}
  */
-proto.ipc.TournamentPersons.deserializeBinaryFromReader = function(msg, reader) {
+proto.ipc.BracketResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1865,9 +8095,17 @@ proto.ipc.TournamentPersons.deserializeBinaryFromReader = function(msg, reader)
       msg.setDivision(value);
       break;
     case 3:
-      var value = new proto.ipc.TournamentPerson;
-      reader.readMessage(value,proto.ipc.TournamentPerson.deserializeBinaryFromReader);
-      msg.addPersons(value);
+      var value = new proto.ipc.BracketMatch;
+      reader.readMessage(value,proto.ipc.BracketMatch.deserializeBinaryFromReader);
+      msg.addMatches(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addPlacements(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setChampion(value);
       break;
     default:
       reader.skipField();
@@ -1882,9 +8120,9 @@ proto.ipc.TournamentPersons.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ipc.TournamentPersons.prototype.serializeBinary = function() {
+proto.ipc.BracketResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ipc.TournamentPersons.serializeBinaryToWriter(this, writer);
+  proto.ipc.BracketResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1892,11 +8130,11 @@ proto.ipc.TournamentPersons.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ipc.TournamentPersons} message
+ * @param {!proto.ipc.BracketResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.TournamentPersons.serializeBinaryToWriter = function(message, writer) {
+proto.ipc.BracketResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f.length > 0) {
@@ -1912,12 +8150,26 @@ proto.ipc.TournamentPersons.serializeBinaryToWriter = function(message, writer)
       f
     );
   }
-  f = message.getPersonsList();
+  f = message.getMatchesList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
       3,
       f,
-      proto.ipc.TournamentPerson.serializeBinaryToWriter
+      proto.ipc.BracketMatch.serializeBinaryToWriter
+    );
+  }
+  f = message.getPlacementsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      4,
+      f
+    );
+  }
+  f = message.getChampion();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
     );
   }
 };
@@ -1927,16 +8179,16 @@ proto.ipc.TournamentPersons.serializeBinaryToWriter = function(message, writer)
  * optional string id = 1;
  * @return {string}
  */
-proto.ipc.TournamentPersons.prototype.getId = function() {
+proto.ipc.BracketResponse.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ipc.TournamentPersons} returns this
+ * @return {!proto.ipc.BracketResponse} returns this
  */
-proto.ipc.TournamentPersons.prototype.setId = function(value) {
+proto.ipc.BracketResponse.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
@@ -1945,55 +8197,110 @@ proto.ipc.TournamentPersons.prototype.setId = function(value) {
  * optional string division = 2;
  * @return {string}
  */
-proto.ipc.TournamentPersons.prototype.getDivision = function() {
+proto.ipc.BracketResponse.prototype.getDivision = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ipc.TournamentPersons} returns this
+ * @param {string} value
+ * @return {!proto.ipc.BracketResponse} returns this
+ */
+proto.ipc.BracketResponse.prototype.setDivision = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * repeated BracketMatch matches = 3;
+ * @return {!Array<!proto.ipc.BracketMatch>}
+ */
+proto.ipc.BracketResponse.prototype.getMatchesList = function() {
+  return /** @type{!Array<!proto.ipc.BracketMatch>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ipc.BracketMatch, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.ipc.BracketMatch>} value
+ * @return {!proto.ipc.BracketResponse} returns this
+*/
+proto.ipc.BracketResponse.prototype.setMatchesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.ipc.BracketMatch=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.BracketMatch}
+ */
+proto.ipc.BracketResponse.prototype.addMatches = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.ipc.BracketMatch, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ipc.BracketResponse} returns this
+ */
+proto.ipc.BracketResponse.prototype.clearMatchesList = function() {
+  return this.setMatchesList([]);
+};
+
+
+/**
+ * repeated string placements = 4;
+ * @return {!Array<string>}
+ */
+proto.ipc.BracketResponse.prototype.getPlacementsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.ipc.BracketResponse} returns this
  */
-proto.ipc.TournamentPersons.prototype.setDivision = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.ipc.BracketResponse.prototype.setPlacementsList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
 };
 
 
 /**
- * repeated TournamentPerson persons = 3;
- * @return {!Array<!proto.ipc.TournamentPerson>}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.BracketResponse} returns this
  */
-proto.ipc.TournamentPersons.prototype.getPersonsList = function() {
-  return /** @type{!Array<!proto.ipc.TournamentPerson>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ipc.TournamentPerson, 3));
+proto.ipc.BracketResponse.prototype.addPlacements = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
 };
 
 
 /**
- * @param {!Array<!proto.ipc.TournamentPerson>} value
- * @return {!proto.ipc.TournamentPersons} returns this
-*/
-proto.ipc.TournamentPersons.prototype.setPersonsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ipc.BracketResponse} returns this
+ */
+proto.ipc.BracketResponse.prototype.clearPlacementsList = function() {
+  return this.setPlacementsList([]);
 };
 
 
 /**
- * @param {!proto.ipc.TournamentPerson=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ipc.TournamentPerson}
+ * optional string champion = 5;
+ * @return {string}
  */
-proto.ipc.TournamentPersons.prototype.addPersons = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.ipc.TournamentPerson, opt_index);
+proto.ipc.BracketResponse.prototype.getChampion = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ipc.TournamentPersons} returns this
+ * @param {string} value
+ * @return {!proto.ipc.BracketResponse} returns this
  */
-proto.ipc.TournamentPersons.prototype.clearPersonsList = function() {
-  return this.setPersonsList([]);
+proto.ipc.BracketResponse.prototype.setChampion = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
@@ -2013,8 +8320,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ipc.RoundControl.prototype.toObject = function(opt_includeInstance) {
-  return proto.ipc.RoundControl.toObject(opt_includeInstance, this);
+proto.ipc.PairingWeight.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.PairingWeight.toObject(opt_includeInstance, this);
 };
 
 
@@ -2023,22 +8330,18 @@ proto.ipc.RoundControl.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ipc.RoundControl} msg The msg instance to transform.
+ * @param {!proto.ipc.PairingWeight} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.RoundControl.toObject = function(includeInstance, msg) {
+proto.ipc.PairingWeight.toObject = function(includeInstance, msg) {
   var f, obj = {
-    pairingMethod: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    firstMethod: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    gamesPerRound: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    round: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    factor: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    initialFontes: jspb.Message.getFieldWithDefault(msg, 6, 0),
-    maxRepeats: jspb.Message.getFieldWithDefault(msg, 7, 0),
-    allowOverMaxRepeats: jspb.Message.getBooleanFieldWithDefault(msg, 8, false),
-    repeatRelativeWeight: jspb.Message.getFieldWithDefault(msg, 9, 0),
-    winDifferenceRelativeWeight: jspb.Message.getFieldWithDefault(msg, 10, 0)
+    winDifference: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    spread: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    repeat: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    firsts: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    total: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    contention: jspb.Message.getFieldWithDefault(msg, 6, 0)
   };
 
   if (includeInstance) {
@@ -2052,23 +8355,23 @@ proto.ipc.RoundControl.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ipc.RoundControl}
+ * @return {!proto.ipc.PairingWeight}
  */
-proto.ipc.RoundControl.deserializeBinary = function(bytes) {
+proto.ipc.PairingWeight.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ipc.RoundControl;
-  return proto.ipc.RoundControl.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ipc.PairingWeight;
+  return proto.ipc.PairingWeight.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ipc.RoundControl} msg The message object to deserialize into.
+ * @param {!proto.ipc.PairingWeight} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ipc.RoundControl}
+ * @return {!proto.ipc.PairingWeight}
  */
-proto.ipc.RoundControl.deserializeBinaryFromReader = function(msg, reader) {
+proto.ipc.PairingWeight.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2076,44 +8379,28 @@ proto.ipc.RoundControl.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!proto.ipc.PairingMethod} */ (reader.readEnum());
-      msg.setPairingMethod(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setWinDifference(value);
       break;
     case 2:
-      var value = /** @type {!proto.ipc.FirstMethod} */ (reader.readEnum());
-      msg.setFirstMethod(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setSpread(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setGamesPerRound(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setRepeat(value);
       break;
     case 4:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setRound(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setFirsts(value);
       break;
     case 5:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setFactor(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTotal(value);
       break;
     case 6:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setInitialFontes(value);
-      break;
-    case 7:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setMaxRepeats(value);
-      break;
-    case 8:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setAllowOverMaxRepeats(value);
-      break;
-    case 9:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setRepeatRelativeWeight(value);
-      break;
-    case 10:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setWinDifferenceRelativeWeight(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setContention(value);
       break;
     default:
       reader.skipField();
@@ -2128,9 +8415,9 @@ proto.ipc.RoundControl.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ipc.RoundControl.prototype.serializeBinary = function() {
+proto.ipc.PairingWeight.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ipc.RoundControl.serializeBinaryToWriter(this, writer);
+  proto.ipc.PairingWeight.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2138,266 +8425,363 @@ proto.ipc.RoundControl.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ipc.RoundControl} message
+ * @param {!proto.ipc.PairingWeight} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.RoundControl.serializeBinaryToWriter = function(message, writer) {
+proto.ipc.PairingWeight.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPairingMethod();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getWinDifference();
+  if (f !== 0) {
+    writer.writeInt64(
       1,
       f
     );
   }
-  f = message.getFirstMethod();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getSpread();
+  if (f !== 0) {
+    writer.writeInt64(
       2,
       f
     );
   }
-  f = message.getGamesPerRound();
+  f = message.getRepeat();
   if (f !== 0) {
-    writer.writeInt32(
+    writer.writeInt64(
       3,
       f
     );
   }
-  f = message.getRound();
+  f = message.getFirsts();
   if (f !== 0) {
-    writer.writeInt32(
+    writer.writeInt64(
       4,
       f
     );
   }
-  f = message.getFactor();
+  f = message.getTotal();
   if (f !== 0) {
-    writer.writeInt32(
+    writer.writeInt64(
       5,
       f
     );
   }
-  f = message.getInitialFontes();
+  f = message.getContention();
   if (f !== 0) {
-    writer.writeInt32(
+    writer.writeInt64(
       6,
       f
     );
   }
-  f = message.getMaxRepeats();
-  if (f !== 0) {
-    writer.writeInt32(
-      7,
-      f
-    );
-  }
-  f = message.getAllowOverMaxRepeats();
-  if (f) {
-    writer.writeBool(
-      8,
-      f
-    );
-  }
-  f = message.getRepeatRelativeWeight();
-  if (f !== 0) {
-    writer.writeInt32(
-      9,
-      f
-    );
-  }
-  f = message.getWinDifferenceRelativeWeight();
-  if (f !== 0) {
-    writer.writeInt32(
-      10,
-      f
-    );
-  }
 };
 
 
 /**
- * optional PairingMethod pairing_method = 1;
- * @return {!proto.ipc.PairingMethod}
+ * optional int64 win_difference = 1;
+ * @return {number}
  */
-proto.ipc.RoundControl.prototype.getPairingMethod = function() {
-  return /** @type {!proto.ipc.PairingMethod} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.ipc.PairingWeight.prototype.getWinDifference = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {!proto.ipc.PairingMethod} value
- * @return {!proto.ipc.RoundControl} returns this
+ * @param {number} value
+ * @return {!proto.ipc.PairingWeight} returns this
  */
-proto.ipc.RoundControl.prototype.setPairingMethod = function(value) {
-  return jspb.Message.setProto3EnumField(this, 1, value);
+proto.ipc.PairingWeight.prototype.setWinDifference = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional FirstMethod first_method = 2;
- * @return {!proto.ipc.FirstMethod}
+ * optional int64 spread = 2;
+ * @return {number}
  */
-proto.ipc.RoundControl.prototype.getFirstMethod = function() {
-  return /** @type {!proto.ipc.FirstMethod} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.ipc.PairingWeight.prototype.getSpread = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {!proto.ipc.FirstMethod} value
- * @return {!proto.ipc.RoundControl} returns this
+ * @param {number} value
+ * @return {!proto.ipc.PairingWeight} returns this
  */
-proto.ipc.RoundControl.prototype.setFirstMethod = function(value) {
-  return jspb.Message.setProto3EnumField(this, 2, value);
+proto.ipc.PairingWeight.prototype.setSpread = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional int32 games_per_round = 3;
+ * optional int64 repeat = 3;
  * @return {number}
  */
-proto.ipc.RoundControl.prototype.getGamesPerRound = function() {
+proto.ipc.PairingWeight.prototype.getRepeat = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ipc.RoundControl} returns this
+ * @return {!proto.ipc.PairingWeight} returns this
  */
-proto.ipc.RoundControl.prototype.setGamesPerRound = function(value) {
+proto.ipc.PairingWeight.prototype.setRepeat = function(value) {
   return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * optional int32 round = 4;
+ * optional int64 firsts = 4;
  * @return {number}
  */
-proto.ipc.RoundControl.prototype.getRound = function() {
+proto.ipc.PairingWeight.prototype.getFirsts = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ipc.RoundControl} returns this
+ * @return {!proto.ipc.PairingWeight} returns this
  */
-proto.ipc.RoundControl.prototype.setRound = function(value) {
+proto.ipc.PairingWeight.prototype.setFirsts = function(value) {
   return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * optional int32 factor = 5;
+ * optional int64 total = 5;
  * @return {number}
  */
-proto.ipc.RoundControl.prototype.getFactor = function() {
+proto.ipc.PairingWeight.prototype.getTotal = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ipc.RoundControl} returns this
+ * @return {!proto.ipc.PairingWeight} returns this
  */
-proto.ipc.RoundControl.prototype.setFactor = function(value) {
+proto.ipc.PairingWeight.prototype.setTotal = function(value) {
   return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
 /**
- * optional int32 initial_fontes = 6;
+ * optional int64 contention = 6;
  * @return {number}
  */
-proto.ipc.RoundControl.prototype.getInitialFontes = function() {
+proto.ipc.PairingWeight.prototype.getContention = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ipc.RoundControl} returns this
+ * @return {!proto.ipc.PairingWeight} returns this
  */
-proto.ipc.RoundControl.prototype.setInitialFontes = function(value) {
+proto.ipc.PairingWeight.prototype.setContention = function(value) {
   return jspb.Message.setProto3IntField(this, 6, value);
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ipc.PlayerContention.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.PlayerContention.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ipc.PlayerContention} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.PlayerContention.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    playerId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    rank: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    bestPlace: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ipc.PlayerContention}
+ */
+proto.ipc.PlayerContention.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ipc.PlayerContention;
+  return proto.ipc.PlayerContention.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ipc.PlayerContention} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ipc.PlayerContention}
+ */
+proto.ipc.PlayerContention.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPlayerId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRank(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setBestPlace(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
 /**
- * optional int32 max_repeats = 7;
- * @return {number}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.ipc.RoundControl.prototype.getMaxRepeats = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+proto.ipc.PlayerContention.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ipc.PlayerContention.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ipc.RoundControl} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ipc.PlayerContention} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.RoundControl.prototype.setMaxRepeats = function(value) {
-  return jspb.Message.setProto3IntField(this, 7, value);
+proto.ipc.PlayerContention.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPlayerId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getRank();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getBestPlace();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional bool allow_over_max_repeats = 8;
- * @return {boolean}
+ * optional string player_id = 1;
+ * @return {string}
  */
-proto.ipc.RoundControl.prototype.getAllowOverMaxRepeats = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
+proto.ipc.PlayerContention.prototype.getPlayerId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.ipc.RoundControl} returns this
+ * @param {string} value
+ * @return {!proto.ipc.PlayerContention} returns this
  */
-proto.ipc.RoundControl.prototype.setAllowOverMaxRepeats = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 8, value);
+proto.ipc.PlayerContention.prototype.setPlayerId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional int32 repeat_relative_weight = 9;
+ * optional int32 rank = 2;
  * @return {number}
  */
-proto.ipc.RoundControl.prototype.getRepeatRelativeWeight = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+proto.ipc.PlayerContention.prototype.getRank = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ipc.RoundControl} returns this
+ * @return {!proto.ipc.PlayerContention} returns this
  */
-proto.ipc.RoundControl.prototype.setRepeatRelativeWeight = function(value) {
-  return jspb.Message.setProto3IntField(this, 9, value);
+proto.ipc.PlayerContention.prototype.setRank = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional int32 win_difference_relative_weight = 10;
+ * optional int32 best_place = 3;
  * @return {number}
  */
-proto.ipc.RoundControl.prototype.getWinDifferenceRelativeWeight = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+proto.ipc.PlayerContention.prototype.getBestPlace = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ipc.RoundControl} returns this
+ * @return {!proto.ipc.PlayerContention} returns this
  */
-proto.ipc.RoundControl.prototype.setWinDifferenceRelativeWeight = function(value) {
-  return jspb.Message.setProto3IntField(this, 10, value);
+proto.ipc.PlayerContention.prototype.setBestPlace = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ipc.PairingPreview.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2413,8 +8797,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ipc.DivisionControls.prototype.toObject = function(opt_includeInstance) {
-  return proto.ipc.DivisionControls.toObject(opt_includeInstance, this);
+proto.ipc.PairingPreview.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.PairingPreview.toObject(opt_includeInstance, this);
 };
 
 
@@ -2423,23 +8807,18 @@ proto.ipc.DivisionControls.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ipc.DivisionControls} msg The msg instance to transform.
+ * @param {!proto.ipc.PairingPreview} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.DivisionControls.toObject = function(includeInstance, msg) {
+proto.ipc.PairingPreview.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    gameRequest: (f = msg.getGameRequest()) && api_proto_ipc_omgwords_pb.GameRequest.toObject(includeInstance, f),
-    suspendedResult: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    suspendedSpread: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    autoStart: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
-    spreadCap: jspb.Message.getFieldWithDefault(msg, 7, 0),
-    gibsonize: jspb.Message.getBooleanFieldWithDefault(msg, 8, false),
-    gibsonSpread: jspb.Message.getFieldWithDefault(msg, 9, 0),
-    minimumPlacement: jspb.Message.getFieldWithDefault(msg, 10, 0),
-    maximumByePlacement: jspb.Message.getFieldWithDefault(msg, 11, 0)
+    playersList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    previousGames: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    repeat: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    bye: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    gibson: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    weight: (f = msg.getWeight()) && proto.ipc.PairingWeight.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -2453,23 +8832,23 @@ proto.ipc.DivisionControls.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ipc.DivisionControls}
+ * @return {!proto.ipc.PairingPreview}
  */
-proto.ipc.DivisionControls.deserializeBinary = function(bytes) {
+proto.ipc.PairingPreview.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ipc.DivisionControls;
-  return proto.ipc.DivisionControls.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ipc.PairingPreview;
+  return proto.ipc.PairingPreview.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ipc.DivisionControls} msg The message object to deserialize into.
+ * @param {!proto.ipc.PairingPreview} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ipc.DivisionControls}
+ * @return {!proto.ipc.PairingPreview}
  */
-proto.ipc.DivisionControls.deserializeBinaryFromReader = function(msg, reader) {
+proto.ipc.PairingPreview.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2478,48 +8857,28 @@ proto.ipc.DivisionControls.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      msg.addPlayers(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDivision(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPreviousGames(value);
       break;
     case 3:
-      var value = new api_proto_ipc_omgwords_pb.GameRequest;
-      reader.readMessage(value,api_proto_ipc_omgwords_pb.GameRequest.deserializeBinaryFromReader);
-      msg.setGameRequest(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRepeat(value);
       break;
     case 4:
-      var value = /** @type {!proto.ipc.TournamentGameResult} */ (reader.readEnum());
-      msg.setSuspendedResult(value);
-      break;
-    case 5:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setSuspendedSpread(value);
-      break;
-    case 6:
       var value = /** @type {boolean} */ (reader.readBool());
-      msg.setAutoStart(value);
-      break;
-    case 7:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setSpreadCap(value);
+      msg.setBye(value);
       break;
-    case 8:
+    case 5:
       var value = /** @type {boolean} */ (reader.readBool());
-      msg.setGibsonize(value);
-      break;
-    case 9:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setGibsonSpread(value);
-      break;
-    case 10:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setMinimumPlacement(value);
+      msg.setGibson(value);
       break;
-    case 11:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setMaximumByePlacement(value);
+    case 6:
+      var value = new proto.ipc.PairingWeight;
+      reader.readMessage(value,proto.ipc.PairingWeight.deserializeBinaryFromReader);
+      msg.setWeight(value);
       break;
     default:
       reader.skipField();
@@ -2534,9 +8893,9 @@ proto.ipc.DivisionControls.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ipc.DivisionControls.prototype.serializeBinary = function() {
+proto.ipc.PairingPreview.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ipc.DivisionControls.serializeBinaryToWriter(this, writer);
+  proto.ipc.PairingPreview.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2544,307 +8903,201 @@ proto.ipc.DivisionControls.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ipc.DivisionControls} message
+ * @param {!proto.ipc.PairingPreview} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.DivisionControls.serializeBinaryToWriter = function(message, writer) {
+proto.ipc.PairingPreview.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getPlayersList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedString(
       1,
       f
     );
   }
-  f = message.getDivision();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getGameRequest();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      api_proto_ipc_omgwords_pb.GameRequest.serializeBinaryToWriter
-    );
-  }
-  f = message.getSuspendedResult();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      4,
-      f
-    );
-  }
-  f = message.getSuspendedSpread();
+  f = message.getPreviousGames();
   if (f !== 0) {
     writer.writeInt32(
-      5,
+      2,
       f
     );
   }
-  f = message.getAutoStart();
+  f = message.getRepeat();
   if (f) {
     writer.writeBool(
-      6,
-      f
-    );
-  }
-  f = message.getSpreadCap();
-  if (f !== 0) {
-    writer.writeInt32(
-      7,
+      3,
       f
     );
   }
-  f = message.getGibsonize();
+  f = message.getBye();
   if (f) {
     writer.writeBool(
-      8,
-      f
-    );
-  }
-  f = message.getGibsonSpread();
-  if (f !== 0) {
-    writer.writeInt32(
-      9,
-      f
-    );
-  }
-  f = message.getMinimumPlacement();
-  if (f !== 0) {
-    writer.writeInt32(
-      10,
-      f
-    );
-  }
-  f = message.getMaximumByePlacement();
-  if (f !== 0) {
-    writer.writeInt32(
-      11,
+      4,
       f
     );
   }
+  f = message.getGibson();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = message.getWeight();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.ipc.PairingWeight.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional string id = 1;
- * @return {string}
- */
-proto.ipc.DivisionControls.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.ipc.DivisionControls} returns this
- */
-proto.ipc.DivisionControls.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * optional string division = 2;
- * @return {string}
- */
-proto.ipc.DivisionControls.prototype.getDivision = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.ipc.DivisionControls} returns this
- */
-proto.ipc.DivisionControls.prototype.setDivision = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
-/**
- * optional GameRequest game_request = 3;
- * @return {?proto.ipc.GameRequest}
- */
-proto.ipc.DivisionControls.prototype.getGameRequest = function() {
-  return /** @type{?proto.ipc.GameRequest} */ (
-    jspb.Message.getWrapperField(this, api_proto_ipc_omgwords_pb.GameRequest, 3));
-};
-
-
-/**
- * @param {?proto.ipc.GameRequest|undefined} value
- * @return {!proto.ipc.DivisionControls} returns this
-*/
-proto.ipc.DivisionControls.prototype.setGameRequest = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.ipc.DivisionControls} returns this
+ * repeated string players = 1;
+ * @return {!Array<string>}
  */
-proto.ipc.DivisionControls.prototype.clearGameRequest = function() {
-  return this.setGameRequest(undefined);
+proto.ipc.PairingPreview.prototype.getPlayersList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {!Array<string>} value
+ * @return {!proto.ipc.PairingPreview} returns this
  */
-proto.ipc.DivisionControls.prototype.hasGameRequest = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.ipc.PairingPreview.prototype.setPlayersList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
- * optional TournamentGameResult suspended_result = 4;
- * @return {!proto.ipc.TournamentGameResult}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.PairingPreview} returns this
  */
-proto.ipc.DivisionControls.prototype.getSuspendedResult = function() {
-  return /** @type {!proto.ipc.TournamentGameResult} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.ipc.PairingPreview.prototype.addPlayers = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
- * @param {!proto.ipc.TournamentGameResult} value
- * @return {!proto.ipc.DivisionControls} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ipc.PairingPreview} returns this
  */
-proto.ipc.DivisionControls.prototype.setSuspendedResult = function(value) {
-  return jspb.Message.setProto3EnumField(this, 4, value);
+proto.ipc.PairingPreview.prototype.clearPlayersList = function() {
+  return this.setPlayersList([]);
 };
 
 
 /**
- * optional int32 suspended_spread = 5;
+ * optional int32 previous_games = 2;
  * @return {number}
  */
-proto.ipc.DivisionControls.prototype.getSuspendedSpread = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.ipc.PairingPreview.prototype.getPreviousGames = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ipc.DivisionControls} returns this
+ * @return {!proto.ipc.PairingPreview} returns this
  */
-proto.ipc.DivisionControls.prototype.setSuspendedSpread = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
+proto.ipc.PairingPreview.prototype.setPreviousGames = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional bool auto_start = 6;
+ * optional bool repeat = 3;
  * @return {boolean}
  */
-proto.ipc.DivisionControls.prototype.getAutoStart = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+proto.ipc.PairingPreview.prototype.getRepeat = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
 };
 
 
 /**
  * @param {boolean} value
- * @return {!proto.ipc.DivisionControls} returns this
- */
-proto.ipc.DivisionControls.prototype.setAutoStart = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 6, value);
-};
-
-
-/**
- * optional int32 spread_cap = 7;
- * @return {number}
- */
-proto.ipc.DivisionControls.prototype.getSpreadCap = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.ipc.DivisionControls} returns this
+ * @return {!proto.ipc.PairingPreview} returns this
  */
-proto.ipc.DivisionControls.prototype.setSpreadCap = function(value) {
-  return jspb.Message.setProto3IntField(this, 7, value);
+proto.ipc.PairingPreview.prototype.setRepeat = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
 };
 
 
 /**
- * optional bool gibsonize = 8;
+ * optional bool bye = 4;
  * @return {boolean}
  */
-proto.ipc.DivisionControls.prototype.getGibsonize = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
+proto.ipc.PairingPreview.prototype.getBye = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
 };
 
 
 /**
  * @param {boolean} value
- * @return {!proto.ipc.DivisionControls} returns this
+ * @return {!proto.ipc.PairingPreview} returns this
  */
-proto.ipc.DivisionControls.prototype.setGibsonize = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 8, value);
+proto.ipc.PairingPreview.prototype.setBye = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
 };
 
 
 /**
- * optional int32 gibson_spread = 9;
- * @return {number}
+ * optional bool gibson = 5;
+ * @return {boolean}
  */
-proto.ipc.DivisionControls.prototype.getGibsonSpread = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+proto.ipc.PairingPreview.prototype.getGibson = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ipc.DivisionControls} returns this
+ * @param {boolean} value
+ * @return {!proto.ipc.PairingPreview} returns this
  */
-proto.ipc.DivisionControls.prototype.setGibsonSpread = function(value) {
-  return jspb.Message.setProto3IntField(this, 9, value);
+proto.ipc.PairingPreview.prototype.setGibson = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
 };
 
 
 /**
- * optional int32 minimum_placement = 10;
- * @return {number}
+ * optional PairingWeight weight = 6;
+ * @return {?proto.ipc.PairingWeight}
  */
-proto.ipc.DivisionControls.prototype.getMinimumPlacement = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+proto.ipc.PairingPreview.prototype.getWeight = function() {
+  return /** @type{?proto.ipc.PairingWeight} */ (
+    jspb.Message.getWrapperField(this, proto.ipc.PairingWeight, 6));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ipc.DivisionControls} returns this
- */
-proto.ipc.DivisionControls.prototype.setMinimumPlacement = function(value) {
-  return jspb.Message.setProto3IntField(this, 10, value);
+ * @param {?proto.ipc.PairingWeight|undefined} value
+ * @return {!proto.ipc.PairingPreview} returns this
+*/
+proto.ipc.PairingPreview.prototype.setWeight = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
 };
 
 
 /**
- * optional int32 maximum_bye_placement = 11;
- * @return {number}
+ * Clears the message field making it undefined.
+ * @return {!proto.ipc.PairingPreview} returns this
  */
-proto.ipc.DivisionControls.prototype.getMaximumByePlacement = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+proto.ipc.PairingPreview.prototype.clearWeight = function() {
+  return this.setWeight(undefined);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ipc.DivisionControls} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.ipc.DivisionControls.prototype.setMaximumByePlacement = function(value) {
-  return jspb.Message.setProto3IntField(this, 11, value);
+proto.ipc.PairingPreview.prototype.hasWeight = function() {
+  return jspb.Message.getField(this, 6) != null;
 };
 
 
@@ -2854,7 +9107,7 @@ proto.ipc.DivisionControls.prototype.setMaximumByePlacement = function(value) {
  * @private {!Array<number>}
  * @const
  */
-proto.ipc.TournamentGame.repeatedFields_ = [1,2];
+proto.ipc.PairingPreviewResponse.repeatedFields_ = [5,6,8];
 
 
 
@@ -2871,8 +9124,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ipc.TournamentGame.prototype.toObject = function(opt_includeInstance) {
-  return proto.ipc.TournamentGame.toObject(opt_includeInstance, this);
+proto.ipc.PairingPreviewResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.PairingPreviewResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2881,16 +9134,22 @@ proto.ipc.TournamentGame.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ipc.TournamentGame} msg The msg instance to transform.
+ * @param {!proto.ipc.PairingPreviewResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.TournamentGame.toObject = function(includeInstance, msg) {
+proto.ipc.PairingPreviewResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    scoresList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
-    resultsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
-    gameEndReason: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    id: jspb.Message.getFieldWithDefault(msg, 4, "")
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    round: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    roundControls: (f = msg.getRoundControls()) && proto.ipc.RoundControl.toObject(includeInstance, f),
+    pairingsList: jspb.Message.toObjectList(msg.getPairingsList(),
+    proto.ipc.PairingPreview.toObject, includeInstance),
+    gibsonizedPlayersList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f,
+    totalWeight: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    contentionList: jspb.Message.toObjectList(msg.getContentionList(),
+    proto.ipc.PlayerContention.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -2904,23 +9163,23 @@ proto.ipc.TournamentGame.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ipc.TournamentGame}
+ * @return {!proto.ipc.PairingPreviewResponse}
  */
-proto.ipc.TournamentGame.deserializeBinary = function(bytes) {
+proto.ipc.PairingPreviewResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ipc.TournamentGame;
-  return proto.ipc.TournamentGame.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ipc.PairingPreviewResponse;
+  return proto.ipc.PairingPreviewResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ipc.TournamentGame} msg The message object to deserialize into.
+ * @param {!proto.ipc.PairingPreviewResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ipc.TournamentGame}
+ * @return {!proto.ipc.PairingPreviewResponse}
  */
-proto.ipc.TournamentGame.deserializeBinaryFromReader = function(msg, reader) {
+proto.ipc.PairingPreviewResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2928,24 +9187,39 @@ proto.ipc.TournamentGame.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var values = /** @type {!Array<number>} */ (reader.isDelimited() ? reader.readPackedInt32() : [reader.readInt32()]);
-      for (var i = 0; i < values.length; i++) {
-        msg.addScores(values[i]);
-      }
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
       break;
     case 2:
-      var values = /** @type {!Array<!proto.ipc.TournamentGameResult>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
-      for (var i = 0; i < values.length; i++) {
-        msg.addResults(values[i]);
-      }
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDivision(value);
       break;
     case 3:
-      var value = /** @type {!proto.ipc.GameEndReason} */ (reader.readEnum());
-      msg.setGameEndReason(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRound(value);
       break;
     case 4:
+      var value = new proto.ipc.RoundControl;
+      reader.readMessage(value,proto.ipc.RoundControl.deserializeBinaryFromReader);
+      msg.setRoundControls(value);
+      break;
+    case 5:
+      var value = new proto.ipc.PairingPreview;
+      reader.readMessage(value,proto.ipc.PairingPreview.deserializeBinaryFromReader);
+      msg.addPairings(value);
+      break;
+    case 6:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      msg.addGibsonizedPlayers(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTotalWeight(value);
+      break;
+    case 8:
+      var value = new proto.ipc.PlayerContention;
+      reader.readMessage(value,proto.ipc.PlayerContention.deserializeBinaryFromReader);
+      msg.addContention(value);
       break;
     default:
       reader.skipField();
@@ -2957,173 +9231,309 @@ proto.ipc.TournamentGame.deserializeBinaryFromReader = function(msg, reader) {
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ipc.PairingPreviewResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ipc.PairingPreviewResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ipc.PairingPreviewResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.PairingPreviewResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDivision();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getRound();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getRoundControls();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      proto.ipc.RoundControl.serializeBinaryToWriter
+    );
+  }
+  f = message.getPairingsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      5,
+      f,
+      proto.ipc.PairingPreview.serializeBinaryToWriter
+    );
+  }
+  f = message.getGibsonizedPlayersList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      6,
+      f
+    );
+  }
+  f = message.getTotalWeight();
+  if (f !== 0) {
+    writer.writeInt64(
+      7,
+      f
+    );
+  }
+  f = message.getContentionList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      8,
+      f,
+      proto.ipc.PlayerContention.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.ipc.PairingPreviewResponse.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.PairingPreviewResponse} returns this
+ */
+proto.ipc.PairingPreviewResponse.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string division = 2;
+ * @return {string}
+ */
+proto.ipc.PairingPreviewResponse.prototype.getDivision = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.PairingPreviewResponse} returns this
+ */
+proto.ipc.PairingPreviewResponse.prototype.setDivision = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional int32 round = 3;
+ * @return {number}
+ */
+proto.ipc.PairingPreviewResponse.prototype.getRound = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.PairingPreviewResponse} returns this
  */
-proto.ipc.TournamentGame.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.ipc.TournamentGame.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.ipc.PairingPreviewResponse.prototype.setRound = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.ipc.TournamentGame} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional RoundControl round_controls = 4;
+ * @return {?proto.ipc.RoundControl}
  */
-proto.ipc.TournamentGame.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getScoresList();
-  if (f.length > 0) {
-    writer.writePackedInt32(
-      1,
-      f
-    );
-  }
-  f = message.getResultsList();
-  if (f.length > 0) {
-    writer.writePackedEnum(
-      2,
-      f
-    );
-  }
-  f = message.getGameEndReason();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      3,
-      f
-    );
-  }
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
+proto.ipc.PairingPreviewResponse.prototype.getRoundControls = function() {
+  return /** @type{?proto.ipc.RoundControl} */ (
+    jspb.Message.getWrapperField(this, proto.ipc.RoundControl, 4));
 };
 
 
 /**
- * repeated int32 scores = 1;
- * @return {!Array<number>}
+ * @param {?proto.ipc.RoundControl|undefined} value
+ * @return {!proto.ipc.PairingPreviewResponse} returns this
+*/
+proto.ipc.PairingPreviewResponse.prototype.setRoundControls = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ipc.PairingPreviewResponse} returns this
  */
-proto.ipc.TournamentGame.prototype.getScoresList = function() {
-  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.ipc.PairingPreviewResponse.prototype.clearRoundControls = function() {
+  return this.setRoundControls(undefined);
 };
 
 
 /**
- * @param {!Array<number>} value
- * @return {!proto.ipc.TournamentGame} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.ipc.TournamentGame.prototype.setScoresList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.ipc.PairingPreviewResponse.prototype.hasRoundControls = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
 /**
- * @param {number} value
+ * repeated PairingPreview pairings = 5;
+ * @return {!Array<!proto.ipc.PairingPreview>}
+ */
+proto.ipc.PairingPreviewResponse.prototype.getPairingsList = function() {
+  return /** @type{!Array<!proto.ipc.PairingPreview>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ipc.PairingPreview, 5));
+};
+
+
+/**
+ * @param {!Array<!proto.ipc.PairingPreview>} value
+ * @return {!proto.ipc.PairingPreviewResponse} returns this
+*/
+proto.ipc.PairingPreviewResponse.prototype.setPairingsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 5, value);
+};
+
+
+/**
+ * @param {!proto.ipc.PairingPreview=} opt_value
  * @param {number=} opt_index
- * @return {!proto.ipc.TournamentGame} returns this
+ * @return {!proto.ipc.PairingPreview}
  */
-proto.ipc.TournamentGame.prototype.addScores = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.ipc.PairingPreviewResponse.prototype.addPairings = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.ipc.PairingPreview, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.ipc.TournamentGame} returns this
+ * @return {!proto.ipc.PairingPreviewResponse} returns this
  */
-proto.ipc.TournamentGame.prototype.clearScoresList = function() {
-  return this.setScoresList([]);
+proto.ipc.PairingPreviewResponse.prototype.clearPairingsList = function() {
+  return this.setPairingsList([]);
 };
 
 
 /**
- * repeated TournamentGameResult results = 2;
- * @return {!Array<!proto.ipc.TournamentGameResult>}
+ * repeated string gibsonized_players = 6;
+ * @return {!Array<string>}
  */
-proto.ipc.TournamentGame.prototype.getResultsList = function() {
-  return /** @type {!Array<!proto.ipc.TournamentGameResult>} */ (jspb.Message.getRepeatedField(this, 2));
+proto.ipc.PairingPreviewResponse.prototype.getGibsonizedPlayersList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 6));
 };
 
 
 /**
- * @param {!Array<!proto.ipc.TournamentGameResult>} value
- * @return {!proto.ipc.TournamentGame} returns this
+ * @param {!Array<string>} value
+ * @return {!proto.ipc.PairingPreviewResponse} returns this
  */
-proto.ipc.TournamentGame.prototype.setResultsList = function(value) {
-  return jspb.Message.setField(this, 2, value || []);
+proto.ipc.PairingPreviewResponse.prototype.setGibsonizedPlayersList = function(value) {
+  return jspb.Message.setField(this, 6, value || []);
 };
 
 
 /**
- * @param {!proto.ipc.TournamentGameResult} value
+ * @param {string} value
  * @param {number=} opt_index
- * @return {!proto.ipc.TournamentGame} returns this
+ * @return {!proto.ipc.PairingPreviewResponse} returns this
  */
-proto.ipc.TournamentGame.prototype.addResults = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+proto.ipc.PairingPreviewResponse.prototype.addGibsonizedPlayers = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.ipc.TournamentGame} returns this
+ * @return {!proto.ipc.PairingPreviewResponse} returns this
  */
-proto.ipc.TournamentGame.prototype.clearResultsList = function() {
-  return this.setResultsList([]);
+proto.ipc.PairingPreviewResponse.prototype.clearGibsonizedPlayersList = function() {
+  return this.setGibsonizedPlayersList([]);
 };
 
 
 /**
- * optional GameEndReason game_end_reason = 3;
- * @return {!proto.ipc.GameEndReason}
+ * optional int64 total_weight = 7;
+ * @return {number}
  */
-proto.ipc.TournamentGame.prototype.getGameEndReason = function() {
-  return /** @type {!proto.ipc.GameEndReason} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.ipc.PairingPreviewResponse.prototype.getTotalWeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
 };
 
 
 /**
- * @param {!proto.ipc.GameEndReason} value
- * @return {!proto.ipc.TournamentGame} returns this
+ * @param {number} value
+ * @return {!proto.ipc.PairingPreviewResponse} returns this
  */
-proto.ipc.TournamentGame.prototype.setGameEndReason = function(value) {
-  return jspb.Message.setProto3EnumField(this, 3, value);
+proto.ipc.PairingPreviewResponse.prototype.setTotalWeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
 };
 
 
 /**
- * optional string id = 4;
- * @return {string}
+ * repeated PlayerContention contention = 8;
+ * @return {!Array<!proto.ipc.PlayerContention>}
  */
-proto.ipc.TournamentGame.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.ipc.PairingPreviewResponse.prototype.getContentionList = function() {
+  return /** @type{!Array<!proto.ipc.PlayerContention>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ipc.PlayerContention, 8));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ipc.TournamentGame} returns this
- */
-proto.ipc.TournamentGame.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+ * @param {!Array<!proto.ipc.PlayerContention>} value
+ * @return {!proto.ipc.PairingPreviewResponse} returns this
+*/
+proto.ipc.PairingPreviewResponse.prototype.setContentionList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 8, value);
 };
 
 
+/**
+ * @param {!proto.ipc.PlayerContention=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.PlayerContention}
+ */
+proto.ipc.PairingPreviewResponse.prototype.addContention = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 8, opt_value, proto.ipc.PlayerContention, opt_index);
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ipc.PairingPreviewResponse} returns this
  */
-proto.ipc.Pairing.repeatedFields_ = [1,3,4,5];
+proto.ipc.PairingPreviewResponse.prototype.clearContentionList = function() {
+  return this.setContentionList([]);
+};
+
+
 
 
 
@@ -3140,8 +9550,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ipc.Pairing.prototype.toObject = function(opt_includeInstance) {
-  return proto.ipc.Pairing.toObject(opt_includeInstance, this);
+proto.ipc.LadderChallenge.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.LadderChallenge.toObject(opt_includeInstance, this);
 };
 
 
@@ -3150,18 +9560,19 @@ proto.ipc.Pairing.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ipc.Pairing} msg The msg instance to transform.
+ * @param {!proto.ipc.LadderChallenge} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.Pairing.toObject = function(includeInstance, msg) {
+proto.ipc.LadderChallenge.toObject = function(includeInstance, msg) {
   var f, obj = {
-    playersList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
-    round: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    gamesList: jspb.Message.toObjectList(msg.getGamesList(),
-    proto.ipc.TournamentGame.toObject, includeInstance),
-    outcomesList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
-    readyStatesList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    challenger: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    challenged: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    createdAt: (f = msg.getCreatedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    deadline: (f = msg.getDeadline()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    gameId: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    acceptedAt: (f = msg.getAcceptedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -3175,23 +9586,23 @@ proto.ipc.Pairing.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ipc.Pairing}
+ * @return {!proto.ipc.LadderChallenge}
  */
-proto.ipc.Pairing.deserializeBinary = function(bytes) {
+proto.ipc.LadderChallenge.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ipc.Pairing;
-  return proto.ipc.Pairing.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ipc.LadderChallenge;
+  return proto.ipc.LadderChallenge.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ipc.Pairing} msg The message object to deserialize into.
+ * @param {!proto.ipc.LadderChallenge} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ipc.Pairing}
+ * @return {!proto.ipc.LadderChallenge}
  */
-proto.ipc.Pairing.deserializeBinaryFromReader = function(msg, reader) {
+proto.ipc.LadderChallenge.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3199,29 +9610,35 @@ proto.ipc.Pairing.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var values = /** @type {!Array<number>} */ (reader.isDelimited() ? reader.readPackedInt32() : [reader.readInt32()]);
-      for (var i = 0; i < values.length; i++) {
-        msg.addPlayers(values[i]);
-      }
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setRound(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setChallenger(value);
       break;
     case 3:
-      var value = new proto.ipc.TournamentGame;
-      reader.readMessage(value,proto.ipc.TournamentGame.deserializeBinaryFromReader);
-      msg.addGames(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setChallenged(value);
       break;
     case 4:
-      var values = /** @type {!Array<!proto.ipc.TournamentGameResult>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
-      for (var i = 0; i < values.length; i++) {
-        msg.addOutcomes(values[i]);
-      }
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCreatedAt(value);
       break;
     case 5:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setDeadline(value);
+      break;
+    case 6:
       var value = /** @type {string} */ (reader.readString());
-      msg.addReadyStates(value);
+      msg.setGameId(value);
+      break;
+    case 7:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setAcceptedAt(value);
       break;
     default:
       reader.skipField();
@@ -3236,9 +9653,9 @@ proto.ipc.Pairing.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ipc.Pairing.prototype.serializeBinary = function() {
+proto.ipc.LadderChallenge.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ipc.Pairing.serializeBinaryToWriter(this, writer);
+  proto.ipc.LadderChallenge.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3246,219 +9663,258 @@ proto.ipc.Pairing.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ipc.Pairing} message
+ * @param {!proto.ipc.LadderChallenge} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.Pairing.serializeBinaryToWriter = function(message, writer) {
+proto.ipc.LadderChallenge.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPlayersList();
+  f = message.getId();
   if (f.length > 0) {
-    writer.writePackedInt32(
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getRound();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getChallenger();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
   }
-  f = message.getGamesList();
+  f = message.getChallenged();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       3,
-      f,
-      proto.ipc.TournamentGame.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getOutcomesList();
-  if (f.length > 0) {
-    writer.writePackedEnum(
+  f = message.getCreatedAt();
+  if (f != null) {
+    writer.writeMessage(
       4,
-      f
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
-  f = message.getReadyStatesList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
+  f = message.getDeadline();
+  if (f != null) {
+    writer.writeMessage(
       5,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getGameId();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
       f
     );
   }
+  f = message.getAcceptedAt();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * repeated int32 players = 1;
- * @return {!Array<number>}
+ * optional string id = 1;
+ * @return {string}
  */
-proto.ipc.Pairing.prototype.getPlayersList = function() {
-  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.ipc.LadderChallenge.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!Array<number>} value
- * @return {!proto.ipc.Pairing} returns this
+ * @param {string} value
+ * @return {!proto.ipc.LadderChallenge} returns this
+ */
+proto.ipc.LadderChallenge.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string challenger = 2;
+ * @return {string}
+ */
+proto.ipc.LadderChallenge.prototype.getChallenger = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.LadderChallenge} returns this
  */
-proto.ipc.Pairing.prototype.setPlayersList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.ipc.LadderChallenge.prototype.setChallenger = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * @param {number} value
- * @param {number=} opt_index
- * @return {!proto.ipc.Pairing} returns this
+ * optional string challenged = 3;
+ * @return {string}
  */
-proto.ipc.Pairing.prototype.addPlayers = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.ipc.LadderChallenge.prototype.getChallenged = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ipc.Pairing} returns this
+ * @param {string} value
+ * @return {!proto.ipc.LadderChallenge} returns this
  */
-proto.ipc.Pairing.prototype.clearPlayersList = function() {
-  return this.setPlayersList([]);
+proto.ipc.LadderChallenge.prototype.setChallenged = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional int32 round = 2;
- * @return {number}
+ * optional google.protobuf.Timestamp created_at = 4;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.ipc.Pairing.prototype.getRound = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.ipc.LadderChallenge.prototype.getCreatedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 4));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ipc.Pairing} returns this
- */
-proto.ipc.Pairing.prototype.setRound = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.ipc.LadderChallenge} returns this
+*/
+proto.ipc.LadderChallenge.prototype.setCreatedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
 };
 
 
 /**
- * repeated TournamentGame games = 3;
- * @return {!Array<!proto.ipc.TournamentGame>}
+ * Clears the message field making it undefined.
+ * @return {!proto.ipc.LadderChallenge} returns this
  */
-proto.ipc.Pairing.prototype.getGamesList = function() {
-  return /** @type{!Array<!proto.ipc.TournamentGame>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ipc.TournamentGame, 3));
+proto.ipc.LadderChallenge.prototype.clearCreatedAt = function() {
+  return this.setCreatedAt(undefined);
 };
 
 
 /**
- * @param {!Array<!proto.ipc.TournamentGame>} value
- * @return {!proto.ipc.Pairing} returns this
-*/
-proto.ipc.Pairing.prototype.setGamesList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ipc.LadderChallenge.prototype.hasCreatedAt = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
 /**
- * @param {!proto.ipc.TournamentGame=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ipc.TournamentGame}
+ * optional google.protobuf.Timestamp deadline = 5;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.ipc.Pairing.prototype.addGames = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.ipc.TournamentGame, opt_index);
+proto.ipc.LadderChallenge.prototype.getDeadline = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 5));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ipc.Pairing} returns this
- */
-proto.ipc.Pairing.prototype.clearGamesList = function() {
-  return this.setGamesList([]);
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.ipc.LadderChallenge} returns this
+*/
+proto.ipc.LadderChallenge.prototype.setDeadline = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
 };
 
 
 /**
- * repeated TournamentGameResult outcomes = 4;
- * @return {!Array<!proto.ipc.TournamentGameResult>}
+ * Clears the message field making it undefined.
+ * @return {!proto.ipc.LadderChallenge} returns this
  */
-proto.ipc.Pairing.prototype.getOutcomesList = function() {
-  return /** @type {!Array<!proto.ipc.TournamentGameResult>} */ (jspb.Message.getRepeatedField(this, 4));
+proto.ipc.LadderChallenge.prototype.clearDeadline = function() {
+  return this.setDeadline(undefined);
 };
 
 
 /**
- * @param {!Array<!proto.ipc.TournamentGameResult>} value
- * @return {!proto.ipc.Pairing} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.ipc.Pairing.prototype.setOutcomesList = function(value) {
-  return jspb.Message.setField(this, 4, value || []);
+proto.ipc.LadderChallenge.prototype.hasDeadline = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
 
 
 /**
- * @param {!proto.ipc.TournamentGameResult} value
- * @param {number=} opt_index
- * @return {!proto.ipc.Pairing} returns this
+ * optional string game_id = 6;
+ * @return {string}
  */
-proto.ipc.Pairing.prototype.addOutcomes = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+proto.ipc.LadderChallenge.prototype.getGameId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ipc.Pairing} returns this
+ * @param {string} value
+ * @return {!proto.ipc.LadderChallenge} returns this
  */
-proto.ipc.Pairing.prototype.clearOutcomesList = function() {
-  return this.setOutcomesList([]);
+proto.ipc.LadderChallenge.prototype.setGameId = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
 /**
- * repeated string ready_states = 5;
- * @return {!Array<string>}
+ * optional google.protobuf.Timestamp accepted_at = 7;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.ipc.Pairing.prototype.getReadyStatesList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
+proto.ipc.LadderChallenge.prototype.getAcceptedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 7));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.ipc.Pairing} returns this
- */
-proto.ipc.Pairing.prototype.setReadyStatesList = function(value) {
-  return jspb.Message.setField(this, 5, value || []);
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.ipc.LadderChallenge} returns this
+*/
+proto.ipc.LadderChallenge.prototype.setAcceptedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.ipc.Pairing} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.ipc.LadderChallenge} returns this
  */
-proto.ipc.Pairing.prototype.addReadyStates = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
+proto.ipc.LadderChallenge.prototype.clearAcceptedAt = function() {
+  return this.setAcceptedAt(undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ipc.Pairing} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.ipc.Pairing.prototype.clearReadyStatesList = function() {
-  return this.setReadyStatesList([]);
+proto.ipc.LadderChallenge.prototype.hasAcceptedAt = function() {
+  return jspb.Message.getField(this, 7) != null;
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ipc.Ladder.repeatedFields_ = [2,5];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -3474,8 +9930,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ipc.PlayerStanding.prototype.toObject = function(opt_includeInstance) {
-  return proto.ipc.PlayerStanding.toObject(opt_includeInstance, this);
+proto.ipc.Ladder.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.Ladder.toObject(opt_includeInstance, this);
 };
 
 
@@ -3484,18 +9940,19 @@ proto.ipc.PlayerStanding.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ipc.PlayerStanding} msg The msg instance to transform.
+ * @param {!proto.ipc.Ladder} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.PlayerStanding.toObject = function(includeInstance, msg) {
+proto.ipc.Ladder.toObject = function(includeInstance, msg) {
   var f, obj = {
-    playerId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    wins: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    losses: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    draws: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    spread: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    gibsonized: jspb.Message.getBooleanFieldWithDefault(msg, 6, false)
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    playersList: jspb.Message.toObjectList(msg.getPlayersList(),
+    proto.ipc.TournamentPerson.toObject, includeInstance),
+    maxChallengeDistance: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    acceptSeconds: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    challengesList: jspb.Message.toObjectList(msg.getChallengesList(),
+    proto.ipc.LadderChallenge.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -3509,23 +9966,23 @@ proto.ipc.PlayerStanding.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ipc.PlayerStanding}
+ * @return {!proto.ipc.Ladder}
  */
-proto.ipc.PlayerStanding.deserializeBinary = function(bytes) {
+proto.ipc.Ladder.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ipc.PlayerStanding;
-  return proto.ipc.PlayerStanding.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ipc.Ladder;
+  return proto.ipc.Ladder.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ipc.PlayerStanding} msg The message object to deserialize into.
+ * @param {!proto.ipc.Ladder} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ipc.PlayerStanding}
+ * @return {!proto.ipc.Ladder}
  */
-proto.ipc.PlayerStanding.deserializeBinaryFromReader = function(msg, reader) {
+proto.ipc.Ladder.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3534,27 +9991,25 @@ proto.ipc.PlayerStanding.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPlayerId(value);
+      msg.setId(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setWins(value);
+      var value = new proto.ipc.TournamentPerson;
+      reader.readMessage(value,proto.ipc.TournamentPerson.deserializeBinaryFromReader);
+      msg.addPlayers(value);
       break;
     case 3:
       var value = /** @type {number} */ (reader.readInt32());
-      msg.setLosses(value);
+      msg.setMaxChallengeDistance(value);
       break;
     case 4:
       var value = /** @type {number} */ (reader.readInt32());
-      msg.setDraws(value);
+      msg.setAcceptSeconds(value);
       break;
     case 5:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setSpread(value);
-      break;
-    case 6:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setGibsonized(value);
+      var value = new proto.ipc.LadderChallenge;
+      reader.readMessage(value,proto.ipc.LadderChallenge.deserializeBinaryFromReader);
+      msg.addChallenges(value);
       break;
     default:
       reader.skipField();
@@ -3569,9 +10024,9 @@ proto.ipc.PlayerStanding.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ipc.PlayerStanding.prototype.serializeBinary = function() {
+proto.ipc.Ladder.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ipc.PlayerStanding.serializeBinaryToWriter(this, writer);
+  proto.ipc.Ladder.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3579,173 +10034,183 @@ proto.ipc.PlayerStanding.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ipc.PlayerStanding} message
+ * @param {!proto.ipc.Ladder} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.PlayerStanding.serializeBinaryToWriter = function(message, writer) {
+proto.ipc.Ladder.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPlayerId();
+  f = message.getId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getWins();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getPlayersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       2,
-      f
+      f,
+      proto.ipc.TournamentPerson.serializeBinaryToWriter
     );
   }
-  f = message.getLosses();
+  f = message.getMaxChallengeDistance();
   if (f !== 0) {
     writer.writeInt32(
       3,
       f
     );
   }
-  f = message.getDraws();
+  f = message.getAcceptSeconds();
   if (f !== 0) {
     writer.writeInt32(
       4,
       f
     );
   }
-  f = message.getSpread();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getChallengesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       5,
-      f
-    );
-  }
-  f = message.getGibsonized();
-  if (f) {
-    writer.writeBool(
-      6,
-      f
+      f,
+      proto.ipc.LadderChallenge.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string player_id = 1;
+ * optional string id = 1;
  * @return {string}
  */
-proto.ipc.PlayerStanding.prototype.getPlayerId = function() {
+proto.ipc.Ladder.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ipc.PlayerStanding} returns this
+ * @return {!proto.ipc.Ladder} returns this
  */
-proto.ipc.PlayerStanding.prototype.setPlayerId = function(value) {
+proto.ipc.Ladder.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional int32 wins = 2;
- * @return {number}
+ * repeated TournamentPerson players = 2;
+ * @return {!Array<!proto.ipc.TournamentPerson>}
  */
-proto.ipc.PlayerStanding.prototype.getWins = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.ipc.Ladder.prototype.getPlayersList = function() {
+  return /** @type{!Array<!proto.ipc.TournamentPerson>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ipc.TournamentPerson, 2));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ipc.PlayerStanding} returns this
+ * @param {!Array<!proto.ipc.TournamentPerson>} value
+ * @return {!proto.ipc.Ladder} returns this
+*/
+proto.ipc.Ladder.prototype.setPlayersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.ipc.TournamentPerson=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.TournamentPerson}
  */
-proto.ipc.PlayerStanding.prototype.setWins = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.ipc.Ladder.prototype.addPlayers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.ipc.TournamentPerson, opt_index);
 };
 
 
 /**
- * optional int32 losses = 3;
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ipc.Ladder} returns this
+ */
+proto.ipc.Ladder.prototype.clearPlayersList = function() {
+  return this.setPlayersList([]);
+};
+
+
+/**
+ * optional int32 max_challenge_distance = 3;
  * @return {number}
  */
-proto.ipc.PlayerStanding.prototype.getLosses = function() {
+proto.ipc.Ladder.prototype.getMaxChallengeDistance = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ipc.PlayerStanding} returns this
+ * @return {!proto.ipc.Ladder} returns this
  */
-proto.ipc.PlayerStanding.prototype.setLosses = function(value) {
+proto.ipc.Ladder.prototype.setMaxChallengeDistance = function(value) {
   return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * optional int32 draws = 4;
+ * optional int32 accept_seconds = 4;
  * @return {number}
  */
-proto.ipc.PlayerStanding.prototype.getDraws = function() {
+proto.ipc.Ladder.prototype.getAcceptSeconds = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ipc.PlayerStanding} returns this
+ * @return {!proto.ipc.Ladder} returns this
  */
-proto.ipc.PlayerStanding.prototype.setDraws = function(value) {
+proto.ipc.Ladder.prototype.setAcceptSeconds = function(value) {
   return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * optional int32 spread = 5;
- * @return {number}
+ * repeated LadderChallenge challenges = 5;
+ * @return {!Array<!proto.ipc.LadderChallenge>}
  */
-proto.ipc.PlayerStanding.prototype.getSpread = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.ipc.Ladder.prototype.getChallengesList = function() {
+  return /** @type{!Array<!proto.ipc.LadderChallenge>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ipc.LadderChallenge, 5));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ipc.PlayerStanding} returns this
- */
-proto.ipc.PlayerStanding.prototype.setSpread = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
+ * @param {!Array<!proto.ipc.LadderChallenge>} value
+ * @return {!proto.ipc.Ladder} returns this
+*/
+proto.ipc.Ladder.prototype.setChallengesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 5, value);
 };
 
 
 /**
- * optional bool gibsonized = 6;
- * @return {boolean}
+ * @param {!proto.ipc.LadderChallenge=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.LadderChallenge}
  */
-proto.ipc.PlayerStanding.prototype.getGibsonized = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+proto.ipc.Ladder.prototype.addChallenges = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.ipc.LadderChallenge, opt_index);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.ipc.PlayerStanding} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ipc.Ladder} returns this
  */
-proto.ipc.PlayerStanding.prototype.setGibsonized = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 6, value);
+proto.ipc.Ladder.prototype.clearChallengesList = function() {
+  return this.setChallengesList([]);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.ipc.RoundStandings.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -3761,8 +10226,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ipc.RoundStandings.prototype.toObject = function(opt_includeInstance) {
-  return proto.ipc.RoundStandings.toObject(opt_includeInstance, this);
+proto.ipc.ClubMember.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.ClubMember.toObject(opt_includeInstance, this);
 };
 
 
@@ -3771,14 +10236,15 @@ proto.ipc.RoundStandings.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ipc.RoundStandings} msg The msg instance to transform.
+ * @param {!proto.ipc.ClubMember} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.RoundStandings.toObject = function(includeInstance, msg) {
+proto.ipc.ClubMember.toObject = function(includeInstance, msg) {
   var f, obj = {
-    standingsList: jspb.Message.toObjectList(msg.getStandingsList(),
-    proto.ipc.PlayerStanding.toObject, includeInstance)
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    role: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    joinedAt: (f = msg.getJoinedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -3792,23 +10258,23 @@ proto.ipc.RoundStandings.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ipc.RoundStandings}
+ * @return {!proto.ipc.ClubMember}
  */
-proto.ipc.RoundStandings.deserializeBinary = function(bytes) {
+proto.ipc.ClubMember.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ipc.RoundStandings;
-  return proto.ipc.RoundStandings.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ipc.ClubMember;
+  return proto.ipc.ClubMember.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ipc.RoundStandings} msg The message object to deserialize into.
+ * @param {!proto.ipc.ClubMember} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ipc.RoundStandings}
+ * @return {!proto.ipc.ClubMember}
  */
-proto.ipc.RoundStandings.deserializeBinaryFromReader = function(msg, reader) {
+proto.ipc.ClubMember.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3816,9 +10282,17 @@ proto.ipc.RoundStandings.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.ipc.PlayerStanding;
-      reader.readMessage(value,proto.ipc.PlayerStanding.deserializeBinaryFromReader);
-      msg.addStandings(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.ipc.ClubRole} */ (reader.readEnum());
+      msg.setRole(value);
+      break;
+    case 3:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setJoinedAt(value);
       break;
     default:
       reader.skipField();
@@ -3833,9 +10307,9 @@ proto.ipc.RoundStandings.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ipc.RoundStandings.prototype.serializeBinary = function() {
+proto.ipc.ClubMember.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ipc.RoundStandings.serializeBinaryToWriter(this, writer);
+  proto.ipc.ClubMember.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3843,58 +10317,107 @@ proto.ipc.RoundStandings.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ipc.RoundStandings} message
+ * @param {!proto.ipc.ClubMember} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.RoundStandings.serializeBinaryToWriter = function(message, writer) {
+proto.ipc.ClubMember.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getStandingsList();
+  f = message.getId();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
+      f
+    );
+  }
+  f = message.getRole();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = message.getJoinedAt();
+  if (f != null) {
+    writer.writeMessage(
+      3,
       f,
-      proto.ipc.PlayerStanding.serializeBinaryToWriter
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated PlayerStanding standings = 1;
- * @return {!Array<!proto.ipc.PlayerStanding>}
+ * optional string id = 1;
+ * @return {string}
  */
-proto.ipc.RoundStandings.prototype.getStandingsList = function() {
-  return /** @type{!Array<!proto.ipc.PlayerStanding>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ipc.PlayerStanding, 1));
+proto.ipc.ClubMember.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!Array<!proto.ipc.PlayerStanding>} value
- * @return {!proto.ipc.RoundStandings} returns this
+ * @param {string} value
+ * @return {!proto.ipc.ClubMember} returns this
+ */
+proto.ipc.ClubMember.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional ClubRole role = 2;
+ * @return {!proto.ipc.ClubRole}
+ */
+proto.ipc.ClubMember.prototype.getRole = function() {
+  return /** @type {!proto.ipc.ClubRole} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.ipc.ClubRole} value
+ * @return {!proto.ipc.ClubMember} returns this
+ */
+proto.ipc.ClubMember.prototype.setRole = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp joined_at = 3;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.ipc.ClubMember.prototype.getJoinedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.ipc.ClubMember} returns this
 */
-proto.ipc.RoundStandings.prototype.setStandingsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+proto.ipc.ClubMember.prototype.setJoinedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
 };
 
 
 /**
- * @param {!proto.ipc.PlayerStanding=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ipc.PlayerStanding}
+ * Clears the message field making it undefined.
+ * @return {!proto.ipc.ClubMember} returns this
  */
-proto.ipc.RoundStandings.prototype.addStandings = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ipc.PlayerStanding, opt_index);
+proto.ipc.ClubMember.prototype.clearJoinedAt = function() {
+  return this.setJoinedAt(undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ipc.RoundStandings} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.ipc.RoundStandings.prototype.clearStandingsList = function() {
-  return this.setStandingsList([]);
+proto.ipc.ClubMember.prototype.hasJoinedAt = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
@@ -3904,7 +10427,7 @@ proto.ipc.RoundStandings.prototype.clearStandingsList = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.ipc.DivisionPairingsResponse.repeatedFields_ = [3];
+proto.ipc.Club.repeatedFields_ = [2];
 
 
 
@@ -3921,8 +10444,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ipc.DivisionPairingsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.ipc.DivisionPairingsResponse.toObject(opt_includeInstance, this);
+proto.ipc.Club.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.Club.toObject(opt_includeInstance, this);
 };
 
 
@@ -3931,17 +10454,18 @@ proto.ipc.DivisionPairingsResponse.prototype.toObject = function(opt_includeInst
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ipc.DivisionPairingsResponse} msg The msg instance to transform.
+ * @param {!proto.ipc.Club} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.DivisionPairingsResponse.toObject = function(includeInstance, msg) {
+proto.ipc.Club.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    divisionPairingsList: jspb.Message.toObjectList(msg.getDivisionPairingsList(),
-    proto.ipc.Pairing.toObject, includeInstance),
-    divisionStandingsMap: (f = msg.getDivisionStandingsMap()) ? f.toObject(includeInstance, proto.ipc.RoundStandings.toObject) : []
+    membersList: jspb.Message.toObjectList(msg.getMembersList(),
+    proto.ipc.ClubMember.toObject, includeInstance),
+    membersOnly: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    sessionTemplate: (f = msg.getSessionTemplate()) && proto.ipc.ClubSessionTemplate.toObject(includeInstance, f),
+    sessionSchedule: (f = msg.getSessionSchedule()) && proto.ipc.ClubSessionSchedule.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -3955,23 +10479,23 @@ proto.ipc.DivisionPairingsResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ipc.DivisionPairingsResponse}
+ * @return {!proto.ipc.Club}
  */
-proto.ipc.DivisionPairingsResponse.deserializeBinary = function(bytes) {
+proto.ipc.Club.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ipc.DivisionPairingsResponse;
-  return proto.ipc.DivisionPairingsResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ipc.Club;
+  return proto.ipc.Club.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ipc.DivisionPairingsResponse} msg The message object to deserialize into.
+ * @param {!proto.ipc.Club} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ipc.DivisionPairingsResponse}
+ * @return {!proto.ipc.Club}
  */
-proto.ipc.DivisionPairingsResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.ipc.Club.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3983,19 +10507,23 @@ proto.ipc.DivisionPairingsResponse.deserializeBinaryFromReader = function(msg, r
       msg.setId(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDivision(value);
+      var value = new proto.ipc.ClubMember;
+      reader.readMessage(value,proto.ipc.ClubMember.deserializeBinaryFromReader);
+      msg.addMembers(value);
       break;
     case 3:
-      var value = new proto.ipc.Pairing;
-      reader.readMessage(value,proto.ipc.Pairing.deserializeBinaryFromReader);
-      msg.addDivisionPairings(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setMembersOnly(value);
       break;
     case 4:
-      var value = msg.getDivisionStandingsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readInt32, jspb.BinaryReader.prototype.readMessage, proto.ipc.RoundStandings.deserializeBinaryFromReader, 0, new proto.ipc.RoundStandings());
-         });
+      var value = new proto.ipc.ClubSessionTemplate;
+      reader.readMessage(value,proto.ipc.ClubSessionTemplate.deserializeBinaryFromReader);
+      msg.setSessionTemplate(value);
+      break;
+    case 5:
+      var value = new proto.ipc.ClubSessionSchedule;
+      reader.readMessage(value,proto.ipc.ClubSessionSchedule.deserializeBinaryFromReader);
+      msg.setSessionSchedule(value);
       break;
     default:
       reader.skipField();
@@ -4010,9 +10538,9 @@ proto.ipc.DivisionPairingsResponse.deserializeBinaryFromReader = function(msg, r
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ipc.DivisionPairingsResponse.prototype.serializeBinary = function() {
+proto.ipc.Club.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ipc.DivisionPairingsResponse.serializeBinaryToWriter(this, writer);
+  proto.ipc.Club.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4020,11 +10548,11 @@ proto.ipc.DivisionPairingsResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ipc.DivisionPairingsResponse} message
+ * @param {!proto.ipc.Club} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.DivisionPairingsResponse.serializeBinaryToWriter = function(message, writer) {
+proto.ipc.Club.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f.length > 0) {
@@ -4033,24 +10561,36 @@ proto.ipc.DivisionPairingsResponse.serializeBinaryToWriter = function(message, w
       f
     );
   }
-  f = message.getDivision();
+  f = message.getMembersList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       2,
-      f
+      f,
+      proto.ipc.ClubMember.serializeBinaryToWriter
     );
   }
-  f = message.getDivisionPairingsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getMembersOnly();
+  if (f) {
+    writer.writeBool(
       3,
+      f
+    );
+  }
+  f = message.getSessionTemplate();
+  if (f != null) {
+    writer.writeMessage(
+      4,
       f,
-      proto.ipc.Pairing.serializeBinaryToWriter
+      proto.ipc.ClubSessionTemplate.serializeBinaryToWriter
     );
   }
-  f = message.getDivisionStandingsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(4, writer, jspb.BinaryWriter.prototype.writeInt32, jspb.BinaryWriter.prototype.writeMessage, proto.ipc.RoundStandings.serializeBinaryToWriter);
+  f = message.getSessionSchedule();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      proto.ipc.ClubSessionSchedule.serializeBinaryToWriter
+    );
   }
 };
 
@@ -4059,99 +10599,158 @@ proto.ipc.DivisionPairingsResponse.serializeBinaryToWriter = function(message, w
  * optional string id = 1;
  * @return {string}
  */
-proto.ipc.DivisionPairingsResponse.prototype.getId = function() {
+proto.ipc.Club.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ipc.DivisionPairingsResponse} returns this
+ * @return {!proto.ipc.Club} returns this
  */
-proto.ipc.DivisionPairingsResponse.prototype.setId = function(value) {
+proto.ipc.Club.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string division = 2;
- * @return {string}
+ * repeated ClubMember members = 2;
+ * @return {!Array<!proto.ipc.ClubMember>}
  */
-proto.ipc.DivisionPairingsResponse.prototype.getDivision = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.ipc.Club.prototype.getMembersList = function() {
+  return /** @type{!Array<!proto.ipc.ClubMember>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ipc.ClubMember, 2));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ipc.DivisionPairingsResponse} returns this
+ * @param {!Array<!proto.ipc.ClubMember>} value
+ * @return {!proto.ipc.Club} returns this
+*/
+proto.ipc.Club.prototype.setMembersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.ipc.ClubMember=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.ClubMember}
  */
-proto.ipc.DivisionPairingsResponse.prototype.setDivision = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.ipc.Club.prototype.addMembers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.ipc.ClubMember, opt_index);
 };
 
 
 /**
- * repeated Pairing division_pairings = 3;
- * @return {!Array<!proto.ipc.Pairing>}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ipc.Club} returns this
  */
-proto.ipc.DivisionPairingsResponse.prototype.getDivisionPairingsList = function() {
-  return /** @type{!Array<!proto.ipc.Pairing>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ipc.Pairing, 3));
+proto.ipc.Club.prototype.clearMembersList = function() {
+  return this.setMembersList([]);
 };
 
 
 /**
- * @param {!Array<!proto.ipc.Pairing>} value
- * @return {!proto.ipc.DivisionPairingsResponse} returns this
+ * optional bool members_only = 3;
+ * @return {boolean}
+ */
+proto.ipc.Club.prototype.getMembersOnly = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ipc.Club} returns this
+ */
+proto.ipc.Club.prototype.setMembersOnly = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * optional ClubSessionTemplate session_template = 4;
+ * @return {?proto.ipc.ClubSessionTemplate}
+ */
+proto.ipc.Club.prototype.getSessionTemplate = function() {
+  return /** @type{?proto.ipc.ClubSessionTemplate} */ (
+    jspb.Message.getWrapperField(this, proto.ipc.ClubSessionTemplate, 4));
+};
+
+
+/**
+ * @param {?proto.ipc.ClubSessionTemplate|undefined} value
+ * @return {!proto.ipc.Club} returns this
 */
-proto.ipc.DivisionPairingsResponse.prototype.setDivisionPairingsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+proto.ipc.Club.prototype.setSessionTemplate = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
 };
 
 
 /**
- * @param {!proto.ipc.Pairing=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ipc.Pairing}
+ * Clears the message field making it undefined.
+ * @return {!proto.ipc.Club} returns this
  */
-proto.ipc.DivisionPairingsResponse.prototype.addDivisionPairings = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.ipc.Pairing, opt_index);
+proto.ipc.Club.prototype.clearSessionTemplate = function() {
+  return this.setSessionTemplate(undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ipc.DivisionPairingsResponse} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.ipc.DivisionPairingsResponse.prototype.clearDivisionPairingsList = function() {
-  return this.setDivisionPairingsList([]);
+proto.ipc.Club.prototype.hasSessionTemplate = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
 /**
- * map<int32, RoundStandings> division_standings = 4;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<number,!proto.ipc.RoundStandings>}
+ * optional ClubSessionSchedule session_schedule = 5;
+ * @return {?proto.ipc.ClubSessionSchedule}
  */
-proto.ipc.DivisionPairingsResponse.prototype.getDivisionStandingsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<number,!proto.ipc.RoundStandings>} */ (
-      jspb.Message.getMapField(this, 4, opt_noLazyCreate,
-      proto.ipc.RoundStandings));
+proto.ipc.Club.prototype.getSessionSchedule = function() {
+  return /** @type{?proto.ipc.ClubSessionSchedule} */ (
+    jspb.Message.getWrapperField(this, proto.ipc.ClubSessionSchedule, 5));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.ipc.DivisionPairingsResponse} returns this
+ * @param {?proto.ipc.ClubSessionSchedule|undefined} value
+ * @return {!proto.ipc.Club} returns this
+*/
+proto.ipc.Club.prototype.setSessionSchedule = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ipc.Club} returns this
  */
-proto.ipc.DivisionPairingsResponse.prototype.clearDivisionStandingsMap = function() {
-  this.getDivisionStandingsMap().clear();
-  return this;};
+proto.ipc.Club.prototype.clearSessionSchedule = function() {
+  return this.setSessionSchedule(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ipc.Club.prototype.hasSessionSchedule = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ipc.ClubSessionTemplateDivision.repeatedFields_ = [3];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -4167,8 +10766,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ipc.DivisionPairingsDeletedResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.ipc.DivisionPairingsDeletedResponse.toObject(opt_includeInstance, this);
+proto.ipc.ClubSessionTemplateDivision.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.ClubSessionTemplateDivision.toObject(opt_includeInstance, this);
 };
 
 
@@ -4177,15 +10776,16 @@ proto.ipc.DivisionPairingsDeletedResponse.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ipc.DivisionPairingsDeletedResponse} msg The msg instance to transform.
+ * @param {!proto.ipc.ClubSessionTemplateDivision} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.DivisionPairingsDeletedResponse.toObject = function(includeInstance, msg) {
+proto.ipc.ClubSessionTemplateDivision.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    round: jspb.Message.getFieldWithDefault(msg, 3, 0)
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    controls: (f = msg.getControls()) && proto.ipc.DivisionControls.toObject(includeInstance, f),
+    roundControlsList: jspb.Message.toObjectList(msg.getRoundControlsList(),
+    proto.ipc.RoundControl.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -4199,23 +10799,23 @@ proto.ipc.DivisionPairingsDeletedResponse.toObject = function(includeInstance, m
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ipc.DivisionPairingsDeletedResponse}
+ * @return {!proto.ipc.ClubSessionTemplateDivision}
  */
-proto.ipc.DivisionPairingsDeletedResponse.deserializeBinary = function(bytes) {
+proto.ipc.ClubSessionTemplateDivision.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ipc.DivisionPairingsDeletedResponse;
-  return proto.ipc.DivisionPairingsDeletedResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ipc.ClubSessionTemplateDivision;
+  return proto.ipc.ClubSessionTemplateDivision.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ipc.DivisionPairingsDeletedResponse} msg The message object to deserialize into.
+ * @param {!proto.ipc.ClubSessionTemplateDivision} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ipc.DivisionPairingsDeletedResponse}
+ * @return {!proto.ipc.ClubSessionTemplateDivision}
  */
-proto.ipc.DivisionPairingsDeletedResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.ipc.ClubSessionTemplateDivision.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4224,15 +10824,17 @@ proto.ipc.DivisionPairingsDeletedResponse.deserializeBinaryFromReader = function
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      msg.setName(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDivision(value);
+      var value = new proto.ipc.DivisionControls;
+      reader.readMessage(value,proto.ipc.DivisionControls.deserializeBinaryFromReader);
+      msg.setControls(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setRound(value);
+      var value = new proto.ipc.RoundControl;
+      reader.readMessage(value,proto.ipc.RoundControl.deserializeBinaryFromReader);
+      msg.addRoundControls(value);
       break;
     default:
       reader.skipField();
@@ -4247,9 +10849,9 @@ proto.ipc.DivisionPairingsDeletedResponse.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ipc.DivisionPairingsDeletedResponse.prototype.serializeBinary = function() {
+proto.ipc.ClubSessionTemplateDivision.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ipc.DivisionPairingsDeletedResponse.serializeBinaryToWriter(this, writer);
+  proto.ipc.ClubSessionTemplateDivision.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4257,87 +10859,128 @@ proto.ipc.DivisionPairingsDeletedResponse.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ipc.DivisionPairingsDeletedResponse} message
+ * @param {!proto.ipc.ClubSessionTemplateDivision} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.DivisionPairingsDeletedResponse.serializeBinaryToWriter = function(message, writer) {
+proto.ipc.ClubSessionTemplateDivision.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getName();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getDivision();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getControls();
+  if (f != null) {
+    writer.writeMessage(
       2,
-      f
+      f,
+      proto.ipc.DivisionControls.serializeBinaryToWriter
     );
   }
-  f = message.getRound();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getRoundControlsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       3,
-      f
+      f,
+      proto.ipc.RoundControl.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string id = 1;
+ * optional string name = 1;
  * @return {string}
  */
-proto.ipc.DivisionPairingsDeletedResponse.prototype.getId = function() {
+proto.ipc.ClubSessionTemplateDivision.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ipc.DivisionPairingsDeletedResponse} returns this
+ * @return {!proto.ipc.ClubSessionTemplateDivision} returns this
  */
-proto.ipc.DivisionPairingsDeletedResponse.prototype.setId = function(value) {
+proto.ipc.ClubSessionTemplateDivision.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string division = 2;
- * @return {string}
+ * optional DivisionControls controls = 2;
+ * @return {?proto.ipc.DivisionControls}
  */
-proto.ipc.DivisionPairingsDeletedResponse.prototype.getDivision = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.ipc.ClubSessionTemplateDivision.prototype.getControls = function() {
+  return /** @type{?proto.ipc.DivisionControls} */ (
+    jspb.Message.getWrapperField(this, proto.ipc.DivisionControls, 2));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ipc.DivisionPairingsDeletedResponse} returns this
+ * @param {?proto.ipc.DivisionControls|undefined} value
+ * @return {!proto.ipc.ClubSessionTemplateDivision} returns this
+*/
+proto.ipc.ClubSessionTemplateDivision.prototype.setControls = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ipc.ClubSessionTemplateDivision} returns this
  */
-proto.ipc.DivisionPairingsDeletedResponse.prototype.setDivision = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.ipc.ClubSessionTemplateDivision.prototype.clearControls = function() {
+  return this.setControls(undefined);
 };
 
 
 /**
- * optional int32 round = 3;
- * @return {number}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.ipc.DivisionPairingsDeletedResponse.prototype.getRound = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.ipc.ClubSessionTemplateDivision.prototype.hasControls = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ipc.DivisionPairingsDeletedResponse} returns this
+ * repeated RoundControl round_controls = 3;
+ * @return {!Array<!proto.ipc.RoundControl>}
  */
-proto.ipc.DivisionPairingsDeletedResponse.prototype.setRound = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.ipc.ClubSessionTemplateDivision.prototype.getRoundControlsList = function() {
+  return /** @type{!Array<!proto.ipc.RoundControl>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ipc.RoundControl, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.ipc.RoundControl>} value
+ * @return {!proto.ipc.ClubSessionTemplateDivision} returns this
+*/
+proto.ipc.ClubSessionTemplateDivision.prototype.setRoundControlsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.ipc.RoundControl=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.RoundControl}
+ */
+proto.ipc.ClubSessionTemplateDivision.prototype.addRoundControls = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.ipc.RoundControl, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ipc.ClubSessionTemplateDivision} returns this
+ */
+proto.ipc.ClubSessionTemplateDivision.prototype.clearRoundControlsList = function() {
+  return this.setRoundControlsList([]);
 };
 
 
@@ -4347,7 +10990,7 @@ proto.ipc.DivisionPairingsDeletedResponse.prototype.setRound = function(value) {
  * @private {!Array<number>}
  * @const
  */
-proto.ipc.PlayersAddedOrRemovedResponse.repeatedFields_ = [4];
+proto.ipc.ClubSessionTemplate.repeatedFields_ = [1];
 
 
 
@@ -4364,8 +11007,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ipc.PlayersAddedOrRemovedResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.ipc.PlayersAddedOrRemovedResponse.toObject(opt_includeInstance, this);
+proto.ipc.ClubSessionTemplate.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.ClubSessionTemplate.toObject(opt_includeInstance, this);
 };
 
 
@@ -4374,18 +11017,15 @@ proto.ipc.PlayersAddedOrRemovedResponse.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ipc.PlayersAddedOrRemovedResponse} msg The msg instance to transform.
+ * @param {!proto.ipc.ClubSessionTemplate} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.PlayersAddedOrRemovedResponse.toObject = function(includeInstance, msg) {
+proto.ipc.ClubSessionTemplate.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    players: (f = msg.getPlayers()) && proto.ipc.TournamentPersons.toObject(includeInstance, f),
-    divisionPairingsList: jspb.Message.toObjectList(msg.getDivisionPairingsList(),
-    proto.ipc.Pairing.toObject, includeInstance),
-    divisionStandingsMap: (f = msg.getDivisionStandingsMap()) ? f.toObject(includeInstance, proto.ipc.RoundStandings.toObject) : []
+    divisionsList: jspb.Message.toObjectList(msg.getDivisionsList(),
+    proto.ipc.ClubSessionTemplateDivision.toObject, includeInstance),
+    defaultClubSettings: (f = msg.getDefaultClubSettings()) && api_proto_ipc_omgwords_pb.GameRequest.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -4399,23 +11039,23 @@ proto.ipc.PlayersAddedOrRemovedResponse.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ipc.PlayersAddedOrRemovedResponse}
+ * @return {!proto.ipc.ClubSessionTemplate}
  */
-proto.ipc.PlayersAddedOrRemovedResponse.deserializeBinary = function(bytes) {
+proto.ipc.ClubSessionTemplate.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ipc.PlayersAddedOrRemovedResponse;
-  return proto.ipc.PlayersAddedOrRemovedResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ipc.ClubSessionTemplate;
+  return proto.ipc.ClubSessionTemplate.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ipc.PlayersAddedOrRemovedResponse} msg The message object to deserialize into.
+ * @param {!proto.ipc.ClubSessionTemplate} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ipc.PlayersAddedOrRemovedResponse}
+ * @return {!proto.ipc.ClubSessionTemplate}
  */
-proto.ipc.PlayersAddedOrRemovedResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.ipc.ClubSessionTemplate.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4423,28 +11063,14 @@ proto.ipc.PlayersAddedOrRemovedResponse.deserializeBinaryFromReader = function(m
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      var value = new proto.ipc.ClubSessionTemplateDivision;
+      reader.readMessage(value,proto.ipc.ClubSessionTemplateDivision.deserializeBinaryFromReader);
+      msg.addDivisions(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDivision(value);
-      break;
-    case 3:
-      var value = new proto.ipc.TournamentPersons;
-      reader.readMessage(value,proto.ipc.TournamentPersons.deserializeBinaryFromReader);
-      msg.setPlayers(value);
-      break;
-    case 4:
-      var value = new proto.ipc.Pairing;
-      reader.readMessage(value,proto.ipc.Pairing.deserializeBinaryFromReader);
-      msg.addDivisionPairings(value);
-      break;
-    case 5:
-      var value = msg.getDivisionStandingsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readInt32, jspb.BinaryReader.prototype.readMessage, proto.ipc.RoundStandings.deserializeBinaryFromReader, 0, new proto.ipc.RoundStandings());
-         });
+      var value = new api_proto_ipc_omgwords_pb.GameRequest;
+      reader.readMessage(value,api_proto_ipc_omgwords_pb.GameRequest.deserializeBinaryFromReader);
+      msg.setDefaultClubSettings(value);
       break;
     default:
       reader.skipField();
@@ -4459,9 +11085,9 @@ proto.ipc.PlayersAddedOrRemovedResponse.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ipc.PlayersAddedOrRemovedResponse.prototype.serializeBinary = function() {
+proto.ipc.ClubSessionTemplate.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ipc.PlayersAddedOrRemovedResponse.serializeBinaryToWriter(this, writer);
+  proto.ipc.ClubSessionTemplate.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4469,189 +11095,106 @@ proto.ipc.PlayersAddedOrRemovedResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ipc.PlayersAddedOrRemovedResponse} message
+ * @param {!proto.ipc.ClubSessionTemplate} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.PlayersAddedOrRemovedResponse.serializeBinaryToWriter = function(message, writer) {
+proto.ipc.ClubSessionTemplate.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getDivisionsList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       1,
-      f
-    );
-  }
-  f = message.getDivision();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
+      f,
+      proto.ipc.ClubSessionTemplateDivision.serializeBinaryToWriter
     );
   }
-  f = message.getPlayers();
+  f = message.getDefaultClubSettings();
   if (f != null) {
     writer.writeMessage(
-      3,
-      f,
-      proto.ipc.TournamentPersons.serializeBinaryToWriter
-    );
-  }
-  f = message.getDivisionPairingsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      4,
+      2,
       f,
-      proto.ipc.Pairing.serializeBinaryToWriter
+      api_proto_ipc_omgwords_pb.GameRequest.serializeBinaryToWriter
     );
   }
-  f = message.getDivisionStandingsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(5, writer, jspb.BinaryWriter.prototype.writeInt32, jspb.BinaryWriter.prototype.writeMessage, proto.ipc.RoundStandings.serializeBinaryToWriter);
-  }
-};
-
-
-/**
- * optional string id = 1;
- * @return {string}
- */
-proto.ipc.PlayersAddedOrRemovedResponse.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.ipc.PlayersAddedOrRemovedResponse} returns this
- */
-proto.ipc.PlayersAddedOrRemovedResponse.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string division = 2;
- * @return {string}
- */
-proto.ipc.PlayersAddedOrRemovedResponse.prototype.getDivision = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.ipc.PlayersAddedOrRemovedResponse} returns this
- */
-proto.ipc.PlayersAddedOrRemovedResponse.prototype.setDivision = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
-/**
- * optional TournamentPersons players = 3;
- * @return {?proto.ipc.TournamentPersons}
+ * repeated ClubSessionTemplateDivision divisions = 1;
+ * @return {!Array<!proto.ipc.ClubSessionTemplateDivision>}
  */
-proto.ipc.PlayersAddedOrRemovedResponse.prototype.getPlayers = function() {
-  return /** @type{?proto.ipc.TournamentPersons} */ (
-    jspb.Message.getWrapperField(this, proto.ipc.TournamentPersons, 3));
+proto.ipc.ClubSessionTemplate.prototype.getDivisionsList = function() {
+  return /** @type{!Array<!proto.ipc.ClubSessionTemplateDivision>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ipc.ClubSessionTemplateDivision, 1));
 };
 
 
 /**
- * @param {?proto.ipc.TournamentPersons|undefined} value
- * @return {!proto.ipc.PlayersAddedOrRemovedResponse} returns this
+ * @param {!Array<!proto.ipc.ClubSessionTemplateDivision>} value
+ * @return {!proto.ipc.ClubSessionTemplate} returns this
 */
-proto.ipc.PlayersAddedOrRemovedResponse.prototype.setPlayers = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.ipc.PlayersAddedOrRemovedResponse} returns this
- */
-proto.ipc.PlayersAddedOrRemovedResponse.prototype.clearPlayers = function() {
-  return this.setPlayers(undefined);
+proto.ipc.ClubSessionTemplate.prototype.setDivisionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {!proto.ipc.ClubSessionTemplateDivision=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.ClubSessionTemplateDivision}
  */
-proto.ipc.PlayersAddedOrRemovedResponse.prototype.hasPlayers = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.ipc.ClubSessionTemplate.prototype.addDivisions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ipc.ClubSessionTemplateDivision, opt_index);
 };
 
 
 /**
- * repeated Pairing division_pairings = 4;
- * @return {!Array<!proto.ipc.Pairing>}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ipc.ClubSessionTemplate} returns this
  */
-proto.ipc.PlayersAddedOrRemovedResponse.prototype.getDivisionPairingsList = function() {
-  return /** @type{!Array<!proto.ipc.Pairing>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ipc.Pairing, 4));
+proto.ipc.ClubSessionTemplate.prototype.clearDivisionsList = function() {
+  return this.setDivisionsList([]);
 };
 
 
 /**
- * @param {!Array<!proto.ipc.Pairing>} value
- * @return {!proto.ipc.PlayersAddedOrRemovedResponse} returns this
-*/
-proto.ipc.PlayersAddedOrRemovedResponse.prototype.setDivisionPairingsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 4, value);
-};
-
-
-/**
- * @param {!proto.ipc.Pairing=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ipc.Pairing}
+ * optional GameRequest default_club_settings = 2;
+ * @return {?proto.ipc.GameRequest}
  */
-proto.ipc.PlayersAddedOrRemovedResponse.prototype.addDivisionPairings = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.ipc.Pairing, opt_index);
+proto.ipc.ClubSessionTemplate.prototype.getDefaultClubSettings = function() {
+  return /** @type{?proto.ipc.GameRequest} */ (
+    jspb.Message.getWrapperField(this, api_proto_ipc_omgwords_pb.GameRequest, 2));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ipc.PlayersAddedOrRemovedResponse} returns this
- */
-proto.ipc.PlayersAddedOrRemovedResponse.prototype.clearDivisionPairingsList = function() {
-  return this.setDivisionPairingsList([]);
+ * @param {?proto.ipc.GameRequest|undefined} value
+ * @return {!proto.ipc.ClubSessionTemplate} returns this
+*/
+proto.ipc.ClubSessionTemplate.prototype.setDefaultClubSettings = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
- * map<int32, RoundStandings> division_standings = 5;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<number,!proto.ipc.RoundStandings>}
+ * Clears the message field making it undefined.
+ * @return {!proto.ipc.ClubSessionTemplate} returns this
  */
-proto.ipc.PlayersAddedOrRemovedResponse.prototype.getDivisionStandingsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<number,!proto.ipc.RoundStandings>} */ (
-      jspb.Message.getMapField(this, 5, opt_noLazyCreate,
-      proto.ipc.RoundStandings));
+proto.ipc.ClubSessionTemplate.prototype.clearDefaultClubSettings = function() {
+  return this.setDefaultClubSettings(undefined);
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.ipc.PlayersAddedOrRemovedResponse} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.ipc.PlayersAddedOrRemovedResponse.prototype.clearDivisionStandingsMap = function() {
-  this.getDivisionStandingsMap().clear();
-  return this;};
-
+proto.ipc.ClubSessionTemplate.prototype.hasDefaultClubSettings = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.ipc.DivisionRoundControls.repeatedFields_ = [3,4];
 
 
 
@@ -4668,8 +11211,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ipc.DivisionRoundControls.prototype.toObject = function(opt_includeInstance) {
-  return proto.ipc.DivisionRoundControls.toObject(opt_includeInstance, this);
+proto.ipc.ClubSessionSchedule.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.ClubSessionSchedule.toObject(opt_includeInstance, this);
 };
 
 
@@ -4678,19 +11221,18 @@ proto.ipc.DivisionRoundControls.prototype.toObject = function(opt_includeInstanc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ipc.DivisionRoundControls} msg The msg instance to transform.
+ * @param {!proto.ipc.ClubSessionSchedule} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.DivisionRoundControls.toObject = function(includeInstance, msg) {
+proto.ipc.ClubSessionSchedule.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    roundControlsList: jspb.Message.toObjectList(msg.getRoundControlsList(),
-    proto.ipc.RoundControl.toObject, includeInstance),
-    divisionPairingsList: jspb.Message.toObjectList(msg.getDivisionPairingsList(),
-    proto.ipc.Pairing.toObject, includeInstance),
-    divisionStandingsMap: (f = msg.getDivisionStandingsMap()) ? f.toObject(includeInstance, proto.ipc.RoundStandings.toObject) : []
+    weekday: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    hour: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    minute: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    timeZone: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    leadHours: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    lastSession: (f = msg.getLastSession()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -4704,23 +11246,23 @@ proto.ipc.DivisionRoundControls.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ipc.DivisionRoundControls}
+ * @return {!proto.ipc.ClubSessionSchedule}
  */
-proto.ipc.DivisionRoundControls.deserializeBinary = function(bytes) {
+proto.ipc.ClubSessionSchedule.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ipc.DivisionRoundControls;
-  return proto.ipc.DivisionRoundControls.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ipc.ClubSessionSchedule;
+  return proto.ipc.ClubSessionSchedule.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ipc.DivisionRoundControls} msg The message object to deserialize into.
+ * @param {!proto.ipc.ClubSessionSchedule} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ipc.DivisionRoundControls}
+ * @return {!proto.ipc.ClubSessionSchedule}
  */
-proto.ipc.DivisionRoundControls.deserializeBinaryFromReader = function(msg, reader) {
+proto.ipc.ClubSessionSchedule.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4728,28 +11270,29 @@ proto.ipc.DivisionRoundControls.deserializeBinaryFromReader = function(msg, read
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setWeekday(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDivision(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setHour(value);
       break;
     case 3:
-      var value = new proto.ipc.RoundControl;
-      reader.readMessage(value,proto.ipc.RoundControl.deserializeBinaryFromReader);
-      msg.addRoundControls(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMinute(value);
       break;
     case 4:
-      var value = new proto.ipc.Pairing;
-      reader.readMessage(value,proto.ipc.Pairing.deserializeBinaryFromReader);
-      msg.addDivisionPairings(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTimeZone(value);
       break;
     case 5:
-      var value = msg.getDivisionStandingsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readInt32, jspb.BinaryReader.prototype.readMessage, proto.ipc.RoundStandings.deserializeBinaryFromReader, 0, new proto.ipc.RoundStandings());
-         });
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setLeadHours(value);
+      break;
+    case 6:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setLastSession(value);
       break;
     default:
       reader.skipField();
@@ -4764,9 +11307,9 @@ proto.ipc.DivisionRoundControls.deserializeBinaryFromReader = function(msg, read
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ipc.DivisionRoundControls.prototype.serializeBinary = function() {
+proto.ipc.ClubSessionSchedule.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ipc.DivisionRoundControls.serializeBinaryToWriter(this, writer);
+  proto.ipc.ClubSessionSchedule.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4774,181 +11317,183 @@ proto.ipc.DivisionRoundControls.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ipc.DivisionRoundControls} message
+ * @param {!proto.ipc.ClubSessionSchedule} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.DivisionRoundControls.serializeBinaryToWriter = function(message, writer) {
+proto.ipc.ClubSessionSchedule.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getWeekday();
+  if (f !== 0) {
+    writer.writeInt32(
       1,
       f
     );
   }
-  f = message.getDivision();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getHour();
+  if (f !== 0) {
+    writer.writeInt32(
       2,
       f
     );
   }
-  f = message.getRoundControlsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getMinute();
+  if (f !== 0) {
+    writer.writeInt32(
       3,
-      f,
-      proto.ipc.RoundControl.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getDivisionPairingsList();
+  f = message.getTimeZone();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       4,
-      f,
-      proto.ipc.Pairing.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getDivisionStandingsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(5, writer, jspb.BinaryWriter.prototype.writeInt32, jspb.BinaryWriter.prototype.writeMessage, proto.ipc.RoundStandings.serializeBinaryToWriter);
+  f = message.getLeadHours();
+  if (f !== 0) {
+    writer.writeInt32(
+      5,
+      f
+    );
+  }
+  f = message.getLastSession();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
   }
 };
 
 
 /**
- * optional string id = 1;
- * @return {string}
+ * optional int32 weekday = 1;
+ * @return {number}
  */
-proto.ipc.DivisionRoundControls.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.ipc.ClubSessionSchedule.prototype.getWeekday = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ipc.DivisionRoundControls} returns this
+ * @param {number} value
+ * @return {!proto.ipc.ClubSessionSchedule} returns this
  */
-proto.ipc.DivisionRoundControls.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.ipc.ClubSessionSchedule.prototype.setWeekday = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional string division = 2;
- * @return {string}
+ * optional int32 hour = 2;
+ * @return {number}
  */
-proto.ipc.DivisionRoundControls.prototype.getDivision = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.ipc.ClubSessionSchedule.prototype.getHour = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ipc.DivisionRoundControls} returns this
+ * @param {number} value
+ * @return {!proto.ipc.ClubSessionSchedule} returns this
  */
-proto.ipc.DivisionRoundControls.prototype.setDivision = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.ipc.ClubSessionSchedule.prototype.setHour = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * repeated RoundControl round_controls = 3;
- * @return {!Array<!proto.ipc.RoundControl>}
+ * optional int32 minute = 3;
+ * @return {number}
  */
-proto.ipc.DivisionRoundControls.prototype.getRoundControlsList = function() {
-  return /** @type{!Array<!proto.ipc.RoundControl>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ipc.RoundControl, 3));
+proto.ipc.ClubSessionSchedule.prototype.getMinute = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {!Array<!proto.ipc.RoundControl>} value
- * @return {!proto.ipc.DivisionRoundControls} returns this
-*/
-proto.ipc.DivisionRoundControls.prototype.setRoundControlsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+ * @param {number} value
+ * @return {!proto.ipc.ClubSessionSchedule} returns this
+ */
+proto.ipc.ClubSessionSchedule.prototype.setMinute = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * @param {!proto.ipc.RoundControl=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ipc.RoundControl}
+ * optional string time_zone = 4;
+ * @return {string}
  */
-proto.ipc.DivisionRoundControls.prototype.addRoundControls = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.ipc.RoundControl, opt_index);
+proto.ipc.ClubSessionSchedule.prototype.getTimeZone = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ipc.DivisionRoundControls} returns this
+ * @param {string} value
+ * @return {!proto.ipc.ClubSessionSchedule} returns this
  */
-proto.ipc.DivisionRoundControls.prototype.clearRoundControlsList = function() {
-  return this.setRoundControlsList([]);
+proto.ipc.ClubSessionSchedule.prototype.setTimeZone = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * repeated Pairing division_pairings = 4;
- * @return {!Array<!proto.ipc.Pairing>}
+ * optional int32 lead_hours = 5;
+ * @return {number}
  */
-proto.ipc.DivisionRoundControls.prototype.getDivisionPairingsList = function() {
-  return /** @type{!Array<!proto.ipc.Pairing>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ipc.Pairing, 4));
+proto.ipc.ClubSessionSchedule.prototype.getLeadHours = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * @param {!Array<!proto.ipc.Pairing>} value
- * @return {!proto.ipc.DivisionRoundControls} returns this
-*/
-proto.ipc.DivisionRoundControls.prototype.setDivisionPairingsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+ * @param {number} value
+ * @return {!proto.ipc.ClubSessionSchedule} returns this
+ */
+proto.ipc.ClubSessionSchedule.prototype.setLeadHours = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
 /**
- * @param {!proto.ipc.Pairing=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ipc.Pairing}
+ * optional google.protobuf.Timestamp last_session = 6;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.ipc.DivisionRoundControls.prototype.addDivisionPairings = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.ipc.Pairing, opt_index);
+proto.ipc.ClubSessionSchedule.prototype.getLastSession = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 6));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ipc.DivisionRoundControls} returns this
- */
-proto.ipc.DivisionRoundControls.prototype.clearDivisionPairingsList = function() {
-  return this.setDivisionPairingsList([]);
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.ipc.ClubSessionSchedule} returns this
+*/
+proto.ipc.ClubSessionSchedule.prototype.setLastSession = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
 };
 
 
 /**
- * map<int32, RoundStandings> division_standings = 5;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<number,!proto.ipc.RoundStandings>}
+ * Clears the message field making it undefined.
+ * @return {!proto.ipc.ClubSessionSchedule} returns this
  */
-proto.ipc.DivisionRoundControls.prototype.getDivisionStandingsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<number,!proto.ipc.RoundStandings>} */ (
-      jspb.Message.getMapField(this, 5, opt_noLazyCreate,
-      proto.ipc.RoundStandings));
+proto.ipc.ClubSessionSchedule.prototype.clearLastSession = function() {
+  return this.setLastSession(undefined);
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.ipc.DivisionRoundControls} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.ipc.DivisionRoundControls.prototype.clearDivisionStandingsMap = function() {
-  this.getDivisionStandingsMap().clear();
-  return this;};
+proto.ipc.ClubSessionSchedule.prototype.hasLastSession = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
 
 
 
@@ -4967,8 +11512,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ipc.DivisionControlsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.ipc.DivisionControlsResponse.toObject(opt_includeInstance, this);
+proto.ipc.Prize.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.Prize.toObject(opt_includeInstance, this);
 };
 
 
@@ -4977,16 +11522,19 @@ proto.ipc.DivisionControlsResponse.prototype.toObject = function(opt_includeInst
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ipc.DivisionControlsResponse} msg The msg instance to transform.
+ * @param {!proto.ipc.Prize} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.DivisionControlsResponse.toObject = function(includeInstance, msg) {
+proto.ipc.Prize.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    type: jspb.Message.getFieldWithDefault(msg, 1, 0),
     division: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    divisionControls: (f = msg.getDivisionControls()) && proto.ipc.DivisionControls.toObject(includeInstance, f),
-    divisionStandingsMap: (f = msg.getDivisionStandingsMap()) ? f.toObject(includeInstance, proto.ipc.RoundStandings.toObject) : []
+    place: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    ratingFloor: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    ratingCeiling: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    amount: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    description: jspb.Message.getFieldWithDefault(msg, 7, "")
   };
 
   if (includeInstance) {
@@ -5000,23 +11548,23 @@ proto.ipc.DivisionControlsResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ipc.DivisionControlsResponse}
+ * @return {!proto.ipc.Prize}
  */
-proto.ipc.DivisionControlsResponse.deserializeBinary = function(bytes) {
+proto.ipc.Prize.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ipc.DivisionControlsResponse;
-  return proto.ipc.DivisionControlsResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ipc.Prize;
+  return proto.ipc.Prize.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ipc.DivisionControlsResponse} msg The message object to deserialize into.
+ * @param {!proto.ipc.Prize} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ipc.DivisionControlsResponse}
+ * @return {!proto.ipc.Prize}
  */
-proto.ipc.DivisionControlsResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.ipc.Prize.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5024,23 +11572,32 @@ proto.ipc.DivisionControlsResponse.deserializeBinaryFromReader = function(msg, r
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      var value = /** @type {!proto.ipc.PrizeType} */ (reader.readEnum());
+      msg.setType(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
       msg.setDivision(value);
       break;
     case 3:
-      var value = new proto.ipc.DivisionControls;
-      reader.readMessage(value,proto.ipc.DivisionControls.deserializeBinaryFromReader);
-      msg.setDivisionControls(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPlace(value);
       break;
     case 4:
-      var value = msg.getDivisionStandingsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readInt32, jspb.BinaryReader.prototype.readMessage, proto.ipc.RoundStandings.deserializeBinaryFromReader, 0, new proto.ipc.RoundStandings());
-         });
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRatingFloor(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRatingCeiling(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setAmount(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDescription(value);
       break;
     default:
       reader.skipField();
@@ -5055,9 +11612,9 @@ proto.ipc.DivisionControlsResponse.deserializeBinaryFromReader = function(msg, r
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ipc.DivisionControlsResponse.prototype.serializeBinary = function() {
+proto.ipc.Prize.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ipc.DivisionControlsResponse.serializeBinaryToWriter(this, writer);
+  proto.ipc.Prize.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5065,15 +11622,15 @@ proto.ipc.DivisionControlsResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ipc.DivisionControlsResponse} message
+ * @param {!proto.ipc.Prize} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.DivisionControlsResponse.serializeBinaryToWriter = function(message, writer) {
+proto.ipc.Prize.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getType();
+  if (f !== 0.0) {
+    writer.writeEnum(
       1,
       f
     );
@@ -5085,36 +11642,59 @@ proto.ipc.DivisionControlsResponse.serializeBinaryToWriter = function(message, w
       f
     );
   }
-  f = message.getDivisionControls();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getPlace();
+  if (f !== 0) {
+    writer.writeInt32(
       3,
-      f,
-      proto.ipc.DivisionControls.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getDivisionStandingsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(4, writer, jspb.BinaryWriter.prototype.writeInt32, jspb.BinaryWriter.prototype.writeMessage, proto.ipc.RoundStandings.serializeBinaryToWriter);
+  f = message.getRatingFloor();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+  f = message.getRatingCeiling();
+  if (f !== 0) {
+    writer.writeInt32(
+      5,
+      f
+    );
+  }
+  f = message.getAmount();
+  if (f !== 0) {
+    writer.writeInt64(
+      6,
+      f
+    );
+  }
+  f = message.getDescription();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
   }
 };
 
 
 /**
- * optional string id = 1;
- * @return {string}
+ * optional PrizeType type = 1;
+ * @return {!proto.ipc.PrizeType}
  */
-proto.ipc.DivisionControlsResponse.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.ipc.Prize.prototype.getType = function() {
+  return /** @type {!proto.ipc.PrizeType} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ipc.DivisionControlsResponse} returns this
+ * @param {!proto.ipc.PrizeType} value
+ * @return {!proto.ipc.Prize} returns this
  */
-proto.ipc.DivisionControlsResponse.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.ipc.Prize.prototype.setType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
 };
 
 
@@ -5122,77 +11702,108 @@ proto.ipc.DivisionControlsResponse.prototype.setId = function(value) {
  * optional string division = 2;
  * @return {string}
  */
-proto.ipc.DivisionControlsResponse.prototype.getDivision = function() {
+proto.ipc.Prize.prototype.getDivision = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ipc.DivisionControlsResponse} returns this
+ * @return {!proto.ipc.Prize} returns this
  */
-proto.ipc.DivisionControlsResponse.prototype.setDivision = function(value) {
+proto.ipc.Prize.prototype.setDivision = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional DivisionControls division_controls = 3;
- * @return {?proto.ipc.DivisionControls}
+ * optional int32 place = 3;
+ * @return {number}
  */
-proto.ipc.DivisionControlsResponse.prototype.getDivisionControls = function() {
-  return /** @type{?proto.ipc.DivisionControls} */ (
-    jspb.Message.getWrapperField(this, proto.ipc.DivisionControls, 3));
+proto.ipc.Prize.prototype.getPlace = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {?proto.ipc.DivisionControls|undefined} value
- * @return {!proto.ipc.DivisionControlsResponse} returns this
-*/
-proto.ipc.DivisionControlsResponse.prototype.setDivisionControls = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+ * @param {number} value
+ * @return {!proto.ipc.Prize} returns this
+ */
+proto.ipc.Prize.prototype.setPlace = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.ipc.DivisionControlsResponse} returns this
+ * optional int32 rating_floor = 4;
+ * @return {number}
  */
-proto.ipc.DivisionControlsResponse.prototype.clearDivisionControls = function() {
-  return this.setDivisionControls(undefined);
+proto.ipc.Prize.prototype.getRatingFloor = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.ipc.Prize} returns this
  */
-proto.ipc.DivisionControlsResponse.prototype.hasDivisionControls = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.ipc.Prize.prototype.setRatingFloor = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * map<int32, RoundStandings> division_standings = 4;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<number,!proto.ipc.RoundStandings>}
+ * optional int32 rating_ceiling = 5;
+ * @return {number}
  */
-proto.ipc.DivisionControlsResponse.prototype.getDivisionStandingsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<number,!proto.ipc.RoundStandings>} */ (
-      jspb.Message.getMapField(this, 4, opt_noLazyCreate,
-      proto.ipc.RoundStandings));
+proto.ipc.Prize.prototype.getRatingCeiling = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.ipc.DivisionControlsResponse} returns this
+ * @param {number} value
+ * @return {!proto.ipc.Prize} returns this
  */
-proto.ipc.DivisionControlsResponse.prototype.clearDivisionStandingsMap = function() {
-  this.getDivisionStandingsMap().clear();
-  return this;};
+proto.ipc.Prize.prototype.setRatingCeiling = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional int64 amount = 6;
+ * @return {number}
+ */
+proto.ipc.Prize.prototype.getAmount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.Prize} returns this
+ */
+proto.ipc.Prize.prototype.setAmount = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional string description = 7;
+ * @return {string}
+ */
+proto.ipc.Prize.prototype.getDescription = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.Prize} returns this
+ */
+proto.ipc.Prize.prototype.setDescription = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
 
 
 
@@ -5201,7 +11812,7 @@ proto.ipc.DivisionControlsResponse.prototype.clearDivisionStandingsMap = functio
  * @private {!Array<number>}
  * @const
  */
-proto.ipc.TournamentDivisionDataResponse.repeatedFields_ = [7];
+proto.ipc.PrizeAward.repeatedFields_ = [3,6];
 
 
 
@@ -5218,8 +11829,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ipc.TournamentDivisionDataResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.ipc.TournamentDivisionDataResponse.toObject(opt_includeInstance, this);
+proto.ipc.PrizeAward.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.PrizeAward.toObject(opt_includeInstance, this);
 };
 
 
@@ -5228,21 +11839,17 @@ proto.ipc.TournamentDivisionDataResponse.prototype.toObject = function(opt_inclu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ipc.TournamentDivisionDataResponse} msg The msg instance to transform.
+ * @param {!proto.ipc.PrizeAward} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.TournamentDivisionDataResponse.toObject = function(includeInstance, msg) {
+proto.ipc.PrizeAward.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    prize: (f = msg.getPrize()) && proto.ipc.Prize.toObject(includeInstance, f),
     division: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    players: (f = msg.getPlayers()) && proto.ipc.TournamentPersons.toObject(includeInstance, f),
-    standingsMap: (f = msg.getStandingsMap()) ? f.toObject(includeInstance, proto.ipc.RoundStandings.toObject) : [],
-    pairingMapMap: (f = msg.getPairingMapMap()) ? f.toObject(includeInstance, proto.ipc.Pairing.toObject) : [],
-    controls: (f = msg.getControls()) && proto.ipc.DivisionControls.toObject(includeInstance, f),
-    roundControlsList: jspb.Message.toObjectList(msg.getRoundControlsList(),
-    proto.ipc.RoundControl.toObject, includeInstance),
-    currentRound: jspb.Message.getFieldWithDefault(msg, 8, 0)
+    playerIdsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    score: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    amountsList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -5256,23 +11863,23 @@ proto.ipc.TournamentDivisionDataResponse.toObject = function(includeInstance, ms
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ipc.TournamentDivisionDataResponse}
+ * @return {!proto.ipc.PrizeAward}
  */
-proto.ipc.TournamentDivisionDataResponse.deserializeBinary = function(bytes) {
+proto.ipc.PrizeAward.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ipc.TournamentDivisionDataResponse;
-  return proto.ipc.TournamentDivisionDataResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ipc.PrizeAward;
+  return proto.ipc.PrizeAward.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ipc.TournamentDivisionDataResponse} msg The message object to deserialize into.
+ * @param {!proto.ipc.PrizeAward} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ipc.TournamentDivisionDataResponse}
+ * @return {!proto.ipc.PrizeAward}
  */
-proto.ipc.TournamentDivisionDataResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.ipc.PrizeAward.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5280,43 +11887,27 @@ proto.ipc.TournamentDivisionDataResponse.deserializeBinaryFromReader = function(
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      var value = new proto.ipc.Prize;
+      reader.readMessage(value,proto.ipc.Prize.deserializeBinaryFromReader);
+      msg.setPrize(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
       msg.setDivision(value);
       break;
     case 3:
-      var value = new proto.ipc.TournamentPersons;
-      reader.readMessage(value,proto.ipc.TournamentPersons.deserializeBinaryFromReader);
-      msg.setPlayers(value);
-      break;
-    case 4:
-      var value = msg.getStandingsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readInt32, jspb.BinaryReader.prototype.readMessage, proto.ipc.RoundStandings.deserializeBinaryFromReader, 0, new proto.ipc.RoundStandings());
-         });
+      var value = /** @type {string} */ (reader.readString());
+      msg.addPlayerIds(value);
       break;
     case 5:
-      var value = msg.getPairingMapMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.ipc.Pairing.deserializeBinaryFromReader, "", new proto.ipc.Pairing());
-         });
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setScore(value);
       break;
     case 6:
-      var value = new proto.ipc.DivisionControls;
-      reader.readMessage(value,proto.ipc.DivisionControls.deserializeBinaryFromReader);
-      msg.setControls(value);
-      break;
-    case 7:
-      var value = new proto.ipc.RoundControl;
-      reader.readMessage(value,proto.ipc.RoundControl.deserializeBinaryFromReader);
-      msg.addRoundControls(value);
-      break;
-    case 8:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setCurrentRound(value);
+      var values = /** @type {!Array<number>} */ (reader.isDelimited() ? reader.readPackedInt64() : [reader.readInt64()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addAmounts(values[i]);
+      }
       break;
     default:
       reader.skipField();
@@ -5331,9 +11922,9 @@ proto.ipc.TournamentDivisionDataResponse.deserializeBinaryFromReader = function(
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ipc.TournamentDivisionDataResponse.prototype.serializeBinary = function() {
+proto.ipc.PrizeAward.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ipc.TournamentDivisionDataResponse.serializeBinaryToWriter(this, writer);
+  proto.ipc.PrizeAward.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5341,17 +11932,18 @@ proto.ipc.TournamentDivisionDataResponse.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ipc.TournamentDivisionDataResponse} message
+ * @param {!proto.ipc.PrizeAward} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.TournamentDivisionDataResponse.serializeBinaryToWriter = function(message, writer) {
+proto.ipc.PrizeAward.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getPrize();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      proto.ipc.Prize.serializeBinaryToWriter
     );
   }
   f = message.getDivision();
@@ -5361,109 +11953,55 @@ proto.ipc.TournamentDivisionDataResponse.serializeBinaryToWriter = function(mess
       f
     );
   }
-  f = message.getPlayers();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      proto.ipc.TournamentPersons.serializeBinaryToWriter
-    );
-  }
-  f = message.getStandingsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(4, writer, jspb.BinaryWriter.prototype.writeInt32, jspb.BinaryWriter.prototype.writeMessage, proto.ipc.RoundStandings.serializeBinaryToWriter);
-  }
-  f = message.getPairingMapMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(5, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.ipc.Pairing.serializeBinaryToWriter);
-  }
-  f = message.getControls();
-  if (f != null) {
-    writer.writeMessage(
-      6,
-      f,
-      proto.ipc.DivisionControls.serializeBinaryToWriter
-    );
-  }
-  f = message.getRoundControlsList();
+  f = message.getPlayerIdsList();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      7,
-      f,
-      proto.ipc.RoundControl.serializeBinaryToWriter
+    writer.writeRepeatedString(
+      3,
+      f
     );
   }
-  f = message.getCurrentRound();
+  f = message.getScore();
   if (f !== 0) {
     writer.writeInt32(
-      8,
+      5,
       f
-    );
-  }
-};
-
-
-/**
- * optional string id = 1;
- * @return {string}
- */
-proto.ipc.TournamentDivisionDataResponse.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.ipc.TournamentDivisionDataResponse} returns this
- */
-proto.ipc.TournamentDivisionDataResponse.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * optional string division = 2;
- * @return {string}
- */
-proto.ipc.TournamentDivisionDataResponse.prototype.getDivision = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.ipc.TournamentDivisionDataResponse} returns this
- */
-proto.ipc.TournamentDivisionDataResponse.prototype.setDivision = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+    );
+  }
+  f = message.getAmountsList();
+  if (f.length > 0) {
+    writer.writePackedInt64(
+      6,
+      f
+    );
+  }
 };
 
 
 /**
- * optional TournamentPersons players = 3;
- * @return {?proto.ipc.TournamentPersons}
+ * optional Prize prize = 1;
+ * @return {?proto.ipc.Prize}
  */
-proto.ipc.TournamentDivisionDataResponse.prototype.getPlayers = function() {
-  return /** @type{?proto.ipc.TournamentPersons} */ (
-    jspb.Message.getWrapperField(this, proto.ipc.TournamentPersons, 3));
+proto.ipc.PrizeAward.prototype.getPrize = function() {
+  return /** @type{?proto.ipc.Prize} */ (
+    jspb.Message.getWrapperField(this, proto.ipc.Prize, 1));
 };
 
 
 /**
- * @param {?proto.ipc.TournamentPersons|undefined} value
- * @return {!proto.ipc.TournamentDivisionDataResponse} returns this
+ * @param {?proto.ipc.Prize|undefined} value
+ * @return {!proto.ipc.PrizeAward} returns this
 */
-proto.ipc.TournamentDivisionDataResponse.prototype.setPlayers = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+proto.ipc.PrizeAward.prototype.setPrize = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.ipc.TournamentDivisionDataResponse} returns this
+ * @return {!proto.ipc.PrizeAward} returns this
  */
-proto.ipc.TournamentDivisionDataResponse.prototype.clearPlayers = function() {
-  return this.setPlayers(undefined);
+proto.ipc.PrizeAward.prototype.clearPrize = function() {
+  return this.setPrize(undefined);
 };
 
 
@@ -5471,145 +12009,118 @@ proto.ipc.TournamentDivisionDataResponse.prototype.clearPlayers = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.ipc.TournamentDivisionDataResponse.prototype.hasPlayers = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.ipc.PrizeAward.prototype.hasPrize = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * map<int32, RoundStandings> standings = 4;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<number,!proto.ipc.RoundStandings>}
+ * optional string division = 2;
+ * @return {string}
  */
-proto.ipc.TournamentDivisionDataResponse.prototype.getStandingsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<number,!proto.ipc.RoundStandings>} */ (
-      jspb.Message.getMapField(this, 4, opt_noLazyCreate,
-      proto.ipc.RoundStandings));
+proto.ipc.PrizeAward.prototype.getDivision = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.ipc.TournamentDivisionDataResponse} returns this
- */
-proto.ipc.TournamentDivisionDataResponse.prototype.clearStandingsMap = function() {
-  this.getStandingsMap().clear();
-  return this;};
-
-
-/**
- * map<string, Pairing> pairing_map = 5;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.ipc.Pairing>}
+ * @param {string} value
+ * @return {!proto.ipc.PrizeAward} returns this
  */
-proto.ipc.TournamentDivisionDataResponse.prototype.getPairingMapMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.ipc.Pairing>} */ (
-      jspb.Message.getMapField(this, 5, opt_noLazyCreate,
-      proto.ipc.Pairing));
+proto.ipc.PrizeAward.prototype.setDivision = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.ipc.TournamentDivisionDataResponse} returns this
- */
-proto.ipc.TournamentDivisionDataResponse.prototype.clearPairingMapMap = function() {
-  this.getPairingMapMap().clear();
-  return this;};
-
-
-/**
- * optional DivisionControls controls = 6;
- * @return {?proto.ipc.DivisionControls}
+ * repeated string player_ids = 3;
+ * @return {!Array<string>}
  */
-proto.ipc.TournamentDivisionDataResponse.prototype.getControls = function() {
-  return /** @type{?proto.ipc.DivisionControls} */ (
-    jspb.Message.getWrapperField(this, proto.ipc.DivisionControls, 6));
+proto.ipc.PrizeAward.prototype.getPlayerIdsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
 };
 
 
 /**
- * @param {?proto.ipc.DivisionControls|undefined} value
- * @return {!proto.ipc.TournamentDivisionDataResponse} returns this
-*/
-proto.ipc.TournamentDivisionDataResponse.prototype.setControls = function(value) {
-  return jspb.Message.setWrapperField(this, 6, value);
+ * @param {!Array<string>} value
+ * @return {!proto.ipc.PrizeAward} returns this
+ */
+proto.ipc.PrizeAward.prototype.setPlayerIdsList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.ipc.TournamentDivisionDataResponse} returns this
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.PrizeAward} returns this
  */
-proto.ipc.TournamentDivisionDataResponse.prototype.clearControls = function() {
-  return this.setControls(undefined);
+proto.ipc.PrizeAward.prototype.addPlayerIds = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ipc.PrizeAward} returns this
  */
-proto.ipc.TournamentDivisionDataResponse.prototype.hasControls = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.ipc.PrizeAward.prototype.clearPlayerIdsList = function() {
+  return this.setPlayerIdsList([]);
 };
 
 
 /**
- * repeated RoundControl round_controls = 7;
- * @return {!Array<!proto.ipc.RoundControl>}
+ * optional int32 score = 5;
+ * @return {number}
  */
-proto.ipc.TournamentDivisionDataResponse.prototype.getRoundControlsList = function() {
-  return /** @type{!Array<!proto.ipc.RoundControl>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ipc.RoundControl, 7));
+proto.ipc.PrizeAward.prototype.getScore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * @param {!Array<!proto.ipc.RoundControl>} value
- * @return {!proto.ipc.TournamentDivisionDataResponse} returns this
-*/
-proto.ipc.TournamentDivisionDataResponse.prototype.setRoundControlsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 7, value);
+ * @param {number} value
+ * @return {!proto.ipc.PrizeAward} returns this
+ */
+proto.ipc.PrizeAward.prototype.setScore = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
 /**
- * @param {!proto.ipc.RoundControl=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ipc.RoundControl}
+ * repeated int64 amounts = 6;
+ * @return {!Array<number>}
  */
-proto.ipc.TournamentDivisionDataResponse.prototype.addRoundControls = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 7, opt_value, proto.ipc.RoundControl, opt_index);
+proto.ipc.PrizeAward.prototype.getAmountsList = function() {
+  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedField(this, 6));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ipc.TournamentDivisionDataResponse} returns this
+ * @param {!Array<number>} value
+ * @return {!proto.ipc.PrizeAward} returns this
  */
-proto.ipc.TournamentDivisionDataResponse.prototype.clearRoundControlsList = function() {
-  return this.setRoundControlsList([]);
+proto.ipc.PrizeAward.prototype.setAmountsList = function(value) {
+  return jspb.Message.setField(this, 6, value || []);
 };
 
 
 /**
- * optional int32 current_round = 8;
- * @return {number}
+ * @param {number} value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.PrizeAward} returns this
  */
-proto.ipc.TournamentDivisionDataResponse.prototype.getCurrentRound = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+proto.ipc.PrizeAward.prototype.addAmounts = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ipc.TournamentDivisionDataResponse} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ipc.PrizeAward} returns this
  */
-proto.ipc.TournamentDivisionDataResponse.prototype.setCurrentRound = function(value) {
-  return jspb.Message.setProto3IntField(this, 8, value);
+proto.ipc.PrizeAward.prototype.clearAmountsList = function() {
+  return this.setAmountsList([]);
 };
 
 
@@ -5629,8 +12140,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ipc.FullTournamentDivisions.prototype.toObject = function(opt_includeInstance) {
-  return proto.ipc.FullTournamentDivisions.toObject(opt_includeInstance, this);
+proto.ipc.LadderChallengeAccept.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.LadderChallengeAccept.toObject(opt_includeInstance, this);
 };
 
 
@@ -5639,14 +12150,14 @@ proto.ipc.FullTournamentDivisions.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ipc.FullTournamentDivisions} msg The msg instance to transform.
+ * @param {!proto.ipc.LadderChallengeAccept} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.FullTournamentDivisions.toObject = function(includeInstance, msg) {
+proto.ipc.LadderChallengeAccept.toObject = function(includeInstance, msg) {
   var f, obj = {
-    divisionsMap: (f = msg.getDivisionsMap()) ? f.toObject(includeInstance, proto.ipc.TournamentDivisionDataResponse.toObject) : [],
-    started: jspb.Message.getBooleanFieldWithDefault(msg, 2, false)
+    tournamentId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    challengeId: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -5660,23 +12171,23 @@ proto.ipc.FullTournamentDivisions.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ipc.FullTournamentDivisions}
+ * @return {!proto.ipc.LadderChallengeAccept}
  */
-proto.ipc.FullTournamentDivisions.deserializeBinary = function(bytes) {
+proto.ipc.LadderChallengeAccept.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ipc.FullTournamentDivisions;
-  return proto.ipc.FullTournamentDivisions.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ipc.LadderChallengeAccept;
+  return proto.ipc.LadderChallengeAccept.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ipc.FullTournamentDivisions} msg The message object to deserialize into.
+ * @param {!proto.ipc.LadderChallengeAccept} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ipc.FullTournamentDivisions}
+ * @return {!proto.ipc.LadderChallengeAccept}
  */
-proto.ipc.FullTournamentDivisions.deserializeBinaryFromReader = function(msg, reader) {
+proto.ipc.LadderChallengeAccept.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5684,14 +12195,12 @@ proto.ipc.FullTournamentDivisions.deserializeBinaryFromReader = function(msg, re
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getDivisionsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.ipc.TournamentDivisionDataResponse.deserializeBinaryFromReader, "", new proto.ipc.TournamentDivisionDataResponse());
-         });
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTournamentId(value);
       break;
     case 2:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setStarted(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setChallengeId(value);
       break;
     default:
       reader.skipField();
@@ -5706,9 +12215,9 @@ proto.ipc.FullTournamentDivisions.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ipc.FullTournamentDivisions.prototype.serializeBinary = function() {
+proto.ipc.LadderChallengeAccept.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ipc.FullTournamentDivisions.serializeBinaryToWriter(this, writer);
+  proto.ipc.LadderChallengeAccept.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5716,19 +12225,22 @@ proto.ipc.FullTournamentDivisions.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ipc.FullTournamentDivisions} message
+ * @param {!proto.ipc.LadderChallengeAccept} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.FullTournamentDivisions.serializeBinaryToWriter = function(message, writer) {
+proto.ipc.LadderChallengeAccept.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getDivisionsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.ipc.TournamentDivisionDataResponse.serializeBinaryToWriter);
+  f = message.getTournamentId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
   }
-  f = message.getStarted();
-  if (f) {
-    writer.writeBool(
+  f = message.getChallengeId();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
@@ -5737,42 +12249,38 @@ proto.ipc.FullTournamentDivisions.serializeBinaryToWriter = function(message, wr
 
 
 /**
- * map<string, TournamentDivisionDataResponse> divisions = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.ipc.TournamentDivisionDataResponse>}
+ * optional string tournament_id = 1;
+ * @return {string}
  */
-proto.ipc.FullTournamentDivisions.prototype.getDivisionsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.ipc.TournamentDivisionDataResponse>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      proto.ipc.TournamentDivisionDataResponse));
+proto.ipc.LadderChallengeAccept.prototype.getTournamentId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.ipc.FullTournamentDivisions} returns this
+ * @param {string} value
+ * @return {!proto.ipc.LadderChallengeAccept} returns this
  */
-proto.ipc.FullTournamentDivisions.prototype.clearDivisionsMap = function() {
-  this.getDivisionsMap().clear();
-  return this;};
+proto.ipc.LadderChallengeAccept.prototype.setTournamentId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
 
 
 /**
- * optional bool started = 2;
- * @return {boolean}
+ * optional string challenge_id = 2;
+ * @return {string}
  */
-proto.ipc.FullTournamentDivisions.prototype.getStarted = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+proto.ipc.LadderChallengeAccept.prototype.getChallengeId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.ipc.FullTournamentDivisions} returns this
+ * @param {string} value
+ * @return {!proto.ipc.LadderChallengeAccept} returns this
  */
-proto.ipc.FullTournamentDivisions.prototype.setStarted = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 2, value);
+proto.ipc.LadderChallengeAccept.prototype.setChallengeId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -5792,8 +12300,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ipc.TournamentFinishedResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.ipc.TournamentFinishedResponse.toObject(opt_includeInstance, this);
+proto.ipc.LadderChallengeDecline.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.LadderChallengeDecline.toObject(opt_includeInstance, this);
 };
 
 
@@ -5802,13 +12310,14 @@ proto.ipc.TournamentFinishedResponse.prototype.toObject = function(opt_includeIn
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ipc.TournamentFinishedResponse} msg The msg instance to transform.
+ * @param {!proto.ipc.LadderChallengeDecline} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.TournamentFinishedResponse.toObject = function(includeInstance, msg) {
+proto.ipc.LadderChallengeDecline.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+    tournamentId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    challengeId: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -5822,23 +12331,23 @@ proto.ipc.TournamentFinishedResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ipc.TournamentFinishedResponse}
+ * @return {!proto.ipc.LadderChallengeDecline}
  */
-proto.ipc.TournamentFinishedResponse.deserializeBinary = function(bytes) {
+proto.ipc.LadderChallengeDecline.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ipc.TournamentFinishedResponse;
-  return proto.ipc.TournamentFinishedResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ipc.LadderChallengeDecline;
+  return proto.ipc.LadderChallengeDecline.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ipc.TournamentFinishedResponse} msg The message object to deserialize into.
+ * @param {!proto.ipc.LadderChallengeDecline} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ipc.TournamentFinishedResponse}
+ * @return {!proto.ipc.LadderChallengeDecline}
  */
-proto.ipc.TournamentFinishedResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.ipc.LadderChallengeDecline.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5847,7 +12356,11 @@ proto.ipc.TournamentFinishedResponse.deserializeBinaryFromReader = function(msg,
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      msg.setTournamentId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setChallengeId(value);
       break;
     default:
       reader.skipField();
@@ -5862,9 +12375,9 @@ proto.ipc.TournamentFinishedResponse.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ipc.TournamentFinishedResponse.prototype.serializeBinary = function() {
+proto.ipc.LadderChallengeDecline.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ipc.TournamentFinishedResponse.serializeBinaryToWriter(this, writer);
+  proto.ipc.LadderChallengeDecline.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5872,40 +12385,65 @@ proto.ipc.TournamentFinishedResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ipc.TournamentFinishedResponse} message
+ * @param {!proto.ipc.LadderChallengeDecline} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.TournamentFinishedResponse.serializeBinaryToWriter = function(message, writer) {
+proto.ipc.LadderChallengeDecline.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getTournamentId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getChallengeId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string id = 1;
+ * optional string tournament_id = 1;
  * @return {string}
  */
-proto.ipc.TournamentFinishedResponse.prototype.getId = function() {
+proto.ipc.LadderChallengeDecline.prototype.getTournamentId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ipc.TournamentFinishedResponse} returns this
+ * @return {!proto.ipc.LadderChallengeDecline} returns this
  */
-proto.ipc.TournamentFinishedResponse.prototype.setId = function(value) {
+proto.ipc.LadderChallengeDecline.prototype.setTournamentId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional string challenge_id = 2;
+ * @return {string}
+ */
+proto.ipc.LadderChallengeDecline.prototype.getChallengeId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ipc.LadderChallengeDecline} returns this
+ */
+proto.ipc.LadderChallengeDecline.prototype.setChallengeId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
 
 
 
@@ -5922,8 +12460,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ipc.TournamentDataResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.ipc.TournamentDataResponse.toObject(opt_includeInstance, this);
+proto.ipc.PlayerPairingReport.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.PlayerPairingReport.toObject(opt_includeInstance, this);
 };
 
 
@@ -5932,19 +12470,20 @@ proto.ipc.TournamentDataResponse.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ipc.TournamentDataResponse} msg The msg instance to transform.
+ * @param {!proto.ipc.PlayerPairingReport} msg The msg instance to transform.
  * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.ipc.TournamentDataResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    description: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    executiveDirector: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    directors: (f = msg.getDirectors()) && proto.ipc.TournamentPersons.toObject(includeInstance, f),
-    isStarted: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
-    startTime: (f = msg.getStartTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.PlayerPairingReport.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    playerId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    games: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    repeats: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    firsts: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    seconds: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    byes: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    averageOpponentRating: jspb.Message.getFloatingPointFieldWithDefault(msg, 7, 0.0),
+    opponentRatingVariance: jspb.Message.getFloatingPointFieldWithDefault(msg, 8, 0.0)
   };
 
   if (includeInstance) {
@@ -5958,23 +12497,23 @@ proto.ipc.TournamentDataResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ipc.TournamentDataResponse}
+ * @return {!proto.ipc.PlayerPairingReport}
  */
-proto.ipc.TournamentDataResponse.deserializeBinary = function(bytes) {
+proto.ipc.PlayerPairingReport.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ipc.TournamentDataResponse;
-  return proto.ipc.TournamentDataResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ipc.PlayerPairingReport;
+  return proto.ipc.PlayerPairingReport.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ipc.TournamentDataResponse} msg The message object to deserialize into.
+ * @param {!proto.ipc.PlayerPairingReport} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ipc.TournamentDataResponse}
+ * @return {!proto.ipc.PlayerPairingReport}
  */
-proto.ipc.TournamentDataResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.ipc.PlayerPairingReport.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5983,33 +12522,35 @@ proto.ipc.TournamentDataResponse.deserializeBinaryFromReader = function(msg, rea
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      msg.setPlayerId(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setGames(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDescription(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRepeats(value);
       break;
     case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setExecutiveDirector(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setFirsts(value);
       break;
     case 5:
-      var value = new proto.ipc.TournamentPersons;
-      reader.readMessage(value,proto.ipc.TournamentPersons.deserializeBinaryFromReader);
-      msg.setDirectors(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setSeconds(value);
       break;
     case 6:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsStarted(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setByes(value);
       break;
     case 7:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setStartTime(value);
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setAverageOpponentRating(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setOpponentRatingVariance(value);
       break;
     default:
       reader.skipField();
@@ -6024,9 +12565,9 @@ proto.ipc.TournamentDataResponse.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ipc.TournamentDataResponse.prototype.serializeBinary = function() {
+proto.ipc.PlayerPairingReport.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ipc.TournamentDataResponse.serializeBinaryToWriter(this, writer);
+  proto.ipc.PlayerPairingReport.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6034,230 +12575,472 @@ proto.ipc.TournamentDataResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ipc.TournamentDataResponse} message
+ * @param {!proto.ipc.PlayerPairingReport} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.TournamentDataResponse.serializeBinaryToWriter = function(message, writer) {
+proto.ipc.PlayerPairingReport.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getPlayerId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getGames();
+  if (f !== 0) {
+    writer.writeInt32(
       2,
       f
     );
   }
-  f = message.getDescription();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getRepeats();
+  if (f !== 0) {
+    writer.writeInt32(
       3,
       f
     );
   }
-  f = message.getExecutiveDirector();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getFirsts();
+  if (f !== 0) {
+    writer.writeInt32(
       4,
       f
     );
   }
-  f = message.getDirectors();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getSeconds();
+  if (f !== 0) {
+    writer.writeInt32(
       5,
-      f,
-      proto.ipc.TournamentPersons.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getIsStarted();
-  if (f) {
-    writer.writeBool(
+  f = message.getByes();
+  if (f !== 0) {
+    writer.writeInt32(
       6,
       f
     );
   }
-  f = message.getStartTime();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getAverageOpponentRating();
+  if (f !== 0.0) {
+    writer.writeDouble(
       7,
-      f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getOpponentRatingVariance();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      8,
+      f
     );
   }
 };
 
 
 /**
- * optional string id = 1;
+ * optional string player_id = 1;
  * @return {string}
  */
-proto.ipc.TournamentDataResponse.prototype.getId = function() {
+proto.ipc.PlayerPairingReport.prototype.getPlayerId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ipc.TournamentDataResponse} returns this
+ * @return {!proto.ipc.PlayerPairingReport} returns this
  */
-proto.ipc.TournamentDataResponse.prototype.setId = function(value) {
+proto.ipc.PlayerPairingReport.prototype.setPlayerId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string name = 2;
- * @return {string}
+ * optional int32 games = 2;
+ * @return {number}
  */
-proto.ipc.TournamentDataResponse.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.ipc.PlayerPairingReport.prototype.getGames = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.PlayerPairingReport} returns this
+ */
+proto.ipc.PlayerPairingReport.prototype.setGames = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int32 repeats = 3;
+ * @return {number}
+ */
+proto.ipc.PlayerPairingReport.prototype.getRepeats = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.PlayerPairingReport} returns this
+ */
+proto.ipc.PlayerPairingReport.prototype.setRepeats = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional int32 firsts = 4;
+ * @return {number}
+ */
+proto.ipc.PlayerPairingReport.prototype.getFirsts = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.PlayerPairingReport} returns this
+ */
+proto.ipc.PlayerPairingReport.prototype.setFirsts = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional int32 seconds = 5;
+ * @return {number}
+ */
+proto.ipc.PlayerPairingReport.prototype.getSeconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.PlayerPairingReport} returns this
+ */
+proto.ipc.PlayerPairingReport.prototype.setSeconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional int32 byes = 6;
+ * @return {number}
+ */
+proto.ipc.PlayerPairingReport.prototype.getByes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.PlayerPairingReport} returns this
+ */
+proto.ipc.PlayerPairingReport.prototype.setByes = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional double average_opponent_rating = 7;
+ * @return {number}
+ */
+proto.ipc.PlayerPairingReport.prototype.getAverageOpponentRating = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 7, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.PlayerPairingReport} returns this
+ */
+proto.ipc.PlayerPairingReport.prototype.setAverageOpponentRating = function(value) {
+  return jspb.Message.setProto3FloatField(this, 7, value);
+};
+
+
+/**
+ * optional double opponent_rating_variance = 8;
+ * @return {number}
+ */
+proto.ipc.PlayerPairingReport.prototype.getOpponentRatingVariance = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 8, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ipc.PlayerPairingReport} returns this
+ */
+proto.ipc.PlayerPairingReport.prototype.setOpponentRatingVariance = function(value) {
+  return jspb.Message.setProto3FloatField(this, 8, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ipc.RoundPairingReport.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.RoundPairingReport.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ipc.RoundPairingReport} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ipc.RoundPairingReport.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    round: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    pairingMethod: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    games: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    repeats: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    mismatchedGames: jspb.Message.getFieldWithDefault(msg, 5, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ipc.RoundPairingReport}
+ */
+proto.ipc.RoundPairingReport.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ipc.RoundPairingReport;
+  return proto.ipc.RoundPairingReport.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ipc.RoundPairingReport} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ipc.RoundPairingReport}
+ */
+proto.ipc.RoundPairingReport.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRound(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.ipc.PairingMethod} */ (reader.readEnum());
+      msg.setPairingMethod(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setGames(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRepeats(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMismatchedGames(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ipc.TournamentDataResponse} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.ipc.TournamentDataResponse.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.ipc.RoundPairingReport.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ipc.RoundPairingReport.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * optional string description = 3;
- * @return {string}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ipc.RoundPairingReport} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.TournamentDataResponse.prototype.getDescription = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.ipc.RoundPairingReport.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRound();
+  if (f !== 0) {
+    writer.writeInt32(
+      1,
+      f
+    );
+  }
+  f = message.getPairingMethod();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = message.getGames();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getRepeats();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+  f = message.getMismatchedGames();
+  if (f !== 0) {
+    writer.writeInt32(
+      5,
+      f
+    );
+  }
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ipc.TournamentDataResponse} returns this
+ * optional int32 round = 1;
+ * @return {number}
  */
-proto.ipc.TournamentDataResponse.prototype.setDescription = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.ipc.RoundPairingReport.prototype.getRound = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * optional string executive_director = 4;
- * @return {string}
+ * @param {number} value
+ * @return {!proto.ipc.RoundPairingReport} returns this
  */
-proto.ipc.TournamentDataResponse.prototype.getExecutiveDirector = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.ipc.RoundPairingReport.prototype.setRound = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ipc.TournamentDataResponse} returns this
+ * optional PairingMethod pairing_method = 2;
+ * @return {!proto.ipc.PairingMethod}
  */
-proto.ipc.TournamentDataResponse.prototype.setExecutiveDirector = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.ipc.RoundPairingReport.prototype.getPairingMethod = function() {
+  return /** @type {!proto.ipc.PairingMethod} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * optional TournamentPersons directors = 5;
- * @return {?proto.ipc.TournamentPersons}
+ * @param {!proto.ipc.PairingMethod} value
+ * @return {!proto.ipc.RoundPairingReport} returns this
  */
-proto.ipc.TournamentDataResponse.prototype.getDirectors = function() {
-  return /** @type{?proto.ipc.TournamentPersons} */ (
-    jspb.Message.getWrapperField(this, proto.ipc.TournamentPersons, 5));
-};
-
-
-/**
- * @param {?proto.ipc.TournamentPersons|undefined} value
- * @return {!proto.ipc.TournamentDataResponse} returns this
-*/
-proto.ipc.TournamentDataResponse.prototype.setDirectors = function(value) {
-  return jspb.Message.setWrapperField(this, 5, value);
+proto.ipc.RoundPairingReport.prototype.setPairingMethod = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.ipc.TournamentDataResponse} returns this
+ * optional int32 games = 3;
+ * @return {number}
  */
-proto.ipc.TournamentDataResponse.prototype.clearDirectors = function() {
-  return this.setDirectors(undefined);
+proto.ipc.RoundPairingReport.prototype.getGames = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.ipc.RoundPairingReport} returns this
  */
-proto.ipc.TournamentDataResponse.prototype.hasDirectors = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.ipc.RoundPairingReport.prototype.setGames = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * optional bool is_started = 6;
- * @return {boolean}
+ * optional int32 repeats = 4;
+ * @return {number}
  */
-proto.ipc.TournamentDataResponse.prototype.getIsStarted = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+proto.ipc.RoundPairingReport.prototype.getRepeats = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.ipc.TournamentDataResponse} returns this
+ * @param {number} value
+ * @return {!proto.ipc.RoundPairingReport} returns this
  */
-proto.ipc.TournamentDataResponse.prototype.setIsStarted = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 6, value);
+proto.ipc.RoundPairingReport.prototype.setRepeats = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * optional google.protobuf.Timestamp start_time = 7;
- * @return {?proto.google.protobuf.Timestamp}
+ * optional int32 mismatched_games = 5;
+ * @return {number}
  */
-proto.ipc.TournamentDataResponse.prototype.getStartTime = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 7));
-};
-
-
-/**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.ipc.TournamentDataResponse} returns this
-*/
-proto.ipc.TournamentDataResponse.prototype.setStartTime = function(value) {
-  return jspb.Message.setWrapperField(this, 7, value);
+proto.ipc.RoundPairingReport.prototype.getMismatchedGames = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.ipc.TournamentDataResponse} returns this
+ * @param {number} value
+ * @return {!proto.ipc.RoundPairingReport} returns this
  */
-proto.ipc.TournamentDataResponse.prototype.clearStartTime = function() {
-  return this.setStartTime(undefined);
+proto.ipc.RoundPairingReport.prototype.setMismatchedGames = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
+
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.ipc.TournamentDataResponse.prototype.hasStartTime = function() {
-  return jspb.Message.getField(this, 7) != null;
-};
-
-
+proto.ipc.PairingReport.repeatedFields_ = [3,4];
 
 
 
@@ -6274,8 +13057,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ipc.TournamentDivisionDeletedResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.ipc.TournamentDivisionDeletedResponse.toObject(opt_includeInstance, this);
+proto.ipc.PairingReport.prototype.toObject = function(opt_includeInstance) {
+  return proto.ipc.PairingReport.toObject(opt_includeInstance, this);
 };
 
 
@@ -6284,14 +13067,18 @@ proto.ipc.TournamentDivisionDeletedResponse.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ipc.TournamentDivisionDeletedResponse} msg The msg instance to transform.
+ * @param {!proto.ipc.PairingReport} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.TournamentDivisionDeletedResponse.toObject = function(includeInstance, msg) {
+proto.ipc.PairingReport.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    division: jspb.Message.getFieldWithDefault(msg, 2, "")
+    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    playersList: jspb.Message.toObjectList(msg.getPlayersList(),
+    proto.ipc.PlayerPairingReport.toObject, includeInstance),
+    roundsList: jspb.Message.toObjectList(msg.getRoundsList(),
+    proto.ipc.RoundPairingReport.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -6305,23 +13092,23 @@ proto.ipc.TournamentDivisionDeletedResponse.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ipc.TournamentDivisionDeletedResponse}
+ * @return {!proto.ipc.PairingReport}
  */
-proto.ipc.TournamentDivisionDeletedResponse.deserializeBinary = function(bytes) {
+proto.ipc.PairingReport.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ipc.TournamentDivisionDeletedResponse;
-  return proto.ipc.TournamentDivisionDeletedResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ipc.PairingReport;
+  return proto.ipc.PairingReport.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ipc.TournamentDivisionDeletedResponse} msg The message object to deserialize into.
+ * @param {!proto.ipc.PairingReport} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ipc.TournamentDivisionDeletedResponse}
+ * @return {!proto.ipc.PairingReport}
  */
-proto.ipc.TournamentDivisionDeletedResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.ipc.PairingReport.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6336,6 +13123,16 @@ proto.ipc.TournamentDivisionDeletedResponse.deserializeBinaryFromReader = functi
       var value = /** @type {string} */ (reader.readString());
       msg.setDivision(value);
       break;
+    case 3:
+      var value = new proto.ipc.PlayerPairingReport;
+      reader.readMessage(value,proto.ipc.PlayerPairingReport.deserializeBinaryFromReader);
+      msg.addPlayers(value);
+      break;
+    case 4:
+      var value = new proto.ipc.RoundPairingReport;
+      reader.readMessage(value,proto.ipc.RoundPairingReport.deserializeBinaryFromReader);
+      msg.addRounds(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -6349,9 +13146,9 @@ proto.ipc.TournamentDivisionDeletedResponse.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ipc.TournamentDivisionDeletedResponse.prototype.serializeBinary = function() {
+proto.ipc.PairingReport.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ipc.TournamentDivisionDeletedResponse.serializeBinaryToWriter(this, writer);
+  proto.ipc.PairingReport.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6359,11 +13156,11 @@ proto.ipc.TournamentDivisionDeletedResponse.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ipc.TournamentDivisionDeletedResponse} message
+ * @param {!proto.ipc.PairingReport} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ipc.TournamentDivisionDeletedResponse.serializeBinaryToWriter = function(message, writer) {
+proto.ipc.PairingReport.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f.length > 0) {
@@ -6379,6 +13176,22 @@ proto.ipc.TournamentDivisionDeletedResponse.serializeBinaryToWriter = function(m
       f
     );
   }
+  f = message.getPlayersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.ipc.PlayerPairingReport.serializeBinaryToWriter
+    );
+  }
+  f = message.getRoundsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.ipc.RoundPairingReport.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -6386,16 +13199,16 @@ proto.ipc.TournamentDivisionDeletedResponse.serializeBinaryToWriter = function(m
  * optional string id = 1;
  * @return {string}
  */
-proto.ipc.TournamentDivisionDeletedResponse.prototype.getId = function() {
+proto.ipc.PairingReport.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ipc.TournamentDivisionDeletedResponse} returns this
+ * @return {!proto.ipc.PairingReport} returns this
  */
-proto.ipc.TournamentDivisionDeletedResponse.prototype.setId = function(value) {
+proto.ipc.PairingReport.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
@@ -6404,20 +13217,96 @@ proto.ipc.TournamentDivisionDeletedResponse.prototype.setId = function(value) {
  * optional string division = 2;
  * @return {string}
  */
-proto.ipc.TournamentDivisionDeletedResponse.prototype.getDivision = function() {
+proto.ipc.PairingReport.prototype.getDivision = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ipc.TournamentDivisionDeletedResponse} returns this
+ * @return {!proto.ipc.PairingReport} returns this
  */
-proto.ipc.TournamentDivisionDeletedResponse.prototype.setDivision = function(value) {
+proto.ipc.PairingReport.prototype.setDivision = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+/**
+ * repeated PlayerPairingReport players = 3;
+ * @return {!Array<!proto.ipc.PlayerPairingReport>}
+ */
+proto.ipc.PairingReport.prototype.getPlayersList = function() {
+  return /** @type{!Array<!proto.ipc.PlayerPairingReport>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ipc.PlayerPairingReport, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.ipc.PlayerPairingReport>} value
+ * @return {!proto.ipc.PairingReport} returns this
+*/
+proto.ipc.PairingReport.prototype.setPlayersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.ipc.PlayerPairingReport=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.PlayerPairingReport}
+ */
+proto.ipc.PairingReport.prototype.addPlayers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.ipc.PlayerPairingReport, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ipc.PairingReport} returns this
+ */
+proto.ipc.PairingReport.prototype.clearPlayersList = function() {
+  return this.setPlayersList([]);
+};
+
+
+/**
+ * repeated RoundPairingReport rounds = 4;
+ * @return {!Array<!proto.ipc.RoundPairingReport>}
+ */
+proto.ipc.PairingReport.prototype.getRoundsList = function() {
+  return /** @type{!Array<!proto.ipc.RoundPairingReport>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ipc.RoundPairingReport, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.ipc.RoundPairingReport>} value
+ * @return {!proto.ipc.PairingReport} returns this
+*/
+proto.ipc.PairingReport.prototype.setRoundsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.ipc.RoundPairingReport=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.RoundPairingReport}
+ */
+proto.ipc.PairingReport.prototype.addRounds = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.ipc.RoundPairingReport, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ipc.PairingReport} returns this
+ */
+proto.ipc.PairingReport.prototype.clearRoundsList = function() {
+  return this.setRoundsList([]);
+};
+
+
 /**
  * @enum {number}
  */
@@ -6446,7 +13335,10 @@ proto.ipc.PairingMethod = {
   SWISS: 6,
   QUICKPAIR: 7,
   MANUAL: 8,
-  TEAM_ROUND_ROBIN: 9
+  TEAM_ROUND_ROBIN: 9,
+  DOUBLE_ELIMINATION: 10,
+  SEEDED_SPLIT: 11,
+  SEEDED_FOLD: 12
 };
 
 /**
@@ -6458,4 +13350,48 @@ proto.ipc.FirstMethod = {
   AUTOMATIC_FIRST: 2
 };
 
+/**
+ * @enum {number}
+ */
+proto.ipc.WithdrawalPolicy = {
+  WITHDRAW_DROP: 0,
+  WITHDRAW_FORFEIT: 1
+};
+
+/**
+ * @enum {number}
+ */
+proto.ipc.BracketSide = {
+  WINNERS_BRACKET: 0,
+  LOSERS_BRACKET: 1,
+  GRAND_FINAL: 2,
+  CONSOLATION_BRACKET: 3
+};
+
+/**
+ * @enum {number}
+ */
+proto.ipc.ClubRole = {
+  CLUB_MEMBER: 0,
+  CLUB_OFFICER: 1
+};
+
+/**
+ * @enum {number}
+ */
+proto.ipc.PrizeType = {
+  PLACE_PRIZE: 0,
+  CLASS_PRIZE: 1,
+  HIGH_GAME_PRIZE: 2,
+  HIGH_LOSS_PRIZE: 3
+};
+
+/**
+ * @enum {number}
+ */
+proto.ipc.PrizeTieRule = {
+  SPLIT_BY_WINS_AND_SPREAD: 0,
+  SPLIT_BY_WINS: 1
+};
+
 goog.object.extend(exports, proto.ipc);
@@ -115,6 +115,14 @@ export class TournamentMetadata extends jspb.Message {
   getPrivateAnalysis(): boolean;
   setPrivateAnalysis(value: boolean): void;
 
+  clearPrizesList(): void;
+  getPrizesList(): Array<api_proto_ipc_tournament_pb.Prize>;
+  setPrizesList(value: Array<api_proto_ipc_tournament_pb.Prize>): void;
+  addPrizes(value?: api_proto_ipc_tournament_pb.Prize, index?: number): api_proto_ipc_tournament_pb.Prize;
+
+  getPrizeTieRule(): api_proto_ipc_tournament_pb.PrizeTieRuleMap[keyof api_proto_ipc_tournament_pb.PrizeTieRuleMap];
+  setPrizeTieRule(value: api_proto_ipc_tournament_pb.PrizeTieRuleMap[keyof api_proto_ipc_tournament_pb.PrizeTieRuleMap]): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): TournamentMetadata.AsObject;
   static toObject(includeInstance: boolean, msg: TournamentMetadata): TournamentMetadata.AsObject;
@@ -141,6 +149,8 @@ export namespace TournamentMetadata {
     logo: string,
     color: string,
     privateAnalysis: boolean,
+    prizesList: Array<api_proto_ipc_tournament_pb.Prize.AsObject>,
+    prizeTieRule: api_proto_ipc_tournament_pb.PrizeTieRuleMap[keyof api_proto_ipc_tournament_pb.PrizeTieRuleMap],
   }
 }
 
@@ -236,6 +246,44 @@ export namespace PairRoundRequest {
   }
 }
 
+export class PairingPreviewRequest extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getDivision(): string;
+  setDivision(value: string): void;
+
+  getRound(): number;
+  setRound(value: number): void;
+
+  hasRoundControls(): boolean;
+  clearRoundControls(): void;
+  getRoundControls(): api_proto_ipc_tournament_pb.RoundControl | undefined;
+  setRoundControls(value?: api_proto_ipc_tournament_pb.RoundControl): void;
+
+  getPreserveByes(): boolean;
+  setPreserveByes(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PairingPreviewRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: PairingPreviewRequest): PairingPreviewRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: PairingPreviewRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PairingPreviewRequest;
+  static deserializeBinaryFromReader(message: PairingPreviewRequest, reader: jspb.BinaryReader): PairingPreviewRequest;
+}
+
+export namespace PairingPreviewRequest {
+  export type AsObject = {
+    id: string,
+    division: string,
+    round: number,
+    roundControls?: api_proto_ipc_tournament_pb.RoundControl.AsObject,
+    preserveByes: boolean,
+  }
+}
+
 export class TournamentDivisionRequest extends jspb.Message {
   getId(): string;
   setId(value: string): void;
@@ -522,6 +570,36 @@ export namespace FinishTournamentRequest {
   }
 }
 
+export class ScheduledRound extends jspb.Message {
+  getDivision(): string;
+  setDivision(value: string): void;
+
+  getRound(): number;
+  setRound(value: number): void;
+
+  hasStartTime(): boolean;
+  clearStartTime(): void;
+  getStartTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setStartTime(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ScheduledRound.AsObject;
+  static toObject(includeInstance: boolean, msg: ScheduledRound): ScheduledRound.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ScheduledRound, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ScheduledRound;
+  static deserializeBinaryFromReader(message: ScheduledRound, reader: jspb.BinaryReader): ScheduledRound;
+}
+
+export namespace ScheduledRound {
+  export type AsObject = {
+    division: string,
+    round: number,
+    startTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+}
+
 export class TournamentMetadataResponse extends jspb.Message {
   hasMetadata(): boolean;
   clearMetadata(): void;
@@ -533,6 +611,16 @@ export class TournamentMetadataResponse extends jspb.Message {
   setDirectorsList(value: Array<string>): void;
   addDirectors(value: string, index?: number): string;
 
+  clearScheduleList(): void;
+  getScheduleList(): Array<ScheduledRound>;
+  setScheduleList(value: Array<ScheduledRound>): void;
+  addSchedule(value?: ScheduledRound, index?: number): ScheduledRound;
+
+  clearPrizeAwardsList(): void;
+  getPrizeAwardsList(): Array<api_proto_ipc_tournament_pb.PrizeAward>;
+  setPrizeAwardsList(value: Array<api_proto_ipc_tournament_pb.PrizeAward>): void;
+  addPrizeAwards(value?: api_proto_ipc_tournament_pb.PrizeAward, index?: number): api_proto_ipc_tournament_pb.PrizeAward;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): TournamentMetadataResponse.AsObject;
   static toObject(includeInstance: boolean, msg: TournamentMetadataResponse): TournamentMetadataResponse.AsObject;
@@ -547,6 +635,8 @@ export namespace TournamentMetadataResponse {
   export type AsObject = {
     metadata?: TournamentMetadata.AsObject,
     directorsList: Array<string>,
+    scheduleList: Array<ScheduledRound.AsObject>,
+    prizeAwardsList: Array<api_proto_ipc_tournament_pb.PrizeAward.AsObject>,
   }
 }
 
@@ -620,6 +710,74 @@ export namespace UnstartTournamentRequest {
   }
 }
 
+export class WithdrawPlayersRequest extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getDivision(): string;
+  setDivision(value: string): void;
+
+  clearPlayersList(): void;
+  getPlayersList(): Array<string>;
+  setPlayersList(value: Array<string>): void;
+  addPlayers(value: string, index?: number): string;
+
+  getPolicy(): api_proto_ipc_tournament_pb.WithdrawalPolicyMap[keyof api_proto_ipc_tournament_pb.WithdrawalPolicyMap];
+  setPolicy(value: api_proto_ipc_tournament_pb.WithdrawalPolicyMap[keyof api_proto_ipc_tournament_pb.WithdrawalPolicyMap]): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): WithdrawPlayersRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: WithdrawPlayersRequest): WithdrawPlayersRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: WithdrawPlayersRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): WithdrawPlayersRequest;
+  static deserializeBinaryFromReader(message: WithdrawPlayersRequest, reader: jspb.BinaryReader): WithdrawPlayersRequest;
+}
+
+export namespace WithdrawPlayersRequest {
+  export type AsObject = {
+    id: string,
+    division: string,
+    playersList: Array<string>,
+    policy: api_proto_ipc_tournament_pb.WithdrawalPolicyMap[keyof api_proto_ipc_tournament_pb.WithdrawalPolicyMap],
+  }
+}
+
+export class ReadmitPlayersRequest extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getDivision(): string;
+  setDivision(value: string): void;
+
+  clearPlayersList(): void;
+  getPlayersList(): Array<string>;
+  setPlayersList(value: Array<string>): void;
+  addPlayers(value: string, index?: number): string;
+
+  getCatchUpByes(): number;
+  setCatchUpByes(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ReadmitPlayersRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ReadmitPlayersRequest): ReadmitPlayersRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ReadmitPlayersRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ReadmitPlayersRequest;
+  static deserializeBinaryFromReader(message: ReadmitPlayersRequest, reader: jspb.BinaryReader): ReadmitPlayersRequest;
+}
+
+export namespace ReadmitPlayersRequest {
+  export type AsObject = {
+    id: string,
+    division: string,
+    playersList: Array<string>,
+    catchUpByes: number,
+  }
+}
+
 export class UncheckInRequest extends jspb.Message {
   getId(): string;
   setId(value: string): void;
@@ -660,112 +818,976 @@ export namespace CheckinRequest {
   }
 }
 
-export class NewClubSessionRequest extends jspb.Message {
-  hasDate(): boolean;
-  clearDate(): void;
-  getDate(): google_protobuf_timestamp_pb.Timestamp | undefined;
-  setDate(value?: google_protobuf_timestamp_pb.Timestamp): void;
+export class RegisterRequest extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
 
-  getClubId(): string;
-  setClubId(value: string): void;
+  getDivision(): string;
+  setDivision(value: string): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): NewClubSessionRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: NewClubSessionRequest): NewClubSessionRequest.AsObject;
+  toObject(includeInstance?: boolean): RegisterRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: RegisterRequest): RegisterRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: NewClubSessionRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): NewClubSessionRequest;
-  static deserializeBinaryFromReader(message: NewClubSessionRequest, reader: jspb.BinaryReader): NewClubSessionRequest;
+  static serializeBinaryToWriter(message: RegisterRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RegisterRequest;
+  static deserializeBinaryFromReader(message: RegisterRequest, reader: jspb.BinaryReader): RegisterRequest;
 }
 
-export namespace NewClubSessionRequest {
+export namespace RegisterRequest {
   export type AsObject = {
-    date?: google_protobuf_timestamp_pb.Timestamp.AsObject,
-    clubId: string,
+    id: string,
+    division: string,
   }
 }
 
-export class ClubSessionResponse extends jspb.Message {
-  getTournamentId(): string;
-  setTournamentId(value: string): void;
+export class RegisterResponse extends jspb.Message {
+  getStatus(): RegistrationStatusMap[keyof RegistrationStatusMap];
+  setStatus(value: RegistrationStatusMap[keyof RegistrationStatusMap]): void;
 
-  getSlug(): string;
-  setSlug(value: string): void;
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RegisterResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: RegisterResponse): RegisterResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: RegisterResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RegisterResponse;
+  static deserializeBinaryFromReader(message: RegisterResponse, reader: jspb.BinaryReader): RegisterResponse;
+}
+
+export namespace RegisterResponse {
+  export type AsObject = {
+    status: RegistrationStatusMap[keyof RegistrationStatusMap],
+  }
+}
+
+export class DivisionRegistrationsResponse extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getDivision(): string;
+  setDivision(value: string): void;
+
+  clearPendingList(): void;
+  getPendingList(): Array<api_proto_ipc_tournament_pb.TournamentPerson>;
+  setPendingList(value: Array<api_proto_ipc_tournament_pb.TournamentPerson>): void;
+  addPending(value?: api_proto_ipc_tournament_pb.TournamentPerson, index?: number): api_proto_ipc_tournament_pb.TournamentPerson;
+
+  clearWaitlistList(): void;
+  getWaitlistList(): Array<api_proto_ipc_tournament_pb.TournamentPerson>;
+  setWaitlistList(value: Array<api_proto_ipc_tournament_pb.TournamentPerson>): void;
+  addWaitlist(value?: api_proto_ipc_tournament_pb.TournamentPerson, index?: number): api_proto_ipc_tournament_pb.TournamentPerson;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): ClubSessionResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: ClubSessionResponse): ClubSessionResponse.AsObject;
+  toObject(includeInstance?: boolean): DivisionRegistrationsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: DivisionRegistrationsResponse): DivisionRegistrationsResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: ClubSessionResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): ClubSessionResponse;
-  static deserializeBinaryFromReader(message: ClubSessionResponse, reader: jspb.BinaryReader): ClubSessionResponse;
+  static serializeBinaryToWriter(message: DivisionRegistrationsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): DivisionRegistrationsResponse;
+  static deserializeBinaryFromReader(message: DivisionRegistrationsResponse, reader: jspb.BinaryReader): DivisionRegistrationsResponse;
 }
 
-export namespace ClubSessionResponse {
+export namespace DivisionRegistrationsResponse {
   export type AsObject = {
-    tournamentId: string,
-    slug: string,
+    id: string,
+    division: string,
+    pendingList: Array<api_proto_ipc_tournament_pb.TournamentPerson.AsObject>,
+    waitlistList: Array<api_proto_ipc_tournament_pb.TournamentPerson.AsObject>,
   }
 }
 
-export class RecentClubSessionsRequest extends jspb.Message {
+export class AuditLogEntry extends jspb.Message {
+  getActor(): string;
+  setActor(value: string): void;
+
+  getAction(): string;
+  setAction(value: string): void;
+
+  getDivision(): string;
+  setDivision(value: string): void;
+
+  getPayload(): string;
+  setPayload(value: string): void;
+
+  getDiff(): string;
+  setDiff(value: string): void;
+
+  hasCreatedAt(): boolean;
+  clearCreatedAt(): void;
+  getCreatedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setCreatedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AuditLogEntry.AsObject;
+  static toObject(includeInstance: boolean, msg: AuditLogEntry): AuditLogEntry.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: AuditLogEntry, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AuditLogEntry;
+  static deserializeBinaryFromReader(message: AuditLogEntry, reader: jspb.BinaryReader): AuditLogEntry;
+}
+
+export namespace AuditLogEntry {
+  export type AsObject = {
+    actor: string,
+    action: string,
+    division: string,
+    payload: string,
+    diff: string,
+    createdAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+}
+
+export class AuditLogRequest extends jspb.Message {
   getId(): string;
   setId(value: string): void;
 
-  getCount(): number;
-  setCount(value: number): void;
+  getLimit(): number;
+  setLimit(value: number): void;
 
   getOffset(): number;
   setOffset(value: number): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): RecentClubSessionsRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: RecentClubSessionsRequest): RecentClubSessionsRequest.AsObject;
+  toObject(includeInstance?: boolean): AuditLogRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: AuditLogRequest): AuditLogRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: RecentClubSessionsRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): RecentClubSessionsRequest;
-  static deserializeBinaryFromReader(message: RecentClubSessionsRequest, reader: jspb.BinaryReader): RecentClubSessionsRequest;
+  static serializeBinaryToWriter(message: AuditLogRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AuditLogRequest;
+  static deserializeBinaryFromReader(message: AuditLogRequest, reader: jspb.BinaryReader): AuditLogRequest;
 }
 
-export namespace RecentClubSessionsRequest {
+export namespace AuditLogRequest {
   export type AsObject = {
     id: string,
-    count: number,
+    limit: number,
     offset: number,
   }
 }
 
-export class ClubSessionsResponse extends jspb.Message {
-  clearSessionsList(): void;
-  getSessionsList(): Array<ClubSessionResponse>;
-  setSessionsList(value: Array<ClubSessionResponse>): void;
-  addSessions(value?: ClubSessionResponse, index?: number): ClubSessionResponse;
+export class AuditLogResponse extends jspb.Message {
+  clearEntriesList(): void;
+  getEntriesList(): Array<AuditLogEntry>;
+  setEntriesList(value: Array<AuditLogEntry>): void;
+  addEntries(value?: AuditLogEntry, index?: number): AuditLogEntry;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): ClubSessionsResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: ClubSessionsResponse): ClubSessionsResponse.AsObject;
+  toObject(includeInstance?: boolean): AuditLogResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: AuditLogResponse): AuditLogResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: ClubSessionsResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): ClubSessionsResponse;
-  static deserializeBinaryFromReader(message: ClubSessionsResponse, reader: jspb.BinaryReader): ClubSessionsResponse;
+  static serializeBinaryToWriter(message: AuditLogResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AuditLogResponse;
+  static deserializeBinaryFromReader(message: AuditLogResponse, reader: jspb.BinaryReader): AuditLogResponse;
 }
 
-export namespace ClubSessionsResponse {
+export namespace AuditLogResponse {
   export type AsObject = {
-    sessionsList: Array<ClubSessionResponse.AsObject>,
+    entriesList: Array<AuditLogEntry.AsObject>,
   }
 }
 
-export interface TTypeMap {
-  STANDARD: 0;
-  CLUB: 1;
-  CHILD: 2;
-  LEGACY: 3;
+export class CloneTournamentRequest extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getName(): string;
+  setName(value: string): void;
+
+  getSlug(): string;
+  setSlug(value: string): void;
+
+  getIncludePlayers(): boolean;
+  setIncludePlayers(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CloneTournamentRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: CloneTournamentRequest): CloneTournamentRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: CloneTournamentRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CloneTournamentRequest;
+  static deserializeBinaryFromReader(message: CloneTournamentRequest, reader: jspb.BinaryReader): CloneTournamentRequest;
 }
 
-export const TType: TTypeMap;
+export namespace CloneTournamentRequest {
+  export type AsObject = {
+    id: string,
+    name: string,
+    slug: string,
+    includePlayers: boolean,
+  }
+}
+
+export class TournamentSnapshot extends jspb.Message {
+  getId(): number;
+  setId(value: number): void;
+
+  getLabel(): string;
+  setLabel(value: string): void;
+
+  getActor(): string;
+  setActor(value: string): void;
+
+  hasCreatedAt(): boolean;
+  clearCreatedAt(): void;
+  getCreatedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setCreatedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TournamentSnapshot.AsObject;
+  static toObject(includeInstance: boolean, msg: TournamentSnapshot): TournamentSnapshot.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: TournamentSnapshot, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TournamentSnapshot;
+  static deserializeBinaryFromReader(message: TournamentSnapshot, reader: jspb.BinaryReader): TournamentSnapshot;
+}
+
+export namespace TournamentSnapshot {
+  export type AsObject = {
+    id: number,
+    label: string,
+    actor: string,
+    createdAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+}
+
+export class SnapshotRequest extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getLabel(): string;
+  setLabel(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SnapshotRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SnapshotRequest): SnapshotRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SnapshotRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SnapshotRequest;
+  static deserializeBinaryFromReader(message: SnapshotRequest, reader: jspb.BinaryReader): SnapshotRequest;
+}
+
+export namespace SnapshotRequest {
+  export type AsObject = {
+    id: string,
+    label: string,
+  }
+}
+
+export class SnapshotsResponse extends jspb.Message {
+  clearSnapshotsList(): void;
+  getSnapshotsList(): Array<TournamentSnapshot>;
+  setSnapshotsList(value: Array<TournamentSnapshot>): void;
+  addSnapshots(value?: TournamentSnapshot, index?: number): TournamentSnapshot;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SnapshotsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SnapshotsResponse): SnapshotsResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SnapshotsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SnapshotsResponse;
+  static deserializeBinaryFromReader(message: SnapshotsResponse, reader: jspb.BinaryReader): SnapshotsResponse;
+}
+
+export namespace SnapshotsResponse {
+  export type AsObject = {
+    snapshotsList: Array<TournamentSnapshot.AsObject>,
+  }
+}
+
+export class RestoreSnapshotRequest extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getSnapshotId(): number;
+  setSnapshotId(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RestoreSnapshotRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: RestoreSnapshotRequest): RestoreSnapshotRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: RestoreSnapshotRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RestoreSnapshotRequest;
+  static deserializeBinaryFromReader(message: RestoreSnapshotRequest, reader: jspb.BinaryReader): RestoreSnapshotRequest;
+}
+
+export namespace RestoreSnapshotRequest {
+  export type AsObject = {
+    id: string,
+    snapshotId: number,
+  }
+}
+
+export class LadderControlsRequest extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getMaxChallengeDistance(): number;
+  setMaxChallengeDistance(value: number): void;
+
+  getAcceptSeconds(): number;
+  setAcceptSeconds(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): LadderControlsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: LadderControlsRequest): LadderControlsRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: LadderControlsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): LadderControlsRequest;
+  static deserializeBinaryFromReader(message: LadderControlsRequest, reader: jspb.BinaryReader): LadderControlsRequest;
+}
+
+export namespace LadderControlsRequest {
+  export type AsObject = {
+    id: string,
+    maxChallengeDistance: number,
+    acceptSeconds: number,
+  }
+}
+
+export class LadderChallengeRequest extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getOpponent(): string;
+  setOpponent(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): LadderChallengeRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: LadderChallengeRequest): LadderChallengeRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: LadderChallengeRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): LadderChallengeRequest;
+  static deserializeBinaryFromReader(message: LadderChallengeRequest, reader: jspb.BinaryReader): LadderChallengeRequest;
+}
+
+export namespace LadderChallengeRequest {
+  export type AsObject = {
+    id: string,
+    opponent: string,
+  }
+}
+
+export class GetLadderRequest extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetLadderRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: GetLadderRequest): GetLadderRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetLadderRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetLadderRequest;
+  static deserializeBinaryFromReader(message: GetLadderRequest, reader: jspb.BinaryReader): GetLadderRequest;
+}
+
+export namespace GetLadderRequest {
+  export type AsObject = {
+    id: string,
+  }
+}
+
+export class ProjectedRating extends jspb.Message {
+  getUserId(): string;
+  setUserId(value: string): void;
+
+  getRating(): number;
+  setRating(value: number): void;
+
+  getRatingDeviation(): number;
+  setRatingDeviation(value: number): void;
+
+  getNewRating(): number;
+  setNewRating(value: number): void;
+
+  getNewRatingDeviation(): number;
+  setNewRatingDeviation(value: number): void;
+
+  getNewVolatility(): number;
+  setNewVolatility(value: number): void;
+
+  getGames(): number;
+  setGames(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ProjectedRating.AsObject;
+  static toObject(includeInstance: boolean, msg: ProjectedRating): ProjectedRating.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ProjectedRating, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ProjectedRating;
+  static deserializeBinaryFromReader(message: ProjectedRating, reader: jspb.BinaryReader): ProjectedRating;
+}
+
+export namespace ProjectedRating {
+  export type AsObject = {
+    userId: string,
+    rating: number,
+    ratingDeviation: number,
+    newRating: number,
+    newRatingDeviation: number,
+    newVolatility: number,
+    games: number,
+  }
+}
+
+export class RatingPeriodResponse extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getDivision(): string;
+  setDivision(value: string): void;
+
+  getVariant(): string;
+  setVariant(value: string): void;
+
+  getApplied(): boolean;
+  setApplied(value: boolean): void;
+
+  clearRatingsList(): void;
+  getRatingsList(): Array<ProjectedRating>;
+  setRatingsList(value: Array<ProjectedRating>): void;
+  addRatings(value?: ProjectedRating, index?: number): ProjectedRating;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RatingPeriodResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: RatingPeriodResponse): RatingPeriodResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: RatingPeriodResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RatingPeriodResponse;
+  static deserializeBinaryFromReader(message: RatingPeriodResponse, reader: jspb.BinaryReader): RatingPeriodResponse;
+}
+
+export namespace RatingPeriodResponse {
+  export type AsObject = {
+    id: string,
+    division: string,
+    variant: string,
+    applied: boolean,
+    ratingsList: Array<ProjectedRating.AsObject>,
+  }
+}
+
+export class NewClubSessionRequest extends jspb.Message {
+  hasDate(): boolean;
+  clearDate(): void;
+  getDate(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setDate(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  getClubId(): string;
+  setClubId(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): NewClubSessionRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: NewClubSessionRequest): NewClubSessionRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: NewClubSessionRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): NewClubSessionRequest;
+  static deserializeBinaryFromReader(message: NewClubSessionRequest, reader: jspb.BinaryReader): NewClubSessionRequest;
+}
+
+export namespace NewClubSessionRequest {
+  export type AsObject = {
+    date?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    clubId: string,
+  }
+}
+
+export class ClubSessionResponse extends jspb.Message {
+  getTournamentId(): string;
+  setTournamentId(value: string): void;
+
+  getSlug(): string;
+  setSlug(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ClubSessionResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ClubSessionResponse): ClubSessionResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ClubSessionResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ClubSessionResponse;
+  static deserializeBinaryFromReader(message: ClubSessionResponse, reader: jspb.BinaryReader): ClubSessionResponse;
+}
+
+export namespace ClubSessionResponse {
+  export type AsObject = {
+    tournamentId: string,
+    slug: string,
+  }
+}
+
+export class PromotionRequest extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getNextSessionId(): string;
+  setNextSessionId(value: string): void;
+
+  clearDivisionsList(): void;
+  getDivisionsList(): Array<string>;
+  setDivisionsList(value: Array<string>): void;
+  addDivisions(value: string, index?: number): string;
+
+  getPromoted(): number;
+  setPromoted(value: number): void;
+
+  getRelegated(): number;
+  setRelegated(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PromotionRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: PromotionRequest): PromotionRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: PromotionRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PromotionRequest;
+  static deserializeBinaryFromReader(message: PromotionRequest, reader: jspb.BinaryReader): PromotionRequest;
+}
+
+export namespace PromotionRequest {
+  export type AsObject = {
+    id: string,
+    nextSessionId: string,
+    divisionsList: Array<string>,
+    promoted: number,
+    relegated: number,
+  }
+}
+
+export class PromotionResponse extends jspb.Message {
+  clearDivisionsList(): void;
+  getDivisionsList(): Array<api_proto_ipc_tournament_pb.TournamentPersons>;
+  setDivisionsList(value: Array<api_proto_ipc_tournament_pb.TournamentPersons>): void;
+  addDivisions(value?: api_proto_ipc_tournament_pb.TournamentPersons, index?: number): api_proto_ipc_tournament_pb.TournamentPersons;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PromotionResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: PromotionResponse): PromotionResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: PromotionResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PromotionResponse;
+  static deserializeBinaryFromReader(message: PromotionResponse, reader: jspb.BinaryReader): PromotionResponse;
+}
+
+export namespace PromotionResponse {
+  export type AsObject = {
+    divisionsList: Array<api_proto_ipc_tournament_pb.TournamentPersons.AsObject>,
+  }
+}
+
+export class RecentClubSessionsRequest extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getCount(): number;
+  setCount(value: number): void;
+
+  getOffset(): number;
+  setOffset(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RecentClubSessionsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: RecentClubSessionsRequest): RecentClubSessionsRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: RecentClubSessionsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RecentClubSessionsRequest;
+  static deserializeBinaryFromReader(message: RecentClubSessionsRequest, reader: jspb.BinaryReader): RecentClubSessionsRequest;
+}
+
+export namespace RecentClubSessionsRequest {
+  export type AsObject = {
+    id: string,
+    count: number,
+    offset: number,
+  }
+}
+
+export class ClubSessionsResponse extends jspb.Message {
+  clearSessionsList(): void;
+  getSessionsList(): Array<ClubSessionResponse>;
+  setSessionsList(value: Array<ClubSessionResponse>): void;
+  addSessions(value?: ClubSessionResponse, index?: number): ClubSessionResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ClubSessionsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ClubSessionsResponse): ClubSessionsResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ClubSessionsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ClubSessionsResponse;
+  static deserializeBinaryFromReader(message: ClubSessionsResponse, reader: jspb.BinaryReader): ClubSessionsResponse;
+}
+
+export namespace ClubSessionsResponse {
+  export type AsObject = {
+    sessionsList: Array<ClubSessionResponse.AsObject>,
+  }
+}
+
+export class ClubMembersRequest extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  clearMembersList(): void;
+  getMembersList(): Array<api_proto_ipc_tournament_pb.ClubMember>;
+  setMembersList(value: Array<api_proto_ipc_tournament_pb.ClubMember>): void;
+  addMembers(value?: api_proto_ipc_tournament_pb.ClubMember, index?: number): api_proto_ipc_tournament_pb.ClubMember;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ClubMembersRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ClubMembersRequest): ClubMembersRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ClubMembersRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ClubMembersRequest;
+  static deserializeBinaryFromReader(message: ClubMembersRequest, reader: jspb.BinaryReader): ClubMembersRequest;
+}
+
+export namespace ClubMembersRequest {
+  export type AsObject = {
+    id: string,
+    membersList: Array<api_proto_ipc_tournament_pb.ClubMember.AsObject>,
+  }
+}
+
+export class ClubControlsRequest extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getMembersOnly(): boolean;
+  setMembersOnly(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ClubControlsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ClubControlsRequest): ClubControlsRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ClubControlsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ClubControlsRequest;
+  static deserializeBinaryFromReader(message: ClubControlsRequest, reader: jspb.BinaryReader): ClubControlsRequest;
+}
+
+export namespace ClubControlsRequest {
+  export type AsObject = {
+    id: string,
+    membersOnly: boolean,
+  }
+}
+
+export class ClubSessionTemplateRequest extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  hasTemplate(): boolean;
+  clearTemplate(): void;
+  getTemplate(): api_proto_ipc_tournament_pb.ClubSessionTemplate | undefined;
+  setTemplate(value?: api_proto_ipc_tournament_pb.ClubSessionTemplate): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ClubSessionTemplateRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ClubSessionTemplateRequest): ClubSessionTemplateRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ClubSessionTemplateRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ClubSessionTemplateRequest;
+  static deserializeBinaryFromReader(message: ClubSessionTemplateRequest, reader: jspb.BinaryReader): ClubSessionTemplateRequest;
+}
+
+export namespace ClubSessionTemplateRequest {
+  export type AsObject = {
+    id: string,
+    template?: api_proto_ipc_tournament_pb.ClubSessionTemplate.AsObject,
+  }
+}
+
+export class ClubSessionScheduleRequest extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  hasSchedule(): boolean;
+  clearSchedule(): void;
+  getSchedule(): api_proto_ipc_tournament_pb.ClubSessionSchedule | undefined;
+  setSchedule(value?: api_proto_ipc_tournament_pb.ClubSessionSchedule): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ClubSessionScheduleRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ClubSessionScheduleRequest): ClubSessionScheduleRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ClubSessionScheduleRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ClubSessionScheduleRequest;
+  static deserializeBinaryFromReader(message: ClubSessionScheduleRequest, reader: jspb.BinaryReader): ClubSessionScheduleRequest;
+}
+
+export namespace ClubSessionScheduleRequest {
+  export type AsObject = {
+    id: string,
+    schedule?: api_proto_ipc_tournament_pb.ClubSessionSchedule.AsObject,
+  }
+}
+
+export class GetClubRequest extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetClubRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: GetClubRequest): GetClubRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetClubRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetClubRequest;
+  static deserializeBinaryFromReader(message: GetClubRequest, reader: jspb.BinaryReader): GetClubRequest;
+}
+
+export namespace GetClubRequest {
+  export type AsObject = {
+    id: string,
+  }
+}
+
+export class ClubRating extends jspb.Message {
+  getUserId(): string;
+  setUserId(value: string): void;
+
+  getRating(): number;
+  setRating(value: number): void;
+
+  getRatingDeviation(): number;
+  setRatingDeviation(value: number): void;
+
+  getGames(): number;
+  setGames(value: number): void;
+
+  getMember(): boolean;
+  setMember(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ClubRating.AsObject;
+  static toObject(includeInstance: boolean, msg: ClubRating): ClubRating.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ClubRating, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ClubRating;
+  static deserializeBinaryFromReader(message: ClubRating, reader: jspb.BinaryReader): ClubRating;
+}
+
+export namespace ClubRating {
+  export type AsObject = {
+    userId: string,
+    rating: number,
+    ratingDeviation: number,
+    games: number,
+    member: boolean,
+  }
+}
+
+export class ClubRatingsRequest extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getOffset(): number;
+  setOffset(value: number): void;
+
+  getLimit(): number;
+  setLimit(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ClubRatingsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ClubRatingsRequest): ClubRatingsRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ClubRatingsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ClubRatingsRequest;
+  static deserializeBinaryFromReader(message: ClubRatingsRequest, reader: jspb.BinaryReader): ClubRatingsRequest;
+}
+
+export namespace ClubRatingsRequest {
+  export type AsObject = {
+    id: string,
+    offset: number,
+    limit: number,
+  }
+}
+
+export class ClubRatingsResponse extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  clearRatingsList(): void;
+  getRatingsList(): Array<ClubRating>;
+  setRatingsList(value: Array<ClubRating>): void;
+  addRatings(value?: ClubRating, index?: number): ClubRating;
+
+  getTotal(): number;
+  setTotal(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ClubRatingsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ClubRatingsResponse): ClubRatingsResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ClubRatingsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ClubRatingsResponse;
+  static deserializeBinaryFromReader(message: ClubRatingsResponse, reader: jspb.BinaryReader): ClubRatingsResponse;
+}
+
+export namespace ClubRatingsResponse {
+  export type AsObject = {
+    id: string,
+    ratingsList: Array<ClubRating.AsObject>,
+    total: number,
+  }
+}
+
+export class ClubStatsRequest extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getLimit(): number;
+  setLimit(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ClubStatsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ClubStatsRequest): ClubStatsRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ClubStatsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ClubStatsRequest;
+  static deserializeBinaryFromReader(message: ClubStatsRequest, reader: jspb.BinaryReader): ClubStatsRequest;
+}
+
+export namespace ClubStatsRequest {
+  export type AsObject = {
+    id: string,
+    limit: number,
+  }
+}
+
+export class SessionAttendance extends jspb.Message {
+  getTournamentId(): string;
+  setTournamentId(value: string): void;
+
+  getSlug(): string;
+  setSlug(value: string): void;
+
+  getPlayers(): number;
+  setPlayers(value: number): void;
+
+  getGames(): number;
+  setGames(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SessionAttendance.AsObject;
+  static toObject(includeInstance: boolean, msg: SessionAttendance): SessionAttendance.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SessionAttendance, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SessionAttendance;
+  static deserializeBinaryFromReader(message: SessionAttendance, reader: jspb.BinaryReader): SessionAttendance;
+}
+
+export namespace SessionAttendance {
+  export type AsObject = {
+    tournamentId: string,
+    slug: string,
+    players: number,
+    games: number,
+  }
+}
+
+export class ClubMemberActivity extends jspb.Message {
+  getUserId(): string;
+  setUserId(value: string): void;
+
+  getSessions(): number;
+  setSessions(value: number): void;
+
+  getGames(): number;
+  setGames(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ClubMemberActivity.AsObject;
+  static toObject(includeInstance: boolean, msg: ClubMemberActivity): ClubMemberActivity.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ClubMemberActivity, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ClubMemberActivity;
+  static deserializeBinaryFromReader(message: ClubMemberActivity, reader: jspb.BinaryReader): ClubMemberActivity;
+}
+
+export namespace ClubMemberActivity {
+  export type AsObject = {
+    userId: string,
+    sessions: number,
+    games: number,
+  }
+}
+
+export class ClubStatsResponse extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  clearSessionsList(): void;
+  getSessionsList(): Array<SessionAttendance>;
+  setSessionsList(value: Array<SessionAttendance>): void;
+  addSessions(value?: SessionAttendance, index?: number): SessionAttendance;
+
+  clearMostActiveList(): void;
+  getMostActiveList(): Array<ClubMemberActivity>;
+  setMostActiveList(value: Array<ClubMemberActivity>): void;
+  addMostActive(value?: ClubMemberActivity, index?: number): ClubMemberActivity;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ClubStatsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ClubStatsResponse): ClubStatsResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ClubStatsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ClubStatsResponse;
+  static deserializeBinaryFromReader(message: ClubStatsResponse, reader: jspb.BinaryReader): ClubStatsResponse;
+}
+
+export namespace ClubStatsResponse {
+  export type AsObject = {
+    id: string,
+    sessionsList: Array<SessionAttendance.AsObject>,
+    mostActiveList: Array<ClubMemberActivity.AsObject>,
+  }
+}
+
+export interface TTypeMap {
+  STANDARD: 0;
+  CLUB: 1;
+  CHILD: 2;
+  LEGACY: 3;
+  LADDER: 4;
+}
+
+export const TType: TTypeMap;
+
+export interface RegistrationStatusMap {
+  REGISTRATION_UNSPECIFIED: 0;
+  REGISTERED: 1;
+  WAITLISTED: 2;
+  PENDING_APPROVAL: 3;
+}
+
+export const RegistrationStatus: RegistrationStatusMap;
 
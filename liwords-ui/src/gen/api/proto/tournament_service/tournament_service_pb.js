@@ -21,21 +21,54 @@ var api_proto_ipc_tournament_pb = require('../../../api/proto/ipc/tournament_pb.
 goog.object.extend(proto, api_proto_ipc_tournament_pb);
 var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
 goog.object.extend(proto, google_protobuf_timestamp_pb);
+goog.exportSymbol('proto.tournament_service.AuditLogEntry', null, global);
+goog.exportSymbol('proto.tournament_service.AuditLogRequest', null, global);
+goog.exportSymbol('proto.tournament_service.AuditLogResponse', null, global);
 goog.exportSymbol('proto.tournament_service.CheckinRequest', null, global);
+goog.exportSymbol('proto.tournament_service.CloneTournamentRequest', null, global);
+goog.exportSymbol('proto.tournament_service.ClubControlsRequest', null, global);
+goog.exportSymbol('proto.tournament_service.ClubMemberActivity', null, global);
+goog.exportSymbol('proto.tournament_service.ClubMembersRequest', null, global);
+goog.exportSymbol('proto.tournament_service.ClubRating', null, global);
+goog.exportSymbol('proto.tournament_service.ClubRatingsRequest', null, global);
+goog.exportSymbol('proto.tournament_service.ClubRatingsResponse', null, global);
 goog.exportSymbol('proto.tournament_service.ClubSessionResponse', null, global);
+goog.exportSymbol('proto.tournament_service.ClubSessionScheduleRequest', null, global);
+goog.exportSymbol('proto.tournament_service.ClubSessionTemplateRequest', null, global);
 goog.exportSymbol('proto.tournament_service.ClubSessionsResponse', null, global);
+goog.exportSymbol('proto.tournament_service.ClubStatsRequest', null, global);
+goog.exportSymbol('proto.tournament_service.ClubStatsResponse', null, global);
+goog.exportSymbol('proto.tournament_service.DivisionRegistrationsResponse', null, global);
 goog.exportSymbol('proto.tournament_service.FinishTournamentRequest', null, global);
+goog.exportSymbol('proto.tournament_service.GetClubRequest', null, global);
+goog.exportSymbol('proto.tournament_service.GetLadderRequest', null, global);
 goog.exportSymbol('proto.tournament_service.GetTournamentMetadataRequest', null, global);
 goog.exportSymbol('proto.tournament_service.GetTournamentRequest', null, global);
+goog.exportSymbol('proto.tournament_service.LadderChallengeRequest', null, global);
+goog.exportSymbol('proto.tournament_service.LadderControlsRequest', null, global);
 goog.exportSymbol('proto.tournament_service.NewClubSessionRequest', null, global);
 goog.exportSymbol('proto.tournament_service.NewTournamentRequest', null, global);
 goog.exportSymbol('proto.tournament_service.NewTournamentResponse', null, global);
 goog.exportSymbol('proto.tournament_service.PairRoundRequest', null, global);
+goog.exportSymbol('proto.tournament_service.PairingPreviewRequest', null, global);
+goog.exportSymbol('proto.tournament_service.ProjectedRating', null, global);
+goog.exportSymbol('proto.tournament_service.PromotionRequest', null, global);
+goog.exportSymbol('proto.tournament_service.PromotionResponse', null, global);
+goog.exportSymbol('proto.tournament_service.RatingPeriodResponse', null, global);
+goog.exportSymbol('proto.tournament_service.ReadmitPlayersRequest', null, global);
 goog.exportSymbol('proto.tournament_service.RecentClubSessionsRequest', null, global);
 goog.exportSymbol('proto.tournament_service.RecentGamesRequest', null, global);
 goog.exportSymbol('proto.tournament_service.RecentGamesResponse', null, global);
+goog.exportSymbol('proto.tournament_service.RegisterRequest', null, global);
+goog.exportSymbol('proto.tournament_service.RegisterResponse', null, global);
+goog.exportSymbol('proto.tournament_service.RegistrationStatus', null, global);
+goog.exportSymbol('proto.tournament_service.RestoreSnapshotRequest', null, global);
+goog.exportSymbol('proto.tournament_service.ScheduledRound', null, global);
+goog.exportSymbol('proto.tournament_service.SessionAttendance', null, global);
 goog.exportSymbol('proto.tournament_service.SetTournamentMetadataRequest', null, global);
 goog.exportSymbol('proto.tournament_service.SingleRoundControlsRequest', null, global);
+goog.exportSymbol('proto.tournament_service.SnapshotRequest', null, global);
+goog.exportSymbol('proto.tournament_service.SnapshotsResponse', null, global);
 goog.exportSymbol('proto.tournament_service.StartRoundRequest', null, global);
 goog.exportSymbol('proto.tournament_service.TType', null, global);
 goog.exportSymbol('proto.tournament_service.TournamentDivisionRequest', null, global);
@@ -45,9 +78,11 @@ goog.exportSymbol('proto.tournament_service.TournamentPairingRequest', null, glo
 goog.exportSymbol('proto.tournament_service.TournamentPairingsRequest', null, global);
 goog.exportSymbol('proto.tournament_service.TournamentResponse', null, global);
 goog.exportSymbol('proto.tournament_service.TournamentResultOverrideRequest', null, global);
+goog.exportSymbol('proto.tournament_service.TournamentSnapshot', null, global);
 goog.exportSymbol('proto.tournament_service.TournamentStartRoundCountdownRequest', null, global);
 goog.exportSymbol('proto.tournament_service.UncheckInRequest', null, global);
 goog.exportSymbol('proto.tournament_service.UnstartTournamentRequest', null, global);
+goog.exportSymbol('proto.tournament_service.WithdrawPlayersRequest', null, global);
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -174,6 +209,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.tournament_service.PairRoundRequest.displayName = 'proto.tournament_service.PairRoundRequest';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.tournament_service.PairingPreviewRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.tournament_service.PairingPreviewRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.PairingPreviewRequest.displayName = 'proto.tournament_service.PairingPreviewRequest';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -384,6 +440,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.tournament_service.FinishTournamentRequest.displayName = 'proto.tournament_service.FinishTournamentRequest';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.tournament_service.ScheduledRound = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.tournament_service.ScheduledRound, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.ScheduledRound.displayName = 'proto.tournament_service.ScheduledRound';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -468,6 +545,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.tournament_service.UnstartTournamentRequest.displayName = 'proto.tournament_service.UnstartTournamentRequest';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.tournament_service.WithdrawPlayersRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.tournament_service.WithdrawPlayersRequest.repeatedFields_, null);
+};
+goog.inherits(proto.tournament_service.WithdrawPlayersRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.WithdrawPlayersRequest.displayName = 'proto.tournament_service.WithdrawPlayersRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.tournament_service.ReadmitPlayersRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.tournament_service.ReadmitPlayersRequest.repeatedFields_, null);
+};
+goog.inherits(proto.tournament_service.ReadmitPlayersRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.ReadmitPlayersRequest.displayName = 'proto.tournament_service.ReadmitPlayersRequest';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -520,16 +639,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.tournament_service.NewClubSessionRequest = function(opt_data) {
+proto.tournament_service.RegisterRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.tournament_service.NewClubSessionRequest, jspb.Message);
+goog.inherits(proto.tournament_service.RegisterRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.tournament_service.NewClubSessionRequest.displayName = 'proto.tournament_service.NewClubSessionRequest';
+  proto.tournament_service.RegisterRequest.displayName = 'proto.tournament_service.RegisterRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -541,16 +660,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.tournament_service.ClubSessionResponse = function(opt_data) {
+proto.tournament_service.RegisterResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.tournament_service.ClubSessionResponse, jspb.Message);
+goog.inherits(proto.tournament_service.RegisterResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.tournament_service.ClubSessionResponse.displayName = 'proto.tournament_service.ClubSessionResponse';
+  proto.tournament_service.RegisterResponse.displayName = 'proto.tournament_service.RegisterResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -562,16 +681,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.tournament_service.RecentClubSessionsRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.tournament_service.DivisionRegistrationsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.tournament_service.DivisionRegistrationsResponse.repeatedFields_, null);
 };
-goog.inherits(proto.tournament_service.RecentClubSessionsRequest, jspb.Message);
+goog.inherits(proto.tournament_service.DivisionRegistrationsResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.tournament_service.RecentClubSessionsRequest.displayName = 'proto.tournament_service.RecentClubSessionsRequest';
+  proto.tournament_service.DivisionRegistrationsResponse.displayName = 'proto.tournament_service.DivisionRegistrationsResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -583,184 +702,7797 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.tournament_service.ClubSessionsResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.tournament_service.ClubSessionsResponse.repeatedFields_, null);
+proto.tournament_service.AuditLogEntry = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.tournament_service.ClubSessionsResponse, jspb.Message);
+goog.inherits(proto.tournament_service.AuditLogEntry, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.tournament_service.ClubSessionsResponse.displayName = 'proto.tournament_service.ClubSessionsResponse';
+  proto.tournament_service.AuditLogEntry.displayName = 'proto.tournament_service.AuditLogEntry';
 }
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.tournament_service.StartRoundRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.tournament_service.StartRoundRequest.toObject(opt_includeInstance, this);
+proto.tournament_service.AuditLogRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.tournament_service.AuditLogRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.AuditLogRequest.displayName = 'proto.tournament_service.AuditLogRequest';
+}
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.tournament_service.StartRoundRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.tournament_service.StartRoundRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    tournamentId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    round: jspb.Message.getFieldWithDefault(msg, 2, 0)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.tournament_service.AuditLogResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.tournament_service.AuditLogResponse.repeatedFields_, null);
 };
+goog.inherits(proto.tournament_service.AuditLogResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.AuditLogResponse.displayName = 'proto.tournament_service.AuditLogResponse';
 }
-
-
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.tournament_service.StartRoundRequest}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.tournament_service.StartRoundRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.tournament_service.StartRoundRequest;
-  return proto.tournament_service.StartRoundRequest.deserializeBinaryFromReader(msg, reader);
+proto.tournament_service.CloneTournamentRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.tournament_service.CloneTournamentRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.CloneTournamentRequest.displayName = 'proto.tournament_service.CloneTournamentRequest';
+}
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.tournament_service.StartRoundRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.tournament_service.StartRoundRequest}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.tournament_service.StartRoundRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setTournamentId(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setRound(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.tournament_service.TournamentSnapshot = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.tournament_service.TournamentSnapshot, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.TournamentSnapshot.displayName = 'proto.tournament_service.TournamentSnapshot';
+}
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.tournament_service.StartRoundRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.tournament_service.StartRoundRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.tournament_service.SnapshotRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.tournament_service.SnapshotRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.SnapshotRequest.displayName = 'proto.tournament_service.SnapshotRequest';
+}
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.tournament_service.StartRoundRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.tournament_service.StartRoundRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getTournamentId();
+proto.tournament_service.SnapshotsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.tournament_service.SnapshotsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.tournament_service.SnapshotsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.SnapshotsResponse.displayName = 'proto.tournament_service.SnapshotsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.tournament_service.RestoreSnapshotRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.tournament_service.RestoreSnapshotRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.RestoreSnapshotRequest.displayName = 'proto.tournament_service.RestoreSnapshotRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.tournament_service.LadderControlsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.tournament_service.LadderControlsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.LadderControlsRequest.displayName = 'proto.tournament_service.LadderControlsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.tournament_service.LadderChallengeRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.tournament_service.LadderChallengeRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.LadderChallengeRequest.displayName = 'proto.tournament_service.LadderChallengeRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.tournament_service.GetLadderRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.tournament_service.GetLadderRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.GetLadderRequest.displayName = 'proto.tournament_service.GetLadderRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.tournament_service.ProjectedRating = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.tournament_service.ProjectedRating, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.ProjectedRating.displayName = 'proto.tournament_service.ProjectedRating';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.tournament_service.RatingPeriodResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.tournament_service.RatingPeriodResponse.repeatedFields_, null);
+};
+goog.inherits(proto.tournament_service.RatingPeriodResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.RatingPeriodResponse.displayName = 'proto.tournament_service.RatingPeriodResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.tournament_service.NewClubSessionRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.tournament_service.NewClubSessionRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.NewClubSessionRequest.displayName = 'proto.tournament_service.NewClubSessionRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.tournament_service.ClubSessionResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.tournament_service.ClubSessionResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.ClubSessionResponse.displayName = 'proto.tournament_service.ClubSessionResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.tournament_service.PromotionRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.tournament_service.PromotionRequest.repeatedFields_, null);
+};
+goog.inherits(proto.tournament_service.PromotionRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.PromotionRequest.displayName = 'proto.tournament_service.PromotionRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.tournament_service.PromotionResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.tournament_service.PromotionResponse.repeatedFields_, null);
+};
+goog.inherits(proto.tournament_service.PromotionResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.PromotionResponse.displayName = 'proto.tournament_service.PromotionResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.tournament_service.RecentClubSessionsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.tournament_service.RecentClubSessionsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.RecentClubSessionsRequest.displayName = 'proto.tournament_service.RecentClubSessionsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.tournament_service.ClubSessionsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.tournament_service.ClubSessionsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.tournament_service.ClubSessionsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.ClubSessionsResponse.displayName = 'proto.tournament_service.ClubSessionsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.tournament_service.ClubMembersRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.tournament_service.ClubMembersRequest.repeatedFields_, null);
+};
+goog.inherits(proto.tournament_service.ClubMembersRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.ClubMembersRequest.displayName = 'proto.tournament_service.ClubMembersRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.tournament_service.ClubControlsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.tournament_service.ClubControlsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.ClubControlsRequest.displayName = 'proto.tournament_service.ClubControlsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.tournament_service.ClubSessionTemplateRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.tournament_service.ClubSessionTemplateRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.ClubSessionTemplateRequest.displayName = 'proto.tournament_service.ClubSessionTemplateRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.tournament_service.ClubSessionScheduleRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.tournament_service.ClubSessionScheduleRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.ClubSessionScheduleRequest.displayName = 'proto.tournament_service.ClubSessionScheduleRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.tournament_service.GetClubRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.tournament_service.GetClubRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.GetClubRequest.displayName = 'proto.tournament_service.GetClubRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.tournament_service.ClubRating = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.tournament_service.ClubRating, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.ClubRating.displayName = 'proto.tournament_service.ClubRating';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.tournament_service.ClubRatingsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.tournament_service.ClubRatingsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.ClubRatingsRequest.displayName = 'proto.tournament_service.ClubRatingsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.tournament_service.ClubRatingsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.tournament_service.ClubRatingsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.tournament_service.ClubRatingsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.ClubRatingsResponse.displayName = 'proto.tournament_service.ClubRatingsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.tournament_service.ClubStatsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.tournament_service.ClubStatsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.ClubStatsRequest.displayName = 'proto.tournament_service.ClubStatsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.tournament_service.SessionAttendance = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.tournament_service.SessionAttendance, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.SessionAttendance.displayName = 'proto.tournament_service.SessionAttendance';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.tournament_service.ClubMemberActivity = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.tournament_service.ClubMemberActivity, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.ClubMemberActivity.displayName = 'proto.tournament_service.ClubMemberActivity';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.tournament_service.ClubStatsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.tournament_service.ClubStatsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.tournament_service.ClubStatsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.tournament_service.ClubStatsResponse.displayName = 'proto.tournament_service.ClubStatsResponse';
+}
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.StartRoundRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.StartRoundRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.StartRoundRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.StartRoundRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    tournamentId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    round: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.StartRoundRequest}
+ */
+proto.tournament_service.StartRoundRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.StartRoundRequest;
+  return proto.tournament_service.StartRoundRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.StartRoundRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.StartRoundRequest}
+ */
+proto.tournament_service.StartRoundRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTournamentId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRound(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.StartRoundRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.StartRoundRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.StartRoundRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.StartRoundRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTournamentId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getRound();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string tournament_id = 1;
+ * @return {string}
+ */
+proto.tournament_service.StartRoundRequest.prototype.getTournamentId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.StartRoundRequest} returns this
+ */
+proto.tournament_service.StartRoundRequest.prototype.setTournamentId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int32 round = 2;
+ * @return {number}
+ */
+proto.tournament_service.StartRoundRequest.prototype.getRound = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.tournament_service.StartRoundRequest} returns this
+ */
+proto.tournament_service.StartRoundRequest.prototype.setRound = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.tournament_service.NewTournamentRequest.repeatedFields_ = [4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.NewTournamentRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.NewTournamentRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.NewTournamentRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.NewTournamentRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    slug: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    description: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    directorUsernamesList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
+    type: jspb.Message.getFieldWithDefault(msg, 5, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.NewTournamentRequest}
+ */
+proto.tournament_service.NewTournamentRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.NewTournamentRequest;
+  return proto.tournament_service.NewTournamentRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.NewTournamentRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.NewTournamentRequest}
+ */
+proto.tournament_service.NewTournamentRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSlug(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDescription(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addDirectorUsernames(value);
+      break;
+    case 5:
+      var value = /** @type {!proto.tournament_service.TType} */ (reader.readEnum());
+      msg.setType(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.NewTournamentRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.NewTournamentRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.NewTournamentRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.NewTournamentRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSlug();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getDescription();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getDirectorUsernamesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      4,
+      f
+    );
+  }
+  f = message.getType();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string slug = 1;
+ * @return {string}
+ */
+proto.tournament_service.NewTournamentRequest.prototype.getSlug = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.NewTournamentRequest} returns this
+ */
+proto.tournament_service.NewTournamentRequest.prototype.setSlug = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.tournament_service.NewTournamentRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.NewTournamentRequest} returns this
+ */
+proto.tournament_service.NewTournamentRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string description = 3;
+ * @return {string}
+ */
+proto.tournament_service.NewTournamentRequest.prototype.getDescription = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.NewTournamentRequest} returns this
+ */
+proto.tournament_service.NewTournamentRequest.prototype.setDescription = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * repeated string director_usernames = 4;
+ * @return {!Array<string>}
+ */
+proto.tournament_service.NewTournamentRequest.prototype.getDirectorUsernamesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.tournament_service.NewTournamentRequest} returns this
+ */
+proto.tournament_service.NewTournamentRequest.prototype.setDirectorUsernamesList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.tournament_service.NewTournamentRequest} returns this
+ */
+proto.tournament_service.NewTournamentRequest.prototype.addDirectorUsernames = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.tournament_service.NewTournamentRequest} returns this
+ */
+proto.tournament_service.NewTournamentRequest.prototype.clearDirectorUsernamesList = function() {
+  return this.setDirectorUsernamesList([]);
+};
+
+
+/**
+ * optional TType type = 5;
+ * @return {!proto.tournament_service.TType}
+ */
+proto.tournament_service.NewTournamentRequest.prototype.getType = function() {
+  return /** @type {!proto.tournament_service.TType} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {!proto.tournament_service.TType} value
+ * @return {!proto.tournament_service.NewTournamentRequest} returns this
+ */
+proto.tournament_service.NewTournamentRequest.prototype.setType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.tournament_service.TournamentMetadata.repeatedFields_ = [10,15];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.TournamentMetadata.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.TournamentMetadata.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.TournamentMetadata} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.TournamentMetadata.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    description: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    slug: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    type: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    disclaimer: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    tileStyle: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    boardStyle: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    defaultClubSettings: (f = msg.getDefaultClubSettings()) && api_proto_ipc_omgwords_pb.GameRequest.toObject(includeInstance, f),
+    freeformClubSettingFieldsList: (f = jspb.Message.getRepeatedField(msg, 10)) == null ? undefined : f,
+    password: jspb.Message.getFieldWithDefault(msg, 11, ""),
+    logo: jspb.Message.getFieldWithDefault(msg, 12, ""),
+    color: jspb.Message.getFieldWithDefault(msg, 13, ""),
+    privateAnalysis: jspb.Message.getBooleanFieldWithDefault(msg, 14, false),
+    prizesList: jspb.Message.toObjectList(msg.getPrizesList(),
+    api_proto_ipc_tournament_pb.Prize.toObject, includeInstance),
+    prizeTieRule: jspb.Message.getFieldWithDefault(msg, 16, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.TournamentMetadata}
+ */
+proto.tournament_service.TournamentMetadata.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.TournamentMetadata;
+  return proto.tournament_service.TournamentMetadata.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.TournamentMetadata} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.TournamentMetadata}
+ */
+proto.tournament_service.TournamentMetadata.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDescription(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSlug(value);
+      break;
+    case 5:
+      var value = /** @type {!proto.tournament_service.TType} */ (reader.readEnum());
+      msg.setType(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDisclaimer(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTileStyle(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBoardStyle(value);
+      break;
+    case 9:
+      var value = new api_proto_ipc_omgwords_pb.GameRequest;
+      reader.readMessage(value,api_proto_ipc_omgwords_pb.GameRequest.deserializeBinaryFromReader);
+      msg.setDefaultClubSettings(value);
+      break;
+    case 10:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addFreeformClubSettingFields(value);
+      break;
+    case 11:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPassword(value);
+      break;
+    case 12:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLogo(value);
+      break;
+    case 13:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setColor(value);
+      break;
+    case 14:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setPrivateAnalysis(value);
+      break;
+    case 15:
+      var value = new api_proto_ipc_tournament_pb.Prize;
+      reader.readMessage(value,api_proto_ipc_tournament_pb.Prize.deserializeBinaryFromReader);
+      msg.addPrizes(value);
+      break;
+    case 16:
+      var value = /** @type {!proto.ipc.PrizeTieRule} */ (reader.readEnum());
+      msg.setPrizeTieRule(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.TournamentMetadata.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.TournamentMetadata.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.TournamentMetadata} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.TournamentMetadata.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getDescription();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getSlug();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getType();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
+  f = message.getDisclaimer();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getTileStyle();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getBoardStyle();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+  f = message.getDefaultClubSettings();
+  if (f != null) {
+    writer.writeMessage(
+      9,
+      f,
+      api_proto_ipc_omgwords_pb.GameRequest.serializeBinaryToWriter
+    );
+  }
+  f = message.getFreeformClubSettingFieldsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      10,
+      f
+    );
+  }
+  f = message.getPassword();
+  if (f.length > 0) {
+    writer.writeString(
+      11,
+      f
+    );
+  }
+  f = message.getLogo();
+  if (f.length > 0) {
+    writer.writeString(
+      12,
+      f
+    );
+  }
+  f = message.getColor();
+  if (f.length > 0) {
+    writer.writeString(
+      13,
+      f
+    );
+  }
+  f = message.getPrivateAnalysis();
+  if (f) {
+    writer.writeBool(
+      14,
+      f
+    );
+  }
+  f = message.getPrizesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      15,
+      f,
+      api_proto_ipc_tournament_pb.Prize.serializeBinaryToWriter
+    );
+  }
+  f = message.getPrizeTieRule();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      16,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.tournament_service.TournamentMetadata.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.TournamentMetadata} returns this
+ */
+proto.tournament_service.TournamentMetadata.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.tournament_service.TournamentMetadata.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.TournamentMetadata} returns this
+ */
+proto.tournament_service.TournamentMetadata.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string description = 3;
+ * @return {string}
+ */
+proto.tournament_service.TournamentMetadata.prototype.getDescription = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.TournamentMetadata} returns this
+ */
+proto.tournament_service.TournamentMetadata.prototype.setDescription = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string slug = 4;
+ * @return {string}
+ */
+proto.tournament_service.TournamentMetadata.prototype.getSlug = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.TournamentMetadata} returns this
+ */
+proto.tournament_service.TournamentMetadata.prototype.setSlug = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional TType type = 5;
+ * @return {!proto.tournament_service.TType}
+ */
+proto.tournament_service.TournamentMetadata.prototype.getType = function() {
+  return /** @type {!proto.tournament_service.TType} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {!proto.tournament_service.TType} value
+ * @return {!proto.tournament_service.TournamentMetadata} returns this
+ */
+proto.tournament_service.TournamentMetadata.prototype.setType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
+};
+
+
+/**
+ * optional string disclaimer = 6;
+ * @return {string}
+ */
+proto.tournament_service.TournamentMetadata.prototype.getDisclaimer = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.TournamentMetadata} returns this
+ */
+proto.tournament_service.TournamentMetadata.prototype.setDisclaimer = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string tile_style = 7;
+ * @return {string}
+ */
+proto.tournament_service.TournamentMetadata.prototype.getTileStyle = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.TournamentMetadata} returns this
+ */
+proto.tournament_service.TournamentMetadata.prototype.setTileStyle = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional string board_style = 8;
+ * @return {string}
+ */
+proto.tournament_service.TournamentMetadata.prototype.getBoardStyle = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.TournamentMetadata} returns this
+ */
+proto.tournament_service.TournamentMetadata.prototype.setBoardStyle = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
+};
+
+
+/**
+ * optional ipc.GameRequest default_club_settings = 9;
+ * @return {?proto.ipc.GameRequest}
+ */
+proto.tournament_service.TournamentMetadata.prototype.getDefaultClubSettings = function() {
+  return /** @type{?proto.ipc.GameRequest} */ (
+    jspb.Message.getWrapperField(this, api_proto_ipc_omgwords_pb.GameRequest, 9));
+};
+
+
+/**
+ * @param {?proto.ipc.GameRequest|undefined} value
+ * @return {!proto.tournament_service.TournamentMetadata} returns this
+*/
+proto.tournament_service.TournamentMetadata.prototype.setDefaultClubSettings = function(value) {
+  return jspb.Message.setWrapperField(this, 9, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.tournament_service.TournamentMetadata} returns this
+ */
+proto.tournament_service.TournamentMetadata.prototype.clearDefaultClubSettings = function() {
+  return this.setDefaultClubSettings(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.tournament_service.TournamentMetadata.prototype.hasDefaultClubSettings = function() {
+  return jspb.Message.getField(this, 9) != null;
+};
+
+
+/**
+ * repeated string freeform_club_setting_fields = 10;
+ * @return {!Array<string>}
+ */
+proto.tournament_service.TournamentMetadata.prototype.getFreeformClubSettingFieldsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 10));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.tournament_service.TournamentMetadata} returns this
+ */
+proto.tournament_service.TournamentMetadata.prototype.setFreeformClubSettingFieldsList = function(value) {
+  return jspb.Message.setField(this, 10, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.tournament_service.TournamentMetadata} returns this
+ */
+proto.tournament_service.TournamentMetadata.prototype.addFreeformClubSettingFields = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 10, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.tournament_service.TournamentMetadata} returns this
+ */
+proto.tournament_service.TournamentMetadata.prototype.clearFreeformClubSettingFieldsList = function() {
+  return this.setFreeformClubSettingFieldsList([]);
+};
+
+
+/**
+ * optional string password = 11;
+ * @return {string}
+ */
+proto.tournament_service.TournamentMetadata.prototype.getPassword = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.TournamentMetadata} returns this
+ */
+proto.tournament_service.TournamentMetadata.prototype.setPassword = function(value) {
+  return jspb.Message.setProto3StringField(this, 11, value);
+};
+
+
+/**
+ * optional string logo = 12;
+ * @return {string}
+ */
+proto.tournament_service.TournamentMetadata.prototype.getLogo = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.TournamentMetadata} returns this
+ */
+proto.tournament_service.TournamentMetadata.prototype.setLogo = function(value) {
+  return jspb.Message.setProto3StringField(this, 12, value);
+};
+
+
+/**
+ * optional string color = 13;
+ * @return {string}
+ */
+proto.tournament_service.TournamentMetadata.prototype.getColor = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 13, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.TournamentMetadata} returns this
+ */
+proto.tournament_service.TournamentMetadata.prototype.setColor = function(value) {
+  return jspb.Message.setProto3StringField(this, 13, value);
+};
+
+
+/**
+ * optional bool private_analysis = 14;
+ * @return {boolean}
+ */
+proto.tournament_service.TournamentMetadata.prototype.getPrivateAnalysis = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 14, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.tournament_service.TournamentMetadata} returns this
+ */
+proto.tournament_service.TournamentMetadata.prototype.setPrivateAnalysis = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 14, value);
+};
+
+
+/**
+ * repeated ipc.Prize prizes = 15;
+ * @return {!Array<!proto.ipc.Prize>}
+ */
+proto.tournament_service.TournamentMetadata.prototype.getPrizesList = function() {
+  return /** @type{!Array<!proto.ipc.Prize>} */ (
+    jspb.Message.getRepeatedWrapperField(this, api_proto_ipc_tournament_pb.Prize, 15));
+};
+
+
+/**
+ * @param {!Array<!proto.ipc.Prize>} value
+ * @return {!proto.tournament_service.TournamentMetadata} returns this
+*/
+proto.tournament_service.TournamentMetadata.prototype.setPrizesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 15, value);
+};
+
+
+/**
+ * @param {!proto.ipc.Prize=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.Prize}
+ */
+proto.tournament_service.TournamentMetadata.prototype.addPrizes = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 15, opt_value, proto.ipc.Prize, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.tournament_service.TournamentMetadata} returns this
+ */
+proto.tournament_service.TournamentMetadata.prototype.clearPrizesList = function() {
+  return this.setPrizesList([]);
+};
+
+
+/**
+ * optional ipc.PrizeTieRule prize_tie_rule = 16;
+ * @return {!proto.ipc.PrizeTieRule}
+ */
+proto.tournament_service.TournamentMetadata.prototype.getPrizeTieRule = function() {
+  return /** @type {!proto.ipc.PrizeTieRule} */ (jspb.Message.getFieldWithDefault(this, 16, 0));
+};
+
+
+/**
+ * @param {!proto.ipc.PrizeTieRule} value
+ * @return {!proto.tournament_service.TournamentMetadata} returns this
+ */
+proto.tournament_service.TournamentMetadata.prototype.setPrizeTieRule = function(value) {
+  return jspb.Message.setProto3EnumField(this, 16, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.SetTournamentMetadataRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.SetTournamentMetadataRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.SetTournamentMetadataRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.SetTournamentMetadataRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    metadata: (f = msg.getMetadata()) && proto.tournament_service.TournamentMetadata.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.SetTournamentMetadataRequest}
+ */
+proto.tournament_service.SetTournamentMetadataRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.SetTournamentMetadataRequest;
+  return proto.tournament_service.SetTournamentMetadataRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.SetTournamentMetadataRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.SetTournamentMetadataRequest}
+ */
+proto.tournament_service.SetTournamentMetadataRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.tournament_service.TournamentMetadata;
+      reader.readMessage(value,proto.tournament_service.TournamentMetadata.deserializeBinaryFromReader);
+      msg.setMetadata(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.SetTournamentMetadataRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.SetTournamentMetadataRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.SetTournamentMetadataRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.SetTournamentMetadataRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getMetadata();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.tournament_service.TournamentMetadata.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional TournamentMetadata metadata = 1;
+ * @return {?proto.tournament_service.TournamentMetadata}
+ */
+proto.tournament_service.SetTournamentMetadataRequest.prototype.getMetadata = function() {
+  return /** @type{?proto.tournament_service.TournamentMetadata} */ (
+    jspb.Message.getWrapperField(this, proto.tournament_service.TournamentMetadata, 1));
+};
+
+
+/**
+ * @param {?proto.tournament_service.TournamentMetadata|undefined} value
+ * @return {!proto.tournament_service.SetTournamentMetadataRequest} returns this
+*/
+proto.tournament_service.SetTournamentMetadataRequest.prototype.setMetadata = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.tournament_service.SetTournamentMetadataRequest} returns this
+ */
+proto.tournament_service.SetTournamentMetadataRequest.prototype.clearMetadata = function() {
+  return this.setMetadata(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.tournament_service.SetTournamentMetadataRequest.prototype.hasMetadata = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.SingleRoundControlsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.SingleRoundControlsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.SingleRoundControlsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.SingleRoundControlsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    round: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    roundControls: (f = msg.getRoundControls()) && api_proto_ipc_tournament_pb.RoundControl.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.SingleRoundControlsRequest}
+ */
+proto.tournament_service.SingleRoundControlsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.SingleRoundControlsRequest;
+  return proto.tournament_service.SingleRoundControlsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.SingleRoundControlsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.SingleRoundControlsRequest}
+ */
+proto.tournament_service.SingleRoundControlsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDivision(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRound(value);
+      break;
+    case 4:
+      var value = new api_proto_ipc_tournament_pb.RoundControl;
+      reader.readMessage(value,api_proto_ipc_tournament_pb.RoundControl.deserializeBinaryFromReader);
+      msg.setRoundControls(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.SingleRoundControlsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.SingleRoundControlsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.SingleRoundControlsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.SingleRoundControlsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDivision();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getRound();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getRoundControls();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      api_proto_ipc_tournament_pb.RoundControl.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.tournament_service.SingleRoundControlsRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.SingleRoundControlsRequest} returns this
+ */
+proto.tournament_service.SingleRoundControlsRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string division = 2;
+ * @return {string}
+ */
+proto.tournament_service.SingleRoundControlsRequest.prototype.getDivision = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.SingleRoundControlsRequest} returns this
+ */
+proto.tournament_service.SingleRoundControlsRequest.prototype.setDivision = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional int32 round = 3;
+ * @return {number}
+ */
+proto.tournament_service.SingleRoundControlsRequest.prototype.getRound = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.tournament_service.SingleRoundControlsRequest} returns this
+ */
+proto.tournament_service.SingleRoundControlsRequest.prototype.setRound = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional ipc.RoundControl round_controls = 4;
+ * @return {?proto.ipc.RoundControl}
+ */
+proto.tournament_service.SingleRoundControlsRequest.prototype.getRoundControls = function() {
+  return /** @type{?proto.ipc.RoundControl} */ (
+    jspb.Message.getWrapperField(this, api_proto_ipc_tournament_pb.RoundControl, 4));
+};
+
+
+/**
+ * @param {?proto.ipc.RoundControl|undefined} value
+ * @return {!proto.tournament_service.SingleRoundControlsRequest} returns this
+*/
+proto.tournament_service.SingleRoundControlsRequest.prototype.setRoundControls = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.tournament_service.SingleRoundControlsRequest} returns this
+ */
+proto.tournament_service.SingleRoundControlsRequest.prototype.clearRoundControls = function() {
+  return this.setRoundControls(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.tournament_service.SingleRoundControlsRequest.prototype.hasRoundControls = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.PairRoundRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.PairRoundRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.PairRoundRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.PairRoundRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    round: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    preserveByes: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    deletePairings: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.PairRoundRequest}
+ */
+proto.tournament_service.PairRoundRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.PairRoundRequest;
+  return proto.tournament_service.PairRoundRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.PairRoundRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.PairRoundRequest}
+ */
+proto.tournament_service.PairRoundRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDivision(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRound(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setPreserveByes(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDeletePairings(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.PairRoundRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.PairRoundRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.PairRoundRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.PairRoundRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDivision();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getRound();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getPreserveByes();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getDeletePairings();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.tournament_service.PairRoundRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.PairRoundRequest} returns this
+ */
+proto.tournament_service.PairRoundRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string division = 2;
+ * @return {string}
+ */
+proto.tournament_service.PairRoundRequest.prototype.getDivision = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.PairRoundRequest} returns this
+ */
+proto.tournament_service.PairRoundRequest.prototype.setDivision = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional int32 round = 3;
+ * @return {number}
+ */
+proto.tournament_service.PairRoundRequest.prototype.getRound = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.tournament_service.PairRoundRequest} returns this
+ */
+proto.tournament_service.PairRoundRequest.prototype.setRound = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional bool preserve_byes = 4;
+ * @return {boolean}
+ */
+proto.tournament_service.PairRoundRequest.prototype.getPreserveByes = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.tournament_service.PairRoundRequest} returns this
+ */
+proto.tournament_service.PairRoundRequest.prototype.setPreserveByes = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
+/**
+ * optional bool delete_pairings = 5;
+ * @return {boolean}
+ */
+proto.tournament_service.PairRoundRequest.prototype.getDeletePairings = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.tournament_service.PairRoundRequest} returns this
+ */
+proto.tournament_service.PairRoundRequest.prototype.setDeletePairings = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.PairingPreviewRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.PairingPreviewRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.PairingPreviewRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.PairingPreviewRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    round: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    roundControls: (f = msg.getRoundControls()) && api_proto_ipc_tournament_pb.RoundControl.toObject(includeInstance, f),
+    preserveByes: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.PairingPreviewRequest}
+ */
+proto.tournament_service.PairingPreviewRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.PairingPreviewRequest;
+  return proto.tournament_service.PairingPreviewRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.PairingPreviewRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.PairingPreviewRequest}
+ */
+proto.tournament_service.PairingPreviewRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDivision(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRound(value);
+      break;
+    case 4:
+      var value = new api_proto_ipc_tournament_pb.RoundControl;
+      reader.readMessage(value,api_proto_ipc_tournament_pb.RoundControl.deserializeBinaryFromReader);
+      msg.setRoundControls(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setPreserveByes(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.PairingPreviewRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.PairingPreviewRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.PairingPreviewRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.PairingPreviewRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDivision();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getRound();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getRoundControls();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      api_proto_ipc_tournament_pb.RoundControl.serializeBinaryToWriter
+    );
+  }
+  f = message.getPreserveByes();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.tournament_service.PairingPreviewRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.PairingPreviewRequest} returns this
+ */
+proto.tournament_service.PairingPreviewRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string division = 2;
+ * @return {string}
+ */
+proto.tournament_service.PairingPreviewRequest.prototype.getDivision = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.PairingPreviewRequest} returns this
+ */
+proto.tournament_service.PairingPreviewRequest.prototype.setDivision = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional int32 round = 3;
+ * @return {number}
+ */
+proto.tournament_service.PairingPreviewRequest.prototype.getRound = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.tournament_service.PairingPreviewRequest} returns this
+ */
+proto.tournament_service.PairingPreviewRequest.prototype.setRound = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional ipc.RoundControl round_controls = 4;
+ * @return {?proto.ipc.RoundControl}
+ */
+proto.tournament_service.PairingPreviewRequest.prototype.getRoundControls = function() {
+  return /** @type{?proto.ipc.RoundControl} */ (
+    jspb.Message.getWrapperField(this, api_proto_ipc_tournament_pb.RoundControl, 4));
+};
+
+
+/**
+ * @param {?proto.ipc.RoundControl|undefined} value
+ * @return {!proto.tournament_service.PairingPreviewRequest} returns this
+*/
+proto.tournament_service.PairingPreviewRequest.prototype.setRoundControls = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.tournament_service.PairingPreviewRequest} returns this
+ */
+proto.tournament_service.PairingPreviewRequest.prototype.clearRoundControls = function() {
+  return this.setRoundControls(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.tournament_service.PairingPreviewRequest.prototype.hasRoundControls = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional bool preserve_byes = 5;
+ * @return {boolean}
+ */
+proto.tournament_service.PairingPreviewRequest.prototype.getPreserveByes = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.tournament_service.PairingPreviewRequest} returns this
+ */
+proto.tournament_service.PairingPreviewRequest.prototype.setPreserveByes = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.TournamentDivisionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.TournamentDivisionRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.TournamentDivisionRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.TournamentDivisionRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    division: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.TournamentDivisionRequest}
+ */
+proto.tournament_service.TournamentDivisionRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.TournamentDivisionRequest;
+  return proto.tournament_service.TournamentDivisionRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.TournamentDivisionRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.TournamentDivisionRequest}
+ */
+proto.tournament_service.TournamentDivisionRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDivision(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.TournamentDivisionRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.TournamentDivisionRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.TournamentDivisionRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.TournamentDivisionRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDivision();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.tournament_service.TournamentDivisionRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.TournamentDivisionRequest} returns this
+ */
+proto.tournament_service.TournamentDivisionRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string division = 2;
+ * @return {string}
+ */
+proto.tournament_service.TournamentDivisionRequest.prototype.getDivision = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.TournamentDivisionRequest} returns this
+ */
+proto.tournament_service.TournamentDivisionRequest.prototype.setDivision = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.TournamentPairingRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.TournamentPairingRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.TournamentPairingRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.TournamentPairingRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    playerOneId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    playerTwoId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    round: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    selfPlayResult: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.TournamentPairingRequest}
+ */
+proto.tournament_service.TournamentPairingRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.TournamentPairingRequest;
+  return proto.tournament_service.TournamentPairingRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.TournamentPairingRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.TournamentPairingRequest}
+ */
+proto.tournament_service.TournamentPairingRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPlayerOneId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPlayerTwoId(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRound(value);
+      break;
+    case 4:
+      var value = /** @type {!proto.ipc.TournamentGameResult} */ (reader.readEnum());
+      msg.setSelfPlayResult(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.TournamentPairingRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.TournamentPairingRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.TournamentPairingRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.TournamentPairingRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPlayerOneId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPlayerTwoId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getRound();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getSelfPlayResult();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string player_one_id = 1;
+ * @return {string}
+ */
+proto.tournament_service.TournamentPairingRequest.prototype.getPlayerOneId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.TournamentPairingRequest} returns this
+ */
+proto.tournament_service.TournamentPairingRequest.prototype.setPlayerOneId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string player_two_id = 2;
+ * @return {string}
+ */
+proto.tournament_service.TournamentPairingRequest.prototype.getPlayerTwoId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.TournamentPairingRequest} returns this
+ */
+proto.tournament_service.TournamentPairingRequest.prototype.setPlayerTwoId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional int32 round = 3;
+ * @return {number}
+ */
+proto.tournament_service.TournamentPairingRequest.prototype.getRound = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.tournament_service.TournamentPairingRequest} returns this
+ */
+proto.tournament_service.TournamentPairingRequest.prototype.setRound = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional ipc.TournamentGameResult self_play_result = 4;
+ * @return {!proto.ipc.TournamentGameResult}
+ */
+proto.tournament_service.TournamentPairingRequest.prototype.getSelfPlayResult = function() {
+  return /** @type {!proto.ipc.TournamentGameResult} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {!proto.ipc.TournamentGameResult} value
+ * @return {!proto.tournament_service.TournamentPairingRequest} returns this
+ */
+proto.tournament_service.TournamentPairingRequest.prototype.setSelfPlayResult = function(value) {
+  return jspb.Message.setProto3EnumField(this, 4, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.tournament_service.TournamentPairingsRequest.repeatedFields_ = [3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.TournamentPairingsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.TournamentPairingsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.TournamentPairingsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.TournamentPairingsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    pairingsList: jspb.Message.toObjectList(msg.getPairingsList(),
+    proto.tournament_service.TournamentPairingRequest.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.TournamentPairingsRequest}
+ */
+proto.tournament_service.TournamentPairingsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.TournamentPairingsRequest;
+  return proto.tournament_service.TournamentPairingsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.TournamentPairingsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.TournamentPairingsRequest}
+ */
+proto.tournament_service.TournamentPairingsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDivision(value);
+      break;
+    case 3:
+      var value = new proto.tournament_service.TournamentPairingRequest;
+      reader.readMessage(value,proto.tournament_service.TournamentPairingRequest.deserializeBinaryFromReader);
+      msg.addPairings(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.TournamentPairingsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.TournamentPairingsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.TournamentPairingsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.TournamentPairingsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDivision();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getPairingsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.tournament_service.TournamentPairingRequest.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.tournament_service.TournamentPairingsRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.TournamentPairingsRequest} returns this
+ */
+proto.tournament_service.TournamentPairingsRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string division = 2;
+ * @return {string}
+ */
+proto.tournament_service.TournamentPairingsRequest.prototype.getDivision = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.TournamentPairingsRequest} returns this
+ */
+proto.tournament_service.TournamentPairingsRequest.prototype.setDivision = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * repeated TournamentPairingRequest pairings = 3;
+ * @return {!Array<!proto.tournament_service.TournamentPairingRequest>}
+ */
+proto.tournament_service.TournamentPairingsRequest.prototype.getPairingsList = function() {
+  return /** @type{!Array<!proto.tournament_service.TournamentPairingRequest>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.tournament_service.TournamentPairingRequest, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.tournament_service.TournamentPairingRequest>} value
+ * @return {!proto.tournament_service.TournamentPairingsRequest} returns this
+*/
+proto.tournament_service.TournamentPairingsRequest.prototype.setPairingsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.tournament_service.TournamentPairingRequest=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.tournament_service.TournamentPairingRequest}
+ */
+proto.tournament_service.TournamentPairingsRequest.prototype.addPairings = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.tournament_service.TournamentPairingRequest, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.tournament_service.TournamentPairingsRequest} returns this
+ */
+proto.tournament_service.TournamentPairingsRequest.prototype.clearPairingsList = function() {
+  return this.setPairingsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.TournamentResultOverrideRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.TournamentResultOverrideRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.TournamentResultOverrideRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.TournamentResultOverrideRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    playerOneId: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    playerTwoId: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    round: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    playerOneScore: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    playerTwoScore: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    playerOneResult: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    playerTwoResult: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    gameEndReason: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    amendment: jspb.Message.getBooleanFieldWithDefault(msg, 11, false),
+    gameIndex: jspb.Message.getFieldWithDefault(msg, 12, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.TournamentResultOverrideRequest}
+ */
+proto.tournament_service.TournamentResultOverrideRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.TournamentResultOverrideRequest;
+  return proto.tournament_service.TournamentResultOverrideRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.TournamentResultOverrideRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.TournamentResultOverrideRequest}
+ */
+proto.tournament_service.TournamentResultOverrideRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDivision(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPlayerOneId(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPlayerTwoId(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRound(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPlayerOneScore(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPlayerTwoScore(value);
+      break;
+    case 8:
+      var value = /** @type {!proto.ipc.TournamentGameResult} */ (reader.readEnum());
+      msg.setPlayerOneResult(value);
+      break;
+    case 9:
+      var value = /** @type {!proto.ipc.TournamentGameResult} */ (reader.readEnum());
+      msg.setPlayerTwoResult(value);
+      break;
+    case 10:
+      var value = /** @type {!proto.ipc.GameEndReason} */ (reader.readEnum());
+      msg.setGameEndReason(value);
+      break;
+    case 11:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAmendment(value);
+      break;
+    case 12:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setGameIndex(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.TournamentResultOverrideRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.TournamentResultOverrideRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.TournamentResultOverrideRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.TournamentResultOverrideRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDivision();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getPlayerOneId();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getPlayerTwoId();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getRound();
+  if (f !== 0) {
+    writer.writeInt32(
+      5,
+      f
+    );
+  }
+  f = message.getPlayerOneScore();
+  if (f !== 0) {
+    writer.writeInt32(
+      6,
+      f
+    );
+  }
+  f = message.getPlayerTwoScore();
+  if (f !== 0) {
+    writer.writeInt32(
+      7,
+      f
+    );
+  }
+  f = message.getPlayerOneResult();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      8,
+      f
+    );
+  }
+  f = message.getPlayerTwoResult();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      9,
+      f
+    );
+  }
+  f = message.getGameEndReason();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      10,
+      f
+    );
+  }
+  f = message.getAmendment();
+  if (f) {
+    writer.writeBool(
+      11,
+      f
+    );
+  }
+  f = message.getGameIndex();
+  if (f !== 0) {
+    writer.writeInt32(
+      12,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.tournament_service.TournamentResultOverrideRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.TournamentResultOverrideRequest} returns this
+ */
+proto.tournament_service.TournamentResultOverrideRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string division = 2;
+ * @return {string}
+ */
+proto.tournament_service.TournamentResultOverrideRequest.prototype.getDivision = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.TournamentResultOverrideRequest} returns this
+ */
+proto.tournament_service.TournamentResultOverrideRequest.prototype.setDivision = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string player_one_id = 3;
+ * @return {string}
+ */
+proto.tournament_service.TournamentResultOverrideRequest.prototype.getPlayerOneId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.TournamentResultOverrideRequest} returns this
+ */
+proto.tournament_service.TournamentResultOverrideRequest.prototype.setPlayerOneId = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string player_two_id = 4;
+ * @return {string}
+ */
+proto.tournament_service.TournamentResultOverrideRequest.prototype.getPlayerTwoId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.TournamentResultOverrideRequest} returns this
+ */
+proto.tournament_service.TournamentResultOverrideRequest.prototype.setPlayerTwoId = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional int32 round = 5;
+ * @return {number}
+ */
+proto.tournament_service.TournamentResultOverrideRequest.prototype.getRound = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.tournament_service.TournamentResultOverrideRequest} returns this
+ */
+proto.tournament_service.TournamentResultOverrideRequest.prototype.setRound = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional int32 player_one_score = 6;
+ * @return {number}
+ */
+proto.tournament_service.TournamentResultOverrideRequest.prototype.getPlayerOneScore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.tournament_service.TournamentResultOverrideRequest} returns this
+ */
+proto.tournament_service.TournamentResultOverrideRequest.prototype.setPlayerOneScore = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional int32 player_two_score = 7;
+ * @return {number}
+ */
+proto.tournament_service.TournamentResultOverrideRequest.prototype.getPlayerTwoScore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.tournament_service.TournamentResultOverrideRequest} returns this
+ */
+proto.tournament_service.TournamentResultOverrideRequest.prototype.setPlayerTwoScore = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+/**
+ * optional ipc.TournamentGameResult player_one_result = 8;
+ * @return {!proto.ipc.TournamentGameResult}
+ */
+proto.tournament_service.TournamentResultOverrideRequest.prototype.getPlayerOneResult = function() {
+  return /** @type {!proto.ipc.TournamentGameResult} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {!proto.ipc.TournamentGameResult} value
+ * @return {!proto.tournament_service.TournamentResultOverrideRequest} returns this
+ */
+proto.tournament_service.TournamentResultOverrideRequest.prototype.setPlayerOneResult = function(value) {
+  return jspb.Message.setProto3EnumField(this, 8, value);
+};
+
+
+/**
+ * optional ipc.TournamentGameResult player_two_result = 9;
+ * @return {!proto.ipc.TournamentGameResult}
+ */
+proto.tournament_service.TournamentResultOverrideRequest.prototype.getPlayerTwoResult = function() {
+  return /** @type {!proto.ipc.TournamentGameResult} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {!proto.ipc.TournamentGameResult} value
+ * @return {!proto.tournament_service.TournamentResultOverrideRequest} returns this
+ */
+proto.tournament_service.TournamentResultOverrideRequest.prototype.setPlayerTwoResult = function(value) {
+  return jspb.Message.setProto3EnumField(this, 9, value);
+};
+
+
+/**
+ * optional ipc.GameEndReason game_end_reason = 10;
+ * @return {!proto.ipc.GameEndReason}
+ */
+proto.tournament_service.TournamentResultOverrideRequest.prototype.getGameEndReason = function() {
+  return /** @type {!proto.ipc.GameEndReason} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {!proto.ipc.GameEndReason} value
+ * @return {!proto.tournament_service.TournamentResultOverrideRequest} returns this
+ */
+proto.tournament_service.TournamentResultOverrideRequest.prototype.setGameEndReason = function(value) {
+  return jspb.Message.setProto3EnumField(this, 10, value);
+};
+
+
+/**
+ * optional bool amendment = 11;
+ * @return {boolean}
+ */
+proto.tournament_service.TournamentResultOverrideRequest.prototype.getAmendment = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 11, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.tournament_service.TournamentResultOverrideRequest} returns this
+ */
+proto.tournament_service.TournamentResultOverrideRequest.prototype.setAmendment = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 11, value);
+};
+
+
+/**
+ * optional int32 game_index = 12;
+ * @return {number}
+ */
+proto.tournament_service.TournamentResultOverrideRequest.prototype.getGameIndex = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 12, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.tournament_service.TournamentResultOverrideRequest} returns this
+ */
+proto.tournament_service.TournamentResultOverrideRequest.prototype.setGameIndex = function(value) {
+  return jspb.Message.setProto3IntField(this, 12, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.TournamentStartRoundCountdownRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.TournamentStartRoundCountdownRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.TournamentStartRoundCountdownRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.TournamentStartRoundCountdownRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    round: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    startAllRounds: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.TournamentStartRoundCountdownRequest}
+ */
+proto.tournament_service.TournamentStartRoundCountdownRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.TournamentStartRoundCountdownRequest;
+  return proto.tournament_service.TournamentStartRoundCountdownRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.TournamentStartRoundCountdownRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.TournamentStartRoundCountdownRequest}
+ */
+proto.tournament_service.TournamentStartRoundCountdownRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDivision(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRound(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setStartAllRounds(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.TournamentStartRoundCountdownRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.TournamentStartRoundCountdownRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.TournamentStartRoundCountdownRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.TournamentStartRoundCountdownRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDivision();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getRound();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getStartAllRounds();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.tournament_service.TournamentStartRoundCountdownRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.TournamentStartRoundCountdownRequest} returns this
+ */
+proto.tournament_service.TournamentStartRoundCountdownRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string division = 2;
+ * @return {string}
+ */
+proto.tournament_service.TournamentStartRoundCountdownRequest.prototype.getDivision = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.TournamentStartRoundCountdownRequest} returns this
+ */
+proto.tournament_service.TournamentStartRoundCountdownRequest.prototype.setDivision = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional int32 round = 3;
+ * @return {number}
+ */
+proto.tournament_service.TournamentStartRoundCountdownRequest.prototype.getRound = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.tournament_service.TournamentStartRoundCountdownRequest} returns this
+ */
+proto.tournament_service.TournamentStartRoundCountdownRequest.prototype.setRound = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional bool start_all_rounds = 4;
+ * @return {boolean}
+ */
+proto.tournament_service.TournamentStartRoundCountdownRequest.prototype.getStartAllRounds = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.tournament_service.TournamentStartRoundCountdownRequest} returns this
+ */
+proto.tournament_service.TournamentStartRoundCountdownRequest.prototype.setStartAllRounds = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.TournamentResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.TournamentResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.TournamentResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.TournamentResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.TournamentResponse}
+ */
+proto.tournament_service.TournamentResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.TournamentResponse;
+  return proto.tournament_service.TournamentResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.TournamentResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.TournamentResponse}
+ */
+proto.tournament_service.TournamentResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.TournamentResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.TournamentResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.TournamentResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.TournamentResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.NewTournamentResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.NewTournamentResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.NewTournamentResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.NewTournamentResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    slug: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.NewTournamentResponse}
+ */
+proto.tournament_service.NewTournamentResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.NewTournamentResponse;
+  return proto.tournament_service.NewTournamentResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.NewTournamentResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.NewTournamentResponse}
+ */
+proto.tournament_service.NewTournamentResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSlug(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.NewTournamentResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.NewTournamentResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.NewTournamentResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.NewTournamentResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSlug();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.tournament_service.NewTournamentResponse.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.NewTournamentResponse} returns this
+ */
+proto.tournament_service.NewTournamentResponse.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string slug = 2;
+ * @return {string}
+ */
+proto.tournament_service.NewTournamentResponse.prototype.getSlug = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.NewTournamentResponse} returns this
+ */
+proto.tournament_service.NewTournamentResponse.prototype.setSlug = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.GetTournamentMetadataRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.GetTournamentMetadataRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.GetTournamentMetadataRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.GetTournamentMetadataRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    slug: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.GetTournamentMetadataRequest}
+ */
+proto.tournament_service.GetTournamentMetadataRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.GetTournamentMetadataRequest;
+  return proto.tournament_service.GetTournamentMetadataRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.GetTournamentMetadataRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.GetTournamentMetadataRequest}
+ */
+proto.tournament_service.GetTournamentMetadataRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSlug(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.GetTournamentMetadataRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.GetTournamentMetadataRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.GetTournamentMetadataRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.GetTournamentMetadataRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSlug();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.tournament_service.GetTournamentMetadataRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.GetTournamentMetadataRequest} returns this
+ */
+proto.tournament_service.GetTournamentMetadataRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string slug = 2;
+ * @return {string}
+ */
+proto.tournament_service.GetTournamentMetadataRequest.prototype.getSlug = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.GetTournamentMetadataRequest} returns this
+ */
+proto.tournament_service.GetTournamentMetadataRequest.prototype.setSlug = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.GetTournamentRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.GetTournamentRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.GetTournamentRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.GetTournamentRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.GetTournamentRequest}
+ */
+proto.tournament_service.GetTournamentRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.GetTournamentRequest;
+  return proto.tournament_service.GetTournamentRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.GetTournamentRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.GetTournamentRequest}
+ */
+proto.tournament_service.GetTournamentRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.GetTournamentRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.GetTournamentRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.GetTournamentRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.GetTournamentRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.tournament_service.GetTournamentRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.GetTournamentRequest} returns this
+ */
+proto.tournament_service.GetTournamentRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.FinishTournamentRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.FinishTournamentRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.FinishTournamentRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.FinishTournamentRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.FinishTournamentRequest}
+ */
+proto.tournament_service.FinishTournamentRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.FinishTournamentRequest;
+  return proto.tournament_service.FinishTournamentRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.FinishTournamentRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.FinishTournamentRequest}
+ */
+proto.tournament_service.FinishTournamentRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.FinishTournamentRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.FinishTournamentRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.FinishTournamentRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.FinishTournamentRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.tournament_service.FinishTournamentRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.FinishTournamentRequest} returns this
+ */
+proto.tournament_service.FinishTournamentRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.ScheduledRound.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.ScheduledRound.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.ScheduledRound} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.ScheduledRound.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    division: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    round: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    startTime: (f = msg.getStartTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.ScheduledRound}
+ */
+proto.tournament_service.ScheduledRound.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.ScheduledRound;
+  return proto.tournament_service.ScheduledRound.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.ScheduledRound} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.ScheduledRound}
+ */
+proto.tournament_service.ScheduledRound.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDivision(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRound(value);
+      break;
+    case 3:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setStartTime(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.ScheduledRound.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.ScheduledRound.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.ScheduledRound} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.ScheduledRound.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDivision();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getRound();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getStartTime();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string division = 1;
+ * @return {string}
+ */
+proto.tournament_service.ScheduledRound.prototype.getDivision = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.ScheduledRound} returns this
+ */
+proto.tournament_service.ScheduledRound.prototype.setDivision = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int32 round = 2;
+ * @return {number}
+ */
+proto.tournament_service.ScheduledRound.prototype.getRound = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.tournament_service.ScheduledRound} returns this
+ */
+proto.tournament_service.ScheduledRound.prototype.setRound = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp start_time = 3;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.tournament_service.ScheduledRound.prototype.getStartTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.tournament_service.ScheduledRound} returns this
+*/
+proto.tournament_service.ScheduledRound.prototype.setStartTime = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.tournament_service.ScheduledRound} returns this
+ */
+proto.tournament_service.ScheduledRound.prototype.clearStartTime = function() {
+  return this.setStartTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.tournament_service.ScheduledRound.prototype.hasStartTime = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.tournament_service.TournamentMetadataResponse.repeatedFields_ = [2,3,4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.TournamentMetadataResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.TournamentMetadataResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.TournamentMetadataResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.TournamentMetadataResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    metadata: (f = msg.getMetadata()) && proto.tournament_service.TournamentMetadata.toObject(includeInstance, f),
+    directorsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+    scheduleList: jspb.Message.toObjectList(msg.getScheduleList(),
+    proto.tournament_service.ScheduledRound.toObject, includeInstance),
+    prizeAwardsList: jspb.Message.toObjectList(msg.getPrizeAwardsList(),
+    api_proto_ipc_tournament_pb.PrizeAward.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.TournamentMetadataResponse}
+ */
+proto.tournament_service.TournamentMetadataResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.TournamentMetadataResponse;
+  return proto.tournament_service.TournamentMetadataResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.TournamentMetadataResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.TournamentMetadataResponse}
+ */
+proto.tournament_service.TournamentMetadataResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.tournament_service.TournamentMetadata;
+      reader.readMessage(value,proto.tournament_service.TournamentMetadata.deserializeBinaryFromReader);
+      msg.setMetadata(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addDirectors(value);
+      break;
+    case 3:
+      var value = new proto.tournament_service.ScheduledRound;
+      reader.readMessage(value,proto.tournament_service.ScheduledRound.deserializeBinaryFromReader);
+      msg.addSchedule(value);
+      break;
+    case 4:
+      var value = new api_proto_ipc_tournament_pb.PrizeAward;
+      reader.readMessage(value,api_proto_ipc_tournament_pb.PrizeAward.deserializeBinaryFromReader);
+      msg.addPrizeAwards(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.TournamentMetadataResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.TournamentMetadataResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.TournamentMetadataResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.TournamentMetadataResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getMetadata();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.tournament_service.TournamentMetadata.serializeBinaryToWriter
+    );
+  }
+  f = message.getDirectorsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+  f = message.getScheduleList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.tournament_service.ScheduledRound.serializeBinaryToWriter
+    );
+  }
+  f = message.getPrizeAwardsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      api_proto_ipc_tournament_pb.PrizeAward.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional TournamentMetadata metadata = 1;
+ * @return {?proto.tournament_service.TournamentMetadata}
+ */
+proto.tournament_service.TournamentMetadataResponse.prototype.getMetadata = function() {
+  return /** @type{?proto.tournament_service.TournamentMetadata} */ (
+    jspb.Message.getWrapperField(this, proto.tournament_service.TournamentMetadata, 1));
+};
+
+
+/**
+ * @param {?proto.tournament_service.TournamentMetadata|undefined} value
+ * @return {!proto.tournament_service.TournamentMetadataResponse} returns this
+*/
+proto.tournament_service.TournamentMetadataResponse.prototype.setMetadata = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.tournament_service.TournamentMetadataResponse} returns this
+ */
+proto.tournament_service.TournamentMetadataResponse.prototype.clearMetadata = function() {
+  return this.setMetadata(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.tournament_service.TournamentMetadataResponse.prototype.hasMetadata = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * repeated string directors = 2;
+ * @return {!Array<string>}
+ */
+proto.tournament_service.TournamentMetadataResponse.prototype.getDirectorsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.tournament_service.TournamentMetadataResponse} returns this
+ */
+proto.tournament_service.TournamentMetadataResponse.prototype.setDirectorsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.tournament_service.TournamentMetadataResponse} returns this
+ */
+proto.tournament_service.TournamentMetadataResponse.prototype.addDirectors = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.tournament_service.TournamentMetadataResponse} returns this
+ */
+proto.tournament_service.TournamentMetadataResponse.prototype.clearDirectorsList = function() {
+  return this.setDirectorsList([]);
+};
+
+
+/**
+ * repeated ScheduledRound schedule = 3;
+ * @return {!Array<!proto.tournament_service.ScheduledRound>}
+ */
+proto.tournament_service.TournamentMetadataResponse.prototype.getScheduleList = function() {
+  return /** @type{!Array<!proto.tournament_service.ScheduledRound>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.tournament_service.ScheduledRound, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.tournament_service.ScheduledRound>} value
+ * @return {!proto.tournament_service.TournamentMetadataResponse} returns this
+*/
+proto.tournament_service.TournamentMetadataResponse.prototype.setScheduleList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.tournament_service.ScheduledRound=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.tournament_service.ScheduledRound}
+ */
+proto.tournament_service.TournamentMetadataResponse.prototype.addSchedule = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.tournament_service.ScheduledRound, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.tournament_service.TournamentMetadataResponse} returns this
+ */
+proto.tournament_service.TournamentMetadataResponse.prototype.clearScheduleList = function() {
+  return this.setScheduleList([]);
+};
+
+
+/**
+ * repeated ipc.PrizeAward prize_awards = 4;
+ * @return {!Array<!proto.ipc.PrizeAward>}
+ */
+proto.tournament_service.TournamentMetadataResponse.prototype.getPrizeAwardsList = function() {
+  return /** @type{!Array<!proto.ipc.PrizeAward>} */ (
+    jspb.Message.getRepeatedWrapperField(this, api_proto_ipc_tournament_pb.PrizeAward, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.ipc.PrizeAward>} value
+ * @return {!proto.tournament_service.TournamentMetadataResponse} returns this
+*/
+proto.tournament_service.TournamentMetadataResponse.prototype.setPrizeAwardsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.ipc.PrizeAward=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.PrizeAward}
+ */
+proto.tournament_service.TournamentMetadataResponse.prototype.addPrizeAwards = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.ipc.PrizeAward, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.tournament_service.TournamentMetadataResponse} returns this
+ */
+proto.tournament_service.TournamentMetadataResponse.prototype.clearPrizeAwardsList = function() {
+  return this.setPrizeAwardsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.RecentGamesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.RecentGamesRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.RecentGamesRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.RecentGamesRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    numGames: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    offset: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.RecentGamesRequest}
+ */
+proto.tournament_service.RecentGamesRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.RecentGamesRequest;
+  return proto.tournament_service.RecentGamesRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.RecentGamesRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.RecentGamesRequest}
+ */
+proto.tournament_service.RecentGamesRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setNumGames(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setOffset(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.RecentGamesRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.RecentGamesRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.RecentGamesRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.RecentGamesRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getNumGames();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getOffset();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.tournament_service.RecentGamesRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.RecentGamesRequest} returns this
+ */
+proto.tournament_service.RecentGamesRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int32 num_games = 2;
+ * @return {number}
+ */
+proto.tournament_service.RecentGamesRequest.prototype.getNumGames = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.tournament_service.RecentGamesRequest} returns this
+ */
+proto.tournament_service.RecentGamesRequest.prototype.setNumGames = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int32 offset = 3;
+ * @return {number}
+ */
+proto.tournament_service.RecentGamesRequest.prototype.getOffset = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.tournament_service.RecentGamesRequest} returns this
+ */
+proto.tournament_service.RecentGamesRequest.prototype.setOffset = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.tournament_service.RecentGamesResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.RecentGamesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.RecentGamesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.RecentGamesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.RecentGamesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    gamesList: jspb.Message.toObjectList(msg.getGamesList(),
+    api_proto_ipc_tournament_pb.TournamentGameEndedEvent.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.RecentGamesResponse}
+ */
+proto.tournament_service.RecentGamesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.RecentGamesResponse;
+  return proto.tournament_service.RecentGamesResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.RecentGamesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.RecentGamesResponse}
+ */
+proto.tournament_service.RecentGamesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new api_proto_ipc_tournament_pb.TournamentGameEndedEvent;
+      reader.readMessage(value,api_proto_ipc_tournament_pb.TournamentGameEndedEvent.deserializeBinaryFromReader);
+      msg.addGames(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.RecentGamesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.RecentGamesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.RecentGamesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.RecentGamesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getGamesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      api_proto_ipc_tournament_pb.TournamentGameEndedEvent.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated ipc.TournamentGameEndedEvent games = 1;
+ * @return {!Array<!proto.ipc.TournamentGameEndedEvent>}
+ */
+proto.tournament_service.RecentGamesResponse.prototype.getGamesList = function() {
+  return /** @type{!Array<!proto.ipc.TournamentGameEndedEvent>} */ (
+    jspb.Message.getRepeatedWrapperField(this, api_proto_ipc_tournament_pb.TournamentGameEndedEvent, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.ipc.TournamentGameEndedEvent>} value
+ * @return {!proto.tournament_service.RecentGamesResponse} returns this
+*/
+proto.tournament_service.RecentGamesResponse.prototype.setGamesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.ipc.TournamentGameEndedEvent=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.TournamentGameEndedEvent}
+ */
+proto.tournament_service.RecentGamesResponse.prototype.addGames = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ipc.TournamentGameEndedEvent, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.tournament_service.RecentGamesResponse} returns this
+ */
+proto.tournament_service.RecentGamesResponse.prototype.clearGamesList = function() {
+  return this.setGamesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.UnstartTournamentRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.UnstartTournamentRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.UnstartTournamentRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.UnstartTournamentRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.UnstartTournamentRequest}
+ */
+proto.tournament_service.UnstartTournamentRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.UnstartTournamentRequest;
+  return proto.tournament_service.UnstartTournamentRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.UnstartTournamentRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.UnstartTournamentRequest}
+ */
+proto.tournament_service.UnstartTournamentRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.UnstartTournamentRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.UnstartTournamentRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.UnstartTournamentRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.UnstartTournamentRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.tournament_service.UnstartTournamentRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.UnstartTournamentRequest} returns this
+ */
+proto.tournament_service.UnstartTournamentRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.tournament_service.WithdrawPlayersRequest.repeatedFields_ = [3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.WithdrawPlayersRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.WithdrawPlayersRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.WithdrawPlayersRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.WithdrawPlayersRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    playersList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    policy: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.WithdrawPlayersRequest}
+ */
+proto.tournament_service.WithdrawPlayersRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.WithdrawPlayersRequest;
+  return proto.tournament_service.WithdrawPlayersRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.WithdrawPlayersRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.WithdrawPlayersRequest}
+ */
+proto.tournament_service.WithdrawPlayersRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDivision(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addPlayers(value);
+      break;
+    case 4:
+      var value = /** @type {!proto.ipc.WithdrawalPolicy} */ (reader.readEnum());
+      msg.setPolicy(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.WithdrawPlayersRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.WithdrawPlayersRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.WithdrawPlayersRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.WithdrawPlayersRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDivision();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getPlayersList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      3,
+      f
+    );
+  }
+  f = message.getPolicy();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.tournament_service.WithdrawPlayersRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.WithdrawPlayersRequest} returns this
+ */
+proto.tournament_service.WithdrawPlayersRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string division = 2;
+ * @return {string}
+ */
+proto.tournament_service.WithdrawPlayersRequest.prototype.getDivision = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.WithdrawPlayersRequest} returns this
+ */
+proto.tournament_service.WithdrawPlayersRequest.prototype.setDivision = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * repeated string players = 3;
+ * @return {!Array<string>}
+ */
+proto.tournament_service.WithdrawPlayersRequest.prototype.getPlayersList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.tournament_service.WithdrawPlayersRequest} returns this
+ */
+proto.tournament_service.WithdrawPlayersRequest.prototype.setPlayersList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.tournament_service.WithdrawPlayersRequest} returns this
+ */
+proto.tournament_service.WithdrawPlayersRequest.prototype.addPlayers = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.tournament_service.WithdrawPlayersRequest} returns this
+ */
+proto.tournament_service.WithdrawPlayersRequest.prototype.clearPlayersList = function() {
+  return this.setPlayersList([]);
+};
+
+
+/**
+ * optional ipc.WithdrawalPolicy policy = 4;
+ * @return {!proto.ipc.WithdrawalPolicy}
+ */
+proto.tournament_service.WithdrawPlayersRequest.prototype.getPolicy = function() {
+  return /** @type {!proto.ipc.WithdrawalPolicy} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {!proto.ipc.WithdrawalPolicy} value
+ * @return {!proto.tournament_service.WithdrawPlayersRequest} returns this
+ */
+proto.tournament_service.WithdrawPlayersRequest.prototype.setPolicy = function(value) {
+  return jspb.Message.setProto3EnumField(this, 4, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.tournament_service.ReadmitPlayersRequest.repeatedFields_ = [3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.ReadmitPlayersRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.ReadmitPlayersRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.ReadmitPlayersRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.ReadmitPlayersRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    playersList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    catchUpByes: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.ReadmitPlayersRequest}
+ */
+proto.tournament_service.ReadmitPlayersRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.ReadmitPlayersRequest;
+  return proto.tournament_service.ReadmitPlayersRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.ReadmitPlayersRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.ReadmitPlayersRequest}
+ */
+proto.tournament_service.ReadmitPlayersRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDivision(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addPlayers(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setCatchUpByes(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.ReadmitPlayersRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.ReadmitPlayersRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.ReadmitPlayersRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.ReadmitPlayersRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDivision();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getPlayersList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      3,
+      f
+    );
+  }
+  f = message.getCatchUpByes();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.tournament_service.ReadmitPlayersRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.ReadmitPlayersRequest} returns this
+ */
+proto.tournament_service.ReadmitPlayersRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string division = 2;
+ * @return {string}
+ */
+proto.tournament_service.ReadmitPlayersRequest.prototype.getDivision = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.ReadmitPlayersRequest} returns this
+ */
+proto.tournament_service.ReadmitPlayersRequest.prototype.setDivision = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * repeated string players = 3;
+ * @return {!Array<string>}
+ */
+proto.tournament_service.ReadmitPlayersRequest.prototype.getPlayersList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.tournament_service.ReadmitPlayersRequest} returns this
+ */
+proto.tournament_service.ReadmitPlayersRequest.prototype.setPlayersList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.tournament_service.ReadmitPlayersRequest} returns this
+ */
+proto.tournament_service.ReadmitPlayersRequest.prototype.addPlayers = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.tournament_service.ReadmitPlayersRequest} returns this
+ */
+proto.tournament_service.ReadmitPlayersRequest.prototype.clearPlayersList = function() {
+  return this.setPlayersList([]);
+};
+
+
+/**
+ * optional int32 catch_up_byes = 4;
+ * @return {number}
+ */
+proto.tournament_service.ReadmitPlayersRequest.prototype.getCatchUpByes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.tournament_service.ReadmitPlayersRequest} returns this
+ */
+proto.tournament_service.ReadmitPlayersRequest.prototype.setCatchUpByes = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.UncheckInRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.UncheckInRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.UncheckInRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.UncheckInRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.UncheckInRequest}
+ */
+proto.tournament_service.UncheckInRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.UncheckInRequest;
+  return proto.tournament_service.UncheckInRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.UncheckInRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.UncheckInRequest}
+ */
+proto.tournament_service.UncheckInRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.UncheckInRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.UncheckInRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.UncheckInRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.UncheckInRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.tournament_service.UncheckInRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.UncheckInRequest} returns this
+ */
+proto.tournament_service.UncheckInRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.CheckinRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.CheckinRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.CheckinRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.CheckinRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.CheckinRequest}
+ */
+proto.tournament_service.CheckinRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.CheckinRequest;
+  return proto.tournament_service.CheckinRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.CheckinRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.CheckinRequest}
+ */
+proto.tournament_service.CheckinRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.CheckinRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.CheckinRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.CheckinRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.CheckinRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.tournament_service.CheckinRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.CheckinRequest} returns this
+ */
+proto.tournament_service.CheckinRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.RegisterRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.RegisterRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.RegisterRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.RegisterRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    division: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.RegisterRequest}
+ */
+proto.tournament_service.RegisterRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.RegisterRequest;
+  return proto.tournament_service.RegisterRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.RegisterRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.RegisterRequest}
+ */
+proto.tournament_service.RegisterRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDivision(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.RegisterRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.RegisterRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.RegisterRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.RegisterRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDivision();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.tournament_service.RegisterRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.RegisterRequest} returns this
+ */
+proto.tournament_service.RegisterRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string division = 2;
+ * @return {string}
+ */
+proto.tournament_service.RegisterRequest.prototype.getDivision = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.RegisterRequest} returns this
+ */
+proto.tournament_service.RegisterRequest.prototype.setDivision = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.RegisterResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.RegisterResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.RegisterResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.RegisterResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    status: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.RegisterResponse}
+ */
+proto.tournament_service.RegisterResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.RegisterResponse;
+  return proto.tournament_service.RegisterResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.RegisterResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.RegisterResponse}
+ */
+proto.tournament_service.RegisterResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.tournament_service.RegistrationStatus} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.RegisterResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.RegisterResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.RegisterResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.RegisterResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional RegistrationStatus status = 1;
+ * @return {!proto.tournament_service.RegistrationStatus}
+ */
+proto.tournament_service.RegisterResponse.prototype.getStatus = function() {
+  return /** @type {!proto.tournament_service.RegistrationStatus} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.tournament_service.RegistrationStatus} value
+ * @return {!proto.tournament_service.RegisterResponse} returns this
+ */
+proto.tournament_service.RegisterResponse.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.tournament_service.DivisionRegistrationsResponse.repeatedFields_ = [3,4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.DivisionRegistrationsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.DivisionRegistrationsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.DivisionRegistrationsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.DivisionRegistrationsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    pendingList: jspb.Message.toObjectList(msg.getPendingList(),
+    api_proto_ipc_tournament_pb.TournamentPerson.toObject, includeInstance),
+    waitlistList: jspb.Message.toObjectList(msg.getWaitlistList(),
+    api_proto_ipc_tournament_pb.TournamentPerson.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.DivisionRegistrationsResponse}
+ */
+proto.tournament_service.DivisionRegistrationsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.DivisionRegistrationsResponse;
+  return proto.tournament_service.DivisionRegistrationsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.DivisionRegistrationsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.DivisionRegistrationsResponse}
+ */
+proto.tournament_service.DivisionRegistrationsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDivision(value);
+      break;
+    case 3:
+      var value = new api_proto_ipc_tournament_pb.TournamentPerson;
+      reader.readMessage(value,api_proto_ipc_tournament_pb.TournamentPerson.deserializeBinaryFromReader);
+      msg.addPending(value);
+      break;
+    case 4:
+      var value = new api_proto_ipc_tournament_pb.TournamentPerson;
+      reader.readMessage(value,api_proto_ipc_tournament_pb.TournamentPerson.deserializeBinaryFromReader);
+      msg.addWaitlist(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.DivisionRegistrationsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.DivisionRegistrationsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.DivisionRegistrationsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.DivisionRegistrationsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDivision();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getPendingList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      api_proto_ipc_tournament_pb.TournamentPerson.serializeBinaryToWriter
+    );
+  }
+  f = message.getWaitlistList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      api_proto_ipc_tournament_pb.TournamentPerson.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.tournament_service.DivisionRegistrationsResponse.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.DivisionRegistrationsResponse} returns this
+ */
+proto.tournament_service.DivisionRegistrationsResponse.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string division = 2;
+ * @return {string}
+ */
+proto.tournament_service.DivisionRegistrationsResponse.prototype.getDivision = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.DivisionRegistrationsResponse} returns this
+ */
+proto.tournament_service.DivisionRegistrationsResponse.prototype.setDivision = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * repeated ipc.TournamentPerson pending = 3;
+ * @return {!Array<!proto.ipc.TournamentPerson>}
+ */
+proto.tournament_service.DivisionRegistrationsResponse.prototype.getPendingList = function() {
+  return /** @type{!Array<!proto.ipc.TournamentPerson>} */ (
+    jspb.Message.getRepeatedWrapperField(this, api_proto_ipc_tournament_pb.TournamentPerson, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.ipc.TournamentPerson>} value
+ * @return {!proto.tournament_service.DivisionRegistrationsResponse} returns this
+*/
+proto.tournament_service.DivisionRegistrationsResponse.prototype.setPendingList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.ipc.TournamentPerson=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.TournamentPerson}
+ */
+proto.tournament_service.DivisionRegistrationsResponse.prototype.addPending = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.ipc.TournamentPerson, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.tournament_service.DivisionRegistrationsResponse} returns this
+ */
+proto.tournament_service.DivisionRegistrationsResponse.prototype.clearPendingList = function() {
+  return this.setPendingList([]);
+};
+
+
+/**
+ * repeated ipc.TournamentPerson waitlist = 4;
+ * @return {!Array<!proto.ipc.TournamentPerson>}
+ */
+proto.tournament_service.DivisionRegistrationsResponse.prototype.getWaitlistList = function() {
+  return /** @type{!Array<!proto.ipc.TournamentPerson>} */ (
+    jspb.Message.getRepeatedWrapperField(this, api_proto_ipc_tournament_pb.TournamentPerson, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.ipc.TournamentPerson>} value
+ * @return {!proto.tournament_service.DivisionRegistrationsResponse} returns this
+*/
+proto.tournament_service.DivisionRegistrationsResponse.prototype.setWaitlistList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.ipc.TournamentPerson=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.TournamentPerson}
+ */
+proto.tournament_service.DivisionRegistrationsResponse.prototype.addWaitlist = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.ipc.TournamentPerson, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.tournament_service.DivisionRegistrationsResponse} returns this
+ */
+proto.tournament_service.DivisionRegistrationsResponse.prototype.clearWaitlistList = function() {
+  return this.setWaitlistList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.AuditLogEntry.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.AuditLogEntry.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.AuditLogEntry} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.AuditLogEntry.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    actor: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    action: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    division: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    payload: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    diff: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    createdAt: (f = msg.getCreatedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.AuditLogEntry}
+ */
+proto.tournament_service.AuditLogEntry.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.AuditLogEntry;
+  return proto.tournament_service.AuditLogEntry.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.AuditLogEntry} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.AuditLogEntry}
+ */
+proto.tournament_service.AuditLogEntry.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setActor(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAction(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDivision(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPayload(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDiff(value);
+      break;
+    case 6:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCreatedAt(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.AuditLogEntry.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.AuditLogEntry.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.AuditLogEntry} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.AuditLogEntry.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getActor();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getAction();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getDivision();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getPayload();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getDiff();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getCreatedAt();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string actor = 1;
+ * @return {string}
+ */
+proto.tournament_service.AuditLogEntry.prototype.getActor = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.AuditLogEntry} returns this
+ */
+proto.tournament_service.AuditLogEntry.prototype.setActor = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string action = 2;
+ * @return {string}
+ */
+proto.tournament_service.AuditLogEntry.prototype.getAction = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.AuditLogEntry} returns this
+ */
+proto.tournament_service.AuditLogEntry.prototype.setAction = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string division = 3;
+ * @return {string}
+ */
+proto.tournament_service.AuditLogEntry.prototype.getDivision = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.AuditLogEntry} returns this
+ */
+proto.tournament_service.AuditLogEntry.prototype.setDivision = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string payload = 4;
+ * @return {string}
+ */
+proto.tournament_service.AuditLogEntry.prototype.getPayload = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.AuditLogEntry} returns this
+ */
+proto.tournament_service.AuditLogEntry.prototype.setPayload = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string diff = 5;
+ * @return {string}
+ */
+proto.tournament_service.AuditLogEntry.prototype.getDiff = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.AuditLogEntry} returns this
+ */
+proto.tournament_service.AuditLogEntry.prototype.setDiff = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp created_at = 6;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.tournament_service.AuditLogEntry.prototype.getCreatedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 6));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.tournament_service.AuditLogEntry} returns this
+*/
+proto.tournament_service.AuditLogEntry.prototype.setCreatedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.tournament_service.AuditLogEntry} returns this
+ */
+proto.tournament_service.AuditLogEntry.prototype.clearCreatedAt = function() {
+  return this.setCreatedAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.tournament_service.AuditLogEntry.prototype.hasCreatedAt = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.AuditLogRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.AuditLogRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.AuditLogRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.AuditLogRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    limit: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    offset: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.AuditLogRequest}
+ */
+proto.tournament_service.AuditLogRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.AuditLogRequest;
+  return proto.tournament_service.AuditLogRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.AuditLogRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.AuditLogRequest}
+ */
+proto.tournament_service.AuditLogRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setLimit(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setOffset(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.AuditLogRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.AuditLogRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.AuditLogRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.AuditLogRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getLimit();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getOffset();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.tournament_service.AuditLogRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.AuditLogRequest} returns this
+ */
+proto.tournament_service.AuditLogRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int32 limit = 2;
+ * @return {number}
+ */
+proto.tournament_service.AuditLogRequest.prototype.getLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.tournament_service.AuditLogRequest} returns this
+ */
+proto.tournament_service.AuditLogRequest.prototype.setLimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int32 offset = 3;
+ * @return {number}
+ */
+proto.tournament_service.AuditLogRequest.prototype.getOffset = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.tournament_service.AuditLogRequest} returns this
+ */
+proto.tournament_service.AuditLogRequest.prototype.setOffset = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.tournament_service.AuditLogResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.AuditLogResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.AuditLogResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.AuditLogResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.AuditLogResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    entriesList: jspb.Message.toObjectList(msg.getEntriesList(),
+    proto.tournament_service.AuditLogEntry.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.AuditLogResponse}
+ */
+proto.tournament_service.AuditLogResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.AuditLogResponse;
+  return proto.tournament_service.AuditLogResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.AuditLogResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.AuditLogResponse}
+ */
+proto.tournament_service.AuditLogResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.tournament_service.AuditLogEntry;
+      reader.readMessage(value,proto.tournament_service.AuditLogEntry.deserializeBinaryFromReader);
+      msg.addEntries(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.AuditLogResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.AuditLogResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.AuditLogResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.AuditLogResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEntriesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.tournament_service.AuditLogEntry.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated AuditLogEntry entries = 1;
+ * @return {!Array<!proto.tournament_service.AuditLogEntry>}
+ */
+proto.tournament_service.AuditLogResponse.prototype.getEntriesList = function() {
+  return /** @type{!Array<!proto.tournament_service.AuditLogEntry>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.tournament_service.AuditLogEntry, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.tournament_service.AuditLogEntry>} value
+ * @return {!proto.tournament_service.AuditLogResponse} returns this
+*/
+proto.tournament_service.AuditLogResponse.prototype.setEntriesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.tournament_service.AuditLogEntry=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.tournament_service.AuditLogEntry}
+ */
+proto.tournament_service.AuditLogResponse.prototype.addEntries = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.tournament_service.AuditLogEntry, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.tournament_service.AuditLogResponse} returns this
+ */
+proto.tournament_service.AuditLogResponse.prototype.clearEntriesList = function() {
+  return this.setEntriesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.tournament_service.CloneTournamentRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.CloneTournamentRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.CloneTournamentRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.CloneTournamentRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    slug: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    includePlayers: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.CloneTournamentRequest}
+ */
+proto.tournament_service.CloneTournamentRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.CloneTournamentRequest;
+  return proto.tournament_service.CloneTournamentRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.CloneTournamentRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.CloneTournamentRequest}
+ */
+proto.tournament_service.CloneTournamentRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSlug(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIncludePlayers(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.tournament_service.CloneTournamentRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.CloneTournamentRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.CloneTournamentRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.CloneTournamentRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getRound();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
   }
+  f = message.getSlug();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getIncludePlayers();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string tournament_id = 1;
+ * optional string id = 1;
  * @return {string}
  */
-proto.tournament_service.StartRoundRequest.prototype.getTournamentId = function() {
+proto.tournament_service.CloneTournamentRequest.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.tournament_service.StartRoundRequest} returns this
+ * @return {!proto.tournament_service.CloneTournamentRequest} returns this
  */
-proto.tournament_service.StartRoundRequest.prototype.setTournamentId = function(value) {
+proto.tournament_service.CloneTournamentRequest.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional int32 round = 2;
- * @return {number}
+ * optional string name = 2;
+ * @return {string}
  */
-proto.tournament_service.StartRoundRequest.prototype.getRound = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.tournament_service.CloneTournamentRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.tournament_service.StartRoundRequest} returns this
+ * @param {string} value
+ * @return {!proto.tournament_service.CloneTournamentRequest} returns this
  */
-proto.tournament_service.StartRoundRequest.prototype.setRound = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.tournament_service.CloneTournamentRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+/**
+ * optional string slug = 3;
+ * @return {string}
+ */
+proto.tournament_service.CloneTournamentRequest.prototype.getSlug = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {string} value
+ * @return {!proto.tournament_service.CloneTournamentRequest} returns this
  */
-proto.tournament_service.NewTournamentRequest.repeatedFields_ = [4];
+proto.tournament_service.CloneTournamentRequest.prototype.setSlug = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional bool include_players = 4;
+ * @return {boolean}
+ */
+proto.tournament_service.CloneTournamentRequest.prototype.getIncludePlayers = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.tournament_service.CloneTournamentRequest} returns this
+ */
+proto.tournament_service.CloneTournamentRequest.prototype.setIncludePlayers = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
 
 
 
@@ -777,8 +8509,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.tournament_service.NewTournamentRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.tournament_service.NewTournamentRequest.toObject(opt_includeInstance, this);
+proto.tournament_service.TournamentSnapshot.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.TournamentSnapshot.toObject(opt_includeInstance, this);
 };
 
 
@@ -787,17 +8519,16 @@ proto.tournament_service.NewTournamentRequest.prototype.toObject = function(opt_
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.tournament_service.NewTournamentRequest} msg The msg instance to transform.
+ * @param {!proto.tournament_service.TournamentSnapshot} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.NewTournamentRequest.toObject = function(includeInstance, msg) {
+proto.tournament_service.TournamentSnapshot.toObject = function(includeInstance, msg) {
   var f, obj = {
-    slug: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    description: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    directorUsernamesList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
-    type: jspb.Message.getFieldWithDefault(msg, 5, 0)
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    label: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    actor: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    createdAt: (f = msg.getCreatedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -811,23 +8542,23 @@ proto.tournament_service.NewTournamentRequest.toObject = function(includeInstanc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.tournament_service.NewTournamentRequest}
+ * @return {!proto.tournament_service.TournamentSnapshot}
  */
-proto.tournament_service.NewTournamentRequest.deserializeBinary = function(bytes) {
+proto.tournament_service.TournamentSnapshot.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.tournament_service.NewTournamentRequest;
-  return proto.tournament_service.NewTournamentRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.tournament_service.TournamentSnapshot;
+  return proto.tournament_service.TournamentSnapshot.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.tournament_service.NewTournamentRequest} msg The message object to deserialize into.
+ * @param {!proto.tournament_service.TournamentSnapshot} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.tournament_service.NewTournamentRequest}
+ * @return {!proto.tournament_service.TournamentSnapshot}
  */
-proto.tournament_service.NewTournamentRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.tournament_service.TournamentSnapshot.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -835,24 +8566,21 @@ proto.tournament_service.NewTournamentRequest.deserializeBinaryFromReader = func
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSlug(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setLabel(value);
       break;
     case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setDescription(value);
+      msg.setActor(value);
       break;
     case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addDirectorUsernames(value);
-      break;
-    case 5:
-      var value = /** @type {!proto.tournament_service.TType} */ (reader.readEnum());
-      msg.setType(value);
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCreatedAt(value);
       break;
     default:
       reader.skipField();
@@ -867,9 +8595,9 @@ proto.tournament_service.NewTournamentRequest.deserializeBinaryFromReader = func
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.tournament_service.NewTournamentRequest.prototype.serializeBinary = function() {
+proto.tournament_service.TournamentSnapshot.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.tournament_service.NewTournamentRequest.serializeBinaryToWriter(this, writer);
+  proto.tournament_service.TournamentSnapshot.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -877,167 +8605,136 @@ proto.tournament_service.NewTournamentRequest.prototype.serializeBinary = functi
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.tournament_service.NewTournamentRequest} message
+ * @param {!proto.tournament_service.TournamentSnapshot} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.NewTournamentRequest.serializeBinaryToWriter = function(message, writer) {
+proto.tournament_service.TournamentSnapshot.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSlug();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getName();
+  f = message.getLabel();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getDescription();
+  f = message.getActor();
   if (f.length > 0) {
     writer.writeString(
       3,
       f
     );
   }
-  f = message.getDirectorUsernamesList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
+  f = message.getCreatedAt();
+  if (f != null) {
+    writer.writeMessage(
       4,
-      f
-    );
-  }
-  f = message.getType();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      5,
-      f
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string slug = 1;
- * @return {string}
+ * optional uint64 id = 1;
+ * @return {number}
  */
-proto.tournament_service.NewTournamentRequest.prototype.getSlug = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.tournament_service.TournamentSnapshot.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.tournament_service.NewTournamentRequest} returns this
+ * @param {number} value
+ * @return {!proto.tournament_service.TournamentSnapshot} returns this
  */
-proto.tournament_service.NewTournamentRequest.prototype.setSlug = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.tournament_service.TournamentSnapshot.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional string name = 2;
+ * optional string label = 2;
  * @return {string}
  */
-proto.tournament_service.NewTournamentRequest.prototype.getName = function() {
+proto.tournament_service.TournamentSnapshot.prototype.getLabel = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.tournament_service.NewTournamentRequest} returns this
+ * @return {!proto.tournament_service.TournamentSnapshot} returns this
  */
-proto.tournament_service.NewTournamentRequest.prototype.setName = function(value) {
+proto.tournament_service.TournamentSnapshot.prototype.setLabel = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string description = 3;
+ * optional string actor = 3;
  * @return {string}
  */
-proto.tournament_service.NewTournamentRequest.prototype.getDescription = function() {
+proto.tournament_service.TournamentSnapshot.prototype.getActor = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.tournament_service.NewTournamentRequest} returns this
+ * @return {!proto.tournament_service.TournamentSnapshot} returns this
  */
-proto.tournament_service.NewTournamentRequest.prototype.setDescription = function(value) {
+proto.tournament_service.TournamentSnapshot.prototype.setActor = function(value) {
   return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * repeated string director_usernames = 4;
- * @return {!Array<string>}
- */
-proto.tournament_service.NewTournamentRequest.prototype.getDirectorUsernamesList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
-};
-
-
-/**
- * @param {!Array<string>} value
- * @return {!proto.tournament_service.NewTournamentRequest} returns this
- */
-proto.tournament_service.NewTournamentRequest.prototype.setDirectorUsernamesList = function(value) {
-  return jspb.Message.setField(this, 4, value || []);
-};
-
-
-/**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.tournament_service.NewTournamentRequest} returns this
+ * optional google.protobuf.Timestamp created_at = 4;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.tournament_service.NewTournamentRequest.prototype.addDirectorUsernames = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+proto.tournament_service.TournamentSnapshot.prototype.getCreatedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 4));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.tournament_service.NewTournamentRequest} returns this
- */
-proto.tournament_service.NewTournamentRequest.prototype.clearDirectorUsernamesList = function() {
-  return this.setDirectorUsernamesList([]);
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.tournament_service.TournamentSnapshot} returns this
+*/
+proto.tournament_service.TournamentSnapshot.prototype.setCreatedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
 };
 
 
 /**
- * optional TType type = 5;
- * @return {!proto.tournament_service.TType}
+ * Clears the message field making it undefined.
+ * @return {!proto.tournament_service.TournamentSnapshot} returns this
  */
-proto.tournament_service.NewTournamentRequest.prototype.getType = function() {
-  return /** @type {!proto.tournament_service.TType} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.tournament_service.TournamentSnapshot.prototype.clearCreatedAt = function() {
+  return this.setCreatedAt(undefined);
 };
 
 
 /**
- * @param {!proto.tournament_service.TType} value
- * @return {!proto.tournament_service.NewTournamentRequest} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.tournament_service.NewTournamentRequest.prototype.setType = function(value) {
-  return jspb.Message.setProto3EnumField(this, 5, value);
+proto.tournament_service.TournamentSnapshot.prototype.hasCreatedAt = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.tournament_service.TournamentMetadata.repeatedFields_ = [10];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -1053,8 +8750,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.tournament_service.TournamentMetadata.prototype.toObject = function(opt_includeInstance) {
-  return proto.tournament_service.TournamentMetadata.toObject(opt_includeInstance, this);
+proto.tournament_service.SnapshotRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.SnapshotRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -1063,26 +8760,14 @@ proto.tournament_service.TournamentMetadata.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.tournament_service.TournamentMetadata} msg The msg instance to transform.
+ * @param {!proto.tournament_service.SnapshotRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.TournamentMetadata.toObject = function(includeInstance, msg) {
+proto.tournament_service.SnapshotRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    description: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    slug: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    type: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    disclaimer: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    tileStyle: jspb.Message.getFieldWithDefault(msg, 7, ""),
-    boardStyle: jspb.Message.getFieldWithDefault(msg, 8, ""),
-    defaultClubSettings: (f = msg.getDefaultClubSettings()) && api_proto_ipc_omgwords_pb.GameRequest.toObject(includeInstance, f),
-    freeformClubSettingFieldsList: (f = jspb.Message.getRepeatedField(msg, 10)) == null ? undefined : f,
-    password: jspb.Message.getFieldWithDefault(msg, 11, ""),
-    logo: jspb.Message.getFieldWithDefault(msg, 12, ""),
-    color: jspb.Message.getFieldWithDefault(msg, 13, ""),
-    privateAnalysis: jspb.Message.getBooleanFieldWithDefault(msg, 14, false)
+    label: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -1096,23 +8781,23 @@ proto.tournament_service.TournamentMetadata.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.tournament_service.TournamentMetadata}
+ * @return {!proto.tournament_service.SnapshotRequest}
  */
-proto.tournament_service.TournamentMetadata.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.tournament_service.TournamentMetadata;
-  return proto.tournament_service.TournamentMetadata.deserializeBinaryFromReader(msg, reader);
+proto.tournament_service.SnapshotRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.SnapshotRequest;
+  return proto.tournament_service.SnapshotRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.tournament_service.TournamentMetadata} msg The message object to deserialize into.
+ * @param {!proto.tournament_service.SnapshotRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.tournament_service.TournamentMetadata}
+ * @return {!proto.tournament_service.SnapshotRequest}
  */
-proto.tournament_service.TournamentMetadata.deserializeBinaryFromReader = function(msg, reader) {
+proto.tournament_service.SnapshotRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1125,56 +8810,7 @@ proto.tournament_service.TournamentMetadata.deserializeBinaryFromReader = functi
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDescription(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSlug(value);
-      break;
-    case 5:
-      var value = /** @type {!proto.tournament_service.TType} */ (reader.readEnum());
-      msg.setType(value);
-      break;
-    case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDisclaimer(value);
-      break;
-    case 7:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setTileStyle(value);
-      break;
-    case 8:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setBoardStyle(value);
-      break;
-    case 9:
-      var value = new api_proto_ipc_omgwords_pb.GameRequest;
-      reader.readMessage(value,api_proto_ipc_omgwords_pb.GameRequest.deserializeBinaryFromReader);
-      msg.setDefaultClubSettings(value);
-      break;
-    case 10:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addFreeformClubSettingFields(value);
-      break;
-    case 11:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPassword(value);
-      break;
-    case 12:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setLogo(value);
-      break;
-    case 13:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setColor(value);
-      break;
-    case 14:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setPrivateAnalysis(value);
+      msg.setLabel(value);
       break;
     default:
       reader.skipField();
@@ -1189,9 +8825,9 @@ proto.tournament_service.TournamentMetadata.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.tournament_service.TournamentMetadata.prototype.serializeBinary = function() {
+proto.tournament_service.SnapshotRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.tournament_service.TournamentMetadata.serializeBinaryToWriter(this, writer);
+  proto.tournament_service.SnapshotRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1199,11 +8835,11 @@ proto.tournament_service.TournamentMetadata.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.tournament_service.TournamentMetadata} message
+ * @param {!proto.tournament_service.SnapshotRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.TournamentMetadata.serializeBinaryToWriter = function(message, writer) {
+proto.tournament_service.SnapshotRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f.length > 0) {
@@ -1212,98 +8848,13 @@ proto.tournament_service.TournamentMetadata.serializeBinaryToWriter = function(m
       f
     );
   }
-  f = message.getName();
+  f = message.getLabel();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getDescription();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getSlug();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getType();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      5,
-      f
-    );
-  }
-  f = message.getDisclaimer();
-  if (f.length > 0) {
-    writer.writeString(
-      6,
-      f
-    );
-  }
-  f = message.getTileStyle();
-  if (f.length > 0) {
-    writer.writeString(
-      7,
-      f
-    );
-  }
-  f = message.getBoardStyle();
-  if (f.length > 0) {
-    writer.writeString(
-      8,
-      f
-    );
-  }
-  f = message.getDefaultClubSettings();
-  if (f != null) {
-    writer.writeMessage(
-      9,
-      f,
-      api_proto_ipc_omgwords_pb.GameRequest.serializeBinaryToWriter
-    );
-  }
-  f = message.getFreeformClubSettingFieldsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      10,
-      f
-    );
-  }
-  f = message.getPassword();
-  if (f.length > 0) {
-    writer.writeString(
-      11,
-      f
-    );
-  }
-  f = message.getLogo();
-  if (f.length > 0) {
-    writer.writeString(
-      12,
-      f
-    );
-  }
-  f = message.getColor();
-  if (f.length > 0) {
-    writer.writeString(
-      13,
-      f
-    );
-  }
-  f = message.getPrivateAnalysis();
-  if (f) {
-    writer.writeBool(
-      14,
-      f
-    );
-  }
 };
 
 
@@ -1311,289 +8862,355 @@ proto.tournament_service.TournamentMetadata.serializeBinaryToWriter = function(m
  * optional string id = 1;
  * @return {string}
  */
-proto.tournament_service.TournamentMetadata.prototype.getId = function() {
+proto.tournament_service.SnapshotRequest.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.tournament_service.TournamentMetadata} returns this
+ * @return {!proto.tournament_service.SnapshotRequest} returns this
  */
-proto.tournament_service.TournamentMetadata.prototype.setId = function(value) {
+proto.tournament_service.SnapshotRequest.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string name = 2;
+ * optional string label = 2;
  * @return {string}
  */
-proto.tournament_service.TournamentMetadata.prototype.getName = function() {
+proto.tournament_service.SnapshotRequest.prototype.getLabel = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.tournament_service.TournamentMetadata} returns this
+ * @return {!proto.tournament_service.SnapshotRequest} returns this
  */
-proto.tournament_service.TournamentMetadata.prototype.setName = function(value) {
+proto.tournament_service.SnapshotRequest.prototype.setLabel = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
-/**
- * optional string description = 3;
- * @return {string}
- */
-proto.tournament_service.TournamentMetadata.prototype.getDescription = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.tournament_service.TournamentMetadata} returns this
- */
-proto.tournament_service.TournamentMetadata.prototype.setDescription = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
-};
-
-
-/**
- * optional string slug = 4;
- * @return {string}
- */
-proto.tournament_service.TournamentMetadata.prototype.getSlug = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
-};
-
 
 /**
- * @param {string} value
- * @return {!proto.tournament_service.TournamentMetadata} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.tournament_service.TournamentMetadata.prototype.setSlug = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
-};
-
+proto.tournament_service.SnapshotsResponse.repeatedFields_ = [1];
 
-/**
- * optional TType type = 5;
- * @return {!proto.tournament_service.TType}
- */
-proto.tournament_service.TournamentMetadata.prototype.getType = function() {
-  return /** @type {!proto.tournament_service.TType} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {!proto.tournament_service.TType} value
- * @return {!proto.tournament_service.TournamentMetadata} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.tournament_service.TournamentMetadata.prototype.setType = function(value) {
-  return jspb.Message.setProto3EnumField(this, 5, value);
+proto.tournament_service.SnapshotsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.SnapshotsResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * optional string disclaimer = 6;
- * @return {string}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.SnapshotsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.TournamentMetadata.prototype.getDisclaimer = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
-};
-
+proto.tournament_service.SnapshotsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    snapshotsList: jspb.Message.toObjectList(msg.getSnapshotsList(),
+    proto.tournament_service.TournamentSnapshot.toObject, includeInstance)
+  };
 
-/**
- * @param {string} value
- * @return {!proto.tournament_service.TournamentMetadata} returns this
- */
-proto.tournament_service.TournamentMetadata.prototype.setDisclaimer = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional string tile_style = 7;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.SnapshotsResponse}
  */
-proto.tournament_service.TournamentMetadata.prototype.getTileStyle = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.tournament_service.SnapshotsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.SnapshotsResponse;
+  return proto.tournament_service.SnapshotsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.tournament_service.TournamentMetadata} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.SnapshotsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.SnapshotsResponse}
  */
-proto.tournament_service.TournamentMetadata.prototype.setTileStyle = function(value) {
-  return jspb.Message.setProto3StringField(this, 7, value);
+proto.tournament_service.SnapshotsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.tournament_service.TournamentSnapshot;
+      reader.readMessage(value,proto.tournament_service.TournamentSnapshot.deserializeBinaryFromReader);
+      msg.addSnapshots(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional string board_style = 8;
- * @return {string}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.tournament_service.TournamentMetadata.prototype.getBoardStyle = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+proto.tournament_service.SnapshotsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.SnapshotsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.tournament_service.TournamentMetadata} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.SnapshotsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.TournamentMetadata.prototype.setBoardStyle = function(value) {
-  return jspb.Message.setProto3StringField(this, 8, value);
+proto.tournament_service.SnapshotsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSnapshotsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.tournament_service.TournamentSnapshot.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional ipc.GameRequest default_club_settings = 9;
- * @return {?proto.ipc.GameRequest}
+ * repeated TournamentSnapshot snapshots = 1;
+ * @return {!Array<!proto.tournament_service.TournamentSnapshot>}
  */
-proto.tournament_service.TournamentMetadata.prototype.getDefaultClubSettings = function() {
-  return /** @type{?proto.ipc.GameRequest} */ (
-    jspb.Message.getWrapperField(this, api_proto_ipc_omgwords_pb.GameRequest, 9));
+proto.tournament_service.SnapshotsResponse.prototype.getSnapshotsList = function() {
+  return /** @type{!Array<!proto.tournament_service.TournamentSnapshot>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.tournament_service.TournamentSnapshot, 1));
 };
 
 
 /**
- * @param {?proto.ipc.GameRequest|undefined} value
- * @return {!proto.tournament_service.TournamentMetadata} returns this
+ * @param {!Array<!proto.tournament_service.TournamentSnapshot>} value
+ * @return {!proto.tournament_service.SnapshotsResponse} returns this
 */
-proto.tournament_service.TournamentMetadata.prototype.setDefaultClubSettings = function(value) {
-  return jspb.Message.setWrapperField(this, 9, value);
+proto.tournament_service.SnapshotsResponse.prototype.setSnapshotsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.tournament_service.TournamentMetadata} returns this
+ * @param {!proto.tournament_service.TournamentSnapshot=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.tournament_service.TournamentSnapshot}
  */
-proto.tournament_service.TournamentMetadata.prototype.clearDefaultClubSettings = function() {
-  return this.setDefaultClubSettings(undefined);
+proto.tournament_service.SnapshotsResponse.prototype.addSnapshots = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.tournament_service.TournamentSnapshot, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.tournament_service.SnapshotsResponse} returns this
  */
-proto.tournament_service.TournamentMetadata.prototype.hasDefaultClubSettings = function() {
-  return jspb.Message.getField(this, 9) != null;
+proto.tournament_service.SnapshotsResponse.prototype.clearSnapshotsList = function() {
+  return this.setSnapshotsList([]);
 };
 
 
-/**
- * repeated string freeform_club_setting_fields = 10;
- * @return {!Array<string>}
- */
-proto.tournament_service.TournamentMetadata.prototype.getFreeformClubSettingFieldsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 10));
-};
-
 
-/**
- * @param {!Array<string>} value
- * @return {!proto.tournament_service.TournamentMetadata} returns this
- */
-proto.tournament_service.TournamentMetadata.prototype.setFreeformClubSettingFieldsList = function(value) {
-  return jspb.Message.setField(this, 10, value || []);
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.tournament_service.TournamentMetadata} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.tournament_service.TournamentMetadata.prototype.addFreeformClubSettingFields = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 10, value, opt_index);
+proto.tournament_service.RestoreSnapshotRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.RestoreSnapshotRequest.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.tournament_service.TournamentMetadata} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.RestoreSnapshotRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.TournamentMetadata.prototype.clearFreeformClubSettingFieldsList = function() {
-  return this.setFreeformClubSettingFieldsList([]);
+proto.tournament_service.RestoreSnapshotRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    snapshotId: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional string password = 11;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.RestoreSnapshotRequest}
  */
-proto.tournament_service.TournamentMetadata.prototype.getPassword = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
+proto.tournament_service.RestoreSnapshotRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.RestoreSnapshotRequest;
+  return proto.tournament_service.RestoreSnapshotRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.tournament_service.TournamentMetadata} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.RestoreSnapshotRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.RestoreSnapshotRequest}
  */
-proto.tournament_service.TournamentMetadata.prototype.setPassword = function(value) {
-  return jspb.Message.setProto3StringField(this, 11, value);
+proto.tournament_service.RestoreSnapshotRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSnapshotId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional string logo = 12;
- * @return {string}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.tournament_service.TournamentMetadata.prototype.getLogo = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+proto.tournament_service.RestoreSnapshotRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.RestoreSnapshotRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.tournament_service.TournamentMetadata} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.RestoreSnapshotRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.TournamentMetadata.prototype.setLogo = function(value) {
-  return jspb.Message.setProto3StringField(this, 12, value);
+proto.tournament_service.RestoreSnapshotRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSnapshotId();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string color = 13;
+ * optional string id = 1;
  * @return {string}
  */
-proto.tournament_service.TournamentMetadata.prototype.getColor = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 13, ""));
+proto.tournament_service.RestoreSnapshotRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.tournament_service.TournamentMetadata} returns this
+ * @return {!proto.tournament_service.RestoreSnapshotRequest} returns this
  */
-proto.tournament_service.TournamentMetadata.prototype.setColor = function(value) {
-  return jspb.Message.setProto3StringField(this, 13, value);
+proto.tournament_service.RestoreSnapshotRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional bool private_analysis = 14;
- * @return {boolean}
+ * optional uint64 snapshot_id = 2;
+ * @return {number}
  */
-proto.tournament_service.TournamentMetadata.prototype.getPrivateAnalysis = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 14, false));
+proto.tournament_service.RestoreSnapshotRequest.prototype.getSnapshotId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.tournament_service.TournamentMetadata} returns this
+ * @param {number} value
+ * @return {!proto.tournament_service.RestoreSnapshotRequest} returns this
  */
-proto.tournament_service.TournamentMetadata.prototype.setPrivateAnalysis = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 14, value);
+proto.tournament_service.RestoreSnapshotRequest.prototype.setSnapshotId = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
@@ -1613,8 +9230,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.tournament_service.SetTournamentMetadataRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.tournament_service.SetTournamentMetadataRequest.toObject(opt_includeInstance, this);
+proto.tournament_service.LadderControlsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.LadderControlsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -1623,13 +9240,15 @@ proto.tournament_service.SetTournamentMetadataRequest.prototype.toObject = funct
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.tournament_service.SetTournamentMetadataRequest} msg The msg instance to transform.
+ * @param {!proto.tournament_service.LadderControlsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.SetTournamentMetadataRequest.toObject = function(includeInstance, msg) {
+proto.tournament_service.LadderControlsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    metadata: (f = msg.getMetadata()) && proto.tournament_service.TournamentMetadata.toObject(includeInstance, f)
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    maxChallengeDistance: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    acceptSeconds: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -1643,23 +9262,23 @@ proto.tournament_service.SetTournamentMetadataRequest.toObject = function(includ
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.tournament_service.SetTournamentMetadataRequest}
+ * @return {!proto.tournament_service.LadderControlsRequest}
  */
-proto.tournament_service.SetTournamentMetadataRequest.deserializeBinary = function(bytes) {
+proto.tournament_service.LadderControlsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.tournament_service.SetTournamentMetadataRequest;
-  return proto.tournament_service.SetTournamentMetadataRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.tournament_service.LadderControlsRequest;
+  return proto.tournament_service.LadderControlsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.tournament_service.SetTournamentMetadataRequest} msg The message object to deserialize into.
+ * @param {!proto.tournament_service.LadderControlsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.tournament_service.SetTournamentMetadataRequest}
+ * @return {!proto.tournament_service.LadderControlsRequest}
  */
-proto.tournament_service.SetTournamentMetadataRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.tournament_service.LadderControlsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1667,9 +9286,16 @@ proto.tournament_service.SetTournamentMetadataRequest.deserializeBinaryFromReade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.tournament_service.TournamentMetadata;
-      reader.readMessage(value,proto.tournament_service.TournamentMetadata.deserializeBinaryFromReader);
-      msg.setMetadata(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxChallengeDistance(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setAcceptSeconds(value);
       break;
     default:
       reader.skipField();
@@ -1684,9 +9310,9 @@ proto.tournament_service.SetTournamentMetadataRequest.deserializeBinaryFromReade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.tournament_service.SetTournamentMetadataRequest.prototype.serializeBinary = function() {
+proto.tournament_service.LadderControlsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.tournament_service.SetTournamentMetadataRequest.serializeBinaryToWriter(this, writer);
+  proto.tournament_service.LadderControlsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1694,57 +9320,87 @@ proto.tournament_service.SetTournamentMetadataRequest.prototype.serializeBinary
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.tournament_service.SetTournamentMetadataRequest} message
+ * @param {!proto.tournament_service.LadderControlsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.SetTournamentMetadataRequest.serializeBinaryToWriter = function(message, writer) {
+proto.tournament_service.LadderControlsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getMetadata();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
       1,
-      f,
-      proto.tournament_service.TournamentMetadata.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getMaxChallengeDistance();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getAcceptSeconds();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
     );
   }
 };
 
 
 /**
- * optional TournamentMetadata metadata = 1;
- * @return {?proto.tournament_service.TournamentMetadata}
+ * optional string id = 1;
+ * @return {string}
  */
-proto.tournament_service.SetTournamentMetadataRequest.prototype.getMetadata = function() {
-  return /** @type{?proto.tournament_service.TournamentMetadata} */ (
-    jspb.Message.getWrapperField(this, proto.tournament_service.TournamentMetadata, 1));
+proto.tournament_service.LadderControlsRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {?proto.tournament_service.TournamentMetadata|undefined} value
- * @return {!proto.tournament_service.SetTournamentMetadataRequest} returns this
-*/
-proto.tournament_service.SetTournamentMetadataRequest.prototype.setMetadata = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * @param {string} value
+ * @return {!proto.tournament_service.LadderControlsRequest} returns this
+ */
+proto.tournament_service.LadderControlsRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int32 max_challenge_distance = 2;
+ * @return {number}
+ */
+proto.tournament_service.LadderControlsRequest.prototype.getMaxChallengeDistance = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.tournament_service.LadderControlsRequest} returns this
+ */
+proto.tournament_service.LadderControlsRequest.prototype.setMaxChallengeDistance = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.tournament_service.SetTournamentMetadataRequest} returns this
+ * optional int32 accept_seconds = 3;
+ * @return {number}
  */
-proto.tournament_service.SetTournamentMetadataRequest.prototype.clearMetadata = function() {
-  return this.setMetadata(undefined);
+proto.tournament_service.LadderControlsRequest.prototype.getAcceptSeconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.tournament_service.LadderControlsRequest} returns this
  */
-proto.tournament_service.SetTournamentMetadataRequest.prototype.hasMetadata = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.tournament_service.LadderControlsRequest.prototype.setAcceptSeconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
@@ -1764,8 +9420,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.tournament_service.SingleRoundControlsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.tournament_service.SingleRoundControlsRequest.toObject(opt_includeInstance, this);
+proto.tournament_service.LadderChallengeRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.LadderChallengeRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -1774,16 +9430,14 @@ proto.tournament_service.SingleRoundControlsRequest.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.tournament_service.SingleRoundControlsRequest} msg The msg instance to transform.
+ * @param {!proto.tournament_service.LadderChallengeRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.SingleRoundControlsRequest.toObject = function(includeInstance, msg) {
+proto.tournament_service.LadderChallengeRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    round: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    roundControls: (f = msg.getRoundControls()) && api_proto_ipc_tournament_pb.RoundControl.toObject(includeInstance, f)
+    opponent: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -1797,23 +9451,23 @@ proto.tournament_service.SingleRoundControlsRequest.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.tournament_service.SingleRoundControlsRequest}
+ * @return {!proto.tournament_service.LadderChallengeRequest}
  */
-proto.tournament_service.SingleRoundControlsRequest.deserializeBinary = function(bytes) {
+proto.tournament_service.LadderChallengeRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.tournament_service.SingleRoundControlsRequest;
-  return proto.tournament_service.SingleRoundControlsRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.tournament_service.LadderChallengeRequest;
+  return proto.tournament_service.LadderChallengeRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.tournament_service.SingleRoundControlsRequest} msg The message object to deserialize into.
+ * @param {!proto.tournament_service.LadderChallengeRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.tournament_service.SingleRoundControlsRequest}
+ * @return {!proto.tournament_service.LadderChallengeRequest}
  */
-proto.tournament_service.SingleRoundControlsRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.tournament_service.LadderChallengeRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1826,16 +9480,7 @@ proto.tournament_service.SingleRoundControlsRequest.deserializeBinaryFromReader
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setDivision(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setRound(value);
-      break;
-    case 4:
-      var value = new api_proto_ipc_tournament_pb.RoundControl;
-      reader.readMessage(value,api_proto_ipc_tournament_pb.RoundControl.deserializeBinaryFromReader);
-      msg.setRoundControls(value);
+      msg.setOpponent(value);
       break;
     default:
       reader.skipField();
@@ -1850,9 +9495,9 @@ proto.tournament_service.SingleRoundControlsRequest.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.tournament_service.SingleRoundControlsRequest.prototype.serializeBinary = function() {
+proto.tournament_service.LadderChallengeRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.tournament_service.SingleRoundControlsRequest.serializeBinaryToWriter(this, writer);
+  proto.tournament_service.LadderChallengeRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1860,11 +9505,11 @@ proto.tournament_service.SingleRoundControlsRequest.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.tournament_service.SingleRoundControlsRequest} message
+ * @param {!proto.tournament_service.LadderChallengeRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.SingleRoundControlsRequest.serializeBinaryToWriter = function(message, writer) {
+proto.tournament_service.LadderChallengeRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f.length > 0) {
@@ -1873,28 +9518,13 @@ proto.tournament_service.SingleRoundControlsRequest.serializeBinaryToWriter = fu
       f
     );
   }
-  f = message.getDivision();
+  f = message.getOpponent();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getRound();
-  if (f !== 0) {
-    writer.writeInt32(
-      3,
-      f
-    );
-  }
-  f = message.getRoundControls();
-  if (f != null) {
-    writer.writeMessage(
-      4,
-      f,
-      api_proto_ipc_tournament_pb.RoundControl.serializeBinaryToWriter
-    );
-  }
 };
 
 
@@ -1902,90 +9532,165 @@ proto.tournament_service.SingleRoundControlsRequest.serializeBinaryToWriter = fu
  * optional string id = 1;
  * @return {string}
  */
-proto.tournament_service.SingleRoundControlsRequest.prototype.getId = function() {
+proto.tournament_service.LadderChallengeRequest.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.tournament_service.SingleRoundControlsRequest} returns this
+ * @return {!proto.tournament_service.LadderChallengeRequest} returns this
  */
-proto.tournament_service.SingleRoundControlsRequest.prototype.setId = function(value) {
+proto.tournament_service.LadderChallengeRequest.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string division = 2;
+ * optional string opponent = 2;
  * @return {string}
  */
-proto.tournament_service.SingleRoundControlsRequest.prototype.getDivision = function() {
+proto.tournament_service.LadderChallengeRequest.prototype.getOpponent = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.tournament_service.SingleRoundControlsRequest} returns this
+ * @return {!proto.tournament_service.LadderChallengeRequest} returns this
  */
-proto.tournament_service.SingleRoundControlsRequest.prototype.setDivision = function(value) {
+proto.tournament_service.LadderChallengeRequest.prototype.setOpponent = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional int32 round = 3;
- * @return {number}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.tournament_service.SingleRoundControlsRequest.prototype.getRound = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.tournament_service.GetLadderRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.GetLadderRequest.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.tournament_service.SingleRoundControlsRequest} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.GetLadderRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.SingleRoundControlsRequest.prototype.setRound = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.tournament_service.GetLadderRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional ipc.RoundControl round_controls = 4;
- * @return {?proto.ipc.RoundControl}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.GetLadderRequest}
  */
-proto.tournament_service.SingleRoundControlsRequest.prototype.getRoundControls = function() {
-  return /** @type{?proto.ipc.RoundControl} */ (
-    jspb.Message.getWrapperField(this, api_proto_ipc_tournament_pb.RoundControl, 4));
+proto.tournament_service.GetLadderRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.GetLadderRequest;
+  return proto.tournament_service.GetLadderRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {?proto.ipc.RoundControl|undefined} value
- * @return {!proto.tournament_service.SingleRoundControlsRequest} returns this
-*/
-proto.tournament_service.SingleRoundControlsRequest.prototype.setRoundControls = function(value) {
-  return jspb.Message.setWrapperField(this, 4, value);
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.GetLadderRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.GetLadderRequest}
+ */
+proto.tournament_service.GetLadderRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.tournament_service.SingleRoundControlsRequest} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.tournament_service.SingleRoundControlsRequest.prototype.clearRoundControls = function() {
-  return this.setRoundControls(undefined);
+proto.tournament_service.GetLadderRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.GetLadderRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.GetLadderRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.SingleRoundControlsRequest.prototype.hasRoundControls = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.tournament_service.GetLadderRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.tournament_service.GetLadderRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.GetLadderRequest} returns this
+ */
+proto.tournament_service.GetLadderRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -2005,8 +9710,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.tournament_service.PairRoundRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.tournament_service.PairRoundRequest.toObject(opt_includeInstance, this);
+proto.tournament_service.ProjectedRating.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.ProjectedRating.toObject(opt_includeInstance, this);
 };
 
 
@@ -2015,17 +9720,19 @@ proto.tournament_service.PairRoundRequest.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.tournament_service.PairRoundRequest} msg The msg instance to transform.
+ * @param {!proto.tournament_service.ProjectedRating} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.PairRoundRequest.toObject = function(includeInstance, msg) {
+proto.tournament_service.ProjectedRating.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    round: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    preserveByes: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
-    deletePairings: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
+    userId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    rating: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0),
+    ratingDeviation: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
+    newRating: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0),
+    newRatingDeviation: jspb.Message.getFloatingPointFieldWithDefault(msg, 5, 0.0),
+    newVolatility: jspb.Message.getFloatingPointFieldWithDefault(msg, 6, 0.0),
+    games: jspb.Message.getFieldWithDefault(msg, 7, 0)
   };
 
   if (includeInstance) {
@@ -2039,23 +9746,23 @@ proto.tournament_service.PairRoundRequest.toObject = function(includeInstance, m
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.tournament_service.PairRoundRequest}
+ * @return {!proto.tournament_service.ProjectedRating}
  */
-proto.tournament_service.PairRoundRequest.deserializeBinary = function(bytes) {
+proto.tournament_service.ProjectedRating.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.tournament_service.PairRoundRequest;
-  return proto.tournament_service.PairRoundRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.tournament_service.ProjectedRating;
+  return proto.tournament_service.ProjectedRating.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.tournament_service.PairRoundRequest} msg The message object to deserialize into.
+ * @param {!proto.tournament_service.ProjectedRating} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.tournament_service.PairRoundRequest}
+ * @return {!proto.tournament_service.ProjectedRating}
  */
-proto.tournament_service.PairRoundRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.tournament_service.ProjectedRating.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2064,23 +9771,31 @@ proto.tournament_service.PairRoundRequest.deserializeBinaryFromReader = function
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      msg.setUserId(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDivision(value);
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setRating(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setRound(value);
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setRatingDeviation(value);
       break;
     case 4:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setPreserveByes(value);
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setNewRating(value);
       break;
     case 5:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setDeletePairings(value);
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setNewRatingDeviation(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setNewVolatility(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setGames(value);
       break;
     default:
       reader.skipField();
@@ -2095,9 +9810,9 @@ proto.tournament_service.PairRoundRequest.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.tournament_service.PairRoundRequest.prototype.serializeBinary = function() {
+proto.tournament_service.ProjectedRating.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.tournament_service.PairRoundRequest.serializeBinaryToWriter(this, writer);
+  proto.tournament_service.ProjectedRating.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2105,141 +9820,198 @@ proto.tournament_service.PairRoundRequest.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.tournament_service.PairRoundRequest} message
+ * @param {!proto.tournament_service.ProjectedRating} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.PairRoundRequest.serializeBinaryToWriter = function(message, writer) {
+proto.tournament_service.ProjectedRating.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getUserId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getDivision();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getRating();
+  if (f !== 0.0) {
+    writer.writeDouble(
       2,
       f
     );
   }
-  f = message.getRound();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getRatingDeviation();
+  if (f !== 0.0) {
+    writer.writeDouble(
       3,
       f
     );
   }
-  f = message.getPreserveByes();
-  if (f) {
-    writer.writeBool(
+  f = message.getNewRating();
+  if (f !== 0.0) {
+    writer.writeDouble(
       4,
       f
     );
   }
-  f = message.getDeletePairings();
-  if (f) {
-    writer.writeBool(
+  f = message.getNewRatingDeviation();
+  if (f !== 0.0) {
+    writer.writeDouble(
       5,
       f
     );
   }
+  f = message.getNewVolatility();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      6,
+      f
+    );
+  }
+  f = message.getGames();
+  if (f !== 0) {
+    writer.writeInt32(
+      7,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string user_id = 1;
+ * @return {string}
+ */
+proto.tournament_service.ProjectedRating.prototype.getUserId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.ProjectedRating} returns this
+ */
+proto.tournament_service.ProjectedRating.prototype.setUserId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional double rating = 2;
+ * @return {number}
+ */
+proto.tournament_service.ProjectedRating.prototype.getRating = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
 };
 
 
 /**
- * optional string id = 1;
- * @return {string}
+ * @param {number} value
+ * @return {!proto.tournament_service.ProjectedRating} returns this
  */
-proto.tournament_service.PairRoundRequest.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.tournament_service.ProjectedRating.prototype.setRating = function(value) {
+  return jspb.Message.setProto3FloatField(this, 2, value);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.tournament_service.PairRoundRequest} returns this
+ * optional double rating_deviation = 3;
+ * @return {number}
  */
-proto.tournament_service.PairRoundRequest.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.tournament_service.ProjectedRating.prototype.getRatingDeviation = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
 };
 
 
 /**
- * optional string division = 2;
- * @return {string}
+ * @param {number} value
+ * @return {!proto.tournament_service.ProjectedRating} returns this
  */
-proto.tournament_service.PairRoundRequest.prototype.getDivision = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.tournament_service.ProjectedRating.prototype.setRatingDeviation = function(value) {
+  return jspb.Message.setProto3FloatField(this, 3, value);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.tournament_service.PairRoundRequest} returns this
+ * optional double new_rating = 4;
+ * @return {number}
  */
-proto.tournament_service.PairRoundRequest.prototype.setDivision = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.tournament_service.ProjectedRating.prototype.getNewRating = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 4, 0.0));
 };
 
 
 /**
- * optional int32 round = 3;
+ * @param {number} value
+ * @return {!proto.tournament_service.ProjectedRating} returns this
+ */
+proto.tournament_service.ProjectedRating.prototype.setNewRating = function(value) {
+  return jspb.Message.setProto3FloatField(this, 4, value);
+};
+
+
+/**
+ * optional double new_rating_deviation = 5;
  * @return {number}
  */
-proto.tournament_service.PairRoundRequest.prototype.getRound = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.tournament_service.ProjectedRating.prototype.getNewRatingDeviation = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 5, 0.0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.tournament_service.PairRoundRequest} returns this
+ * @return {!proto.tournament_service.ProjectedRating} returns this
  */
-proto.tournament_service.PairRoundRequest.prototype.setRound = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.tournament_service.ProjectedRating.prototype.setNewRatingDeviation = function(value) {
+  return jspb.Message.setProto3FloatField(this, 5, value);
 };
 
 
 /**
- * optional bool preserve_byes = 4;
- * @return {boolean}
+ * optional double new_volatility = 6;
+ * @return {number}
  */
-proto.tournament_service.PairRoundRequest.prototype.getPreserveByes = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+proto.tournament_service.ProjectedRating.prototype.getNewVolatility = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 6, 0.0));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.tournament_service.PairRoundRequest} returns this
+ * @param {number} value
+ * @return {!proto.tournament_service.ProjectedRating} returns this
  */
-proto.tournament_service.PairRoundRequest.prototype.setPreserveByes = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 4, value);
+proto.tournament_service.ProjectedRating.prototype.setNewVolatility = function(value) {
+  return jspb.Message.setProto3FloatField(this, 6, value);
 };
 
 
 /**
- * optional bool delete_pairings = 5;
- * @return {boolean}
+ * optional int32 games = 7;
+ * @return {number}
  */
-proto.tournament_service.PairRoundRequest.prototype.getDeletePairings = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+proto.tournament_service.ProjectedRating.prototype.getGames = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.tournament_service.PairRoundRequest} returns this
+ * @param {number} value
+ * @return {!proto.tournament_service.ProjectedRating} returns this
  */
-proto.tournament_service.PairRoundRequest.prototype.setDeletePairings = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 5, value);
+proto.tournament_service.ProjectedRating.prototype.setGames = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.tournament_service.RatingPeriodResponse.repeatedFields_ = [5];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2255,8 +10027,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.tournament_service.TournamentDivisionRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.tournament_service.TournamentDivisionRequest.toObject(opt_includeInstance, this);
+proto.tournament_service.RatingPeriodResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.RatingPeriodResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2265,14 +10037,18 @@ proto.tournament_service.TournamentDivisionRequest.prototype.toObject = function
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.tournament_service.TournamentDivisionRequest} msg The msg instance to transform.
+ * @param {!proto.tournament_service.RatingPeriodResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.TournamentDivisionRequest.toObject = function(includeInstance, msg) {
+proto.tournament_service.RatingPeriodResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    division: jspb.Message.getFieldWithDefault(msg, 2, "")
+    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    variant: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    applied: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    ratingsList: jspb.Message.toObjectList(msg.getRatingsList(),
+    proto.tournament_service.ProjectedRating.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -2286,23 +10062,23 @@ proto.tournament_service.TournamentDivisionRequest.toObject = function(includeIn
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.tournament_service.TournamentDivisionRequest}
+ * @return {!proto.tournament_service.RatingPeriodResponse}
  */
-proto.tournament_service.TournamentDivisionRequest.deserializeBinary = function(bytes) {
+proto.tournament_service.RatingPeriodResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.tournament_service.TournamentDivisionRequest;
-  return proto.tournament_service.TournamentDivisionRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.tournament_service.RatingPeriodResponse;
+  return proto.tournament_service.RatingPeriodResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.tournament_service.TournamentDivisionRequest} msg The message object to deserialize into.
+ * @param {!proto.tournament_service.RatingPeriodResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.tournament_service.TournamentDivisionRequest}
+ * @return {!proto.tournament_service.RatingPeriodResponse}
  */
-proto.tournament_service.TournamentDivisionRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.tournament_service.RatingPeriodResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2317,6 +10093,19 @@ proto.tournament_service.TournamentDivisionRequest.deserializeBinaryFromReader =
       var value = /** @type {string} */ (reader.readString());
       msg.setDivision(value);
       break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setVariant(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setApplied(value);
+      break;
+    case 5:
+      var value = new proto.tournament_service.ProjectedRating;
+      reader.readMessage(value,proto.tournament_service.ProjectedRating.deserializeBinaryFromReader);
+      msg.addRatings(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -2330,9 +10119,9 @@ proto.tournament_service.TournamentDivisionRequest.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.tournament_service.TournamentDivisionRequest.prototype.serializeBinary = function() {
+proto.tournament_service.RatingPeriodResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.tournament_service.TournamentDivisionRequest.serializeBinaryToWriter(this, writer);
+  proto.tournament_service.RatingPeriodResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2340,11 +10129,11 @@ proto.tournament_service.TournamentDivisionRequest.prototype.serializeBinary = f
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.tournament_service.TournamentDivisionRequest} message
+ * @param {!proto.tournament_service.RatingPeriodResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.TournamentDivisionRequest.serializeBinaryToWriter = function(message, writer) {
+proto.tournament_service.RatingPeriodResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f.length > 0) {
@@ -2360,6 +10149,28 @@ proto.tournament_service.TournamentDivisionRequest.serializeBinaryToWriter = fun
       f
     );
   }
+  f = message.getVariant();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getApplied();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getRatingsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      5,
+      f,
+      proto.tournament_service.ProjectedRating.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -2367,16 +10178,16 @@ proto.tournament_service.TournamentDivisionRequest.serializeBinaryToWriter = fun
  * optional string id = 1;
  * @return {string}
  */
-proto.tournament_service.TournamentDivisionRequest.prototype.getId = function() {
+proto.tournament_service.RatingPeriodResponse.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.tournament_service.TournamentDivisionRequest} returns this
+ * @return {!proto.tournament_service.RatingPeriodResponse} returns this
  */
-proto.tournament_service.TournamentDivisionRequest.prototype.setId = function(value) {
+proto.tournament_service.RatingPeriodResponse.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
@@ -2385,20 +10196,94 @@ proto.tournament_service.TournamentDivisionRequest.prototype.setId = function(va
  * optional string division = 2;
  * @return {string}
  */
-proto.tournament_service.TournamentDivisionRequest.prototype.getDivision = function() {
+proto.tournament_service.RatingPeriodResponse.prototype.getDivision = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.tournament_service.TournamentDivisionRequest} returns this
+ * @return {!proto.tournament_service.RatingPeriodResponse} returns this
  */
-proto.tournament_service.TournamentDivisionRequest.prototype.setDivision = function(value) {
+proto.tournament_service.RatingPeriodResponse.prototype.setDivision = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+/**
+ * optional string variant = 3;
+ * @return {string}
+ */
+proto.tournament_service.RatingPeriodResponse.prototype.getVariant = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.tournament_service.RatingPeriodResponse} returns this
+ */
+proto.tournament_service.RatingPeriodResponse.prototype.setVariant = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional bool applied = 4;
+ * @return {boolean}
+ */
+proto.tournament_service.RatingPeriodResponse.prototype.getApplied = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.tournament_service.RatingPeriodResponse} returns this
+ */
+proto.tournament_service.RatingPeriodResponse.prototype.setApplied = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
+/**
+ * repeated ProjectedRating ratings = 5;
+ * @return {!Array<!proto.tournament_service.ProjectedRating>}
+ */
+proto.tournament_service.RatingPeriodResponse.prototype.getRatingsList = function() {
+  return /** @type{!Array<!proto.tournament_service.ProjectedRating>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.tournament_service.ProjectedRating, 5));
+};
+
+
+/**
+ * @param {!Array<!proto.tournament_service.ProjectedRating>} value
+ * @return {!proto.tournament_service.RatingPeriodResponse} returns this
+*/
+proto.tournament_service.RatingPeriodResponse.prototype.setRatingsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 5, value);
+};
+
+
+/**
+ * @param {!proto.tournament_service.ProjectedRating=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.tournament_service.ProjectedRating}
+ */
+proto.tournament_service.RatingPeriodResponse.prototype.addRatings = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.tournament_service.ProjectedRating, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.tournament_service.RatingPeriodResponse} returns this
+ */
+proto.tournament_service.RatingPeriodResponse.prototype.clearRatingsList = function() {
+  return this.setRatingsList([]);
+};
+
+
 
 
 
@@ -2415,8 +10300,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.tournament_service.TournamentPairingRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.tournament_service.TournamentPairingRequest.toObject(opt_includeInstance, this);
+proto.tournament_service.NewClubSessionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.NewClubSessionRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2425,16 +10310,14 @@ proto.tournament_service.TournamentPairingRequest.prototype.toObject = function(
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.tournament_service.TournamentPairingRequest} msg The msg instance to transform.
+ * @param {!proto.tournament_service.NewClubSessionRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.TournamentPairingRequest.toObject = function(includeInstance, msg) {
+proto.tournament_service.NewClubSessionRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    playerOneId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    playerTwoId: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    round: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    selfPlayResult: jspb.Message.getFieldWithDefault(msg, 4, 0)
+    date: (f = msg.getDate()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    clubId: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -2448,23 +10331,23 @@ proto.tournament_service.TournamentPairingRequest.toObject = function(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.tournament_service.TournamentPairingRequest}
+ * @return {!proto.tournament_service.NewClubSessionRequest}
  */
-proto.tournament_service.TournamentPairingRequest.deserializeBinary = function(bytes) {
+proto.tournament_service.NewClubSessionRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.tournament_service.TournamentPairingRequest;
-  return proto.tournament_service.TournamentPairingRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.tournament_service.NewClubSessionRequest;
+  return proto.tournament_service.NewClubSessionRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.tournament_service.TournamentPairingRequest} msg The message object to deserialize into.
+ * @param {!proto.tournament_service.NewClubSessionRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.tournament_service.TournamentPairingRequest}
+ * @return {!proto.tournament_service.NewClubSessionRequest}
  */
-proto.tournament_service.TournamentPairingRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.tournament_service.NewClubSessionRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2472,20 +10355,13 @@ proto.tournament_service.TournamentPairingRequest.deserializeBinaryFromReader =
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPlayerOneId(value);
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setDate(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPlayerTwoId(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setRound(value);
-      break;
-    case 4:
-      var value = /** @type {!proto.ipc.TournamentGameResult} */ (reader.readEnum());
-      msg.setSelfPlayResult(value);
+      msg.setClubId(value);
       break;
     default:
       reader.skipField();
@@ -2500,133 +10376,96 @@ proto.tournament_service.TournamentPairingRequest.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.tournament_service.TournamentPairingRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.tournament_service.TournamentPairingRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
-};
-
-
-/**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.tournament_service.TournamentPairingRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.tournament_service.TournamentPairingRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getPlayerOneId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getPlayerTwoId();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getRound();
-  if (f !== 0) {
-    writer.writeInt32(
-      3,
-      f
-    );
-  }
-  f = message.getSelfPlayResult();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      4,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string player_one_id = 1;
- * @return {string}
- */
-proto.tournament_service.TournamentPairingRequest.prototype.getPlayerOneId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.tournament_service.NewClubSessionRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.NewClubSessionRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.tournament_service.TournamentPairingRequest} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.NewClubSessionRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.TournamentPairingRequest.prototype.setPlayerOneId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.tournament_service.NewClubSessionRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDate();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getClubId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string player_two_id = 2;
- * @return {string}
+ * optional google.protobuf.Timestamp date = 1;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.tournament_service.TournamentPairingRequest.prototype.getPlayerTwoId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.tournament_service.NewClubSessionRequest.prototype.getDate = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.tournament_service.TournamentPairingRequest} returns this
- */
-proto.tournament_service.TournamentPairingRequest.prototype.setPlayerTwoId = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.tournament_service.NewClubSessionRequest} returns this
+*/
+proto.tournament_service.NewClubSessionRequest.prototype.setDate = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
- * optional int32 round = 3;
- * @return {number}
+ * Clears the message field making it undefined.
+ * @return {!proto.tournament_service.NewClubSessionRequest} returns this
  */
-proto.tournament_service.TournamentPairingRequest.prototype.getRound = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.tournament_service.NewClubSessionRequest.prototype.clearDate = function() {
+  return this.setDate(undefined);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.tournament_service.TournamentPairingRequest} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.tournament_service.TournamentPairingRequest.prototype.setRound = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.tournament_service.NewClubSessionRequest.prototype.hasDate = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * optional ipc.TournamentGameResult self_play_result = 4;
- * @return {!proto.ipc.TournamentGameResult}
+ * optional string club_id = 2;
+ * @return {string}
  */
-proto.tournament_service.TournamentPairingRequest.prototype.getSelfPlayResult = function() {
-  return /** @type {!proto.ipc.TournamentGameResult} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.tournament_service.NewClubSessionRequest.prototype.getClubId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {!proto.ipc.TournamentGameResult} value
- * @return {!proto.tournament_service.TournamentPairingRequest} returns this
+ * @param {string} value
+ * @return {!proto.tournament_service.NewClubSessionRequest} returns this
  */
-proto.tournament_service.TournamentPairingRequest.prototype.setSelfPlayResult = function(value) {
-  return jspb.Message.setProto3EnumField(this, 4, value);
+proto.tournament_service.NewClubSessionRequest.prototype.setClubId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.tournament_service.TournamentPairingsRequest.repeatedFields_ = [3];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2642,8 +10481,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.tournament_service.TournamentPairingsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.tournament_service.TournamentPairingsRequest.toObject(opt_includeInstance, this);
+proto.tournament_service.ClubSessionResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.ClubSessionResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2652,16 +10491,14 @@ proto.tournament_service.TournamentPairingsRequest.prototype.toObject = function
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.tournament_service.TournamentPairingsRequest} msg The msg instance to transform.
+ * @param {!proto.tournament_service.ClubSessionResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.TournamentPairingsRequest.toObject = function(includeInstance, msg) {
+proto.tournament_service.ClubSessionResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    pairingsList: jspb.Message.toObjectList(msg.getPairingsList(),
-    proto.tournament_service.TournamentPairingRequest.toObject, includeInstance)
+    tournamentId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    slug: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -2675,23 +10512,23 @@ proto.tournament_service.TournamentPairingsRequest.toObject = function(includeIn
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.tournament_service.TournamentPairingsRequest}
+ * @return {!proto.tournament_service.ClubSessionResponse}
  */
-proto.tournament_service.TournamentPairingsRequest.deserializeBinary = function(bytes) {
+proto.tournament_service.ClubSessionResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.tournament_service.TournamentPairingsRequest;
-  return proto.tournament_service.TournamentPairingsRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.tournament_service.ClubSessionResponse;
+  return proto.tournament_service.ClubSessionResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.tournament_service.TournamentPairingsRequest} msg The message object to deserialize into.
+ * @param {!proto.tournament_service.ClubSessionResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.tournament_service.TournamentPairingsRequest}
+ * @return {!proto.tournament_service.ClubSessionResponse}
  */
-proto.tournament_service.TournamentPairingsRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.tournament_service.ClubSessionResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2700,16 +10537,11 @@ proto.tournament_service.TournamentPairingsRequest.deserializeBinaryFromReader =
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      msg.setTournamentId(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setDivision(value);
-      break;
-    case 3:
-      var value = new proto.tournament_service.TournamentPairingRequest;
-      reader.readMessage(value,proto.tournament_service.TournamentPairingRequest.deserializeBinaryFromReader);
-      msg.addPairings(value);
+      msg.setSlug(value);
       break;
     default:
       reader.skipField();
@@ -2724,9 +10556,9 @@ proto.tournament_service.TournamentPairingsRequest.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.tournament_service.TournamentPairingsRequest.prototype.serializeBinary = function() {
+proto.tournament_service.ClubSessionResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.tournament_service.TournamentPairingsRequest.serializeBinaryToWriter(this, writer);
+  proto.tournament_service.ClubSessionResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2734,111 +10566,72 @@ proto.tournament_service.TournamentPairingsRequest.prototype.serializeBinary = f
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.tournament_service.TournamentPairingsRequest} message
+ * @param {!proto.tournament_service.ClubSessionResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.TournamentPairingsRequest.serializeBinaryToWriter = function(message, writer) {
+proto.tournament_service.ClubSessionResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getTournamentId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getDivision();
+  f = message.getSlug();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getPairingsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      3,
-      f,
-      proto.tournament_service.TournamentPairingRequest.serializeBinaryToWriter
-    );
-  }
 };
 
 
 /**
- * optional string id = 1;
+ * optional string tournament_id = 1;
  * @return {string}
  */
-proto.tournament_service.TournamentPairingsRequest.prototype.getId = function() {
+proto.tournament_service.ClubSessionResponse.prototype.getTournamentId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.tournament_service.TournamentPairingsRequest} returns this
+ * @return {!proto.tournament_service.ClubSessionResponse} returns this
  */
-proto.tournament_service.TournamentPairingsRequest.prototype.setId = function(value) {
+proto.tournament_service.ClubSessionResponse.prototype.setTournamentId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string division = 2;
+ * optional string slug = 2;
  * @return {string}
  */
-proto.tournament_service.TournamentPairingsRequest.prototype.getDivision = function() {
+proto.tournament_service.ClubSessionResponse.prototype.getSlug = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.tournament_service.TournamentPairingsRequest} returns this
+ * @return {!proto.tournament_service.ClubSessionResponse} returns this
  */
-proto.tournament_service.TournamentPairingsRequest.prototype.setDivision = function(value) {
+proto.tournament_service.ClubSessionResponse.prototype.setSlug = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
-/**
- * repeated TournamentPairingRequest pairings = 3;
- * @return {!Array<!proto.tournament_service.TournamentPairingRequest>}
- */
-proto.tournament_service.TournamentPairingsRequest.prototype.getPairingsList = function() {
-  return /** @type{!Array<!proto.tournament_service.TournamentPairingRequest>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.tournament_service.TournamentPairingRequest, 3));
-};
-
-
-/**
- * @param {!Array<!proto.tournament_service.TournamentPairingRequest>} value
- * @return {!proto.tournament_service.TournamentPairingsRequest} returns this
-*/
-proto.tournament_service.TournamentPairingsRequest.prototype.setPairingsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 3, value);
-};
-
-
-/**
- * @param {!proto.tournament_service.TournamentPairingRequest=} opt_value
- * @param {number=} opt_index
- * @return {!proto.tournament_service.TournamentPairingRequest}
- */
-proto.tournament_service.TournamentPairingsRequest.prototype.addPairings = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.tournament_service.TournamentPairingRequest, opt_index);
-};
-
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.tournament_service.TournamentPairingsRequest} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.tournament_service.TournamentPairingsRequest.prototype.clearPairingsList = function() {
-  return this.setPairingsList([]);
-};
-
-
+proto.tournament_service.PromotionRequest.repeatedFields_ = [3];
 
 
 
@@ -2855,8 +10648,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.tournament_service.TournamentResultOverrideRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.tournament_service.TournamentResultOverrideRequest.toObject(opt_includeInstance, this);
+proto.tournament_service.PromotionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.PromotionRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2865,24 +10658,17 @@ proto.tournament_service.TournamentResultOverrideRequest.prototype.toObject = fu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.tournament_service.TournamentResultOverrideRequest} msg The msg instance to transform.
+ * @param {!proto.tournament_service.PromotionRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.TournamentResultOverrideRequest.toObject = function(includeInstance, msg) {
+proto.tournament_service.PromotionRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    playerOneId: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    playerTwoId: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    round: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    playerOneScore: jspb.Message.getFieldWithDefault(msg, 6, 0),
-    playerTwoScore: jspb.Message.getFieldWithDefault(msg, 7, 0),
-    playerOneResult: jspb.Message.getFieldWithDefault(msg, 8, 0),
-    playerTwoResult: jspb.Message.getFieldWithDefault(msg, 9, 0),
-    gameEndReason: jspb.Message.getFieldWithDefault(msg, 10, 0),
-    amendment: jspb.Message.getBooleanFieldWithDefault(msg, 11, false),
-    gameIndex: jspb.Message.getFieldWithDefault(msg, 12, 0)
+    nextSessionId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    divisionsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    promoted: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    relegated: jspb.Message.getFieldWithDefault(msg, 5, 0)
   };
 
   if (includeInstance) {
@@ -2896,76 +10682,48 @@ proto.tournament_service.TournamentResultOverrideRequest.toObject = function(inc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.tournament_service.TournamentResultOverrideRequest}
+ * @return {!proto.tournament_service.PromotionRequest}
  */
-proto.tournament_service.TournamentResultOverrideRequest.deserializeBinary = function(bytes) {
+proto.tournament_service.PromotionRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.tournament_service.TournamentResultOverrideRequest;
-  return proto.tournament_service.TournamentResultOverrideRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.tournament_service.PromotionRequest;
+  return proto.tournament_service.PromotionRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.tournament_service.TournamentResultOverrideRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.tournament_service.TournamentResultOverrideRequest}
- */
-proto.tournament_service.TournamentResultOverrideRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDivision(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPlayerOneId(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPlayerTwoId(value);
-      break;
-    case 5:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setRound(value);
-      break;
-    case 6:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setPlayerOneScore(value);
-      break;
-    case 7:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setPlayerTwoScore(value);
+ * @param {!proto.tournament_service.PromotionRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.PromotionRequest}
+ */
+proto.tournament_service.PromotionRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
       break;
-    case 8:
-      var value = /** @type {!proto.ipc.TournamentGameResult} */ (reader.readEnum());
-      msg.setPlayerOneResult(value);
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
       break;
-    case 9:
-      var value = /** @type {!proto.ipc.TournamentGameResult} */ (reader.readEnum());
-      msg.setPlayerTwoResult(value);
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNextSessionId(value);
       break;
-    case 10:
-      var value = /** @type {!proto.ipc.GameEndReason} */ (reader.readEnum());
-      msg.setGameEndReason(value);
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addDivisions(value);
       break;
-    case 11:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setAmendment(value);
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPromoted(value);
       break;
-    case 12:
+    case 5:
       var value = /** @type {number} */ (reader.readInt32());
-      msg.setGameIndex(value);
+      msg.setRelegated(value);
       break;
     default:
       reader.skipField();
@@ -2980,9 +10738,9 @@ proto.tournament_service.TournamentResultOverrideRequest.deserializeBinaryFromRe
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.tournament_service.TournamentResultOverrideRequest.prototype.serializeBinary = function() {
+proto.tournament_service.PromotionRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.tournament_service.TournamentResultOverrideRequest.serializeBinaryToWriter(this, writer);
+  proto.tournament_service.PromotionRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2990,11 +10748,11 @@ proto.tournament_service.TournamentResultOverrideRequest.prototype.serializeBina
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.tournament_service.TournamentResultOverrideRequest} message
+ * @param {!proto.tournament_service.PromotionRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.TournamentResultOverrideRequest.serializeBinaryToWriter = function(message, writer) {
+proto.tournament_service.PromotionRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f.length > 0) {
@@ -3003,80 +10761,31 @@ proto.tournament_service.TournamentResultOverrideRequest.serializeBinaryToWriter
       f
     );
   }
-  f = message.getDivision();
+  f = message.getNextSessionId();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getPlayerOneId();
+  f = message.getDivisionsList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedString(
       3,
       f
     );
   }
-  f = message.getPlayerTwoId();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getRound();
-  if (f !== 0) {
-    writer.writeInt32(
-      5,
-      f
-    );
-  }
-  f = message.getPlayerOneScore();
-  if (f !== 0) {
-    writer.writeInt32(
-      6,
-      f
-    );
-  }
-  f = message.getPlayerTwoScore();
+  f = message.getPromoted();
   if (f !== 0) {
     writer.writeInt32(
-      7,
-      f
-    );
-  }
-  f = message.getPlayerOneResult();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      8,
-      f
-    );
-  }
-  f = message.getPlayerTwoResult();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      9,
-      f
-    );
-  }
-  f = message.getGameEndReason();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      10,
-      f
-    );
-  }
-  f = message.getAmendment();
-  if (f) {
-    writer.writeBool(
-      11,
+      4,
       f
     );
   }
-  f = message.getGameIndex();
+  f = message.getRelegated();
   if (f !== 0) {
     writer.writeInt32(
-      12,
+      5,
       f
     );
   }
@@ -3087,215 +10796,268 @@ proto.tournament_service.TournamentResultOverrideRequest.serializeBinaryToWriter
  * optional string id = 1;
  * @return {string}
  */
-proto.tournament_service.TournamentResultOverrideRequest.prototype.getId = function() {
+proto.tournament_service.PromotionRequest.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.tournament_service.TournamentResultOverrideRequest} returns this
+ * @return {!proto.tournament_service.PromotionRequest} returns this
  */
-proto.tournament_service.TournamentResultOverrideRequest.prototype.setId = function(value) {
+proto.tournament_service.PromotionRequest.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string division = 2;
+ * optional string next_session_id = 2;
  * @return {string}
  */
-proto.tournament_service.TournamentResultOverrideRequest.prototype.getDivision = function() {
+proto.tournament_service.PromotionRequest.prototype.getNextSessionId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.tournament_service.TournamentResultOverrideRequest} returns this
+ * @return {!proto.tournament_service.PromotionRequest} returns this
  */
-proto.tournament_service.TournamentResultOverrideRequest.prototype.setDivision = function(value) {
+proto.tournament_service.PromotionRequest.prototype.setNextSessionId = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string player_one_id = 3;
- * @return {string}
+ * repeated string divisions = 3;
+ * @return {!Array<string>}
  */
-proto.tournament_service.TournamentResultOverrideRequest.prototype.getPlayerOneId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.tournament_service.PromotionRequest.prototype.getDivisionsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.tournament_service.TournamentResultOverrideRequest} returns this
+ * @param {!Array<string>} value
+ * @return {!proto.tournament_service.PromotionRequest} returns this
  */
-proto.tournament_service.TournamentResultOverrideRequest.prototype.setPlayerOneId = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.tournament_service.PromotionRequest.prototype.setDivisionsList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
 };
 
 
 /**
- * optional string player_two_id = 4;
- * @return {string}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.tournament_service.PromotionRequest} returns this
  */
-proto.tournament_service.TournamentResultOverrideRequest.prototype.getPlayerTwoId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.tournament_service.PromotionRequest.prototype.addDivisions = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.tournament_service.TournamentResultOverrideRequest} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.tournament_service.PromotionRequest} returns this
  */
-proto.tournament_service.TournamentResultOverrideRequest.prototype.setPlayerTwoId = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.tournament_service.PromotionRequest.prototype.clearDivisionsList = function() {
+  return this.setDivisionsList([]);
 };
 
 
 /**
- * optional int32 round = 5;
+ * optional int32 promoted = 4;
  * @return {number}
  */
-proto.tournament_service.TournamentResultOverrideRequest.prototype.getRound = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.tournament_service.PromotionRequest.prototype.getPromoted = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.tournament_service.TournamentResultOverrideRequest} returns this
+ * @return {!proto.tournament_service.PromotionRequest} returns this
  */
-proto.tournament_service.TournamentResultOverrideRequest.prototype.setRound = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
+proto.tournament_service.PromotionRequest.prototype.setPromoted = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * optional int32 player_one_score = 6;
+ * optional int32 relegated = 5;
  * @return {number}
  */
-proto.tournament_service.TournamentResultOverrideRequest.prototype.getPlayerOneScore = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.tournament_service.PromotionRequest.prototype.getRelegated = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.tournament_service.TournamentResultOverrideRequest} returns this
+ * @return {!proto.tournament_service.PromotionRequest} returns this
  */
-proto.tournament_service.TournamentResultOverrideRequest.prototype.setPlayerOneScore = function(value) {
-  return jspb.Message.setProto3IntField(this, 6, value);
+proto.tournament_service.PromotionRequest.prototype.setRelegated = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
-/**
- * optional int32 player_two_score = 7;
- * @return {number}
- */
-proto.tournament_service.TournamentResultOverrideRequest.prototype.getPlayerTwoScore = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
-};
-
 
 /**
- * @param {number} value
- * @return {!proto.tournament_service.TournamentResultOverrideRequest} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.tournament_service.TournamentResultOverrideRequest.prototype.setPlayerTwoScore = function(value) {
-  return jspb.Message.setProto3IntField(this, 7, value);
-};
+proto.tournament_service.PromotionResponse.repeatedFields_ = [1];
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional ipc.TournamentGameResult player_one_result = 8;
- * @return {!proto.ipc.TournamentGameResult}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.tournament_service.TournamentResultOverrideRequest.prototype.getPlayerOneResult = function() {
-  return /** @type {!proto.ipc.TournamentGameResult} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+proto.tournament_service.PromotionResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.PromotionResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {!proto.ipc.TournamentGameResult} value
- * @return {!proto.tournament_service.TournamentResultOverrideRequest} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.tournament_service.PromotionResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.TournamentResultOverrideRequest.prototype.setPlayerOneResult = function(value) {
-  return jspb.Message.setProto3EnumField(this, 8, value);
+proto.tournament_service.PromotionResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    divisionsList: jspb.Message.toObjectList(msg.getDivisionsList(),
+    api_proto_ipc_tournament_pb.TournamentPersons.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional ipc.TournamentGameResult player_two_result = 9;
- * @return {!proto.ipc.TournamentGameResult}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.tournament_service.PromotionResponse}
  */
-proto.tournament_service.TournamentResultOverrideRequest.prototype.getPlayerTwoResult = function() {
-  return /** @type {!proto.ipc.TournamentGameResult} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+proto.tournament_service.PromotionResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.tournament_service.PromotionResponse;
+  return proto.tournament_service.PromotionResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {!proto.ipc.TournamentGameResult} value
- * @return {!proto.tournament_service.TournamentResultOverrideRequest} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.tournament_service.PromotionResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.tournament_service.PromotionResponse}
  */
-proto.tournament_service.TournamentResultOverrideRequest.prototype.setPlayerTwoResult = function(value) {
-  return jspb.Message.setProto3EnumField(this, 9, value);
+proto.tournament_service.PromotionResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new api_proto_ipc_tournament_pb.TournamentPersons;
+      reader.readMessage(value,api_proto_ipc_tournament_pb.TournamentPersons.deserializeBinaryFromReader);
+      msg.addDivisions(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional ipc.GameEndReason game_end_reason = 10;
- * @return {!proto.ipc.GameEndReason}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.tournament_service.TournamentResultOverrideRequest.prototype.getGameEndReason = function() {
-  return /** @type {!proto.ipc.GameEndReason} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+proto.tournament_service.PromotionResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.tournament_service.PromotionResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {!proto.ipc.GameEndReason} value
- * @return {!proto.tournament_service.TournamentResultOverrideRequest} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.PromotionResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.TournamentResultOverrideRequest.prototype.setGameEndReason = function(value) {
-  return jspb.Message.setProto3EnumField(this, 10, value);
+proto.tournament_service.PromotionResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDivisionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      api_proto_ipc_tournament_pb.TournamentPersons.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional bool amendment = 11;
- * @return {boolean}
+ * repeated ipc.TournamentPersons divisions = 1;
+ * @return {!Array<!proto.ipc.TournamentPersons>}
  */
-proto.tournament_service.TournamentResultOverrideRequest.prototype.getAmendment = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 11, false));
+proto.tournament_service.PromotionResponse.prototype.getDivisionsList = function() {
+  return /** @type{!Array<!proto.ipc.TournamentPersons>} */ (
+    jspb.Message.getRepeatedWrapperField(this, api_proto_ipc_tournament_pb.TournamentPersons, 1));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.tournament_service.TournamentResultOverrideRequest} returns this
- */
-proto.tournament_service.TournamentResultOverrideRequest.prototype.setAmendment = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 11, value);
+ * @param {!Array<!proto.ipc.TournamentPersons>} value
+ * @return {!proto.tournament_service.PromotionResponse} returns this
+*/
+proto.tournament_service.PromotionResponse.prototype.setDivisionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * optional int32 game_index = 12;
- * @return {number}
+ * @param {!proto.ipc.TournamentPersons=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.TournamentPersons}
  */
-proto.tournament_service.TournamentResultOverrideRequest.prototype.getGameIndex = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 12, 0));
+proto.tournament_service.PromotionResponse.prototype.addDivisions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ipc.TournamentPersons, opt_index);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.tournament_service.TournamentResultOverrideRequest} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.tournament_service.PromotionResponse} returns this
  */
-proto.tournament_service.TournamentResultOverrideRequest.prototype.setGameIndex = function(value) {
-  return jspb.Message.setProto3IntField(this, 12, value);
+proto.tournament_service.PromotionResponse.prototype.clearDivisionsList = function() {
+  return this.setDivisionsList([]);
 };
 
 
@@ -3315,8 +11077,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.tournament_service.TournamentStartRoundCountdownRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.tournament_service.TournamentStartRoundCountdownRequest.toObject(opt_includeInstance, this);
+proto.tournament_service.RecentClubSessionsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.RecentClubSessionsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -3325,16 +11087,15 @@ proto.tournament_service.TournamentStartRoundCountdownRequest.prototype.toObject
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.tournament_service.TournamentStartRoundCountdownRequest} msg The msg instance to transform.
+ * @param {!proto.tournament_service.RecentClubSessionsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.TournamentStartRoundCountdownRequest.toObject = function(includeInstance, msg) {
+proto.tournament_service.RecentClubSessionsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    division: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    round: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    startAllRounds: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
+    count: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    offset: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -3348,23 +11109,23 @@ proto.tournament_service.TournamentStartRoundCountdownRequest.toObject = functio
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.tournament_service.TournamentStartRoundCountdownRequest}
+ * @return {!proto.tournament_service.RecentClubSessionsRequest}
  */
-proto.tournament_service.TournamentStartRoundCountdownRequest.deserializeBinary = function(bytes) {
+proto.tournament_service.RecentClubSessionsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.tournament_service.TournamentStartRoundCountdownRequest;
-  return proto.tournament_service.TournamentStartRoundCountdownRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.tournament_service.RecentClubSessionsRequest;
+  return proto.tournament_service.RecentClubSessionsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.tournament_service.TournamentStartRoundCountdownRequest} msg The message object to deserialize into.
+ * @param {!proto.tournament_service.RecentClubSessionsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.tournament_service.TournamentStartRoundCountdownRequest}
+ * @return {!proto.tournament_service.RecentClubSessionsRequest}
  */
-proto.tournament_service.TournamentStartRoundCountdownRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.tournament_service.RecentClubSessionsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3376,16 +11137,12 @@ proto.tournament_service.TournamentStartRoundCountdownRequest.deserializeBinaryF
       msg.setId(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDivision(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setCount(value);
       break;
     case 3:
       var value = /** @type {number} */ (reader.readInt32());
-      msg.setRound(value);
-      break;
-    case 4:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setStartAllRounds(value);
+      msg.setOffset(value);
       break;
     default:
       reader.skipField();
@@ -3400,9 +11157,9 @@ proto.tournament_service.TournamentStartRoundCountdownRequest.deserializeBinaryF
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.tournament_service.TournamentStartRoundCountdownRequest.prototype.serializeBinary = function() {
+proto.tournament_service.RecentClubSessionsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.tournament_service.TournamentStartRoundCountdownRequest.serializeBinaryToWriter(this, writer);
+  proto.tournament_service.RecentClubSessionsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3410,11 +11167,11 @@ proto.tournament_service.TournamentStartRoundCountdownRequest.prototype.serializ
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.tournament_service.TournamentStartRoundCountdownRequest} message
+ * @param {!proto.tournament_service.RecentClubSessionsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.TournamentStartRoundCountdownRequest.serializeBinaryToWriter = function(message, writer) {
+proto.tournament_service.RecentClubSessionsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f.length > 0) {
@@ -3423,27 +11180,20 @@ proto.tournament_service.TournamentStartRoundCountdownRequest.serializeBinaryToW
       f
     );
   }
-  f = message.getDivision();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getCount();
+  if (f !== 0) {
+    writer.writeInt32(
       2,
       f
     );
   }
-  f = message.getRound();
+  f = message.getOffset();
   if (f !== 0) {
     writer.writeInt32(
       3,
       f
     );
   }
-  f = message.getStartAllRounds();
-  if (f) {
-    writer.writeBool(
-      4,
-      f
-    );
-  }
 };
 
 
@@ -3451,175 +11201,63 @@ proto.tournament_service.TournamentStartRoundCountdownRequest.serializeBinaryToW
  * optional string id = 1;
  * @return {string}
  */
-proto.tournament_service.TournamentStartRoundCountdownRequest.prototype.getId = function() {
+proto.tournament_service.RecentClubSessionsRequest.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.tournament_service.TournamentStartRoundCountdownRequest} returns this
+ * @return {!proto.tournament_service.RecentClubSessionsRequest} returns this
  */
-proto.tournament_service.TournamentStartRoundCountdownRequest.prototype.setId = function(value) {
+proto.tournament_service.RecentClubSessionsRequest.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string division = 2;
- * @return {string}
+ * optional int32 count = 2;
+ * @return {number}
  */
-proto.tournament_service.TournamentStartRoundCountdownRequest.prototype.getDivision = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.tournament_service.RecentClubSessionsRequest.prototype.getCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.tournament_service.TournamentStartRoundCountdownRequest} returns this
+ * @param {number} value
+ * @return {!proto.tournament_service.RecentClubSessionsRequest} returns this
  */
-proto.tournament_service.TournamentStartRoundCountdownRequest.prototype.setDivision = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.tournament_service.RecentClubSessionsRequest.prototype.setCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional int32 round = 3;
+ * optional int32 offset = 3;
  * @return {number}
  */
-proto.tournament_service.TournamentStartRoundCountdownRequest.prototype.getRound = function() {
+proto.tournament_service.RecentClubSessionsRequest.prototype.getOffset = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.tournament_service.TournamentStartRoundCountdownRequest} returns this
+ * @return {!proto.tournament_service.RecentClubSessionsRequest} returns this
  */
-proto.tournament_service.TournamentStartRoundCountdownRequest.prototype.setRound = function(value) {
+proto.tournament_service.RecentClubSessionsRequest.prototype.setOffset = function(value) {
   return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
-/**
- * optional bool start_all_rounds = 4;
- * @return {boolean}
- */
-proto.tournament_service.TournamentStartRoundCountdownRequest.prototype.getStartAllRounds = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.tournament_service.TournamentStartRoundCountdownRequest} returns this
- */
-proto.tournament_service.TournamentStartRoundCountdownRequest.prototype.setStartAllRounds = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 4, value);
-};
-
-
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
-/**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
- */
-proto.tournament_service.TournamentResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.tournament_service.TournamentResponse.toObject(opt_includeInstance, this);
-};
-
-
-/**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.tournament_service.TournamentResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.tournament_service.TournamentResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
-};
-}
-
-
-/**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.tournament_service.TournamentResponse}
- */
-proto.tournament_service.TournamentResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.tournament_service.TournamentResponse;
-  return proto.tournament_service.TournamentResponse.deserializeBinaryFromReader(msg, reader);
-};
-
-
-/**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.tournament_service.TournamentResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.tournament_service.TournamentResponse}
- */
-proto.tournament_service.TournamentResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
-};
-
-
-/**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
- */
-proto.tournament_service.TournamentResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.tournament_service.TournamentResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
-};
-
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.tournament_service.TournamentResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.tournament_service.TournamentResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-};
-
-
+proto.tournament_service.ClubSessionsResponse.repeatedFields_ = [1];
 
 
 
@@ -3636,8 +11274,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.tournament_service.NewTournamentResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.tournament_service.NewTournamentResponse.toObject(opt_includeInstance, this);
+proto.tournament_service.ClubSessionsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.ClubSessionsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3646,14 +11284,14 @@ proto.tournament_service.NewTournamentResponse.prototype.toObject = function(opt
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.tournament_service.NewTournamentResponse} msg The msg instance to transform.
+ * @param {!proto.tournament_service.ClubSessionsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.NewTournamentResponse.toObject = function(includeInstance, msg) {
+proto.tournament_service.ClubSessionsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    slug: jspb.Message.getFieldWithDefault(msg, 2, "")
+    sessionsList: jspb.Message.toObjectList(msg.getSessionsList(),
+    proto.tournament_service.ClubSessionResponse.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -3667,23 +11305,23 @@ proto.tournament_service.NewTournamentResponse.toObject = function(includeInstan
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.tournament_service.NewTournamentResponse}
+ * @return {!proto.tournament_service.ClubSessionsResponse}
  */
-proto.tournament_service.NewTournamentResponse.deserializeBinary = function(bytes) {
+proto.tournament_service.ClubSessionsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.tournament_service.NewTournamentResponse;
-  return proto.tournament_service.NewTournamentResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.tournament_service.ClubSessionsResponse;
+  return proto.tournament_service.ClubSessionsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.tournament_service.NewTournamentResponse} msg The message object to deserialize into.
+ * @param {!proto.tournament_service.ClubSessionsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.tournament_service.NewTournamentResponse}
+ * @return {!proto.tournament_service.ClubSessionsResponse}
  */
-proto.tournament_service.NewTournamentResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.tournament_service.ClubSessionsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3691,12 +11329,9 @@ proto.tournament_service.NewTournamentResponse.deserializeBinaryFromReader = fun
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSlug(value);
+      var value = new proto.tournament_service.ClubSessionResponse;
+      reader.readMessage(value,proto.tournament_service.ClubSessionResponse.deserializeBinaryFromReader);
+      msg.addSessions(value);
       break;
     default:
       reader.skipField();
@@ -3711,9 +11346,9 @@ proto.tournament_service.NewTournamentResponse.deserializeBinaryFromReader = fun
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.tournament_service.NewTournamentResponse.prototype.serializeBinary = function() {
+proto.tournament_service.ClubSessionsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.tournament_service.NewTournamentResponse.serializeBinaryToWriter(this, writer);
+  proto.tournament_service.ClubSessionsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3721,66 +11356,69 @@ proto.tournament_service.NewTournamentResponse.prototype.serializeBinary = funct
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.tournament_service.NewTournamentResponse} message
+ * @param {!proto.tournament_service.ClubSessionsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.NewTournamentResponse.serializeBinaryToWriter = function(message, writer) {
+proto.tournament_service.ClubSessionsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getSessionsList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       1,
-      f
-    );
-  }
-  f = message.getSlug();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
+      f,
+      proto.tournament_service.ClubSessionResponse.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string id = 1;
- * @return {string}
+ * repeated ClubSessionResponse sessions = 1;
+ * @return {!Array<!proto.tournament_service.ClubSessionResponse>}
  */
-proto.tournament_service.NewTournamentResponse.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.tournament_service.ClubSessionsResponse.prototype.getSessionsList = function() {
+  return /** @type{!Array<!proto.tournament_service.ClubSessionResponse>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.tournament_service.ClubSessionResponse, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.tournament_service.NewTournamentResponse} returns this
- */
-proto.tournament_service.NewTournamentResponse.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+ * @param {!Array<!proto.tournament_service.ClubSessionResponse>} value
+ * @return {!proto.tournament_service.ClubSessionsResponse} returns this
+*/
+proto.tournament_service.ClubSessionsResponse.prototype.setSessionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * optional string slug = 2;
- * @return {string}
+ * @param {!proto.tournament_service.ClubSessionResponse=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.tournament_service.ClubSessionResponse}
  */
-proto.tournament_service.NewTournamentResponse.prototype.getSlug = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.tournament_service.ClubSessionsResponse.prototype.addSessions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.tournament_service.ClubSessionResponse, opt_index);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.tournament_service.NewTournamentResponse} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.tournament_service.ClubSessionsResponse} returns this
  */
-proto.tournament_service.NewTournamentResponse.prototype.setSlug = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.tournament_service.ClubSessionsResponse.prototype.clearSessionsList = function() {
+  return this.setSessionsList([]);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.tournament_service.ClubMembersRequest.repeatedFields_ = [2];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -3796,8 +11434,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.tournament_service.GetTournamentMetadataRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.tournament_service.GetTournamentMetadataRequest.toObject(opt_includeInstance, this);
+proto.tournament_service.ClubMembersRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.ClubMembersRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -3806,14 +11444,15 @@ proto.tournament_service.GetTournamentMetadataRequest.prototype.toObject = funct
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.tournament_service.GetTournamentMetadataRequest} msg The msg instance to transform.
+ * @param {!proto.tournament_service.ClubMembersRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.GetTournamentMetadataRequest.toObject = function(includeInstance, msg) {
+proto.tournament_service.ClubMembersRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    slug: jspb.Message.getFieldWithDefault(msg, 2, "")
+    membersList: jspb.Message.toObjectList(msg.getMembersList(),
+    api_proto_ipc_tournament_pb.ClubMember.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -3827,23 +11466,23 @@ proto.tournament_service.GetTournamentMetadataRequest.toObject = function(includ
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.tournament_service.GetTournamentMetadataRequest}
+ * @return {!proto.tournament_service.ClubMembersRequest}
  */
-proto.tournament_service.GetTournamentMetadataRequest.deserializeBinary = function(bytes) {
+proto.tournament_service.ClubMembersRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.tournament_service.GetTournamentMetadataRequest;
-  return proto.tournament_service.GetTournamentMetadataRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.tournament_service.ClubMembersRequest;
+  return proto.tournament_service.ClubMembersRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.tournament_service.GetTournamentMetadataRequest} msg The message object to deserialize into.
+ * @param {!proto.tournament_service.ClubMembersRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.tournament_service.GetTournamentMetadataRequest}
+ * @return {!proto.tournament_service.ClubMembersRequest}
  */
-proto.tournament_service.GetTournamentMetadataRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.tournament_service.ClubMembersRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3855,8 +11494,9 @@ proto.tournament_service.GetTournamentMetadataRequest.deserializeBinaryFromReade
       msg.setId(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSlug(value);
+      var value = new api_proto_ipc_tournament_pb.ClubMember;
+      reader.readMessage(value,api_proto_ipc_tournament_pb.ClubMember.deserializeBinaryFromReader);
+      msg.addMembers(value);
       break;
     default:
       reader.skipField();
@@ -3871,9 +11511,9 @@ proto.tournament_service.GetTournamentMetadataRequest.deserializeBinaryFromReade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.tournament_service.GetTournamentMetadataRequest.prototype.serializeBinary = function() {
+proto.tournament_service.ClubMembersRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.tournament_service.GetTournamentMetadataRequest.serializeBinaryToWriter(this, writer);
+  proto.tournament_service.ClubMembersRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3881,11 +11521,11 @@ proto.tournament_service.GetTournamentMetadataRequest.prototype.serializeBinary
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.tournament_service.GetTournamentMetadataRequest} message
+ * @param {!proto.tournament_service.ClubMembersRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.GetTournamentMetadataRequest.serializeBinaryToWriter = function(message, writer) {
+proto.tournament_service.ClubMembersRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f.length > 0) {
@@ -3894,11 +11534,12 @@ proto.tournament_service.GetTournamentMetadataRequest.serializeBinaryToWriter =
       f
     );
   }
-  f = message.getSlug();
+  f = message.getMembersList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       2,
-      f
+      f,
+      api_proto_ipc_tournament_pb.ClubMember.serializeBinaryToWriter
     );
   }
 };
@@ -3908,35 +11549,55 @@ proto.tournament_service.GetTournamentMetadataRequest.serializeBinaryToWriter =
  * optional string id = 1;
  * @return {string}
  */
-proto.tournament_service.GetTournamentMetadataRequest.prototype.getId = function() {
+proto.tournament_service.ClubMembersRequest.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.tournament_service.GetTournamentMetadataRequest} returns this
+ * @return {!proto.tournament_service.ClubMembersRequest} returns this
  */
-proto.tournament_service.GetTournamentMetadataRequest.prototype.setId = function(value) {
+proto.tournament_service.ClubMembersRequest.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string slug = 2;
- * @return {string}
+ * repeated ipc.ClubMember members = 2;
+ * @return {!Array<!proto.ipc.ClubMember>}
  */
-proto.tournament_service.GetTournamentMetadataRequest.prototype.getSlug = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.tournament_service.ClubMembersRequest.prototype.getMembersList = function() {
+  return /** @type{!Array<!proto.ipc.ClubMember>} */ (
+    jspb.Message.getRepeatedWrapperField(this, api_proto_ipc_tournament_pb.ClubMember, 2));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.tournament_service.GetTournamentMetadataRequest} returns this
+ * @param {!Array<!proto.ipc.ClubMember>} value
+ * @return {!proto.tournament_service.ClubMembersRequest} returns this
+*/
+proto.tournament_service.ClubMembersRequest.prototype.setMembersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.ipc.ClubMember=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ipc.ClubMember}
  */
-proto.tournament_service.GetTournamentMetadataRequest.prototype.setSlug = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.tournament_service.ClubMembersRequest.prototype.addMembers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.ipc.ClubMember, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.tournament_service.ClubMembersRequest} returns this
+ */
+proto.tournament_service.ClubMembersRequest.prototype.clearMembersList = function() {
+  return this.setMembersList([]);
 };
 
 
@@ -3956,8 +11617,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.tournament_service.GetTournamentRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.tournament_service.GetTournamentRequest.toObject(opt_includeInstance, this);
+proto.tournament_service.ClubControlsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.ClubControlsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -3966,13 +11627,14 @@ proto.tournament_service.GetTournamentRequest.prototype.toObject = function(opt_
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.tournament_service.GetTournamentRequest} msg The msg instance to transform.
+ * @param {!proto.tournament_service.ClubControlsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.GetTournamentRequest.toObject = function(includeInstance, msg) {
+proto.tournament_service.ClubControlsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    membersOnly: jspb.Message.getBooleanFieldWithDefault(msg, 2, false)
   };
 
   if (includeInstance) {
@@ -3986,23 +11648,23 @@ proto.tournament_service.GetTournamentRequest.toObject = function(includeInstanc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.tournament_service.GetTournamentRequest}
+ * @return {!proto.tournament_service.ClubControlsRequest}
  */
-proto.tournament_service.GetTournamentRequest.deserializeBinary = function(bytes) {
+proto.tournament_service.ClubControlsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.tournament_service.GetTournamentRequest;
-  return proto.tournament_service.GetTournamentRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.tournament_service.ClubControlsRequest;
+  return proto.tournament_service.ClubControlsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.tournament_service.GetTournamentRequest} msg The message object to deserialize into.
+ * @param {!proto.tournament_service.ClubControlsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.tournament_service.GetTournamentRequest}
+ * @return {!proto.tournament_service.ClubControlsRequest}
  */
-proto.tournament_service.GetTournamentRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.tournament_service.ClubControlsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4013,6 +11675,10 @@ proto.tournament_service.GetTournamentRequest.deserializeBinaryFromReader = func
       var value = /** @type {string} */ (reader.readString());
       msg.setId(value);
       break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setMembersOnly(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -4026,9 +11692,9 @@ proto.tournament_service.GetTournamentRequest.deserializeBinaryFromReader = func
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.tournament_service.GetTournamentRequest.prototype.serializeBinary = function() {
+proto.tournament_service.ClubControlsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.tournament_service.GetTournamentRequest.serializeBinaryToWriter(this, writer);
+  proto.tournament_service.ClubControlsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4036,11 +11702,11 @@ proto.tournament_service.GetTournamentRequest.prototype.serializeBinary = functi
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.tournament_service.GetTournamentRequest} message
+ * @param {!proto.tournament_service.ClubControlsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.GetTournamentRequest.serializeBinaryToWriter = function(message, writer) {
+proto.tournament_service.ClubControlsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f.length > 0) {
@@ -4049,6 +11715,13 @@ proto.tournament_service.GetTournamentRequest.serializeBinaryToWriter = function
       f
     );
   }
+  f = message.getMembersOnly();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
 };
 
 
@@ -4056,20 +11729,38 @@ proto.tournament_service.GetTournamentRequest.serializeBinaryToWriter = function
  * optional string id = 1;
  * @return {string}
  */
-proto.tournament_service.GetTournamentRequest.prototype.getId = function() {
+proto.tournament_service.ClubControlsRequest.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.tournament_service.GetTournamentRequest} returns this
+ * @return {!proto.tournament_service.ClubControlsRequest} returns this
  */
-proto.tournament_service.GetTournamentRequest.prototype.setId = function(value) {
+proto.tournament_service.ClubControlsRequest.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional bool members_only = 2;
+ * @return {boolean}
+ */
+proto.tournament_service.ClubControlsRequest.prototype.getMembersOnly = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.tournament_service.ClubControlsRequest} returns this
+ */
+proto.tournament_service.ClubControlsRequest.prototype.setMembersOnly = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
 
 
 
@@ -4086,8 +11777,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.tournament_service.FinishTournamentRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.tournament_service.FinishTournamentRequest.toObject(opt_includeInstance, this);
+proto.tournament_service.ClubSessionTemplateRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.ClubSessionTemplateRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -4096,13 +11787,14 @@ proto.tournament_service.FinishTournamentRequest.prototype.toObject = function(o
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.tournament_service.FinishTournamentRequest} msg The msg instance to transform.
+ * @param {!proto.tournament_service.ClubSessionTemplateRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.FinishTournamentRequest.toObject = function(includeInstance, msg) {
+proto.tournament_service.ClubSessionTemplateRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    template: (f = msg.getTemplate()) && api_proto_ipc_tournament_pb.ClubSessionTemplate.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -4116,23 +11808,23 @@ proto.tournament_service.FinishTournamentRequest.toObject = function(includeInst
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.tournament_service.FinishTournamentRequest}
+ * @return {!proto.tournament_service.ClubSessionTemplateRequest}
  */
-proto.tournament_service.FinishTournamentRequest.deserializeBinary = function(bytes) {
+proto.tournament_service.ClubSessionTemplateRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.tournament_service.FinishTournamentRequest;
-  return proto.tournament_service.FinishTournamentRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.tournament_service.ClubSessionTemplateRequest;
+  return proto.tournament_service.ClubSessionTemplateRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.tournament_service.FinishTournamentRequest} msg The message object to deserialize into.
+ * @param {!proto.tournament_service.ClubSessionTemplateRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.tournament_service.FinishTournamentRequest}
+ * @return {!proto.tournament_service.ClubSessionTemplateRequest}
  */
-proto.tournament_service.FinishTournamentRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.tournament_service.ClubSessionTemplateRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4143,6 +11835,11 @@ proto.tournament_service.FinishTournamentRequest.deserializeBinaryFromReader = f
       var value = /** @type {string} */ (reader.readString());
       msg.setId(value);
       break;
+    case 2:
+      var value = new api_proto_ipc_tournament_pb.ClubSessionTemplate;
+      reader.readMessage(value,api_proto_ipc_tournament_pb.ClubSessionTemplate.deserializeBinaryFromReader);
+      msg.setTemplate(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -4156,9 +11853,9 @@ proto.tournament_service.FinishTournamentRequest.deserializeBinaryFromReader = f
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.tournament_service.FinishTournamentRequest.prototype.serializeBinary = function() {
+proto.tournament_service.ClubSessionTemplateRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.tournament_service.FinishTournamentRequest.serializeBinaryToWriter(this, writer);
+  proto.tournament_service.ClubSessionTemplateRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4166,11 +11863,11 @@ proto.tournament_service.FinishTournamentRequest.prototype.serializeBinary = fun
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.tournament_service.FinishTournamentRequest} message
+ * @param {!proto.tournament_service.ClubSessionTemplateRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.FinishTournamentRequest.serializeBinaryToWriter = function(message, writer) {
+proto.tournament_service.ClubSessionTemplateRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f.length > 0) {
@@ -4179,6 +11876,14 @@ proto.tournament_service.FinishTournamentRequest.serializeBinaryToWriter = funct
       f
     );
   }
+  f = message.getTemplate();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      api_proto_ipc_tournament_pb.ClubSessionTemplate.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -4186,27 +11891,57 @@ proto.tournament_service.FinishTournamentRequest.serializeBinaryToWriter = funct
  * optional string id = 1;
  * @return {string}
  */
-proto.tournament_service.FinishTournamentRequest.prototype.getId = function() {
+proto.tournament_service.ClubSessionTemplateRequest.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.tournament_service.FinishTournamentRequest} returns this
+ * @return {!proto.tournament_service.ClubSessionTemplateRequest} returns this
  */
-proto.tournament_service.FinishTournamentRequest.prototype.setId = function(value) {
+proto.tournament_service.ClubSessionTemplateRequest.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional ipc.ClubSessionTemplate template = 2;
+ * @return {?proto.ipc.ClubSessionTemplate}
+ */
+proto.tournament_service.ClubSessionTemplateRequest.prototype.getTemplate = function() {
+  return /** @type{?proto.ipc.ClubSessionTemplate} */ (
+    jspb.Message.getWrapperField(this, api_proto_ipc_tournament_pb.ClubSessionTemplate, 2));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {?proto.ipc.ClubSessionTemplate|undefined} value
+ * @return {!proto.tournament_service.ClubSessionTemplateRequest} returns this
+*/
+proto.tournament_service.ClubSessionTemplateRequest.prototype.setTemplate = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.tournament_service.ClubSessionTemplateRequest} returns this
+ */
+proto.tournament_service.ClubSessionTemplateRequest.prototype.clearTemplate = function() {
+  return this.setTemplate(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.tournament_service.TournamentMetadataResponse.repeatedFields_ = [2];
+proto.tournament_service.ClubSessionTemplateRequest.prototype.hasTemplate = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
 
 
 
@@ -4223,8 +11958,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.tournament_service.TournamentMetadataResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.tournament_service.TournamentMetadataResponse.toObject(opt_includeInstance, this);
+proto.tournament_service.ClubSessionScheduleRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.ClubSessionScheduleRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -4233,14 +11968,14 @@ proto.tournament_service.TournamentMetadataResponse.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.tournament_service.TournamentMetadataResponse} msg The msg instance to transform.
+ * @param {!proto.tournament_service.ClubSessionScheduleRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.TournamentMetadataResponse.toObject = function(includeInstance, msg) {
+proto.tournament_service.ClubSessionScheduleRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    metadata: (f = msg.getMetadata()) && proto.tournament_service.TournamentMetadata.toObject(includeInstance, f),
-    directorsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    schedule: (f = msg.getSchedule()) && api_proto_ipc_tournament_pb.ClubSessionSchedule.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -4254,23 +11989,23 @@ proto.tournament_service.TournamentMetadataResponse.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.tournament_service.TournamentMetadataResponse}
+ * @return {!proto.tournament_service.ClubSessionScheduleRequest}
  */
-proto.tournament_service.TournamentMetadataResponse.deserializeBinary = function(bytes) {
+proto.tournament_service.ClubSessionScheduleRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.tournament_service.TournamentMetadataResponse;
-  return proto.tournament_service.TournamentMetadataResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.tournament_service.ClubSessionScheduleRequest;
+  return proto.tournament_service.ClubSessionScheduleRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.tournament_service.TournamentMetadataResponse} msg The message object to deserialize into.
+ * @param {!proto.tournament_service.ClubSessionScheduleRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.tournament_service.TournamentMetadataResponse}
+ * @return {!proto.tournament_service.ClubSessionScheduleRequest}
  */
-proto.tournament_service.TournamentMetadataResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.tournament_service.ClubSessionScheduleRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4278,13 +12013,13 @@ proto.tournament_service.TournamentMetadataResponse.deserializeBinaryFromReader
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.tournament_service.TournamentMetadata;
-      reader.readMessage(value,proto.tournament_service.TournamentMetadata.deserializeBinaryFromReader);
-      msg.setMetadata(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addDirectors(value);
+      var value = new api_proto_ipc_tournament_pb.ClubSessionSchedule;
+      reader.readMessage(value,api_proto_ipc_tournament_pb.ClubSessionSchedule.deserializeBinaryFromReader);
+      msg.setSchedule(value);
       break;
     default:
       reader.skipField();
@@ -4299,111 +12034,92 @@ proto.tournament_service.TournamentMetadataResponse.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.tournament_service.TournamentMetadataResponse.prototype.serializeBinary = function() {
+proto.tournament_service.ClubSessionScheduleRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.tournament_service.TournamentMetadataResponse.serializeBinaryToWriter(this, writer);
+  proto.tournament_service.ClubSessionScheduleRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
 
 /**
  * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.tournament_service.TournamentMetadataResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.tournament_service.TournamentMetadataResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getMetadata();
-  if (f != null) {
-    writer.writeMessage(
-      1,
-      f,
-      proto.tournament_service.TournamentMetadata.serializeBinaryToWriter
-    );
-  }
-  f = message.getDirectorsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      2,
-      f
-    );
-  }
-};
-
-
-/**
- * optional TournamentMetadata metadata = 1;
- * @return {?proto.tournament_service.TournamentMetadata}
- */
-proto.tournament_service.TournamentMetadataResponse.prototype.getMetadata = function() {
-  return /** @type{?proto.tournament_service.TournamentMetadata} */ (
-    jspb.Message.getWrapperField(this, proto.tournament_service.TournamentMetadata, 1));
-};
-
-
-/**
- * @param {?proto.tournament_service.TournamentMetadata|undefined} value
- * @return {!proto.tournament_service.TournamentMetadataResponse} returns this
-*/
-proto.tournament_service.TournamentMetadataResponse.prototype.setMetadata = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.tournament_service.ClubSessionScheduleRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.tournament_service.ClubSessionScheduleRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSchedule();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      api_proto_ipc_tournament_pb.ClubSessionSchedule.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.tournament_service.TournamentMetadataResponse} returns this
+ * optional string id = 1;
+ * @return {string}
  */
-proto.tournament_service.TournamentMetadataResponse.prototype.clearMetadata = function() {
-  return this.setMetadata(undefined);
+proto.tournament_service.ClubSessionScheduleRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.tournament_service.ClubSessionScheduleRequest} returns this
  */
-proto.tournament_service.TournamentMetadataResponse.prototype.hasMetadata = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.tournament_service.ClubSessionScheduleRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * repeated string directors = 2;
- * @return {!Array<string>}
+ * optional ipc.ClubSessionSchedule schedule = 2;
+ * @return {?proto.ipc.ClubSessionSchedule}
  */
-proto.tournament_service.TournamentMetadataResponse.prototype.getDirectorsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+proto.tournament_service.ClubSessionScheduleRequest.prototype.getSchedule = function() {
+  return /** @type{?proto.ipc.ClubSessionSchedule} */ (
+    jspb.Message.getWrapperField(this, api_proto_ipc_tournament_pb.ClubSessionSchedule, 2));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.tournament_service.TournamentMetadataResponse} returns this
- */
-proto.tournament_service.TournamentMetadataResponse.prototype.setDirectorsList = function(value) {
-  return jspb.Message.setField(this, 2, value || []);
+ * @param {?proto.ipc.ClubSessionSchedule|undefined} value
+ * @return {!proto.tournament_service.ClubSessionScheduleRequest} returns this
+*/
+proto.tournament_service.ClubSessionScheduleRequest.prototype.setSchedule = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.tournament_service.TournamentMetadataResponse} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.tournament_service.ClubSessionScheduleRequest} returns this
  */
-proto.tournament_service.TournamentMetadataResponse.prototype.addDirectors = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+proto.tournament_service.ClubSessionScheduleRequest.prototype.clearSchedule = function() {
+  return this.setSchedule(undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.tournament_service.TournamentMetadataResponse} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.tournament_service.TournamentMetadataResponse.prototype.clearDirectorsList = function() {
-  return this.setDirectorsList([]);
+proto.tournament_service.ClubSessionScheduleRequest.prototype.hasSchedule = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
@@ -4423,8 +12139,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.tournament_service.RecentGamesRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.tournament_service.RecentGamesRequest.toObject(opt_includeInstance, this);
+proto.tournament_service.GetClubRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.GetClubRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -4433,15 +12149,13 @@ proto.tournament_service.RecentGamesRequest.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.tournament_service.RecentGamesRequest} msg The msg instance to transform.
+ * @param {!proto.tournament_service.GetClubRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.RecentGamesRequest.toObject = function(includeInstance, msg) {
+proto.tournament_service.GetClubRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    numGames: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    offset: jspb.Message.getFieldWithDefault(msg, 3, 0)
+    id: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -4455,23 +12169,23 @@ proto.tournament_service.RecentGamesRequest.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.tournament_service.RecentGamesRequest}
+ * @return {!proto.tournament_service.GetClubRequest}
  */
-proto.tournament_service.RecentGamesRequest.deserializeBinary = function(bytes) {
+proto.tournament_service.GetClubRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.tournament_service.RecentGamesRequest;
-  return proto.tournament_service.RecentGamesRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.tournament_service.GetClubRequest;
+  return proto.tournament_service.GetClubRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.tournament_service.RecentGamesRequest} msg The message object to deserialize into.
+ * @param {!proto.tournament_service.GetClubRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.tournament_service.RecentGamesRequest}
+ * @return {!proto.tournament_service.GetClubRequest}
  */
-proto.tournament_service.RecentGamesRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.tournament_service.GetClubRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4482,14 +12196,6 @@ proto.tournament_service.RecentGamesRequest.deserializeBinaryFromReader = functi
       var value = /** @type {string} */ (reader.readString());
       msg.setId(value);
       break;
-    case 2:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setNumGames(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setOffset(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -4503,9 +12209,9 @@ proto.tournament_service.RecentGamesRequest.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.tournament_service.RecentGamesRequest.prototype.serializeBinary = function() {
+proto.tournament_service.GetClubRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.tournament_service.RecentGamesRequest.serializeBinaryToWriter(this, writer);
+  proto.tournament_service.GetClubRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4513,11 +12219,11 @@ proto.tournament_service.RecentGamesRequest.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.tournament_service.RecentGamesRequest} message
+ * @param {!proto.tournament_service.GetClubRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.RecentGamesRequest.serializeBinaryToWriter = function(message, writer) {
+proto.tournament_service.GetClubRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f.length > 0) {
@@ -4526,20 +12232,6 @@ proto.tournament_service.RecentGamesRequest.serializeBinaryToWriter = function(m
       f
     );
   }
-  f = message.getNumGames();
-  if (f !== 0) {
-    writer.writeInt32(
-      2,
-      f
-    );
-  }
-  f = message.getOffset();
-  if (f !== 0) {
-    writer.writeInt32(
-      3,
-      f
-    );
-  }
 };
 
 
@@ -4547,63 +12239,20 @@ proto.tournament_service.RecentGamesRequest.serializeBinaryToWriter = function(m
  * optional string id = 1;
  * @return {string}
  */
-proto.tournament_service.RecentGamesRequest.prototype.getId = function() {
+proto.tournament_service.GetClubRequest.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.tournament_service.RecentGamesRequest} returns this
+ * @return {!proto.tournament_service.GetClubRequest} returns this
  */
-proto.tournament_service.RecentGamesRequest.prototype.setId = function(value) {
+proto.tournament_service.GetClubRequest.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-/**
- * optional int32 num_games = 2;
- * @return {number}
- */
-proto.tournament_service.RecentGamesRequest.prototype.getNumGames = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.tournament_service.RecentGamesRequest} returns this
- */
-proto.tournament_service.RecentGamesRequest.prototype.setNumGames = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
-
-
-/**
- * optional int32 offset = 3;
- * @return {number}
- */
-proto.tournament_service.RecentGamesRequest.prototype.getOffset = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.tournament_service.RecentGamesRequest} returns this
- */
-proto.tournament_service.RecentGamesRequest.prototype.setOffset = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
-};
-
-
-
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.tournament_service.RecentGamesResponse.repeatedFields_ = [1];
 
 
 
@@ -4620,8 +12269,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.tournament_service.RecentGamesResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.tournament_service.RecentGamesResponse.toObject(opt_includeInstance, this);
+proto.tournament_service.ClubRating.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.ClubRating.toObject(opt_includeInstance, this);
 };
 
 
@@ -4630,14 +12279,17 @@ proto.tournament_service.RecentGamesResponse.prototype.toObject = function(opt_i
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.tournament_service.RecentGamesResponse} msg The msg instance to transform.
+ * @param {!proto.tournament_service.ClubRating} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.RecentGamesResponse.toObject = function(includeInstance, msg) {
+proto.tournament_service.ClubRating.toObject = function(includeInstance, msg) {
   var f, obj = {
-    gamesList: jspb.Message.toObjectList(msg.getGamesList(),
-    api_proto_ipc_tournament_pb.TournamentGameEndedEvent.toObject, includeInstance)
+    userId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    rating: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0),
+    ratingDeviation: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
+    games: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    member: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
   };
 
   if (includeInstance) {
@@ -4651,23 +12303,23 @@ proto.tournament_service.RecentGamesResponse.toObject = function(includeInstance
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.tournament_service.RecentGamesResponse}
+ * @return {!proto.tournament_service.ClubRating}
  */
-proto.tournament_service.RecentGamesResponse.deserializeBinary = function(bytes) {
+proto.tournament_service.ClubRating.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.tournament_service.RecentGamesResponse;
-  return proto.tournament_service.RecentGamesResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.tournament_service.ClubRating;
+  return proto.tournament_service.ClubRating.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.tournament_service.RecentGamesResponse} msg The message object to deserialize into.
+ * @param {!proto.tournament_service.ClubRating} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.tournament_service.RecentGamesResponse}
+ * @return {!proto.tournament_service.ClubRating}
  */
-proto.tournament_service.RecentGamesResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.tournament_service.ClubRating.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4675,9 +12327,24 @@ proto.tournament_service.RecentGamesResponse.deserializeBinaryFromReader = funct
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new api_proto_ipc_tournament_pb.TournamentGameEndedEvent;
-      reader.readMessage(value,api_proto_ipc_tournament_pb.TournamentGameEndedEvent.deserializeBinaryFromReader);
-      msg.addGames(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUserId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setRating(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setRatingDeviation(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setGames(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setMember(value);
       break;
     default:
       reader.skipField();
@@ -4692,9 +12359,9 @@ proto.tournament_service.RecentGamesResponse.deserializeBinaryFromReader = funct
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.tournament_service.RecentGamesResponse.prototype.serializeBinary = function() {
+proto.tournament_service.ClubRating.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.tournament_service.RecentGamesResponse.serializeBinaryToWriter(this, writer);
+  proto.tournament_service.ClubRating.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4702,58 +12369,137 @@ proto.tournament_service.RecentGamesResponse.prototype.serializeBinary = functio
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.tournament_service.RecentGamesResponse} message
+ * @param {!proto.tournament_service.ClubRating} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.RecentGamesResponse.serializeBinaryToWriter = function(message, writer) {
+proto.tournament_service.ClubRating.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getGamesList();
+  f = message.getUserId();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
-      f,
-      api_proto_ipc_tournament_pb.TournamentGameEndedEvent.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getRating();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      2,
+      f
+    );
+  }
+  f = message.getRatingDeviation();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      3,
+      f
+    );
+  }
+  f = message.getGames();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+  f = message.getMember();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
     );
   }
 };
 
 
 /**
- * repeated ipc.TournamentGameEndedEvent games = 1;
- * @return {!Array<!proto.ipc.TournamentGameEndedEvent>}
+ * optional string user_id = 1;
+ * @return {string}
  */
-proto.tournament_service.RecentGamesResponse.prototype.getGamesList = function() {
-  return /** @type{!Array<!proto.ipc.TournamentGameEndedEvent>} */ (
-    jspb.Message.getRepeatedWrapperField(this, api_proto_ipc_tournament_pb.TournamentGameEndedEvent, 1));
+proto.tournament_service.ClubRating.prototype.getUserId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!Array<!proto.ipc.TournamentGameEndedEvent>} value
- * @return {!proto.tournament_service.RecentGamesResponse} returns this
-*/
-proto.tournament_service.RecentGamesResponse.prototype.setGamesList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {string} value
+ * @return {!proto.tournament_service.ClubRating} returns this
+ */
+proto.tournament_service.ClubRating.prototype.setUserId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.ipc.TournamentGameEndedEvent=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ipc.TournamentGameEndedEvent}
+ * optional double rating = 2;
+ * @return {number}
  */
-proto.tournament_service.RecentGamesResponse.prototype.addGames = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ipc.TournamentGameEndedEvent, opt_index);
+proto.tournament_service.ClubRating.prototype.getRating = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.tournament_service.ClubRating} returns this
+ */
+proto.tournament_service.ClubRating.prototype.setRating = function(value) {
+  return jspb.Message.setProto3FloatField(this, 2, value);
+};
+
+
+/**
+ * optional double rating_deviation = 3;
+ * @return {number}
+ */
+proto.tournament_service.ClubRating.prototype.getRatingDeviation = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.tournament_service.ClubRating} returns this
+ */
+proto.tournament_service.ClubRating.prototype.setRatingDeviation = function(value) {
+  return jspb.Message.setProto3FloatField(this, 3, value);
+};
+
+
+/**
+ * optional int32 games = 4;
+ * @return {number}
+ */
+proto.tournament_service.ClubRating.prototype.getGames = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.tournament_service.ClubRating} returns this
+ */
+proto.tournament_service.ClubRating.prototype.setGames = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional bool member = 5;
+ * @return {boolean}
+ */
+proto.tournament_service.ClubRating.prototype.getMember = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.tournament_service.RecentGamesResponse} returns this
+ * @param {boolean} value
+ * @return {!proto.tournament_service.ClubRating} returns this
  */
-proto.tournament_service.RecentGamesResponse.prototype.clearGamesList = function() {
-  return this.setGamesList([]);
+proto.tournament_service.ClubRating.prototype.setMember = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
 };
 
 
@@ -4773,8 +12519,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.tournament_service.UnstartTournamentRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.tournament_service.UnstartTournamentRequest.toObject(opt_includeInstance, this);
+proto.tournament_service.ClubRatingsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.ClubRatingsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -4783,13 +12529,15 @@ proto.tournament_service.UnstartTournamentRequest.prototype.toObject = function(
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.tournament_service.UnstartTournamentRequest} msg The msg instance to transform.
+ * @param {!proto.tournament_service.ClubRatingsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.UnstartTournamentRequest.toObject = function(includeInstance, msg) {
+proto.tournament_service.ClubRatingsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    offset: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    limit: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -4803,23 +12551,23 @@ proto.tournament_service.UnstartTournamentRequest.toObject = function(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.tournament_service.UnstartTournamentRequest}
+ * @return {!proto.tournament_service.ClubRatingsRequest}
  */
-proto.tournament_service.UnstartTournamentRequest.deserializeBinary = function(bytes) {
+proto.tournament_service.ClubRatingsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.tournament_service.UnstartTournamentRequest;
-  return proto.tournament_service.UnstartTournamentRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.tournament_service.ClubRatingsRequest;
+  return proto.tournament_service.ClubRatingsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.tournament_service.UnstartTournamentRequest} msg The message object to deserialize into.
+ * @param {!proto.tournament_service.ClubRatingsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.tournament_service.UnstartTournamentRequest}
+ * @return {!proto.tournament_service.ClubRatingsRequest}
  */
-proto.tournament_service.UnstartTournamentRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.tournament_service.ClubRatingsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4830,6 +12578,14 @@ proto.tournament_service.UnstartTournamentRequest.deserializeBinaryFromReader =
       var value = /** @type {string} */ (reader.readString());
       msg.setId(value);
       break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setOffset(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setLimit(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -4843,9 +12599,9 @@ proto.tournament_service.UnstartTournamentRequest.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.tournament_service.UnstartTournamentRequest.prototype.serializeBinary = function() {
+proto.tournament_service.ClubRatingsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.tournament_service.UnstartTournamentRequest.serializeBinaryToWriter(this, writer);
+  proto.tournament_service.ClubRatingsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4853,11 +12609,11 @@ proto.tournament_service.UnstartTournamentRequest.prototype.serializeBinary = fu
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.tournament_service.UnstartTournamentRequest} message
+ * @param {!proto.tournament_service.ClubRatingsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.UnstartTournamentRequest.serializeBinaryToWriter = function(message, writer) {
+proto.tournament_service.ClubRatingsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f.length > 0) {
@@ -4866,6 +12622,20 @@ proto.tournament_service.UnstartTournamentRequest.serializeBinaryToWriter = func
       f
     );
   }
+  f = message.getOffset();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getLimit();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -4873,20 +12643,63 @@ proto.tournament_service.UnstartTournamentRequest.serializeBinaryToWriter = func
  * optional string id = 1;
  * @return {string}
  */
-proto.tournament_service.UnstartTournamentRequest.prototype.getId = function() {
+proto.tournament_service.ClubRatingsRequest.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.tournament_service.UnstartTournamentRequest} returns this
+ * @return {!proto.tournament_service.ClubRatingsRequest} returns this
  */
-proto.tournament_service.UnstartTournamentRequest.prototype.setId = function(value) {
+proto.tournament_service.ClubRatingsRequest.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional int32 offset = 2;
+ * @return {number}
+ */
+proto.tournament_service.ClubRatingsRequest.prototype.getOffset = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.tournament_service.ClubRatingsRequest} returns this
+ */
+proto.tournament_service.ClubRatingsRequest.prototype.setOffset = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int32 limit = 3;
+ * @return {number}
+ */
+proto.tournament_service.ClubRatingsRequest.prototype.getLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.tournament_service.ClubRatingsRequest} returns this
+ */
+proto.tournament_service.ClubRatingsRequest.prototype.setLimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.tournament_service.ClubRatingsResponse.repeatedFields_ = [2];
 
 
 
@@ -4903,8 +12716,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.tournament_service.UncheckInRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.tournament_service.UncheckInRequest.toObject(opt_includeInstance, this);
+proto.tournament_service.ClubRatingsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.ClubRatingsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -4913,13 +12726,16 @@ proto.tournament_service.UncheckInRequest.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.tournament_service.UncheckInRequest} msg The msg instance to transform.
+ * @param {!proto.tournament_service.ClubRatingsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.UncheckInRequest.toObject = function(includeInstance, msg) {
+proto.tournament_service.ClubRatingsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    ratingsList: jspb.Message.toObjectList(msg.getRatingsList(),
+    proto.tournament_service.ClubRating.toObject, includeInstance),
+    total: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -4933,23 +12749,23 @@ proto.tournament_service.UncheckInRequest.toObject = function(includeInstance, m
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.tournament_service.UncheckInRequest}
+ * @return {!proto.tournament_service.ClubRatingsResponse}
  */
-proto.tournament_service.UncheckInRequest.deserializeBinary = function(bytes) {
+proto.tournament_service.ClubRatingsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.tournament_service.UncheckInRequest;
-  return proto.tournament_service.UncheckInRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.tournament_service.ClubRatingsResponse;
+  return proto.tournament_service.ClubRatingsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.tournament_service.UncheckInRequest} msg The message object to deserialize into.
+ * @param {!proto.tournament_service.ClubRatingsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.tournament_service.UncheckInRequest}
+ * @return {!proto.tournament_service.ClubRatingsResponse}
  */
-proto.tournament_service.UncheckInRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.tournament_service.ClubRatingsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4960,6 +12776,15 @@ proto.tournament_service.UncheckInRequest.deserializeBinaryFromReader = function
       var value = /** @type {string} */ (reader.readString());
       msg.setId(value);
       break;
+    case 2:
+      var value = new proto.tournament_service.ClubRating;
+      reader.readMessage(value,proto.tournament_service.ClubRating.deserializeBinaryFromReader);
+      msg.addRatings(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setTotal(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -4973,9 +12798,9 @@ proto.tournament_service.UncheckInRequest.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.tournament_service.UncheckInRequest.prototype.serializeBinary = function() {
+proto.tournament_service.ClubRatingsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.tournament_service.UncheckInRequest.serializeBinaryToWriter(this, writer);
+  proto.tournament_service.ClubRatingsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4983,11 +12808,11 @@ proto.tournament_service.UncheckInRequest.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.tournament_service.UncheckInRequest} message
+ * @param {!proto.tournament_service.ClubRatingsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.UncheckInRequest.serializeBinaryToWriter = function(message, writer) {
+proto.tournament_service.ClubRatingsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f.length > 0) {
@@ -4996,6 +12821,21 @@ proto.tournament_service.UncheckInRequest.serializeBinaryToWriter = function(mes
       f
     );
   }
+  f = message.getRatingsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.tournament_service.ClubRating.serializeBinaryToWriter
+    );
+  }
+  f = message.getTotal();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -5003,147 +12843,73 @@ proto.tournament_service.UncheckInRequest.serializeBinaryToWriter = function(mes
  * optional string id = 1;
  * @return {string}
  */
-proto.tournament_service.UncheckInRequest.prototype.getId = function() {
+proto.tournament_service.ClubRatingsResponse.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.tournament_service.UncheckInRequest} returns this
+ * @return {!proto.tournament_service.ClubRatingsResponse} returns this
  */
-proto.tournament_service.UncheckInRequest.prototype.setId = function(value) {
+proto.tournament_service.ClubRatingsResponse.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
-/**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
- */
-proto.tournament_service.CheckinRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.tournament_service.CheckinRequest.toObject(opt_includeInstance, this);
-};
-
-
-/**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.tournament_service.CheckinRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.tournament_service.CheckinRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
-};
-}
-
-
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.tournament_service.CheckinRequest}
+ * repeated ClubRating ratings = 2;
+ * @return {!Array<!proto.tournament_service.ClubRating>}
  */
-proto.tournament_service.CheckinRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.tournament_service.CheckinRequest;
-  return proto.tournament_service.CheckinRequest.deserializeBinaryFromReader(msg, reader);
+proto.tournament_service.ClubRatingsResponse.prototype.getRatingsList = function() {
+  return /** @type{!Array<!proto.tournament_service.ClubRating>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.tournament_service.ClubRating, 2));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.tournament_service.CheckinRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.tournament_service.CheckinRequest}
- */
-proto.tournament_service.CheckinRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+ * @param {!Array<!proto.tournament_service.ClubRating>} value
+ * @return {!proto.tournament_service.ClubRatingsResponse} returns this
+*/
+proto.tournament_service.ClubRatingsResponse.prototype.setRatingsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {!proto.tournament_service.ClubRating=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.tournament_service.ClubRating}
  */
-proto.tournament_service.CheckinRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.tournament_service.CheckinRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.tournament_service.ClubRatingsResponse.prototype.addRatings = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.tournament_service.ClubRating, opt_index);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.tournament_service.CheckinRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the list making it empty but non-null.
+ * @return {!proto.tournament_service.ClubRatingsResponse} returns this
  */
-proto.tournament_service.CheckinRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.tournament_service.ClubRatingsResponse.prototype.clearRatingsList = function() {
+  return this.setRatingsList([]);
 };
 
 
 /**
- * optional string id = 1;
- * @return {string}
+ * optional int32 total = 3;
+ * @return {number}
  */
-proto.tournament_service.CheckinRequest.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.tournament_service.ClubRatingsResponse.prototype.getTotal = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.tournament_service.CheckinRequest} returns this
+ * @param {number} value
+ * @return {!proto.tournament_service.ClubRatingsResponse} returns this
  */
-proto.tournament_service.CheckinRequest.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.tournament_service.ClubRatingsResponse.prototype.setTotal = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
@@ -5163,8 +12929,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.tournament_service.NewClubSessionRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.tournament_service.NewClubSessionRequest.toObject(opt_includeInstance, this);
+proto.tournament_service.ClubStatsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.ClubStatsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -5173,14 +12939,14 @@ proto.tournament_service.NewClubSessionRequest.prototype.toObject = function(opt
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.tournament_service.NewClubSessionRequest} msg The msg instance to transform.
+ * @param {!proto.tournament_service.ClubStatsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.NewClubSessionRequest.toObject = function(includeInstance, msg) {
+proto.tournament_service.ClubStatsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    date: (f = msg.getDate()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
-    clubId: jspb.Message.getFieldWithDefault(msg, 2, "")
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    limit: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -5194,23 +12960,23 @@ proto.tournament_service.NewClubSessionRequest.toObject = function(includeInstan
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.tournament_service.NewClubSessionRequest}
+ * @return {!proto.tournament_service.ClubStatsRequest}
  */
-proto.tournament_service.NewClubSessionRequest.deserializeBinary = function(bytes) {
+proto.tournament_service.ClubStatsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.tournament_service.NewClubSessionRequest;
-  return proto.tournament_service.NewClubSessionRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.tournament_service.ClubStatsRequest;
+  return proto.tournament_service.ClubStatsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.tournament_service.NewClubSessionRequest} msg The message object to deserialize into.
+ * @param {!proto.tournament_service.ClubStatsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.tournament_service.NewClubSessionRequest}
+ * @return {!proto.tournament_service.ClubStatsRequest}
  */
-proto.tournament_service.NewClubSessionRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.tournament_service.ClubStatsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5218,13 +12984,12 @@ proto.tournament_service.NewClubSessionRequest.deserializeBinaryFromReader = fun
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setDate(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setClubId(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setLimit(value);
       break;
     default:
       reader.skipField();
@@ -5239,9 +13004,9 @@ proto.tournament_service.NewClubSessionRequest.deserializeBinaryFromReader = fun
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.tournament_service.NewClubSessionRequest.prototype.serializeBinary = function() {
+proto.tournament_service.ClubStatsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.tournament_service.NewClubSessionRequest.serializeBinaryToWriter(this, writer);
+  proto.tournament_service.ClubStatsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5249,23 +13014,22 @@ proto.tournament_service.NewClubSessionRequest.prototype.serializeBinary = funct
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.tournament_service.NewClubSessionRequest} message
+ * @param {!proto.tournament_service.ClubStatsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.NewClubSessionRequest.serializeBinaryToWriter = function(message, writer) {
+proto.tournament_service.ClubStatsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getDate();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
       1,
-      f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getClubId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getLimit();
+  if (f !== 0) {
+    writer.writeInt32(
       2,
       f
     );
@@ -5274,57 +13038,38 @@ proto.tournament_service.NewClubSessionRequest.serializeBinaryToWriter = functio
 
 
 /**
- * optional google.protobuf.Timestamp date = 1;
- * @return {?proto.google.protobuf.Timestamp}
- */
-proto.tournament_service.NewClubSessionRequest.prototype.getDate = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 1));
-};
-
-
-/**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.tournament_service.NewClubSessionRequest} returns this
-*/
-proto.tournament_service.NewClubSessionRequest.prototype.setDate = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.tournament_service.NewClubSessionRequest} returns this
+ * optional string id = 1;
+ * @return {string}
  */
-proto.tournament_service.NewClubSessionRequest.prototype.clearDate = function() {
-  return this.setDate(undefined);
+proto.tournament_service.ClubStatsRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.tournament_service.ClubStatsRequest} returns this
  */
-proto.tournament_service.NewClubSessionRequest.prototype.hasDate = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.tournament_service.ClubStatsRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string club_id = 2;
- * @return {string}
+ * optional int32 limit = 2;
+ * @return {number}
  */
-proto.tournament_service.NewClubSessionRequest.prototype.getClubId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.tournament_service.ClubStatsRequest.prototype.getLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.tournament_service.NewClubSessionRequest} returns this
+ * @param {number} value
+ * @return {!proto.tournament_service.ClubStatsRequest} returns this
  */
-proto.tournament_service.NewClubSessionRequest.prototype.setClubId = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.tournament_service.ClubStatsRequest.prototype.setLimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
@@ -5344,8 +13089,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.tournament_service.ClubSessionResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.tournament_service.ClubSessionResponse.toObject(opt_includeInstance, this);
+proto.tournament_service.SessionAttendance.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.SessionAttendance.toObject(opt_includeInstance, this);
 };
 
 
@@ -5354,14 +13099,16 @@ proto.tournament_service.ClubSessionResponse.prototype.toObject = function(opt_i
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.tournament_service.ClubSessionResponse} msg The msg instance to transform.
+ * @param {!proto.tournament_service.SessionAttendance} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.ClubSessionResponse.toObject = function(includeInstance, msg) {
+proto.tournament_service.SessionAttendance.toObject = function(includeInstance, msg) {
   var f, obj = {
     tournamentId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    slug: jspb.Message.getFieldWithDefault(msg, 2, "")
+    slug: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    players: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    games: jspb.Message.getFieldWithDefault(msg, 4, 0)
   };
 
   if (includeInstance) {
@@ -5375,23 +13122,23 @@ proto.tournament_service.ClubSessionResponse.toObject = function(includeInstance
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.tournament_service.ClubSessionResponse}
+ * @return {!proto.tournament_service.SessionAttendance}
  */
-proto.tournament_service.ClubSessionResponse.deserializeBinary = function(bytes) {
+proto.tournament_service.SessionAttendance.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.tournament_service.ClubSessionResponse;
-  return proto.tournament_service.ClubSessionResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.tournament_service.SessionAttendance;
+  return proto.tournament_service.SessionAttendance.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.tournament_service.ClubSessionResponse} msg The message object to deserialize into.
+ * @param {!proto.tournament_service.SessionAttendance} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.tournament_service.ClubSessionResponse}
+ * @return {!proto.tournament_service.SessionAttendance}
  */
-proto.tournament_service.ClubSessionResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.tournament_service.SessionAttendance.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5406,6 +13153,14 @@ proto.tournament_service.ClubSessionResponse.deserializeBinaryFromReader = funct
       var value = /** @type {string} */ (reader.readString());
       msg.setSlug(value);
       break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPlayers(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setGames(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -5419,9 +13174,9 @@ proto.tournament_service.ClubSessionResponse.deserializeBinaryFromReader = funct
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.tournament_service.ClubSessionResponse.prototype.serializeBinary = function() {
+proto.tournament_service.SessionAttendance.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.tournament_service.ClubSessionResponse.serializeBinaryToWriter(this, writer);
+  proto.tournament_service.SessionAttendance.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5429,11 +13184,11 @@ proto.tournament_service.ClubSessionResponse.prototype.serializeBinary = functio
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.tournament_service.ClubSessionResponse} message
+ * @param {!proto.tournament_service.SessionAttendance} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.ClubSessionResponse.serializeBinaryToWriter = function(message, writer) {
+proto.tournament_service.SessionAttendance.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getTournamentId();
   if (f.length > 0) {
@@ -5449,6 +13204,20 @@ proto.tournament_service.ClubSessionResponse.serializeBinaryToWriter = function(
       f
     );
   }
+  f = message.getPlayers();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getGames();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
 };
 
 
@@ -5456,16 +13225,16 @@ proto.tournament_service.ClubSessionResponse.serializeBinaryToWriter = function(
  * optional string tournament_id = 1;
  * @return {string}
  */
-proto.tournament_service.ClubSessionResponse.prototype.getTournamentId = function() {
+proto.tournament_service.SessionAttendance.prototype.getTournamentId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.tournament_service.ClubSessionResponse} returns this
+ * @return {!proto.tournament_service.SessionAttendance} returns this
  */
-proto.tournament_service.ClubSessionResponse.prototype.setTournamentId = function(value) {
+proto.tournament_service.SessionAttendance.prototype.setTournamentId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
@@ -5474,20 +13243,56 @@ proto.tournament_service.ClubSessionResponse.prototype.setTournamentId = functio
  * optional string slug = 2;
  * @return {string}
  */
-proto.tournament_service.ClubSessionResponse.prototype.getSlug = function() {
+proto.tournament_service.SessionAttendance.prototype.getSlug = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.tournament_service.ClubSessionResponse} returns this
+ * @return {!proto.tournament_service.SessionAttendance} returns this
  */
-proto.tournament_service.ClubSessionResponse.prototype.setSlug = function(value) {
+proto.tournament_service.SessionAttendance.prototype.setSlug = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+/**
+ * optional int32 players = 3;
+ * @return {number}
+ */
+proto.tournament_service.SessionAttendance.prototype.getPlayers = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.tournament_service.SessionAttendance} returns this
+ */
+proto.tournament_service.SessionAttendance.prototype.setPlayers = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional int32 games = 4;
+ * @return {number}
+ */
+proto.tournament_service.SessionAttendance.prototype.getGames = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.tournament_service.SessionAttendance} returns this
+ */
+proto.tournament_service.SessionAttendance.prototype.setGames = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
 
 
 
@@ -5504,8 +13309,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.tournament_service.RecentClubSessionsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.tournament_service.RecentClubSessionsRequest.toObject(opt_includeInstance, this);
+proto.tournament_service.ClubMemberActivity.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.ClubMemberActivity.toObject(opt_includeInstance, this);
 };
 
 
@@ -5514,15 +13319,15 @@ proto.tournament_service.RecentClubSessionsRequest.prototype.toObject = function
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.tournament_service.RecentClubSessionsRequest} msg The msg instance to transform.
+ * @param {!proto.tournament_service.ClubMemberActivity} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.RecentClubSessionsRequest.toObject = function(includeInstance, msg) {
+proto.tournament_service.ClubMemberActivity.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    count: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    offset: jspb.Message.getFieldWithDefault(msg, 3, 0)
+    userId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    sessions: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    games: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -5536,23 +13341,23 @@ proto.tournament_service.RecentClubSessionsRequest.toObject = function(includeIn
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.tournament_service.RecentClubSessionsRequest}
+ * @return {!proto.tournament_service.ClubMemberActivity}
  */
-proto.tournament_service.RecentClubSessionsRequest.deserializeBinary = function(bytes) {
+proto.tournament_service.ClubMemberActivity.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.tournament_service.RecentClubSessionsRequest;
-  return proto.tournament_service.RecentClubSessionsRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.tournament_service.ClubMemberActivity;
+  return proto.tournament_service.ClubMemberActivity.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.tournament_service.RecentClubSessionsRequest} msg The message object to deserialize into.
+ * @param {!proto.tournament_service.ClubMemberActivity} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.tournament_service.RecentClubSessionsRequest}
+ * @return {!proto.tournament_service.ClubMemberActivity}
  */
-proto.tournament_service.RecentClubSessionsRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.tournament_service.ClubMemberActivity.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5561,15 +13366,15 @@ proto.tournament_service.RecentClubSessionsRequest.deserializeBinaryFromReader =
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      msg.setUserId(value);
       break;
     case 2:
       var value = /** @type {number} */ (reader.readInt32());
-      msg.setCount(value);
+      msg.setSessions(value);
       break;
     case 3:
       var value = /** @type {number} */ (reader.readInt32());
-      msg.setOffset(value);
+      msg.setGames(value);
       break;
     default:
       reader.skipField();
@@ -5584,9 +13389,9 @@ proto.tournament_service.RecentClubSessionsRequest.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.tournament_service.RecentClubSessionsRequest.prototype.serializeBinary = function() {
+proto.tournament_service.ClubMemberActivity.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.tournament_service.RecentClubSessionsRequest.serializeBinaryToWriter(this, writer);
+  proto.tournament_service.ClubMemberActivity.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5594,27 +13399,27 @@ proto.tournament_service.RecentClubSessionsRequest.prototype.serializeBinary = f
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.tournament_service.RecentClubSessionsRequest} message
+ * @param {!proto.tournament_service.ClubMemberActivity} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.RecentClubSessionsRequest.serializeBinaryToWriter = function(message, writer) {
+proto.tournament_service.ClubMemberActivity.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getUserId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getCount();
+  f = message.getSessions();
   if (f !== 0) {
     writer.writeInt32(
       2,
       f
     );
   }
-  f = message.getOffset();
+  f = message.getGames();
   if (f !== 0) {
     writer.writeInt32(
       3,
@@ -5625,55 +13430,55 @@ proto.tournament_service.RecentClubSessionsRequest.serializeBinaryToWriter = fun
 
 
 /**
- * optional string id = 1;
+ * optional string user_id = 1;
  * @return {string}
  */
-proto.tournament_service.RecentClubSessionsRequest.prototype.getId = function() {
+proto.tournament_service.ClubMemberActivity.prototype.getUserId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.tournament_service.RecentClubSessionsRequest} returns this
+ * @return {!proto.tournament_service.ClubMemberActivity} returns this
  */
-proto.tournament_service.RecentClubSessionsRequest.prototype.setId = function(value) {
+proto.tournament_service.ClubMemberActivity.prototype.setUserId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional int32 count = 2;
+ * optional int32 sessions = 2;
  * @return {number}
  */
-proto.tournament_service.RecentClubSessionsRequest.prototype.getCount = function() {
+proto.tournament_service.ClubMemberActivity.prototype.getSessions = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.tournament_service.RecentClubSessionsRequest} returns this
+ * @return {!proto.tournament_service.ClubMemberActivity} returns this
  */
-proto.tournament_service.RecentClubSessionsRequest.prototype.setCount = function(value) {
+proto.tournament_service.ClubMemberActivity.prototype.setSessions = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional int32 offset = 3;
+ * optional int32 games = 3;
  * @return {number}
  */
-proto.tournament_service.RecentClubSessionsRequest.prototype.getOffset = function() {
+proto.tournament_service.ClubMemberActivity.prototype.getGames = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.tournament_service.RecentClubSessionsRequest} returns this
+ * @return {!proto.tournament_service.ClubMemberActivity} returns this
  */
-proto.tournament_service.RecentClubSessionsRequest.prototype.setOffset = function(value) {
+proto.tournament_service.ClubMemberActivity.prototype.setGames = function(value) {
   return jspb.Message.setProto3IntField(this, 3, value);
 };
 
@@ -5684,7 +13489,7 @@ proto.tournament_service.RecentClubSessionsRequest.prototype.setOffset = functio
  * @private {!Array<number>}
  * @const
  */
-proto.tournament_service.ClubSessionsResponse.repeatedFields_ = [1];
+proto.tournament_service.ClubStatsResponse.repeatedFields_ = [2,3];
 
 
 
@@ -5701,8 +13506,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.tournament_service.ClubSessionsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.tournament_service.ClubSessionsResponse.toObject(opt_includeInstance, this);
+proto.tournament_service.ClubStatsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.tournament_service.ClubStatsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -5711,14 +13516,17 @@ proto.tournament_service.ClubSessionsResponse.prototype.toObject = function(opt_
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.tournament_service.ClubSessionsResponse} msg The msg instance to transform.
+ * @param {!proto.tournament_service.ClubStatsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.ClubSessionsResponse.toObject = function(includeInstance, msg) {
+proto.tournament_service.ClubStatsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
     sessionsList: jspb.Message.toObjectList(msg.getSessionsList(),
-    proto.tournament_service.ClubSessionResponse.toObject, includeInstance)
+    proto.tournament_service.SessionAttendance.toObject, includeInstance),
+    mostActiveList: jspb.Message.toObjectList(msg.getMostActiveList(),
+    proto.tournament_service.ClubMemberActivity.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -5732,23 +13540,23 @@ proto.tournament_service.ClubSessionsResponse.toObject = function(includeInstanc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.tournament_service.ClubSessionsResponse}
+ * @return {!proto.tournament_service.ClubStatsResponse}
  */
-proto.tournament_service.ClubSessionsResponse.deserializeBinary = function(bytes) {
+proto.tournament_service.ClubStatsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.tournament_service.ClubSessionsResponse;
-  return proto.tournament_service.ClubSessionsResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.tournament_service.ClubStatsResponse;
+  return proto.tournament_service.ClubStatsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.tournament_service.ClubSessionsResponse} msg The message object to deserialize into.
+ * @param {!proto.tournament_service.ClubStatsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.tournament_service.ClubSessionsResponse}
+ * @return {!proto.tournament_service.ClubStatsResponse}
  */
-proto.tournament_service.ClubSessionsResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.tournament_service.ClubStatsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5756,10 +13564,19 @@ proto.tournament_service.ClubSessionsResponse.deserializeBinaryFromReader = func
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.tournament_service.ClubSessionResponse;
-      reader.readMessage(value,proto.tournament_service.ClubSessionResponse.deserializeBinaryFromReader);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = new proto.tournament_service.SessionAttendance;
+      reader.readMessage(value,proto.tournament_service.SessionAttendance.deserializeBinaryFromReader);
       msg.addSessions(value);
       break;
+    case 3:
+      var value = new proto.tournament_service.ClubMemberActivity;
+      reader.readMessage(value,proto.tournament_service.ClubMemberActivity.deserializeBinaryFromReader);
+      msg.addMostActive(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -5773,9 +13590,9 @@ proto.tournament_service.ClubSessionsResponse.deserializeBinaryFromReader = func
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.tournament_service.ClubSessionsResponse.prototype.serializeBinary = function() {
+proto.tournament_service.ClubStatsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.tournament_service.ClubSessionsResponse.serializeBinaryToWriter(this, writer);
+  proto.tournament_service.ClubStatsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5783,61 +13600,132 @@ proto.tournament_service.ClubSessionsResponse.prototype.serializeBinary = functi
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.tournament_service.ClubSessionsResponse} message
+ * @param {!proto.tournament_service.ClubStatsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.tournament_service.ClubSessionsResponse.serializeBinaryToWriter = function(message, writer) {
+proto.tournament_service.ClubStatsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
   f = message.getSessionsList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
-      1,
+      2,
       f,
-      proto.tournament_service.ClubSessionResponse.serializeBinaryToWriter
+      proto.tournament_service.SessionAttendance.serializeBinaryToWriter
+    );
+  }
+  f = message.getMostActiveList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.tournament_service.ClubMemberActivity.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated ClubSessionResponse sessions = 1;
- * @return {!Array<!proto.tournament_service.ClubSessionResponse>}
+ * optional string id = 1;
+ * @return {string}
  */
-proto.tournament_service.ClubSessionsResponse.prototype.getSessionsList = function() {
-  return /** @type{!Array<!proto.tournament_service.ClubSessionResponse>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.tournament_service.ClubSessionResponse, 1));
+proto.tournament_service.ClubStatsResponse.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!Array<!proto.tournament_service.ClubSessionResponse>} value
- * @return {!proto.tournament_service.ClubSessionsResponse} returns this
+ * @param {string} value
+ * @return {!proto.tournament_service.ClubStatsResponse} returns this
+ */
+proto.tournament_service.ClubStatsResponse.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * repeated SessionAttendance sessions = 2;
+ * @return {!Array<!proto.tournament_service.SessionAttendance>}
+ */
+proto.tournament_service.ClubStatsResponse.prototype.getSessionsList = function() {
+  return /** @type{!Array<!proto.tournament_service.SessionAttendance>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.tournament_service.SessionAttendance, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.tournament_service.SessionAttendance>} value
+ * @return {!proto.tournament_service.ClubStatsResponse} returns this
 */
-proto.tournament_service.ClubSessionsResponse.prototype.setSessionsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+proto.tournament_service.ClubStatsResponse.prototype.setSessionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
 };
 
 
 /**
- * @param {!proto.tournament_service.ClubSessionResponse=} opt_value
+ * @param {!proto.tournament_service.SessionAttendance=} opt_value
  * @param {number=} opt_index
- * @return {!proto.tournament_service.ClubSessionResponse}
+ * @return {!proto.tournament_service.SessionAttendance}
  */
-proto.tournament_service.ClubSessionsResponse.prototype.addSessions = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.tournament_service.ClubSessionResponse, opt_index);
+proto.tournament_service.ClubStatsResponse.prototype.addSessions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.tournament_service.SessionAttendance, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.tournament_service.ClubSessionsResponse} returns this
+ * @return {!proto.tournament_service.ClubStatsResponse} returns this
  */
-proto.tournament_service.ClubSessionsResponse.prototype.clearSessionsList = function() {
+proto.tournament_service.ClubStatsResponse.prototype.clearSessionsList = function() {
   return this.setSessionsList([]);
 };
 
 
+/**
+ * repeated ClubMemberActivity most_active = 3;
+ * @return {!Array<!proto.tournament_service.ClubMemberActivity>}
+ */
+proto.tournament_service.ClubStatsResponse.prototype.getMostActiveList = function() {
+  return /** @type{!Array<!proto.tournament_service.ClubMemberActivity>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.tournament_service.ClubMemberActivity, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.tournament_service.ClubMemberActivity>} value
+ * @return {!proto.tournament_service.ClubStatsResponse} returns this
+*/
+proto.tournament_service.ClubStatsResponse.prototype.setMostActiveList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.tournament_service.ClubMemberActivity=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.tournament_service.ClubMemberActivity}
+ */
+proto.tournament_service.ClubStatsResponse.prototype.addMostActive = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.tournament_service.ClubMemberActivity, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.tournament_service.ClubStatsResponse} returns this
+ */
+proto.tournament_service.ClubStatsResponse.prototype.clearMostActiveList = function() {
+  return this.setMostActiveList([]);
+};
+
+
 /**
  * @enum {number}
  */
@@ -5845,7 +13733,18 @@ proto.tournament_service.TType = {
   STANDARD: 0,
   CLUB: 1,
   CHILD: 2,
-  LEGACY: 3
+  LEGACY: 3,
+  LADDER: 4
+};
+
+/**
+ * @enum {number}
+ */
+proto.tournament_service.RegistrationStatus = {
+  REGISTRATION_UNSPECIFIED: 0,
+  REGISTERED: 1,
+  WAITLISTED: 2,
+  PENDING_APPROVAL: 3
 };
 
 goog.object.extend(exports, proto.tournament_service);
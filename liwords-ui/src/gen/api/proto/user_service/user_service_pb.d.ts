@@ -3,6 +3,7 @@
 
 import * as jspb from "google-protobuf";
 import * as api_proto_ipc_chat_pb from "../../../api/proto/ipc/chat_pb";
+import * as google_protobuf_timestamp_pb from "google-protobuf/google/protobuf/timestamp_pb";
 
 export class UserLoginRequest extends jspb.Message {
   getUsername(): string;
@@ -464,6 +465,284 @@ export namespace RatingsResponse {
   }
 }
 
+export class RatingHistoryRequest extends jspb.Message {
+  getUsername(): string;
+  setUsername(value: string): void;
+
+  getVariant(): string;
+  setVariant(value: string): void;
+
+  hasBegin(): boolean;
+  clearBegin(): void;
+  getBegin(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setBegin(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  hasEnd(): boolean;
+  clearEnd(): void;
+  getEnd(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setEnd(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  getMaxPoints(): number;
+  setMaxPoints(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RatingHistoryRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: RatingHistoryRequest): RatingHistoryRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: RatingHistoryRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RatingHistoryRequest;
+  static deserializeBinaryFromReader(message: RatingHistoryRequest, reader: jspb.BinaryReader): RatingHistoryRequest;
+}
+
+export namespace RatingHistoryRequest {
+  export type AsObject = {
+    username: string,
+    variant: string,
+    begin?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    end?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    maxPoints: number,
+  }
+}
+
+export class RatingHistoryEntry extends jspb.Message {
+  getRating(): number;
+  setRating(value: number): void;
+
+  getRatingDeviation(): number;
+  setRatingDeviation(value: number): void;
+
+  getVolatility(): number;
+  setVolatility(value: number): void;
+
+  getGameId(): string;
+  setGameId(value: string): void;
+
+  hasCreatedAt(): boolean;
+  clearCreatedAt(): void;
+  getCreatedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setCreatedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RatingHistoryEntry.AsObject;
+  static toObject(includeInstance: boolean, msg: RatingHistoryEntry): RatingHistoryEntry.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: RatingHistoryEntry, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RatingHistoryEntry;
+  static deserializeBinaryFromReader(message: RatingHistoryEntry, reader: jspb.BinaryReader): RatingHistoryEntry;
+}
+
+export namespace RatingHistoryEntry {
+  export type AsObject = {
+    rating: number,
+    ratingDeviation: number,
+    volatility: number,
+    gameId: string,
+    createdAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+}
+
+export class RatingHistoryResponse extends jspb.Message {
+  clearEntriesList(): void;
+  getEntriesList(): Array<RatingHistoryEntry>;
+  setEntriesList(value: Array<RatingHistoryEntry>): void;
+  addEntries(value?: RatingHistoryEntry, index?: number): RatingHistoryEntry;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RatingHistoryResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: RatingHistoryResponse): RatingHistoryResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: RatingHistoryResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RatingHistoryResponse;
+  static deserializeBinaryFromReader(message: RatingHistoryResponse, reader: jspb.BinaryReader): RatingHistoryResponse;
+}
+
+export namespace RatingHistoryResponse {
+  export type AsObject = {
+    entriesList: Array<RatingHistoryEntry.AsObject>,
+  }
+}
+
+export class ExpectedScoreRequest extends jspb.Message {
+  getUsername(): string;
+  setUsername(value: string): void;
+
+  getOpponentUsername(): string;
+  setOpponentUsername(value: string): void;
+
+  getVariant(): string;
+  setVariant(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ExpectedScoreRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ExpectedScoreRequest): ExpectedScoreRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ExpectedScoreRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ExpectedScoreRequest;
+  static deserializeBinaryFromReader(message: ExpectedScoreRequest, reader: jspb.BinaryReader): ExpectedScoreRequest;
+}
+
+export namespace ExpectedScoreRequest {
+  export type AsObject = {
+    username: string,
+    opponentUsername: string,
+    variant: string,
+  }
+}
+
+export class ExpectedScoreResponse extends jspb.Message {
+  getWinProbability(): number;
+  setWinProbability(value: number): void;
+
+  getExpectedSpread(): number;
+  setExpectedSpread(value: number): void;
+
+  getRating(): number;
+  setRating(value: number): void;
+
+  getOpponentRating(): number;
+  setOpponentRating(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ExpectedScoreResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ExpectedScoreResponse): ExpectedScoreResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ExpectedScoreResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ExpectedScoreResponse;
+  static deserializeBinaryFromReader(message: ExpectedScoreResponse, reader: jspb.BinaryReader): ExpectedScoreResponse;
+}
+
+export namespace ExpectedScoreResponse {
+  export type AsObject = {
+    winProbability: number,
+    expectedSpread: number,
+    rating: number,
+    opponentRating: number,
+  }
+}
+
+export class LeaderboardRequest extends jspb.Message {
+  getVariant(): string;
+  setVariant(value: string): void;
+
+  getLimit(): number;
+  setLimit(value: number): void;
+
+  getCountryCode(): string;
+  setCountryCode(value: string): void;
+
+  getActiveDays(): number;
+  setActiveDays(value: number): void;
+
+  getIncludeProvisional(): boolean;
+  setIncludeProvisional(value: boolean): void;
+
+  getOffset(): number;
+  setOffset(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): LeaderboardRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: LeaderboardRequest): LeaderboardRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: LeaderboardRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): LeaderboardRequest;
+  static deserializeBinaryFromReader(message: LeaderboardRequest, reader: jspb.BinaryReader): LeaderboardRequest;
+}
+
+export namespace LeaderboardRequest {
+  export type AsObject = {
+    variant: string,
+    limit: number,
+    countryCode: string,
+    activeDays: number,
+    includeProvisional: boolean,
+    offset: number,
+  }
+}
+
+export class LeaderboardEntry extends jspb.Message {
+  getRank(): number;
+  setRank(value: number): void;
+
+  getUserId(): string;
+  setUserId(value: string): void;
+
+  getUsername(): string;
+  setUsername(value: string): void;
+
+  getCountryCode(): string;
+  setCountryCode(value: string): void;
+
+  getRating(): number;
+  setRating(value: number): void;
+
+  getRatingDeviation(): number;
+  setRatingDeviation(value: number): void;
+
+  hasLastGame(): boolean;
+  clearLastGame(): void;
+  getLastGame(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setLastGame(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  getProvisional(): boolean;
+  setProvisional(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): LeaderboardEntry.AsObject;
+  static toObject(includeInstance: boolean, msg: LeaderboardEntry): LeaderboardEntry.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: LeaderboardEntry, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): LeaderboardEntry;
+  static deserializeBinaryFromReader(message: LeaderboardEntry, reader: jspb.BinaryReader): LeaderboardEntry;
+}
+
+export namespace LeaderboardEntry {
+  export type AsObject = {
+    rank: number,
+    userId: string,
+    username: string,
+    countryCode: string,
+    rating: number,
+    ratingDeviation: number,
+    lastGame?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    provisional: boolean,
+  }
+}
+
+export class LeaderboardResponse extends jspb.Message {
+  clearEntriesList(): void;
+  getEntriesList(): Array<LeaderboardEntry>;
+  setEntriesList(value: Array<LeaderboardEntry>): void;
+  addEntries(value?: LeaderboardEntry, index?: number): LeaderboardEntry;
+
+  hasUpdatedAt(): boolean;
+  clearUpdatedAt(): void;
+  getUpdatedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setUpdatedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): LeaderboardResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: LeaderboardResponse): LeaderboardResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: LeaderboardResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): LeaderboardResponse;
+  static deserializeBinaryFromReader(message: LeaderboardResponse, reader: jspb.BinaryReader): LeaderboardResponse;
+}
+
+export namespace LeaderboardResponse {
+  export type AsObject = {
+    entriesList: Array<LeaderboardEntry.AsObject>,
+    updatedAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+}
+
 export class StatsRequest extends jspb.Message {
   getUsername(): string;
   setUsername(value: string): void;
@@ -825,6 +1104,11 @@ export class BriefProfile extends jspb.Message {
   getAvatarUrl(): string;
   setAvatarUrl(value: string): void;
 
+  clearProvisionalVariantsList(): void;
+  getProvisionalVariantsList(): Array<string>;
+  setProvisionalVariantsList(value: Array<string>): void;
+  addProvisionalVariants(value: string, index?: number): string;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): BriefProfile.AsObject;
   static toObject(includeInstance: boolean, msg: BriefProfile): BriefProfile.AsObject;
@@ -841,6 +1125,7 @@ export namespace BriefProfile {
     fullName: string,
     countryCode: string,
     avatarUrl: string,
+    provisionalVariantsList: Array<string>,
   }
 }
 
@@ -17,6 +17,8 @@ var global = Function('return this')();
 
 var api_proto_ipc_chat_pb = require('../../../api/proto/ipc/chat_pb.js');
 goog.object.extend(proto, api_proto_ipc_chat_pb);
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
+goog.object.extend(proto, google_protobuf_timestamp_pb);
 goog.exportSymbol('proto.user_service.ActiveChatChannels', null, global);
 goog.exportSymbol('proto.user_service.ActiveChatChannels.Channel', null, global);
 goog.exportSymbol('proto.user_service.AddBlockRequest', null, global);
@@ -29,6 +31,8 @@ goog.exportSymbol('proto.user_service.BriefProfilesResponse', null, global);
 goog.exportSymbol('proto.user_service.ChangePasswordRequest', null, global);
 goog.exportSymbol('proto.user_service.ChangePasswordResponse', null, global);
 goog.exportSymbol('proto.user_service.CountryFlag', null, global);
+goog.exportSymbol('proto.user_service.ExpectedScoreRequest', null, global);
+goog.exportSymbol('proto.user_service.ExpectedScoreResponse', null, global);
 goog.exportSymbol('proto.user_service.GetActiveChatChannelsRequest', null, global);
 goog.exportSymbol('proto.user_service.GetBlocksRequest', null, global);
 goog.exportSymbol('proto.user_service.GetBlocksResponse', null, global);
@@ -40,6 +44,9 @@ goog.exportSymbol('proto.user_service.GetFullBlocksResponse', null, global);
 goog.exportSymbol('proto.user_service.GetModListRequest', null, global);
 goog.exportSymbol('proto.user_service.GetModListResponse', null, global);
 goog.exportSymbol('proto.user_service.KickstarterBadge', null, global);
+goog.exportSymbol('proto.user_service.LeaderboardEntry', null, global);
+goog.exportSymbol('proto.user_service.LeaderboardRequest', null, global);
+goog.exportSymbol('proto.user_service.LeaderboardResponse', null, global);
 goog.exportSymbol('proto.user_service.LoginResponse', null, global);
 goog.exportSymbol('proto.user_service.LogoutResponse', null, global);
 goog.exportSymbol('proto.user_service.NotifyAccountClosureRequest', null, global);
@@ -49,6 +56,9 @@ goog.exportSymbol('proto.user_service.PersonalInfoRequest', null, global);
 goog.exportSymbol('proto.user_service.PersonalInfoResponse', null, global);
 goog.exportSymbol('proto.user_service.ProfileRequest', null, global);
 goog.exportSymbol('proto.user_service.ProfileResponse', null, global);
+goog.exportSymbol('proto.user_service.RatingHistoryEntry', null, global);
+goog.exportSymbol('proto.user_service.RatingHistoryRequest', null, global);
+goog.exportSymbol('proto.user_service.RatingHistoryResponse', null, global);
 goog.exportSymbol('proto.user_service.RatingsRequest', null, global);
 goog.exportSymbol('proto.user_service.RatingsResponse', null, global);
 goog.exportSymbol('proto.user_service.RegistrationResponse', null, global);
@@ -493,6 +503,174 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.user_service.RatingsResponse.displayName = 'proto.user_service.RatingsResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.user_service.RatingHistoryRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.user_service.RatingHistoryRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.user_service.RatingHistoryRequest.displayName = 'proto.user_service.RatingHistoryRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.user_service.RatingHistoryEntry = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.user_service.RatingHistoryEntry, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.user_service.RatingHistoryEntry.displayName = 'proto.user_service.RatingHistoryEntry';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.user_service.RatingHistoryResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.user_service.RatingHistoryResponse.repeatedFields_, null);
+};
+goog.inherits(proto.user_service.RatingHistoryResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.user_service.RatingHistoryResponse.displayName = 'proto.user_service.RatingHistoryResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.user_service.ExpectedScoreRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.user_service.ExpectedScoreRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.user_service.ExpectedScoreRequest.displayName = 'proto.user_service.ExpectedScoreRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.user_service.ExpectedScoreResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.user_service.ExpectedScoreResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.user_service.ExpectedScoreResponse.displayName = 'proto.user_service.ExpectedScoreResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.user_service.LeaderboardRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.user_service.LeaderboardRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.user_service.LeaderboardRequest.displayName = 'proto.user_service.LeaderboardRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.user_service.LeaderboardEntry = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.user_service.LeaderboardEntry, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.user_service.LeaderboardEntry.displayName = 'proto.user_service.LeaderboardEntry';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.user_service.LeaderboardResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.user_service.LeaderboardResponse.repeatedFields_, null);
+};
+goog.inherits(proto.user_service.LeaderboardResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.user_service.LeaderboardResponse.displayName = 'proto.user_service.LeaderboardResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -777,7 +955,7 @@ if (goog.DEBUG && !COMPILED) {
  * @constructor
  */
 proto.user_service.BriefProfile = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.user_service.BriefProfile.repeatedFields_, null);
 };
 goog.inherits(proto.user_service.BriefProfile, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
@@ -4342,8 +4520,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.user_service.StatsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.user_service.StatsRequest.toObject(opt_includeInstance, this);
+proto.user_service.RatingHistoryRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.user_service.RatingHistoryRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -4352,13 +4530,17 @@ proto.user_service.StatsRequest.prototype.toObject = function(opt_includeInstanc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.user_service.StatsRequest} msg The msg instance to transform.
+ * @param {!proto.user_service.RatingHistoryRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.user_service.StatsRequest.toObject = function(includeInstance, msg) {
+proto.user_service.RatingHistoryRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    username: jspb.Message.getFieldWithDefault(msg, 1, "")
+    username: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    variant: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    begin: (f = msg.getBegin()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    end: (f = msg.getEnd()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    maxPoints: jspb.Message.getFieldWithDefault(msg, 5, 0)
   };
 
   if (includeInstance) {
@@ -4372,23 +4554,23 @@ proto.user_service.StatsRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.user_service.StatsRequest}
+ * @return {!proto.user_service.RatingHistoryRequest}
  */
-proto.user_service.StatsRequest.deserializeBinary = function(bytes) {
+proto.user_service.RatingHistoryRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.user_service.StatsRequest;
-  return proto.user_service.StatsRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.user_service.RatingHistoryRequest;
+  return proto.user_service.RatingHistoryRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.user_service.StatsRequest} msg The message object to deserialize into.
+ * @param {!proto.user_service.RatingHistoryRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.user_service.StatsRequest}
+ * @return {!proto.user_service.RatingHistoryRequest}
  */
-proto.user_service.StatsRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.user_service.RatingHistoryRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4399,6 +4581,24 @@ proto.user_service.StatsRequest.deserializeBinaryFromReader = function(msg, read
       var value = /** @type {string} */ (reader.readString());
       msg.setUsername(value);
       break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setVariant(value);
+      break;
+    case 3:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setBegin(value);
+      break;
+    case 4:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setEnd(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxPoints(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -4412,9 +4612,9 @@ proto.user_service.StatsRequest.deserializeBinaryFromReader = function(msg, read
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.user_service.StatsRequest.prototype.serializeBinary = function() {
+proto.user_service.RatingHistoryRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.user_service.StatsRequest.serializeBinaryToWriter(this, writer);
+  proto.user_service.RatingHistoryRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4422,11 +4622,11 @@ proto.user_service.StatsRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.user_service.StatsRequest} message
+ * @param {!proto.user_service.RatingHistoryRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.user_service.StatsRequest.serializeBinaryToWriter = function(message, writer) {
+proto.user_service.RatingHistoryRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getUsername();
   if (f.length > 0) {
@@ -4435,6 +4635,36 @@ proto.user_service.StatsRequest.serializeBinaryToWriter = function(message, writ
       f
     );
   }
+  f = message.getVariant();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getBegin();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getEnd();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getMaxPoints();
+  if (f !== 0) {
+    writer.writeInt32(
+      5,
+      f
+    );
+  }
 };
 
 
@@ -4442,85 +4672,2018 @@ proto.user_service.StatsRequest.serializeBinaryToWriter = function(message, writ
  * optional string username = 1;
  * @return {string}
  */
-proto.user_service.StatsRequest.prototype.getUsername = function() {
+proto.user_service.RatingHistoryRequest.prototype.getUsername = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.user_service.StatsRequest} returns this
+ * @return {!proto.user_service.RatingHistoryRequest} returns this
  */
-proto.user_service.StatsRequest.prototype.setUsername = function(value) {
+proto.user_service.RatingHistoryRequest.prototype.setUsername = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional string variant = 2;
+ * @return {string}
+ */
+proto.user_service.RatingHistoryRequest.prototype.getVariant = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {string} value
+ * @return {!proto.user_service.RatingHistoryRequest} returns this
  */
-proto.user_service.StatsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.user_service.StatsResponse.toObject(opt_includeInstance, this);
+proto.user_service.RatingHistoryRequest.prototype.setVariant = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.user_service.StatsResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional google.protobuf.Timestamp begin = 3;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.user_service.StatsResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    json: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
+proto.user_service.RatingHistoryRequest.prototype.getBegin = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
+};
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.user_service.RatingHistoryRequest} returns this
+*/
+proto.user_service.RatingHistoryRequest.prototype.setBegin = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.user_service.StatsResponse}
+ * Clears the message field making it undefined.
+ * @return {!proto.user_service.RatingHistoryRequest} returns this
  */
-proto.user_service.StatsResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.user_service.StatsResponse;
-  return proto.user_service.StatsResponse.deserializeBinaryFromReader(msg, reader);
+proto.user_service.RatingHistoryRequest.prototype.clearBegin = function() {
+  return this.setBegin(undefined);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.user_service.StatsResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.user_service.StatsResponse}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.user_service.StatsResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
+proto.user_service.RatingHistoryRequest.prototype.hasBegin = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional google.protobuf.Timestamp end = 4;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.user_service.RatingHistoryRequest.prototype.getEnd = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 4));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.user_service.RatingHistoryRequest} returns this
+*/
+proto.user_service.RatingHistoryRequest.prototype.setEnd = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.user_service.RatingHistoryRequest} returns this
+ */
+proto.user_service.RatingHistoryRequest.prototype.clearEnd = function() {
+  return this.setEnd(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.user_service.RatingHistoryRequest.prototype.hasEnd = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional int32 max_points = 5;
+ * @return {number}
+ */
+proto.user_service.RatingHistoryRequest.prototype.getMaxPoints = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.user_service.RatingHistoryRequest} returns this
+ */
+proto.user_service.RatingHistoryRequest.prototype.setMaxPoints = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.user_service.RatingHistoryEntry.prototype.toObject = function(opt_includeInstance) {
+  return proto.user_service.RatingHistoryEntry.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.user_service.RatingHistoryEntry} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.user_service.RatingHistoryEntry.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    rating: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0),
+    ratingDeviation: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0),
+    volatility: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
+    gameId: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    createdAt: (f = msg.getCreatedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.user_service.RatingHistoryEntry}
+ */
+proto.user_service.RatingHistoryEntry.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.user_service.RatingHistoryEntry;
+  return proto.user_service.RatingHistoryEntry.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.user_service.RatingHistoryEntry} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.user_service.RatingHistoryEntry}
+ */
+proto.user_service.RatingHistoryEntry.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setRating(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setRatingDeviation(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setVolatility(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setGameId(value);
+      break;
+    case 5:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCreatedAt(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.user_service.RatingHistoryEntry.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.user_service.RatingHistoryEntry.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.user_service.RatingHistoryEntry} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.user_service.RatingHistoryEntry.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRating();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      1,
+      f
+    );
+  }
+  f = message.getRatingDeviation();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      2,
+      f
+    );
+  }
+  f = message.getVolatility();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      3,
+      f
+    );
+  }
+  f = message.getGameId();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getCreatedAt();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional double rating = 1;
+ * @return {number}
+ */
+proto.user_service.RatingHistoryEntry.prototype.getRating = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 1, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.user_service.RatingHistoryEntry} returns this
+ */
+proto.user_service.RatingHistoryEntry.prototype.setRating = function(value) {
+  return jspb.Message.setProto3FloatField(this, 1, value);
+};
+
+
+/**
+ * optional double rating_deviation = 2;
+ * @return {number}
+ */
+proto.user_service.RatingHistoryEntry.prototype.getRatingDeviation = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.user_service.RatingHistoryEntry} returns this
+ */
+proto.user_service.RatingHistoryEntry.prototype.setRatingDeviation = function(value) {
+  return jspb.Message.setProto3FloatField(this, 2, value);
+};
+
+
+/**
+ * optional double volatility = 3;
+ * @return {number}
+ */
+proto.user_service.RatingHistoryEntry.prototype.getVolatility = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.user_service.RatingHistoryEntry} returns this
+ */
+proto.user_service.RatingHistoryEntry.prototype.setVolatility = function(value) {
+  return jspb.Message.setProto3FloatField(this, 3, value);
+};
+
+
+/**
+ * optional string game_id = 4;
+ * @return {string}
+ */
+proto.user_service.RatingHistoryEntry.prototype.getGameId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.user_service.RatingHistoryEntry} returns this
+ */
+proto.user_service.RatingHistoryEntry.prototype.setGameId = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp created_at = 5;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.user_service.RatingHistoryEntry.prototype.getCreatedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 5));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.user_service.RatingHistoryEntry} returns this
+*/
+proto.user_service.RatingHistoryEntry.prototype.setCreatedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.user_service.RatingHistoryEntry} returns this
+ */
+proto.user_service.RatingHistoryEntry.prototype.clearCreatedAt = function() {
+  return this.setCreatedAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.user_service.RatingHistoryEntry.prototype.hasCreatedAt = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.user_service.RatingHistoryResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.user_service.RatingHistoryResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.user_service.RatingHistoryResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.user_service.RatingHistoryResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.user_service.RatingHistoryResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    entriesList: jspb.Message.toObjectList(msg.getEntriesList(),
+    proto.user_service.RatingHistoryEntry.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.user_service.RatingHistoryResponse}
+ */
+proto.user_service.RatingHistoryResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.user_service.RatingHistoryResponse;
+  return proto.user_service.RatingHistoryResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.user_service.RatingHistoryResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.user_service.RatingHistoryResponse}
+ */
+proto.user_service.RatingHistoryResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.user_service.RatingHistoryEntry;
+      reader.readMessage(value,proto.user_service.RatingHistoryEntry.deserializeBinaryFromReader);
+      msg.addEntries(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.user_service.RatingHistoryResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.user_service.RatingHistoryResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.user_service.RatingHistoryResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.user_service.RatingHistoryResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEntriesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.user_service.RatingHistoryEntry.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated RatingHistoryEntry entries = 1;
+ * @return {!Array<!proto.user_service.RatingHistoryEntry>}
+ */
+proto.user_service.RatingHistoryResponse.prototype.getEntriesList = function() {
+  return /** @type{!Array<!proto.user_service.RatingHistoryEntry>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.user_service.RatingHistoryEntry, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.user_service.RatingHistoryEntry>} value
+ * @return {!proto.user_service.RatingHistoryResponse} returns this
+*/
+proto.user_service.RatingHistoryResponse.prototype.setEntriesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.user_service.RatingHistoryEntry=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.user_service.RatingHistoryEntry}
+ */
+proto.user_service.RatingHistoryResponse.prototype.addEntries = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.user_service.RatingHistoryEntry, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.user_service.RatingHistoryResponse} returns this
+ */
+proto.user_service.RatingHistoryResponse.prototype.clearEntriesList = function() {
+  return this.setEntriesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.user_service.ExpectedScoreRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.user_service.ExpectedScoreRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.user_service.ExpectedScoreRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.user_service.ExpectedScoreRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    username: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    opponentUsername: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    variant: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.user_service.ExpectedScoreRequest}
+ */
+proto.user_service.ExpectedScoreRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.user_service.ExpectedScoreRequest;
+  return proto.user_service.ExpectedScoreRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.user_service.ExpectedScoreRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.user_service.ExpectedScoreRequest}
+ */
+proto.user_service.ExpectedScoreRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUsername(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOpponentUsername(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setVariant(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.user_service.ExpectedScoreRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.user_service.ExpectedScoreRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.user_service.ExpectedScoreRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.user_service.ExpectedScoreRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUsername();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getOpponentUsername();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getVariant();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string username = 1;
+ * @return {string}
+ */
+proto.user_service.ExpectedScoreRequest.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.user_service.ExpectedScoreRequest} returns this
+ */
+proto.user_service.ExpectedScoreRequest.prototype.setUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string opponent_username = 2;
+ * @return {string}
+ */
+proto.user_service.ExpectedScoreRequest.prototype.getOpponentUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.user_service.ExpectedScoreRequest} returns this
+ */
+proto.user_service.ExpectedScoreRequest.prototype.setOpponentUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string variant = 3;
+ * @return {string}
+ */
+proto.user_service.ExpectedScoreRequest.prototype.getVariant = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.user_service.ExpectedScoreRequest} returns this
+ */
+proto.user_service.ExpectedScoreRequest.prototype.setVariant = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.user_service.ExpectedScoreResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.user_service.ExpectedScoreResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.user_service.ExpectedScoreResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.user_service.ExpectedScoreResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    winProbability: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0),
+    expectedSpread: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0),
+    rating: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
+    opponentRating: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.user_service.ExpectedScoreResponse}
+ */
+proto.user_service.ExpectedScoreResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.user_service.ExpectedScoreResponse;
+  return proto.user_service.ExpectedScoreResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.user_service.ExpectedScoreResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.user_service.ExpectedScoreResponse}
+ */
+proto.user_service.ExpectedScoreResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setWinProbability(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setExpectedSpread(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setRating(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setOpponentRating(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.user_service.ExpectedScoreResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.user_service.ExpectedScoreResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.user_service.ExpectedScoreResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.user_service.ExpectedScoreResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getWinProbability();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      1,
+      f
+    );
+  }
+  f = message.getExpectedSpread();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      2,
+      f
+    );
+  }
+  f = message.getRating();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      3,
+      f
+    );
+  }
+  f = message.getOpponentRating();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional double win_probability = 1;
+ * @return {number}
+ */
+proto.user_service.ExpectedScoreResponse.prototype.getWinProbability = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 1, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.user_service.ExpectedScoreResponse} returns this
+ */
+proto.user_service.ExpectedScoreResponse.prototype.setWinProbability = function(value) {
+  return jspb.Message.setProto3FloatField(this, 1, value);
+};
+
+
+/**
+ * optional double expected_spread = 2;
+ * @return {number}
+ */
+proto.user_service.ExpectedScoreResponse.prototype.getExpectedSpread = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.user_service.ExpectedScoreResponse} returns this
+ */
+proto.user_service.ExpectedScoreResponse.prototype.setExpectedSpread = function(value) {
+  return jspb.Message.setProto3FloatField(this, 2, value);
+};
+
+
+/**
+ * optional double rating = 3;
+ * @return {number}
+ */
+proto.user_service.ExpectedScoreResponse.prototype.getRating = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.user_service.ExpectedScoreResponse} returns this
+ */
+proto.user_service.ExpectedScoreResponse.prototype.setRating = function(value) {
+  return jspb.Message.setProto3FloatField(this, 3, value);
+};
+
+
+/**
+ * optional double opponent_rating = 4;
+ * @return {number}
+ */
+proto.user_service.ExpectedScoreResponse.prototype.getOpponentRating = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 4, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.user_service.ExpectedScoreResponse} returns this
+ */
+proto.user_service.ExpectedScoreResponse.prototype.setOpponentRating = function(value) {
+  return jspb.Message.setProto3FloatField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.user_service.LeaderboardRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.user_service.LeaderboardRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.user_service.LeaderboardRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.user_service.LeaderboardRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    variant: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    limit: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    countryCode: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    activeDays: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    includeProvisional: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    offset: jspb.Message.getFieldWithDefault(msg, 6, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.user_service.LeaderboardRequest}
+ */
+proto.user_service.LeaderboardRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.user_service.LeaderboardRequest;
+  return proto.user_service.LeaderboardRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.user_service.LeaderboardRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.user_service.LeaderboardRequest}
+ */
+proto.user_service.LeaderboardRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setVariant(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setLimit(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCountryCode(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setActiveDays(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIncludeProvisional(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setOffset(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.user_service.LeaderboardRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.user_service.LeaderboardRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.user_service.LeaderboardRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.user_service.LeaderboardRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getVariant();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getLimit();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getCountryCode();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getActiveDays();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+  f = message.getIncludeProvisional();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = message.getOffset();
+  if (f !== 0) {
+    writer.writeInt32(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string variant = 1;
+ * @return {string}
+ */
+proto.user_service.LeaderboardRequest.prototype.getVariant = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.user_service.LeaderboardRequest} returns this
+ */
+proto.user_service.LeaderboardRequest.prototype.setVariant = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int32 limit = 2;
+ * @return {number}
+ */
+proto.user_service.LeaderboardRequest.prototype.getLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.user_service.LeaderboardRequest} returns this
+ */
+proto.user_service.LeaderboardRequest.prototype.setLimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string country_code = 3;
+ * @return {string}
+ */
+proto.user_service.LeaderboardRequest.prototype.getCountryCode = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.user_service.LeaderboardRequest} returns this
+ */
+proto.user_service.LeaderboardRequest.prototype.setCountryCode = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional int32 active_days = 4;
+ * @return {number}
+ */
+proto.user_service.LeaderboardRequest.prototype.getActiveDays = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.user_service.LeaderboardRequest} returns this
+ */
+proto.user_service.LeaderboardRequest.prototype.setActiveDays = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional bool include_provisional = 5;
+ * @return {boolean}
+ */
+proto.user_service.LeaderboardRequest.prototype.getIncludeProvisional = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.user_service.LeaderboardRequest} returns this
+ */
+proto.user_service.LeaderboardRequest.prototype.setIncludeProvisional = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
+/**
+ * optional int32 offset = 6;
+ * @return {number}
+ */
+proto.user_service.LeaderboardRequest.prototype.getOffset = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.user_service.LeaderboardRequest} returns this
+ */
+proto.user_service.LeaderboardRequest.prototype.setOffset = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.user_service.LeaderboardEntry.prototype.toObject = function(opt_includeInstance) {
+  return proto.user_service.LeaderboardEntry.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.user_service.LeaderboardEntry} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.user_service.LeaderboardEntry.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    rank: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    userId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    username: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    countryCode: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    rating: jspb.Message.getFloatingPointFieldWithDefault(msg, 5, 0.0),
+    ratingDeviation: jspb.Message.getFloatingPointFieldWithDefault(msg, 6, 0.0),
+    lastGame: (f = msg.getLastGame()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    provisional: jspb.Message.getBooleanFieldWithDefault(msg, 8, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.user_service.LeaderboardEntry}
+ */
+proto.user_service.LeaderboardEntry.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.user_service.LeaderboardEntry;
+  return proto.user_service.LeaderboardEntry.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.user_service.LeaderboardEntry} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.user_service.LeaderboardEntry}
+ */
+proto.user_service.LeaderboardEntry.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRank(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUserId(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUsername(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCountryCode(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setRating(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setRatingDeviation(value);
+      break;
+    case 7:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setLastGame(value);
+      break;
+    case 8:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setProvisional(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.user_service.LeaderboardEntry.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.user_service.LeaderboardEntry.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.user_service.LeaderboardEntry} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.user_service.LeaderboardEntry.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRank();
+  if (f !== 0) {
+    writer.writeInt32(
+      1,
+      f
+    );
+  }
+  f = message.getUserId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getUsername();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getCountryCode();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getRating();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      5,
+      f
+    );
+  }
+  f = message.getRatingDeviation();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      6,
+      f
+    );
+  }
+  f = message.getLastGame();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getProvisional();
+  if (f) {
+    writer.writeBool(
+      8,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int32 rank = 1;
+ * @return {number}
+ */
+proto.user_service.LeaderboardEntry.prototype.getRank = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.user_service.LeaderboardEntry} returns this
+ */
+proto.user_service.LeaderboardEntry.prototype.setRank = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional string user_id = 2;
+ * @return {string}
+ */
+proto.user_service.LeaderboardEntry.prototype.getUserId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.user_service.LeaderboardEntry} returns this
+ */
+proto.user_service.LeaderboardEntry.prototype.setUserId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string username = 3;
+ * @return {string}
+ */
+proto.user_service.LeaderboardEntry.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.user_service.LeaderboardEntry} returns this
+ */
+proto.user_service.LeaderboardEntry.prototype.setUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string country_code = 4;
+ * @return {string}
+ */
+proto.user_service.LeaderboardEntry.prototype.getCountryCode = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.user_service.LeaderboardEntry} returns this
+ */
+proto.user_service.LeaderboardEntry.prototype.setCountryCode = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional double rating = 5;
+ * @return {number}
+ */
+proto.user_service.LeaderboardEntry.prototype.getRating = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 5, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.user_service.LeaderboardEntry} returns this
+ */
+proto.user_service.LeaderboardEntry.prototype.setRating = function(value) {
+  return jspb.Message.setProto3FloatField(this, 5, value);
+};
+
+
+/**
+ * optional double rating_deviation = 6;
+ * @return {number}
+ */
+proto.user_service.LeaderboardEntry.prototype.getRatingDeviation = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 6, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.user_service.LeaderboardEntry} returns this
+ */
+proto.user_service.LeaderboardEntry.prototype.setRatingDeviation = function(value) {
+  return jspb.Message.setProto3FloatField(this, 6, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp last_game = 7;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.user_service.LeaderboardEntry.prototype.getLastGame = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 7));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.user_service.LeaderboardEntry} returns this
+*/
+proto.user_service.LeaderboardEntry.prototype.setLastGame = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.user_service.LeaderboardEntry} returns this
+ */
+proto.user_service.LeaderboardEntry.prototype.clearLastGame = function() {
+  return this.setLastGame(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.user_service.LeaderboardEntry.prototype.hasLastGame = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional bool provisional = 8;
+ * @return {boolean}
+ */
+proto.user_service.LeaderboardEntry.prototype.getProvisional = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.user_service.LeaderboardEntry} returns this
+ */
+proto.user_service.LeaderboardEntry.prototype.setProvisional = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 8, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.user_service.LeaderboardResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.user_service.LeaderboardResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.user_service.LeaderboardResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.user_service.LeaderboardResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.user_service.LeaderboardResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    entriesList: jspb.Message.toObjectList(msg.getEntriesList(),
+    proto.user_service.LeaderboardEntry.toObject, includeInstance),
+    updatedAt: (f = msg.getUpdatedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.user_service.LeaderboardResponse}
+ */
+proto.user_service.LeaderboardResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.user_service.LeaderboardResponse;
+  return proto.user_service.LeaderboardResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.user_service.LeaderboardResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.user_service.LeaderboardResponse}
+ */
+proto.user_service.LeaderboardResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.user_service.LeaderboardEntry;
+      reader.readMessage(value,proto.user_service.LeaderboardEntry.deserializeBinaryFromReader);
+      msg.addEntries(value);
+      break;
+    case 2:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setUpdatedAt(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.user_service.LeaderboardResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.user_service.LeaderboardResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.user_service.LeaderboardResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.user_service.LeaderboardResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEntriesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.user_service.LeaderboardEntry.serializeBinaryToWriter
+    );
+  }
+  f = message.getUpdatedAt();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated LeaderboardEntry entries = 1;
+ * @return {!Array<!proto.user_service.LeaderboardEntry>}
+ */
+proto.user_service.LeaderboardResponse.prototype.getEntriesList = function() {
+  return /** @type{!Array<!proto.user_service.LeaderboardEntry>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.user_service.LeaderboardEntry, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.user_service.LeaderboardEntry>} value
+ * @return {!proto.user_service.LeaderboardResponse} returns this
+*/
+proto.user_service.LeaderboardResponse.prototype.setEntriesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.user_service.LeaderboardEntry=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.user_service.LeaderboardEntry}
+ */
+proto.user_service.LeaderboardResponse.prototype.addEntries = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.user_service.LeaderboardEntry, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.user_service.LeaderboardResponse} returns this
+ */
+proto.user_service.LeaderboardResponse.prototype.clearEntriesList = function() {
+  return this.setEntriesList([]);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp updated_at = 2;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.user_service.LeaderboardResponse.prototype.getUpdatedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.user_service.LeaderboardResponse} returns this
+*/
+proto.user_service.LeaderboardResponse.prototype.setUpdatedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.user_service.LeaderboardResponse} returns this
+ */
+proto.user_service.LeaderboardResponse.prototype.clearUpdatedAt = function() {
+  return this.setUpdatedAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.user_service.LeaderboardResponse.prototype.hasUpdatedAt = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.user_service.StatsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.user_service.StatsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.user_service.StatsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.user_service.StatsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    username: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.user_service.StatsRequest}
+ */
+proto.user_service.StatsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.user_service.StatsRequest;
+  return proto.user_service.StatsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.user_service.StatsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.user_service.StatsRequest}
+ */
+proto.user_service.StatsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUsername(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.user_service.StatsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.user_service.StatsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.user_service.StatsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.user_service.StatsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUsername();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string username = 1;
+ * @return {string}
+ */
+proto.user_service.StatsRequest.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.user_service.StatsRequest} returns this
+ */
+proto.user_service.StatsRequest.prototype.setUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.user_service.StatsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.user_service.StatsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.user_service.StatsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.user_service.StatsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    json: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.user_service.StatsResponse}
+ */
+proto.user_service.StatsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.user_service.StatsResponse;
+  return proto.user_service.StatsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.user_service.StatsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.user_service.StatsResponse}
+ */
+proto.user_service.StatsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
@@ -6701,6 +8864,13 @@ proto.user_service.BriefProfilesRequest.prototype.clearUserIdsList = function()
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.user_service.BriefProfile.repeatedFields_ = [10];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -6735,7 +8905,8 @@ proto.user_service.BriefProfile.toObject = function(includeInstance, msg) {
     username: jspb.Message.getFieldWithDefault(msg, 1, ""),
     fullName: jspb.Message.getFieldWithDefault(msg, 2, ""),
     countryCode: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    avatarUrl: jspb.Message.getFieldWithDefault(msg, 9, "")
+    avatarUrl: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    provisionalVariantsList: (f = jspb.Message.getRepeatedField(msg, 10)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -6788,6 +8959,10 @@ proto.user_service.BriefProfile.deserializeBinaryFromReader = function(msg, read
       var value = /** @type {string} */ (reader.readString());
       msg.setAvatarUrl(value);
       break;
+    case 10:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addProvisionalVariants(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -6845,6 +9020,13 @@ proto.user_service.BriefProfile.serializeBinaryToWriter = function(message, writ
       f
     );
   }
+  f = message.getProvisionalVariantsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      10,
+      f
+    );
+  }
 };
 
 
@@ -6920,6 +9102,43 @@ proto.user_service.BriefProfile.prototype.setAvatarUrl = function(value) {
 };
 
 
+/**
+ * repeated string provisional_variants = 10;
+ * @return {!Array<string>}
+ */
+proto.user_service.BriefProfile.prototype.getProvisionalVariantsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 10));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.user_service.BriefProfile} returns this
+ */
+proto.user_service.BriefProfile.prototype.setProvisionalVariantsList = function(value) {
+  return jspb.Message.setField(this, 10, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.user_service.BriefProfile} returns this
+ */
+proto.user_service.BriefProfile.prototype.addProvisionalVariants = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 10, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.user_service.BriefProfile} returns this
+ */
+proto.user_service.BriefProfile.prototype.clearProvisionalVariantsList = function() {
+  return this.setProvisionalVariantsList([]);
+};
+
+
 
 
 
@@ -402,4 +402,80 @@ export const errorMap: Map<number, string> = new Map<number, string>([
     1087,
     'Internal error: cound not find puzzle attempt for user $1 and puzzle $2.',
   ],
+
+  // More tournament errors
+  [
+    1088,
+    'Division $2 has $3 players and $4 rounds. A double elimination division needs a power of two players, two rounds for every doubling of the players, and one more round for a grand final reset if there is one.',
+  ],
+  [1089, 'Division $2 does not have a bracket.'],
+  [
+    1090,
+    'The no-show grace period for division $2 round $3 cannot be negative.',
+  ],
+  [1091, 'Registration for division $2 is closed.'],
+  [1092, 'The registration deadline for division $2 has passed.'],
+  [1093, '$3 is already registered for this event.'],
+  [1094, 'A rating of $3 does not meet the requirements of division $2.'],
+  [1095, '$3 is not registered for division $2.'],
+  [
+    1096,
+    'The rating floor of division $2 ($3) cannot be above its rating ceiling ($4).',
+  ],
+  [1097, 'The maximum number of players for division $2 cannot be negative.'],
+  [1098, 'Check-in for division $2 opens at $3.'],
+  [1099, 'Check-in for division $2 is closed.'],
+  [1100, 'The check-in window for division $2 cannot be negative.'],
+  [
+    1101,
+    'Only Swiss and factor pairings can pair by prize contention (round $3).',
+  ],
+  [
+    1102,
+    'Round $3 cannot have a pairing lag of $4. Only Swiss, factor and king of the hill pairings can lag.',
+  ],
+  [1103, 'The number of catch-up byes for division $2 cannot be negative.'],
+  [
+    1104,
+    'Players can only be promoted and relegated once session $1 is finished.',
+  ],
+  [
+    1105,
+    'Players can only be promoted and relegated into another session of the same club that has not started.',
+  ],
+  [1106, 'The number of players promoted and relegated cannot be negative.'],
+  [1107, '$1 is not a ladder.'],
+  [
+    1108,
+    'Ladder players must be able to challenge at least one place up and have time to accept challenges.',
+  ],
+  [1109, 'Ladder $1 has no default game settings.'],
+  [
+    1110,
+    '$3 can only challenge players ranked up to $4 places above them.',
+  ],
+  [1111, '$3 is already in a ladder challenge.'],
+  [1112, 'The ladder challenge was not found.'],
+  [
+    1113,
+    'Round $3 can only be accelerated if it is paired with Swiss.',
+  ],
+  [
+    1114,
+    'Whether the division is rated as one rating period cannot be changed after it has started.',
+  ],
+  [1115, 'The division is not rated as one rating period.'],
+  [1116, '$1 is not a club.'],
+  [1117, '$4 is not a member of $3, and only members can play in its sessions.'],
+  [1118, '$3 is not a member of $1.'],
+  [
+    1119,
+    'Club sessions must be scheduled on a day of the week, at a valid time in a valid time zone, and created at most a week ahead.',
+  ],
+  [1120, 'Every division of the session template needs a different name.'],
+  [
+    1121,
+    'Prizes cannot have negative amounts, place and class prizes need a place, and class rating bands cannot be empty.',
+  ],
+  [1122, 'Division $2 can only be listed once.'],
 ]);
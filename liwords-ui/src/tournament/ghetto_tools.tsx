@@ -1171,6 +1171,12 @@ const SingleRoundControlFields = (props: SingleRdCtrlFieldsProps) => {
           <Select.Option value={PairingMethod.TEAM_ROUND_ROBIN}>
             Team Round Robin
           </Select.Option>
+          <Select.Option value={PairingMethod.SEEDED_SPLIT}>
+            Seeded Split
+          </Select.Option>
+          <Select.Option value={PairingMethod.SEEDED_FOLD}>
+            Seeded Fold
+          </Select.Option>
         </Select>
       </Form.Item>
       <p></p>
@@ -49,7 +49,9 @@ export const fieldsForMethod = (
     PairingMethod.ROUND_ROBIN,
     PairingMethod.KING_OF_THE_HILL,
     PairingMethod.MANUAL,
-    PairingMethod.INITIAL_FONTES):
+    PairingMethod.INITIAL_FONTES,
+    PairingMethod.SEEDED_SPLIT,
+    PairingMethod.SEEDED_FOLD):
       return [];
     // @ts-expect-error fallthrough is purposeful:
     case PairingMethod.FACTOR:
@@ -35,15 +35,19 @@ import (
 const (
 	MaxMessageLength = 500
 
-	AdjudicateInterval   = 10 * time.Second
-	GamesCounterInterval = 60 * time.Minute
-	SeeksExpireInterval  = 10 * time.Minute
+	AdjudicateInterval    = 10 * time.Second
+	GamesCounterInterval  = 60 * time.Minute
+	SeeksExpireInterval   = 10 * time.Minute
+	RoundScheduleInterval = 10 * time.Second
+	QuickpairInterval     = 5 * time.Second
+	RatingDecayInterval   = 24 * time.Hour
 	// Cancel a game if it hasn't started after this much time.
 	CancelAfter = 60 * time.Second
 )
 
 const (
-	BotRequestID = "bot-request"
+	BotRequestID       = "bot-request"
+	QuickpairRequestID = "quickpair-request"
 )
 
 type Stores struct {
@@ -143,6 +147,16 @@ func NewBus(cfg *config.Config, stores Stores, redisPool *redis.Pool) (*Bus, err
 		bus.subscriptions = append(bus.subscriptions, sub)
 		bus.subchans[topic] = ch
 	}
+
+	// Every node caches users, so they all need to hear about ratings
+	// that were replaced.
+	ch := make(chan *nats.Msg, 64)
+	sub, err := natsconn.ChanSubscribe(RatingsReplacedTopic, ch)
+	if err != nil {
+		return nil, err
+	}
+	bus.subscriptions = append(bus.subscriptions, sub)
+	bus.subchans[RatingsReplacedTopic] = ch
 	return bus, nil
 }
 
@@ -163,6 +177,20 @@ func (b *Bus) ProcessMessages(ctx context.Context) {
 	seekExpirer := time.NewTicker(SeeksExpireInterval)
 	defer seekExpirer.Stop()
 
+	// Start scheduled tournament rounds and forfeit no-shows.
+	roundScheduler := time.NewTicker(RoundScheduleInterval)
+	defer roundScheduler.Stop()
+
+	// Pair the players waiting in the quickpair queue.
+	quickpairer := time.NewTicker(QuickpairInterval)
+	defer quickpairer.Stop()
+
+	// Mark the ratings of players who stopped playing as provisional. Do it
+	// once right away too, since nodes may restart more often than that.
+	ratingDecayer := time.NewTicker(RatingDecayInterval)
+	defer ratingDecayer.Stop()
+	go b.updateProvisionalRatings(ctx)
+
 outerfor:
 	for {
 		select {
@@ -212,6 +240,9 @@ outerfor:
 				}
 			}()
 
+		case msg := <-b.subchans[RatingsReplacedTopic]:
+			b.ratingsReplaced(ctx, msg.Data)
+
 		case msg := <-b.gameEventChan:
 			if msg.Type == pb.MessageType_ACTIVE_GAME_ENTRY {
 				// This message usually has no audience.
@@ -324,6 +355,25 @@ outerfor:
 			if err != nil {
 				log.Err(err).Msg("expiration-error")
 			}
+
+		case <-roundScheduler.C:
+			go func() {
+				err := b.runScheduledRounds(ctx)
+				if err != nil {
+					log.Err(err).Msg("round-schedule-error")
+				}
+			}()
+
+		case <-quickpairer.C:
+			go func() {
+				err := b.matchQuickpairs(ctx)
+				if err != nil {
+					log.Err(err).Msg("quickpair-error")
+				}
+			}()
+
+		case <-ratingDecayer.C:
+			go b.updateProvisionalRatings(ctx)
 		}
 	}
 
@@ -453,6 +503,15 @@ func (b *Bus) handleNatsPublish(ctx context.Context, subtopics []string, data []
 	case pb.MessageType_SEEK_REQUEST.String():
 		log.Debug().Str("user", userID).Msg("seek-request")
 		return b.seekRequest(ctx, auth, userID, wsConnID, data)
+	case pb.MessageType_QUICKPAIR_REQUEST.String():
+		log.Debug().Str("user", userID).Msg("quickpair-request")
+		return b.quickpairRequest(ctx, auth, userID, wsConnID, data)
+	case pb.MessageType_LADDER_CHALLENGE_ACCEPT.String():
+		log.Debug().Str("user", userID).Msg("ladder-challenge-accept")
+		return b.ladderChallengeAccept(ctx, auth, userID, wsConnID, data)
+	case pb.MessageType_LADDER_CHALLENGE_DECLINE.String():
+		log.Debug().Str("user", userID).Msg("ladder-challenge-decline")
+		return b.ladderChallengeDecline(ctx, auth, userID, data)
 	case pb.MessageType_CHAT_MESSAGE.String():
 		// The user is subtopics[2]
 		evt := &pb.ChatMessage{}
@@ -809,6 +868,10 @@ func (b *Bus) leaveTab(ctx context.Context, userID, connID string) error {
 	if err != nil {
 		return err
 	}
+	err = b.leaveQuickpairQueue(userID, connID)
+	if err != nil {
+		return err
+	}
 	// Delete any tournament ready messages
 	err = b.deleteTournamentReadyMsgs(ctx, userID, connID)
 	if err != nil {
@@ -853,7 +916,7 @@ func (b *Bus) leaveSite(ctx context.Context, userID string) error {
 	if err != nil {
 		return err
 	}
-	return nil
+	return b.leaveQuickpairQueue(userID, "")
 }
 
 func (b *Bus) pongReceived(ctx context.Context, userID, connID, ips string) error {
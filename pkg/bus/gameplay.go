@@ -107,8 +107,15 @@ func (b *Bus) instantiateAndStartGame(ctx context.Context, accUser *entity.User,
 	if err != nil {
 		return err
 	}
+	// Neither player should be matched again while this game is on.
+	for _, userID := range []string{accUser.UUID, reqUser.UUID} {
+		err = b.leaveQuickpairQueue(userID, "")
+		if err != nil {
+			log.Err(err).Str("user", userID).Msg("leaving-quickpair-queue")
+		}
+	}
 	// Broadcast a seek delete event, and send both parties a game redirect.
-	if reqID != BotRequestID {
+	if reqID != BotRequestID && reqID != QuickpairRequestID {
 		b.soughtGameStore.Delete(ctx, reqID)
 		err = b.sendSoughtGameDeletion(ctx, sg)
 		if err != nil {
@@ -0,0 +1,113 @@
+package bus
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/gameplay"
+	"github.com/domino14/liwords/pkg/tournament"
+	pb "github.com/domino14/liwords/rpc/api/proto/ipc"
+)
+
+func (b *Bus) ladderChallengeAccept(ctx context.Context, auth, userID, connID string,
+	data []byte) error {
+
+	if auth == "anon" {
+		return errors.New("please log in to accept a challenge")
+	}
+
+	evt := &pb.LadderChallengeAccept{}
+	err := proto.Unmarshal(data, evt)
+	if err != nil {
+		return err
+	}
+
+	err = b.errIfGamesDisabled(ctx)
+	if err != nil {
+		return err
+	}
+
+	reqUser, err := b.userStore.GetByUUID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	return tournament.AcceptLadderChallenge(ctx, b.tournamentStore, evt.TournamentId, evt.ChallengeId,
+		reqUser.TournamentID(), time.Now(), func(challengerUUID, challengedUUID string, gameReq *pb.GameRequest) (string, error) {
+			return b.startLadderGame(ctx, evt.TournamentId, challengerUUID, reqUser, connID, gameReq)
+		})
+}
+
+func (b *Bus) ladderChallengeDecline(ctx context.Context, auth, userID string, data []byte) error {
+	if auth == "anon" {
+		return errors.New("please log in to decline a challenge")
+	}
+
+	evt := &pb.LadderChallengeDecline{}
+	err := proto.Unmarshal(data, evt)
+	if err != nil {
+		return err
+	}
+
+	reqUser, err := b.userStore.GetByUUID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return tournament.DeclineLadderChallenge(ctx, b.tournamentStore, evt.TournamentId, evt.ChallengeId,
+		reqUser.TournamentID())
+}
+
+// startLadderGame starts the game between the challenger and the challenged
+// player, who accepted the challenge from the given connection.
+func (b *Bus) startLadderGame(ctx context.Context, tournamentID, challengerUUID string, challenged *entity.User,
+	connID string, gameReq *pb.GameRequest) (string, error) {
+
+	challenger, err := b.userStore.GetByUUID(ctx, challengerUUID)
+	if err != nil {
+		return "", err
+	}
+	tdata := &entity.TournamentData{
+		Id: tournamentID,
+	}
+
+	g, err := gameplay.InstantiateNewGame(ctx, b.gameStore, b.config,
+		[2]*entity.User{challenged, challenger}, -1, gameReq, tdata)
+	if err != nil {
+		return "", err
+	}
+
+	// Neither player should be matched again while this game is on.
+	for _, userID := range []string{challenged.UUID, challenger.UUID} {
+		err = b.leaveQuickpairQueue(userID, "")
+		if err != nil {
+			log.Err(err).Str("user", userID).Msg("leaving-quickpair-queue")
+		}
+	}
+
+	err = b.broadcastGameCreation(g, challenged, challenger)
+	if err != nil {
+		log.Err(err).Msg("broadcasting-game-creation")
+	}
+
+	// Send both players a game redirect. The challenge was made ahead of
+	// time, so only the accepter's connection is known.
+	ngevt := entity.WrapEvent(&pb.NewGameEvent{
+		GameId:      g.GameID(),
+		AccepterCid: connID,
+	}, pb.MessageType_NEW_GAME_EVENT)
+	b.pubToUser(challenged.UUID, ngevt, "")
+	b.pubToUser(challenger.UUID, ngevt, "")
+
+	log.Info().Str("newgameid", g.History().Uid).
+		Str("challenger", challenger.UUID).
+		Str("challenged", challenged.UUID).
+		Str("tournamentID", tournamentID).Msg("ladder-game-started")
+
+	g.SendChange(g.NewActiveGameEntry(true))
+	return g.GameID(), nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"google.golang.org/protobuf/proto"
@@ -118,6 +119,9 @@ func (b *Bus) newSeekRequest(ctx context.Context, auth, userID, connID string,
 		return err
 	}
 	reqUser.RelevantRating = u.GetRelevantRating(ratingKey)
+	if rating, err := u.GetRating(ratingKey); err == nil {
+		reqUser.Provisional = rating.IsProvisional(time.Now().Unix())
+	}
 	reqUser.DisplayName = u.Username
 
 	req.SeekerConnectionId = connID
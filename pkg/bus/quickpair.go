@@ -0,0 +1,338 @@
+package bus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/lithammer/shortuuid"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/pair"
+	pb "github.com/domino14/liwords/rpc/api/proto/ipc"
+)
+
+const (
+	// The quickpair queue is kept in Redis so that every API node sees
+	// the same queue. Only one node runs the matcher at a time.
+	quickpairQueueKey = "quickpair"
+	quickpairLockKey  = "quickpair:lock"
+
+	// An unlimited rating range, for players who didn't ask for one.
+	quickpairNoRange = 1 << 20
+)
+
+var (
+	// releaseLockScript only deletes a lock if it still holds the token
+	// of the node that took it, so that a node that ran past the expiry
+	// of its lock doesn't release the lock of another node.
+	releaseLockScript = redis.NewScript(1, `
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+	// takeQuickpairEntriesScript takes the players out of the queue, given
+	// as pairs of user IDs and the entries that were read for them. Nobody
+	// is taken out, and 0 is returned, if any of the entries has changed
+	// since, for instance if the player left the queue or joined it again.
+	takeQuickpairEntriesScript = redis.NewScript(1, `
+for i = 1, #ARGV, 2 do
+	if redis.call("HGET", KEYS[1], ARGV[i]) ~= ARGV[i + 1] then
+		return 0
+	end
+end
+for i = 1, #ARGV, 2 do
+	redis.call("HDEL", KEYS[1], ARGV[i])
+end
+return 1`)
+
+	// updateQuickpairEntryScript replaces the entry of the player with
+	// ARGV[3], but only if it is still the entry ARGV[2] that was read.
+	updateQuickpairEntryScript = redis.NewScript(1, `
+if redis.call("HGET", KEYS[1], ARGV[1]) == ARGV[2] then
+	redis.call("HSET", KEYS[1], ARGV[1], ARGV[3])
+	return 1
+end
+return 0`)
+)
+
+// A quickpairEntry is a player waiting in the quickpair queue.
+type quickpairEntry struct {
+	UserID      string          `json:"userID"`
+	ConnID      string          `json:"connID"`
+	GameRequest *pb.GameRequest `json:"gameRequest"`
+	Rating      int             `json:"rating"`
+	RatingRange [2]int          `json:"ratingRange"`
+	Misses      int             `json:"misses"`
+	// RatingDeviation is the deviation of the rating when the player
+	// joined, inflated for any inactivity.
+	RatingDeviation int `json:"ratingDeviation"`
+
+	// data is the entry as it was read from the queue.
+	data string
+}
+
+func (b *Bus) quickpairRequest(ctx context.Context, auth, userID, connID string,
+	data []byte) error {
+
+	if auth == "anon" {
+		return errors.New("please log in to start a game")
+	}
+
+	req := &pb.QuickpairRequest{}
+	err := proto.Unmarshal(data, req)
+	if err != nil {
+		return err
+	}
+
+	if req.Cancel {
+		err = b.leaveQuickpairQueue(userID, "")
+		if err != nil {
+			return err
+		}
+		return b.sendQuickpairStatus(userID, connID, false)
+	}
+
+	err = b.errIfGamesDisabled(ctx)
+	if err != nil {
+		return err
+	}
+
+	gameRequest := req.GameRequest
+	if gameRequest == nil {
+		return errors.New("no game request was found")
+	}
+	if gameRequest.PlayerVsBot {
+		return errors.New("quickpair is only for games against other players")
+	}
+	err = entity.ValidateGameRequest(ctx, gameRequest)
+	if err != nil {
+		return err
+	}
+
+	err = actionExists(ctx, b.userStore, userID, gameRequest)
+	if err != nil {
+		return err
+	}
+
+	ratingKey, err := ratingKey(gameRequest)
+	if err != nil {
+		return err
+	}
+	u, err := b.userStore.GetByUUID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	rating, err := u.GetRating(ratingKey)
+	if err != nil {
+		return err
+	}
+
+	entry := &quickpairEntry{
+		UserID:          userID,
+		ConnID:          connID,
+		GameRequest:     gameRequest,
+		Rating:          int(math.Round(rating.Rating)),
+		RatingRange:     [2]int{-quickpairNoRange, quickpairNoRange},
+		RatingDeviation: int(math.Round(rating.DecayedRatingDeviation(time.Now().Unix()))),
+	}
+	if req.MinimumRatingRange != 0 || req.MaximumRatingRange != 0 {
+		entry.RatingRange = [2]int{entry.Rating + int(req.MinimumRatingRange),
+			entry.Rating + int(req.MaximumRatingRange)}
+	}
+
+	bts, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	conn := b.redisPool.Get()
+	defer conn.Close()
+	_, err = conn.Do("HSET", quickpairQueueKey, userID, bts)
+	if err != nil {
+		return err
+	}
+	log.Debug().Str("user", userID).Int("rating", entry.Rating).
+		Interface("range", entry.RatingRange).Msg("joined-quickpair-queue")
+
+	return b.sendQuickpairStatus(userID, connID, true)
+}
+
+// leaveQuickpairQueue takes the user out of the quickpair queue. If the
+// connection ID is not empty, the user is only taken out if they joined
+// the queue from that connection.
+func (b *Bus) leaveQuickpairQueue(userID, connID string) error {
+	conn := b.redisPool.Get()
+	defer conn.Close()
+
+	if connID != "" {
+		bts, err := redis.Bytes(conn.Do("HGET", quickpairQueueKey, userID))
+		if err == redis.ErrNil {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		entry := &quickpairEntry{}
+		err = json.Unmarshal(bts, entry)
+		if err != nil {
+			return err
+		}
+		if entry.ConnID != connID {
+			return nil
+		}
+	}
+	_, err := conn.Do("HDEL", quickpairQueueKey, userID)
+	return err
+}
+
+func (b *Bus) sendQuickpairStatus(userID, connID string, queued bool) error {
+	evt := entity.WrapEvent(&pb.QuickpairStatus{Queued: queued}, pb.MessageType_QUICKPAIR_STATUS)
+	return b.pubToConnectionID(connID, userID, evt)
+}
+
+// matchQuickpairs pairs the players in the quickpair queue who are
+// looking for the same kind of game and starts their games.
+func (b *Bus) matchQuickpairs(ctx context.Context) error {
+	conn := b.redisPool.Get()
+	defer conn.Close()
+
+	// Another node might already be matching.
+	token := shortuuid.New()
+	locked, err := conn.Do("SET", quickpairLockKey, token, "NX", "PX", QuickpairInterval.Milliseconds())
+	if err != nil {
+		return err
+	}
+	if locked == nil {
+		return nil
+	}
+	defer releaseLockScript.Do(conn, quickpairLockKey, token)
+
+	queue, err := redis.StringMap(conn.Do("HGETALL", quickpairQueueKey))
+	if err != nil {
+		return err
+	}
+
+	pools := make(map[string][]*quickpairEntry)
+	for userID, data := range queue {
+		entry := &quickpairEntry{data: data}
+		err := json.Unmarshal([]byte(data), entry)
+		if err != nil {
+			log.Err(err).Str("user", userID).Msg("bad-quickpair-entry")
+			takeQuickpairEntriesScript.Do(conn, quickpairQueueKey, userID, data)
+			continue
+		}
+		key := quickpairPoolKey(entry.GameRequest)
+		pools[key] = append(pools[key], entry)
+	}
+
+	for _, entries := range pools {
+		err := b.matchQuickpairPool(ctx, conn, entries)
+		if err != nil {
+			log.Err(err).Msg("match-quickpair-pool-error")
+		}
+	}
+	return nil
+}
+
+func (b *Bus) matchQuickpairPool(ctx context.Context, conn redis.Conn, entries []*quickpairEntry) error {
+	members := make([]*entity.PoolMember, len(entries))
+	for idx, entry := range entries {
+		members[idx] = &entity.PoolMember{
+			Id:              entry.UserID,
+			Rating:          entry.Rating,
+			RatingDeviation: entry.RatingDeviation,
+			RatingRange:     entry.RatingRange,
+			Misses:          entry.Misses,
+		}
+		u, err := b.userStore.GetByUUID(ctx, entry.UserID)
+		if err != nil {
+			return err
+		}
+		blocks, err := b.userStore.GetBlocks(ctx, u.ID)
+		if err != nil {
+			return err
+		}
+		for _, blocked := range blocks {
+			members[idx].Blocking = append(members[idx].Blocking, blocked.UUID)
+		}
+	}
+
+	pairings, err := pair.Quickpair(members)
+	if err != nil {
+		return err
+	}
+
+	for i, j := range pairings {
+		if j == -1 {
+			// Save the miss so that their range keeps widening.
+			entries[i].Misses = members[i].Misses
+			bts, err := json.Marshal(entries[i])
+			if err != nil {
+				return err
+			}
+			_, err = updateQuickpairEntryScript.Do(conn, quickpairQueueKey, entries[i].UserID, entries[i].data, bts)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if j < i {
+			continue
+		}
+		taken, err := redis.Int(takeQuickpairEntriesScript.Do(conn, quickpairQueueKey,
+			entries[i].UserID, entries[i].data, entries[j].UserID, entries[j].data))
+		if err != nil {
+			return err
+		}
+		if taken == 0 {
+			// One of them left or changed their request while they
+			// were being matched. Whoever is still in the queue
+			// gets matched again on the next pass.
+			continue
+		}
+		err = b.startQuickpairGame(ctx, entries[i], entries[j])
+		if err != nil {
+			log.Err(err).Str("requester", entries[i].UserID).Str("accepter", entries[j].UserID).
+				Msg("start-quickpair-game-error")
+			for _, entry := range []*quickpairEntry{entries[i], entries[j]} {
+				b.pubToConnectionID(entry.ConnID, entry.UserID, entity.WrapEvent(
+					&pb.ErrorMessage{Message: "Your quickpair game could not be started: " + err.Error()},
+					pb.MessageType_ERROR_MESSAGE))
+				b.sendQuickpairStatus(entry.UserID, entry.ConnID, false)
+			}
+		}
+	}
+	return nil
+}
+
+func (b *Bus) startQuickpairGame(ctx context.Context, requester, accepter *quickpairEntry) error {
+	accUser, err := b.userStore.GetByUUID(ctx, accepter.UserID)
+	if err != nil {
+		return err
+	}
+	sg := entity.NewSoughtGame(&pb.SeekRequest{
+		GameRequest:        proto.Clone(requester.GameRequest).(*pb.GameRequest),
+		User:               &pb.MatchUser{UserId: requester.UserID},
+		SeekerConnectionId: requester.ConnID,
+	})
+	return b.instantiateAndStartGame(ctx, accUser, requester.UserID, sg.SeekRequest.GameRequest,
+		sg, QuickpairRequestID, accepter.ConnID)
+}
+
+// quickpairPoolKey returns a key that is the same for game requests
+// that can be paired with one another.
+func quickpairPoolKey(gr *pb.GameRequest) string {
+	rules := gr.Rules
+	if rules == nil {
+		rules = &pb.GameRules{}
+	}
+	return fmt.Sprintf("%s:%s:%s:%s:%s:%d:%d:%d:%d",
+		gr.Lexicon, rules.VariantName, rules.BoardLayoutName, rules.LetterDistributionName,
+		gr.RatingMode, gr.ChallengeRule, gr.InitialTimeSeconds, gr.IncrementSeconds, gr.MaxOvertimeMinutes)
+}
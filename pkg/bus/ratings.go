@@ -0,0 +1,87 @@
+package bus
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	nats "github.com/nats-io/nats.go"
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/liwords/pkg/entity"
+)
+
+const ratingDecayLockKey = "ratingdecay:lock"
+
+// RatingsReplacedTopic is published to every node when the ratings of some
+// variants were replaced, such as by the rerate command.
+const RatingsReplacedTopic = "ratings.replaced"
+
+// ratingsCache is a user store that caches ratings.
+type ratingsCache interface {
+	RatingsReplaced(ctx context.Context, variants []entity.VariantKey)
+}
+
+// RatingsReplacedPublisher tells every node that ratings were replaced, so
+// that they drop the users they cached.
+type RatingsReplacedPublisher struct {
+	natsconn *nats.Conn
+}
+
+func NewRatingsReplacedPublisher(natsconn *nats.Conn) *RatingsReplacedPublisher {
+	return &RatingsReplacedPublisher{natsconn: natsconn}
+}
+
+func (p *RatingsReplacedPublisher) RatingsReplaced(ctx context.Context, variants []entity.VariantKey) error {
+	data, err := json.Marshal(variants)
+	if err != nil {
+		return err
+	}
+	err = p.natsconn.Publish(RatingsReplacedTopic, data)
+	if err != nil {
+		return err
+	}
+	return p.natsconn.Flush()
+}
+
+func (b *Bus) ratingsReplaced(ctx context.Context, data []byte) {
+	var variants []entity.VariantKey
+	err := json.Unmarshal(data, &variants)
+	if err != nil {
+		log.Err(err).Msg("ratings-replaced-unmarshal")
+		return
+	}
+	if c, ok := b.userStore.(ratingsCache); ok {
+		c.RatingsReplaced(ctx, variants)
+	}
+	log.Info().Interface("variants", variants).Msg("ratings-replaced")
+}
+
+// updateProvisionalRatings marks the ratings of players who stopped playing
+// as provisional, unless another node already did it in this interval.
+func (b *Bus) updateProvisionalRatings(ctx context.Context) {
+	conn := b.redisPool.Get()
+	defer conn.Close()
+	// The lock is left to expire, so that only one node updates the
+	// ratings per interval.
+	locked, err := conn.Do("SET", ratingDecayLockKey, time.Now().Unix(), "NX", "PX", RatingDecayInterval.Milliseconds())
+	if err != nil {
+		log.Err(err).Msg("rating-decay-lock-error")
+		return
+	}
+	if locked == nil {
+		return
+	}
+
+	decayed, err := b.userStore.UpdateProvisionalRatings(ctx, time.Now().Unix())
+	if err != nil {
+		log.Err(err).Msg("rating-decay-error")
+		return
+	}
+	for _, d := range decayed {
+		log.Info().Str("userID", d.UserID).Str("variant", string(d.Variant)).
+			Float64("rd", d.Rating.RatingDeviation).Float64("decayed-rd", d.DecayedRatingDeviation).
+			Bool("provisional", d.Rating.Provisional).Msg("rating-decayed")
+	}
+	log.Info().Int("changed", len(decayed)).Msg("rating-decay")
+}
@@ -0,0 +1,35 @@
+package bus
+
+import (
+	"context"
+	"time"
+
+	"github.com/lithammer/shortuuid"
+
+	"github.com/domino14/liwords/pkg/tournament"
+)
+
+const roundScheduleLockKey = "roundschedule:lock"
+
+// RoundScheduleLockExpiry is how long a node can hold the round scheduler
+// lock if it never releases it, for instance because it went down.
+const RoundScheduleLockExpiry = 5 * time.Minute
+
+// runScheduledRounds runs the tournament schedules, unless another node
+// is already running them, so that no round is started or paired twice.
+func (b *Bus) runScheduledRounds(ctx context.Context) error {
+	conn := b.redisPool.Get()
+	defer conn.Close()
+
+	token := shortuuid.New()
+	locked, err := conn.Do("SET", roundScheduleLockKey, token, "NX", "PX", RoundScheduleLockExpiry.Milliseconds())
+	if err != nil {
+		return err
+	}
+	if locked == nil {
+		return nil
+	}
+	defer releaseLockScript.Do(conn, roundScheduleLockKey, token)
+
+	return tournament.RunScheduledRounds(ctx, b.tournamentStore, b.userStore, time.Now())
+}
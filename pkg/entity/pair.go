@@ -17,6 +17,11 @@ const MaxRelativeWeight int = 100
 const DifferencePenalty = 1
 const DifferencePenaltyMargin = 2
 
+// ContentionWeight is the weight of pairing a player who can still
+// reach a prize place with one who can't. It outweighs the sum of all
+// other weights but many of them can be added without being prohibitive.
+const ContentionWeight int64 = 1 << 44
+
 type PoolMember struct {
 	Id          string
 	Rating      int
@@ -26,6 +31,15 @@ type PoolMember struct {
 	Wins        int
 	Draws       int
 	Spread      int
+	Firsts      int
+	Seconds     int
+	Contender   bool
+	// VirtualPoints are added to the score of accelerated players,
+	// counting a win as 2 and a draw as 1.
+	VirtualPoints int
+	// RatingDeviation makes differences between uncertain ratings count
+	// for less when quickpairing.
+	RatingDeviation int
 }
 
 type UnpairedPoolMembers struct {
@@ -33,3 +47,17 @@ type UnpairedPoolMembers struct {
 	RoundControls *ipc.RoundControl
 	Repeats       map[string]int
 }
+
+// PairingWeight is the weight of a pairing broken down by where
+// each part of it comes from.
+type PairingWeight struct {
+	WinDifference int64
+	Spread        int64
+	Repeat        int64
+	Firsts        int64
+	Contention    int64
+}
+
+func (w *PairingWeight) Total() int64 {
+	return w.WinDifference + w.Spread + w.Repeat + w.Firsts + w.Contention
+}
@@ -5,8 +5,12 @@ import (
 	"encoding/json"
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/domino14/macondo/game"
+
+	"github.com/domino14/liwords/pkg/glicko"
+	pb "github.com/domino14/liwords/rpc/api/proto/ipc"
 )
 
 // SingleRating encodes a whole Glicko-225 rating object.
@@ -16,6 +20,112 @@ type SingleRating struct {
 	Volatility      float64 `json:"v"`
 	// This is the last game timestamp for this user for THIS variant:
 	LastGameTimestamp int64 `json:"ts"`
+	// Provisional is set while the rating is not well known, either because
+	// the player has not played enough games or because they have not played
+	// for a long time. It is set when the rating changes, and refreshed
+	// periodically for players who stopped playing.
+	Provisional bool `json:"p,omitempty"`
+}
+
+// Players who have not played a variant for this long are left off its
+// leaderboards by default.
+const InactiveAfter = 90 * 24 * time.Hour
+
+// NewSingleRating returns the rating of a player after a game or a rating
+// period that ended at the given time.
+func NewSingleRating(rating, ratingDeviation, volatility float64, lastGameTimestamp int64) SingleRating {
+	r := SingleRating{
+		Rating:            rating,
+		RatingDeviation:   ratingDeviation,
+		Volatility:        volatility,
+		LastGameTimestamp: lastGameTimestamp,
+	}
+	r.Provisional = r.IsProvisional(lastGameTimestamp)
+	return r
+}
+
+// DecayedRatingDeviation returns the rating deviation of the rating at the
+// given time, which grows for as long as the player does not play.
+func (r SingleRating) DecayedRatingDeviation(now int64) float64 {
+	if r.LastGameTimestamp == 0 || now <= r.LastGameTimestamp {
+		return r.RatingDeviation
+	}
+	return glicko.DecayedRatingDeviation(r.RatingDeviation, r.Volatility, int(now-r.LastGameTimestamp))
+}
+
+// IsProvisional returns whether the rating is provisional at the given time.
+func (r SingleRating) IsProvisional(now int64) bool {
+	return r.DecayedRatingDeviation(now) > RatingDeviationConfidence
+}
+
+// A DecayedRating is a rating whose provisional status changed because the
+// player has not played for a while.
+type DecayedRating struct {
+	UserID                 string
+	Variant                VariantKey
+	Rating                 SingleRating
+	DecayedRatingDeviation float64
+}
+
+// A RatingChange is the rating of a player in a variant after a game,
+// or after a rating period.
+type RatingChange struct {
+	UserID  string
+	Variant VariantKey
+	Rating  SingleRating
+	GameID  string
+}
+
+// RatingSpread returns the spread a game is rated with, from the point of
+// view of the first player. If the game ended because of the following,
+// the maximum spread is applied.
+func RatingSpread(params glicko.Parameters, scores [2]int32, winnerIdx int,
+	gameEndReason pb.GameEndReason) (int, error) {
+
+	maxPenalty := gameEndReason == pb.GameEndReason_RESIGNED ||
+		gameEndReason == pb.GameEndReason_TIME ||
+		gameEndReason == pb.GameEndReason_FORCE_FORFEIT ||
+		gameEndReason == pb.GameEndReason_TRIPLE_CHALLENGE
+
+	if maxPenalty {
+		if winnerIdx == 0 {
+			return params.SpreadScaling, nil
+		} else if winnerIdx == 1 {
+			return -params.SpreadScaling, nil
+		}
+		return 0, errors.New("no winner, but maximum penalty?")
+	}
+	// The winner is the person with the higher points. We will negate
+	// this when rating in the other direction.
+	spread := int(scores[0] - scores[1])
+	if spread > 0 && winnerIdx != 0 || spread < 0 && winnerIdx != 1 {
+		return 0, errors.New("winner does not match spread")
+	}
+	return spread, nil
+}
+
+// A RatedGame is a rated game that has ended, with what is needed to
+// rate it again. Players, scores and the winner are in the order of the
+// game history.
+type RatedGame struct {
+	GameID        string
+	Variant       VariantKey
+	PlayerIDs     [2]string
+	Scores        [2]int32
+	WinnerIdx     int
+	GameEndReason pb.GameEndReason
+	// EndTime is a unix timestamp, in seconds.
+	EndTime      int64
+	TournamentID string
+	Division     string
+}
+
+// A PlayerRating is the rating of a player in one variant.
+type PlayerRating struct {
+	UserID      string       `json:"id"`
+	Username    string       `json:"u"`
+	CountryCode string       `json:"c"`
+	Rating      SingleRating `json:"r"`
 }
 
 // Ratings gets stored into a PostgreSQL database.
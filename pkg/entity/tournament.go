@@ -3,6 +3,7 @@ package entity
 import (
 	"encoding/json"
 	"sync"
+	"time"
 
 	pb "github.com/domino14/liwords/rpc/api/proto/ipc"
 )
@@ -11,6 +12,7 @@ type DivisionManager interface {
 	SubmitResult(int, string, string, int, int, pb.TournamentGameResult,
 		pb.TournamentGameResult, pb.GameEndReason, bool, int, string) (*pb.DivisionPairingsResponse, error)
 	PairRound(int, bool) (*pb.DivisionPairingsResponse, error)
+	PreviewPairRound(int, bool, *pb.RoundControl) (*pb.PairingPreviewResponse, error)
 	DeletePairings(int) error
 	GetStandings(int) (*pb.RoundStandings, int, error)
 	GetCurrentRound() int
@@ -20,8 +22,11 @@ type DivisionManager interface {
 	SetRoundControls([]*pb.RoundControl) (*pb.DivisionPairingsResponse, []*pb.RoundControl, error)
 	SetDivisionControls(*pb.DivisionControls) (*pb.DivisionControls, map[int32]*pb.RoundStandings, error)
 	GetDivisionControls() *pb.DivisionControls
+	GetRoundControls() []*pb.RoundControl
 	AddPlayers(*pb.TournamentPersons) (*pb.DivisionPairingsResponse, error)
 	RemovePlayers(*pb.TournamentPersons) (*pb.DivisionPairingsResponse, error)
+	WithdrawPlayers(*pb.TournamentPersons, pb.WithdrawalPolicy) (*pb.DivisionPairingsResponse, error)
+	ReadmitPlayers(*pb.TournamentPersons, int) (*pb.DivisionPairingsResponse, error)
 	IsRoundReady(int) error
 	IsRoundComplete(int) (bool, error)
 	IsStarted() bool
@@ -31,12 +36,16 @@ type DivisionManager interface {
 	GetXHRResponse() (*pb.TournamentDivisionDataResponse, error)
 	SetReadyForGame(userID, connID string, round, gameIndex int, unready bool) ([]string, bool, error)
 	ClearReadyStates(userID string, round, gameIndex int) ([]*pb.Pairing, error)
+	ForfeitNoShows(now time.Time) (*pb.DivisionPairingsResponse, error)
 	ResetToBeginning() error
+	GetBracket() (*pb.BracketResponse, error)
+	GetPairingReport() (*pb.PairingReport, error)
+	SetCheckedIn(userID string, now time.Time) error
+	ClearCheckedIn()
+	EnforceCheckIn(now time.Time) (*pb.TournamentPersons, *pb.DivisionPairingsResponse, error)
+	NextScheduledTime() *time.Time
 }
 
-/**	SetCheckedIn(userID string) error
-ClearCheckedIn()*/
-
 type CompetitionType string
 
 const (
@@ -49,6 +58,9 @@ const (
 	// TypeLegacy is a tournament, but in club/clubhouse mode. The only different
 	// from a clubhouse is that it can have a /tournament URL.
 	TypeLegacy = "legacy"
+	// TypeLadder is a ladder, in which players hold ranks and challenge
+	// the players ranked above them.
+	TypeLadder = "ladder"
 )
 
 const (
@@ -65,9 +77,26 @@ const (
 )
 
 type TournamentDivision struct {
-	ManagerType        TournamentType  `json:"mgrType"`
-	DivisionRawMessage json.RawMessage `json:"json"`
-	DivisionManager    DivisionManager `json:"-"`
+	ManagerType        TournamentType        `json:"mgrType"`
+	DivisionRawMessage json.RawMessage       `json:"json"`
+	DivisionManager    DivisionManager       `json:"-"`
+	Registration       *DivisionRegistration `json:"registration,omitempty"`
+	// RatingPeriodApplied is set once the games of a division that is
+	// rated as one rating period have been rated.
+	RatingPeriodApplied bool `json:"ratingPeriodApplied,omitempty"`
+	// PeriodRatings are the ratings the players had when the first round
+	// of a division that is rated as one rating period started, by
+	// player ID.
+	PeriodRatings map[string]SingleRating `json:"periodRatings,omitempty"`
+}
+
+// DivisionRegistration holds the players who registered for a division
+// but are not in it yet.
+type DivisionRegistration struct {
+	// Pending registrations need to be approved by a director.
+	Pending []*pb.TournamentPerson `json:"pending"`
+	// Waitlist players are admitted in order as spots open up.
+	Waitlist []*pb.TournamentPerson `json:"waitlist"`
 }
 
 type TournamentMeta struct {
@@ -80,6 +109,11 @@ type TournamentMeta struct {
 	Logo                      string          `json:"logo"`
 	Color                     string          `json:"color"`
 	PrivateAnalysis           bool            `json:"privateAnalysis"`
+
+	// Prizes are awarded when the tournament is finished.
+	Prizes       []*pb.Prize      `json:"prizes,omitempty"`
+	PrizeTieRule pb.PrizeTieRule  `json:"prizeTieRule,omitempty"`
+	PrizeAwards  []*pb.PrizeAward `json:"prizeAwards,omitempty"`
 }
 
 type Tournament struct {
@@ -100,4 +134,6 @@ type Tournament struct {
 	ParentID          string                         `json:"parent"`
 	Slug              string                         `json:"slug"`
 	ExtraMeta         *TournamentMeta                `json:"extraMeta"`
+	Ladder            *pb.Ladder                     `json:"ladder,omitempty"`
+	Club              *pb.Club                       `json:"club,omitempty"`
 }
@@ -93,7 +93,7 @@ func RelevantRating(ratings Ratings, ratingKey VariantKey) string {
 	}
 	ratdict, ok := ratings.Data[ratingKey]
 	if ok {
-		if ratdict.RatingDeviation <= RatingDeviationConfidence {
+		if !ratdict.Provisional && ratdict.RatingDeviation <= RatingDeviationConfidence {
 			unknownRating = ""
 		}
 		return strconv.Itoa(int(math.Round(ratdict.Rating))) + unknownRating
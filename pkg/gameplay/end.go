@@ -147,7 +147,8 @@ func performEndgameDuties(ctx context.Context, g *entity.Game, gameStore GameSto
 	defer users[1-firstLockingIndex].Unlock()
 
 	// Send a gameEndedEvent, which rates the game.
-	evt := gameEndedEvent(ctx, g, userStore)
+	rated := g.CreationRequest().RatingMode == pb.RatingMode_RATED && !tournament.RatesAsPeriod(ctx, tournamentStore, g)
+	evt := gameEndedEvent(ctx, g, userStore, rated)
 	wrapped := entity.WrapEvent(evt, pb.MessageType_GAME_ENDED_EVENT)
 	for _, p := range players(g) {
 		wrapped.AddAudience(entity.AudUser, p+".game."+g.GameID())
@@ -328,6 +329,9 @@ func redoCancelledGamePairings(ctx context.Context, tstore tournament.Tournament
 		log.Info().Str("tid", tid).Msg("no pairings to redo for club or legacy type")
 		return nil
 	}
+	if t.Type == entity.TypeLadder {
+		return tournament.ClearLadderChallengeGame(ctx, tstore, t, g.GameID(), time.Now())
+	}
 	div := g.TournamentData.Division
 	round := g.TournamentData.Round
 	gidx := g.TournamentData.GameIndex
@@ -396,7 +400,9 @@ func AbortGame(ctx context.Context, gameStore GameStore, tournamentStore tournam
 	return nil
 }
 
-func gameEndedEvent(ctx context.Context, g *entity.Game, userStore user.Store) *pb.GameEndedEvent {
+// gameEndedEvent rates the game if rated is set, and returns the event
+// telling the players how the game ended.
+func gameEndedEvent(ctx context.Context, g *entity.Game, userStore user.Store, rated bool) *pb.GameEndedEvent {
 	var winner, loser string
 	var tie bool
 	winnerIdx := g.GetWinnerIdx()
@@ -420,7 +426,7 @@ func gameEndedEvent(ctx context.Context, g *entity.Game, userStore user.Store) *
 	newRatings := map[string]int32{}
 	var err error
 	var now = time.Now().Unix()
-	if g.CreationRequest().RatingMode == pb.RatingMode_RATED {
+	if rated {
 		ratings, err = Rate(ctx, scores, g, winner, userStore, now)
 		if err != nil {
 			log.Err(err).Msg("rating-error")
@@ -21,6 +21,38 @@ const (
 	iterationMaximum            int     = 1000
 )
 
+// Parameters are the constants of the Glicko-225 algorithm that can be
+// tuned. Games are rated with the DefaultParameters.
+type Parameters struct {
+	SpreadScaling             int
+	MinimumWinBoost           float64
+	Steepness                 float64
+	VolatilityDeltaConstraint float64
+	MaximumVolatility         float64
+	MinimumRatingDeviation    int
+	MaximumRatingDeviation    int
+	RatingPeriodinSeconds     int
+}
+
+var DefaultParameters = Parameters{
+	SpreadScaling:             SpreadScaling,
+	MinimumWinBoost:           MinimumWinBoost,
+	Steepness:                 Steepness,
+	VolatilityDeltaConstraint: VolatilityDeltaConstraint,
+	MaximumVolatility:         MaximumVolatility,
+	MinimumRatingDeviation:    MinimumRatingDeviation,
+	MaximumRatingDeviation:    MaximumRatingDeviation,
+	RatingPeriodinSeconds:     RatingPeriodinSeconds,
+}
+
+// A Result is a game played in a rating period, from the point of view
+// of the player being rated.
+type Result struct {
+	OpponentRating          float64
+	OpponentRatingDeviation float64
+	Spread                  int
+}
+
 func Rate(
 	playerUnscaledRating float64,
 	playerUnscaledRatingDeviation float64,
@@ -30,49 +62,102 @@ func Rate(
 	spread int,
 	secondsSinceLastGame int) (float64, float64, float64) {
 
+	return DefaultParameters.Rate(playerUnscaledRating, playerUnscaledRatingDeviation, playerVolatility,
+		opponentUnscaledRating, opponentUnscaledRatingDeviation, spread, secondsSinceLastGame)
+}
+
+// RatePeriod rates the games of a rating period with the DefaultParameters.
+func RatePeriod(
+	playerUnscaledRating float64,
+	playerUnscaledRatingDeviation float64,
+	playerVolatility float64,
+	results []Result,
+	secondsSinceLastGame int) (float64, float64, float64) {
+
+	return DefaultParameters.RatePeriod(playerUnscaledRating, playerUnscaledRatingDeviation, playerVolatility,
+		results, secondsSinceLastGame)
+}
+
+// Rate rates a single game.
+func (p Parameters) Rate(
+	playerUnscaledRating float64,
+	playerUnscaledRatingDeviation float64,
+	playerVolatility float64,
+	opponentUnscaledRating float64,
+	opponentUnscaledRatingDeviation float64,
+	spread int,
+	secondsSinceLastGame int) (float64, float64, float64) {
+
+	return p.RatePeriod(playerUnscaledRating, playerUnscaledRatingDeviation, playerVolatility,
+		[]Result{{
+			OpponentRating:          opponentUnscaledRating,
+			OpponentRatingDeviation: opponentUnscaledRatingDeviation,
+			Spread:                  spread,
+		}}, secondsSinceLastGame)
+}
+
+// RatePeriod rates all of the games of a player in a rating period at
+// once, against the ratings their opponents had before the period, so
+// the order in which the games were played does not matter.
+func (p Parameters) RatePeriod(
+	playerUnscaledRating float64,
+	playerUnscaledRatingDeviation float64,
+	playerVolatility float64,
+	results []Result,
+	secondsSinceLastGame int) (float64, float64, float64) {
+
 	// Step 1 of the Glicko-225 algorithm was performed upon account creation
 	// Step 2 of the Glicko-225 algorithm
 	playerRating := convertRatingToGlicko225(playerUnscaledRating)
 	playerRatingDeviation := convertRatingDeviationToGlicko225(playerUnscaledRatingDeviation)
-	opponentRating := convertRatingToGlicko225(opponentUnscaledRating)
-	opponentRatingDeviation := convertRatingDeviationToGlicko225(opponentUnscaledRatingDeviation)
+	rdSquared := math.Pow(playerRatingDeviation, 2)
 
-	// Precompute these values for efficiency
-	opponentAdjustedRatingDeviation := adjustedRatingDeviation(opponentRatingDeviation)
-	expectedValue := expectedValue(playerRating, opponentRating, opponentAdjustedRatingDeviation)
+	if len(results) == 0 {
+		// A player who did not play only becomes less certain.
+		return playerUnscaledRating, p.DecayedRatingDeviation(playerUnscaledRatingDeviation, playerVolatility, secondsSinceLastGame), playerVolatility
+	}
+
+	// Step 3 and 4 of the Glicko-225 algorithm, summed over every game
+	inverseVariance := 0.0
+	improvement := 0.0
+	for _, result := range results {
+		opponentRating := convertRatingToGlicko225(result.OpponentRating)
+		opponentRatingDeviation := convertRatingDeviationToGlicko225(result.OpponentRatingDeviation)
 
-	// Step 3 of the Glicko-225 algorithm
-	variance := 1 / variance(opponentAdjustedRatingDeviation, expectedValue)
+		// Precompute these values for efficiency
+		opponentAdjustedRatingDeviation := adjustedRatingDeviation(opponentRatingDeviation)
+		expectedValue := expectedValue(playerRating, opponentRating, opponentAdjustedRatingDeviation)
 
-	// Step 4 of the Glicko-225 algorithm
-	awb := adjustWinBoost(float64(playerUnscaledRating+opponentUnscaledRating) / 2)
-	improvement := improvement(opponentAdjustedRatingDeviation, awb, expectedValue, spread)
+		inverseVariance += variance(opponentAdjustedRatingDeviation, expectedValue)
+		awb := p.adjustWinBoost(float64(playerUnscaledRating+result.OpponentRating) / 2)
+		improvement += p.gameImprovement(opponentAdjustedRatingDeviation, awb, expectedValue, result.Spread)
+	}
+	variance := 1 / inverseVariance
 	improvementDelta := variance * improvement
 
 	// Step 5 of the Glicko-225 algorithm
 	a := math.Log(math.Pow(playerVolatility, 2))
 	A := a
 	deltaSquared := math.Pow(improvementDelta, 2)
-	rdSquared := math.Pow(playerRatingDeviation, 2)
 	var B float64
 	if deltaSquared > rdSquared+variance {
 		B = math.Log(deltaSquared - rdSquared - variance)
 	} else {
 		k := 1
-		B = a - (float64(k) * VolatilityDeltaConstraint)
-		for iterativeHelper(B, deltaSquared, rdSquared, variance, a) < 0 {
+		B = a - (float64(k) * p.VolatilityDeltaConstraint)
+		for p.iterativeHelper(B, deltaSquared, rdSquared, variance, a) < 0 {
 			k = k + 1
-			B = a - (float64(k) * VolatilityDeltaConstraint)
+			B = a - (float64(k) * p.VolatilityDeltaConstraint)
 		}
 
 	}
 
-	fA := iterativeHelper(A, deltaSquared, rdSquared, variance, a)
-	fB := iterativeHelper(B, deltaSquared, rdSquared, variance, a)
+	fA := p.iterativeHelper(A, deltaSquared, rdSquared, variance, a)
+	fB := p.iterativeHelper(B, deltaSquared, rdSquared, variance, a)
 	i := 0
 	for math.Abs(B-A) > ConvergenceTolerance && i < iterationMaximum {
 		C := A + (((A - B) * fA) / (fB - fA))
-		fC := iterativeHelper(C, deltaSquared, rdSquared, variance, a)
+		fC := p.iterativeHelper(C, deltaSquared, rdSquared, variance, a)
 		if fB*fC < 0 {
 			A = B
 			fA = fB
@@ -84,10 +169,10 @@ func Rate(
 		i++
 	}
 
-	newPlayerVolatility := math.Min(MaximumVolatility, math.Exp(A/2))
+	newPlayerVolatility := math.Min(p.MaximumVolatility, math.Exp(A/2))
 
 	// Step 6 of the Glicko-225 algorithm
-	newPlayerRatingDeviation := math.Sqrt(rdSquared + ((float64(secondsSinceLastGame) / float64(RatingPeriodinSeconds)) * math.Pow(newPlayerVolatility, 2)))
+	newPlayerRatingDeviation := math.Sqrt(rdSquared + ((float64(secondsSinceLastGame) / float64(p.RatingPeriodinSeconds)) * math.Pow(newPlayerVolatility, 2)))
 
 	// Step 7 of the Glicko-225 algorithm
 	newPlayerRatingDeviation = 1 / math.Sqrt((1/math.Pow(newPlayerRatingDeviation, 2))+1/variance)
@@ -96,11 +181,67 @@ func Rate(
 	// Step 8 of the Glicko-225 algorithm
 	newPlayerRating = convertRatingFromGlicko225(newPlayerRating)
 	newPlayerRatingDeviation = convertRatingDeviationFromGlicko225(newPlayerRatingDeviation)
-	newPlayerRatingDeviation = math.Max(math.Min(newPlayerRatingDeviation, float64(MaximumRatingDeviation)), float64(MinimumRatingDeviation))
+	newPlayerRatingDeviation = math.Max(math.Min(newPlayerRatingDeviation, float64(p.MaximumRatingDeviation)), float64(p.MinimumRatingDeviation))
 
 	return newPlayerRating, newPlayerRatingDeviation, newPlayerVolatility
 }
 
+// DecayedRatingDeviation returns the rating deviation of a player who has
+// not played for the given number of seconds. Ratings only change when
+// games are played, so this is what the deviation would be if the player
+// played now.
+func DecayedRatingDeviation(playerUnscaledRatingDeviation float64, playerVolatility float64,
+	secondsSinceLastGame int) float64 {
+
+	return DefaultParameters.DecayedRatingDeviation(playerUnscaledRatingDeviation, playerVolatility, secondsSinceLastGame)
+}
+
+func (p Parameters) DecayedRatingDeviation(playerUnscaledRatingDeviation float64, playerVolatility float64,
+	secondsSinceLastGame int) float64 {
+
+	playerRatingDeviation := convertRatingDeviationToGlicko225(playerUnscaledRatingDeviation)
+	rdSquared := math.Pow(playerRatingDeviation, 2)
+	newPlayerRatingDeviation := math.Sqrt(rdSquared + ((float64(secondsSinceLastGame) / float64(p.RatingPeriodinSeconds)) * math.Pow(playerVolatility, 2)))
+	newPlayerRatingDeviation = convertRatingDeviationFromGlicko225(newPlayerRatingDeviation)
+	return math.Max(math.Min(newPlayerRatingDeviation, float64(p.MaximumRatingDeviation)), float64(p.MinimumRatingDeviation))
+}
+
+// ExpectedScore returns the expected score of a player in a game against
+// an opponent, which is also the probability that the player wins. The
+// less certain either rating is, the closer it is to 0.5.
+func ExpectedScore(playerUnscaledRating float64, playerUnscaledRatingDeviation float64,
+	opponentUnscaledRating float64, opponentUnscaledRatingDeviation float64) float64 {
+
+	combinedRatingDeviation := convertRatingDeviationToGlicko225(math.Sqrt(
+		math.Pow(playerUnscaledRatingDeviation, 2) + math.Pow(opponentUnscaledRatingDeviation, 2)))
+	return expectedValue(convertRatingToGlicko225(playerUnscaledRating),
+		convertRatingToGlicko225(opponentUnscaledRating), adjustedRatingDeviation(combinedRatingDeviation))
+}
+
+// ExpectedSpread approximates the spread a player is expected to win a game
+// against an opponent by, with the DefaultParameters.
+func ExpectedSpread(playerUnscaledRating float64, playerUnscaledRatingDeviation float64,
+	opponentUnscaledRating float64, opponentUnscaledRatingDeviation float64) float64 {
+
+	return DefaultParameters.ExpectedSpread(playerUnscaledRating, playerUnscaledRatingDeviation,
+		opponentUnscaledRating, opponentUnscaledRatingDeviation)
+}
+
+// ExpectedSpread approximates the spread a player is expected to win a game
+// against an opponent by, as 2 * SpreadScaling * (expectedScore - 0.5). A
+// game is rated as a result of
+// 0.5 + sign(spread) * winBoost + spread / (2 * SpreadScaling + k(winBoost)),
+// and the approximation is the spread whose result equals the expected
+// score if the win boost and k are left out. It is only meant to give an
+// idea of how lopsided a game is, not to predict its score.
+func (p Parameters) ExpectedSpread(playerUnscaledRating float64, playerUnscaledRatingDeviation float64,
+	opponentUnscaledRating float64, opponentUnscaledRatingDeviation float64) float64 {
+
+	expectedScore := ExpectedScore(playerUnscaledRating, playerUnscaledRatingDeviation,
+		opponentUnscaledRating, opponentUnscaledRatingDeviation)
+	return 2 * float64(p.SpreadScaling) * (expectedScore - 0.5)
+}
+
 func convertRatingToGlicko225(unscaledRating float64) float64 {
 	return (unscaledRating - float64(InitialRating)) / GlickoToGlicko225Conversion
 }
@@ -117,16 +258,16 @@ func convertRatingDeviationFromGlicko225(ratingDeviation float64) float64 {
 	return ratingDeviation * GlickoToGlicko225Conversion
 }
 
-func adjustWinBoost(rating float64) float64 {
-	return ((0.5 - MinimumWinBoost) / (1 + math.Exp(-Steepness*(rating-float64(InitialRating))))) + MinimumWinBoost
+func (p Parameters) adjustWinBoost(rating float64) float64 {
+	return ((0.5 - p.MinimumWinBoost) / (1 + math.Exp(-p.Steepness*(rating-float64(InitialRating))))) + p.MinimumWinBoost
 }
 
 func variance(opponentAdjustedRatingDeviation float64, expectedValue float64) float64 {
 	return math.Pow(opponentAdjustedRatingDeviation, 2) * expectedValue * (1 - expectedValue)
 }
 
-func improvement(opponentAdjustedRatingDeviation float64, awb float64, expectedValue float64, spread int) float64 {
-	return opponentAdjustedRatingDeviation * ((boundedResult(float64(spread)/((2*float64(SpreadScaling))+kfunction(awb))+(float64(sign(spread))*awb)) + 0.5) - expectedValue)
+func (p Parameters) gameImprovement(opponentAdjustedRatingDeviation float64, awb float64, expectedValue float64, spread int) float64 {
+	return opponentAdjustedRatingDeviation * ((boundedResult(float64(spread)/((2*float64(p.SpreadScaling))+p.kfunction(awb))+(float64(sign(spread))*awb)) + 0.5) - expectedValue)
 }
 
 func boundedResult(result float64) float64 {
@@ -139,11 +280,11 @@ func boundedResult(result float64) float64 {
 	return boundedResult
 }
 
-func iterativeHelper(x float64, deltaSquared float64, rdSquared float64, variance float64, a float64) float64 {
+func (p Parameters) iterativeHelper(x float64, deltaSquared float64, rdSquared float64, variance float64, a float64) float64 {
 	ex := math.Exp(x)
 	return (ex*(deltaSquared-rdSquared-variance-ex))/
 		(2*math.Pow(rdSquared+variance+ex, 2)) -
-		(x-a)/math.Pow(VolatilityDeltaConstraint, 2)
+		(x-a)/math.Pow(p.VolatilityDeltaConstraint, 2)
 }
 
 func adjustedRatingDeviation(ratingDeviation float64) float64 {
@@ -154,8 +295,8 @@ func expectedValue(playerRating float64, opponentRating float64, opponentAdjuste
 	return 1 / (1 + math.Exp(-opponentAdjustedRatingDeviation*(playerRating-opponentRating)))
 }
 
-func kfunction(wb float64) float64 {
-	return (float64(4*float64(SpreadScaling)) * wb) / (1 - (2 * wb))
+func (p Parameters) kfunction(wb float64) float64 {
+	return (float64(4*float64(p.SpreadScaling)) * wb) / (1 - (2 * wb))
 }
 
 func sign(spread int) int {
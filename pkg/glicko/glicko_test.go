@@ -87,6 +87,77 @@ func TestRatingGain(t *testing.T) {
 	is.True(volatility < InitialVolatility)
 }
 
+func TestRatePeriod(t *testing.T) {
+
+	is := is.New(t)
+
+	results := []Result{
+		{OpponentRating: 1600, OpponentRatingDeviation: 100, Spread: 50},
+		{OpponentRating: 1400, OpponentRatingDeviation: 100, Spread: -30},
+		{OpponentRating: 1500, OpponentRatingDeviation: 300, Spread: 10},
+	}
+	rating, deviation, volatility := RatePeriod(1500, 200, InitialVolatility, results, 0)
+
+	// The order of the games does not matter.
+	reversed := []Result{results[2], results[1], results[0]}
+	rating2, deviation2, volatility2 := RatePeriod(1500, 200, InitialVolatility, reversed, 0)
+	is.True(withinEpsilon(rating, rating2))
+	is.True(withinEpsilon(deviation, deviation2))
+	is.True(withinEpsilon(volatility, volatility2))
+
+	is.True(rating > 1500)
+	is.True(deviation < 200)
+
+	// A single game is rated the same as it was before rating periods.
+	rating, deviation, volatility = RatePeriod(1500, 200, InitialVolatility, results[:1], 3600)
+	is.True(withinEpsilon(rating, 1601.213600))
+	is.True(withinEpsilon(deviation, 176.814636))
+	is.True(withinEpsilon(volatility, 0.060000))
+
+	// Not playing only makes the rating less certain.
+	rating, deviation, volatility = RatePeriod(1500, 200, InitialVolatility, nil, RatingPeriodinSeconds)
+	is.Equal(rating, 1500.0)
+	is.True(deviation > 200)
+	is.Equal(volatility, InitialVolatility)
+}
+
+func TestDecayedRatingDeviation(t *testing.T) {
+
+	is := is.New(t)
+
+	is.Equal(DecayedRatingDeviation(80, InitialVolatility, 0), 80.0)
+
+	// The deviation grows the longer a player does not play, up to the maximum.
+	month := DecayedRatingDeviation(80, InitialVolatility, 30*86400)
+	year := DecayedRatingDeviation(80, InitialVolatility, 365*86400)
+	is.True(month > 80)
+	is.True(year > month)
+	is.Equal(DecayedRatingDeviation(80, MaximumVolatility, 100*365*86400), float64(MaximumRatingDeviation))
+
+	// It is what the deviation would be before the next game is rated.
+	_, deviation, _ := RatePeriod(1500, 80, InitialVolatility, nil, 30*86400)
+	is.Equal(month, deviation)
+}
+
+func TestExpectedScore(t *testing.T) {
+
+	is := is.New(t)
+
+	is.Equal(ExpectedScore(1500, 100, 1500, 300), 0.5)
+	is.Equal(ExpectedSpread(1500, 100, 1500, 300), 0.0)
+
+	favorite := ExpectedScore(1700, 60, 1500, 60)
+	is.True(favorite > 0.5)
+	is.True(withinEpsilon(favorite+ExpectedScore(1500, 60, 1700, 60), 1))
+	// Uncertain ratings are less predictive.
+	is.True(ExpectedScore(1700, 300, 1500, 300) < favorite)
+
+	spread := ExpectedSpread(1700, 60, 1500, 60)
+	is.True(spread > 0)
+	is.True(withinEpsilon(spread, -ExpectedSpread(1500, 60, 1700, 60)))
+	is.True(spread < float64(SpreadScaling))
+}
+
 func TestRatingLoss(t *testing.T) {
 
 	is := is.New(t)
@@ -653,7 +724,7 @@ func TestAdjustedWinBoost(t *testing.T) {
 	fmt.Println("\nAdjusted Win Boosts for each rating:")
 	for i := 1; i <= 20; i++ {
 		rating := 100 * i
-		fmt.Printf("Rating: %4d, Adjusted Win Boost: %f\n", rating, adjustWinBoost(float64(rating)))
+		fmt.Printf("Rating: %4d, Adjusted Win Boost: %f\n", rating, DefaultParameters.adjustWinBoost(float64(rating)))
 	}
 }
 
@@ -661,7 +732,7 @@ func TestTradeoffs(t *testing.T) {
 
 	for r := 1; r <= 10; r++ {
 		rating := r * 200
-		awb := adjustWinBoost(float64(rating))
+		awb := DefaultParameters.adjustWinBoost(float64(rating))
 		winSpread := 0.0
 		loseSpread := 0.0
 		winResult := 0.0
@@ -3,11 +3,15 @@ package pair
 import (
 	"errors"
 	"fmt"
+	"math"
 	"math/rand"
+	"sort"
 
 	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/glicko"
 	"github.com/domino14/liwords/pkg/matching"
 	"github.com/domino14/liwords/pkg/utilities"
 
@@ -36,6 +40,10 @@ func Pair(members *entity.UnpairedPoolMembers) ([]int, error) {
 		pairings, err = pairInitialFontes(members)
 	} else if pm == pb.PairingMethod_TEAM_ROUND_ROBIN {
 		pairings, err = pairTeamRoundRobin(members)
+	} else if pm == pb.PairingMethod_SEEDED_SPLIT {
+		pairings, err = pairSeeded(members, false)
+	} else if pm == pb.PairingMethod_SEEDED_FOLD {
+		pairings, err = pairSeeded(members, true)
 	} else {
 		// The remaining pairing methods are solved by
 		// reduction to minimum weight matching
@@ -99,6 +107,65 @@ func pairKingOfTheHill(members *entity.UnpairedPoolMembers) ([]int, error) {
 	return pairings, nil
 }
 
+// pairSeeded pairs the members by their ratings, highest first. Split
+// pairs the top half against the bottom half and fold pairs from the
+// outside in. With an odd number of members, the lowest rated one is
+// left unpaired.
+func pairSeeded(members *entity.UnpairedPoolMembers, fold bool) ([]int, error) {
+	seeds := seedOrder(members.PoolMembers)
+	numberOfPlayers := len(seeds) - len(seeds)%2
+	half := numberOfPlayers / 2
+
+	pairings := []int{}
+	for i := 0; i < len(seeds); i++ {
+		pairings = append(pairings, -1)
+	}
+
+	for i := 0; i < half; i++ {
+		opponent := i + half
+		if fold {
+			opponent = numberOfPlayers - 1 - i
+		}
+		pairings[seeds[i]] = seeds[opponent]
+		pairings[seeds[opponent]] = seeds[i]
+	}
+	return pairings, nil
+}
+
+// seedOrder returns the indexes of the members from the highest rating
+// to the lowest. Members with the same rating keep their order.
+func seedOrder(poolMembers []*entity.PoolMember) []int {
+	seeds := []int{}
+	for i := range poolMembers {
+		seeds = append(seeds, i)
+	}
+	sort.SliceStable(seeds, func(i, j int) bool {
+		return poolMembers[seeds[i]].Rating > poolMembers[seeds[j]].Rating
+	})
+	return seeds
+}
+
+// Accelerate gives the members their virtual points for an accelerated
+// round, which is the acceleratedRound-th of acceleratedRounds accelerated
+// rounds, starting at 0. This is the Baku acceleration: the top half of
+// the members by rating, rounded up to an even number, get a virtual win
+// in the first half of the accelerated rounds, rounded up, and a virtual
+// draw in the rest.
+func Accelerate(poolMembers []*entity.PoolMember, acceleratedRounds int, acceleratedRound int) {
+	virtualPoints := 1
+	if acceleratedRound < (acceleratedRounds+1)/2 {
+		virtualPoints = 2
+	}
+	topGroupSize := ((len(poolMembers) + 3) / 4) * 2
+	for rank, idx := range seedOrder(poolMembers) {
+		if rank < topGroupSize {
+			poolMembers[idx].VirtualPoints = virtualPoints
+		} else {
+			poolMembers[idx].VirtualPoints = 0
+		}
+	}
+}
+
 func pairFactor(members *entity.UnpairedPoolMembers) ([]int, error) {
 
 	// Remaining players are paired using the swiss-like min weight matching
@@ -190,13 +257,7 @@ func minWeightMatching(members *entity.UnpairedPoolMembers) ([]int, error) {
 	numberOfMembers := len(members.PoolMembers)
 	edges := []*matching.Edge{}
 
-	if int(members.RoundControls.RepeatRelativeWeight) > entity.MaxRelativeWeight {
-		members.RoundControls.RepeatRelativeWeight = int32(entity.MaxRelativeWeight)
-	}
-
-	if int(members.RoundControls.WinDifferenceRelativeWeight) > entity.MaxRelativeWeight {
-		members.RoundControls.WinDifferenceRelativeWeight = int32(entity.MaxRelativeWeight)
-	}
+	members = CapRelativeWeights(members)
 
 	for i := 0; i < numberOfMembers; i++ {
 		for j := i + 1; j < numberOfMembers; j++ {
@@ -237,6 +298,84 @@ func minWeightMatching(members *entity.UnpairedPoolMembers) ([]int, error) {
 	return pairings, nil
 }
 
+// CapRelativeWeights returns the members with their round controls capped
+// at the maximum relative weights. The round controls are copied if any
+// of them need to be capped, so the controls of the division don't change.
+// It is done once per round, before the members are weighed.
+func CapRelativeWeights(members *entity.UnpairedPoolMembers) *entity.UnpairedPoolMembers {
+	controls := members.RoundControls
+	if int(controls.RepeatRelativeWeight) <= entity.MaxRelativeWeight &&
+		int(controls.WinDifferenceRelativeWeight) <= entity.MaxRelativeWeight &&
+		int(controls.FirstsRelativeWeight) <= entity.MaxRelativeWeight {
+		return members
+	}
+	controls = proto.Clone(controls).(*pb.RoundControl)
+	if int(controls.RepeatRelativeWeight) > entity.MaxRelativeWeight {
+		controls.RepeatRelativeWeight = int32(entity.MaxRelativeWeight)
+	}
+	if int(controls.WinDifferenceRelativeWeight) > entity.MaxRelativeWeight {
+		controls.WinDifferenceRelativeWeight = int32(entity.MaxRelativeWeight)
+	}
+	if int(controls.FirstsRelativeWeight) > entity.MaxRelativeWeight {
+		controls.FirstsRelativeWeight = int32(entity.MaxRelativeWeight)
+	}
+	return &entity.UnpairedPoolMembers{
+		PoolMembers:   members.PoolMembers,
+		RoundControls: controls,
+		Repeats:       members.Repeats,
+	}
+}
+
+// Quickpair pairs the members of a matchmaking pool. Unlike the
+// tournament pairing methods, not everyone has to be paired.
+// Members are only paired with opponents that are in their rating
+// range, which widens every time they miss a pairing, and members
+// that are left unpaired (-1) have their misses incremented.
+func Quickpair(poolMembers []*entity.PoolMember) ([]int, error) {
+	members := &entity.UnpairedPoolMembers{
+		PoolMembers:   poolMembers,
+		RoundControls: &pb.RoundControl{PairingMethod: pb.PairingMethod_QUICKPAIR},
+	}
+	numberOfMembers := len(poolMembers)
+	edges := []*matching.Edge{}
+	for i := 0; i < numberOfMembers; i++ {
+		for j := i + 1; j < numberOfMembers; j++ {
+			if pairable(members, i, j) && inRange(poolMembers[i], poolMembers[j]) &&
+				inRange(poolMembers[j], poolMembers[i]) {
+				edges = append(edges, matching.NewEdge(i, j, weighQuickpair(members, i, j)))
+			}
+		}
+	}
+
+	pairings := make([]int, numberOfMembers)
+	for i := range pairings {
+		pairings[i] = -1
+	}
+	if len(edges) > 0 {
+		matches, _, err := matching.MinWeightMatching(edges, true)
+		if err != nil {
+			log.Debug().Msgf("matching failed: %v", edges)
+			return nil, err
+		}
+		copy(pairings, matches)
+	}
+
+	for index, pairing := range pairings {
+		if pairing == -1 {
+			poolMembers[index].Misses++
+		}
+	}
+	return pairings, nil
+}
+
+// inRange returns true if the opponent's rating is in the
+// player's rating range, widened by the player's misses.
+func inRange(player *entity.PoolMember, opponent *entity.PoolMember) bool {
+	widening := missBonus(player)
+	return player.RatingRange[0]-widening <= opponent.Rating &&
+		opponent.Rating <= player.RatingRange[1]+widening
+}
+
 func pairable(members *entity.UnpairedPoolMembers, i int, j int) bool {
 	// There is probably a better way to do this, but for now:
 	PoolMemberA := members.PoolMembers[i]
@@ -270,6 +409,13 @@ func weigh(members *entity.UnpairedPoolMembers, i int, j int) (int64, error) {
 }
 
 func weighSwiss(members *entity.UnpairedPoolMembers, i int, j int) int64 {
+	return SwissWeight(members, i, j).Total()
+}
+
+// SwissWeight returns the weight that swiss pairings give to
+// pairing members i and j, broken down by its parts. The members
+// are expected to have been capped with CapRelativeWeights.
+func SwissWeight(members *entity.UnpairedPoolMembers, i int, j int) *entity.PairingWeight {
 	p1 := members.PoolMembers[i]
 	p2 := members.PoolMembers[j]
 
@@ -277,7 +423,8 @@ func weighSwiss(members *entity.UnpairedPoolMembers, i int, j int) int64 {
 	// outweighs the sum of all of the edge's possible spread weight
 
 	// The unscaled weight difference where a win is worth 2 and a draw is worth 1
-	unscaledWinDiffWeight := utilities.Abs(((p1.Wins - p2.Wins) * 2) + (p1.Draws - p2.Draws))
+	unscaledWinDiffWeight := utilities.Abs(((p1.Wins - p2.Wins) * 2) + (p1.Draws - p2.Draws) +
+		(p1.VirtualPoints - p2.VirtualPoints))
 
 	// Add marginal penalties to make the weighing function nonlinear
 	// This will discourage larger maximum win differences over a round
@@ -303,18 +450,61 @@ func weighSwiss(members *entity.UnpairedPoolMembers, i int, j int) int64 {
 	} else if repeatsOverMax > 0 {
 		repeatWeight = entity.ProhibitiveWeight
 	}
-	return winDiffWeight + spreadDiffWeight + repeatWeight
+
+	// Like repeats, each first or second a player is forced to take
+	// against their history is worth as much as a win difference.
+	firstsWeight := int64(firstsImbalance(p1, p2)) * entity.WinWeightScaling *
+		int64(members.RoundControls.FirstsRelativeWeight)
+
+	var contentionWeight int64 = 0
+	if members.RoundControls.ContentionPairing && p1.Contender != p2.Contender {
+		contentionWeight = entity.ContentionWeight
+	}
+
+	return &entity.PairingWeight{
+		WinDifference: winDiffWeight,
+		Spread:        spreadDiffWeight,
+		Repeat:        repeatWeight,
+		Firsts:        firstsWeight,
+		Contention:    contentionWeight,
+	}
+}
+
+// firstsImbalance returns how much the pairing of p1 and p2 adds to
+// the imbalance of firsts and seconds. Players whose histories complement
+// each other can both be evened out. If both have gone first more often,
+// the one who has done so less often has to go first again, which
+// takes them further from even, and likewise for seconds.
+func firstsImbalance(p1 *entity.PoolMember, p2 *entity.PoolMember) int {
+	imbalanceOne := p1.Firsts - p1.Seconds
+	imbalanceTwo := p2.Firsts - p2.Seconds
+	if imbalanceOne > 0 && imbalanceTwo > 0 {
+		return utilities.Min(imbalanceOne, imbalanceTwo) + 1
+	}
+	if imbalanceOne < 0 && imbalanceTwo < 0 {
+		return utilities.Min(-imbalanceOne, -imbalanceTwo) + 1
+	}
+	return 0
 }
 
 func weighQuickpair(members *entity.UnpairedPoolMembers, i int, j int) int64 {
 	PoolMemberA := members.PoolMembers[i]
 	PoolMemberB := members.PoolMembers[j]
-	ratingDiff := utilities.Abs(PoolMemberA.Rating - PoolMemberB.Rating)
+	ratingDiff := effectiveRatingDifference(PoolMemberA, PoolMemberB)
 	missBonus := utilities.Min(missBonus(PoolMemberA), missBonus(PoolMemberB))
 	rangeBonus := rangeBonus(PoolMemberA, PoolMemberB)
 	return int64(ratingDiff - missBonus - rangeBonus)
 }
 
+// effectiveRatingDifference returns the difference between two certain
+// ratings that would give the same odds as the ratings of the players. It
+// is the difference between their ratings if their deviations are zero.
+func effectiveRatingDifference(a *entity.PoolMember, b *entity.PoolMember) int {
+	odds := glicko.ExpectedScore(float64(a.Rating), float64(a.RatingDeviation),
+		float64(b.Rating), float64(b.RatingDeviation))
+	return int(math.Round(math.Abs(math.Log(odds/(1-odds))) * glicko.GlickoToGlicko225Conversion))
+}
+
 func missBonus(p *entity.PoolMember) int {
 	return utilities.Min(p.Misses*12, 400)
 }
@@ -323,7 +513,7 @@ func rangeBonus(PoolMemberA *entity.PoolMember, PoolMemberB *entity.PoolMember)
 	rangeBonus := 0
 	if PoolMemberA.RatingRange[0] <= PoolMemberB.Rating &&
 		PoolMemberA.RatingRange[1] >= PoolMemberB.Rating &&
-		PoolMemberB.RatingRange[0] >= PoolMemberA.Rating &&
+		PoolMemberB.RatingRange[0] <= PoolMemberA.Rating &&
 		PoolMemberB.RatingRange[1] >= PoolMemberA.Rating {
 		rangeBonus = 200
 	}
@@ -608,5 +798,7 @@ func IsStandingsIndependent(pm pb.PairingMethod) bool {
 		pm == pb.PairingMethod_TEAM_ROUND_ROBIN ||
 		pm == pb.PairingMethod_RANDOM ||
 		pm == pb.PairingMethod_INITIAL_FONTES ||
+		pm == pb.PairingMethod_SEEDED_SPLIT ||
+		pm == pb.PairingMethod_SEEDED_FOLD ||
 		pm == pb.PairingMethod_MANUAL
 }
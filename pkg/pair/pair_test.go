@@ -6,7 +6,9 @@ import (
 
 	"github.com/matryer/is"
 
+	"github.com/domino14/liwords/pkg/entity"
 	"github.com/domino14/liwords/pkg/utilities"
+	pb "github.com/domino14/liwords/rpc/api/proto/ipc"
 )
 
 // The vast majority of pairing tests are in the tournament package
@@ -161,6 +163,296 @@ func TestInitialFontes(t *testing.T) {
 	}
 }
 
+func TestQuickpair(t *testing.T) {
+	is := is.New(t)
+
+	members := []*entity.PoolMember{
+		{Id: "A", Rating: 1500, RatingRange: [2]int{1400, 1600}},
+		{Id: "B", Rating: 1550, RatingRange: [2]int{1450, 1650}},
+		{Id: "C", Rating: 2000, RatingRange: [2]int{1900, 2100}},
+		{Id: "D", Rating: 1640, RatingRange: [2]int{1550, 1750}, Blocking: []string{"A"}},
+	}
+
+	// B could play A or D, but A is the closer match and
+	// D is blocking A. No one is close enough to C.
+	pairings, err := Quickpair(members)
+	is.NoErr(err)
+	is.NoErr(equalPairings([]int{1, 0, -1, -1}, pairings))
+	is.Equal(members[0].Misses, 0)
+	is.Equal(members[2].Misses, 1)
+	is.Equal(members[3].Misses, 1)
+
+	members = []*entity.PoolMember{
+		{Id: "C", Rating: 2000, RatingRange: [2]int{1900, 2100}},
+		{Id: "E", Rating: 1700, RatingRange: [2]int{1600, 2100}},
+	}
+	pairings, err = Quickpair(members)
+	is.NoErr(err)
+	is.NoErr(equalPairings([]int{-1, -1}, pairings))
+
+	// The range widens the longer a player waits
+	members[0].Misses = 30
+	pairings, err = Quickpair(members)
+	is.NoErr(err)
+	is.NoErr(equalPairings([]int{1, 0}, pairings))
+}
+
+func TestRangeBonus(t *testing.T) {
+	is := is.New(t)
+
+	a := &entity.PoolMember{Id: "A", Rating: 1500, RatingRange: [2]int{1400, 1600}}
+	b := &entity.PoolMember{Id: "B", Rating: 1550, RatingRange: [2]int{1450, 1650}}
+	c := &entity.PoolMember{Id: "C", Rating: 1550, RatingRange: [2]int{1525, 1650}}
+
+	// Both players are in each other's range
+	is.Equal(rangeBonus(a, b), 200)
+	is.Equal(rangeBonus(b, a), 200)
+
+	// A is not in the range of C
+	is.Equal(rangeBonus(a, c), 0)
+	is.Equal(rangeBonus(c, a), 0)
+}
+
+func TestEffectiveRatingDifference(t *testing.T) {
+	is := is.New(t)
+
+	a := &entity.PoolMember{Id: "A", Rating: 1500}
+	b := &entity.PoolMember{Id: "B", Rating: 1620}
+	is.Equal(effectiveRatingDifference(a, b), 120)
+	is.Equal(effectiveRatingDifference(b, a), 120)
+
+	// A new player's rating says less about how close the game will be.
+	b.RatingDeviation = 350
+	is.True(effectiveRatingDifference(a, b) < 100)
+}
+
+func TestCapRelativeWeights(t *testing.T) {
+	is := is.New(t)
+
+	controls := &pb.RoundControl{
+		PairingMethod:               pb.PairingMethod_SWISS,
+		WinDifferenceRelativeWeight: int32(entity.MaxRelativeWeight + 1),
+		RepeatRelativeWeight:        1,
+	}
+	members := &entity.UnpairedPoolMembers{RoundControls: controls}
+
+	capped := CapRelativeWeights(members)
+	is.Equal(capped.RoundControls.WinDifferenceRelativeWeight, int32(entity.MaxRelativeWeight))
+	is.Equal(capped.RoundControls.RepeatRelativeWeight, int32(1))
+
+	// The controls that were given are left as they are
+	is.Equal(controls.WinDifferenceRelativeWeight, int32(entity.MaxRelativeWeight+1))
+	is.True(members.RoundControls == controls)
+
+	controls.WinDifferenceRelativeWeight = 1
+	is.True(CapRelativeWeights(members) == members)
+}
+
+func TestSwissFirstsBalance(t *testing.T) {
+	is := is.New(t)
+
+	// A and B have both gone first, C and D have both gone second.
+	newMembers := func(firstsRelativeWeight int32) *entity.UnpairedPoolMembers {
+		return &entity.UnpairedPoolMembers{
+			RoundControls: &pb.RoundControl{
+				PairingMethod:               pb.PairingMethod_SWISS,
+				MaxRepeats:                  1,
+				WinDifferenceRelativeWeight: 1,
+				FirstsRelativeWeight:        firstsRelativeWeight,
+			},
+			PoolMembers: []*entity.PoolMember{
+				{Id: "A", Wins: 1, Firsts: 1},
+				{Id: "B", Wins: 1, Firsts: 1},
+				{Id: "C", Seconds: 1},
+				{Id: "D", Seconds: 1},
+			},
+		}
+	}
+
+	is.Equal(firstsImbalance(&entity.PoolMember{Firsts: 1}, &entity.PoolMember{Seconds: 1}), 0)
+	is.Equal(firstsImbalance(&entity.PoolMember{Firsts: 1}, &entity.PoolMember{}), 0)
+	is.Equal(firstsImbalance(&entity.PoolMember{Firsts: 3}, &entity.PoolMember{Firsts: 2, Seconds: 1}), 2)
+	is.Equal(firstsImbalance(&entity.PoolMember{Seconds: 2}, &entity.PoolMember{Seconds: 3}), 3)
+
+	// Without the firsts weight, players are paired by record
+	pairings, err := minWeightMatching(newMembers(0))
+	is.NoErr(err)
+	is.NoErr(equalPairings([]int{1, 0, 3, 2}, pairings))
+
+	// With it, complementary histories are worth the win difference
+	pairings, err = minWeightMatching(newMembers(2))
+	is.NoErr(err)
+	is.True(pairings[0] == 2 || pairings[0] == 3)
+	is.True(pairings[1] == 2 || pairings[1] == 3)
+}
+
+func TestSwissContention(t *testing.T) {
+	is := is.New(t)
+
+	// A and B can still reach a prize place, C and D can't.
+	members := &entity.UnpairedPoolMembers{
+		RoundControls: &pb.RoundControl{
+			PairingMethod:               pb.PairingMethod_SWISS,
+			MaxRepeats:                  1,
+			WinDifferenceRelativeWeight: 1,
+		},
+		PoolMembers: []*entity.PoolMember{
+			{Id: "A", Wins: 2, Spread: 100, Contender: true},
+			{Id: "B", Wins: 1, Spread: 90, Contender: true},
+			{Id: "C", Wins: 1, Spread: 0},
+			{Id: "D", Spread: 50},
+		},
+	}
+
+	// The larger spread differences win out without contention
+	pairings, err := minWeightMatching(members)
+	is.NoErr(err)
+	is.NoErr(equalPairings([]int{2, 3, 0, 1}, pairings))
+
+	members.RoundControls.ContentionPairing = true
+	pairings, err = minWeightMatching(members)
+	is.NoErr(err)
+	is.NoErr(equalPairings([]int{1, 0, 3, 2}, pairings))
+	is.Equal(SwissWeight(members, 0, 2).Contention, entity.ContentionWeight)
+	is.Equal(SwissWeight(members, 0, 1).Contention, int64(0))
+}
+
+func TestSeededPairings(t *testing.T) {
+	is := is.New(t)
+
+	newMembers := func(pm pb.PairingMethod, ratings ...int) *entity.UnpairedPoolMembers {
+		members := &entity.UnpairedPoolMembers{RoundControls: &pb.RoundControl{PairingMethod: pm}}
+		for i, rating := range ratings {
+			members.PoolMembers = append(members.PoolMembers, &entity.PoolMember{Id: fmt.Sprint(i), Rating: rating})
+		}
+		return members
+	}
+
+	// The first round of a Dutch system Swiss: 1 v 5, 2 v 6, 3 v 7, 4 v 8.
+	// The seeds are 5, 1, 6, 2, 8, 3, 7, 4.
+	ratings := []int{1500, 2000, 1400, 1900, 1200, 1800, 1300, 1700}
+	pairings, err := Pair(newMembers(pb.PairingMethod_SEEDED_SPLIT, ratings...))
+	is.NoErr(err)
+	is.NoErr(equalPairings([]int{1, 0, 3, 2, 7, 6, 5, 4}, pairings))
+
+	// Folded: 1 v 8, 2 v 7, 3 v 6, 4 v 5.
+	pairings, err = Pair(newMembers(pb.PairingMethod_SEEDED_FOLD, ratings...))
+	is.NoErr(err)
+	is.NoErr(equalPairings([]int{7, 4, 5, 6, 1, 2, 3, 0}, pairings))
+
+	// The lowest seed is left out with an odd number of players
+	pairings, err = Pair(newMembers(pb.PairingMethod_SEEDED_SPLIT, 5, 4, 3, 2, 1))
+	is.NoErr(err)
+	is.NoErr(equalPairings([]int{2, 3, 0, 1, -1}, pairings))
+
+	pairings, err = Pair(newMembers(pb.PairingMethod_SEEDED_FOLD, 1, 2, 3, 4, 5))
+	is.NoErr(err)
+	is.NoErr(equalPairings([]int{-1, 4, 3, 2, 1}, pairings))
+
+	// Players with the same rating keep their order
+	pairings, err = Pair(newMembers(pb.PairingMethod_SEEDED_SPLIT, 0, 0, 0, 0))
+	is.NoErr(err)
+	is.NoErr(equalPairings([]int{2, 3, 0, 1}, pairings))
+
+	for numberOfPlayers := 2; numberOfPlayers <= 40; numberOfPlayers += 2 {
+		ratings := []int{}
+		for i := 0; i < numberOfPlayers; i++ {
+			ratings = append(ratings, 3000-i)
+		}
+		half := numberOfPlayers / 2
+		split, err := Pair(newMembers(pb.PairingMethod_SEEDED_SPLIT, ratings...))
+		is.NoErr(err)
+		fold, err := Pair(newMembers(pb.PairingMethod_SEEDED_FOLD, ratings...))
+		is.NoErr(err)
+		for i := 0; i < half; i++ {
+			is.Equal(split[i], i+half)
+			is.Equal(split[i+half], i)
+			is.Equal(fold[i], numberOfPlayers-1-i)
+			is.Equal(fold[numberOfPlayers-1-i], i)
+		}
+	}
+
+	is.True(IsStandingsIndependent(pb.PairingMethod_SEEDED_SPLIT))
+	is.True(IsStandingsIndependent(pb.PairingMethod_SEEDED_FOLD))
+}
+
+func TestAccelerate(t *testing.T) {
+	is := is.New(t)
+
+	newPoolMembers := func(numberOfPlayers int) []*entity.PoolMember {
+		poolMembers := []*entity.PoolMember{}
+		for i := 0; i < numberOfPlayers; i++ {
+			poolMembers = append(poolMembers, &entity.PoolMember{Id: fmt.Sprint(i), Rating: 2000 - i})
+		}
+		return poolMembers
+	}
+	groupSize := func(poolMembers []*entity.PoolMember) int {
+		size := 0
+		for _, pm := range poolMembers {
+			if pm.VirtualPoints > 0 {
+				size++
+			}
+		}
+		return size
+	}
+
+	// The top group of the Baku acceleration has 2 * ceil(n / 4) players
+	for numberOfPlayers, size := range map[int]int{6: 4, 8: 4, 20: 10, 21: 12, 22: 12, 24: 12, 25: 14} {
+		poolMembers := newPoolMembers(numberOfPlayers)
+		Accelerate(poolMembers, 2, 0)
+		is.Equal(groupSize(poolMembers), size)
+		for i, pm := range poolMembers {
+			is.Equal(pm.VirtualPoints > 0, i < size)
+		}
+	}
+
+	// A virtual win in the first half of the accelerated rounds,
+	// rounded up, and a virtual draw in the rest
+	for acceleratedRounds, points := range map[int][]int{1: {2}, 2: {2, 1}, 3: {2, 2, 1}, 4: {2, 2, 1, 1}} {
+		for acceleratedRound, virtualPoints := range points {
+			poolMembers := newPoolMembers(8)
+			Accelerate(poolMembers, acceleratedRounds, acceleratedRound)
+			is.Equal(poolMembers[0].VirtualPoints, virtualPoints)
+			is.Equal(poolMembers[7].VirtualPoints, 0)
+		}
+	}
+
+	// The seeds are not in order
+	poolMembers := newPoolMembers(8)
+	poolMembers[0].Rating, poolMembers[7].Rating = poolMembers[7].Rating, poolMembers[0].Rating
+	Accelerate(poolMembers, 2, 0)
+	is.Equal(poolMembers[0].VirtualPoints, 0)
+	is.Equal(poolMembers[7].VirtualPoints, 2)
+
+	// In the first round, the top group only plays within itself
+	members := &entity.UnpairedPoolMembers{
+		RoundControls: &pb.RoundControl{
+			PairingMethod:               pb.PairingMethod_SWISS,
+			MaxRepeats:                  1,
+			WinDifferenceRelativeWeight: 1,
+			Accelerated:                 true,
+		},
+		PoolMembers: newPoolMembers(8),
+	}
+	Accelerate(members.PoolMembers, 2, 0)
+	pairings, err := minWeightMatching(members)
+	is.NoErr(err)
+	for i, j := range pairings {
+		is.Equal(i < 4, j < 4)
+	}
+
+	// In the second of two accelerated rounds the top group
+	// is only a virtual draw ahead
+	members.PoolMembers = newPoolMembers(4)
+	members.PoolMembers[1].Wins = 1
+	members.PoolMembers[2].Wins = 1
+	Accelerate(members.PoolMembers, 2, 1)
+	is.Equal(SwissWeight(members, 1, 2).WinDifference, entity.WinWeightScaling/2)
+	is.Equal(SwissWeight(members, 0, 3).WinDifference, entity.WinWeightScaling/2)
+	is.Equal(SwissWeight(members, 0, 1).WinDifference, entity.WinWeightScaling)
+	is.Equal(SwissWeight(members, 1, 3).WinDifference, 3*entity.WinWeightScaling/2)
+}
+
 func equalPairings(s1 []int, s2 []int) error {
 	if len(s1) != len(s2) {
 		return fmt.Errorf("pairing lengths do not match: %d != %d", len(s1), len(s2))
@@ -0,0 +1,177 @@
+package profile
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/domino14/liwords/pkg/entity"
+	pb "github.com/domino14/liwords/rpc/api/proto/user_service"
+)
+
+const (
+	DefaultLeaderboardLimit  = 50
+	MaxLeaderboardLimit      = 200
+	MaxLeaderboardOffset     = 10000
+	MaxLeaderboardActiveDays = 365
+	// While ratings keep changing, a leaderboard is computed again at most
+	// this often.
+	LeaderboardRefreshInterval = time.Minute
+	// Cached leaderboards are read this many players at a time.
+	leaderboardPageSize = 200
+)
+
+// LeaderboardStore caches the players of each variant who may be on its
+// leaderboards, highest rating first.
+type LeaderboardStore interface {
+	LeaderboardUpdated(ctx context.Context, variant entity.VariantKey) (time.Time, bool, error)
+	GetLeaderboardPage(ctx context.Context, variant entity.VariantKey, start int, count int) ([]*entity.PlayerRating, error)
+	SetLeaderboard(ctx context.Context, variant entity.VariantKey, ratings []*entity.PlayerRating, updatedAt time.Time) error
+}
+
+// leaderboardFilter picks the players of a leaderboard who are from the
+// country if one is given, played since activeSince and, unless
+// includeProvisional is set, do not have provisional ratings.
+type leaderboardFilter struct {
+	countryCode        string
+	activeSince        int64
+	includeProvisional bool
+	now                int64
+}
+
+func (ps *ProfileService) GetLeaderboard(ctx context.Context, r *pb.LeaderboardRequest) (*pb.LeaderboardResponse, error) {
+	if r.Variant == "" {
+		return nil, twirp.NewError(twirp.InvalidArgument, "variant is required")
+	}
+	limit := int(r.Limit)
+	if limit == 0 {
+		limit = DefaultLeaderboardLimit
+	}
+	if limit < 0 || limit > MaxLeaderboardLimit {
+		return nil, twirp.NewError(twirp.InvalidArgument, "limit must be between 1 and 200")
+	}
+	offset := int(r.Offset)
+	if offset < 0 || offset > MaxLeaderboardOffset {
+		return nil, twirp.NewError(twirp.InvalidArgument, "offset must be between 0 and 10000")
+	}
+	activeDays := int(r.ActiveDays)
+	if activeDays == 0 {
+		activeDays = int(entity.InactiveAfter / (24 * time.Hour))
+	}
+	if activeDays < 0 || activeDays > MaxLeaderboardActiveDays {
+		return nil, twirp.NewError(twirp.InvalidArgument, "active days must be between 1 and 365")
+	}
+
+	now := time.Now()
+	page, updatedAt, err := ps.leaderboard(ctx, entity.VariantKey(r.Variant), now)
+	if err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+	filter := &leaderboardFilter{
+		countryCode:        r.CountryCode,
+		activeSince:        now.AddDate(0, 0, -activeDays).Unix(),
+		includeProvisional: r.IncludeProvisional,
+		now:                now.Unix(),
+	}
+	entries, err := leaderboardEntries(page, filter, offset, limit)
+	if err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+	return &pb.LeaderboardResponse{
+		Entries:   entries,
+		UpdatedAt: timestamppb.New(updatedAt),
+	}, nil
+}
+
+// leaderboard returns a function that reads the players who may be on the
+// leaderboards of the variant, highest rating first, and when they were
+// listed.
+func (ps *ProfileService) leaderboard(ctx context.Context, variant entity.VariantKey, now time.Time) (
+	func(start, count int) ([]*entity.PlayerRating, error), time.Time, error) {
+
+	cachedPage := func(start, count int) ([]*entity.PlayerRating, error) {
+		return ps.leaderboardStore.GetLeaderboardPage(ctx, variant, start, count)
+	}
+	updatedAt, stale, err := ps.leaderboardStore.LeaderboardUpdated(ctx, variant)
+	if err != nil {
+		// Compute it again.
+		log.Err(err).Str("variant", string(variant)).Msg("get-cached-leaderboard")
+	} else if !updatedAt.IsZero() && (!stale || now.Sub(updatedAt) < LeaderboardRefreshInterval) {
+		return cachedPage, updatedAt, nil
+	}
+
+	ratings, err := ps.userStore.ListRatings(ctx, variant, now.AddDate(0, 0, -MaxLeaderboardActiveDays).Unix())
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	err = ps.leaderboardStore.SetLeaderboard(ctx, variant, ratings, now)
+	if err != nil {
+		log.Err(err).Str("variant", string(variant)).Msg("set-cached-leaderboard")
+	}
+	return slicePage(ratings), now, nil
+}
+
+func slicePage(ratings []*entity.PlayerRating) func(start, count int) ([]*entity.PlayerRating, error) {
+	return func(start, count int) ([]*entity.PlayerRating, error) {
+		if start >= len(ratings) {
+			return nil, nil
+		}
+		end := start + count
+		if end > len(ratings) {
+			end = len(ratings)
+		}
+		return ratings[start:end], nil
+	}
+}
+
+// leaderboardEntries ranks the players the filter picks, highest rating
+// first, and returns limit of them after skipping offset. It reads the
+// leaderboard a page at a time until it has enough.
+func leaderboardEntries(page func(start, count int) ([]*entity.PlayerRating, error),
+	filter *leaderboardFilter, offset int, limit int) ([]*pb.LeaderboardEntry, error) {
+
+	entries := []*pb.LeaderboardEntry{}
+	rank := 0
+	for start := 0; len(entries) < limit; start += leaderboardPageSize {
+		ratings, err := page(start, leaderboardPageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, pr := range ratings {
+			if len(entries) == limit {
+				break
+			}
+			if filter.countryCode != "" && pr.CountryCode != filter.countryCode {
+				continue
+			}
+			if pr.Rating.LastGameTimestamp < filter.activeSince {
+				continue
+			}
+			provisional := pr.Rating.IsProvisional(filter.now)
+			if provisional && !filter.includeProvisional {
+				continue
+			}
+			rank++
+			if rank <= offset {
+				continue
+			}
+			entries = append(entries, &pb.LeaderboardEntry{
+				Rank:            int32(rank),
+				UserId:          pr.UserID,
+				Username:        pr.Username,
+				CountryCode:     pr.CountryCode,
+				Rating:          pr.Rating.Rating,
+				RatingDeviation: pr.Rating.RatingDeviation,
+				LastGame:        timestamppb.New(time.Unix(pr.Rating.LastGameTimestamp, 0)),
+				Provisional:     provisional,
+			})
+		}
+		if len(ratings) < leaderboardPageSize {
+			break
+		}
+	}
+	return entries, nil
+}
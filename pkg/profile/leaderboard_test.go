@@ -0,0 +1,54 @@
+package profile
+
+import (
+	"testing"
+
+	"github.com/matryer/is"
+
+	"github.com/domino14/liwords/pkg/entity"
+)
+
+func TestLeaderboardEntries(t *testing.T) {
+	is := is.New(t)
+	now := int64(100 * 24 * 60 * 60)
+	day := int64(24 * 60 * 60)
+
+	ratings := []*entity.PlayerRating{
+		{UserID: "u1", Username: "mina", CountryCode: "us", Rating: entity.NewSingleRating(2100, 200, 0.06, now-day)},
+		{UserID: "u2", Username: "cesar", CountryCode: "us", Rating: entity.NewSingleRating(2000, 70, 0.06, now-day)},
+		{UserID: "u3", Username: "jesse", CountryCode: "ca", Rating: entity.NewSingleRating(1900, 70, 0.06, now-60*day)},
+		{UserID: "u4", Username: "will", CountryCode: "us", Rating: entity.NewSingleRating(1800, 65, 0.06, now-2*day)},
+	}
+
+	entries, err := leaderboardEntries(slicePage(ratings), &leaderboardFilter{activeSince: now - 90*day, now: now}, 0, 10)
+	is.NoErr(err)
+	is.Equal(len(entries), 3)
+	is.Equal(entries[0].Username, "cesar")
+	is.Equal(entries[0].Rank, int32(1))
+	is.Equal(entries[2].Username, "will")
+	is.Equal(entries[2].Rank, int32(3))
+
+	entries, err = leaderboardEntries(slicePage(ratings),
+		&leaderboardFilter{activeSince: now - 30*day, includeProvisional: true, now: now}, 0, 10)
+	is.NoErr(err)
+	is.Equal(len(entries), 3)
+	is.Equal(entries[0].Username, "mina")
+	is.True(entries[0].Provisional)
+	is.Equal(entries[1].Username, "cesar")
+
+	entries, err = leaderboardEntries(slicePage(ratings), &leaderboardFilter{countryCode: "us", activeSince: now - 90*day, now: now}, 0, 1)
+	is.NoErr(err)
+	is.Equal(len(entries), 1)
+	is.Equal(entries[0].Username, "cesar")
+
+	// The second page keeps the ranks of the first.
+	entries, err = leaderboardEntries(slicePage(ratings), &leaderboardFilter{countryCode: "us", activeSince: now - 90*day, now: now}, 1, 1)
+	is.NoErr(err)
+	is.Equal(len(entries), 1)
+	is.Equal(entries[0].Username, "will")
+	is.Equal(entries[0].Rank, int32(2))
+
+	entries, err = leaderboardEntries(slicePage(ratings), &leaderboardFilter{countryCode: "fr", activeSince: now - 90*day, now: now}, 0, 10)
+	is.NoErr(err)
+	is.Equal(len(entries), 0)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/domino14/liwords/pkg/apiserver"
 	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/glicko"
 	"github.com/domino14/liwords/pkg/mod"
 	"github.com/domino14/liwords/pkg/user"
 	"github.com/rs/zerolog/log"
@@ -18,12 +19,13 @@ import (
 )
 
 type ProfileService struct {
-	userStore     user.Store
-	avatarService user.UploadService
+	userStore        user.Store
+	avatarService    user.UploadService
+	leaderboardStore LeaderboardStore
 }
 
-func NewProfileService(u user.Store, us user.UploadService) *ProfileService {
-	return &ProfileService{userStore: u, avatarService: us}
+func NewProfileService(u user.Store, us user.UploadService, ls LeaderboardStore) *ProfileService {
+	return &ProfileService{userStore: u, avatarService: us, leaderboardStore: ls}
 }
 
 func (ps *ProfileService) GetRatings(ctx context.Context, r *pb.RatingsRequest) (*pb.RatingsResponse, error) {
@@ -42,6 +44,70 @@ func (ps *ProfileService) GetRatings(ctx context.Context, r *pb.RatingsRequest)
 	}, nil
 }
 
+func (ps *ProfileService) GetRatingHistory(ctx context.Context, r *pb.RatingHistoryRequest) (*pb.RatingHistoryResponse, error) {
+	user, err := ps.userStore.Get(ctx, r.Username)
+	if err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+	if r.MaxPoints < 0 {
+		return nil, twirp.NewError(twirp.InvalidArgument, "max points must not be negative")
+	}
+	var begin, end time.Time
+	if r.Begin != nil {
+		begin = r.Begin.AsTime()
+	}
+	if r.End != nil {
+		end = r.End.AsTime()
+	}
+	entries, err := ps.userStore.GetRatingHistory(ctx, user.UUID, entity.VariantKey(r.Variant), begin, end)
+	if err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+	return &pb.RatingHistoryResponse{
+		Entries: downsampleRatingHistory(entries, int(r.MaxPoints)),
+	}, nil
+}
+
+func (ps *ProfileService) GetExpectedScore(ctx context.Context, r *pb.ExpectedScoreRequest) (*pb.ExpectedScoreResponse, error) {
+	if r.Variant == "" {
+		return nil, twirp.NewError(twirp.InvalidArgument, "variant is required")
+	}
+	var ratings [2]*entity.SingleRating
+	for idx, username := range []string{r.Username, r.OpponentUsername} {
+		u, err := ps.userStore.Get(ctx, username)
+		if err != nil {
+			return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+		}
+		ratings[idx], err = u.GetRating(entity.VariantKey(r.Variant))
+		if err != nil {
+			return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+		}
+	}
+	// Ratings of players who have not played for a while are less certain.
+	now := time.Now().Unix()
+	rd0, rd1 := ratings[0].DecayedRatingDeviation(now), ratings[1].DecayedRatingDeviation(now)
+	return &pb.ExpectedScoreResponse{
+		WinProbability: glicko.ExpectedScore(ratings[0].Rating, rd0, ratings[1].Rating, rd1),
+		ExpectedSpread: glicko.ExpectedSpread(ratings[0].Rating, rd0, ratings[1].Rating, rd1),
+		Rating:         ratings[0].Rating,
+		OpponentRating: ratings[1].Rating,
+	}, nil
+}
+
+// downsampleRatingHistory splits the entries into maxPoints buckets of
+// consecutive games and keeps the last entry of each bucket, so that the
+// most recent rating is always shown.
+func downsampleRatingHistory(entries []*pb.RatingHistoryEntry, maxPoints int) []*pb.RatingHistoryEntry {
+	if maxPoints == 0 || len(entries) <= maxPoints {
+		return entries
+	}
+	sampled := make([]*pb.RatingHistoryEntry, maxPoints)
+	for i := range sampled {
+		sampled[i] = entries[(i+1)*len(entries)/maxPoints-1]
+	}
+	return sampled
+}
+
 func (ps *ProfileService) GetStats(ctx context.Context, r *pb.StatsRequest) (*pb.StatsResponse, error) {
 	user, err := ps.userStore.Get(ctx, r.Username)
 	if err != nil {
@@ -0,0 +1,359 @@
+// Package rerate recomputes ratings from scratch by replaying every rated
+// game, so that rating bugs can be fixed and the Glicko-225 constants tuned.
+package rerate
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/gameplay"
+	"github.com/domino14/liwords/pkg/glicko"
+	pb "github.com/domino14/liwords/rpc/api/proto/config_service"
+)
+
+// GameLister lists the rated games of some variants in the order they ended.
+type GameLister interface {
+	ListRatedGames(ctx context.Context, variants []entity.VariantKey) ([]*entity.RatedGame, error)
+}
+
+// RatingStore holds the current ratings.
+type RatingStore interface {
+	GetByUUID(ctx context.Context, uuid string) (*entity.User, error)
+	ReplaceRatings(ctx context.Context, variants []entity.VariantKey, changes []*entity.RatingChange) error
+}
+
+// TournamentGetter gets the tournaments of the games.
+type TournamentGetter interface {
+	Get(ctx context.Context, id string) (*entity.Tournament, error)
+}
+
+// A ReplacementNotifier tells the API nodes that ratings were replaced, so
+// that they stop using the ratings they cached.
+type ReplacementNotifier interface {
+	RatingsReplaced(ctx context.Context, variants []entity.VariantKey) error
+}
+
+// Engine replays rated games to recompute ratings.
+type Engine struct {
+	ratingStore     RatingStore
+	gameLister      GameLister
+	tournamentStore TournamentGetter
+	notifier        ReplacementNotifier
+}
+
+func NewEngine(rs RatingStore, gl GameLister, ts TournamentGetter, n ReplacementNotifier) *Engine {
+	return &Engine{ratingStore: rs, gameLister: gl, tournamentStore: ts, notifier: n}
+}
+
+// A period is a tournament division whose games are rated together.
+type period struct {
+	tournamentID string
+	division     string
+}
+
+// Rerate recomputes the ratings of the requested variants and compares them
+// with the current ratings. If the request asks for it, the new ratings and
+// rating histories replace the current ones in one transaction, and players
+// none of whose games could be rated go back to the initial rating.
+func (e *Engine) Rerate(ctx context.Context, req *pb.RerateRequest) (*pb.RerateResponse, error) {
+	if len(req.Variants) == 0 {
+		return nil, errors.New("no variants were given")
+	}
+	variants := make([]entity.VariantKey, len(req.Variants))
+	for idx, variant := range req.Variants {
+		variants[idx] = entity.VariantKey(variant)
+	}
+
+	games, err := e.gameLister.ListRatedGames(ctx, variants)
+	if err != nil {
+		return nil, err
+	}
+	periods, err := e.ratingPeriods(ctx, games)
+	if err != nil {
+		return nil, err
+	}
+	changes, skipped := replay(games, parameters(req.Parameters), periods)
+	log.Info().Int("games", len(games)).Int("skipped", skipped).Int("changes", len(changes)).
+		Interface("variants", variants).Msg("replayed-rated-games")
+
+	differences, err := e.differences(ctx, changes, req.MinDifference)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.RerateResponse{
+		Games:        int32(len(games)),
+		SkippedGames: int32(skipped),
+		Differences:  differences,
+	}
+	if req.Apply {
+		err = e.ratingStore.ReplaceRatings(ctx, variants, changes)
+		if err != nil {
+			return nil, err
+		}
+		resp.Applied = true
+		log.Info().Interface("variants", variants).Msg("replaced-ratings")
+		err = e.notifier.RatingsReplaced(ctx, variants)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// ratingPeriods finds the tournament divisions of the games that are rated
+// as one rating period. Such a division is only rated once it has finished,
+// so its games are left out until then.
+func (e *Engine) ratingPeriods(ctx context.Context, games []*entity.RatedGame) (map[period]bool, error) {
+	periods := make(map[period]bool)
+	checked := make(map[period]bool)
+	for _, g := range games {
+		p := period{g.TournamentID, g.Division}
+		if g.TournamentID == "" || checked[p] {
+			continue
+		}
+		checked[p] = true
+		t, err := e.tournamentStore.Get(ctx, g.TournamentID)
+		if err != nil {
+			return nil, err
+		}
+		t.RLock()
+		divisionObject, ok := t.Divisions[g.Division]
+		if ok && divisionObject.DivisionManager != nil &&
+			divisionObject.DivisionManager.GetDivisionControls().GetRateAsPeriod() {
+			periods[p] = divisionObject.RatingPeriodApplied
+		}
+		t.RUnlock()
+	}
+	return periods, nil
+}
+
+// differences compares the last rating of every player in the changes with
+// their current rating, largest change first.
+func (e *Engine) differences(ctx context.Context, changes []*entity.RatingChange,
+	minDifference float64) ([]*pb.RatingDifference, error) {
+
+	type key struct {
+		userID  string
+		variant entity.VariantKey
+	}
+	latest := make(map[key]entity.SingleRating)
+	var keys []key
+	for _, change := range changes {
+		k := key{change.UserID, change.Variant}
+		if _, ok := latest[k]; !ok {
+			keys = append(keys, k)
+		}
+		latest[k] = change.Rating
+	}
+
+	var differences []*pb.RatingDifference
+	for _, k := range keys {
+		u, err := e.ratingStore.GetByUUID(ctx, k.userID)
+		if err != nil {
+			return nil, err
+		}
+		current, err := u.GetRating(k.variant)
+		if err != nil {
+			return nil, err
+		}
+		rating := latest[k]
+		if math.Abs(rating.Rating-current.Rating) < minDifference {
+			continue
+		}
+		differences = append(differences, &pb.RatingDifference{
+			UserId:             k.userID,
+			Variant:            string(k.variant),
+			OldRating:          current.Rating,
+			NewRating:          rating.Rating,
+			OldRatingDeviation: current.RatingDeviation,
+			NewRatingDeviation: rating.RatingDeviation,
+		})
+	}
+	sort.SliceStable(differences, func(i, j int) bool {
+		return math.Abs(differences[i].NewRating-differences[i].OldRating) >
+			math.Abs(differences[j].NewRating-differences[j].OldRating)
+	})
+	return differences, nil
+}
+
+// parameters returns the Glicko-225 parameters to rate with.
+func parameters(req *pb.GlickoParameters) glicko.Parameters {
+	params := glicko.DefaultParameters
+	if req == nil {
+		return params
+	}
+	if req.SpreadScaling != 0 {
+		params.SpreadScaling = int(req.SpreadScaling)
+	}
+	if req.MinimumWinBoost != 0 {
+		params.MinimumWinBoost = req.MinimumWinBoost
+	}
+	if req.Steepness != 0 {
+		params.Steepness = req.Steepness
+	}
+	if req.VolatilityDeltaConstraint != 0 {
+		params.VolatilityDeltaConstraint = req.VolatilityDeltaConstraint
+	}
+	if req.MaximumVolatility != 0 {
+		params.MaximumVolatility = req.MaximumVolatility
+	}
+	if req.MinimumRatingDeviation != 0 {
+		params.MinimumRatingDeviation = int(req.MinimumRatingDeviation)
+	}
+	if req.MaximumRatingDeviation != 0 {
+		params.MaximumRatingDeviation = int(req.MaximumRatingDeviation)
+	}
+	if req.RatingPeriodSeconds != 0 {
+		params.RatingPeriodinSeconds = int(req.RatingPeriodSeconds)
+	}
+	return params
+}
+
+// replay rates the games in order, with every player starting out with the
+// initial rating. The games of a division that is rated as one rating period
+// are rated together when its last game ends, if the division was rated;
+// periods maps each such division to whether it was. It returns every rating
+// change in order, and the number of games that could not be rated.
+func replay(games []*entity.RatedGame, params glicko.Parameters, periods map[period]bool) ([]*entity.RatingChange, int) {
+	r := &replayer{params: params, ratings: make(map[entity.VariantKey]map[string]entity.SingleRating)}
+
+	skipped := 0
+	spreads := make([]int, len(games))
+	rateable := make([]bool, len(games))
+	firstGameOf := make(map[period]int)
+	lastGameOf := make(map[period]int)
+	playersOf := make(map[period][]string)
+	for idx, g := range games {
+		spread, err := entity.RatingSpread(params, g.Scores, g.WinnerIdx, g.GameEndReason)
+		if err != nil {
+			log.Debug().Err(err).Str("gid", g.GameID).Msg("replay-skipping-game")
+			skipped++
+			continue
+		}
+		spreads[idx], rateable[idx] = spread, true
+		p := period{g.TournamentID, g.Division}
+		if _, ok := periods[p]; !ok {
+			continue
+		}
+		if _, ok := firstGameOf[p]; !ok {
+			firstGameOf[p] = idx
+		}
+		lastGameOf[p] = idx
+		playersOf[p] = append(playersOf[p], g.PlayerIDs[0], g.PlayerIDs[1])
+	}
+
+	pending := make(map[period][]int)
+	snapshots := make(map[period]map[string]entity.SingleRating)
+	for idx, g := range games {
+		if !rateable[idx] {
+			continue
+		}
+		p := period{g.TournamentID, g.Division}
+		applied, ok := periods[p]
+		if !ok {
+			r0, r1 := gameplay.RateGame(params, r.ratingOf(g.Variant, g.PlayerIDs[0]),
+				r.ratingOf(g.Variant, g.PlayerIDs[1]), spreads[idx], g.EndTime)
+			r.setRating(g.Variant, g.PlayerIDs[0], r0, g.GameID)
+			r.setRating(g.Variant, g.PlayerIDs[1], r1, g.GameID)
+			continue
+		}
+		if !applied {
+			continue
+		}
+		// Like a live period, the period is rated from the ratings the
+		// players had when it started, not when it ended.
+		if firstGameOf[p] == idx {
+			snapshot := make(map[string]entity.SingleRating)
+			for _, player := range playersOf[p] {
+				snapshot[player] = r.ratingOf(g.Variant, player)
+			}
+			snapshots[p] = snapshot
+		}
+		pending[p] = append(pending[p], idx)
+		if lastGameOf[p] == idx {
+			r.ratePeriod(games, spreads, pending[p], snapshots[p], g.EndTime)
+			delete(pending, p)
+			delete(snapshots, p)
+		}
+	}
+	return r.changes, skipped
+}
+
+type replayer struct {
+	params  glicko.Parameters
+	ratings map[entity.VariantKey]map[string]entity.SingleRating
+	changes []*entity.RatingChange
+}
+
+func (r *replayer) ratingOf(variant entity.VariantKey, userID string) entity.SingleRating {
+	if rating, ok := r.ratings[variant][userID]; ok {
+		return rating
+	}
+	return entity.SingleRating{
+		Rating:          float64(glicko.InitialRating),
+		RatingDeviation: float64(glicko.InitialRatingDeviation),
+		Volatility:      glicko.InitialVolatility,
+	}
+}
+
+func (r *replayer) setRating(variant entity.VariantKey, userID string, rating entity.SingleRating, gameID string) {
+	if r.ratings[variant] == nil {
+		r.ratings[variant] = make(map[string]entity.SingleRating)
+	}
+	r.ratings[variant][userID] = rating
+	r.changes = append(r.changes, &entity.RatingChange{
+		UserID:  userID,
+		Variant: variant,
+		Rating:  rating,
+		GameID:  gameID,
+	})
+}
+
+// ratePeriod rates the games of a tournament division together, from the
+// ratings the players had when the period started.
+func (r *replayer) ratePeriod(games []*entity.RatedGame, spreads []int, gameIndexes []int,
+	snapshot map[string]entity.SingleRating, endTime int64) {
+	first := games[gameIndexes[0]]
+	results := make(map[string][]glicko.Result)
+	var players []string
+	for _, idx := range gameIndexes {
+		g := games[idx]
+		for i := 0; i < 2; i++ {
+			player, opponent := g.PlayerIDs[i], g.PlayerIDs[1-i]
+			if _, ok := results[player]; !ok {
+				players = append(players, player)
+			}
+			spread := spreads[idx]
+			if i == 1 {
+				spread = -spread
+			}
+			opponentRating := snapshot[opponent]
+			results[player] = append(results[player], glicko.Result{
+				OpponentRating:          opponentRating.Rating,
+				OpponentRatingDeviation: opponentRating.RatingDeviation,
+				Spread:                  spread,
+			})
+		}
+	}
+
+	newRatings := make([]entity.SingleRating, len(players))
+	for i, player := range players {
+		rating := snapshot[player]
+		lastGameTimestamp := rating.LastGameTimestamp
+		if lastGameTimestamp == 0 {
+			lastGameTimestamp = endTime
+		}
+		newRating, newRatingDeviation, newVolatility := r.params.RatePeriod(
+			rating.Rating, rating.RatingDeviation, rating.Volatility,
+			results[player], int(endTime-lastGameTimestamp))
+		newRatings[i] = entity.NewSingleRating(newRating, newRatingDeviation, newVolatility, endTime)
+	}
+	for i, player := range players {
+		r.setRating(first.Variant, player, newRatings[i], first.TournamentID)
+	}
+}
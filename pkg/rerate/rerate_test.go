@@ -0,0 +1,119 @@
+package rerate
+
+import (
+	"testing"
+
+	"github.com/matryer/is"
+
+	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/gameplay"
+	"github.com/domino14/liwords/pkg/glicko"
+	pb "github.com/domino14/liwords/rpc/api/proto/ipc"
+)
+
+const variant = entity.VariantKey("NWL20.classic.rapid")
+
+func ratedGame(id string, p0, p1 string, s0, s1 int32, endTime int64) *entity.RatedGame {
+	winnerIdx := -1
+	if s0 > s1 {
+		winnerIdx = 0
+	} else if s1 > s0 {
+		winnerIdx = 1
+	}
+	return &entity.RatedGame{
+		GameID:        id,
+		Variant:       variant,
+		PlayerIDs:     [2]string{p0, p1},
+		Scores:        [2]int32{s0, s1},
+		WinnerIdx:     winnerIdx,
+		GameEndReason: pb.GameEndReason_STANDARD,
+		EndTime:       endTime,
+	}
+}
+
+func initialRating() entity.SingleRating {
+	return entity.SingleRating{
+		Rating:          float64(glicko.InitialRating),
+		RatingDeviation: float64(glicko.InitialRatingDeviation),
+		Volatility:      glicko.InitialVolatility,
+	}
+}
+
+func TestReplay(t *testing.T) {
+	is := is.New(t)
+
+	badGame := ratedGame("bad", "cesar", "mina", 400, 300, 150)
+	badGame.WinnerIdx = 1
+	games := []*entity.RatedGame{
+		ratedGame("g1", "cesar", "mina", 400, 300, 100),
+		badGame,
+		ratedGame("g2", "mina", "jesse", 350, 351, 200),
+	}
+	changes, skipped := replay(games, glicko.DefaultParameters, nil)
+	is.Equal(skipped, 1)
+	is.Equal(len(changes), 4)
+
+	cesar, mina := gameplay.RateGame(glicko.DefaultParameters, initialRating(), initialRating(), 100, 100)
+	mina, jesse := gameplay.RateGame(glicko.DefaultParameters, mina, initialRating(), -1, 200)
+	is.Equal(changes[0], &entity.RatingChange{UserID: "cesar", Variant: variant, Rating: cesar, GameID: "g1"})
+	is.Equal(changes[2], &entity.RatingChange{UserID: "mina", Variant: variant, Rating: mina, GameID: "g2"})
+	is.Equal(changes[3], &entity.RatingChange{UserID: "jesse", Variant: variant, Rating: jesse, GameID: "g2"})
+
+	// A larger spread scaling makes the same win count for less.
+	params := glicko.DefaultParameters
+	params.SpreadScaling = 250
+	changes, _ = replay(games, params, nil)
+	is.True(changes[0].Rating.Rating < cesar.Rating)
+}
+
+func TestReplayRatingPeriods(t *testing.T) {
+	is := is.New(t)
+
+	inPeriod := func(g *entity.RatedGame, tid string) *entity.RatedGame {
+		g.TournamentID = tid
+		g.Division = "A"
+		return g
+	}
+	games := []*entity.RatedGame{
+		ratedGame("g1", "cesar", "mina", 400, 300, 100),
+		inPeriod(ratedGame("t1", "cesar", "jesse", 400, 300, 200), "rated"),
+		inPeriod(ratedGame("t2", "mina", "jesse", 300, 400, 300), "unfinished"),
+		ratedGame("g1b", "mina", "zoe", 400, 300, 350),
+		inPeriod(ratedGame("t3", "jesse", "mina", 420, 400, 400), "rated"),
+		ratedGame("g2", "cesar", "mina", 400, 300, 500),
+	}
+	periods := map[period]bool{
+		{"rated", "A"}:      true,
+		{"unfinished", "A"}: false,
+	}
+	changes, skipped := replay(games, glicko.DefaultParameters, periods)
+	is.Equal(skipped, 0)
+	// The unfinished division isn't rated, and the rated one is rated
+	// once for each of its three players.
+	is.Equal(len(changes), 2+2+3+2)
+
+	cesar, mina := gameplay.RateGame(glicko.DefaultParameters, initialRating(), initialRating(), 100, 100)
+	for _, change := range changes[4:7] {
+		is.Equal(change.GameID, "rated")
+		is.Equal(change.Rating.LastGameTimestamp, int64(400))
+	}
+	// Every game of the period is rated against the ratings from before it,
+	// even those of players who played outside of it while it was on.
+	r, rd, v := glicko.RatePeriod(cesar.Rating, cesar.RatingDeviation, cesar.Volatility,
+		[]glicko.Result{{
+			OpponentRating:          float64(glicko.InitialRating),
+			OpponentRatingDeviation: float64(glicko.InitialRatingDeviation),
+			Spread:                  100,
+		}}, 300)
+	is.Equal(changes[4].UserID, "cesar")
+	is.Equal(changes[4].Rating, entity.NewSingleRating(r, rd, v, 400))
+
+	r, rd, v = glicko.RatePeriod(float64(glicko.InitialRating), float64(glicko.InitialRatingDeviation), glicko.InitialVolatility,
+		[]glicko.Result{
+			{OpponentRating: cesar.Rating, OpponentRatingDeviation: cesar.RatingDeviation, Spread: -100},
+			{OpponentRating: mina.Rating, OpponentRatingDeviation: mina.RatingDeviation, Spread: 20},
+		}, 0)
+	is.Equal(changes[5].UserID, "jesse")
+	is.Equal(changes[5].Rating, entity.NewSingleRating(r, rd, v, 400))
+	is.Equal(changes[7].GameID, "g2")
+}
@@ -80,6 +80,11 @@ type game struct {
 	// most games.
 	TournamentID   string `gorm:"index"`
 	TournamentData datatypes.JSON
+
+	// RatingVariant is the rating key of a rated game, and empty for an
+	// unrated game. It is NULL for games saved before it was added, until
+	// BackfillRatingVariants fills it in.
+	RatingVariant *string `gorm:"index"`
 }
 
 // NewDBStore creates a new DB store for games.
@@ -598,6 +603,123 @@ func (s *DBStore) ListAllIDs(ctx context.Context) ([]string, error) {
 	return ids, result.Error
 }
 
+// ListRatedGames returns every rated game that ended in one of the given
+// variants, in the order in which they ended. Games whose rating variant
+// has not been backfilled are left out.
+func (s *DBStore) ListRatedGames(ctx context.Context, variants []entity.VariantKey) ([]*entity.RatedGame, error) {
+	variantNames := make([]string, len(variants))
+	for idx, variant := range variants {
+		variantNames[idx] = string(variant)
+	}
+
+	var games []*entity.RatedGame
+	var rows []*game
+	ctxDB := s.db.WithContext(ctx)
+	result := ctxDB.Select("id", "uuid", "timers", "game_end_reason", "winner_idx", "quickdata",
+		"rating_variant", "tournament_id", "tournament_data").
+		Where("rating_variant IN ?", variantNames).
+		Where("game_end_reason NOT IN ?", []int{int(pb.GameEndReason_NONE),
+			int(pb.GameEndReason_ABORTED), int(pb.GameEndReason_CANCELLED)}).
+		FindInBatches(&rows, 1000, func(tx *gorm.DB, batch int) error {
+			for _, row := range rows {
+				rg, err := ratedGame(row)
+				if err != nil {
+					log.Err(err).Str("gid", row.UUID).Msg("list-rated-games-skipping")
+					continue
+				}
+				games = append(games, rg)
+			}
+			return nil
+		})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	sort.SliceStable(games, func(i, j int) bool {
+		return games[i].EndTime < games[j].EndTime
+	})
+	return games, nil
+}
+
+// BackfillRatingVariants fills in the rating variant of the games that were
+// saved before games had one. Games whose request can't be read are left
+// without one. It returns the number of games it updated.
+func (s *DBStore) BackfillRatingVariants(ctx context.Context) (int, error) {
+	updated := 0
+	var rows []*game
+	ctxDB := s.db.WithContext(ctx)
+	result := ctxDB.Select("id", "uuid", "request").
+		Where("rating_variant IS NULL").
+		FindInBatches(&rows, 1000, func(tx *gorm.DB, batch int) error {
+			for _, row := range rows {
+				req := &pb.GameRequest{}
+				err := proto.Unmarshal(row.Request, req)
+				if err != nil {
+					// Leave the variant unknown rather than marking the game unrated.
+					log.Err(err).Str("gid", row.UUID).Msg("backfill-rating-variant-skipping")
+					continue
+				}
+				err = ctxDB.Model(&game{}).Where("id = ?", row.ID).
+					Update("rating_variant", ratingVariant(req)).Error
+				if err != nil {
+					return err
+				}
+				updated++
+			}
+			return nil
+		})
+	return updated, result.Error
+}
+
+// ratingVariant returns the rating key of a rated game request, or an
+// empty string if the game is not rated.
+func ratingVariant(req *pb.GameRequest) string {
+	if req.GetRatingMode() != pb.RatingMode_RATED || req.Rules == nil {
+		return ""
+	}
+	timefmt, variant, err := entity.VariantFromGameReq(req)
+	if err != nil {
+		return ""
+	}
+	return string(entity.ToVariantKey(req.Lexicon, variant, timefmt))
+}
+
+// ratedGame returns what is needed to rate a rated game again.
+func ratedGame(g *game) (*entity.RatedGame, error) {
+	if g.RatingVariant == nil || *g.RatingVariant == "" {
+		return nil, errors.New("game is not rated")
+	}
+	var tdata entity.Timers
+	err := json.Unmarshal(g.Timers, &tdata)
+	if err != nil {
+		return nil, err
+	}
+	var qdata entity.Quickdata
+	err = json.Unmarshal(g.Quickdata, &qdata)
+	if err != nil {
+		return nil, err
+	}
+	if len(qdata.PlayerInfo) != 2 || len(qdata.FinalScores) != 2 {
+		return nil, errors.New("game is missing players or final scores")
+	}
+
+	rg := &entity.RatedGame{
+		GameID:        g.UUID,
+		Variant:       entity.VariantKey(*g.RatingVariant),
+		PlayerIDs:     [2]string{qdata.PlayerInfo[0].UserId, qdata.PlayerInfo[1].UserId},
+		Scores:        [2]int32{qdata.FinalScores[0], qdata.FinalScores[1]},
+		WinnerIdx:     g.WinnerIdx,
+		GameEndReason: pb.GameEndReason(g.GameEndReason),
+		EndTime:       tdata.TimeOfLastUpdate / 1000,
+		TournamentID:  g.TournamentID,
+	}
+	var trdata entity.TournamentData
+	if json.Unmarshal(g.TournamentData, &trdata) == nil {
+		rg.Division = trdata.Division
+	}
+	return rg, nil
+}
+
 func (s *DBStore) SetReady(ctx context.Context, gid string, pidx int) (int, error) {
 	var rf struct {
 		ReadyFlag int
@@ -660,6 +782,8 @@ func (s *DBStore) toDBObj(g *entity.Game) (*game, error) {
 		MetaEvents:     mdata,
 		Type:           g.Type,
 	}
+	variant := ratingVariant(g.GameReq)
+	dbg.RatingVariant = &variant
 	if g.TournamentData != nil {
 		dbg.TournamentID = g.TournamentData.Id
 	}
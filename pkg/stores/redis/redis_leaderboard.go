@@ -0,0 +1,161 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+
+	"github.com/domino14/liwords/pkg/entity"
+)
+
+const (
+	// leaderboardPrefix is a sorted set of the user IDs of a variant's
+	// leaderboard, scored by rating.
+	leaderboardPrefix = "leaderboard:"
+	// leaderboardPlayersPrefix is a hash of the players of a variant's
+	// leaderboard, by user ID.
+	leaderboardPlayersPrefix = "leaderboard-players:"
+	leaderboardUpdatedPrefix = "leaderboard-updated:"
+	leaderboardStalePrefix   = "leaderboard-stale:"
+	// leaderboardVariants is the set of variants that have a cached leaderboard.
+	leaderboardVariants = "leaderboard-variants"
+	// Cached leaderboards expire after this many seconds even if no
+	// ratings changed, so that inactive players drop off.
+	LeaderboardExpiration = 60 * 60 * 6
+	// Players are added to a leaderboard this many at a time.
+	leaderboardBatchSize = 500
+)
+
+// RedisLeaderboardStore caches the leaderboards of each variant in Redis.
+type RedisLeaderboardStore struct {
+	redisPool *redis.Pool
+}
+
+func NewRedisLeaderboardStore(r *redis.Pool) *RedisLeaderboardStore {
+	return &RedisLeaderboardStore{redisPool: r}
+}
+
+// LeaderboardUpdated returns when the cached leaderboard of the variant was
+// computed, and whether ratings in the variant changed since then. The time
+// is zero if no leaderboard is cached.
+func (s *RedisLeaderboardStore) LeaderboardUpdated(ctx context.Context, variant entity.VariantKey) (
+	time.Time, bool, error) {
+
+	conn := s.redisPool.Get()
+	defer conn.Close()
+
+	vals, err := redis.Values(conn.Do("MGET", leaderboardUpdatedPrefix+string(variant), leaderboardStalePrefix+string(variant)))
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	updatedAt, err := redis.Int64(vals[0], nil)
+	if err == redis.ErrNil {
+		return time.Time{}, false, nil
+	} else if err != nil {
+		return time.Time{}, false, err
+	}
+	return time.Unix(updatedAt, 0), vals[1] != nil, nil
+}
+
+// GetLeaderboardPage returns count players of the cached leaderboard of the
+// variant, highest rating first, starting with the player at start.
+func (s *RedisLeaderboardStore) GetLeaderboardPage(ctx context.Context, variant entity.VariantKey,
+	start int, count int) ([]*entity.PlayerRating, error) {
+
+	conn := s.redisPool.Get()
+	defer conn.Close()
+
+	ids, err := redis.Strings(conn.Do("ZREVRANGE", leaderboardPrefix+string(variant), start, start+count-1))
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+	args := redis.Args{}.Add(leaderboardPlayersPrefix + string(variant)).AddFlat(ids)
+	players, err := redis.ByteSlices(conn.Do("HMGET", args...))
+	if err != nil {
+		return nil, err
+	}
+	ratings := make([]*entity.PlayerRating, 0, len(players))
+	for _, bts := range players {
+		if bts == nil {
+			// The leaderboard expired while it was read.
+			continue
+		}
+		pr := &entity.PlayerRating{}
+		err = json.Unmarshal(bts, pr)
+		if err != nil {
+			return nil, err
+		}
+		ratings = append(ratings, pr)
+	}
+	return ratings, nil
+}
+
+// SetLeaderboard caches the leaderboard of the variant, computed at the
+// given time.
+func (s *RedisLeaderboardStore) SetLeaderboard(ctx context.Context, variant entity.VariantKey,
+	ratings []*entity.PlayerRating, updatedAt time.Time) error {
+
+	conn := s.redisPool.Get()
+	defer conn.Close()
+
+	boardKey := leaderboardPrefix + string(variant)
+	playersKey := leaderboardPlayersPrefix + string(variant)
+
+	conn.Send("MULTI")
+	conn.Send("DEL", boardKey, playersKey)
+	for start := 0; start < len(ratings); start += leaderboardBatchSize {
+		end := start + leaderboardBatchSize
+		if end > len(ratings) {
+			end = len(ratings)
+		}
+		scores := redis.Args{}.Add(boardKey)
+		players := redis.Args{}.Add(playersKey)
+		for _, pr := range ratings[start:end] {
+			bts, err := json.Marshal(pr)
+			if err != nil {
+				conn.Do("DISCARD")
+				return err
+			}
+			scores = scores.Add(strconv.FormatFloat(pr.Rating.Rating, 'f', -1, 64), pr.UserID)
+			players = players.Add(pr.UserID, bts)
+		}
+		conn.Send("ZADD", scores...)
+		conn.Send("HSET", players...)
+	}
+	conn.Send("EXPIRE", boardKey, LeaderboardExpiration)
+	conn.Send("EXPIRE", playersKey, LeaderboardExpiration)
+	conn.Send("SET", leaderboardUpdatedPrefix+string(variant), updatedAt.Unix(), "EX", LeaderboardExpiration)
+	conn.Send("DEL", leaderboardStalePrefix+string(variant))
+	conn.Send("SADD", leaderboardVariants, string(variant))
+	_, err := conn.Do("EXEC")
+	return err
+}
+
+// RatingsChanged marks the leaderboards of the variants as out of date. If
+// no variants are given, every leaderboard is.
+func (s *RedisLeaderboardStore) RatingsChanged(ctx context.Context, variants []entity.VariantKey) error {
+	conn := s.redisPool.Get()
+	defer conn.Close()
+
+	if len(variants) == 0 {
+		members, err := redis.Strings(conn.Do("SMEMBERS", leaderboardVariants))
+		if err != nil {
+			return err
+		}
+		for _, member := range members {
+			variants = append(variants, entity.VariantKey(member))
+		}
+	}
+	if len(variants) == 0 {
+		return nil
+	}
+	conn.Send("MULTI")
+	for _, variant := range variants {
+		conn.Send("SET", leaderboardStalePrefix+string(variant), "1", "EX", LeaderboardExpiration)
+	}
+	_, err := conn.Do("EXEC")
+	return err
+}
@@ -3,6 +3,7 @@ package tournament
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/domino14/liwords/pkg/entity"
 	lru "github.com/hashicorp/golang-lru"
@@ -16,17 +17,26 @@ type backingStore interface {
 	GetBySlug(ctx context.Context, id string) (*entity.Tournament, error)
 	Set(context.Context, *entity.Tournament) error
 	Create(context.Context, *entity.Tournament) error
+	Delete(ctx context.Context, id string) error
 	GetRecentGames(ctx context.Context, tourneyID string, numGames int, offset int) (*pb.RecentGamesResponse, error)
 	Disconnect()
 	SetTournamentEventChan(c chan<- *entity.EventWrapper)
 	TournamentEventChan() chan<- *entity.EventWrapper
 	GetRecentClubSessions(ctx context.Context, clubID string, numSessions int, offset int) (*pb.ClubSessionsResponse, error)
 	ListAllIDs(context.Context) ([]string, error)
+	ListScheduledIDs(ctx context.Context, now time.Time) ([]string, error)
 
 	AddRegistrants(ctx context.Context, tid string, userIDs []string, division string) error
 	RemoveRegistrants(ctx context.Context, tid string, userIDs []string, division string) error
 	RemoveRegistrantsForTournament(ctx context.Context, tid string) error
 	ActiveTournamentsFor(ctx context.Context, userID string) ([][2]string, error)
+
+	AddAuditLogEntry(ctx context.Context, tid string, entry *pb.AuditLogEntry) error
+	GetAuditLog(ctx context.Context, tid string, limit int, offset int) (*pb.AuditLogResponse, error)
+
+	AddSnapshot(ctx context.Context, t *entity.Tournament, actor string, label string, keep int) (*pb.TournamentSnapshot, error)
+	GetSnapshots(ctx context.Context, tid string) (*pb.SnapshotsResponse, error)
+	GetSnapshot(ctx context.Context, tid string, snapshotID uint64) (*entity.Tournament, error)
 }
 
 const (
@@ -107,6 +117,16 @@ func (c *Cache) setOrCreate(ctx context.Context, tm *entity.Tournament, isNew bo
 	return nil
 }
 
+// Delete deletes the tournament from the cache as well as the store.
+func (c *Cache) Delete(ctx context.Context, id string) error {
+	err := c.backing.Delete(ctx, id)
+	if err != nil {
+		return err
+	}
+	c.cache.Remove(id)
+	return nil
+}
+
 // Unload unloads the tournament from the cache
 func (c *Cache) Unload(ctx context.Context, id string) {
 	c.cache.Remove(id)
@@ -137,6 +157,18 @@ func (c *Cache) ListAllIDs(ctx context.Context) ([]string, error) {
 	return c.backing.ListAllIDs(ctx)
 }
 
+func (c *Cache) ListScheduledIDs(ctx context.Context, now time.Time) ([]string, error) {
+	return c.backing.ListScheduledIDs(ctx, now)
+}
+
+func (c *Cache) AddAuditLogEntry(ctx context.Context, tid string, entry *pb.AuditLogEntry) error {
+	return c.backing.AddAuditLogEntry(ctx, tid, entry)
+}
+
+func (c *Cache) GetAuditLog(ctx context.Context, tid string, limit int, offset int) (*pb.AuditLogResponse, error) {
+	return c.backing.GetAuditLog(ctx, tid, limit, offset)
+}
+
 func (c *Cache) AddRegistrants(ctx context.Context, tid string, userIDs []string, division string) error {
 	return c.backing.AddRegistrants(ctx, tid, userIDs, division)
 }
@@ -152,3 +184,15 @@ func (c *Cache) RemoveRegistrantsForTournament(ctx context.Context, tid string)
 func (c *Cache) ActiveTournamentsFor(ctx context.Context, userID string) ([][2]string, error) {
 	return c.backing.ActiveTournamentsFor(ctx, userID)
 }
+
+func (c *Cache) AddSnapshot(ctx context.Context, t *entity.Tournament, actor string, label string, keep int) (*pb.TournamentSnapshot, error) {
+	return c.backing.AddSnapshot(ctx, t, actor, label, keep)
+}
+
+func (c *Cache) GetSnapshots(ctx context.Context, tid string) (*pb.SnapshotsResponse, error) {
+	return c.backing.GetSnapshots(ctx, tid)
+}
+
+func (c *Cache) GetSnapshot(ctx context.Context, tid string, snapshotID uint64) (*entity.Tournament, error) {
+	return c.backing.GetSnapshot(ctx, tid, snapshotID)
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/datatypes"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -48,6 +50,13 @@ type tournament struct {
 	Type string
 	// Parent is a tournament parent ID.
 	Parent string `gorm:"index"`
+	// Ladder holds the ranks and challenges of a ladder.
+	Ladder datatypes.JSON
+	// Club holds the membership roster of a club.
+	Club datatypes.JSON
+	// NextScheduledAt is the earliest time at which the scheduler has
+	// something to do for the tournament.
+	NextScheduledAt *time.Time `gorm:"index"`
 }
 
 type registrant struct {
@@ -56,6 +65,27 @@ type registrant struct {
 	DivisionID   string `gorm:"uniqueIndex:idx_registrant"`
 }
 
+type tournamentAuditLog struct {
+	ID           uint `gorm:"primarykey"`
+	CreatedAt    time.Time
+	TournamentID string
+	Actor        string
+	Action       string
+	Division     string
+	Payload      datatypes.JSON
+	Diff         datatypes.JSON
+}
+
+type tournamentSnapshot struct {
+	ID           uint64 `gorm:"primarykey"`
+	CreatedAt    time.Time
+	TournamentID string
+	Actor        string
+	Label        string
+	// State is the tournament row as it was when the snapshot was taken.
+	State datatypes.JSON
+}
+
 // NewDBStore creates a new DB store for tournament managers.
 func NewDBStore(config *config.Config, gs gameplay.GameStore) (*DBStore, error) {
 	db, err := gorm.Open(postgres.Open(config.DBConnDSN), &gorm.Config{})
@@ -98,6 +128,24 @@ func (s *DBStore) dbObjToEntity(tm *tournament) (*entity.Tournament, error) {
 		// it's ok, don't error out; this tournament has no extra meta
 	}
 
+	var ladder *ipc.Ladder
+	if len(tm.Ladder) > 0 {
+		ladder = &ipc.Ladder{}
+		err = json.Unmarshal(tm.Ladder, ladder)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var club *ipc.Club
+	if len(tm.Club) > 0 {
+		club = &ipc.Club{}
+		err = json.Unmarshal(tm.Club, club)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	tme := &entity.Tournament{UUID: tm.UUID,
 		Name:              tm.Name,
 		Description:       tm.Description,
@@ -111,6 +159,8 @@ func (s *DBStore) dbObjToEntity(tm *tournament) (*entity.Tournament, error) {
 		Type:              entity.CompetitionType(tm.Type),
 		ParentID:          tm.Parent,
 		Slug:              tm.Slug,
+		Ladder:            ladder,
+		Club:              club,
 	}
 	log.Debug().Msg("return-full")
 
@@ -148,8 +198,10 @@ func (s *DBStore) Set(ctx context.Context, tm *entity.Tournament) error {
 	}
 
 	ctxDB := s.db.WithContext(ctx)
+	// Every column is written, so that the columns that have
+	// been cleared, such as the next scheduled time, are saved.
 	result := ctxDB.Model(&tournament{}).Clauses(clause.Locking{Strength: "UPDATE"}).
-		Where("uuid = ?", tm.UUID).Updates(dbt)
+		Where("uuid = ?", tm.UUID).Select("*").Omit("id", "created_at", "deleted_at").Updates(dbt)
 
 	return result.Error
 }
@@ -165,6 +217,13 @@ func (s *DBStore) Create(ctx context.Context, tm *entity.Tournament) error {
 	return result.Error
 }
 
+// Delete deletes the tournament. Its row is kept but no longer found.
+func (s *DBStore) Delete(ctx context.Context, id string) error {
+	ctxDB := s.db.WithContext(ctx)
+	result := ctxDB.Where("uuid = ?", id).Delete(&tournament{})
+	return result.Error
+}
+
 func (s *DBStore) Disconnect() {
 	dbSQL, err := s.db.DB()
 	if err == nil {
@@ -201,6 +260,22 @@ func (s *DBStore) toDBObj(t *entity.Tournament) (*tournament, error) {
 		return nil, err
 	}
 
+	var ladder datatypes.JSON
+	if t.Ladder != nil {
+		ladder, err = json.Marshal(t.Ladder)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var club datatypes.JSON
+	if t.Club != nil {
+		club, err = json.Marshal(t.Club)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	dbt := &tournament{
 		UUID:              t.UUID,
 		Name:              t.Name,
@@ -215,6 +290,9 @@ func (s *DBStore) toDBObj(t *entity.Tournament) (*tournament, error) {
 		Type:              string(t.Type),
 		Parent:            t.ParentID,
 		Slug:              t.Slug,
+		Ladder:            ladder,
+		Club:              club,
+		NextScheduledAt:   tl.NextScheduledAt(t, time.Now()),
 	}
 	return dbt, nil
 }
@@ -307,6 +385,139 @@ func (s *DBStore) ListAllIDs(ctx context.Context) ([]string, error) {
 	return ids, result.Error
 }
 
+// ListScheduledIDs returns the IDs of the tournaments that the scheduler
+// has something to do for at the given time.
+func (s *DBStore) ListScheduledIDs(ctx context.Context, now time.Time) ([]string, error) {
+	var tids []struct{ UUID string }
+	ctxDB := s.db.WithContext(ctx)
+
+	result := ctxDB.Table("tournaments").Select("uuid").
+		Where("next_scheduled_at <= ?", now).
+		Order("next_scheduled_at").Scan(&tids)
+	ids := make([]string, len(tids))
+	for idx, tid := range tids {
+		ids[idx] = tid.UUID
+	}
+	return ids, result.Error
+}
+
+func (s *DBStore) AddAuditLogEntry(ctx context.Context, tid string, entry *pb.AuditLogEntry) error {
+	ctxDB := s.db.WithContext(ctx)
+	dbEntry := &tournamentAuditLog{
+		TournamentID: tid,
+		Actor:        entry.Actor,
+		Action:       entry.Action,
+		Division:     entry.Division,
+		Payload:      datatypes.JSON(entry.Payload),
+		Diff:         datatypes.JSON(entry.Diff),
+	}
+	if entry.CreatedAt != nil {
+		dbEntry.CreatedAt = entry.CreatedAt.AsTime()
+	}
+	return ctxDB.Create(dbEntry).Error
+}
+
+func (s *DBStore) GetAuditLog(ctx context.Context, tid string, limit int, offset int) (*pb.AuditLogResponse, error) {
+	var dbEntries []*tournamentAuditLog
+	ctxDB := s.db.WithContext(ctx)
+	if result := ctxDB.Limit(limit).
+		Offset(offset).
+		Where("tournament_id = ?", tid).
+		Order("created_at desc, id desc").Find(&dbEntries); result.Error != nil {
+		return nil, result.Error
+	}
+
+	entries := make([]*pb.AuditLogEntry, len(dbEntries))
+	for i, e := range dbEntries {
+		entries[i] = &pb.AuditLogEntry{
+			Actor:     e.Actor,
+			Action:    e.Action,
+			Division:  e.Division,
+			Payload:   string(e.Payload),
+			Diff:      string(e.Diff),
+			CreatedAt: timestamppb.New(e.CreatedAt),
+		}
+	}
+	return &pb.AuditLogResponse{Entries: entries}, nil
+}
+
+// AddSnapshot saves the full state of the tournament, and deletes its
+// oldest snapshots so that no more than keep of them are left.
+func (s *DBStore) AddSnapshot(ctx context.Context, t *entity.Tournament, actor string, label string,
+	keep int) (*pb.TournamentSnapshot, error) {
+
+	dbt, err := s.toDBObj(t)
+	if err != nil {
+		return nil, err
+	}
+	state, err := json.Marshal(dbt)
+	if err != nil {
+		return nil, err
+	}
+	dbSnapshot := &tournamentSnapshot{
+		TournamentID: t.UUID,
+		Actor:        actor,
+		Label:        label,
+		State:        state,
+	}
+
+	ctxDB := s.db.WithContext(ctx)
+	err = ctxDB.Transaction(func(tx *gorm.DB) error {
+		if result := tx.Create(dbSnapshot); result.Error != nil {
+			return result.Error
+		}
+		kept := tx.Model(&tournamentSnapshot{}).Select("id").
+			Where("tournament_id = ?", t.UUID).Order("id desc").Limit(keep)
+		return tx.Where("tournament_id = ? AND id NOT IN (?)", t.UUID, kept).
+			Delete(&tournamentSnapshot{}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TournamentSnapshot{
+		Id:        dbSnapshot.ID,
+		Label:     dbSnapshot.Label,
+		Actor:     dbSnapshot.Actor,
+		CreatedAt: timestamppb.New(dbSnapshot.CreatedAt),
+	}, nil
+}
+
+func (s *DBStore) GetSnapshots(ctx context.Context, tid string) (*pb.SnapshotsResponse, error) {
+	var dbSnapshots []*tournamentSnapshot
+	ctxDB := s.db.WithContext(ctx)
+	if result := ctxDB.Select("id", "created_at", "actor", "label").
+		Where("tournament_id = ?", tid).
+		Order("id desc").Find(&dbSnapshots); result.Error != nil {
+		return nil, result.Error
+	}
+
+	snapshots := make([]*pb.TournamentSnapshot, len(dbSnapshots))
+	for i, snapshot := range dbSnapshots {
+		snapshots[i] = &pb.TournamentSnapshot{
+			Id:        snapshot.ID,
+			Label:     snapshot.Label,
+			Actor:     snapshot.Actor,
+			CreatedAt: timestamppb.New(snapshot.CreatedAt),
+		}
+	}
+	return &pb.SnapshotsResponse{Snapshots: snapshots}, nil
+}
+
+// GetSnapshot returns the tournament as it was when the snapshot was taken.
+func (s *DBStore) GetSnapshot(ctx context.Context, tid string, snapshotID uint64) (*entity.Tournament, error) {
+	dbSnapshot := &tournamentSnapshot{}
+	ctxDB := s.db.WithContext(ctx)
+	if result := ctxDB.Where("tournament_id = ? AND id = ?", tid, snapshotID).First(dbSnapshot); result.Error != nil {
+		return nil, result.Error
+	}
+	tm := &tournament{}
+	err := json.Unmarshal(dbSnapshot.State, tm)
+	if err != nil {
+		return nil, err
+	}
+	return s.dbObjToEntity(tm)
+}
+
 func (s *DBStore) AddRegistrants(ctx context.Context, tid string, userIDs []string, division string) error {
 
 	ctxDB := s.db.WithContext(ctx)
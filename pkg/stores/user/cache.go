@@ -30,7 +30,13 @@ type backingStore interface {
 	GetBriefProfiles(ctx context.Context, uuids []string) (map[string]*pb.BriefProfile, error)
 	SetPersonalInfo(ctx context.Context, uuid string, email string, firstName string, lastName string, birthDate string, countryCode string, about string) error
 	SetRatings(ctx context.Context, p0uuid string, p1uuid string, variant entity.VariantKey,
-		p1Rating entity.SingleRating, p2Rating entity.SingleRating) error
+		p1Rating entity.SingleRating, p2Rating entity.SingleRating, gameID string) error
+	SetManyRatings(ctx context.Context, variant entity.VariantKey,
+		ratings map[string]entity.SingleRating, gameID string) error
+	UpdateProvisionalRatings(ctx context.Context, now int64) ([]*entity.DecayedRating, error)
+	ListRatings(ctx context.Context, variant entity.VariantKey, since int64) ([]*entity.PlayerRating, error)
+	GetRatingHistory(ctx context.Context, uuid string, variant entity.VariantKey,
+		begin time.Time, end time.Time) ([]*pb.RatingHistoryEntry, error)
 	SetStats(ctx context.Context, p0uuid string, p1uuid string, variant entity.VariantKey,
 		p0stats *entity.Stats, p1stats *entity.Stats) error
 	SetNotoriety(ctx context.Context, u *entity.User, notoriety int) error
@@ -87,6 +93,29 @@ type Cache struct {
 	briefProfileCache *BriefProfileCache
 
 	cachedModList modListCache
+
+	ratingsListener RatingsListener
+}
+
+// A RatingsListener is told about the variants in which ratings changed.
+// No variants means that ratings in any variant may have changed.
+type RatingsListener interface {
+	RatingsChanged(ctx context.Context, variants []entity.VariantKey) error
+}
+
+// SetRatingsListener sets the listener that is told whenever ratings change.
+func (c *Cache) SetRatingsListener(l RatingsListener) {
+	c.ratingsListener = l
+}
+
+func (c *Cache) ratingsChanged(ctx context.Context, variants []entity.VariantKey) {
+	if c.ratingsListener == nil {
+		return
+	}
+	err := c.ratingsListener.RatingsChanged(ctx, variants)
+	if err != nil {
+		log.Err(err).Msg("ratings-changed-listener")
+	}
 }
 
 func NewCache(backing backingStore) *Cache {
@@ -276,7 +305,7 @@ func (c *Cache) ResetPersonalInfo(ctx context.Context, uuid string) error {
 	return nil
 }
 
-func (c *Cache) SetRatings(ctx context.Context, p0uuid string, p1uuid string, variant entity.VariantKey, p0Rating entity.SingleRating, p1Rating entity.SingleRating) error {
+func (c *Cache) SetRatings(ctx context.Context, p0uuid string, p1uuid string, variant entity.VariantKey, p0Rating entity.SingleRating, p1Rating entity.SingleRating, gameID string) error {
 	u0, err := c.GetByUUID(ctx, p0uuid)
 	if err != nil {
 		return err
@@ -286,7 +315,7 @@ func (c *Cache) SetRatings(ctx context.Context, p0uuid string, p1uuid string, va
 		return err
 	}
 
-	err = c.backing.SetRatings(ctx, p0uuid, p1uuid, variant, p0Rating, p1Rating)
+	err = c.backing.SetRatings(ctx, p0uuid, p1uuid, variant, p0Rating, p1Rating, gameID)
 	if err != nil {
 		return err
 	}
@@ -302,9 +331,64 @@ func (c *Cache) SetRatings(ctx context.Context, p0uuid string, p1uuid string, va
 	u0.Profile.Ratings.Data[variant] = p0Rating
 	u1.Profile.Ratings.Data[variant] = p1Rating
 
+	c.ratingsChanged(ctx, []entity.VariantKey{variant})
+	return nil
+}
+
+func (c *Cache) SetManyRatings(ctx context.Context, variant entity.VariantKey,
+	ratings map[string]entity.SingleRating, gameID string) error {
+
+	users := make(map[string]*entity.User, len(ratings))
+	for uuid := range ratings {
+		u, err := c.GetByUUID(ctx, uuid)
+		if err != nil {
+			return err
+		}
+		users[uuid] = u
+	}
+
+	err := c.backing.SetManyRatings(ctx, variant, ratings, gameID)
+	if err != nil {
+		return err
+	}
+
+	for uuid, u := range users {
+		if u.Profile.Ratings.Data == nil {
+			u.Profile.Ratings.Data = make(map[entity.VariantKey]entity.SingleRating)
+		}
+		u.Profile.Ratings.Data[variant] = ratings[uuid]
+	}
+	c.ratingsChanged(ctx, []entity.VariantKey{variant})
 	return nil
 }
 
+// RatingsReplaced drops every cached user, since another process replaced
+// the ratings of the given variants.
+func (c *Cache) RatingsReplaced(ctx context.Context, variants []entity.VariantKey) {
+	c.cache.Purge()
+	c.ratingsChanged(ctx, variants)
+}
+
+func (c *Cache) UpdateProvisionalRatings(ctx context.Context, now int64) ([]*entity.DecayedRating, error) {
+	decayed, err := c.backing.UpdateProvisionalRatings(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	var variants []entity.VariantKey
+	changed := make(map[entity.VariantKey]bool)
+	for _, d := range decayed {
+		c.cache.Remove(d.UserID)
+		if !changed[d.Variant] {
+			changed[d.Variant] = true
+			variants = append(variants, d.Variant)
+		}
+	}
+	if len(variants) > 0 {
+		c.ratingsChanged(ctx, variants)
+	}
+	return decayed, nil
+}
+
 func (c *Cache) ResetRatings(ctx context.Context, uuid string) error {
 	u, err := c.GetByUUID(ctx, uuid)
 	if err != nil {
@@ -315,9 +399,19 @@ func (c *Cache) ResetRatings(ctx context.Context, uuid string) error {
 		return err
 	}
 	u.Profile.Ratings.Data = nil
+	c.ratingsChanged(ctx, nil)
 	return nil
 }
 
+func (c *Cache) ListRatings(ctx context.Context, variant entity.VariantKey, since int64) ([]*entity.PlayerRating, error) {
+	return c.backing.ListRatings(ctx, variant, since)
+}
+
+func (c *Cache) GetRatingHistory(ctx context.Context, uuid string, variant entity.VariantKey,
+	begin time.Time, end time.Time) ([]*pb.RatingHistoryEntry, error) {
+	return c.backing.GetRatingHistory(ctx, uuid, variant, begin, end)
+}
+
 func (c *Cache) SetStats(ctx context.Context, p0uuid string, p1uuid string, variant entity.VariantKey,
 	p0Stats *entity.Stats, p1Stats *entity.Stats) error {
 	u0, err := c.GetByUUID(ctx, p0uuid)
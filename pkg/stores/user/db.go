@@ -14,10 +14,13 @@ import (
 	"github.com/jinzhu/gorm/dialects/postgres"
 	"github.com/lithammer/shortuuid"
 	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/glicko"
 
 	cpb "github.com/domino14/liwords/rpc/api/proto/config_service"
+	ms "github.com/domino14/liwords/rpc/api/proto/mod_service"
 	pb "github.com/domino14/liwords/rpc/api/proto/user_service"
 	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
 )
@@ -93,6 +96,20 @@ type profile struct {
 	// More complex stats might be in a separate place.
 }
 
+// A ratingHistory is a player's rating in a variant after a rated game.
+// Rows are only ever appended, one per player per game.
+type ratingHistory struct {
+	ID        uint `gorm:"primary_key"`
+	CreatedAt time.Time
+
+	UserUUID        string `gorm:"type:varchar(24)"`
+	Variant         string
+	Rating          float64
+	RatingDeviation float64
+	Volatility      float64
+	GameID          string `gorm:"type:varchar(24)"`
+}
+
 type briefProfile struct {
 	UUID        string
 	Username    string
@@ -102,6 +119,9 @@ type briefProfile struct {
 	FirstName   string // XXX please add full_name to db instead.
 	LastName    string // XXX please add full_name to db instead.
 	BirthDate   string
+	// ProvisionalVariants is a JSON array of the variants whose ratings
+	// are marked as provisional.
+	ProvisionalVariants postgres.Jsonb
 }
 
 type following struct {
@@ -453,7 +473,9 @@ func (s *DBStore) GetBriefProfiles(ctx context.Context, uuids []string) (map[str
 		Table("users").
 		Joins("left join profiles on users.id = profiles.user_id").
 		Where("uuid in (?)", uuids).
-		Select([]string{"uuid", "username", "internal_bot", "country_code", "avatar_url", "first_name", "last_name", "birth_date"}).
+		Select([]string{"uuid", "username", "internal_bot", "country_code", "avatar_url", "first_name", "last_name", "birth_date",
+			"(SELECT COALESCE(jsonb_agg(r.variant ORDER BY r.variant), '[]') FROM " + ratingsEach +
+				" AS r(variant, rating) WHERE r.rating->>'p' = 'true') AS provisional_variants"}).
 		Find(&profiles); result.Error != nil {
 		return nil, result.Error
 	}
@@ -491,11 +513,19 @@ func (s *DBStore) GetBriefProfiles(ctx context.Context, uuids []string) (map[str
 				fullName = prof.LastName
 			}
 		}
+		var provisionalVariants []string
+		if len(prof.ProvisionalVariants.RawMessage) > 0 {
+			err := json.Unmarshal(prof.ProvisionalVariants.RawMessage, &provisionalVariants)
+			if err != nil {
+				return nil, err
+			}
+		}
 		response[uuid] = &pb.BriefProfile{
-			Username:    prof.Username,
-			CountryCode: prof.CountryCode,
-			AvatarUrl:   avatarUrl,
-			FullName:    fullName,
+			Username:            prof.Username,
+			CountryCode:         prof.CountryCode,
+			AvatarUrl:           avatarUrl,
+			FullName:            fullName,
+			ProvisionalVariants: provisionalVariants,
 		}
 	}
 
@@ -527,8 +557,9 @@ func (s *DBStore) SetPersonalInfo(ctx context.Context, uuid string, email string
 }
 
 // SetRatings set the specific ratings for the given variant in a transaction.
+// The new ratings are also added to the rating history of both players.
 func (s *DBStore) SetRatings(ctx context.Context, p0uuid string, p1uuid string, variant entity.VariantKey,
-	p0Rating entity.SingleRating, p1Rating entity.SingleRating) error {
+	p0Rating entity.SingleRating, p1Rating entity.SingleRating, gameID string) error {
 
 	return s.db.Transaction(func(tx *gorm.DB) error {
 
@@ -550,6 +581,15 @@ func (s *DBStore) SetRatings(ctx context.Context, p0uuid string, p1uuid string,
 
 		err = tx.Model(p1Profile).Update("ratings", postgres.Jsonb{RawMessage: p1RatingBytes}).Error
 
+		if err != nil {
+			return err
+		}
+
+		err = addRatingHistory(tx, p0uuid, variant, p0Rating, gameID)
+		if err != nil {
+			return err
+		}
+		err = addRatingHistory(tx, p1uuid, variant, p1Rating, gameID)
 		if err != nil {
 			return err
 		}
@@ -558,6 +598,234 @@ func (s *DBStore) SetRatings(ctx context.Context, p0uuid string, p1uuid string,
 	})
 }
 
+// SetManyRatings sets the ratings of many players for the given variant in
+// one transaction, such as at the end of a tournament rating period. The
+// ratings are added to the rating history with the given ID in place of
+// a game ID.
+func (s *DBStore) SetManyRatings(ctx context.Context, variant entity.VariantKey,
+	ratings map[string]entity.SingleRating, gameID string) error {
+
+	uuids := make([]string, 0, len(ratings))
+	for uuid := range ratings {
+		uuids = append(uuids, uuid)
+	}
+	// Always update the profiles in the same order to avoid deadlocks.
+	sort.Strings(uuids)
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		for _, uuid := range uuids {
+			p, ratingBytes, err := getRatingBytes(tx, ctx, uuid, variant, ratings[uuid])
+			if err != nil {
+				return err
+			}
+			err = tx.Model(p).Update("ratings", postgres.Jsonb{RawMessage: ratingBytes}).Error
+			if err != nil {
+				return err
+			}
+			err = addRatingHistory(tx, uuid, variant, ratings[uuid], gameID)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ReplaceRatings replaces the ratings of players in the given variants, and
+// the rating histories of those variants, in one transaction. The changes
+// must be in chronological order; the last change of each player in a
+// variant is their new rating. Players without any change in a variant go
+// back to the initial rating in it. Live rating waits until the ratings are
+// replaced, and nothing is replaced if a game was rated after the last
+// change, since its rating would be lost.
+func (s *DBStore) ReplaceRatings(ctx context.Context, variants []entity.VariantKey,
+	changes []*entity.RatingChange) error {
+
+	latest := make(map[string]map[entity.VariantKey]entity.SingleRating)
+	replayed := make(map[string]bool)
+	var lastTimestamp int64
+	for _, change := range changes {
+		if latest[change.UserID] == nil {
+			latest[change.UserID] = make(map[entity.VariantKey]entity.SingleRating)
+		}
+		latest[change.UserID][change.Variant] = change.Rating
+		replayed[change.GameID] = true
+		if change.Rating.LastGameTimestamp > lastTimestamp {
+			lastTimestamp = change.Rating.LastGameTimestamp
+		}
+	}
+	uuids := make([]string, 0, len(latest))
+	for uuid := range latest {
+		uuids = append(uuids, uuid)
+	}
+	sort.Strings(uuids)
+
+	variantNames := make([]string, len(variants))
+	for idx, variant := range variants {
+		variantNames[idx] = string(variant)
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		// Live rating updates the profiles before the rating histories, so
+		// the tables are locked in that order.
+		err := tx.Exec("LOCK TABLE profiles, rating_histories IN SHARE ROW EXCLUSIVE MODE").Error
+		if err != nil {
+			return err
+		}
+		var newerGameIDs []string
+		err = tx.Model(&ratingHistory{}).
+			Where("variant IN (?) AND created_at >= ?", variantNames, time.Unix(lastTimestamp, 0)).
+			Pluck("game_id", &newerGameIDs).Error
+		if err != nil {
+			return err
+		}
+		for _, gameID := range newerGameIDs {
+			if !replayed[gameID] {
+				return fmt.Errorf("game %s was rated after the ratings were recomputed, try again", gameID)
+			}
+		}
+
+		err = tx.Where("variant IN (?)", variantNames).Delete(&ratingHistory{}).Error
+		if err != nil {
+			return err
+		}
+		for _, variant := range variantNames {
+			err = tx.Exec("UPDATE profiles SET ratings = jsonb_set(ratings, '{Data}', (ratings->'Data') - ?::text) "+
+				"WHERE jsonb_exists(ratings->'Data', ?)", variant, variant).Error
+			if err != nil {
+				return err
+			}
+		}
+		for _, change := range changes {
+			err = addRatingHistory(tx, change.UserID, change.Variant, change.Rating, change.GameID)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, uuid := range uuids {
+			u := &User{}
+			p := &profile{}
+			if result := tx.Where("uuid = ?", uuid).First(u); result.Error != nil {
+				return result.Error
+			}
+			if result := tx.Model(u).Related(p); result.Error != nil {
+				return result.Error
+			}
+			existingRatings := getExistingRatings(p)
+			for variant, rating := range latest[uuid] {
+				existingRatings.Data[variant] = rating
+			}
+			ratingBytes, err := json.Marshal(existingRatings)
+			if err != nil {
+				return err
+			}
+			err = tx.Model(p).Update("ratings", postgres.Jsonb{RawMessage: ratingBytes}).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// UpdateProvisionalRatings marks the ratings of players who have not played
+// for so long that their rating deviation has grown past
+// entity.RatingDeviationConfidence as provisional, and unmarks the ratings
+// that are no longer provisional. It returns the ratings it changed.
+func (s *DBStore) UpdateProvisionalRatings(ctx context.Context, now int64) ([]*entity.DecayedRating, error) {
+	type profileRatings struct {
+		UUID    string
+		Ratings postgres.Jsonb
+	}
+	// Only the ratings that are not marked as provisional yet but whose
+	// decayed rating deviation is past the confidence are looked at. The
+	// decay is that of glicko.DecayedRatingDeviation:
+	// rd^2 + seconds / period * (volatility * conversion)^2 > confidence^2
+	var profiles []*profileRatings
+	if result := s.db.
+		Table("users").
+		Joins("JOIN profiles ON users.id = profiles.user_id").
+		Where("EXISTS (SELECT 1 FROM "+ratingsEach+" AS r(variant, rating) "+
+			"WHERE r.rating->>'p' IS DISTINCT FROM 'true' AND ("+
+			"(r.rating->>'rd')::float > ? OR "+
+			"((r.rating->>'ts')::bigint BETWEEN 1 AND ? AND "+
+			"power((r.rating->>'rd')::float, 2) + (? - (r.rating->>'ts')::bigint)::float / ? * "+
+			"power((r.rating->>'v')::float * ?, 2) > power(?, 2))))",
+			entity.RatingDeviationConfidence, now, now, glicko.RatingPeriodinSeconds,
+			glicko.GlickoToGlicko225Conversion, entity.RatingDeviationConfidence).
+		Select([]string{"users.uuid", "profiles.ratings"}).
+		Find(&profiles); result.Error != nil {
+		return nil, result.Error
+	}
+
+	var decayed []*entity.DecayedRating
+	for _, prof := range profiles {
+		ratings := getExistingRatings(&profile{Ratings: prof.Ratings})
+		if len(provisionalChanges(prof.UUID, ratings, now)) == 0 {
+			continue
+		}
+		// Check again while holding the profile, in case the player just
+		// finished a game.
+		err := s.db.Transaction(func(tx *gorm.DB) error {
+			u := &User{}
+			p := &profile{}
+			if result := tx.Where("uuid = ?", prof.UUID).First(u); result.Error != nil {
+				return result.Error
+			}
+			if result := tx.Set("gorm:query_option", "FOR UPDATE").Model(u).Related(p); result.Error != nil {
+				return result.Error
+			}
+			existingRatings := getExistingRatings(p)
+			changes := provisionalChanges(prof.UUID, existingRatings, now)
+			if len(changes) == 0 {
+				return nil
+			}
+			for _, change := range changes {
+				existingRatings.Data[change.Variant] = change.Rating
+			}
+			ratingBytes, err := json.Marshal(existingRatings)
+			if err != nil {
+				return err
+			}
+			err = tx.Model(p).Update("ratings", postgres.Jsonb{RawMessage: ratingBytes}).Error
+			if err != nil {
+				return err
+			}
+			decayed = append(decayed, changes...)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return decayed, nil
+}
+
+// ratingsEach expands the ratings of a profile into (variant, rating) rows.
+const ratingsEach = "jsonb_each(CASE WHEN jsonb_typeof(profiles.ratings->'Data') = 'object' " +
+	"THEN profiles.ratings->'Data' ELSE '{}' END)"
+
+// provisionalChanges returns the ratings whose provisional status is out of
+// date, with the status updated.
+func provisionalChanges(uuid string, ratings *entity.Ratings, now int64) []*entity.DecayedRating {
+	var changes []*entity.DecayedRating
+	for variant, rating := range ratings.Data {
+		provisional := rating.IsProvisional(now)
+		if provisional == rating.Provisional {
+			continue
+		}
+		rating.Provisional = provisional
+		changes = append(changes, &entity.DecayedRating{
+			UserID:                 uuid,
+			Variant:                variant,
+			Rating:                 rating,
+			DecayedRatingDeviation: rating.DecayedRatingDeviation(now),
+		})
+	}
+	return changes
+}
+
 func getRatingBytes(tx *gorm.DB, ctx context.Context, uuid string, variant entity.VariantKey,
 	rating entity.SingleRating) (*profile, []byte, error) {
 	u := &User{}
@@ -580,6 +848,112 @@ func getRatingBytes(tx *gorm.DB, ctx context.Context, uuid string, variant entit
 	return p, bytes, nil
 }
 
+func addRatingHistory(tx *gorm.DB, uuid string, variant entity.VariantKey,
+	rating entity.SingleRating, gameID string) error {
+
+	return tx.Create(&ratingHistory{
+		CreatedAt:       time.Unix(rating.LastGameTimestamp, 0),
+		UserUUID:        uuid,
+		Variant:         string(variant),
+		Rating:          rating.Rating,
+		RatingDeviation: rating.RatingDeviation,
+		Volatility:      rating.Volatility,
+		GameID:          gameID,
+	}).Error
+}
+
+// GetRatingHistory gets the ratings of the user in the given variant after
+// each of their rated games, oldest first. A zero begin or end time leaves
+// that end of the range open.
+func (s *DBStore) GetRatingHistory(ctx context.Context, uuid string, variant entity.VariantKey,
+	begin time.Time, end time.Time) ([]*pb.RatingHistoryEntry, error) {
+
+	query := s.db.Where("user_uuid = ? AND variant = ?", uuid, string(variant))
+	if !begin.IsZero() {
+		query = query.Where("created_at >= ?", begin)
+	}
+	if !end.IsZero() {
+		query = query.Where("created_at < ?", end)
+	}
+	var rows []*ratingHistory
+	if result := query.Order("created_at, id").Find(&rows); result.Error != nil {
+		return nil, result.Error
+	}
+
+	entries := make([]*pb.RatingHistoryEntry, len(rows))
+	for idx, row := range rows {
+		entries[idx] = &pb.RatingHistoryEntry{
+			Rating:          row.Rating,
+			RatingDeviation: row.RatingDeviation,
+			Volatility:      row.Volatility,
+			GameId:          row.GameID,
+			CreatedAt:       timestamppb.New(row.CreatedAt),
+		}
+	}
+	return entries, nil
+}
+
+// ListRatings lists the ratings of the players who played the variant
+// since the given time, highest rating first. Bots and players whose
+// accounts are suspended are left out.
+func (s *DBStore) ListRatings(ctx context.Context, variant entity.VariantKey, since int64) ([]*entity.PlayerRating, error) {
+	var rows []*struct {
+		UUID        string
+		Username    string
+		CountryCode string
+		Rating      postgres.Jsonb
+		Actions     postgres.Jsonb
+	}
+	if result := s.db.
+		Table("users").
+		Joins("JOIN profiles ON users.id = profiles.user_id").
+		Select("users.uuid, users.username, profiles.country_code, profiles.ratings->'Data'->? AS rating, "+
+			"COALESCE(users.actions, '{}') AS actions", string(variant)).
+		// The containment test can use the index on the ratings.
+		Where("profiles.ratings->'Data' @> jsonb_build_object(?::text, '{}'::jsonb)", string(variant)).
+		Where("users.internal_bot = ? AND (profiles.ratings->'Data'->?->>'ts')::bigint >= ?", false, string(variant), since).
+		Order(gorm.Expr("(profiles.ratings->'Data'->?->>'r')::float DESC", string(variant))).
+		Scan(&rows); result.Error != nil {
+		return nil, result.Error
+	}
+
+	now := time.Now()
+	ratings := make([]*entity.PlayerRating, 0, len(rows))
+	for _, row := range rows {
+		var actions entity.Actions
+		err := json.Unmarshal(row.Actions.RawMessage, &actions)
+		if err != nil {
+			return nil, err
+		}
+		if !onLeaderboards(&actions, now) {
+			continue
+		}
+		pr := &entity.PlayerRating{
+			UserID:      row.UUID,
+			Username:    row.Username,
+			CountryCode: row.CountryCode,
+		}
+		err = json.Unmarshal(row.Rating.RawMessage, &pr.Rating)
+		if err != nil {
+			return nil, err
+		}
+		ratings = append(ratings, pr)
+	}
+	return ratings, nil
+}
+
+// onLeaderboards returns whether a player with the given moderator actions
+// may be on the leaderboards at the given time, which they may not while
+// their account is suspended.
+func onLeaderboards(actions *entity.Actions, now time.Time) bool {
+	suspension, ok := actions.Current[ms.ModActionType_SUSPEND_ACCOUNT.String()]
+	if !ok {
+		return true
+	}
+	// Actions that ended are only removed once the player is seen again.
+	return suspension.EndTime != nil && !suspension.EndTime.AsTime().After(now)
+}
+
 func getExistingRatings(p *profile) *entity.Ratings {
 	var existingRatings entity.Ratings
 	err := json.Unmarshal(p.Ratings.RawMessage, &existingRatings)
@@ -4,12 +4,15 @@ import (
 	"context"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/matryer/is"
 	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/domino14/liwords/pkg/entity"
 	"github.com/domino14/liwords/pkg/stores/common"
+	ms "github.com/domino14/liwords/rpc/api/proto/mod_service"
 )
 
 func recreateDB() *DBStore {
@@ -144,3 +147,104 @@ func TestRemoveNonexistentFollower(t *testing.T) {
 
 	ustore.Disconnect()
 }
+
+func TestRatingHistory(t *testing.T) {
+	is := is.New(t)
+	ustore := recreateDB()
+	ctx := context.Background()
+	variant := entity.VariantKey("NWL20.classic.rapid")
+	other := entity.VariantKey("CSW21.classic.rapid")
+
+	is.NoErr(ustore.SetRatings(ctx, "mozEwaVMvTfUA2oxZfYN8k", "iW7AaqNJDuaxgcYnrFfcJF", variant,
+		entity.SingleRating{Rating: 1520, RatingDeviation: 320, Volatility: 0.06, LastGameTimestamp: 1000},
+		entity.SingleRating{Rating: 1480, RatingDeviation: 320, Volatility: 0.06, LastGameTimestamp: 1000},
+		"game1"))
+	is.NoErr(ustore.SetRatings(ctx, "mozEwaVMvTfUA2oxZfYN8k", "3xpEkpRAy3AizbVmDg3kdi", variant,
+		entity.SingleRating{Rating: 1490, RatingDeviation: 300, Volatility: 0.06, LastGameTimestamp: 2000},
+		entity.SingleRating{Rating: 1530, RatingDeviation: 320, Volatility: 0.06, LastGameTimestamp: 2000},
+		"game2"))
+	is.NoErr(ustore.SetRatings(ctx, "mozEwaVMvTfUA2oxZfYN8k", "iW7AaqNJDuaxgcYnrFfcJF", other,
+		entity.SingleRating{Rating: 1510, RatingDeviation: 320, Volatility: 0.06, LastGameTimestamp: 3000},
+		entity.SingleRating{Rating: 1490, RatingDeviation: 320, Volatility: 0.06, LastGameTimestamp: 3000},
+		"game3"))
+
+	history, err := ustore.GetRatingHistory(ctx, "mozEwaVMvTfUA2oxZfYN8k", variant, time.Time{}, time.Time{})
+	is.NoErr(err)
+	is.Equal(len(history), 2)
+	is.Equal(history[0].Rating, 1520.0)
+	is.Equal(history[0].GameId, "game1")
+	is.Equal(history[1].Rating, 1490.0)
+	is.Equal(history[1].RatingDeviation, 300.0)
+	is.Equal(history[1].GameId, "game2")
+	is.Equal(history[1].CreatedAt.AsTime().Unix(), int64(2000))
+
+	history, err = ustore.GetRatingHistory(ctx, "mozEwaVMvTfUA2oxZfYN8k", variant, time.Unix(1500, 0), time.Time{})
+	is.NoErr(err)
+	is.Equal(len(history), 1)
+	is.Equal(history[0].GameId, "game2")
+
+	history, err = ustore.GetRatingHistory(ctx, "iW7AaqNJDuaxgcYnrFfcJF", other, time.Time{}, time.Unix(3000, 0))
+	is.NoErr(err)
+	is.Equal(len(history), 0)
+
+	ustore.Disconnect()
+}
+
+func TestUpdateProvisionalRatings(t *testing.T) {
+	is := is.New(t)
+	ustore := recreateDB()
+	ctx := context.Background()
+	variant := entity.VariantKey("NWL20.classic.rapid")
+	year := int64(365 * 24 * 60 * 60)
+
+	is.NoErr(ustore.SetRatings(ctx, "mozEwaVMvTfUA2oxZfYN8k", "iW7AaqNJDuaxgcYnrFfcJF", variant,
+		entity.NewSingleRating(1700, 65, 0.06, 1000),
+		entity.NewSingleRating(1480, 320, 0.06, 1000),
+		"game1"))
+
+	// Nothing changes while the players keep playing.
+	decayed, err := ustore.UpdateProvisionalRatings(ctx, 1000+86400)
+	is.NoErr(err)
+	is.Equal(len(decayed), 0)
+
+	decayed, err = ustore.UpdateProvisionalRatings(ctx, 1000+3*year)
+	is.NoErr(err)
+	is.Equal(len(decayed), 1)
+	is.Equal(decayed[0].UserID, "mozEwaVMvTfUA2oxZfYN8k")
+	is.True(decayed[0].Rating.Provisional)
+	is.True(decayed[0].DecayedRatingDeviation > entity.RatingDeviationConfidence)
+
+	u, err := ustore.GetByUUID(ctx, "mozEwaVMvTfUA2oxZfYN8k")
+	is.NoErr(err)
+	rating, err := u.GetRating(variant)
+	is.NoErr(err)
+	is.True(rating.Provisional)
+	// The rating itself does not change until the next game.
+	is.Equal(rating.RatingDeviation, 65.0)
+	is.Equal(u.GetRelevantRating(variant), "1700?")
+
+	profiles, err := ustore.GetBriefProfiles(ctx, []string{"mozEwaVMvTfUA2oxZfYN8k"})
+	is.NoErr(err)
+	is.Equal(profiles["mozEwaVMvTfUA2oxZfYN8k"].ProvisionalVariants, []string{string(variant)})
+
+	ustore.Disconnect()
+}
+
+func TestOnLeaderboards(t *testing.T) {
+	is := is.New(t)
+	now := time.Unix(1000, 0)
+	suspended := func(endTime *timestamppb.Timestamp) *entity.Actions {
+		return &entity.Actions{Current: map[string]*ms.ModAction{
+			ms.ModActionType_SUSPEND_ACCOUNT.String(): {Type: ms.ModActionType_SUSPEND_ACCOUNT, EndTime: endTime},
+		}}
+	}
+
+	is.True(onLeaderboards(&entity.Actions{}, now))
+	is.True(!onLeaderboards(suspended(nil), now))
+	is.True(!onLeaderboards(suspended(timestamppb.New(now.Add(time.Hour))), now))
+	is.True(onLeaderboards(suspended(timestamppb.New(now.Add(-time.Hour))), now))
+
+	// Players whose ratings were reset in the past are back on the leaderboards.
+	reset := &entity.Actions{History: []*ms.ModAction{{Type: ms.ModActionType_RESET_RATINGS}}}
+	is.True(onLeaderboards(reset, now))
+}
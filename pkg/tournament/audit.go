@@ -0,0 +1,194 @@
+package tournament
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/domino14/liwords/pkg/entity"
+	pb "github.com/domino14/liwords/rpc/api/proto/tournament_service"
+)
+
+// MaxAuditLogEntries is the most audit log entries returned at once.
+const MaxAuditLogEntries = 100
+
+// AuditedAction runs a director's change to a tournament and, if it
+// succeeds, adds an entry to the audit log of the tournament with the
+// director, the request, and what changed in the state of the division.
+// If the division is empty, the state of every division is compared.
+// The mutation must lock the tournament with lockTournament and the
+// context it is passed, so that the state is recorded before and after
+// the change while the lock is held.
+func AuditedAction(ctx context.Context, ts TournamentStore, actor string, id string, division string,
+	action string, req proto.Message, mutate func(ctx context.Context) error) error {
+
+	recorder := &auditRecorder{id: id, division: division}
+	if label, ok := ctx.Value(snapshotLabelKey{}).(string); ok {
+		recorder.snapshot = &snapshotRequest{ts: ts, actor: actor, label: label}
+	}
+	err := mutate(context.WithValue(ctx, auditRecorderKey{}, recorder))
+	if err != nil {
+		return err
+	}
+
+	// The change has already been made at this point, so failing
+	// to record it should not fail the request.
+	entry, err := auditLogEntry(actor, division, action, req, recorder)
+	if err == nil {
+		err = ts.AddAuditLogEntry(ctx, id, entry)
+	}
+	if err != nil {
+		log.Err(err).Str("tid", id).Str("action", action).Str("actor", actor).Msg("add-audit-log-entry-error")
+	}
+	return nil
+}
+
+type auditRecorderKey struct{}
+
+type snapshotLabelKey struct{}
+
+// withSnapshot returns a context that has the audited action take a
+// snapshot of the tournament, with the given label, as soon as the
+// mutation locks it. It is used for changes that cannot be made again
+// by hand, so that they can be undone.
+func withSnapshot(ctx context.Context, label string) context.Context {
+	return context.WithValue(ctx, snapshotLabelKey{}, label)
+}
+
+// snapshotRequest is a snapshot to be taken before an audited action.
+type snapshotRequest struct {
+	ts    TournamentStore
+	actor string
+	label string
+}
+
+// auditRecorder holds the state of the audited tournament from when it
+// is first locked by the mutation and from when it is last unlocked.
+type auditRecorder struct {
+	id       string
+	division string
+	snapshot *snapshotRequest
+	before   json.RawMessage
+	after    json.RawMessage
+	err      error
+}
+
+// lockTournament locks the tournament and returns the function that
+// unlocks it. If the context belongs to an audited action on the
+// tournament, the state of the tournament is recorded right after it
+// is locked and right before it is unlocked, and the snapshot the
+// action asked for is taken right after it is locked.
+func lockTournament(ctx context.Context, t *entity.Tournament) func() {
+	t.Lock()
+	recorder, ok := ctx.Value(auditRecorderKey{}).(*auditRecorder)
+	if !ok || recorder.id != t.UUID {
+		return t.Unlock
+	}
+	if recorder.before == nil && recorder.err == nil {
+		recorder.before, recorder.err = divisionState(t, recorder.division)
+		if recorder.snapshot != nil {
+			takeSnapshot(ctx, t, recorder.snapshot)
+			recorder.snapshot = nil
+		}
+	}
+	return func() {
+		if recorder.err == nil {
+			recorder.after, recorder.err = divisionState(t, recorder.division)
+		}
+		t.Unlock()
+	}
+}
+
+// takeSnapshot saves the tournament, which must be locked. Failing to take
+// the snapshot should not fail the change.
+func takeSnapshot(ctx context.Context, t *entity.Tournament, snapshot *snapshotRequest) {
+	_, err := snapshot.ts.AddSnapshot(ctx, t, snapshot.actor, snapshot.label, MaxTournamentSnapshots)
+	if err != nil {
+		log.Err(err).Str("tid", t.UUID).Str("label", snapshot.label).Msg("take-snapshot-error")
+	}
+}
+
+func auditLogEntry(actor string, division string, action string, req proto.Message,
+	recorder *auditRecorder) (*pb.AuditLogEntry, error) {
+
+	if recorder.err != nil {
+		return nil, recorder.err
+	}
+	if recorder.before == nil || recorder.after == nil {
+		return nil, errors.New("the state of the tournament was not recorded")
+	}
+	payload, err := protojson.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	diff := jsonDiff(recorder.before, recorder.after)
+	if diff == nil {
+		diff = map[string]interface{}{}
+	}
+	diffJSON, err := json.Marshal(diff)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AuditLogEntry{
+		Actor:     actor,
+		Action:    action,
+		Division:  division,
+		Payload:   string(payload),
+		Diff:      string(diffJSON),
+		CreatedAt: timestamppb.Now(),
+	}, nil
+}
+
+// divisionState returns the JSON encoded state of the division,
+// or of all divisions if the division is empty. The tournament
+// must be locked.
+func divisionState(t *entity.Tournament, division string) (json.RawMessage, error) {
+	if division != "" {
+		divisionObject, ok := t.Divisions[division]
+		if !ok || divisionObject.DivisionManager == nil {
+			return json.RawMessage("null"), nil
+		}
+		return json.Marshal(divisionObject.DivisionManager)
+	}
+	managers := make(map[string]interface{})
+	for name, divisionObject := range t.Divisions {
+		managers[name] = divisionObject.DivisionManager
+	}
+	return json.Marshal(managers)
+}
+
+// jsonDiff returns nil if the two JSON values are the same. Objects are
+// compared field by field. Any other value that changed is returned
+// as an object with its "before" and "after" values.
+func jsonDiff(before, after json.RawMessage) interface{} {
+	var beforeObject, afterObject map[string]json.RawMessage
+	if json.Unmarshal(before, &beforeObject) == nil && beforeObject != nil &&
+		json.Unmarshal(after, &afterObject) == nil && afterObject != nil {
+
+		diff := make(map[string]interface{})
+		for key, value := range beforeObject {
+			if d := jsonDiff(value, afterObject[key]); d != nil {
+				diff[key] = d
+			}
+		}
+		for key, value := range afterObject {
+			if _, ok := beforeObject[key]; !ok {
+				diff[key] = jsonDiff(nil, value)
+			}
+		}
+		if len(diff) == 0 {
+			return nil
+		}
+		return diff
+	}
+	if bytes.Equal(before, after) {
+		return nil
+	}
+	return map[string]json.RawMessage{"before": before, "after": after}
+}
@@ -0,0 +1,567 @@
+package tournament
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/domino14/liwords/pkg/entity"
+	pb "github.com/domino14/liwords/rpc/api/proto/ipc"
+)
+
+// A Bracket is used for the elimination formats that cannot be modeled
+// by the ordering of the standings alone, which is how plain single
+// elimination works. These are double elimination and single elimination
+// with consolation rounds.
+//
+// The bracket is a graph of matches. Every match knows which match its
+// winner and loser advance to, so the state of the bracket can always be
+// rebuilt by replaying the results of the division in round order.
+type Bracket struct {
+	Method pb.PairingMethod `json:"method"`
+	// Consolation brackets are played for every place, so
+	// every player plays every round.
+	Consolation bool `json:"consolation"`
+	// Reset is true if a second grand final is played when the
+	// losers bracket champion wins the first one.
+	Reset   bool               `json:"reset"`
+	Seeds   []string           `json:"seeds"`
+	Matches []*pb.BracketMatch `json:"matches"`
+}
+
+func usesBracket(roundControls []*pb.RoundControl) bool {
+	if len(roundControls) == 0 {
+		return false
+	}
+	pm := roundControls[0].PairingMethod
+	return pm == pb.PairingMethod_DOUBLE_ELIMINATION ||
+		(pm == pb.PairingMethod_ELIMINATION && roundControls[0].Consolation)
+}
+
+func isElimination(pm pb.PairingMethod) bool {
+	return pm == pb.PairingMethod_ELIMINATION ||
+		pm == pb.PairingMethod_DOUBLE_ELIMINATION
+}
+
+// doubleEliminationRounds returns the number of rounds needed for a
+// double elimination bracket of 2 ^ n players without a grand final reset.
+func doubleEliminationRounds(n int) int {
+	return 2 * n
+}
+
+// newBracket creates a bracket for the seeds, which should be given
+// in seeding order. It returns nil if the seeds cannot fill the bracket.
+func newBracket(method pb.PairingMethod, consolation bool, numberOfRounds int, seeds []string) *Bracket {
+	n := logTwo(len(seeds))
+	if n < 1 {
+		return nil
+	}
+	b := &Bracket{Method: method, Consolation: consolation, Seeds: seeds, Matches: []*pb.BracketMatch{}}
+	if method == pb.PairingMethod_DOUBLE_ELIMINATION {
+		if numberOfRounds != doubleEliminationRounds(n) &&
+			numberOfRounds != doubleEliminationRounds(n)+1 {
+			return nil
+		}
+		b.Reset = numberOfRounds == doubleEliminationRounds(n)+1
+		b.addDoubleEliminationMatches(n)
+	} else {
+		if numberOfRounds != n {
+			return nil
+		}
+		b.addPlacementMatches(n)
+	}
+
+	order := seedOrder(len(seeds))
+	for i, m := range b.Matches {
+		if m.Side == pb.BracketSide_WINNERS_BRACKET && m.BracketRound == 0 {
+			m.Players = []string{seeds[order[2*i]], seeds[order[2*i+1]]}
+		}
+	}
+
+	// Matches are replayed in the order that they are played,
+	// so a match is always visited after the matches feeding into it.
+	sort.SliceStable(b.Matches, func(i, j int) bool {
+		return b.Matches[i].Round < b.Matches[j].Round
+	})
+	return b
+}
+
+// addPlacementMatches adds the matches for a single elimination bracket
+// where every player plays every round. The winners of a group of
+// matches advance to the next group and the losers to the group after
+// that, so the group of the final round is the final placement.
+func (b *Bracket) addPlacementMatches(n int) {
+	numberOfPlayers := 1 << n
+	for k := 0; k < n; k++ {
+		matchesPerGroup := numberOfPlayers >> (k + 1)
+		for g := 0; g < 1<<k; g++ {
+			for m := 0; m < matchesPerGroup; m++ {
+				side := pb.BracketSide_CONSOLATION_BRACKET
+				if g == 0 {
+					side = pb.BracketSide_WINNERS_BRACKET
+				}
+				match := newBracketMatch(side, k, k, g*matchesPerGroup+m)
+				if k < n-1 {
+					nextMatchesPerGroup := matchesPerGroup / 2
+					match.WinnerTo = bracketMatchID(pb.BracketSide_WINNERS_BRACKET, k+1, (2*g)*nextMatchesPerGroup+m/2)
+					match.LoserTo = bracketMatchID(pb.BracketSide_CONSOLATION_BRACKET, k+1, (2*g+1)*nextMatchesPerGroup+m/2)
+					if g != 0 {
+						match.WinnerTo = bracketMatchID(pb.BracketSide_CONSOLATION_BRACKET, k+1, (2*g)*nextMatchesPerGroup+m/2)
+					}
+					match.WinnerSlot = int32(m % 2)
+					match.LoserSlot = int32(m % 2)
+				}
+				b.Matches = append(b.Matches, match)
+			}
+		}
+	}
+}
+
+// addDoubleEliminationMatches adds the matches for a double elimination
+// bracket of 2 ^ n players. The rounds are scheduled as follows:
+//
+//	winners round 0 is played in round 0
+//	winners round k is played in round 2k - 1
+//	losers round j is played in round j + 1
+//	the grand final is played in round 2n - 1
+//	the grand final reset is played in round 2n
+//
+// Even losers rounds pair the survivors of the losers bracket and
+// odd losers rounds pair them against the players dropping down
+// from the winners bracket.
+func (b *Bracket) addDoubleEliminationMatches(n int) {
+	numberOfPlayers := 1 << n
+	grandFinal := bracketMatchID(pb.BracketSide_GRAND_FINAL, 0, 0)
+	lastLosersRound := 2*n - 3
+
+	for k := 0; k < n; k++ {
+		numberOfMatches := numberOfPlayers >> (k + 1)
+		round := 0
+		if k > 0 {
+			round = 2*k - 1
+		}
+		for i := 0; i < numberOfMatches; i++ {
+			match := newBracketMatch(pb.BracketSide_WINNERS_BRACKET, k, round, i)
+			if k < n-1 {
+				match.WinnerTo = bracketMatchID(pb.BracketSide_WINNERS_BRACKET, k+1, i/2)
+				match.WinnerSlot = int32(i % 2)
+			} else {
+				match.WinnerTo = grandFinal
+				match.WinnerSlot = 0
+			}
+			if k == 0 && n == 1 {
+				match.LoserTo = grandFinal
+				match.LoserSlot = 1
+			} else if k == 0 {
+				match.LoserTo = bracketMatchID(pb.BracketSide_LOSERS_BRACKET, 0, i/2)
+				match.LoserSlot = int32(i % 2)
+			} else {
+				// Losers dropping down meet the losers bracket in reverse
+				// order to make early rematches less likely.
+				match.LoserTo = bracketMatchID(pb.BracketSide_LOSERS_BRACKET, 2*k-1, numberOfMatches-1-i)
+				match.LoserSlot = 1
+			}
+			b.Matches = append(b.Matches, match)
+		}
+	}
+
+	for j := 0; j <= lastLosersRound; j++ {
+		var numberOfMatches int
+		if j%2 == 0 {
+			numberOfMatches = numberOfPlayers >> (j/2 + 2)
+		} else {
+			numberOfMatches = numberOfPlayers >> ((j+1)/2 + 1)
+		}
+		for i := 0; i < numberOfMatches; i++ {
+			match := newBracketMatch(pb.BracketSide_LOSERS_BRACKET, j, j+1, i)
+			if j == lastLosersRound {
+				match.WinnerTo = grandFinal
+				match.WinnerSlot = 1
+			} else if j%2 == 0 {
+				match.WinnerTo = bracketMatchID(pb.BracketSide_LOSERS_BRACKET, j+1, i)
+				match.WinnerSlot = 0
+			} else {
+				match.WinnerTo = bracketMatchID(pb.BracketSide_LOSERS_BRACKET, j+1, i/2)
+				match.WinnerSlot = int32(i % 2)
+			}
+			b.Matches = append(b.Matches, match)
+		}
+	}
+
+	b.Matches = append(b.Matches, newBracketMatch(pb.BracketSide_GRAND_FINAL, 0, 2*n-1, 0))
+	if b.Reset {
+		b.Matches = append(b.Matches, newBracketMatch(pb.BracketSide_GRAND_FINAL, 1, 2*n, 0))
+	}
+}
+
+func newBracketMatch(side pb.BracketSide, bracketRound int, round int, index int) *pb.BracketMatch {
+	return &pb.BracketMatch{Id: bracketMatchID(side, bracketRound, index),
+		Side:         side,
+		BracketRound: int32(bracketRound),
+		Round:        int32(round),
+		Players:      []string{"", ""}}
+}
+
+func bracketMatchID(side pb.BracketSide, bracketRound int, index int) string {
+	prefix := "W"
+	switch side {
+	case pb.BracketSide_LOSERS_BRACKET:
+		prefix = "L"
+	case pb.BracketSide_GRAND_FINAL:
+		prefix = "G"
+	case pb.BracketSide_CONSOLATION_BRACKET:
+		prefix = "C"
+	}
+	return fmt.Sprintf("%s%d-%d", prefix, bracketRound, index)
+}
+
+// seedOrder returns the seed indexes in bracket order, so that
+// the top seeds can only meet in the latest possible rounds.
+// Adjacent seeds in the returned order play each other first.
+func seedOrder(numberOfPlayers int) []int {
+	order := []int{0}
+	for size := 2; size <= numberOfPlayers; size *= 2 {
+		newOrder := []int{}
+		for _, seed := range order {
+			newOrder = append(newOrder, seed, size-1-seed)
+		}
+		order = newOrder
+	}
+	return order
+}
+
+func logTwo(n int) int {
+	res := 0
+	for n > 1 {
+		if n%2 != 0 {
+			return -1
+		}
+		res++
+		n = n / 2
+	}
+	return res
+}
+
+func (b *Bracket) matchMap() map[string]*pb.BracketMatch {
+	m := make(map[string]*pb.BracketMatch)
+	for _, match := range b.Matches {
+		m[match.Id] = match
+	}
+	return m
+}
+
+func (b *Bracket) isGrandFinal(m *pb.BracketMatch) bool {
+	return m.Side == pb.BracketSide_GRAND_FINAL && m.BracketRound == 0
+}
+
+func (b *Bracket) resetMatch() *pb.BracketMatch {
+	for _, m := range b.Matches {
+		if m.Side == pb.BracketSide_GRAND_FINAL && m.BracketRound == 1 {
+			return m
+		}
+	}
+	return nil
+}
+
+// loserContinues returns true if the loser of the decided
+// match m still has matches to play.
+func (b *Bracket) loserContinues(m *pb.BracketMatch) bool {
+	if m.LoserTo != "" {
+		return true
+	}
+	// The winners bracket champion gets a second chance in the reset.
+	return b.Reset && b.isGrandFinal(m) && m.Loser == m.Players[0]
+}
+
+func (b *Bracket) isEliminated(playerId string) bool {
+	for _, m := range b.Matches {
+		if m.Loser == playerId && !b.loserContinues(m) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchFor returns the match the player plays in the given round,
+// if the match has both of its players.
+func (b *Bracket) matchFor(playerId string, round int) *pb.BracketMatch {
+	for _, m := range b.Matches {
+		if int(m.Round) == round && m.Players[0] != "" && m.Players[1] != "" &&
+			(m.Players[0] == playerId || m.Players[1] == playerId) {
+			return m
+		}
+	}
+	return nil
+}
+
+func (b *Bracket) champion() string {
+	if b.Method == pb.PairingMethod_DOUBLE_ELIMINATION {
+		reset := b.resetMatch()
+		if reset != nil && reset.Winner != "" {
+			return reset.Winner
+		}
+		for _, m := range b.Matches {
+			if b.isGrandFinal(m) && m.Winner != "" && !b.loserContinues(m) {
+				return m.Winner
+			}
+		}
+		return ""
+	}
+	final := b.Matches[len(b.Matches)-1]
+	for _, m := range b.Matches {
+		if m.Side == pb.BracketSide_WINNERS_BRACKET && m.Round == final.Round {
+			return m.Winner
+		}
+	}
+	return ""
+}
+
+// placements returns the player IDs in bracket order. Players whose
+// place is not decided yet are ordered by seed.
+func (b *Bracket) placements() []string {
+	seedIndex := make(map[string]int)
+	for i, s := range b.Seeds {
+		seedIndex[s] = i
+	}
+	placements := make([]string, len(b.Seeds))
+	copy(placements, b.Seeds)
+
+	if b.Method != pb.PairingMethod_DOUBLE_ELIMINATION {
+		// Every player plays every round, so the win/loss history
+		// read from the first round determines the place.
+		history := make(map[string]string)
+		for _, m := range b.Matches {
+			if m.Winner != "" {
+				history[m.Winner] += "0"
+				history[m.Loser] += "1"
+			}
+		}
+		sort.SliceStable(placements, func(i, j int) bool {
+			hi := history[placements[i]]
+			hj := history[placements[j]]
+			l := len(hi)
+			if len(hj) < l {
+				l = len(hj)
+			}
+			if hi[:l] != hj[:l] {
+				return hi[:l] < hj[:l]
+			}
+			return seedIndex[placements[i]] < seedIndex[placements[j]]
+		})
+		return placements
+	}
+
+	champion := b.champion()
+	losses := make(map[string]int)
+	eliminatedRound := make(map[string]int)
+	for _, m := range b.Matches {
+		if m.Loser != "" {
+			losses[m.Loser]++
+			if !b.loserContinues(m) {
+				eliminatedRound[m.Loser] = int(m.Round)
+			}
+		}
+	}
+	sort.SliceStable(placements, func(i, j int) bool {
+		pi := placements[i]
+		pj := placements[j]
+		if pi == champion || pj == champion {
+			return pi == champion
+		}
+		ri, iEliminated := eliminatedRound[pi]
+		rj, jEliminated := eliminatedRound[pj]
+		if iEliminated != jEliminated {
+			return !iEliminated
+		}
+		if iEliminated && ri != rj {
+			return ri > rj
+		}
+		if !iEliminated && losses[pi] != losses[pj] {
+			return losses[pi] < losses[pj]
+		}
+		return seedIndex[pi] < seedIndex[pj]
+	})
+	return placements
+}
+
+// updateBracket replays every result of the division through the bracket.
+// Replaying from the seeds means that amended results propagate correctly.
+func (t *ClassicDivision) updateBracket() {
+	t.replayBracket(t.Bracket, true)
+}
+
+// replayBracket replays every result of the division through the bracket b.
+// If setOutcomes is set, the pairings of players who lost but are still in
+// the bracket are marked as losses rather than eliminations.
+func (t *ClassicDivision) replayBracket(b *Bracket, setOutcomes bool) {
+	matches := b.matchMap()
+	for _, m := range b.Matches {
+		m.Winner = ""
+		m.Loser = ""
+		m.Games = nil
+		if !(m.Side == pb.BracketSide_WINNERS_BRACKET && m.BracketRound == 0) {
+			m.Players = []string{"", ""}
+		}
+	}
+
+	reset := b.resetMatch()
+	for _, m := range b.Matches {
+		if m.Players[0] == "" || m.Players[1] == "" {
+			continue
+		}
+		pairing := t.bracketPairing(m)
+		if pairing == nil {
+			continue
+		}
+		m.Games = pairing.Games
+		winnerIndex := -1
+		for i := 0; i < 2; i++ {
+			if pairing.Outcomes[i] == pb.TournamentGameResult_WIN {
+				winnerIndex = i
+			}
+		}
+		if winnerIndex < 0 {
+			continue
+		}
+		m.Winner = t.Players.Persons[pairing.Players[winnerIndex]].Id
+		m.Loser = t.Players.Persons[pairing.Players[1-winnerIndex]].Id
+
+		if m.WinnerTo != "" {
+			matches[m.WinnerTo].Players[m.WinnerSlot] = m.Winner
+		}
+		if m.LoserTo != "" {
+			matches[m.LoserTo].Players[m.LoserSlot] = m.Loser
+		}
+		if setOutcomes && b.loserContinues(m) {
+			// The loser is not out of the tournament yet.
+			pairing.Outcomes[1-winnerIndex] = pb.TournamentGameResult_LOSS
+		}
+		if reset != nil && b.isGrandFinal(m) && b.loserContinues(m) {
+			reset.Players = []string{m.Players[0], m.Players[1]}
+		}
+	}
+}
+
+// bracketPairing returns the pairing for the bracket match if
+// it has been paired.
+func (t *ClassicDivision) bracketPairing(m *pb.BracketMatch) *pb.Pairing {
+	pairingKey, err := t.getPairingKey(m.Players[0], int(m.Round))
+	if err != nil || pairingKey == "" {
+		return nil
+	}
+	pairing, ok := t.PairingMap[pairingKey]
+	if !ok || pairing.Players == nil {
+		return nil
+	}
+	for _, playerIndex := range pairing.Players {
+		id := t.Players.Persons[playerIndex].Id
+		if id != m.Players[0] && id != m.Players[1] {
+			return nil
+		}
+	}
+	return pairing
+}
+
+// pairBracketRound pairs the round from the bracket. Players without
+// a match this round are either eliminated or waiting for the other side
+// of the bracket to catch up, in which case the round is void for them.
+func (t *ClassicDivision) pairBracketRound(round int) (*pb.DivisionPairingsResponse, error) {
+	if t.Bracket == nil {
+		return nil, entity.NewWooglesError(pb.WooglesError_TOURNAMENT_NO_BRACKET, t.TournamentName, t.DivisionName)
+	}
+	for i := 0; i < len(t.Matrix[round]); i++ {
+		err := t.clearPairingKey(int32(i), round)
+		if err != nil {
+			return nil, err
+		}
+	}
+	t.updateBracket()
+
+	pmessage := newPairingsMessage()
+	for _, m := range t.Bracket.Matches {
+		if int(m.Round) != round || m.Players[0] == "" || m.Players[1] == "" {
+			continue
+		}
+		newpmessage, err := t.SetPairing(m.Players[0], m.Players[1], round, pb.TournamentGameResult_NO_RESULT)
+		if err != nil {
+			return nil, err
+		}
+		pmessage = combinePairingMessages(pmessage, newpmessage)
+	}
+
+	for i, player := range t.Players.Persons {
+		if t.Matrix[round][i] != "" {
+			continue
+		}
+		var pairing *pb.Pairing
+		if t.Bracket.isEliminated(player.Id) {
+			pairing = newEliminatedPairing(player.Id, player.Id, round)
+		} else {
+			pairing = newIdlePairing(round)
+		}
+		pairingKey := t.makePairingKey()
+		t.PairingMap[pairingKey] = pairing
+		t.Matrix[round][i] = pairingKey
+	}
+
+	err := validatePairings(t, round)
+	if err != nil {
+		return nil, err
+	}
+	return pmessage, nil
+}
+
+// newIdlePairing is the pairing for a player who is still in the
+// bracket but does not play this round.
+func newIdlePairing(round int) *pb.Pairing {
+	return &pb.Pairing{Outcomes: []pb.TournamentGameResult{pb.TournamentGameResult_VOID,
+		pb.TournamentGameResult_VOID}, Round: int32(round)}
+}
+
+func validateBracketRoundControls(t *ClassicDivision, roundControls []*pb.RoundControl) error {
+	numberOfRounds := len(roundControls)
+	numberOfPlayers := len(t.Players.Persons)
+	if roundControls[0].PairingMethod == pb.PairingMethod_DOUBLE_ELIMINATION {
+		n := logTwo(numberOfPlayers)
+		if n < 1 || (numberOfRounds != doubleEliminationRounds(n) && numberOfRounds != doubleEliminationRounds(n)+1) {
+			return entity.NewWooglesError(pb.WooglesError_TOURNAMENT_INVALID_DOUBLE_ELIMINATION_ROUNDS, t.TournamentName, t.DivisionName,
+				strconv.Itoa(numberOfPlayers), strconv.Itoa(numberOfRounds))
+		}
+	}
+	for _, rc := range roundControls {
+		if rc.Consolation != roundControls[0].Consolation {
+			return entity.NewWooglesError(pb.WooglesError_TOURNAMENT_ELIMINATION_PAIRINGS_MIX, t.TournamentName, t.DivisionName)
+		}
+	}
+	return nil
+}
+
+func (t *ClassicDivision) GetBracket() (*pb.BracketResponse, error) {
+	if t.Bracket == nil {
+		return nil, entity.NewWooglesError(pb.WooglesError_TOURNAMENT_NO_BRACKET, t.TournamentName, t.DivisionName)
+	}
+	// The bracket is rebuilt on a copy, so that reading it changes
+	// nothing in the division.
+	b := &Bracket{
+		Method:      t.Bracket.Method,
+		Consolation: t.Bracket.Consolation,
+		Reset:       t.Bracket.Reset,
+		Seeds:       t.Bracket.Seeds,
+	}
+	for _, m := range t.Bracket.Matches {
+		b.Matches = append(b.Matches, proto.Clone(m).(*pb.BracketMatch))
+	}
+	t.replayBracket(b, false)
+	// The games are those of the pairings.
+	for _, m := range b.Matches {
+		m.Games = append([]*pb.TournamentGame{}, m.Games...)
+		for i, g := range m.Games {
+			m.Games[i] = proto.Clone(g).(*pb.TournamentGame)
+		}
+	}
+	return &pb.BracketResponse{Matches: b.Matches,
+		Placements: b.placements(),
+		Champion:   b.champion()}, nil
+}
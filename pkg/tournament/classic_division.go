@@ -6,12 +6,14 @@ import (
 	"math/rand"
 	"sort"
 	"strconv"
+	"time"
 
 	"github.com/domino14/liwords/pkg/entity"
 	"github.com/domino14/liwords/pkg/pair"
 	"github.com/domino14/liwords/pkg/utilities"
 	pb "github.com/domino14/liwords/rpc/api/proto/ipc"
 	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
 )
 
 type PlayerSorter []*pb.TournamentPerson
@@ -33,6 +35,14 @@ type ClassicDivision struct {
 	DivisionControls *pb.DivisionControls         `json:"divisionControls"`
 	CurrentRound     int32                        `json:"currentRound"`
 	PairingKeyInt    int                          `json:"pairingKeyInt"`
+	Bracket          *Bracket                     `json:"bracket,omitempty"`
+	// RoundStartTime is the unix time at which the current round started.
+	RoundStartTime int64 `json:"roundStartTime,omitempty"`
+	// CheckInEnforced is set once the players who missed the
+	// check-in deadline have been dealt with.
+	CheckInEnforced bool `json:"checkInEnforced,omitempty"`
+	// explanation, if not nil, records why PairRound made its pairings.
+	explanation *pairingExplanation
 }
 
 func NewClassicDivision(tournamentName string, divisionName string) *ClassicDivision {
@@ -53,6 +63,10 @@ func (t *ClassicDivision) GetDivisionControls() *pb.DivisionControls {
 	return t.DivisionControls
 }
 
+func (t *ClassicDivision) GetRoundControls() []*pb.RoundControl {
+	return t.RoundControls
+}
+
 func (t *ClassicDivision) SetDivisionControls(divisionControls *pb.DivisionControls) (*pb.DivisionControls, map[int32]*pb.RoundStandings, error) {
 	err := entity.ValidateGameRequest(context.Background(), divisionControls.GameRequest)
 	if err != nil {
@@ -63,6 +77,19 @@ func (t *ClassicDivision) SetDivisionControls(divisionControls *pb.DivisionContr
 		Str("division", t.DivisionName).
 		Msg("divctrls-validated-game-request")
 
+	if divisionControls.RatingCeiling > 0 && divisionControls.RatingFloor > divisionControls.RatingCeiling {
+		return nil, nil, entity.NewWooglesError(pb.WooglesError_TOURNAMENT_INVALID_RATING_RANGE, t.TournamentName, t.DivisionName,
+			strconv.Itoa(int(divisionControls.RatingFloor)), strconv.Itoa(int(divisionControls.RatingCeiling)))
+	}
+
+	if divisionControls.MaxPlayers < 0 {
+		return nil, nil, entity.NewWooglesError(pb.WooglesError_TOURNAMENT_NEGATIVE_MAX_PLAYERS, t.TournamentName, t.DivisionName)
+	}
+
+	if divisionControls.CheckInWindowSeconds < 0 {
+		return nil, nil, entity.NewWooglesError(pb.WooglesError_TOURNAMENT_NEGATIVE_CHECK_IN_WINDOW, t.TournamentName, t.DivisionName)
+	}
+
 	if divisionControls.MaximumByePlacement < 0 {
 		return nil, nil, entity.NewWooglesError(pb.WooglesError_TOURNAMENT_NEGATIVE_MAX_BYE_PLACEMENT, t.TournamentName, t.DivisionName, strconv.Itoa(int(divisionControls.MaximumByePlacement+1)))
 	}
@@ -83,6 +110,12 @@ func (t *ClassicDivision) SetDivisionControls(divisionControls *pb.DivisionContr
 		}
 	}
 
+	// Games rated one at a time can't be rated again in a rating period,
+	// nor the other way around.
+	if t.IsStarted() && divisionControls.RateAsPeriod != t.DivisionControls.GetRateAsPeriod() {
+		return nil, nil, entity.NewWooglesError(pb.WooglesError_TOURNAMENT_RATING_PERIOD_STARTED, t.TournamentName, t.DivisionName)
+	}
+
 	gibsonChanged := false
 	if divisionControls.Gibsonize != t.DivisionControls.Gibsonize ||
 		divisionControls.GibsonSpread != t.DivisionControls.GibsonSpread ||
@@ -90,6 +123,11 @@ func (t *ClassicDivision) SetDivisionControls(divisionControls *pb.DivisionContr
 		gibsonChanged = true
 	}
 
+	if !proto.Equal(divisionControls.CheckInDeadline, t.DivisionControls.CheckInDeadline) {
+		// A new deadline has to be enforced again
+		t.CheckInEnforced = false
+	}
+
 	t.DivisionControls = divisionControls
 
 	standingsMap := make(map[int32]*pb.RoundStandings)
@@ -119,12 +157,14 @@ func (t *ClassicDivision) SetRoundControls(roundControls []*pb.RoundControl) (*p
 		return nil, nil, entity.NewWooglesError(pb.WooglesError_TOURNAMENT_SET_ROUND_CONTROLS_AFTER_START, t.TournamentName, t.DivisionName, "classic_division")
 	}
 
-	isElimination := false
+	eliminationMethod := pb.PairingMethod_MANUAL
+	isEliminationDivision := false
 
 	for i := 0; i < numberOfRounds; i++ {
 		control := roundControls[i]
-		if control.PairingMethod == pb.PairingMethod_ELIMINATION {
-			isElimination = true
+		if isElimination(control.PairingMethod) {
+			eliminationMethod = control.PairingMethod
+			isEliminationDivision = true
 			break
 		}
 	}
@@ -132,7 +172,7 @@ func (t *ClassicDivision) SetRoundControls(roundControls []*pb.RoundControl) (*p
 	var initialFontes int32 = 0
 	for i := 0; i < numberOfRounds; i++ {
 		control := roundControls[i]
-		if isElimination && control.PairingMethod != pb.PairingMethod_ELIMINATION {
+		if isEliminationDivision && control.PairingMethod != eliminationMethod {
 			return nil, nil, entity.NewWooglesError(pb.WooglesError_TOURNAMENT_ELIMINATION_PAIRINGS_MIX, t.TournamentName, t.DivisionName)
 		} else if i != 0 {
 			if control.PairingMethod == pb.PairingMethod_INITIAL_FONTES &&
@@ -163,6 +203,13 @@ func (t *ClassicDivision) SetRoundControls(roundControls []*pb.RoundControl) (*p
 		}
 	}
 
+	if isEliminationDivision {
+		err := validateBracketRoundControls(t, roundControls)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
 	for i := 0; i < numberOfRounds; i++ {
 		roundControls[i].InitialFontes = initialFontes
 		roundControls[i].Round = int32(i)
@@ -182,9 +229,26 @@ func (t *ClassicDivision) SetRoundControls(roundControls []*pb.RoundControl) (*p
 func (t *ClassicDivision) prepair() (*pb.DivisionPairingsResponse, error) {
 	t.PairingMap = make(map[string]*pb.Pairing)
 	t.Standings = make(map[int32]*pb.RoundStandings)
+	t.Bracket = nil
 	pm := newPairingsMessage()
 	if t.IsStartable() {
 		numberOfPlayers := len(t.Players.Persons)
+		if usesBracket(t.RoundControls) {
+			seeds := []string{}
+			for _, player := range t.Players.Persons {
+				seeds = append(seeds, player.Id)
+			}
+			t.Bracket = newBracket(t.RoundControls[0].PairingMethod, t.RoundControls[0].Consolation,
+				len(t.RoundControls), seeds)
+			if t.Bracket == nil {
+				// The bracket can't be filled by the current players.
+				err := validateBracketRoundControls(t, t.RoundControls)
+				if err == nil {
+					err = entity.NewWooglesError(pb.WooglesError_TOURNAMENT_NO_BRACKET, t.TournamentName, t.DivisionName)
+				}
+				return nil, err
+			}
+		}
 		initFontes := t.RoundControls[0].InitialFontes
 		if t.RoundControls[0].PairingMethod != pb.PairingMethod_MANUAL &&
 			numberOfPlayers >= int(initFontes)+1 {
@@ -201,7 +265,9 @@ func (t *ClassicDivision) prepair() (*pb.DivisionPairingsResponse, error) {
 			initFontes := t.RoundControls[i].InitialFontes
 			// Don't pair Initial Fontes round if there are more initial fontes
 			// rounds than players
-			if pair.IsStandingsIndependent(pairingMethod) &&
+			// Rounds that lag far enough behind are paired
+			// from the standings before anyone has played.
+			if (pair.IsStandingsIndependent(pairingMethod) || t.standingsRoundFor(i) < 0) &&
 				numberOfPlayers >= int(initFontes)+1 &&
 				pairingMethod != pb.PairingMethod_MANUAL {
 				newpm, err := t.PairRound(i, false)
@@ -225,6 +291,14 @@ func (t *ClassicDivision) SetSingleRoundControls(round int, controls *pb.RoundCo
 		return nil, err
 	}
 
+	// The bracket is fixed once it is created
+	currentControls := t.RoundControls[round]
+	if isElimination(currentControls.PairingMethod) &&
+		(controls.PairingMethod != currentControls.PairingMethod ||
+			controls.Consolation != currentControls.Consolation) {
+		return nil, entity.NewWooglesError(pb.WooglesError_TOURNAMENT_ELIMINATION_PAIRINGS_MIX, t.TournamentName, t.DivisionName)
+	}
+
 	controls.Round = t.RoundControls[round].Round
 	controls.InitialFontes = t.RoundControls[round].InitialFontes
 	t.RoundControls[round] = controls
@@ -329,8 +403,8 @@ func (t *ClassicDivision) SetPairing(playerOne string, playerTwo string, round i
 				p1score = int(t.DivisionControls.SuspendedSpread)
 				p2score = 0
 				if selfPlayResult == pb.TournamentGameResult_NO_RESULT {
-					p1tgr = t.DivisionControls.SuspendedResult
-					p2tgr = t.DivisionControls.SuspendedResult
+					p1tgr = t.suspendedResult(playerOneIndex)
+					p2tgr = p1tgr
 				} else {
 					// user overrode
 					p1tgr = selfPlayResult
@@ -355,11 +429,11 @@ func (t *ClassicDivision) SetPairing(playerOne string, playerTwo string, round i
 			p2tgr = pb.TournamentGameResult_FORFEIT_WIN
 			if t.Players.Persons[playerOneIndex].Suspended {
 				p1score = int(t.DivisionControls.SuspendedSpread)
-				p1tgr = t.DivisionControls.SuspendedResult
+				p1tgr = t.suspendedResult(playerOneIndex)
 			}
 			if t.Players.Persons[playerTwoIndex].Suspended {
 				p2score = int(t.DivisionControls.SuspendedSpread)
-				p2tgr = t.DivisionControls.SuspendedResult
+				p2tgr = t.suspendedResult(playerTwoIndex)
 			}
 		}
 
@@ -466,7 +540,7 @@ func (t *ClassicDivision) SubmitResult(round int,
 	// For Elimination tournaments only.
 	// Could be a tiebreaking result or could be an out of range
 	// game index
-	if pairingMethod == pb.PairingMethod_ELIMINATION && gameIndex >= int(t.RoundControls[round].GamesPerRound) {
+	if isElimination(pairingMethod) && gameIndex >= int(t.RoundControls[round].GamesPerRound) {
 		if gameIndex != len(pairing.Games) {
 			return nil, entity.NewWooglesError(pb.WooglesError_TOURNAMENT_TIEBREAK_INVALID_GAME_INDEX, t.TournamentName, t.DivisionName, strconv.Itoa(round+1), p1, p2, strconv.Itoa(gameIndex))
 		} else {
@@ -515,7 +589,7 @@ func (t *ClassicDivision) SubmitResult(round int,
 		p2Score = scores[1]
 	}
 
-	if pairingMethod == pb.PairingMethod_ELIMINATION {
+	if isElimination(pairingMethod) {
 		pairing.Games[gameIndex].Scores[p1Index] = int32(p1Score)
 		pairing.Games[gameIndex].Scores[1-p1Index] = int32(p2Score)
 		pairing.Games[gameIndex].Results[p1Index] = p1Result
@@ -530,6 +604,10 @@ func (t *ClassicDivision) SubmitResult(round int,
 
 		pairing.Outcomes[0] = newOutcomes[0]
 		pairing.Outcomes[1] = newOutcomes[1]
+
+		if t.Bracket != nil {
+			t.updateBracket()
+		}
 	} else {
 		// Classic tournaments only ever have
 		// one game per round
@@ -566,10 +644,16 @@ func (t *ClassicDivision) SubmitResult(round int,
 
 	// Only pair if this round is complete and the tournament
 	// is not over. Don't pair for standings independent pairings since those pairings
-	// were made when the tournament was created.
+	// were made when the tournament was created. Every round that is paired
+	// from the standings after this round is paired now, which is
+	// the next round unless its pairings lag behind.
 	if roundComplete && !finished && !amend {
-		if !pair.IsStandingsIndependent(t.RoundControls[round+1].PairingMethod) {
-			newpmessage, err := t.PairRound(round+1, false)
+		for i := round + 1; i < len(t.Matrix); i++ {
+			if t.standingsRoundFor(i) != round ||
+				pair.IsStandingsIndependent(t.RoundControls[i].PairingMethod) {
+				continue
+			}
+			newpmessage, err := t.PairRound(i, false)
 			if err != nil {
 				return nil, err
 			}
@@ -613,10 +697,73 @@ func (t *ClassicDivision) canCatch(records []*pb.PlayerStanding, round int, i in
 	return canCatch, nil
 }
 
+// contention returns the best place that each player in the records can
+// still reach, or -1 for players who can no longer reach a prize place.
+// Prize places are the places up to the minimum placement. A player
+// can reach a place if they can catch the player who is in it now.
+func (t *ClassicDivision) contention(records []*pb.PlayerStanding, round int) ([]int, error) {
+	minimumPlacement := utilities.Min(int(t.DivisionControls.MinimumPlacement), len(records)-1)
+	bestPlaces := make([]int, len(records))
+	for j := range records {
+		bestPlaces[j] = -1
+		for place := 0; place <= minimumPlacement; place++ {
+			if j <= place {
+				bestPlaces[j] = j
+				break
+			}
+			cc, err := t.canCatch(records, round, place, j)
+			if err != nil {
+				return nil, err
+			}
+			if cc {
+				bestPlaces[j] = place
+				break
+			}
+		}
+	}
+	return bestPlaces, nil
+}
+
+// pairableRecords leaves out the players who withdrew but are still in
+// the standings, so that they don't take up a place when byes and
+// gibsonizations are given. It also returns the gibson rank among the
+// players who are left.
+func (t *ClassicDivision) pairableRecords(records []*pb.PlayerStanding, gibsonRank int) ([]*pb.PlayerStanding, int) {
+	pairable := []*pb.PlayerStanding{}
+	pairableGibsonRank := -1
+	for i, record := range records {
+		if t.Players.Persons[t.PlayerIndexMap[record.PlayerId]].Suspended {
+			continue
+		}
+		pairable = append(pairable, record)
+		if i <= gibsonRank {
+			pairableGibsonRank = len(pairable) - 1
+		}
+	}
+	return pairable, pairableGibsonRank
+}
+
+// standingsRoundFor returns the round whose standings are used to pair
+// the round, which is before the previous round if its pairings lag
+// behind. It is negative if the round is paired before any results.
+func (t *ClassicDivision) standingsRoundFor(round int) int {
+	return round - 1 - int(t.RoundControls[round].PairingLag)
+}
+
+// isPaired returns whether the future round has already been paired from
+// the standings, which it is once the round before the current round is
+// over, or earlier if its pairings lag behind.
+func (t *ClassicDivision) isPaired(round int) bool {
+	return t.standingsRoundFor(round) <= int(t.CurrentRound)
+}
+
 func (t *ClassicDivision) PairRound(round int, preserveByes bool) (*pb.DivisionPairingsResponse, error) {
 	if round < 0 || round >= len(t.Matrix) {
 		return nil, entity.NewWooglesError(pb.WooglesError_TOURNAMENT_ROUND_NUMBER_OUT_OF_RANGE, t.TournamentName, t.DivisionName, strconv.Itoa(round+1), "PairRound")
 	}
+	if usesBracket(t.RoundControls) {
+		return t.pairBracketRound(round)
+	}
 	roundPairings := t.Matrix[round]
 	pairingMethod := t.RoundControls[round].PairingMethod
 	// This automatic pairing could be the result of an
@@ -650,15 +797,19 @@ func (t *ClassicDivision) PairRound(round int, preserveByes bool) (*pb.DivisionP
 		}
 	}
 
-	standingsRound := round
-	if standingsRound == 0 {
+	standingsRound := t.standingsRoundFor(round) + 1
+	if standingsRound < 1 {
 		standingsRound = 1
 	}
+	// The rounds left to catch up in include
+	// the rounds that the pairings lag behind.
+	catchRound := utilities.Max(0, t.standingsRoundFor(round)+1)
 
 	standings, gibsonRank, err := t.GetStandings(standingsRound - 1)
 	if err != nil {
 		return nil, err
 	}
+	records, gibsonRank := t.pairableRecords(standings.Standings, gibsonRank)
 
 	repeats, err := getRepeats(t, round-1)
 	if err != nil {
@@ -677,13 +828,13 @@ func (t *ClassicDivision) PairRound(round int, preserveByes bool) (*pb.DivisionP
 	} else {
 
 		// If there are an odd number of players, give a bye based on the standings.
-		totalNumberOfPlayers := len(standings.Standings)
+		totalNumberOfPlayers := len(records)
 		maxByePlacement := utilities.Min(totalNumberOfPlayers-1, int(t.DivisionControls.MaximumByePlacement))
 		if (totalNumberOfPlayers-len(playersWithByes))%2 != 0 {
 			var invByePlayerIndex int
 			minNumberOfByes := len(t.Matrix) + 1
 			for i := totalNumberOfPlayers - 1; i >= maxByePlacement; i-- {
-				playerId := standings.Standings[i].PlayerId
+				playerId := records[i].PlayerId
 				if !playersWithByes[playerId] {
 					numberOfByes := repeats[pair.GetRepeatKey(playerId, playerId)]
 					if numberOfByes < minNumberOfByes {
@@ -697,7 +848,7 @@ func (t *ClassicDivision) PairRound(round int, preserveByes bool) (*pb.DivisionP
 				return nil, entity.NewWooglesError(pb.WooglesError_TOURNAMENT_CANNOT_ASSIGN_BYE, t.TournamentName, t.DivisionName, strconv.Itoa(round+1))
 			}
 
-			byePlayer := standings.Standings[invByePlayerIndex].PlayerId
+			byePlayer := records[invByePlayerIndex].PlayerId
 
 			newpmessage, err := t.SetPairing(byePlayer, byePlayer, round, pb.TournamentGameResult_BYE)
 			if err != nil {
@@ -708,8 +859,8 @@ func (t *ClassicDivision) PairRound(round int, preserveByes bool) (*pb.DivisionP
 		}
 
 		for i := 0; i < totalNumberOfPlayers; i++ {
-			if !playersWithByes[standings.Standings[i].PlayerId] {
-				playerOrder = append(playerOrder, standings.Standings[i])
+			if !playersWithByes[records[i].PlayerId] {
+				playerOrder = append(playerOrder, records[i])
 			}
 		}
 
@@ -719,10 +870,36 @@ func (t *ClassicDivision) PairRound(round int, preserveByes bool) (*pb.DivisionP
 	}
 
 	for i := 0; i < len(playerOrder); i++ {
+		playerIndex := t.PlayerIndexMap[playerOrder[i].PlayerId]
+		fs := getPlayerFirstsAndSeconds(t, playerIndex, round-1)
 		poolMembers = append(poolMembers, &entity.PoolMember{Id: playerOrder[i].PlayerId,
-			Wins:   int(playerOrder[i].Wins),
-			Draws:  int(playerOrder[i].Draws),
-			Spread: int(playerOrder[i].Spread)})
+			Rating:  int(t.Players.Persons[playerIndex].Rating),
+			Wins:    int(playerOrder[i].Wins),
+			Draws:   int(playerOrder[i].Draws),
+			Spread:  int(playerOrder[i].Spread),
+			Firsts:  fs[0],
+			Seconds: fs[1]})
+	}
+
+	if t.RoundControls[round].ContentionPairing || t.explanation != nil {
+		bestPlaces, err := t.contention(records, catchRound)
+		if err != nil {
+			return nil, err
+		}
+		contenders := make(map[string]bool)
+		for i, record := range records {
+			contenders[record.PlayerId] = bestPlaces[i] >= 0
+			if t.explanation != nil {
+				t.explanation.contention = append(t.explanation.contention, &pb.PlayerContention{
+					PlayerId:  record.PlayerId,
+					Rank:      int32(i),
+					BestPlace: int32(bestPlaces[i]),
+				})
+			}
+		}
+		for _, pm := range poolMembers {
+			pm.Contender = contenders[pm.Id]
+		}
 	}
 
 	gibsonPairedPlayers := make(map[string]bool)
@@ -748,7 +925,7 @@ func (t *ClassicDivision) PairRound(round int, preserveByes bool) (*pb.DivisionP
 				// Pair with someone who cannot cash
 				// If everyone can still cash, pair them with the player in last
 				for j := i + 1; j < len(playerOrder); j++ {
-					cc, err := t.canCatch(playerOrder, round, minimumPlacement, j)
+					cc, err := t.canCatch(playerOrder, catchRound, minimumPlacement, j)
 					if err != nil {
 						return nil, err
 					}
@@ -784,10 +961,33 @@ func (t *ClassicDivision) PairRound(round int, preserveByes bool) (*pb.DivisionP
 		}
 	}
 
+	// The acceleration groups are made of the players who are
+	// left to be paired, after byes and Gibsonization.
+	if t.RoundControls[round].Accelerated {
+		acceleratedRounds, acceleratedRound := 0, 0
+		for i, rc := range t.RoundControls {
+			if rc.Accelerated {
+				if i < round {
+					acceleratedRound++
+				}
+				acceleratedRounds++
+			}
+		}
+		pair.Accelerate(poolMembers, acceleratedRounds, acceleratedRound)
+	}
+
 	upm := &entity.UnpairedPoolMembers{RoundControls: t.RoundControls[round],
 		PoolMembers: poolMembers,
 		Repeats:     repeats}
 
+	if t.explanation != nil {
+		t.explanation.members = pair.CapRelativeWeights(upm)
+		t.explanation.gibsonPairedPlayers = gibsonPairedPlayers
+		for i := 0; i <= gibsonRank && i < len(playerOrder); i++ {
+			t.explanation.gibsonizedPlayers = append(t.explanation.gibsonizedPlayers, playerOrder[i].PlayerId)
+		}
+	}
+
 	pairings, err := pair.Pair(upm)
 
 	if err != nil {
@@ -910,9 +1110,10 @@ func (t *ClassicDivision) AddPlayers(players *pb.TournamentPersons) (*pb.Divisio
 	newPlayers := make(map[string]bool)
 	for _, player := range players.Persons {
 		idx, ok := t.PlayerIndexMap[player.Id]
-		// If the player exists and is not suspended or the tournament hasn't started
-		// throw an error
-		if ok && (!t.Players.Persons[idx].Suspended || t.CurrentRound < 0) {
+		// If the player exists and is not suspended throw an error.
+		// Before the tournament starts, players are only suspended
+		// for missing the check-in, and adding them reinstates them.
+		if ok && !t.Players.Persons[idx].Suspended {
 			return nil, entity.NewWooglesError(pb.WooglesError_TOURNAMENT_PLAYER_ALREADY_EXISTS, t.TournamentName, t.DivisionName, player.Id)
 		}
 		if !ok {
@@ -924,7 +1125,13 @@ func (t *ClassicDivision) AddPlayers(players *pb.TournamentPersons) (*pb.Divisio
 	pmessage := newPairingsMessage()
 
 	if t.CurrentRound < 0 {
-		t.Players.Persons = append(t.Players.Persons, players.Persons...)
+		for _, player := range players.Persons {
+			if newPlayers[player.Id] {
+				t.Players.Persons = append(t.Players.Persons, player)
+			} else {
+				t.Players.Persons[t.PlayerIndexMap[player.Id]].Suspended = false
+			}
+		}
 		sort.Sort(PlayerSorter(t.Players.Persons))
 		t.PlayerIndexMap = newPlayerIndexMap(t.Players.Persons)
 		t.Matrix = newPairingMatrix(len(t.RoundControls), len(t.Players.Persons))
@@ -982,7 +1189,7 @@ func (t *ClassicDivision) AddPlayers(players *pb.TournamentPersons) (*pb.Divisio
 				}
 			} else {
 				pm := t.RoundControls[i].PairingMethod
-				if (i == int(t.CurrentRound)+1 || pair.IsStandingsIndependent(pm)) && pm != pb.PairingMethod_MANUAL {
+				if (t.isPaired(i) || pair.IsStandingsIndependent(pm)) && pm != pb.PairingMethod_MANUAL {
 					newpmessage, err := t.PairRound(i, false)
 					if err != nil {
 						return nil, err
@@ -1053,7 +1260,7 @@ func (t *ClassicDivision) RemovePlayers(persons *pb.TournamentPersons) (*pb.Divi
 
 		for i := int(t.CurrentRound + 1); i < len(t.Matrix); i++ {
 			pm := t.RoundControls[i].PairingMethod
-			if (i == int(t.CurrentRound)+1 || pair.IsStandingsIndependent(pm)) && pm != pb.PairingMethod_MANUAL {
+			if (t.isPaired(i) || pair.IsStandingsIndependent(pm)) && pm != pb.PairingMethod_MANUAL {
 				newPairingsMessage, err := t.PairRound(i, false)
 				if err != nil {
 					return nil, err
@@ -1073,6 +1280,107 @@ func (t *ClassicDivision) RemovePlayers(persons *pb.TournamentPersons) (*pb.Divi
 	return pairingsMessage, nil
 }
 
+// WithdrawPlayers takes the players out of the pairings for the rest of
+// the division. Their past results are kept, and the policy decides
+// whether they stay in the standings. Before the division starts,
+// withdrawing players removes them.
+func (t *ClassicDivision) WithdrawPlayers(persons *pb.TournamentPersons, policy pb.WithdrawalPolicy) (*pb.DivisionPairingsResponse, error) {
+	if t.CurrentRound < 0 {
+		return t.RemovePlayers(persons)
+	}
+
+	previousPolicies := make(map[int32]pb.WithdrawalPolicy)
+	for _, player := range persons.Persons {
+		playerIndex, ok := t.PlayerIndexMap[player.Id]
+		if ok && !t.Players.Persons[playerIndex].Suspended {
+			previousPolicies[playerIndex] = t.Players.Persons[playerIndex].WithdrawalPolicy
+			t.Players.Persons[playerIndex].WithdrawalPolicy = policy
+		}
+	}
+
+	pairingsMessage, err := t.RemovePlayers(persons)
+	if err != nil {
+		for playerIndex, previousPolicy := range previousPolicies {
+			t.Players.Persons[playerIndex].WithdrawalPolicy = previousPolicy
+		}
+		return nil, err
+	}
+	return pairingsMessage, nil
+}
+
+// ReadmitPlayers brings withdrawn players back into the pairings. Up to
+// catchUpByes of the rounds that each player missed, counting back from
+// the current round, are turned into byes.
+func (t *ClassicDivision) ReadmitPlayers(persons *pb.TournamentPersons, catchUpByes int) (*pb.DivisionPairingsResponse, error) {
+	if catchUpByes < 0 {
+		return nil, entity.NewWooglesError(pb.WooglesError_TOURNAMENT_NEGATIVE_CATCH_UP_BYES, t.TournamentName, t.DivisionName)
+	}
+
+	// The rounds that become byes are found before anything changes so
+	// that the players are either readmitted with their byes or not at all.
+	byeRounds := make(map[string][]int)
+	for _, player := range persons.Persons {
+		playerIndex, ok := t.PlayerIndexMap[player.Id]
+		if !ok {
+			return nil, entity.NewWooglesError(pb.WooglesError_TOURNAMENT_NONEXISTENT_PLAYER, t.TournamentName, t.DivisionName, "0", player.Id, "readmitPlayers")
+		}
+		if !t.Players.Persons[playerIndex].Suspended {
+			return nil, entity.NewWooglesError(pb.WooglesError_TOURNAMENT_PLAYER_ALREADY_EXISTS, t.TournamentName, t.DivisionName, player.Id)
+		}
+		for round := int(t.CurrentRound); round >= 0 && len(byeRounds[player.Id]) < catchUpByes; round-- {
+			isBye, err := t.pairingIsBye(player.Id, round)
+			if err != nil {
+				return nil, err
+			}
+			if !isBye {
+				continue
+			}
+			pairing, err := t.getPairing(player.Id, round)
+			if err != nil {
+				return nil, err
+			}
+			if pairing.Outcomes[0] == pb.TournamentGameResult_BYE {
+				continue
+			}
+			byeRounds[player.Id] = append(byeRounds[player.Id], round)
+		}
+	}
+
+	pairingsMessage, err := t.AddPlayers(persons)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, player := range persons.Persons {
+		t.Players.Persons[t.PlayerIndexMap[player.Id]].WithdrawalPolicy = pb.WithdrawalPolicy_WITHDRAW_DROP
+		for _, round := range byeRounds[player.Id] {
+			newPairingsMessage, err := t.SubmitResult(round, player.Id, player.Id, entity.ByeScore, 0,
+				pb.TournamentGameResult_BYE, pb.TournamentGameResult_BYE,
+				pb.GameEndReason_NONE, true, 0, "")
+			if err != nil {
+				return nil, err
+			}
+			pairingsMessage = combinePairingMessages(pairingsMessage, newPairingsMessage)
+		}
+	}
+
+	standingsMessage, err := t.RecalculateStandings()
+	if err != nil {
+		return nil, err
+	}
+	return combinePairingMessages(pairingsMessage, standingsMessage), nil
+}
+
+// suspendedResult returns the result that the suspended player gets for
+// the rounds they don't play. Players who withdrew under the forfeit
+// policy stay in the standings, so they always forfeit those rounds.
+func (t *ClassicDivision) suspendedResult(playerIndex int32) pb.TournamentGameResult {
+	if t.Players.Persons[playerIndex].WithdrawalPolicy == pb.WithdrawalPolicy_WITHDRAW_FORFEIT {
+		return pb.TournamentGameResult_FORFEIT_LOSS
+	}
+	return t.DivisionControls.SuspendedResult
+}
+
 func (t *ClassicDivision) GetCurrentRound() int {
 	return int(t.CurrentRound)
 }
@@ -1083,6 +1391,7 @@ func (t *ClassicDivision) GetPlayers() *pb.TournamentPersons {
 
 func (t *ClassicDivision) ResetToBeginning() error {
 	t.CurrentRound = -1
+	t.RoundStartTime = 0
 
 	for _, p := range t.Players.Persons {
 		p.Suspended = false
@@ -1112,7 +1421,8 @@ func getRecords(t *ClassicDivision, round int) ([]*pb.PlayerStanding, error) {
 		draws = 0
 		spread = 0
 		playerId = t.Players.Persons[i].Id
-		if t.Players.Persons[i].Suspended {
+		if t.Players.Persons[i].Suspended &&
+			t.Players.Persons[i].WithdrawalPolicy != pb.WithdrawalPolicy_WITHDRAW_FORFEIT {
 			continue
 		}
 		for j := 0; j <= round; j++ {
@@ -1164,6 +1474,19 @@ func getRecords(t *ClassicDivision, round int) ([]*pb.PlayerStanding, error) {
 
 	pairingMethod := t.RoundControls[round].PairingMethod
 
+	if t.Bracket != nil {
+		// Brackets keep track of the placements themselves
+		placementIndex := make(map[string]int)
+		for i, playerId := range t.Bracket.placements() {
+			placementIndex[playerId] = i
+		}
+		sort.SliceStable(records,
+			func(i, j int) bool {
+				return placementIndex[records[i].PlayerId] < placementIndex[records[j].PlayerId]
+			})
+		return records, nil
+	}
+
 	// The difference for Elimination is that the original order
 	// of the player list must be preserved. This is how we keep
 	// track of the "bracket", which is simply modeled by an
@@ -1354,6 +1677,7 @@ func (t *ClassicDivision) StartRound(checkForStartable bool) error {
 	}
 
 	t.CurrentRound = t.CurrentRound + 1
+	t.RoundStartTime = time.Now().Unix()
 
 	return nil
 }
@@ -1424,6 +1748,168 @@ func (t *ClassicDivision) SetReadyForGame(playerID, connID string, round, gameIn
 
 }
 
+// ForfeitNoShows submits forfeits for every game in the current round
+// that has not started because a player never said they were ready,
+// once the no-show grace period of the round has passed.
+// A player whose opponent did not show up gets a forfeit win.
+func (t *ClassicDivision) ForfeitNoShows(now time.Time) (*pb.DivisionPairingsResponse, error) {
+	pmessage := newPairingsMessage()
+	round := int(t.CurrentRound)
+	if round < 0 || round >= len(t.Matrix) {
+		return pmessage, nil
+	}
+	forfeitTime, ok := t.noShowForfeitTime(round)
+	if !ok || now.Before(forfeitTime) {
+		return pmessage, nil
+	}
+
+	visited := make(map[string]bool)
+	for _, pairingKey := range t.Matrix[round] {
+		if visited[pairingKey] {
+			continue
+		}
+		visited[pairingKey] = true
+		pairing, ok := t.PairingMap[pairingKey]
+		if !ok || !t.isNoShowPairing(pairing, round) {
+			continue
+		}
+		p1Ready := pairing.ReadyStates[0] != ""
+		p2Ready := pairing.ReadyStates[1] != ""
+
+		p1Score := 0
+		p2Score := 0
+		p1Result := pb.TournamentGameResult_FORFEIT_WIN
+		p2Result := pb.TournamentGameResult_FORFEIT_WIN
+		if !p1Ready {
+			p1Score = int(t.DivisionControls.SuspendedSpread)
+			p1Result = pb.TournamentGameResult_FORFEIT_LOSS
+		}
+		if !p2Ready {
+			p2Score = int(t.DivisionControls.SuspendedSpread)
+			p2Result = pb.TournamentGameResult_FORFEIT_LOSS
+		}
+		playerOne := t.Players.Persons[pairing.Players[0]].Id
+		playerTwo := t.Players.Persons[pairing.Players[1]].Id
+
+		for gameIndex, game := range pairing.Games {
+			if pairing.Outcomes[0] != pb.TournamentGameResult_NO_RESULT {
+				// Elimination matches can be decided before all games are played
+				break
+			}
+			if game.Results[0] != pb.TournamentGameResult_NO_RESULT {
+				continue
+			}
+			newpmessage, err := t.SubmitResult(round,
+				playerOne,
+				playerTwo,
+				p1Score,
+				p2Score,
+				p1Result,
+				p2Result,
+				pb.GameEndReason_FORCE_FORFEIT,
+				false,
+				gameIndex,
+				"")
+			if err != nil {
+				return nil, err
+			}
+			pmessage = combinePairingMessages(pmessage, newpmessage)
+		}
+	}
+	return pmessage, nil
+}
+
+// noShowForfeitTime returns the time at which the no-shows of the round
+// are forfeited, if the round has a no-show grace period.
+func (t *ClassicDivision) noShowForfeitTime(round int) (time.Time, bool) {
+	rc := t.RoundControls[round]
+	if rc.ScheduledStartTime == nil || rc.NoShowGraceSeconds == 0 {
+		return time.Time{}, false
+	}
+	// The grace period starts when the round does, which
+	// can be later than scheduled if the previous round ran long.
+	start := rc.ScheduledStartTime.AsTime()
+	if t.RoundStartTime > start.Unix() {
+		start = time.Unix(t.RoundStartTime, 0)
+	}
+	return start.Add(time.Duration(rc.NoShowGraceSeconds) * time.Second), true
+}
+
+// isNoShowPairing returns whether the pairing is a game that has not
+// started because a player never said they were ready, and that can
+// be decided by forfeit.
+func (t *ClassicDivision) isNoShowPairing(pairing *pb.Pairing, round int) bool {
+	if pairing.Players == nil || pairing.Players[0] == pairing.Players[1] {
+		return false
+	}
+	if pairing.Outcomes[0] != pb.TournamentGameResult_NO_RESULT ||
+		pairing.Outcomes[1] != pb.TournamentGameResult_NO_RESULT {
+		return false
+	}
+	p1Ready := pairing.ReadyStates[0] != ""
+	p2Ready := pairing.ReadyStates[1] != ""
+	if p1Ready && p2Ready {
+		// Both players showed up, so the game is underway
+		return false
+	}
+	if !p1Ready && !p2Ready && isElimination(t.RoundControls[round].PairingMethod) {
+		// Someone has to advance, so the director needs to decide this one
+		return false
+	}
+	return true
+}
+
+// NextScheduledTime returns the earliest time at which the division has
+// something to do without anyone's input: enforcing the check-in deadline,
+// forfeiting the no-shows of the current round, or starting the next round.
+// It returns nil if there is nothing scheduled.
+func (t *ClassicDivision) NextScheduledTime() *time.Time {
+	var next *time.Time
+	consider := func(at time.Time) {
+		if next == nil || at.Before(*next) {
+			next = &at
+		}
+	}
+
+	// The check-in is only enforced once someone has checked in.
+	deadline := t.DivisionControls.CheckInDeadline
+	if deadline != nil && !t.CheckInEnforced && t.CurrentRound < 0 {
+		for _, player := range t.Players.Persons {
+			if player.CheckedIn {
+				consider(deadline.AsTime())
+				break
+			}
+		}
+	}
+
+	round := int(t.CurrentRound)
+	if round >= 0 && round < len(t.Matrix) {
+		if forfeitTime, ok := t.noShowForfeitTime(round); ok {
+			for _, pairingKey := range t.Matrix[round] {
+				pairing, ok := t.PairingMap[pairingKey]
+				if ok && t.isNoShowPairing(pairing, round) {
+					consider(forfeitTime)
+					break
+				}
+			}
+		}
+	}
+
+	nextRound := round + 1
+	if nextRound < len(t.RoundControls) && t.RoundControls[nextRound].ScheduledStartTime != nil {
+		// A round that is waiting for the previous one to
+		// finish is rescheduled when the last result comes in.
+		complete := true
+		if nextRound > 0 {
+			complete, _ = t.IsRoundComplete(nextRound - 1)
+		}
+		if complete {
+			consider(t.RoundControls[nextRound].ScheduledStartTime.AsTime())
+		}
+	}
+	return next
+}
+
 func (t *ClassicDivision) ClearReadyStates(playerID string, round, gameIndex int) ([]*pb.Pairing, error) {
 	if round >= len(t.Matrix) || round < 0 {
 		return nil, entity.NewWooglesError(pb.WooglesError_TOURNAMENT_ROUND_NUMBER_OUT_OF_RANGE, t.TournamentName, t.DivisionName, strconv.Itoa(round+1), "ClearReadyStates")
@@ -1559,7 +2045,7 @@ func getPlayerFirstsAndSeconds(t *ClassicDivision, playerIndex int32, round int)
 		pairingKey := t.Matrix[i][int(playerIndex)]
 		if pairingKey != "" {
 			pairing, ok := t.PairingMap[pairingKey]
-			if ok {
+			if ok && pairing.Players != nil {
 				playerPairingIndex := 0
 				if pairing.Players[1] == playerIndex {
 					playerPairingIndex = 1
@@ -1899,7 +2385,7 @@ func validatePairings(t *ClassicDivision, round int) error {
 		}
 		if pairing.Players == nil {
 			// Some pairings can be nil for Elimination tournaments
-			if t.RoundControls[0].PairingMethod != pb.PairingMethod_ELIMINATION {
+			if !isElimination(t.RoundControls[0].PairingMethod) {
 				return entity.NewWooglesError(pb.WooglesError_TOURNAMENT_UNPAIRED_PLAYER, t.TournamentName, t.DivisionName, strconv.Itoa(round+1), t.Players.Persons[i].Id, strconv.Itoa(i), pairingKey)
 			} else {
 				continue
@@ -1941,23 +2427,103 @@ func validateRoundControl(t *ClassicDivision, rc *pb.RoundControl) error {
 	if rc.GamesPerRound == 0 {
 		return entity.NewWooglesError(pb.WooglesError_TOURNAMENT_ZERO_GAMES_PER_ROUND, t.TournamentName, t.DivisionName, strconv.Itoa(int(rc.Round+1)))
 	}
+	if rc.ContentionPairing && rc.PairingMethod != pb.PairingMethod_SWISS &&
+		rc.PairingMethod != pb.PairingMethod_FACTOR {
+		return entity.NewWooglesError(pb.WooglesError_TOURNAMENT_INVALID_CONTENTION_PAIRING, t.TournamentName, t.DivisionName, strconv.Itoa(int(rc.Round+1)))
+	}
+	if rc.PairingLag < 0 || (rc.PairingLag > 0 &&
+		rc.PairingMethod != pb.PairingMethod_SWISS &&
+		rc.PairingMethod != pb.PairingMethod_FACTOR &&
+		rc.PairingMethod != pb.PairingMethod_KING_OF_THE_HILL) {
+		return entity.NewWooglesError(pb.WooglesError_TOURNAMENT_INVALID_PAIRING_LAG, t.TournamentName, t.DivisionName, strconv.Itoa(int(rc.Round+1)), strconv.Itoa(int(rc.PairingLag)))
+	}
+	if rc.Accelerated && rc.PairingMethod != pb.PairingMethod_SWISS {
+		return entity.NewWooglesError(pb.WooglesError_TOURNAMENT_INVALID_ACCELERATION, t.TournamentName, t.DivisionName, strconv.Itoa(int(rc.Round+1)))
+	}
+	if rc.NoShowGraceSeconds < 0 {
+		return entity.NewWooglesError(pb.WooglesError_TOURNAMENT_NEGATIVE_NO_SHOW_GRACE, t.TournamentName, t.DivisionName, strconv.Itoa(int(rc.Round+1)))
+	}
 	return nil
 }
 
-/**
-func (t *ClassicDivision) SetCheckedIn(playerID string) error {
-	for idx, v := range t.Players {
-		if v == playerID {
-			t.PlayersProperties[idx].CheckedIn = true
-			return t.writeResponse(0)
+// SetCheckedIn checks the player in for the first round.
+// If the division has a check-in deadline, players can only check in
+// during the window that closes at the deadline.
+func (t *ClassicDivision) SetCheckedIn(playerID string, now time.Time) error {
+	playerIndex, ok := t.PlayerIndexMap[playerID]
+	if !ok {
+		return entity.NewWooglesError(pb.WooglesError_TOURNAMENT_NONEXISTENT_PLAYER, t.TournamentName, t.DivisionName, "0", playerID, "SetCheckedIn")
+	}
+	deadline := t.DivisionControls.CheckInDeadline
+	if t.CurrentRound >= 0 || t.CheckInEnforced ||
+		(deadline != nil && !now.Before(deadline.AsTime())) {
+		return entity.NewWooglesError(pb.WooglesError_TOURNAMENT_CHECK_IN_CLOSED, t.TournamentName, t.DivisionName)
+	}
+	if deadline != nil && t.DivisionControls.CheckInWindowSeconds > 0 {
+		opens := deadline.AsTime().Add(-time.Duration(t.DivisionControls.CheckInWindowSeconds) * time.Second)
+		if now.Before(opens) {
+			return entity.NewWooglesError(pb.WooglesError_TOURNAMENT_CHECK_IN_NOT_OPEN, t.TournamentName, t.DivisionName, opens.UTC().Format(time.RFC1123))
 		}
 	}
-	return fmt.Errorf("player %v not found", playerID)
+	t.Players.Persons[playerIndex].CheckedIn = true
+	return nil
 }
 
+// ClearCheckedIn checks every player out so that check-in can start over.
 func (t *ClassicDivision) ClearCheckedIn() {
-	for idx := range t.Players {
-		t.PlayersProperties[idx].CheckedIn = false
+	for _, player := range t.Players.Persons {
+		player.CheckedIn = false
+	}
+	t.CheckInEnforced = false
+}
+
+// EnforceCheckIn suspends the players who did not check in before the
+// deadline, or removes them if the division controls say so, and pairs
+// the first round again without them. It returns the players who
+// missed the check-in.
+func (t *ClassicDivision) EnforceCheckIn(now time.Time) (*pb.TournamentPersons, *pb.DivisionPairingsResponse, error) {
+	missed := &pb.TournamentPersons{}
+	pmessage := newPairingsMessage()
+	deadline := t.DivisionControls.CheckInDeadline
+	if deadline == nil || t.CheckInEnforced || t.CurrentRound >= 0 || now.Before(deadline.AsTime()) {
+		return missed, pmessage, nil
+	}
+
+	checkedIn := 0
+	for _, player := range t.Players.Persons {
+		if player.CheckedIn {
+			checkedIn++
+		} else if !player.Suspended {
+			missed.Persons = append(missed.Persons, &pb.TournamentPerson{Id: player.Id, Rating: player.Rating})
+		}
+	}
+	if checkedIn == 0 {
+		// If no one checked in at all the players were most likely
+		// never asked to, so the check-in is not enforced and the
+		// field is left to the director.
+		return &pb.TournamentPersons{}, pmessage, nil
+	}
+	t.CheckInEnforced = true
+	if len(missed.Persons) == 0 {
+		return missed, pmessage, nil
+	}
+
+	// Suspended players would still take up a seed in a bracket,
+	// so bracket divisions always remove them.
+	if t.DivisionControls.RemoveUncheckedIn || usesBracket(t.RoundControls) {
+		pmessage, err := t.RemovePlayers(missed)
+		if err != nil {
+			return nil, nil, err
+		}
+		return missed, pmessage, nil
 	}
-	t.writeResponse(0)
-*/
+
+	for _, player := range missed.Persons {
+		t.Players.Persons[t.PlayerIndexMap[player.Id]].Suspended = true
+	}
+	pmessage, err := t.prepair()
+	if err != nil {
+		return nil, nil, err
+	}
+	return missed, pmessage, nil
+}
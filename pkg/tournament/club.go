@@ -0,0 +1,442 @@
+package tournament
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/glicko"
+	"github.com/domino14/liwords/pkg/user"
+	ipc "github.com/domino14/liwords/rpc/api/proto/ipc"
+	pb "github.com/domino14/liwords/rpc/api/proto/tournament_service"
+)
+
+const (
+	DefaultClubStatsLimit   = 10
+	MaxClubStatsLimit       = 100
+	DefaultClubRatingsLimit = 50
+	MaxClubRatingsLimit     = 200
+	// Club ratings and stats cover this many of the most recent sessions.
+	MaxClubSessions      = 100
+	clubSessionsPageSize = 50
+)
+
+// clubSession holds the divisions of one session of a club.
+type clubSession struct {
+	id        string
+	slug      string
+	divisions []*ipc.TournamentDivisionDataResponse
+}
+
+// clubOf returns the roster of the tournament, which must be a club.
+// The tournament must be locked.
+func clubOf(t *entity.Tournament) (*ipc.Club, error) {
+	if t.Type != entity.TypeClub {
+		return nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NOT_A_CLUB, t.Name, "")
+	}
+	if t.Club == nil {
+		t.Club = &ipc.Club{Id: t.UUID}
+	}
+	return t.Club, nil
+}
+
+func GetClub(ctx context.Context, ts TournamentStore, id string) (*ipc.Club, error) {
+	t, err := ts.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// clubOf may set up the roster.
+	defer lockTournament(ctx, t)()
+
+	club, err := clubOf(t)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Clone(club).(*ipc.Club), nil
+}
+
+func SetClubControls(ctx context.Context, ts TournamentStore, id string, membersOnly bool) error {
+	t, err := ts.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	defer lockTournament(ctx, t)()
+
+	club, err := clubOf(t)
+	if err != nil {
+		return err
+	}
+	club.MembersOnly = membersOnly
+	return ts.Set(ctx, t)
+}
+
+// AddClubMembers adds the members, given by their usernames, to the club.
+// Members who are already on the roster keep the date they joined, and
+// take the new role.
+func AddClubMembers(ctx context.Context, ts TournamentStore, us user.Store, id string, members []*ipc.ClubMember,
+	now time.Time) error {
+
+	t, err := ts.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	defer lockTournament(ctx, t)()
+
+	club, err := clubOf(t)
+	if err != nil {
+		return err
+	}
+
+	// Only perform the add operation if all members can be added.
+	fullIDs := []string{}
+	for _, member := range members {
+		fullID, _, err := constructFullID(t.Name, "", ctx, us, member.Id)
+		if err != nil {
+			return err
+		}
+		fullIDs = append(fullIDs, fullID)
+	}
+
+	for idx, member := range members {
+		existing := clubMember(club, fullIDs[idx])
+		if existing != nil {
+			existing.Role = member.Role
+			continue
+		}
+		club.Members = append(club.Members, &ipc.ClubMember{
+			Id:       fullIDs[idx],
+			Role:     member.Role,
+			JoinedAt: timestamppb.New(now),
+		})
+	}
+	return ts.Set(ctx, t)
+}
+
+// RemoveClubMembers takes the members, given by their usernames, off the
+// roster of the club.
+func RemoveClubMembers(ctx context.Context, ts TournamentStore, us user.Store, id string, members []*ipc.ClubMember) error {
+	t, err := ts.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	defer lockTournament(ctx, t)()
+
+	club, err := clubOf(t)
+	if err != nil {
+		return err
+	}
+
+	removed := make(map[string]bool)
+	for _, member := range members {
+		fullID, _, err := constructFullID(t.Name, "", ctx, us, member.Id)
+		if err != nil {
+			return err
+		}
+		if clubMember(club, fullID) == nil {
+			return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NONEXISTENT_CLUB_MEMBER, t.Name, "", fullID)
+		}
+		removed[fullID] = true
+	}
+
+	remaining := []*ipc.ClubMember{}
+	for _, member := range club.Members {
+		if !removed[member.Id] {
+			remaining = append(remaining, member)
+		}
+	}
+	club.Members = remaining
+	return ts.Set(ctx, t)
+}
+
+// clubRoster is the roster of a club whose sessions are for members only.
+type clubRoster struct {
+	name    string
+	members map[string]bool
+}
+
+// membersOnlyRoster returns the roster of the club the tournament is a
+// session of, if its sessions are for members only, and nil otherwise.
+// It reads the parent club, so it must be called before the session is
+// locked.
+func membersOnlyRoster(ctx context.Context, ts TournamentStore, t *entity.Tournament) (*clubRoster, error) {
+	t.RLock()
+	isSession := t.Type == entity.TypeChild
+	parentID := t.ParentID
+	t.RUnlock()
+
+	if !isSession || parentID == "" {
+		return nil, nil
+	}
+	parent, err := ts.Get(ctx, parentID)
+	if err != nil {
+		return nil, err
+	}
+	parent.RLock()
+	defer parent.RUnlock()
+
+	if parent.Type != entity.TypeClub || parent.Club == nil || !parent.Club.MembersOnly {
+		return nil, nil
+	}
+	roster := &clubRoster{name: parent.Name, members: make(map[string]bool)}
+	for _, member := range parent.Club.Members {
+		roster.members[member.Id] = true
+	}
+	return roster, nil
+}
+
+// isMember returns whether the player can play in the sessions of the club.
+// Everyone can if the sessions are not for members only.
+func (r *clubRoster) isMember(fullID string) bool {
+	return r == nil || r.members[fullID]
+}
+
+// checkClubMembership returns an error if the player cannot play in the
+// session t of the club.
+func checkClubMembership(t *entity.Tournament, division string, roster *clubRoster, fullID string) error {
+	if !roster.isMember(fullID) {
+		return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_CLUB_MEMBERS_ONLY, t.Name, division, roster.name, fullID)
+	}
+	return nil
+}
+
+// GetClubRatings rates the players on the games played in the most recent
+// sessions of the club, starting everyone at the initial rating. It returns
+// limit ratings, starting at offset.
+func GetClubRatings(ctx context.Context, ts TournamentStore, id string, offset int, limit int) (*pb.ClubRatingsResponse, error) {
+	club, err := GetClub(ctx, ts, id)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := clubSessions(ctx, ts, id)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = DefaultClubRatingsLimit
+	} else if limit > MaxClubRatingsLimit {
+		limit = MaxClubRatingsLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	ratings := clubRatings(sessions, club)
+	resp := &pb.ClubRatingsResponse{Id: id, Total: int32(len(ratings))}
+	if offset < len(ratings) {
+		end := offset + limit
+		if end > len(ratings) {
+			end = len(ratings)
+		}
+		resp.Ratings = ratings[offset:end]
+	}
+	return resp, nil
+}
+
+// GetClubStats returns the attendance of the most recent sessions of the
+// club, and its most active members in them.
+func GetClubStats(ctx context.Context, ts TournamentStore, id string, limit int) (*pb.ClubStatsResponse, error) {
+	club, err := GetClub(ctx, ts, id)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := clubSessions(ctx, ts, id)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = DefaultClubStatsLimit
+	} else if limit > MaxClubStatsLimit {
+		limit = MaxClubStatsLimit
+	}
+	resp := clubStats(sessions, club, limit)
+	resp.Id = id
+	return resp, nil
+}
+
+// clubSessions returns the divisions of the most recent sessions of the
+// club, up to MaxClubSessions of them, from the oldest to the most recent.
+func clubSessions(ctx context.Context, ts TournamentStore, id string) ([]*clubSession, error) {
+	sessions := []*clubSession{}
+	for offset := 0; offset < MaxClubSessions; offset += clubSessionsPageSize {
+		count := clubSessionsPageSize
+		if offset+count > MaxClubSessions {
+			count = MaxClubSessions - offset
+		}
+		resp, err := ts.GetRecentClubSessions(ctx, id, count, offset)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range resp.Sessions {
+			session, err := clubSessionDivisions(ctx, ts, s.TournamentId)
+			if err != nil {
+				return nil, err
+			}
+			sessions = append(sessions, session)
+		}
+		if len(resp.Sessions) < count {
+			break
+		}
+	}
+	for i, j := 0, len(sessions)-1; i < j; i, j = i+1, j-1 {
+		sessions[i], sessions[j] = sessions[j], sessions[i]
+	}
+	return sessions, nil
+}
+
+func clubSessionDivisions(ctx context.Context, ts TournamentStore, id string) (*clubSession, error) {
+	t, err := ts.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	t.RLock()
+	defer t.RUnlock()
+
+	session := &clubSession{id: t.UUID, slug: t.Slug}
+	divisionNames := make([]string, 0, len(t.Divisions))
+	for name, divisionObject := range t.Divisions {
+		if divisionObject.DivisionManager != nil {
+			divisionNames = append(divisionNames, name)
+		}
+	}
+	sort.Strings(divisionNames)
+	for _, name := range divisionNames {
+		divisionData, err := t.Divisions[name].DivisionManager.GetXHRResponse()
+		if err != nil {
+			return nil, err
+		}
+		session.divisions = append(session.divisions, divisionData)
+	}
+	return session, nil
+}
+
+// clubRatings rates each session as one rating period, in order. Only the
+// players who played a club game on the site are rated. Since sessions
+// are rated back to back, rating deviations do not grow in between them.
+func clubRatings(sessions []*clubSession, club *ipc.Club) []*pb.ClubRating {
+	ratings := make(map[string]*entity.SingleRating)
+	games := make(map[string]int32)
+
+	for _, session := range sessions {
+		newRatings := make(map[string]*entity.SingleRating)
+		for _, divisionData := range session.divisions {
+			for _, person := range divisionData.Players.Persons {
+				if ratings[person.Id] == nil {
+					ratings[person.Id] = &entity.SingleRating{
+						Rating:          float64(glicko.InitialRating),
+						RatingDeviation: float64(glicko.InitialRatingDeviation),
+						Volatility:      glicko.InitialVolatility,
+					}
+				}
+			}
+			for _, projected := range ratePeriodGames(divisionData, ratings, 0) {
+				if projected.Games == 0 {
+					continue
+				}
+				newRatings[projected.UserId] = &entity.SingleRating{
+					Rating:          projected.NewRating,
+					RatingDeviation: projected.NewRatingDeviation,
+					Volatility:      projected.NewVolatility,
+				}
+				games[projected.UserId] += projected.Games
+			}
+		}
+		for playerID, rating := range newRatings {
+			ratings[playerID] = rating
+		}
+	}
+
+	clubRatings := []*pb.ClubRating{}
+	for playerID, n := range games {
+		clubRatings = append(clubRatings, &pb.ClubRating{
+			UserId:          playerID,
+			Rating:          ratings[playerID].Rating,
+			RatingDeviation: ratings[playerID].RatingDeviation,
+			Games:           n,
+			Member:          clubMember(club, playerID) != nil,
+		})
+	}
+	sort.Slice(clubRatings, func(i, j int) bool {
+		if clubRatings[i].Rating != clubRatings[j].Rating {
+			return clubRatings[i].Rating > clubRatings[j].Rating
+		}
+		return clubRatings[i].UserId < clubRatings[j].UserId
+	})
+	return clubRatings
+}
+
+// clubStats counts the players and the games with results of each session,
+// and the sessions and games of each member of the club.
+func clubStats(sessions []*clubSession, club *ipc.Club, limit int) *pb.ClubStatsResponse {
+	activity := make(map[string]*pb.ClubMemberActivity)
+	for _, member := range club.Members {
+		activity[member.Id] = &pb.ClubMemberActivity{UserId: member.Id}
+	}
+
+	resp := &pb.ClubStatsResponse{}
+	for idx := len(sessions) - 1; idx >= 0; idx-- {
+		session := sessions[idx]
+		attendance := &pb.SessionAttendance{TournamentId: session.id, Slug: session.slug}
+		for _, divisionData := range session.divisions {
+			persons := divisionData.Players.Persons
+			for _, person := range persons {
+				attendance.Players++
+				if memberActivity, ok := activity[person.Id]; ok {
+					memberActivity.Sessions++
+				}
+			}
+			for _, pairing := range divisionData.PairingMap {
+				if len(pairing.Players) != 2 || pairing.Players[0] == pairing.Players[1] {
+					continue
+				}
+				for _, game := range pairing.Games {
+					if !isRatedResult(game.Results[0]) {
+						continue
+					}
+					attendance.Games++
+					for _, p := range pairing.Players {
+						if memberActivity, ok := activity[persons[p].Id]; ok {
+							memberActivity.Games++
+						}
+					}
+				}
+			}
+		}
+		resp.Sessions = append(resp.Sessions, attendance)
+	}
+
+	for _, memberActivity := range activity {
+		if memberActivity.Sessions > 0 {
+			resp.MostActive = append(resp.MostActive, memberActivity)
+		}
+	}
+	sort.Slice(resp.MostActive, func(i, j int) bool {
+		a, b := resp.MostActive[i], resp.MostActive[j]
+		if a.Sessions != b.Sessions {
+			return a.Sessions > b.Sessions
+		}
+		if a.Games != b.Games {
+			return a.Games > b.Games
+		}
+		return a.UserId < b.UserId
+	})
+	if len(resp.MostActive) > limit {
+		resp.MostActive = resp.MostActive[:limit]
+	}
+	return resp
+}
+
+func clubMember(club *ipc.Club, playerID string) *ipc.ClubMember {
+	for _, member := range club.Members {
+		if member.Id == playerID {
+			return member
+		}
+	}
+	return nil
+}
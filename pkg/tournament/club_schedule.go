@@ -0,0 +1,247 @@
+package tournament
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/lithammer/shortuuid"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/domino14/liwords/pkg/entity"
+	ipc "github.com/domino14/liwords/rpc/api/proto/ipc"
+)
+
+const (
+	DefaultClubSessionLeadHours = 24
+	MaxClubSessionLeadHours     = 7 * 24
+)
+
+// CreateClubSession creates a session of the club on the given date and
+// sets it up from the session template of the club, if it has one.
+func CreateClubSession(ctx context.Context, ts TournamentStore, clubID string, date time.Time) (*entity.Tournament, error) {
+	club, err := ts.Get(ctx, clubID)
+	if err != nil {
+		return nil, err
+	}
+
+	club.RLock()
+	if club.Type != entity.TypeClub {
+		club.RUnlock()
+		return nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NOT_A_CLUB, club.Name, "")
+	}
+	// /club/madison/
+	slug := club.Slug + "/" + date.Format("2006-01-02-") + shortuuid.New()[2:5]
+	name := club.Name + " - " + date.Format("Mon Jan 2, 2006")
+	description := club.Description
+	directors := proto.Clone(club.Directors).(*ipc.TournamentPersons)
+	var template *ipc.ClubSessionTemplate
+	if club.Club != nil && club.Club.SessionTemplate != nil {
+		template = proto.Clone(club.Club.SessionTemplate).(*ipc.ClubSessionTemplate)
+	}
+	club.RUnlock()
+
+	t, err := NewTournament(ctx, ts, name, description, directors, entity.TypeChild, clubID, slug)
+	if err != nil {
+		return nil, err
+	}
+	if template == nil {
+		return t, nil
+	}
+	return t, applyClubSessionTemplate(ctx, ts, t, template, date)
+}
+
+// applyClubSessionTemplate adds the divisions of the template to the
+// session, which starts at the given time.
+func applyClubSessionTemplate(ctx context.Context, ts TournamentStore, t *entity.Tournament,
+	template *ipc.ClubSessionTemplate, start time.Time) error {
+
+	if template.DefaultClubSettings != nil {
+		unlock := lockTournament(ctx, t)
+		t.ExtraMeta.DefaultClubSettings = template.DefaultClubSettings
+		err := ts.Set(ctx, t)
+		unlock()
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, division := range template.Divisions {
+		moveClubSessionTimes(division, start)
+		err := AddDivision(ctx, ts, t.UUID, division.Name)
+		if err != nil {
+			return err
+		}
+		if division.Controls != nil {
+			err = SetDivisionControls(ctx, ts, t.UUID, division.Name, division.Controls)
+			if err != nil {
+				return err
+			}
+		}
+		if len(division.RoundControls) > 0 {
+			err = SetRoundControls(ctx, ts, t.UUID, division.Name, division.RoundControls)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// moveClubSessionTimes moves the deadlines and round start times of the
+// template division by as much as it takes for the first round to start
+// at the given time. If the first round has no start time, they are unset
+// as they belong to some earlier session.
+func moveClubSessionTimes(division *ipc.ClubSessionTemplateDivision, start time.Time) {
+	var offset time.Duration
+	keep := len(division.RoundControls) > 0 && division.RoundControls[0].ScheduledStartTime != nil
+	if keep {
+		offset = start.Sub(division.RoundControls[0].ScheduledStartTime.AsTime())
+	}
+	move := func(ts *timestamppb.Timestamp) *timestamppb.Timestamp {
+		if ts == nil || !keep {
+			return nil
+		}
+		return timestamppb.New(ts.AsTime().Add(offset))
+	}
+
+	if division.Controls != nil {
+		division.Controls.RegistrationDeadline = move(division.Controls.RegistrationDeadline)
+		division.Controls.CheckInDeadline = move(division.Controls.CheckInDeadline)
+	}
+	for _, roundControls := range division.RoundControls {
+		roundControls.ScheduledStartTime = move(roundControls.ScheduledStartTime)
+	}
+}
+
+func SetClubSessionTemplate(ctx context.Context, ts TournamentStore, id string, template *ipc.ClubSessionTemplate) error {
+	t, err := ts.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	defer lockTournament(ctx, t)()
+
+	club, err := clubOf(t)
+	if err != nil {
+		return err
+	}
+	if template != nil {
+		names := make(map[string]bool)
+		for _, division := range template.Divisions {
+			if division.Name == "" || names[division.Name] {
+				return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_INVALID_CLUB_SESSION_TEMPLATE, t.Name, division.Name)
+			}
+			names[division.Name] = true
+		}
+	}
+	club.SessionTemplate = template
+	return ts.Set(ctx, t)
+}
+
+// SetClubSessionSchedule sets the weekly schedule of the sessions of the
+// club. The last session created from an earlier schedule is kept so that
+// it is not created again.
+func SetClubSessionSchedule(ctx context.Context, ts TournamentStore, id string, schedule *ipc.ClubSessionSchedule) error {
+	t, err := ts.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	defer lockTournament(ctx, t)()
+
+	club, err := clubOf(t)
+	if err != nil {
+		return err
+	}
+	if schedule != nil {
+		_, err = time.LoadLocation(schedule.TimeZone)
+		if err != nil || schedule.Weekday < 0 || schedule.Weekday > 6 ||
+			schedule.Hour < 0 || schedule.Hour > 23 || schedule.Minute < 0 || schedule.Minute > 59 ||
+			schedule.LeadHours < 0 || schedule.LeadHours > MaxClubSessionLeadHours {
+			return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_INVALID_CLUB_SESSION_SCHEDULE, t.Name, "")
+		}
+		schedule.LastSession = nil
+		if club.SessionSchedule != nil {
+			schedule.LastSession = club.SessionSchedule.LastSession
+		}
+	}
+	club.SessionSchedule = schedule
+	return ts.Set(ctx, t)
+}
+
+// clubSessionsBeingCreated holds the IDs of the clubs whose next session is
+// being created, so that overlapping runs of the scheduler do not create it
+// twice.
+var clubSessionsBeingCreated sync.Map
+
+// runClubSchedule creates the next session of the club once it is due.
+// The session is marked as created only once it is set up. If it cannot
+// be set up it is deleted, and created again on the next run.
+func runClubSchedule(ctx context.Context, ts TournamentStore, t *entity.Tournament, now time.Time) error {
+	if _, busy := clubSessionsBeingCreated.LoadOrStore(t.UUID, true); busy {
+		return nil
+	}
+	defer clubSessionsBeingCreated.Delete(t.UUID)
+
+	t.RLock()
+	if t.Club == nil || t.Club.SessionSchedule == nil {
+		t.RUnlock()
+		return nil
+	}
+	start, createAt, err := nextClubSession(t.Club.SessionSchedule, now)
+	t.RUnlock()
+	if err != nil || now.Before(createAt) {
+		return err
+	}
+
+	session, err := CreateClubSession(ctx, ts, t.UUID, start)
+	if err != nil {
+		if session != nil {
+			if delErr := ts.Delete(ctx, session.UUID); delErr != nil {
+				log.Err(delErr).Str("tid", t.UUID).Str("session", session.UUID).Msg("delete-scheduled-club-session-error")
+			}
+		}
+		return err
+	}
+
+	defer lockTournament(ctx, t)()
+	if t.Club != nil && t.Club.SessionSchedule != nil {
+		t.Club.SessionSchedule.LastSession = timestamppb.New(start)
+	}
+	err = ts.Set(ctx, t)
+	if err != nil {
+		return err
+	}
+	log.Info().Str("tid", t.UUID).Str("session", session.UUID).Time("start", start).Msg("created-scheduled-club-session")
+	return nil
+}
+
+// nextClubSession returns the start of the next session on the schedule
+// after the last one that was created, and the time at which to create it.
+func nextClubSession(schedule *ipc.ClubSessionSchedule, now time.Time) (time.Time, time.Time, error) {
+	loc, err := time.LoadLocation(schedule.TimeZone)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	leadHours := int(schedule.LeadHours)
+	if leadHours == 0 {
+		leadHours = DefaultClubSessionLeadHours
+	}
+
+	after := now
+	if schedule.LastSession != nil && schedule.LastSession.AsTime().After(now) {
+		after = schedule.LastSession.AsTime()
+	}
+	local := after.In(loc)
+	days := (int(schedule.Weekday) - int(local.Weekday()) + 7) % 7
+	start := time.Date(local.Year(), local.Month(), local.Day()+days,
+		int(schedule.Hour), int(schedule.Minute), 0, 0, loc)
+	if !start.After(after) {
+		start = time.Date(local.Year(), local.Month(), local.Day()+days+7,
+			int(schedule.Hour), int(schedule.Minute), 0, 0, loc)
+	}
+	return start, start.Add(-time.Duration(leadHours) * time.Hour), nil
+}
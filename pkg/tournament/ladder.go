@@ -0,0 +1,467 @@
+package tournament
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lithammer/shortuuid"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/user"
+	ipc "github.com/domino14/liwords/rpc/api/proto/ipc"
+)
+
+const (
+	DefaultLadderChallengeDistance = 3
+	DefaultLadderAcceptSeconds     = 48 * 60 * 60
+)
+
+// StartLadderGame creates the game for an accepted ladder challenge
+// between two users, given by their UUIDs, and returns its ID.
+type StartLadderGame func(challengerUUID, challengedUUID string, gameReq *ipc.GameRequest) (string, error)
+
+// ladderOf returns the ladder of the tournament, which must be a ladder.
+// The tournament must be locked.
+func ladderOf(t *entity.Tournament) (*ipc.Ladder, error) {
+	if t.Type != entity.TypeLadder {
+		return nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NOT_A_LADDER, t.Name, "")
+	}
+	if t.Ladder == nil {
+		t.Ladder = &ipc.Ladder{
+			Id:                   t.UUID,
+			MaxChallengeDistance: DefaultLadderChallengeDistance,
+			AcceptSeconds:        DefaultLadderAcceptSeconds,
+		}
+	}
+	return t.Ladder, nil
+}
+
+func GetLadder(ctx context.Context, ts TournamentStore, id string) (*ipc.Ladder, error) {
+	t, err := ts.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// ladderOf may set up the ladder.
+	defer lockTournament(ctx, t)()
+
+	ladder, err := ladderOf(t)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Clone(ladder).(*ipc.Ladder), nil
+}
+
+func SetLadderControls(ctx context.Context, ts TournamentStore, id string, maxChallengeDistance int, acceptSeconds int) error {
+	t, err := ts.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	defer lockTournament(ctx, t)()
+
+	ladder, err := ladderOf(t)
+	if err != nil {
+		return err
+	}
+	if maxChallengeDistance < 1 || acceptSeconds < 1 {
+		return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_INVALID_LADDER_CONTROLS, t.Name, "")
+	}
+	ladder.MaxChallengeDistance = int32(maxChallengeDistance)
+	ladder.AcceptSeconds = int32(acceptSeconds)
+	return setLadder(ctx, ts, t)
+}
+
+// AddLadderPlayers adds the players, given by their usernames, at the
+// bottom of the ladder.
+func AddLadderPlayers(ctx context.Context, ts TournamentStore, us user.Store, id string, players *ipc.TournamentPersons) error {
+	t, err := ts.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	defer lockTournament(ctx, t)()
+
+	ladder, err := ladderOf(t)
+	if err != nil {
+		return err
+	}
+
+	// Only perform the add operation if all persons can be added.
+	userUUIDs := []string{}
+	added := []*ipc.TournamentPerson{}
+	for _, player := range players.Persons {
+		fullID, UUID, err := constructFullID(t.Name, "", ctx, us, player.Id)
+		if err != nil {
+			return err
+		}
+		if ladderRank(ladder, fullID) >= 0 {
+			return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_PLAYER_ALREADY_EXISTS, t.Name, "", fullID)
+		}
+		userUUIDs = append(userUUIDs, UUID)
+		added = append(added, &ipc.TournamentPerson{Id: fullID, Rating: player.Rating})
+	}
+
+	err = ts.AddRegistrants(ctx, t.UUID, userUUIDs, "")
+	if err != nil {
+		return err
+	}
+	ladder.Players = append(ladder.Players, added...)
+	return setLadder(ctx, ts, t)
+}
+
+// RemoveLadderPlayers takes the players, given by their usernames, off
+// the ladder. Everyone ranked below them moves up, and their challenges
+// are called off.
+func RemoveLadderPlayers(ctx context.Context, ts TournamentStore, us user.Store, id string, players *ipc.TournamentPersons) error {
+	t, err := ts.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	defer lockTournament(ctx, t)()
+
+	ladder, err := ladderOf(t)
+	if err != nil {
+		return err
+	}
+
+	userUUIDs := []string{}
+	removed := make(map[string]bool)
+	for _, player := range players.Persons {
+		fullID, UUID, err := constructFullID(t.Name, "", ctx, us, player.Id)
+		if err != nil {
+			return err
+		}
+		if ladderRank(ladder, fullID) < 0 {
+			return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NONEXISTENT_PLAYER, t.Name, "", fullID)
+		}
+		userUUIDs = append(userUUIDs, UUID)
+		removed[fullID] = true
+	}
+
+	err = ts.RemoveRegistrants(ctx, t.UUID, userUUIDs, "")
+	if err != nil {
+		return err
+	}
+
+	remaining := []*ipc.TournamentPerson{}
+	for _, player := range ladder.Players {
+		if !removed[player.Id] {
+			remaining = append(remaining, player)
+		}
+	}
+	ladder.Players = remaining
+
+	challenges := []*ipc.LadderChallenge{}
+	for _, challenge := range ladder.Challenges {
+		if !removed[challenge.Challenger] && !removed[challenge.Challenged] {
+			challenges = append(challenges, challenge)
+		}
+	}
+	ladder.Challenges = challenges
+	return setLadder(ctx, ts, t)
+}
+
+// ChallengeLadderPlayer has the player challenge the opponent, given by
+// their username, who must be ranked above the player and within the
+// challenge distance of the ladder.
+func ChallengeLadderPlayer(ctx context.Context, ts TournamentStore, us user.Store, id string, playerID string,
+	opponent string, now time.Time) error {
+
+	t, err := ts.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	defer lockTournament(ctx, t)()
+
+	ladder, err := ladderOf(t)
+	if err != nil {
+		return err
+	}
+	if t.ExtraMeta == nil || t.ExtraMeta.DefaultClubSettings == nil {
+		return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_LADDER_NO_GAME_SETTINGS, t.Name, "")
+	}
+
+	opponentID, _, err := constructFullID(t.Name, "", ctx, us, opponent)
+	if err != nil {
+		return err
+	}
+	playerRank := ladderRank(ladder, playerID)
+	if playerRank < 0 {
+		return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NONEXISTENT_PLAYER, t.Name, "", playerID)
+	}
+	opponentRank := ladderRank(ladder, opponentID)
+	if opponentRank < 0 {
+		return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NONEXISTENT_PLAYER, t.Name, "", opponentID)
+	}
+	if opponentRank >= playerRank || playerRank-opponentRank > int(ladder.MaxChallengeDistance) {
+		return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_LADDER_CHALLENGE_OUT_OF_RANGE, t.Name, "",
+			playerID, strconv.Itoa(int(ladder.MaxChallengeDistance)))
+	}
+	for _, player := range []string{playerID, opponentID} {
+		if ladderChallengeOf(ladder, player) != nil {
+			return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_LADDER_PLAYER_CHALLENGED, t.Name, "", player)
+		}
+	}
+
+	ladder.Challenges = append(ladder.Challenges, &ipc.LadderChallenge{
+		Id:         shortuuid.New(),
+		Challenger: playerID,
+		Challenged: opponentID,
+		CreatedAt:  timestamppb.New(now),
+		Deadline:   timestamppb.New(now.Add(time.Duration(ladder.AcceptSeconds) * time.Second)),
+	})
+	return setLadder(ctx, ts, t)
+}
+
+// AcceptLadderChallenge has the challenged player accept the challenge
+// and starts the game with the default game settings of the ladder. The
+// acceptance is recorded first, and the game is created without holding
+// the lock on the tournament. If the game can't be created, the challenge
+// is reopened with a new deadline.
+func AcceptLadderChallenge(ctx context.Context, ts TournamentStore, id string, challengeID string, playerID string,
+	now time.Time, startGame StartLadderGame) error {
+
+	t, err := ts.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	challenge, gameReq, err := markLadderChallengeAccepted(ctx, ts, t, challengeID, playerID, now)
+	if err != nil {
+		return err
+	}
+
+	gameID, err := startGame(strings.Split(challenge.Challenger, ":")[0], strings.Split(challenge.Challenged, ":")[0], gameReq)
+
+	defer lockTournament(ctx, t)()
+
+	ladder, lerr := ladderOf(t)
+	if lerr != nil {
+		return lerr
+	}
+	challenge = nil
+	for _, c := range ladder.Challenges {
+		if c.Id == challengeID {
+			challenge = c
+		}
+	}
+	if challenge == nil {
+		// The challenge was taken off the ladder while the game was
+		// being created, for instance because a player left the ladder.
+		log.Info().Str("tid", id).Str("challenge", challengeID).Str("game", gameID).
+			Msg("ladder-challenge-removed-while-accepting")
+		return err
+	}
+	if err != nil {
+		challenge.AcceptedAt = nil
+		challenge.Deadline = timestamppb.New(now.Add(time.Duration(ladder.AcceptSeconds) * time.Second))
+		if serr := setLadder(ctx, ts, t); serr != nil {
+			log.Err(serr).Str("tid", id).Str("challenge", challengeID).Msg("reopen-ladder-challenge-error")
+		}
+		return err
+	}
+	challenge.GameId = gameID
+	return setLadder(ctx, ts, t)
+}
+
+// markLadderChallengeAccepted records that the challenged player accepted
+// the challenge, so that it can't be accepted twice or expire while its
+// game is being created. It returns a copy of the challenge and the game
+// request for its game.
+func markLadderChallengeAccepted(ctx context.Context, ts TournamentStore, t *entity.Tournament, challengeID string,
+	playerID string, now time.Time) (*ipc.LadderChallenge, *ipc.GameRequest, error) {
+
+	defer lockTournament(ctx, t)()
+
+	ladder, err := ladderOf(t)
+	if err != nil {
+		return nil, nil, err
+	}
+	challenge := openLadderChallenge(ladder, challengeID)
+	if challenge == nil || challenge.Challenged != playerID {
+		return nil, nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_LADDER_NONEXISTENT_CHALLENGE, t.Name, "")
+	}
+	if t.ExtraMeta == nil || t.ExtraMeta.DefaultClubSettings == nil {
+		return nil, nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_LADDER_NO_GAME_SETTINGS, t.Name, "")
+	}
+
+	challenge.AcceptedAt = timestamppb.New(now)
+	err = setLadder(ctx, ts, t)
+	if err != nil {
+		return nil, nil, err
+	}
+	return proto.Clone(challenge).(*ipc.LadderChallenge),
+		proto.Clone(t.ExtraMeta.DefaultClubSettings).(*ipc.GameRequest), nil
+}
+
+// DeclineLadderChallenge takes a challenge that was not accepted yet off
+// the ladder. The challenged player declining it forfeits it, the same as
+// if they let it expire, while the challenger declining it withdraws it.
+func DeclineLadderChallenge(ctx context.Context, ts TournamentStore, id string, challengeID string, playerID string) error {
+	t, err := ts.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	defer lockTournament(ctx, t)()
+
+	ladder, err := ladderOf(t)
+	if err != nil {
+		return err
+	}
+	challenge := openLadderChallenge(ladder, challengeID)
+	if challenge == nil || (challenge.Challenged != playerID && challenge.Challenger != playerID) {
+		return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_LADDER_NONEXISTENT_CHALLENGE, t.Name, "")
+	}
+	if challenge.Challenged == playerID {
+		moveUpLadder(ladder, challenge.Challenger, challenge.Challenged)
+	}
+	removeLadderChallenge(ladder, challengeID)
+	return setLadder(ctx, ts, t)
+}
+
+// ClearLadderChallengeGame reopens the challenge that was played in the
+// game, if that game was cancelled, so that it can be accepted again.
+func ClearLadderChallengeGame(ctx context.Context, ts TournamentStore, t *entity.Tournament, gameID string, now time.Time) error {
+	defer lockTournament(ctx, t)()
+
+	ladder, err := ladderOf(t)
+	if err != nil {
+		return err
+	}
+	for _, challenge := range ladder.Challenges {
+		if challenge.GameId == gameID {
+			challenge.GameId = ""
+			challenge.AcceptedAt = nil
+			challenge.Deadline = timestamppb.New(now.Add(time.Duration(ladder.AcceptSeconds) * time.Second))
+			return setLadder(ctx, ts, t)
+		}
+	}
+	return nil
+}
+
+// ladderGameEnded settles the challenge that was played in the game. If the
+// challenger won, they take the rank of the challenged player, and everyone
+// in between moves down a rank.
+func ladderGameEnded(ctx context.Context, ts TournamentStore, t *entity.Tournament, g *entity.Game) error {
+	defer lockTournament(ctx, t)()
+
+	ladder, err := ladderOf(t)
+	if err != nil {
+		return err
+	}
+	for idx, challenge := range ladder.Challenges {
+		if challenge.GameId != g.GameID() {
+			continue
+		}
+		if g.WinnerIdx >= 0 && g.History().Players[g.WinnerIdx].UserId == strings.Split(challenge.Challenger, ":")[0] {
+			moveUpLadder(ladder, challenge.Challenger, challenge.Challenged)
+		}
+		ladder.Challenges = append(ladder.Challenges[:idx], ladder.Challenges[idx+1:]...)
+		return setLadder(ctx, ts, t)
+	}
+	// This was not a challenge game.
+	return nil
+}
+
+// expireLadderChallenges forfeits the challenges that were not accepted
+// in time for the challenged players.
+func expireLadderChallenges(ctx context.Context, ts TournamentStore, t *entity.Tournament, now time.Time) error {
+	ladder, err := ladderOf(t)
+	if err != nil {
+		return err
+	}
+	open := []*ipc.LadderChallenge{}
+	for _, challenge := range ladder.Challenges {
+		if ladderChallengeIsOpen(challenge) && challenge.Deadline != nil && !now.Before(challenge.Deadline.AsTime()) {
+			log.Info().Str("tid", t.UUID).Str("challenger", challenge.Challenger).
+				Str("challenged", challenge.Challenged).Msg("ladder-challenge-expired")
+			moveUpLadder(ladder, challenge.Challenger, challenge.Challenged)
+			continue
+		}
+		open = append(open, challenge)
+	}
+	if len(open) == len(ladder.Challenges) {
+		return nil
+	}
+	ladder.Challenges = open
+	return setLadder(ctx, ts, t)
+}
+
+// moveUpLadder has the winner take the rank of the loser if the loser
+// was ranked above them. Everyone from the loser down to the winner
+// moves down a rank.
+func moveUpLadder(ladder *ipc.Ladder, winner string, loser string) {
+	winnerRank := ladderRank(ladder, winner)
+	loserRank := ladderRank(ladder, loser)
+	if winnerRank < 0 || loserRank < 0 || loserRank >= winnerRank {
+		return
+	}
+	winnerPerson := ladder.Players[winnerRank]
+	copy(ladder.Players[loserRank+1:winnerRank+1], ladder.Players[loserRank:winnerRank])
+	ladder.Players[loserRank] = winnerPerson
+}
+
+// ladderRank returns the rank of the player, starting at 0, or -1 if
+// the player is not on the ladder.
+func ladderRank(ladder *ipc.Ladder, playerID string) int {
+	for idx, player := range ladder.Players {
+		if player.Id == playerID {
+			return idx
+		}
+	}
+	return -1
+}
+
+// ladderChallengeIsOpen returns true if the challenge is still waiting
+// for the challenged player to accept it.
+func ladderChallengeIsOpen(challenge *ipc.LadderChallenge) bool {
+	return challenge.GameId == "" && challenge.AcceptedAt == nil
+}
+
+// openLadderChallenge returns the challenge if it is still open, or nil.
+func openLadderChallenge(ladder *ipc.Ladder, challengeID string) *ipc.LadderChallenge {
+	for _, challenge := range ladder.Challenges {
+		if challenge.Id == challengeID && ladderChallengeIsOpen(challenge) {
+			return challenge
+		}
+	}
+	return nil
+}
+
+func removeLadderChallenge(ladder *ipc.Ladder, challengeID string) {
+	for idx, challenge := range ladder.Challenges {
+		if challenge.Id == challengeID {
+			ladder.Challenges = append(ladder.Challenges[:idx], ladder.Challenges[idx+1:]...)
+			return
+		}
+	}
+}
+
+func ladderChallengeOf(ladder *ipc.Ladder, playerID string) *ipc.LadderChallenge {
+	for _, challenge := range ladder.Challenges {
+		if challenge.Challenger == playerID || challenge.Challenged == playerID {
+			return challenge
+		}
+	}
+	return nil
+}
+
+// setLadder saves the tournament and sends the ladder to the tournament
+// channel. The tournament must be locked.
+func setLadder(ctx context.Context, ts TournamentStore, t *entity.Tournament) error {
+	err := ts.Set(ctx, t)
+	if err != nil {
+		return err
+	}
+	wrapped := entity.WrapEvent(proto.Clone(t.Ladder), ipc.MessageType_LADDER_MESSAGE)
+	return SendTournamentMessage(ctx, ts, t.UUID, wrapped)
+}
@@ -0,0 +1,118 @@
+package tournament
+
+import (
+	"encoding/json"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/pair"
+	pb "github.com/domino14/liwords/rpc/api/proto/ipc"
+)
+
+// pairingExplanation records what went into the pairings of a round.
+type pairingExplanation struct {
+	// members are the players who were paired by the pairing method.
+	members             *entity.UnpairedPoolMembers
+	gibsonizedPlayers   []string
+	gibsonPairedPlayers map[string]bool
+	contention          []*pb.PlayerContention
+}
+
+// PreviewPairRound returns the pairings that PairRound would make for the
+// round, and why, without changing the division. If the controls are not
+// nil, they are used for the round instead of its current controls.
+func (t *ClassicDivision) PreviewPairRound(round int, preserveByes bool, controls *pb.RoundControl) (*pb.PairingPreviewResponse, error) {
+	preview, err := t.clone()
+	if err != nil {
+		return nil, err
+	}
+	if controls != nil {
+		_, err = preview.SetSingleRoundControls(round, proto.Clone(controls).(*pb.RoundControl))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	explanation := &pairingExplanation{}
+	preview.explanation = explanation
+	_, err = preview.PairRound(round, preserveByes)
+	if err != nil {
+		return nil, err
+	}
+
+	repeats, err := getRepeats(preview, round-1)
+	if err != nil {
+		return nil, err
+	}
+
+	roundControls := preview.RoundControls[round]
+	response := &pb.PairingPreviewResponse{
+		Round:             int32(round),
+		RoundControls:     roundControls,
+		GibsonizedPlayers: explanation.gibsonizedPlayers,
+		Contention:        explanation.contention,
+	}
+
+	memberIndexes := make(map[string]int)
+	weighed := explanation.members != nil &&
+		(roundControls.PairingMethod == pb.PairingMethod_SWISS ||
+			roundControls.PairingMethod == pb.PairingMethod_FACTOR)
+	if weighed {
+		for idx, member := range explanation.members.PoolMembers {
+			memberIndexes[member.Id] = idx
+		}
+	}
+
+	previewed := make(map[string]bool)
+	for _, pairingKey := range preview.Matrix[round] {
+		pairing, ok := preview.PairingMap[pairingKey]
+		if !ok || pairing.Players == nil || previewed[pairingKey] {
+			continue
+		}
+		previewed[pairingKey] = true
+
+		playerOne := preview.Players.Persons[pairing.Players[0]].Id
+		playerTwo := preview.Players.Persons[pairing.Players[1]].Id
+		previousGames := repeats[pair.GetRepeatKey(playerOne, playerTwo)]
+		pairingPreview := &pb.PairingPreview{
+			Players:       []string{playerOne, playerTwo},
+			PreviousGames: int32(previousGames),
+			Bye:           playerOne == playerTwo,
+			Gibson:        explanation.gibsonPairedPlayers[playerOne] && explanation.gibsonPairedPlayers[playerTwo],
+		}
+		pairingPreview.Repeat = !pairingPreview.Bye && previousGames > 0
+
+		i, okOne := memberIndexes[playerOne]
+		j, okTwo := memberIndexes[playerTwo]
+		if weighed && okOne && okTwo && !pairingPreview.Bye {
+			weight := pair.SwissWeight(explanation.members, i, j)
+			pairingPreview.Weight = &pb.PairingWeight{
+				WinDifference: weight.WinDifference,
+				Spread:        weight.Spread,
+				Repeat:        weight.Repeat,
+				Firsts:        weight.Firsts,
+				Contention:    weight.Contention,
+				Total:         weight.Total(),
+			}
+			response.TotalWeight += weight.Total()
+		}
+		response.Pairings = append(response.Pairings, pairingPreview)
+	}
+	return response, nil
+}
+
+// clone returns a deep copy of the division, made the same
+// way the division is saved and loaded.
+func (t *ClassicDivision) clone() (*ClassicDivision, error) {
+	bts, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	division := &ClassicDivision{}
+	err = json.Unmarshal(bts, division)
+	if err != nil {
+		return nil, err
+	}
+	return division, nil
+}
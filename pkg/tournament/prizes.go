@@ -0,0 +1,249 @@
+package tournament
+
+import (
+	"context"
+	"sort"
+
+	"github.com/domino14/liwords/pkg/entity"
+	ipc "github.com/domino14/liwords/rpc/api/proto/ipc"
+)
+
+// validatePrizes checks the prizes of the tournament, which must be locked.
+func validatePrizes(t *entity.Tournament, prizes []*ipc.Prize) error {
+	for _, prize := range prizes {
+		if _, ok := t.Divisions[prize.Division]; prize.Division != "" && !ok {
+			return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NONEXISTENT_DIVISION, t.Name, prize.Division)
+		}
+		placed := prize.Type == ipc.PrizeType_PLACE_PRIZE || prize.Type == ipc.PrizeType_CLASS_PRIZE
+		if prize.Amount < 0 || (placed && prize.Place < 1) ||
+			(prize.RatingFloor > 0 && prize.RatingCeiling > 0 && prize.RatingFloor > prize.RatingCeiling) {
+			return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_INVALID_PRIZE, t.Name, prize.Division)
+		}
+	}
+	return nil
+}
+
+// finishTournament finishes the tournament once every division is over,
+// awarding its prizes. The tournament must be locked.
+func finishTournament(ctx context.Context, ts TournamentStore, t *entity.Tournament) error {
+	awards, err := computePrizeAwards(t)
+	if err != nil {
+		return err
+	}
+	if awards != nil {
+		t.ExtraMeta.PrizeAwards = awards
+	}
+	t.IsFinished = true
+	return ts.RemoveRegistrantsForTournament(ctx, t.UUID)
+}
+
+// computePrizeAwards awards the prizes of the tournament from the final
+// standings and the games of each division. The tournament must be locked.
+func computePrizeAwards(t *entity.Tournament) ([]*ipc.PrizeAward, error) {
+	if t.ExtraMeta == nil || len(t.ExtraMeta.Prizes) == 0 {
+		return nil, nil
+	}
+	divisionNames := make([]string, 0, len(t.Divisions))
+	for name := range t.Divisions {
+		divisionNames = append(divisionNames, name)
+	}
+	sort.Strings(divisionNames)
+
+	awards := []*ipc.PrizeAward{}
+	for _, division := range divisionNames {
+		prizes := []*ipc.Prize{}
+		for _, prize := range t.ExtraMeta.Prizes {
+			if prize.Division == "" || prize.Division == division {
+				prizes = append(prizes, prize)
+			}
+		}
+		if len(prizes) == 0 {
+			continue
+		}
+		dm := t.Divisions[division].DivisionManager
+		if dm == nil {
+			return nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NIL_DIVISION_MANAGER, t.Name, division)
+		}
+		standings, _, err := dm.GetStandings(len(dm.GetRoundControls()) - 1)
+		if err != nil {
+			return nil, err
+		}
+		divisionData, err := dm.GetXHRResponse()
+		if err != nil {
+			return nil, err
+		}
+		awards = append(awards, divisionPrizeAwards(division, prizes, t.ExtraMeta.PrizeTieRule,
+			standings.Standings, divisionData)...)
+	}
+	return awards, nil
+}
+
+// divisionPrizeAwards awards the prizes of one division. Tied players share
+// every prize for the places they hold, according to the tie rule.
+func divisionPrizeAwards(division string, prizes []*ipc.Prize, tieRule ipc.PrizeTieRule,
+	standings []*ipc.PlayerStanding, divisionData *ipc.TournamentDivisionDataResponse) []*ipc.PrizeAward {
+
+	persons := make(map[string]*ipc.TournamentPerson)
+	seeds := make(map[string]int)
+	for idx, person := range divisionData.Players.Persons {
+		persons[person.Id] = person
+		seeds[person.Id] = idx
+	}
+	// Players who were removed from the division are not in the running.
+	ranked := []*ipc.PlayerStanding{}
+	for _, record := range standings {
+		if person, ok := persons[record.PlayerId]; ok && !person.Suspended {
+			ranked = append(ranked, record)
+		}
+	}
+
+	awards := []*ipc.PrizeAward{}
+	placeWinners := make(map[string]bool)
+	for _, prize := range prizes {
+		if prize.Type != ipc.PrizeType_PLACE_PRIZE {
+			continue
+		}
+		winners := tiedAtPlace(ranked, int(prize.Place), tieRule)
+		if len(winners) == 0 {
+			continue
+		}
+		for _, winner := range winners {
+			placeWinners[winner] = true
+		}
+		awards = append(awards, prizeAward(prize, division, winners, 0, seeds))
+	}
+
+	for _, prize := range prizes {
+		if prize.Type != ipc.PrizeType_CLASS_PRIZE {
+			continue
+		}
+		class := []*ipc.PlayerStanding{}
+		for _, record := range ranked {
+			rating := persons[record.PlayerId].Rating
+			if placeWinners[record.PlayerId] ||
+				(prize.RatingFloor > 0 && rating < prize.RatingFloor) ||
+				(prize.RatingCeiling > 0 && rating > prize.RatingCeiling) {
+				continue
+			}
+			class = append(class, record)
+		}
+		winners := tiedAtPlace(class, int(prize.Place), tieRule)
+		if len(winners) > 0 {
+			awards = append(awards, prizeAward(prize, division, winners, 0, seeds))
+		}
+	}
+
+	highGame, highGamers, highLoss, highLosers := highScores(divisionData)
+	for _, prize := range prizes {
+		if prize.Type == ipc.PrizeType_HIGH_GAME_PRIZE && len(highGamers) > 0 {
+			awards = append(awards, prizeAward(prize, division, highGamers, highGame, seeds))
+		} else if prize.Type == ipc.PrizeType_HIGH_LOSS_PRIZE && len(highLosers) > 0 {
+			awards = append(awards, prizeAward(prize, division, highLosers, highLoss, seeds))
+		}
+	}
+	return awards
+}
+
+// tiedAtPlace returns the players who hold the place, starting at 1,
+// in the standings. There is more than one if players are tied.
+func tiedAtPlace(standings []*ipc.PlayerStanding, place int, tieRule ipc.PrizeTieRule) []string {
+	if place < 1 || place > len(standings) {
+		return nil
+	}
+	tied := func(a, b *ipc.PlayerStanding) bool {
+		if 2*a.Wins+a.Draws != 2*b.Wins+b.Draws {
+			return false
+		}
+		return tieRule == ipc.PrizeTieRule_SPLIT_BY_WINS || a.Spread == b.Spread
+	}
+	record := standings[place-1]
+	first, last := place-1, place-1
+	for first > 0 && tied(standings[first-1], record) {
+		first--
+	}
+	for last < len(standings)-1 && tied(standings[last+1], record) {
+		last++
+	}
+	players := []string{}
+	for _, r := range standings[first : last+1] {
+		players = append(players, r.PlayerId)
+	}
+	return players
+}
+
+// highScores returns the highest score of a game and of a lost game in the
+// division, and the players who scored them.
+func highScores(divisionData *ipc.TournamentDivisionDataResponse) (int32, []string, int32, []string) {
+	persons := divisionData.Players.Persons
+	var highGame, highLoss int32
+	highGamers, highLosers := []string{}, []string{}
+	best := func(score int32, player string, high int32, players []string) (int32, []string) {
+		if len(players) == 0 || score > high {
+			return score, []string{player}
+		}
+		if score == high {
+			for _, p := range players {
+				if p == player {
+					return high, players
+				}
+			}
+			return high, append(players, player)
+		}
+		return high, players
+	}
+
+	// Go through the pairings in order so that tied players are always
+	// listed the same way.
+	pairingKeys := make([]string, 0, len(divisionData.PairingMap))
+	for key := range divisionData.PairingMap {
+		pairingKeys = append(pairingKeys, key)
+	}
+	sort.Strings(pairingKeys)
+
+	for _, key := range pairingKeys {
+		pairing := divisionData.PairingMap[key]
+		if len(pairing.Players) != 2 || pairing.Players[0] == pairing.Players[1] {
+			continue
+		}
+		for _, game := range pairing.Games {
+			if !isRatedResult(game.Results[0]) {
+				continue
+			}
+			switch game.GameEndReason {
+			case ipc.GameEndReason_RESIGNED, ipc.GameEndReason_TIME,
+				ipc.GameEndReason_FORCE_FORFEIT, ipc.GameEndReason_TRIPLE_CHALLENGE:
+				continue
+			}
+			for idx, p := range pairing.Players {
+				player := persons[p].Id
+				highGame, highGamers = best(game.Scores[idx], player, highGame, highGamers)
+				if game.Results[idx] == ipc.TournamentGameResult_LOSS {
+					highLoss, highLosers = best(game.Scores[idx], player, highLoss, highLosers)
+				}
+			}
+		}
+	}
+	return highGame, highGamers, highLoss, highLosers
+}
+
+// prizeAward splits the prize evenly between the winners, listed in seed
+// order. The first winners get one unit more each until the prize is used
+// up.
+func prizeAward(prize *ipc.Prize, division string, winners []string, score int32, seeds map[string]int) *ipc.PrizeAward {
+	sort.SliceStable(winners, func(i, j int) bool { return seeds[winners[i]] < seeds[winners[j]] })
+	amounts := make([]int64, len(winners))
+	share, remainder := prize.Amount/int64(len(winners)), prize.Amount%int64(len(winners))
+	for idx := range amounts {
+		amounts[idx] = share
+		if int64(idx) < remainder {
+			amounts[idx]++
+		}
+	}
+	return &ipc.PrizeAward{
+		Prize:     prize,
+		Division:  division,
+		PlayerIds: winners,
+		Amounts:   amounts,
+		Score:     score,
+	}
+}
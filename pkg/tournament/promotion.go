@@ -0,0 +1,232 @@
+package tournament
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/user"
+	ipc "github.com/domino14/liwords/rpc/api/proto/ipc"
+)
+
+// PromoteAndRelegate moves the players of a finished club session into the
+// divisions of the next session of the same club. The divisions are ordered
+// from the highest to the lowest. The top promoted players of each division
+// move up a division, the bottom relegated players move down, and everyone
+// else stays. Divisions that the next session doesn't have yet are added,
+// and the players keep the ratings they were seeded by. Everything is
+// checked before the next session changes, and either all of the players
+// are moved or none of them are.
+func PromoteAndRelegate(ctx context.Context, ts TournamentStore, us user.Store, id string, nextID string,
+	divisions []string, promoted int, relegated int) ([]*ipc.TournamentPersons, error) {
+
+	t, err := ts.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	unlock := lockTournament(ctx, t)
+	name, uuid, tType, parentID := t.Name, t.UUID, t.Type, t.ParentID
+	finalStandings, err := sessionFinalStandings(t, divisions)
+	unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	if promoted < 0 || relegated < 0 {
+		return nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NEGATIVE_PROMOTIONS, name)
+	}
+	seen := make(map[string]bool)
+	for _, division := range divisions {
+		if seen[division] {
+			return nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_PROMOTION_DUPLICATE_DIVISION, name, division)
+		}
+		seen[division] = true
+	}
+
+	next, err := ts.Get(ctx, nextID)
+	if err != nil {
+		return nil, err
+	}
+	defer lockTournament(ctx, next)()
+
+	validNext := next.UUID != uuid && tType == entity.TypeChild && next.Type == entity.TypeChild &&
+		parentID != "" && next.ParentID == parentID && !next.IsStarted && !next.IsFinished
+	if !validNext {
+		return nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_PROMOTION_INVALID_NEXT_SESSION, name)
+	}
+
+	inNext := make(map[string]bool)
+	for _, divisionObject := range next.Divisions {
+		if divisionObject.DivisionManager == nil {
+			continue
+		}
+		for _, player := range divisionObject.DivisionManager.GetPlayers().Persons {
+			inNext[player.Id] = true
+		}
+	}
+
+	// The players are added to copies of the divisions, which only replace
+	// the divisions of the next session once all of them have their players.
+	moved := promoteAndRelegate(finalStandings, promoted, relegated)
+	newDivisions := make([]*ClassicDivision, len(divisions))
+	response := []*ipc.TournamentPersons{}
+	userUUIDs := make([][]string, len(divisions))
+	for idx, division := range divisions {
+		divisionObject, exists := next.Divisions[division]
+		if !exists {
+			if len(division) == 0 || len(division) > MaxDivisionNameLength {
+				return nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_INVALID_DIVISION_NAME, next.Name, division)
+			}
+			newDivisions[idx] = NewClassicDivision(next.Name, division)
+		} else {
+			if divisionObject.DivisionManager == nil {
+				return nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NIL_DIVISION_MANAGER, next.Name, division)
+			}
+			newDivisions[idx], err = copyDivision(divisionObject.DivisionManager)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		players := &ipc.TournamentPersons{Id: nextID, Division: division}
+		added := &ipc.TournamentPersons{Id: nextID, Division: division}
+		for _, player := range moved[idx] {
+			username := player.Id[strings.Index(player.Id, ":")+1:]
+			fullID, UUID, err := constructFullID(next.Name, division, ctx, us, username)
+			if err != nil {
+				return nil, err
+			}
+			if inNext[fullID] {
+				return nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_PLAYER_ALREADY_EXISTS, next.Name, division, fullID)
+			}
+			players.Persons = append(players.Persons, &ipc.TournamentPerson{Id: fullID, Rating: player.Rating})
+			added.Persons = append(added.Persons, &ipc.TournamentPerson{Id: fullID, Rating: player.Rating})
+			userUUIDs[idx] = append(userUUIDs[idx], UUID)
+		}
+		if len(players.Persons) > 0 {
+			_, err = newDivisions[idx].AddPlayers(players)
+			if err != nil {
+				return nil, err
+			}
+		}
+		response = append(response, added)
+	}
+
+	for idx, division := range divisions {
+		if _, exists := next.Divisions[division]; !exists {
+			next.Divisions[division] = &entity.TournamentDivision{}
+		}
+		next.Divisions[division].DivisionManager = newDivisions[idx]
+		if len(userUUIDs[idx]) > 0 {
+			err = ts.AddRegistrants(ctx, nextID, userUUIDs[idx], division)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	err = ts.Set(ctx, next)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, division := range divisions {
+		tdevt, err := next.Divisions[division].DivisionManager.GetXHRResponse()
+		if err != nil {
+			return nil, err
+		}
+		tdevt.Id = nextID
+		tdevt.Division = division
+		err = SendTournamentMessage(ctx, ts, nextID, entity.WrapEvent(tdevt, ipc.MessageType_TOURNAMENT_DIVISION_MESSAGE))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+// copyDivision returns a copy of the division, made the same way the
+// division is saved and loaded.
+func copyDivision(dm entity.DivisionManager) (*ClassicDivision, error) {
+	bts, err := json.Marshal(dm)
+	if err != nil {
+		return nil, err
+	}
+	division := &ClassicDivision{}
+	err = json.Unmarshal(bts, division)
+	if err != nil {
+		return nil, err
+	}
+	return division, nil
+}
+
+// sessionFinalStandings returns the players of each division who were
+// still playing at the end of the session, from first place to last.
+// The tournament must be locked.
+func sessionFinalStandings(t *entity.Tournament, divisions []string) ([][]*ipc.TournamentPerson, error) {
+	// Getting the standings caches them in the division,
+	// so the tournament must be write locked.
+	if !t.IsFinished {
+		return nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_PROMOTION_SESSION_NOT_FINISHED, t.Name)
+	}
+
+	finalStandings := [][]*ipc.TournamentPerson{}
+	for _, division := range divisions {
+		divisionObject, ok := t.Divisions[division]
+		if !ok {
+			return nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NONEXISTENT_DIVISION, t.Name, division)
+		}
+		dm := divisionObject.DivisionManager
+		if dm == nil {
+			return nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NIL_DIVISION_MANAGER, t.Name, division)
+		}
+		persons := make(map[string]*ipc.TournamentPerson)
+		for _, person := range dm.GetPlayers().Persons {
+			persons[person.Id] = person
+		}
+		standings, _, err := dm.GetStandings(len(dm.GetRoundControls()) - 1)
+		if err != nil {
+			return nil, err
+		}
+		players := []*ipc.TournamentPerson{}
+		for _, record := range standings.Standings {
+			person, ok := persons[record.PlayerId]
+			if ok && !person.Suspended {
+				players = append(players, person)
+			}
+		}
+		finalStandings = append(finalStandings, players)
+	}
+	return finalStandings, nil
+}
+
+// promoteAndRelegate returns the players of each division after the top
+// promoted players of each division but the highest move up a division and
+// the bottom relegated players of each division but the lowest move down.
+// The divisions are ordered from the highest to the lowest and their players
+// from first place to last. Promotions come first in a division that is too
+// small for both.
+func promoteAndRelegate(divisions [][]*ipc.TournamentPerson, promoted int, relegated int) [][]*ipc.TournamentPerson {
+	moved := make([][]*ipc.TournamentPerson, len(divisions))
+	for idx, players := range divisions {
+		up := 0
+		if idx > 0 {
+			up = promoted
+			if up > len(players) {
+				up = len(players)
+			}
+			moved[idx-1] = append(moved[idx-1], players[:up]...)
+		}
+		down := 0
+		if idx < len(divisions)-1 {
+			down = relegated
+			if down > len(players)-up {
+				down = len(players) - up
+			}
+			moved[idx+1] = append(moved[idx+1], players[len(players)-down:]...)
+		}
+		moved[idx] = append(moved[idx], players[up:len(players)-down]...)
+	}
+	return moved
+}
@@ -0,0 +1,307 @@
+package tournament
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/glicko"
+	"github.com/domino14/liwords/pkg/user"
+	ipc "github.com/domino14/liwords/rpc/api/proto/ipc"
+	pb "github.com/domino14/liwords/rpc/api/proto/tournament_service"
+)
+
+// RatesAsPeriod returns whether the tournament game is in a division that
+// is rated as one rating period, in which case it must not be rated when
+// it ends.
+func RatesAsPeriod(ctx context.Context, ts TournamentStore, g *entity.Game) bool {
+	if g.TournamentData == nil || g.TournamentData.Id == "" {
+		return false
+	}
+	t, err := ts.Get(ctx, g.TournamentData.Id)
+	if err != nil {
+		log.Err(err).Str("tid", g.TournamentData.Id).Msg("rates-as-period-get-tournament")
+		return false
+	}
+	t.RLock()
+	defer t.RUnlock()
+
+	divisionObject, ok := t.Divisions[g.TournamentData.Division]
+	if !ok || divisionObject.DivisionManager == nil {
+		return false
+	}
+	return divisionObject.DivisionManager.GetDivisionControls().GetRateAsPeriod()
+}
+
+// RatingPeriodPreview returns the rating changes that the games played so
+// far in the division would make if the division were rated now.
+func RatingPeriodPreview(ctx context.Context, ts TournamentStore, us user.Store, id string, division string) (*pb.RatingPeriodResponse, error) {
+	t, err := ts.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	t.RLock()
+	defer t.RUnlock()
+
+	resp, _, err := rateDivisionPeriod(ctx, us, t, division, time.Now().Unix())
+	if err != nil {
+		return nil, err
+	}
+	resp.Id = id
+	return resp, nil
+}
+
+// ratingPeriodPending returns whether the division has finished but its
+// rating period has not been applied yet, usually because the ratings
+// could not be saved when it ended.
+func ratingPeriodPending(divisionObject *entity.TournamentDivision) bool {
+	dm := divisionObject.DivisionManager
+	if dm == nil || divisionObject.RatingPeriodApplied || !dm.GetDivisionControls().GetRateAsPeriod() {
+		return false
+	}
+	finished, err := dm.IsFinished()
+	return err == nil && finished
+}
+
+// applyPendingRatingPeriods retries the rating periods of the finished
+// divisions that could not be applied. It returns whether any were applied.
+// The caller must hold the lock on the tournament.
+func applyPendingRatingPeriods(ctx context.Context, us user.Store, t *entity.Tournament) (bool, error) {
+	applied := false
+	for division, divisionObject := range t.Divisions {
+		if !ratingPeriodPending(divisionObject) {
+			continue
+		}
+		err := possiblyApplyRatingPeriod(ctx, us, t, division)
+		if err != nil {
+			return applied, err
+		}
+		applied = true
+	}
+	return applied, nil
+}
+
+// possiblyApplyRatingPeriod rates the games of the division in one rating
+// period once the division has finished, if the division is rated that way.
+// The caller must hold the lock on the tournament.
+func possiblyApplyRatingPeriod(ctx context.Context, us user.Store, t *entity.Tournament, division string) error {
+	divisionObject := t.Divisions[division]
+	if divisionObject.RatingPeriodApplied || !divisionObject.DivisionManager.GetDivisionControls().GetRateAsPeriod() {
+		return nil
+	}
+	finished, err := divisionObject.DivisionManager.IsFinished()
+	if err != nil || !finished {
+		return err
+	}
+
+	resp, ratings, err := rateDivisionPeriod(ctx, us, t, division, time.Now().Unix())
+	if err != nil {
+		return err
+	}
+	if len(ratings) > 0 {
+		err = us.SetManyRatings(ctx, entity.VariantKey(resp.Variant), ratings, t.UUID)
+		if err != nil {
+			return err
+		}
+	}
+	divisionObject.RatingPeriodApplied = true
+	log.Info().Str("tid", t.UUID).Str("division", division).Int("players", len(ratings)).
+		Msg("applied-rating-period")
+	return nil
+}
+
+// snapshotPeriodRatings records the ratings of the players when the first
+// round of a division that is rated as one rating period starts, so that
+// games they play outside of the event do not change the ratings the
+// period is rated from. The caller must hold the lock on the tournament.
+func snapshotPeriodRatings(ctx context.Context, us user.Store, t *entity.Tournament, division string) error {
+	divisionObject := t.Divisions[division]
+	dm := divisionObject.DivisionManager
+	if dm.GetCurrentRound() >= 0 || !dm.GetDivisionControls().GetRateAsPeriod() {
+		return nil
+	}
+	variantKey, err := periodVariantKey(t, division, dm.GetDivisionControls())
+	if err != nil {
+		return err
+	}
+	snapshot := make(map[string]entity.SingleRating)
+	for _, person := range dm.GetPlayers().Persons {
+		rating, err := currentRating(ctx, us, person.Id, variantKey)
+		if err != nil {
+			return err
+		}
+		snapshot[person.Id] = *rating
+	}
+	divisionObject.PeriodRatings = snapshot
+	return nil
+}
+
+func periodVariantKey(t *entity.Tournament, division string, controls *ipc.DivisionControls) (entity.VariantKey, error) {
+	if controls.GameRequest == nil {
+		return "", entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_GAME_CONTROLS_NOT_SET, t.Name, division)
+	}
+	timefmt, variant, err := entity.VariantFromGameReq(controls.GameRequest)
+	if err != nil {
+		return "", err
+	}
+	return entity.ToVariantKey(controls.GameRequest.Lexicon, variant, timefmt), nil
+}
+
+func currentRating(ctx context.Context, us user.Store, playerID string, variantKey entity.VariantKey) (*entity.SingleRating, error) {
+	u, err := us.GetByUUID(ctx, strings.Split(playerID, ":")[0])
+	if err != nil {
+		return nil, err
+	}
+	return u.GetRating(variantKey)
+}
+
+// rateDivisionPeriod rates the games played so far in the division. It
+// returns the projected ratings, and the new ratings by user UUID.
+func rateDivisionPeriod(ctx context.Context, us user.Store, t *entity.Tournament, division string,
+	now int64) (*pb.RatingPeriodResponse, map[string]entity.SingleRating, error) {
+
+	divisionObject, ok := t.Divisions[division]
+	if !ok {
+		return nil, nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NONEXISTENT_DIVISION, t.Name, division)
+	}
+	if divisionObject.DivisionManager == nil {
+		return nil, nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NIL_DIVISION_MANAGER, t.Name, division)
+	}
+	controls := divisionObject.DivisionManager.GetDivisionControls()
+	if !controls.GetRateAsPeriod() {
+		return nil, nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NOT_RATED_AS_PERIOD, t.Name, division)
+	}
+	variantKey, err := periodVariantKey(t, division, controls)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp := &pb.RatingPeriodResponse{
+		Division: division,
+		Variant:  string(variantKey),
+		Applied:  divisionObject.RatingPeriodApplied,
+	}
+	if controls.GameRequest.RatingMode != ipc.RatingMode_RATED {
+		return resp, nil, nil
+	}
+
+	divisionData, err := divisionObject.DivisionManager.GetXHRResponse()
+	if err != nil {
+		return nil, nil, err
+	}
+	// The period is rated from the ratings the players had when the first
+	// round started. Players who joined later, and divisions that have not
+	// started yet, use the current ratings.
+	ratings := make(map[string]*entity.SingleRating)
+	for _, person := range divisionData.Players.Persons {
+		if rating, ok := divisionObject.PeriodRatings[person.Id]; ok {
+			ratings[person.Id] = &rating
+			continue
+		}
+		rating, err := currentRating(ctx, us, person.Id, variantKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		ratings[person.Id] = rating
+	}
+
+	resp.Ratings = ratePeriodGames(divisionData, ratings, now)
+	newRatings := make(map[string]entity.SingleRating)
+	for _, projected := range resp.Ratings {
+		if projected.Games == 0 {
+			continue
+		}
+		newRatings[strings.Split(projected.UserId, ":")[0]] = entity.NewSingleRating(
+			projected.NewRating, projected.NewRatingDeviation, projected.NewVolatility, now)
+	}
+	return resp, newRatings, nil
+}
+
+// ratePeriodGames rates every game of the division that was played on the
+// site against the ratings the players had before the rating period.
+// Results that were entered without a game, byes and forfeits are not rated.
+func ratePeriodGames(divisionData *ipc.TournamentDivisionDataResponse, ratings map[string]*entity.SingleRating,
+	now int64) []*pb.ProjectedRating {
+
+	params := glicko.DefaultParameters
+	persons := divisionData.Players.Persons
+	results := make([][]glicko.Result, len(persons))
+
+	// Go through the pairings in order so that the ratings are always
+	// summed up the same way.
+	pairingKeys := make([]string, 0, len(divisionData.PairingMap))
+	for key := range divisionData.PairingMap {
+		pairingKeys = append(pairingKeys, key)
+	}
+	sort.Strings(pairingKeys)
+
+	for _, key := range pairingKeys {
+		pairing := divisionData.PairingMap[key]
+		if len(pairing.Players) != 2 || pairing.Players[0] == pairing.Players[1] {
+			continue
+		}
+		p0, p1 := pairing.Players[0], pairing.Players[1]
+		for _, game := range pairing.Games {
+			if game.Id == "" || !isRatedResult(game.Results[0]) {
+				continue
+			}
+			winnerIdx := -1
+			if game.Results[0] == ipc.TournamentGameResult_WIN {
+				winnerIdx = 0
+			} else if game.Results[0] == ipc.TournamentGameResult_LOSS {
+				winnerIdx = 1
+			}
+			spread, err := entity.RatingSpread(params, [2]int32{game.Scores[0], game.Scores[1]},
+				winnerIdx, game.GameEndReason)
+			if err != nil {
+				log.Debug().Err(err).Str("gid", game.Id).Msg("rating-period-skipping-game")
+				continue
+			}
+			r0, r1 := ratings[persons[p0].Id], ratings[persons[p1].Id]
+			results[p0] = append(results[p0], glicko.Result{
+				OpponentRating:          r1.Rating,
+				OpponentRatingDeviation: r1.RatingDeviation,
+				Spread:                  spread,
+			})
+			results[p1] = append(results[p1], glicko.Result{
+				OpponentRating:          r0.Rating,
+				OpponentRatingDeviation: r0.RatingDeviation,
+				Spread:                  -spread,
+			})
+		}
+	}
+
+	projected := make([]*pb.ProjectedRating, len(persons))
+	for idx, person := range persons {
+		rating := ratings[person.Id]
+		lastGameTimestamp := rating.LastGameTimestamp
+		if lastGameTimestamp == 0 {
+			lastGameTimestamp = now
+		}
+		newRating, newRatingDeviation, newVolatility := rating.Rating, rating.RatingDeviation, rating.Volatility
+		if len(results[idx]) > 0 {
+			newRating, newRatingDeviation, newVolatility = params.RatePeriod(
+				rating.Rating, rating.RatingDeviation, rating.Volatility,
+				results[idx], int(now-lastGameTimestamp))
+		}
+		projected[idx] = &pb.ProjectedRating{
+			UserId:             person.Id,
+			Rating:             rating.Rating,
+			RatingDeviation:    rating.RatingDeviation,
+			NewRating:          newRating,
+			NewRatingDeviation: newRatingDeviation,
+			NewVolatility:      newVolatility,
+			Games:              int32(len(results[idx])),
+		}
+	}
+	return projected
+}
+
+func isRatedResult(result ipc.TournamentGameResult) bool {
+	return result == ipc.TournamentGameResult_WIN ||
+		result == ipc.TournamentGameResult_LOSS ||
+		result == ipc.TournamentGameResult_DRAW
+}
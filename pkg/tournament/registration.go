@@ -0,0 +1,453 @@
+package tournament
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/user"
+
+	ipc "github.com/domino14/liwords/rpc/api/proto/ipc"
+	pb "github.com/domino14/liwords/rpc/api/proto/tournament_service"
+)
+
+// Register lets a player register themselves for a division. Depending
+// on the division controls, the player is added to the division right
+// away, put on the waitlist, or has to wait for a director's approval.
+func Register(ctx context.Context, ts TournamentStore, us user.Store, id string, division string,
+	userID string, now time.Time) (pb.RegistrationStatus, error) {
+
+	t, err := ts.Get(ctx, id)
+	if err != nil {
+		return pb.RegistrationStatus_REGISTRATION_UNSPECIFIED, err
+	}
+	roster, err := membersOnlyRoster(ctx, ts, t)
+	if err != nil {
+		return pb.RegistrationStatus_REGISTRATION_UNSPECIFIED, err
+	}
+
+	defer lockTournament(ctx, t)()
+
+	divisionObject, err := registrationDivision(t, division)
+	if err != nil {
+		return pb.RegistrationStatus_REGISTRATION_UNSPECIFIED, err
+	}
+
+	controls := divisionObject.DivisionManager.GetDivisionControls()
+	if t.IsStarted || !controls.OpenRegistration {
+		return pb.RegistrationStatus_REGISTRATION_UNSPECIFIED, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_REGISTRATION_CLOSED, t.Name, division)
+	}
+	if controls.RegistrationDeadline != nil && now.After(controls.RegistrationDeadline.AsTime()) {
+		return pb.RegistrationStatus_REGISTRATION_UNSPECIFIED, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_REGISTRATION_DEADLINE_PASSED, t.Name, division)
+	}
+	if controls.GameRequest == nil {
+		return pb.RegistrationStatus_REGISTRATION_UNSPECIFIED, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_GAME_CONTROLS_NOT_SET, t.Name, division)
+	}
+
+	u, err := us.GetByUUID(ctx, userID)
+	if err != nil {
+		return pb.RegistrationStatus_REGISTRATION_UNSPECIFIED, err
+	}
+	if isRegistered(t, u.TournamentID()) {
+		return pb.RegistrationStatus_REGISTRATION_UNSPECIFIED, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_ALREADY_REGISTERED, t.Name, division, u.Username)
+	}
+	err = checkClubMembership(t, division, roster, u.TournamentID())
+	if err != nil {
+		return pb.RegistrationStatus_REGISTRATION_UNSPECIFIED, err
+	}
+
+	rating, err := registrationRating(u, controls.GameRequest)
+	if err != nil {
+		return pb.RegistrationStatus_REGISTRATION_UNSPECIFIED, err
+	}
+	if (controls.RatingFloor > 0 && rating < controls.RatingFloor) ||
+		(controls.RatingCeiling > 0 && rating > controls.RatingCeiling) {
+		return pb.RegistrationStatus_REGISTRATION_UNSPECIFIED, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_RATING_OUT_OF_RANGE, t.Name, division, strconv.Itoa(int(rating)))
+	}
+
+	person := &ipc.TournamentPerson{Id: u.TournamentID(), Rating: rating}
+
+	if controls.RegistrationRequiresApproval {
+		registration := divisionRegistration(divisionObject)
+		registration.Pending = append(registration.Pending, person)
+		return pb.RegistrationStatus_PENDING_APPROVAL, ts.Set(ctx, t)
+	}
+
+	pairingsResp, added, err := admitPlayers(ctx, ts, t, division, []*ipc.TournamentPerson{person}, roster)
+	if err != nil {
+		return pb.RegistrationStatus_REGISTRATION_UNSPECIFIED, err
+	}
+	err = ts.Set(ctx, t)
+	if err != nil {
+		return pb.RegistrationStatus_REGISTRATION_UNSPECIFIED, err
+	}
+	if added == 0 {
+		return pb.RegistrationStatus_WAITLISTED, nil
+	}
+	return pb.RegistrationStatus_REGISTERED, sendPlayersChange(ctx, ts, t, division, pairingsResp)
+}
+
+// Unregister withdraws a player's registration. Players can only
+// withdraw from a division before the tournament starts. If the player
+// was in the division, the first player on the waitlist takes their spot.
+func Unregister(ctx context.Context, ts TournamentStore, us user.Store, id string, division string, userID string) error {
+	t, err := ts.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	roster, err := membersOnlyRoster(ctx, ts, t)
+	if err != nil {
+		return err
+	}
+
+	defer lockTournament(ctx, t)()
+
+	divisionObject, err := registrationDivision(t, division)
+	if err != nil {
+		return err
+	}
+
+	u, err := us.GetByUUID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	fullID := u.TournamentID()
+
+	registration := divisionRegistration(divisionObject)
+	var removed bool
+	registration.Pending, removed = removeRegistration(registration.Pending, fullID)
+	if !removed {
+		registration.Waitlist, removed = removeRegistration(registration.Waitlist, fullID)
+	}
+	if removed {
+		return ts.Set(ctx, t)
+	}
+
+	if !isPlayerInDivision(divisionObject, fullID) {
+		return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NOT_REGISTERED, t.Name, division, u.Username)
+	}
+	if t.IsStarted {
+		return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_REGISTRATION_CLOSED, t.Name, division)
+	}
+
+	pairingsResp, err := divisionObject.DivisionManager.RemovePlayers(
+		&ipc.TournamentPersons{Persons: []*ipc.TournamentPerson{{Id: fullID}}})
+	if err != nil {
+		return err
+	}
+
+	err = ts.RemoveRegistrants(ctx, t.UUID, []string{u.UUID}, division)
+	if err != nil {
+		return err
+	}
+
+	promotedResp, err := promoteWaitlist(ctx, ts, t, division, roster)
+	if err != nil {
+		return err
+	}
+
+	err = ts.Set(ctx, t)
+	if err != nil {
+		return err
+	}
+	return sendPlayersChange(ctx, ts, t, division, combinePairingMessages(pairingsResp, promotedResp))
+}
+
+// ApproveRegistrations admits the pending registrations of the given
+// players. If the division is full they are put on the waitlist instead.
+func ApproveRegistrations(ctx context.Context, ts TournamentStore, us user.Store, id string, division string, players *ipc.TournamentPersons) error {
+	t, err := ts.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	roster, err := membersOnlyRoster(ctx, ts, t)
+	if err != nil {
+		return err
+	}
+
+	defer lockTournament(ctx, t)()
+
+	divisionObject, err := registrationDivision(t, division)
+	if err != nil {
+		return err
+	}
+
+	approved, err := takePendingRegistrations(ctx, t, us, divisionObject, division, players)
+	if err != nil {
+		return err
+	}
+
+	pairingsResp, _, err := admitPlayers(ctx, ts, t, division, approved, roster)
+	if err != nil {
+		return err
+	}
+
+	err = ts.Set(ctx, t)
+	if err != nil {
+		return err
+	}
+	return sendPlayersChange(ctx, ts, t, division, pairingsResp)
+}
+
+// RejectRegistrations removes the pending registrations of the given players.
+func RejectRegistrations(ctx context.Context, ts TournamentStore, us user.Store, id string, division string, players *ipc.TournamentPersons) error {
+	t, err := ts.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	defer lockTournament(ctx, t)()
+
+	divisionObject, err := registrationDivision(t, division)
+	if err != nil {
+		return err
+	}
+
+	_, err = takePendingRegistrations(ctx, t, us, divisionObject, division, players)
+	if err != nil {
+		return err
+	}
+	return ts.Set(ctx, t)
+}
+
+func GetRegistrations(ctx context.Context, ts TournamentStore, id string, division string) (*pb.DivisionRegistrationsResponse, error) {
+	t, err := ts.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	t.RLock()
+	defer t.RUnlock()
+
+	divisionObject, ok := t.Divisions[division]
+	if !ok {
+		return nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NONEXISTENT_DIVISION, t.Name, division)
+	}
+
+	response := &pb.DivisionRegistrationsResponse{Id: id,
+		Division: division,
+		Pending:  []*ipc.TournamentPerson{},
+		Waitlist: []*ipc.TournamentPerson{}}
+	if divisionObject.Registration != nil {
+		response.Pending = divisionObject.Registration.Pending
+		response.Waitlist = divisionObject.Registration.Waitlist
+	}
+	return response, nil
+}
+
+func registrationDivision(t *entity.Tournament, division string) (*entity.TournamentDivision, error) {
+	if t.IsFinished {
+		return nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_FINISHED, t.Name, division)
+	}
+
+	divisionObject, ok := t.Divisions[division]
+	if !ok {
+		return nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NONEXISTENT_DIVISION, t.Name, division)
+	}
+
+	if divisionObject.DivisionManager == nil {
+		return nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NIL_DIVISION_MANAGER, t.Name, division)
+	}
+	return divisionObject, nil
+}
+
+func divisionRegistration(divisionObject *entity.TournamentDivision) *entity.DivisionRegistration {
+	if divisionObject.Registration == nil {
+		divisionObject.Registration = &entity.DivisionRegistration{
+			Pending:  []*ipc.TournamentPerson{},
+			Waitlist: []*ipc.TournamentPerson{},
+		}
+	}
+	return divisionObject.Registration
+}
+
+// admitPlayers adds as many of the players to the division as it has
+// room for. The rest are put on the end of the waitlist. It returns the
+// number of players that were added. If the tournament is a session of a
+// club for members only, every player must be a member.
+func admitPlayers(ctx context.Context, ts TournamentStore, t *entity.Tournament, division string,
+	players []*ipc.TournamentPerson, roster *clubRoster) (*ipc.DivisionPairingsResponse, int, error) {
+
+	for _, player := range players {
+		err := checkClubMembership(t, division, roster, player.Id)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	divisionObject := t.Divisions[division]
+	dm := divisionObject.DivisionManager
+	maxPlayers := int(dm.GetDivisionControls().MaxPlayers)
+
+	toAdd := players
+	if maxPlayers > 0 {
+		// Players who withdrew or were suspended do not take up a spot.
+		spots := maxPlayers
+		for _, person := range dm.GetPlayers().Persons {
+			if !person.Suspended {
+				spots--
+			}
+		}
+		if spots < 0 {
+			spots = 0
+		}
+		if spots < len(toAdd) {
+			toAdd = players[:spots]
+		}
+	}
+	registration := divisionRegistration(divisionObject)
+
+	if len(toAdd) == 0 {
+		registration.Waitlist = append(registration.Waitlist, players...)
+		return newPairingsMessage(), 0, nil
+	}
+
+	pairingsResp, err := dm.AddPlayers(&ipc.TournamentPersons{Persons: toAdd})
+	if err != nil {
+		return nil, 0, err
+	}
+	registration.Waitlist = append(registration.Waitlist, players[len(toAdd):]...)
+
+	userUUIDs := []string{}
+	for _, player := range toAdd {
+		userUUIDs = append(userUUIDs, strings.Split(player.Id, ":")[0])
+	}
+	err = ts.AddRegistrants(ctx, t.UUID, userUUIDs, division)
+	if err != nil {
+		return nil, 0, err
+	}
+	return pairingsResp, len(toAdd), nil
+}
+
+// promoteWaitlist fills the open spots of the division from the waitlist.
+// Players who are no longer members of the club, if its sessions are for
+// members only, are taken off the waitlist.
+func promoteWaitlist(ctx context.Context, ts TournamentStore, t *entity.Tournament, division string,
+	roster *clubRoster) (*ipc.DivisionPairingsResponse, error) {
+
+	registration := t.Divisions[division].Registration
+	if registration == nil || len(registration.Waitlist) == 0 {
+		return newPairingsMessage(), nil
+	}
+	waitlist := registration.Waitlist
+	eligible := []*ipc.TournamentPerson{}
+	for _, player := range waitlist {
+		if roster.isMember(player.Id) {
+			eligible = append(eligible, player)
+		}
+	}
+	registration.Waitlist = []*ipc.TournamentPerson{}
+	pairingsResp, _, err := admitPlayers(ctx, ts, t, division, eligible, roster)
+	if err != nil {
+		registration.Waitlist = waitlist
+		return nil, err
+	}
+	return pairingsResp, nil
+}
+
+// takePendingRegistrations removes the pending registrations of the players,
+// given by username, and returns them. Either all of them are removed or none are.
+func takePendingRegistrations(ctx context.Context, t *entity.Tournament, us user.Store, divisionObject *entity.TournamentDivision,
+	division string, players *ipc.TournamentPersons) ([]*ipc.TournamentPerson, error) {
+
+	registration := divisionRegistration(divisionObject)
+	fullIDs := []string{}
+	for _, player := range players.Persons {
+		fullID, _, err := constructFullID(t.Name, division, ctx, us, player.Id)
+		if err != nil {
+			return nil, err
+		}
+		found := false
+		for _, pending := range registration.Pending {
+			if pending.Id == fullID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NOT_REGISTERED, t.Name, division, player.Id)
+		}
+		fullIDs = append(fullIDs, fullID)
+	}
+
+	taken := []*ipc.TournamentPerson{}
+	for _, fullID := range fullIDs {
+		for _, pending := range registration.Pending {
+			if pending.Id == fullID {
+				taken = append(taken, pending)
+				break
+			}
+		}
+		registration.Pending, _ = removeRegistration(registration.Pending, fullID)
+	}
+	return taken, nil
+}
+
+func removeRegistration(persons []*ipc.TournamentPerson, fullID string) ([]*ipc.TournamentPerson, bool) {
+	for idx, person := range persons {
+		if person.Id == fullID {
+			return append(persons[:idx], persons[idx+1:]...), true
+		}
+	}
+	return persons, false
+}
+
+func isPlayerInDivision(divisionObject *entity.TournamentDivision, fullID string) bool {
+	if divisionObject.DivisionManager == nil {
+		return false
+	}
+	for _, player := range divisionObject.DivisionManager.GetPlayers().Persons {
+		if player.Id == fullID {
+			return true
+		}
+	}
+	return false
+}
+
+// isRegistered returns true if the player is in, waiting for, or
+// waitlisted for any division of the tournament.
+func isRegistered(t *entity.Tournament, fullID string) bool {
+	for _, divisionObject := range t.Divisions {
+		if isPlayerInDivision(divisionObject, fullID) {
+			return true
+		}
+		if divisionObject.Registration == nil {
+			continue
+		}
+		for _, persons := range [][]*ipc.TournamentPerson{divisionObject.Registration.Pending,
+			divisionObject.Registration.Waitlist} {
+			for _, person := range persons {
+				if person.Id == fullID {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func registrationRating(u *entity.User, gameRequest *ipc.GameRequest) (int32, error) {
+	timefmt, variant, err := entity.VariantFromGameReq(gameRequest)
+	if err != nil {
+		return 0, err
+	}
+	rating, err := u.GetRating(entity.ToVariantKey(gameRequest.Lexicon, variant, timefmt))
+	if err != nil {
+		return 0, err
+	}
+	return int32(math.Round(rating.Rating)), nil
+}
+
+func sendPlayersChange(ctx context.Context, ts TournamentStore, t *entity.Tournament, division string,
+	pairingsResp *ipc.DivisionPairingsResponse) error {
+
+	playersMessage := &ipc.PlayersAddedOrRemovedResponse{Id: t.UUID,
+		Division:          division,
+		Players:           t.Divisions[division].DivisionManager.GetPlayers(),
+		DivisionPairings:  pairingsResp.DivisionPairings,
+		DivisionStandings: pairingsResp.DivisionStandings}
+	wrapped := entity.WrapEvent(playersMessage, ipc.MessageType_TOURNAMENT_DIVISION_PLAYER_CHANGE_MESSAGE)
+	return SendTournamentMessage(ctx, ts, t.UUID, wrapped)
+}
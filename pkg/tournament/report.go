@@ -0,0 +1,98 @@
+package tournament
+
+import (
+	"github.com/domino14/liwords/pkg/pair"
+	pb "github.com/domino14/liwords/rpc/api/proto/ipc"
+)
+
+// GetPairingReport shows how fair the pairings of the division were over
+// the rounds that have started: the repeats, firsts, byes and opponent
+// ratings of each player, and the repeats and mismatched games of each round.
+func (t *ClassicDivision) GetPairingReport() (*pb.PairingReport, error) {
+	report := &pb.PairingReport{}
+	players := make([]*pb.PlayerPairingReport, len(t.Players.Persons))
+	opponentRatings := make([][]float64, len(t.Players.Persons))
+	for i, person := range t.Players.Persons {
+		players[i] = &pb.PlayerPairingReport{PlayerId: person.Id}
+	}
+
+	meetings := make(map[string]int)
+	for round := 0; round <= int(t.CurrentRound) && round < len(t.Matrix); round++ {
+		pairingMethod := t.RoundControls[round].PairingMethod
+		roundReport := &pb.RoundPairingReport{Round: int32(round), PairingMethod: pairingMethod}
+
+		// The win totals that a swiss round was paired from
+		var scores map[string]int32
+		if pairingMethod == pb.PairingMethod_SWISS && t.standingsRoundFor(round) >= 0 {
+			records, err := getRecords(t, t.standingsRoundFor(round))
+			if err != nil {
+				return nil, err
+			}
+			scores = make(map[string]int32)
+			for _, record := range records {
+				scores[record.PlayerId] = record.Wins*2 + record.Draws
+			}
+		}
+
+		reported := make(map[string]bool)
+		for _, pairingKey := range t.Matrix[round] {
+			pairing, ok := t.PairingMap[pairingKey]
+			if !ok || pairing.Players == nil || reported[pairingKey] {
+				continue
+			}
+			reported[pairingKey] = true
+			playerOne, playerTwo := pairing.Players[0], pairing.Players[1]
+			if playerOne == playerTwo {
+				if pairing.Outcomes[0] == pb.TournamentGameResult_BYE {
+					players[playerOne].Byes++
+				}
+				continue
+			}
+
+			roundReport.Games++
+			playerOneID := t.Players.Persons[playerOne].Id
+			playerTwoID := t.Players.Persons[playerTwo].Id
+			key := pair.GetRepeatKey(playerOneID, playerTwoID)
+			if meetings[key] > 0 {
+				roundReport.Repeats++
+				players[playerOne].Repeats++
+				players[playerTwo].Repeats++
+			}
+			meetings[key]++
+			if scores != nil && scores[playerOneID] != scores[playerTwoID] {
+				roundReport.MismatchedGames++
+			}
+
+			players[playerOne].Games++
+			players[playerTwo].Games++
+			opponentRatings[playerOne] = append(opponentRatings[playerOne], float64(t.Players.Persons[playerTwo].Rating))
+			opponentRatings[playerTwo] = append(opponentRatings[playerTwo], float64(t.Players.Persons[playerOne].Rating))
+		}
+		report.Rounds = append(report.Rounds, roundReport)
+	}
+
+	for i, player := range players {
+		fs := getPlayerFirstsAndSeconds(t, int32(i), int(t.CurrentRound))
+		player.Firsts = int32(fs[0])
+		player.Seconds = int32(fs[1])
+		player.AverageOpponentRating, player.OpponentRatingVariance = meanAndVariance(opponentRatings[i])
+	}
+	report.Players = players
+	return report, nil
+}
+
+func meanAndVariance(values []float64) (float64, float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+	sum := 0.0
+	for _, value := range values {
+		sum += value
+	}
+	mean := sum / float64(len(values))
+	variance := 0.0
+	for _, value := range values {
+		variance += (value - mean) * (value - mean)
+	}
+	return mean, variance / float64(len(values))
+}
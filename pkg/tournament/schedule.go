@@ -0,0 +1,311 @@
+package tournament
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/user"
+	ipc "github.com/domino14/liwords/rpc/api/proto/ipc"
+	pb "github.com/domino14/liwords/rpc/api/proto/tournament_service"
+)
+
+// RunScheduledRounds starts every round whose scheduled start time has
+// passed and forfeits the players who did not show up for their games
+// once the grace period of the current round is over. It also deals
+// with the players who missed the check-in deadline of a division, and
+// creates the sessions of clubs that are scheduled to start soon, and
+// saves the ratings of finished divisions that could not be saved before.
+func RunScheduledRounds(ctx context.Context, ts TournamentStore, us user.Store, now time.Time) error {
+	ids, err := ts.ListScheduledIDs(ctx, now)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		err := runTournamentSchedule(ctx, ts, us, id, now)
+		if err != nil {
+			// One badly configured tournament should not hold up the others.
+			log.Err(err).Str("tid", id).Msg("run-tournament-schedule-error")
+		}
+	}
+	return nil
+}
+
+func runTournamentSchedule(ctx context.Context, ts TournamentStore, us user.Store, id string, now time.Time) error {
+	t, err := ts.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	unlock := lockTournament(ctx, t)
+	next := NextScheduledAt(t, now)
+	if next == nil || next.After(now) {
+		// Nothing is due yet, so the time that was stored with
+		// the tournament is out of date.
+		err = ts.Set(ctx, t)
+		unlock()
+		return err
+	}
+	if t.Type == entity.TypeClub {
+		unlock()
+		return runClubSchedule(ctx, ts, t, now)
+	}
+	defer unlock()
+
+	if t.Type == entity.TypeLadder {
+		return expireLadderChallenges(ctx, ts, t, now)
+	}
+
+	applied, err := applyPendingRatingPeriods(ctx, us, t)
+	if applied {
+		if setErr := ts.Set(ctx, t); setErr != nil {
+			return setErr
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if startTournamentChecks(t) != nil {
+		return nil
+	}
+
+	pairingsMessages := make(map[string]*ipc.DivisionPairingsResponse)
+	startedRounds := make(map[string]int)
+	missedCheckIns := make(map[string][]*ipc.TournamentPerson)
+
+	for division, divisionObject := range t.Divisions {
+		dm := divisionObject.DivisionManager
+		if dm == nil {
+			continue
+		}
+
+		missed, checkInResp, err := dm.EnforceCheckIn(now)
+		if err != nil {
+			return err
+		}
+		if len(missed.Persons) > 0 {
+			// Players who were only suspended are still registered.
+			userUUIDs := []string{}
+			for _, player := range missed.Persons {
+				if !isPlayerInDivision(divisionObject, player.Id) {
+					userUUIDs = append(userUUIDs, strings.Split(player.Id, ":")[0])
+				}
+			}
+			if len(userUUIDs) > 0 {
+				err = ts.RemoveRegistrants(ctx, t.UUID, userUUIDs, division)
+				if err != nil {
+					return err
+				}
+			}
+			missedCheckIns[division] = missed.Persons
+			pairingsMessages[division] = checkInResp
+		}
+
+		pairingsResp, err := dm.ForfeitNoShows(now)
+		if err != nil {
+			return err
+		}
+		if len(pairingsResp.DivisionPairings) > 0 {
+			if previous, ok := pairingsMessages[division]; ok {
+				pairingsResp = combinePairingMessages(previous, pairingsResp)
+			}
+			pairingsMessages[division] = pairingsResp
+			err = possiblyEndTournament(ctx, ts, t, division)
+			if err != nil {
+				return err
+			}
+		}
+
+		nextRound := dm.GetCurrentRound() + 1
+		roundControls := dm.GetRoundControls()
+		if nextRound >= len(roundControls) {
+			continue
+		}
+		scheduledStart := roundControls[nextRound].ScheduledStartTime
+		if scheduledStart == nil || now.Before(scheduledStart.AsTime()) {
+			continue
+		}
+
+		if nextRound > 0 {
+			complete, err := dm.IsRoundComplete(nextRound - 1)
+			if err != nil {
+				return err
+			}
+			if !complete {
+				// The round starts as soon as the previous one is over.
+				continue
+			}
+		}
+
+		// The first round is paired as soon as the division is startable
+		if nextRound > 0 && dm.IsRoundReady(nextRound) != nil &&
+			roundControls[nextRound].PairingMethod != ipc.PairingMethod_MANUAL {
+			pairingsResp, err := dm.PairRound(nextRound, false)
+			if err != nil {
+				return err
+			}
+			if previous, ok := pairingsMessages[division]; ok {
+				pairingsResp = combinePairingMessages(previous, pairingsResp)
+			}
+			pairingsMessages[division] = pairingsResp
+		}
+
+		if startDivisionChecks(t, division, nextRound) != nil {
+			// The division needs the director's attention
+			// before the round can start.
+			continue
+		}
+		err = snapshotPeriodRatings(ctx, us, t, division)
+		if err != nil {
+			return err
+		}
+		err = dm.StartRound(false)
+		if err != nil {
+			return err
+		}
+		startedRounds[division] = nextRound
+	}
+
+	if len(pairingsMessages) == 0 && len(startedRounds) == 0 {
+		return nil
+	}
+	if len(startedRounds) > 0 {
+		t.IsStarted = true
+	}
+
+	err = ts.Set(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	for division, persons := range missedCheckIns {
+		err = sendPlayersChange(ctx, ts, t, division, pairingsMessages[division])
+		if err != nil {
+			return err
+		}
+		delete(pairingsMessages, division)
+		sendCheckInMissed(ts, t, division, persons)
+	}
+
+	for division, pairingsResp := range pairingsMessages {
+		pairingsResp.Id = id
+		pairingsResp.Division = division
+		wrapped := entity.WrapEvent(pairingsResp, ipc.MessageType_TOURNAMENT_DIVISION_PAIRINGS_MESSAGE)
+		err = SendTournamentMessage(ctx, ts, id, wrapped)
+		if err != nil {
+			return err
+		}
+	}
+
+	for division, round := range startedRounds {
+		err = sendDivisionStart(ts, t.UUID, division, round)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sendCheckInMissed lets the players who missed the check-in know
+// that they were suspended or removed from the division.
+func sendCheckInMissed(ts TournamentStore, t *entity.Tournament, division string, persons []*ipc.TournamentPerson) {
+	eventChannel := ts.TournamentEventChan()
+	if eventChannel == nil {
+		return
+	}
+	for _, player := range persons {
+		action := "removed from"
+		if isPlayerInDivision(t.Divisions[division], player.Id) {
+			action = "suspended in"
+		}
+		msg := fmt.Sprintf("You did not check in to %s in time and have been %s division %s.",
+			t.Name, action, division)
+		wrapped := entity.WrapEvent(&ipc.ServerMessage{Message: msg}, ipc.MessageType_SERVER_MESSAGE)
+		wrapped.AddAudience(entity.AudUser, strings.Split(player.Id, ":")[0])
+		eventChannel <- wrapped
+	}
+}
+
+// NextScheduledAt returns the earliest time at which the scheduler has
+// something to do for the tournament, or nil if there is nothing scheduled.
+// It is stored with the tournament so that the scheduler only loads the
+// tournaments that are due.
+func NextScheduledAt(t *entity.Tournament, now time.Time) *time.Time {
+	for _, divisionObject := range t.Divisions {
+		if ratingPeriodPending(divisionObject) {
+			// The ratings could not be saved when the division finished.
+			return &now
+		}
+	}
+	if t.IsFinished {
+		return nil
+	}
+	var next *time.Time
+	consider := func(at *time.Time) {
+		if at != nil && (next == nil || at.Before(*next)) {
+			next = at
+		}
+	}
+
+	if t.Ladder != nil {
+		for _, challenge := range t.Ladder.Challenges {
+			if ladderChallengeIsOpen(challenge) && challenge.Deadline != nil {
+				deadline := challenge.Deadline.AsTime()
+				consider(&deadline)
+			}
+		}
+	}
+
+	if t.Club != nil && t.Club.SessionSchedule != nil {
+		_, createAt, err := nextClubSession(t.Club.SessionSchedule, now)
+		if err == nil {
+			consider(&createAt)
+		}
+	}
+
+	for _, divisionObject := range t.Divisions {
+		if divisionObject.DivisionManager != nil {
+			consider(divisionObject.DivisionManager.NextScheduledTime())
+		}
+	}
+	return next
+}
+
+// Schedule returns the rounds of the tournament that have a scheduled
+// start time, in chronological order.
+func Schedule(t *entity.Tournament) []*pb.ScheduledRound {
+	schedule := []*pb.ScheduledRound{}
+	for division, divisionObject := range t.Divisions {
+		if divisionObject.DivisionManager == nil {
+			continue
+		}
+		for round, rc := range divisionObject.DivisionManager.GetRoundControls() {
+			if rc.ScheduledStartTime == nil {
+				continue
+			}
+			schedule = append(schedule, &pb.ScheduledRound{
+				Division:  division,
+				Round:     int32(round),
+				StartTime: rc.ScheduledStartTime,
+			})
+		}
+	}
+	sort.Slice(schedule, func(i, j int) bool {
+		ti := schedule[i].StartTime.AsTime()
+		tj := schedule[j].StartTime.AsTime()
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+		if schedule[i].Division != schedule[j].Division {
+			return schedule[i].Division < schedule[j].Division
+		}
+		return schedule[i].Round < schedule[j].Round
+	})
+	return schedule
+}
@@ -2,11 +2,10 @@ package tournament
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"strings"
+	"time"
 
-	"github.com/lithammer/shortuuid"
 	"github.com/rs/zerolog/log"
 	"github.com/twitchtv/twirp"
 	"google.golang.org/protobuf/proto"
@@ -43,7 +42,9 @@ func (ts *TournamentService) AddDivision(ctx context.Context, req *pb.Tournament
 	if err != nil {
 		return nil, err
 	}
-	err = AddDivision(ctx, ts.tournamentStore, req.Id, req.Division)
+	err = ts.audited(ctx, req.Id, req.Division, "AddDivision", req, func(ctx context.Context) error {
+		return AddDivision(ctx, ts.tournamentStore, req.Id, req.Division)
+	})
 	if err != nil {
 		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
 	}
@@ -55,7 +56,9 @@ func (ts *TournamentService) RemoveDivision(ctx context.Context, req *pb.Tournam
 	if err != nil {
 		return nil, err
 	}
-	err = RemoveDivision(ctx, ts.tournamentStore, req.Id, req.Division)
+	err = ts.audited(ctx, req.Id, req.Division, "RemoveDivision", req, func(ctx context.Context) error {
+		return RemoveDivision(ctx, ts.tournamentStore, req.Id, req.Division)
+	})
 	if err != nil {
 		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
 	}
@@ -71,7 +74,9 @@ func (ts *TournamentService) SetTournamentMetadata(ctx context.Context, req *pb.
 		return nil, err
 	}
 
-	err = SetTournamentMetadata(ctx, ts.tournamentStore, req.Metadata)
+	err = ts.audited(ctx, req.Metadata.Id, "", "SetTournamentMetadata", req, func(ctx context.Context) error {
+		return SetTournamentMetadata(ctx, ts.tournamentStore, req.Metadata)
+	})
 	if err != nil {
 		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
 	}
@@ -83,7 +88,9 @@ func (ts *TournamentService) SetSingleRoundControls(ctx context.Context, req *pb
 	if err != nil {
 		return nil, err
 	}
-	err = SetSingleRoundControls(ctx, ts.tournamentStore, req.Id, req.Division, int(req.Round), req.RoundControls)
+	err = ts.audited(ctx, req.Id, req.Division, "SetSingleRoundControls", req, func(ctx context.Context) error {
+		return SetSingleRoundControls(ctx, ts.tournamentStore, req.Id, req.Division, int(req.Round), req.RoundControls)
+	})
 	if err != nil {
 		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
 	}
@@ -95,7 +102,9 @@ func (ts *TournamentService) SetRoundControls(ctx context.Context, req *ipc.Divi
 	if err != nil {
 		return nil, err
 	}
-	err = SetRoundControls(ctx, ts.tournamentStore, req.Id, req.Division, req.RoundControls)
+	err = ts.audited(ctx, req.Id, req.Division, "SetRoundControls", req, func(ctx context.Context) error {
+		return SetRoundControls(ctx, ts.tournamentStore, req.Id, req.Division, req.RoundControls)
+	})
 	if err != nil {
 		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
 	}
@@ -108,7 +117,9 @@ func (ts *TournamentService) SetDivisionControls(ctx context.Context, req *ipc.D
 		return nil, err
 	}
 
-	err = SetDivisionControls(ctx, ts.tournamentStore, req.Id, req.Division, req)
+	err = ts.audited(ctx, req.Id, req.Division, "SetDivisionControls", req, func(ctx context.Context) error {
+		return SetDivisionControls(ctx, ts.tournamentStore, req.Id, req.Division, req)
+	})
 
 	if err != nil {
 		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
@@ -162,6 +173,30 @@ func (ts *TournamentService) GetTournament(ctx context.Context, req *pb.GetTourn
 	return response, nil
 }
 
+func (ts *TournamentService) GetBracket(ctx context.Context, req *pb.TournamentDivisionRequest) (*ipc.BracketResponse, error) {
+	response, err := GetBracket(ctx, ts.tournamentStore, req.Id, req.Division)
+	if err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+	return response, nil
+}
+
+func (ts *TournamentService) GetPairingReport(ctx context.Context, req *pb.TournamentDivisionRequest) (*ipc.PairingReport, error) {
+	response, err := GetPairingReport(ctx, ts.tournamentStore, req.Id, req.Division)
+	if err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+	return response, nil
+}
+
+func (ts *TournamentService) GetRatingPeriodPreview(ctx context.Context, req *pb.TournamentDivisionRequest) (*pb.RatingPeriodResponse, error) {
+	response, err := RatingPeriodPreview(ctx, ts.tournamentStore, ts.userStore, req.Id, req.Division)
+	if err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+	return response, nil
+}
+
 func (ts *TournamentService) GetTournamentMetadata(ctx context.Context, req *pb.GetTournamentMetadataRequest) (*pb.TournamentMetadataResponse, error) {
 	if req.Id != "" && req.Slug != "" {
 		return nil, twirp.NewError(twirp.InvalidArgument, "you must provide tournament ID or slug, but not both")
@@ -213,18 +248,9 @@ func (ts *TournamentService) GetTournamentMetadata(ctx context.Context, req *pb.
 		}
 	}
 
-	var tt pb.TType
-	switch t.Type {
-	case entity.TypeStandard:
-		tt = pb.TType_STANDARD
-	case entity.TypeChild:
-		tt = pb.TType_CHILD
-	case entity.TypeClub:
-		tt = pb.TType_CLUB
-	case entity.TypeLegacy:
-		tt = pb.TType_LEGACY
-	default:
-		return nil, fmt.Errorf("unrecognized tournament type: %v", t.Type)
+	tt, err := tournamentTType(t.Type)
+	if err != nil {
+		return nil, err
 	}
 	metadata := &pb.TournamentMetadata{
 		Id:                        t.UUID,
@@ -241,11 +267,15 @@ func (ts *TournamentService) GetTournamentMetadata(ctx context.Context, req *pb.
 		Logo:                      t.ExtraMeta.Logo,
 		Color:                     t.ExtraMeta.Color,
 		PrivateAnalysis:           t.ExtraMeta.PrivateAnalysis,
+		Prizes:                    t.ExtraMeta.Prizes,
+		PrizeTieRule:              t.ExtraMeta.PrizeTieRule,
 	}
 
 	return &pb.TournamentMetadataResponse{
-		Metadata:  metadata,
-		Directors: directors,
+		Metadata:    metadata,
+		Directors:   directors,
+		Schedule:    Schedule(t),
+		PrizeAwards: t.ExtraMeta.PrizeAwards,
 	}, nil
 
 }
@@ -256,7 +286,9 @@ func (ts *TournamentService) AddDirectors(ctx context.Context, req *ipc.Tourname
 		return nil, err
 	}
 
-	err = AddDirectors(ctx, ts.tournamentStore, ts.userStore, req.Id, req)
+	err = ts.audited(ctx, req.Id, "", "AddDirectors", req, func(ctx context.Context) error {
+		return AddDirectors(ctx, ts.tournamentStore, ts.userStore, req.Id, req)
+	})
 	if err != nil {
 		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
 	}
@@ -269,7 +301,9 @@ func (ts *TournamentService) RemoveDirectors(ctx context.Context, req *ipc.Tourn
 		return nil, err
 	}
 
-	err = RemoveDirectors(ctx, ts.tournamentStore, ts.userStore, req.Id, req)
+	err = ts.audited(ctx, req.Id, "", "RemoveDirectors", req, func(ctx context.Context) error {
+		return RemoveDirectors(ctx, ts.tournamentStore, ts.userStore, req.Id, req)
+	})
 	if err != nil {
 		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
 	}
@@ -281,7 +315,9 @@ func (ts *TournamentService) AddPlayers(ctx context.Context, req *ipc.Tournament
 		return nil, err
 	}
 
-	err = AddPlayers(ctx, ts.tournamentStore, ts.userStore, req.Id, req.Division, req)
+	err = ts.audited(ctx, req.Id, req.Division, "AddPlayers", req, func(ctx context.Context) error {
+		return AddPlayers(ctx, ts.tournamentStore, ts.userStore, req.Id, req.Division, req)
+	})
 	if err != nil {
 		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
 	}
@@ -293,7 +329,39 @@ func (ts *TournamentService) RemovePlayers(ctx context.Context, req *ipc.Tournam
 		return nil, err
 	}
 
-	err = RemovePlayers(ctx, ts.tournamentStore, ts.userStore, req.Id, req.Division, req)
+	err = ts.audited(ctx, req.Id, req.Division, "RemovePlayers", req, func(ctx context.Context) error {
+		return RemovePlayers(ctx, ts.tournamentStore, ts.userStore, req.Id, req.Division, req)
+	})
+	if err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+	return &pb.TournamentResponse{}, nil
+}
+
+func (ts *TournamentService) WithdrawPlayers(ctx context.Context, req *pb.WithdrawPlayersRequest) (*pb.TournamentResponse, error) {
+	err := authenticateDirector(ctx, ts, req.Id, false, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ts.audited(ctx, req.Id, req.Division, "WithdrawPlayers", req, func(ctx context.Context) error {
+		return WithdrawPlayers(ctx, ts.tournamentStore, ts.userStore, req.Id, req.Division, req.Players, req.Policy)
+	})
+	if err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+	return &pb.TournamentResponse{}, nil
+}
+
+func (ts *TournamentService) ReadmitPlayers(ctx context.Context, req *pb.ReadmitPlayersRequest) (*pb.TournamentResponse, error) {
+	err := authenticateDirector(ctx, ts, req.Id, false, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ts.audited(ctx, req.Id, req.Division, "ReadmitPlayers", req, func(ctx context.Context) error {
+		return ReadmitPlayers(ctx, ts.tournamentStore, ts.userStore, req.Id, req.Division, req.Players, int(req.CatchUpByes))
+	})
 	if err != nil {
 		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
 	}
@@ -306,7 +374,9 @@ func (ts *TournamentService) SetPairing(ctx context.Context, req *pb.TournamentP
 		return nil, err
 	}
 
-	err = SetPairings(ctx, ts.tournamentStore, req.Id, req.Division, req.Pairings)
+	err = ts.audited(ctx, req.Id, req.Division, "SetPairing", req, func(ctx context.Context) error {
+		return SetPairings(ctx, ts.tournamentStore, req.Id, req.Division, req.Pairings)
+	})
 	if err != nil {
 		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
 	}
@@ -319,22 +389,28 @@ func (ts *TournamentService) SetResult(ctx context.Context, req *pb.TournamentRe
 	if err != nil {
 		return nil, err
 	}
-	err = SetResult(ctx,
-		ts.tournamentStore,
-		ts.userStore,
-		req.Id,
-		req.Division,
-		req.PlayerOneId,
-		req.PlayerTwoId,
-		int(req.PlayerOneScore),
-		int(req.PlayerTwoScore),
-		req.PlayerOneResult,
-		req.PlayerTwoResult,
-		req.GameEndReason,
-		int(req.Round),
-		int(req.GameIndex),
-		req.Amendment,
-		nil)
+	if req.Amendment {
+		// Amending a result overwrites the one that was reported.
+		ctx = withSnapshot(ctx, "Before SetResult")
+	}
+	err = ts.audited(ctx, req.Id, req.Division, "SetResult", req, func(ctx context.Context) error {
+		return SetResult(ctx,
+			ts.tournamentStore,
+			ts.userStore,
+			req.Id,
+			req.Division,
+			req.PlayerOneId,
+			req.PlayerTwoId,
+			int(req.PlayerOneScore),
+			int(req.PlayerTwoScore),
+			req.PlayerOneResult,
+			req.PlayerTwoResult,
+			req.GameEndReason,
+			int(req.Round),
+			int(req.GameIndex),
+			req.Amendment,
+			nil)
+	})
 	if err != nil {
 		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
 	}
@@ -346,17 +422,31 @@ func (ts *TournamentService) PairRound(ctx context.Context, req *pb.PairRoundReq
 	if err != nil {
 		return nil, err
 	}
-	if req.DeletePairings {
-		err = DeletePairings(ctx, ts.tournamentStore, req.Id, req.Division, int(req.Round))
-	} else {
-		err = PairRound(ctx, ts.tournamentStore, req.Id, req.Division, int(req.Round), req.PreserveByes)
-	}
+	ctx = withSnapshot(ctx, "Before PairRound")
+	err = ts.audited(ctx, req.Id, req.Division, "PairRound", req, func(ctx context.Context) error {
+		if req.DeletePairings {
+			return DeletePairings(ctx, ts.tournamentStore, req.Id, req.Division, int(req.Round))
+		}
+		return PairRound(ctx, ts.tournamentStore, req.Id, req.Division, int(req.Round), req.PreserveByes)
+	})
 	if err != nil {
 		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
 	}
 	return &pb.TournamentResponse{}, nil
 }
 
+func (ts *TournamentService) PreviewPairRound(ctx context.Context, req *pb.PairingPreviewRequest) (*ipc.PairingPreviewResponse, error) {
+	err := authenticateDirector(ctx, ts, req.Id, false, req)
+	if err != nil {
+		return nil, err
+	}
+	response, err := PreviewPairRound(ctx, ts.tournamentStore, req.Id, req.Division, int(req.Round), req.PreserveByes, req.RoundControls)
+	if err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+	return response, nil
+}
+
 func (ts *TournamentService) RecentGames(ctx context.Context, req *pb.RecentGamesRequest) (*pb.RecentGamesResponse, error) {
 	response, err := ts.tournamentStore.GetRecentGames(ctx, req.Id, int(req.NumGames), int(req.Offset))
 	if err != nil {
@@ -375,7 +465,9 @@ func (ts *TournamentService) FinishTournament(ctx context.Context, req *pb.Finis
 	if err != nil {
 		return nil, err
 	}
-	err = SetFinished(ctx, ts.tournamentStore, req.Id)
+	err = ts.audited(ctx, req.Id, "", "FinishTournament", req, func(ctx context.Context) error {
+		return SetFinished(ctx, ts.tournamentStore, req.Id)
+	})
 	if err != nil {
 		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
 	}
@@ -388,11 +480,16 @@ func (ts *TournamentService) StartRoundCountdown(ctx context.Context, req *pb.To
 		return nil, err
 	}
 
+	division := req.Division
 	if req.StartAllRounds {
-		err = StartAllRoundCountdowns(ctx, ts.tournamentStore, req.Id, int(req.Round))
-	} else {
-		err = StartRoundCountdown(ctx, ts.tournamentStore, req.Id, req.Division, int(req.Round))
+		division = ""
 	}
+	err = ts.audited(ctx, req.Id, division, "StartRoundCountdown", req, func(ctx context.Context) error {
+		if req.StartAllRounds {
+			return StartAllRoundCountdowns(ctx, ts.tournamentStore, ts.userStore, req.Id, int(req.Round))
+		}
+		return StartRoundCountdown(ctx, ts.tournamentStore, ts.userStore, req.Id, req.Division, int(req.Round))
+	})
 
 	if err != nil {
 		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
@@ -405,24 +502,7 @@ func (ts *TournamentService) CreateClubSession(ctx context.Context, req *pb.NewC
 	if err != nil {
 		return nil, err
 	}
-	// Fetch the club
-	club, err := ts.tournamentStore.Get(ctx, req.ClubId)
-	if err != nil {
-		return nil, err
-	}
-	if club.Type != entity.TypeClub {
-		return nil, errors.New("club sessions can only be created for clubs")
-	}
-	// /club/madison/
-	slugPrefix := club.Slug + "/"
-	slug := slugPrefix + req.Date.AsTime().Format("2006-01-02-") + shortuuid.New()[2:5]
-
-	sessionDate := req.Date.AsTime().Format("Mon Jan 2, 2006")
-
-	name := club.Name + " - " + sessionDate
-	// Create a tournament / club session.
-	t, err := NewTournament(ctx, ts.tournamentStore, name, club.Description, club.Directors,
-		entity.TypeChild, club.UUID, slug)
+	t, err := CreateClubSession(ctx, ts.tournamentStore, req.ClubId, req.Date.AsTime())
 	if err != nil {
 		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
 	}
@@ -437,6 +517,45 @@ func (ts *TournamentService) GetRecentClubSessions(ctx context.Context, req *pb.
 	return ts.tournamentStore.GetRecentClubSessions(ctx, req.Id, int(req.Count), int(req.Offset))
 }
 
+func (ts *TournamentService) PromoteAndRelegate(ctx context.Context, req *pb.PromotionRequest) (*pb.PromotionResponse, error) {
+	err := authenticateDirector(ctx, ts, req.Id, false, req)
+	if err != nil {
+		return nil, err
+	}
+	err = authenticateDirector(ctx, ts, req.NextSessionId, false, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var divisions []*ipc.TournamentPersons
+	err = ts.audited(ctx, req.NextSessionId, "", "PromoteAndRelegate", req, func(ctx context.Context) error {
+		var err error
+		divisions, err = PromoteAndRelegate(ctx, ts.tournamentStore, ts.userStore, req.Id, req.NextSessionId,
+			req.Divisions, int(req.Promoted), int(req.Relegated))
+		return err
+	})
+	if err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+	return &pb.PromotionResponse{Divisions: divisions}, nil
+}
+
+func tournamentTType(ct entity.CompetitionType) (pb.TType, error) {
+	switch ct {
+	case entity.TypeStandard:
+		return pb.TType_STANDARD, nil
+	case entity.TypeChild:
+		return pb.TType_CHILD, nil
+	case entity.TypeClub:
+		return pb.TType_CLUB, nil
+	case entity.TypeLegacy:
+		return pb.TType_LEGACY, nil
+	case entity.TypeLadder:
+		return pb.TType_LADDER, nil
+	}
+	return pb.TType_STANDARD, fmt.Errorf("unrecognized tournament type: %v", ct)
+}
+
 func sessionUser(ctx context.Context, ts *TournamentService) (*entity.User, error) {
 	sess, err := apiserver.GetSession(ctx)
 	if err != nil {
@@ -546,7 +665,7 @@ func (ts *TournamentService) CheckIn(ctx context.Context, req *pb.CheckinRequest
 		return nil, err
 	}
 
-	err = CheckIn(ctx, ts.tournamentStore, req.Id, user.TournamentID())
+	err = CheckIn(ctx, ts.tournamentStore, req.Id, user.TournamentID(), time.Now())
 	if err != nil {
 		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
 	}
@@ -558,13 +677,81 @@ func (ts *TournamentService) UncheckIn(ctx context.Context, req *pb.UncheckInReq
 	if err != nil {
 		return nil, err
 	}
-	err = UncheckIn(ctx, ts.tournamentStore, req.Id)
+	err = ts.audited(ctx, req.Id, "", "UncheckIn", req, func(ctx context.Context) error {
+		return UncheckIn(ctx, ts.tournamentStore, req.Id)
+	})
 	if err != nil {
 		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
 	}
 	return &pb.TournamentResponse{}, nil
 }
 
+func (ts *TournamentService) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterResponse, error) {
+	user, err := sessionUser(ctx, ts)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := Register(ctx, ts.tournamentStore, ts.userStore, req.Id, req.Division, user.UUID, time.Now())
+	if err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+	return &pb.RegisterResponse{Status: status}, nil
+}
+
+func (ts *TournamentService) Unregister(ctx context.Context, req *pb.RegisterRequest) (*pb.TournamentResponse, error) {
+	user, err := sessionUser(ctx, ts)
+	if err != nil {
+		return nil, err
+	}
+
+	err = Unregister(ctx, ts.tournamentStore, ts.userStore, req.Id, req.Division, user.UUID)
+	if err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+	return &pb.TournamentResponse{}, nil
+}
+
+func (ts *TournamentService) ApproveRegistrations(ctx context.Context, req *ipc.TournamentPersons) (*pb.TournamentResponse, error) {
+	err := authenticateDirector(ctx, ts, req.Id, false, req)
+	if err != nil {
+		return nil, err
+	}
+	err = ts.audited(ctx, req.Id, req.Division, "ApproveRegistrations", req, func(ctx context.Context) error {
+		return ApproveRegistrations(ctx, ts.tournamentStore, ts.userStore, req.Id, req.Division, req)
+	})
+	if err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+	return &pb.TournamentResponse{}, nil
+}
+
+func (ts *TournamentService) RejectRegistrations(ctx context.Context, req *ipc.TournamentPersons) (*pb.TournamentResponse, error) {
+	err := authenticateDirector(ctx, ts, req.Id, false, req)
+	if err != nil {
+		return nil, err
+	}
+	err = ts.audited(ctx, req.Id, req.Division, "RejectRegistrations", req, func(ctx context.Context) error {
+		return RejectRegistrations(ctx, ts.tournamentStore, ts.userStore, req.Id, req.Division, req)
+	})
+	if err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+	return &pb.TournamentResponse{}, nil
+}
+
+func (ts *TournamentService) GetRegistrations(ctx context.Context, req *pb.TournamentDivisionRequest) (*pb.DivisionRegistrationsResponse, error) {
+	err := authenticateDirector(ctx, ts, req.Id, false, req)
+	if err != nil {
+		return nil, err
+	}
+	response, err := GetRegistrations(ctx, ts.tournamentStore, req.Id, req.Division)
+	if err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+	return response, nil
+}
+
 func (ts *TournamentService) UnstartTournament(ctx context.Context, req *pb.UnstartTournamentRequest) (*pb.TournamentResponse, error) {
 	// Unstarting a tournament rolls the round back to zero, and deletes all game info,
 	// but does not delete the players or divisions.
@@ -574,26 +761,343 @@ func (ts *TournamentService) UnstartTournament(ctx context.Context, req *pb.Unst
 		return nil, err
 	}
 
+	err = ts.audited(ctx, req.Id, "", "UnstartTournament", req, func(ctx context.Context) error {
+		t, err := ts.tournamentStore.Get(ctx, req.Id)
+		if err != nil {
+			return err
+		}
+		defer lockTournament(ctx, t)()
+
+		for division := range t.Divisions {
+			dm := t.Divisions[division].DivisionManager
+			if dm == nil {
+				return fmt.Errorf("cannot reset division %s because it has a nil division manager", division)
+			}
+			err = dm.ResetToBeginning()
+			if err != nil {
+				return err
+			}
+		}
+		t.IsStarted = false
+
+		return ts.tournamentStore.Set(ctx, t)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TournamentResponse{}, nil
+}
+
+// GetAuditLog is only available to the executive director and admins.
+func (ts *TournamentService) GetAuditLog(ctx context.Context, req *pb.AuditLogRequest) (*pb.AuditLogResponse, error) {
+	user, err := sessionUser(ctx, ts)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsAdmin {
+		t, err := ts.tournamentStore.Get(ctx, req.Id)
+		if err != nil {
+			return nil, twirp.InternalErrorWith(err)
+		}
+		if user.TournamentID() != t.ExecutiveDirector {
+			return nil, twirp.NewError(twirp.Unauthenticated, "this user is not the authorized executive director for this event")
+		}
+	}
+	limit := int(req.Limit)
+	if limit <= 0 || limit > MaxAuditLogEntries {
+		limit = MaxAuditLogEntries
+	}
+	return ts.tournamentStore.GetAuditLog(ctx, req.Id, limit, int(req.Offset))
+}
+
+// audited runs a director's change to the tournament and records it
+// in the tournament's audit log.
+func (ts *TournamentService) audited(ctx context.Context, id string, division string, action string,
+	req proto.Message, mutate func(ctx context.Context) error) error {
+
+	user, err := sessionUser(ctx, ts)
+	if err != nil {
+		return err
+	}
+	return AuditedAction(ctx, ts.tournamentStore, user.TournamentID(), id, division, action, req, mutate)
+}
+
+// CloneTournament can be used by the directors of the tournament even
+// after it has finished, to set up the next event.
+func (ts *TournamentService) CloneTournament(ctx context.Context, req *pb.CloneTournamentRequest) (*pb.NewTournamentResponse, error) {
+	user, err := directorOrAdmin(ctx, ts)
+	if err != nil {
+		return nil, err
+	}
 	t, err := ts.tournamentStore.Get(ctx, req.Id)
+	if err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+	if !user.IsAdmin {
+		authorized := false
+		for _, director := range t.Directors.Persons {
+			if director.Id == user.TournamentID() {
+				authorized = true
+				break
+			}
+		}
+		if !authorized {
+			return nil, twirp.NewError(twirp.Unauthenticated, "this user is not an authorized director for this event")
+		}
+	}
+	tt, err := tournamentTType(t.Type)
+	if err != nil {
+		return nil, err
+	}
+	_, err = validateTournamentMeta(tt, req.Slug)
+	if err != nil {
+		return nil, err
+	}
+
+	clone, err := CloneTournament(ctx, ts.tournamentStore, ts.userStore, req.Id, req.Name, req.Slug, req.IncludePlayers)
+	if err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+	return &pb.NewTournamentResponse{
+		Id:   clone.UUID,
+		Slug: clone.Slug,
+	}, nil
+}
+
+func (ts *TournamentService) CreateSnapshot(ctx context.Context, req *pb.SnapshotRequest) (*pb.TournamentSnapshot, error) {
+	err := authenticateDirector(ctx, ts, req.Id, false, req)
+	if err != nil {
+		return nil, err
+	}
+	user, err := sessionUser(ctx, ts)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := TakeSnapshot(ctx, ts.tournamentStore, req.Id, user.TournamentID(), req.Label)
+	if err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+	return snapshot, nil
+}
+
+func (ts *TournamentService) GetSnapshots(ctx context.Context, req *pb.GetTournamentRequest) (*pb.SnapshotsResponse, error) {
+	err := authenticateDirector(ctx, ts, req.Id, false, req)
+	if err != nil {
+		return nil, err
+	}
+	return ts.tournamentStore.GetSnapshots(ctx, req.Id)
+}
+
+func (ts *TournamentService) RestoreSnapshot(ctx context.Context, req *pb.RestoreSnapshotRequest) (*pb.TournamentResponse, error) {
+	err := authenticateDirector(ctx, ts, req.Id, false, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ts.audited(ctx, req.Id, "", "RestoreSnapshot", req, func(ctx context.Context) error {
+		return RestoreSnapshot(ctx, ts.tournamentStore, req.Id, req.SnapshotId)
+	})
+	if err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+	return &pb.TournamentResponse{}, nil
+}
+
+func (ts *TournamentService) GetLadder(ctx context.Context, req *pb.GetLadderRequest) (*ipc.Ladder, error) {
+	ladder, err := GetLadder(ctx, ts.tournamentStore, req.Id)
+	if err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+	return ladder, nil
+}
+
+func (ts *TournamentService) SetLadderControls(ctx context.Context, req *pb.LadderControlsRequest) (*pb.TournamentResponse, error) {
+	err := authenticateDirector(ctx, ts, req.Id, false, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ts.audited(ctx, req.Id, "", "SetLadderControls", req, func(ctx context.Context) error {
+		return SetLadderControls(ctx, ts.tournamentStore, req.Id, int(req.MaxChallengeDistance), int(req.AcceptSeconds))
+	})
+	if err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+	return &pb.TournamentResponse{}, nil
+}
+
+func (ts *TournamentService) AddLadderPlayers(ctx context.Context, req *ipc.TournamentPersons) (*pb.TournamentResponse, error) {
+	err := authenticateDirector(ctx, ts, req.Id, false, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ts.audited(ctx, req.Id, "", "AddLadderPlayers", req, func(ctx context.Context) error {
+		return AddLadderPlayers(ctx, ts.tournamentStore, ts.userStore, req.Id, req)
+	})
+	if err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+	return &pb.TournamentResponse{}, nil
+}
+
+func (ts *TournamentService) RemoveLadderPlayers(ctx context.Context, req *ipc.TournamentPersons) (*pb.TournamentResponse, error) {
+	err := authenticateDirector(ctx, ts, req.Id, false, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ts.audited(ctx, req.Id, "", "RemoveLadderPlayers", req, func(ctx context.Context) error {
+		return RemoveLadderPlayers(ctx, ts.tournamentStore, ts.userStore, req.Id, req)
+	})
+	if err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+	return &pb.TournamentResponse{}, nil
+}
+
+func (ts *TournamentService) ChallengeLadderPlayer(ctx context.Context, req *pb.LadderChallengeRequest) (*pb.TournamentResponse, error) {
+	user, err := sessionUser(ctx, ts)
 	if err != nil {
 		return nil, err
 	}
 
-	for division := range t.Divisions {
-		dm := t.Divisions[division].DivisionManager
-		if dm == nil {
-			return nil, fmt.Errorf("cannot reset division %s because it has a nil division manager", division)
+	err = ChallengeLadderPlayer(ctx, ts.tournamentStore, ts.userStore, req.Id, user.TournamentID(), req.Opponent, time.Now())
+	if err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+	return &pb.TournamentResponse{}, nil
+}
+
+func (ts *TournamentService) GetClub(ctx context.Context, req *pb.GetClubRequest) (*ipc.Club, error) {
+	club, err := GetClub(ctx, ts.tournamentStore, req.Id)
+	if err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+	return club, nil
+}
+
+// authenticateClubOfficer allows the directors of the club and its officers.
+// Officers can only add and remove members who are not officers.
+func authenticateClubOfficer(ctx context.Context, ts *TournamentService, req *pb.ClubMembersRequest) error {
+	err := authenticateDirector(ctx, ts, req.Id, false, req)
+	if err == nil {
+		return nil
+	}
+	user, userErr := sessionUser(ctx, ts)
+	if userErr != nil {
+		return userErr
+	}
+	club, clubErr := GetClub(ctx, ts.tournamentStore, req.Id)
+	if clubErr != nil {
+		return err
+	}
+	officer := clubMember(club, user.TournamentID())
+	if officer == nil || officer.Role != ipc.ClubRole_CLUB_OFFICER {
+		return err
+	}
+	for _, member := range req.Members {
+		if member.Role == ipc.ClubRole_CLUB_OFFICER {
+			return twirp.NewError(twirp.Unauthenticated, "only directors can manage the officers of this club")
 		}
-		err = dm.ResetToBeginning()
-		if err != nil {
-			return nil, err
+		for _, existing := range club.Members {
+			if existing.Role == ipc.ClubRole_CLUB_OFFICER &&
+				strings.EqualFold(existing.Id[strings.Index(existing.Id, ":")+1:], member.Id) {
+				return twirp.NewError(twirp.Unauthenticated, "only directors can manage the officers of this club")
+			}
 		}
 	}
-	t.IsStarted = false
+	log.Info().Str("officer", user.TournamentID()).Interface("req", req).Msg("authenticated-club-officer-request")
+	return nil
+}
+
+func (ts *TournamentService) AddClubMembers(ctx context.Context, req *pb.ClubMembersRequest) (*pb.TournamentResponse, error) {
+	err := authenticateClubOfficer(ctx, ts, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ts.audited(ctx, req.Id, "", "AddClubMembers", req, func(ctx context.Context) error {
+		return AddClubMembers(ctx, ts.tournamentStore, ts.userStore, req.Id, req.Members, time.Now())
+	})
+	if err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+	return &pb.TournamentResponse{}, nil
+}
+
+func (ts *TournamentService) RemoveClubMembers(ctx context.Context, req *pb.ClubMembersRequest) (*pb.TournamentResponse, error) {
+	err := authenticateClubOfficer(ctx, ts, req)
+	if err != nil {
+		return nil, err
+	}
 
-	err = ts.tournamentStore.Set(ctx, t)
+	err = ts.audited(ctx, req.Id, "", "RemoveClubMembers", req, func(ctx context.Context) error {
+		return RemoveClubMembers(ctx, ts.tournamentStore, ts.userStore, req.Id, req.Members)
+	})
+	if err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+	return &pb.TournamentResponse{}, nil
+}
+
+func (ts *TournamentService) SetClubControls(ctx context.Context, req *pb.ClubControlsRequest) (*pb.TournamentResponse, error) {
+	err := authenticateDirector(ctx, ts, req.Id, false, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ts.audited(ctx, req.Id, "", "SetClubControls", req, func(ctx context.Context) error {
+		return SetClubControls(ctx, ts.tournamentStore, req.Id, req.MembersOnly)
+	})
+	if err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+	return &pb.TournamentResponse{}, nil
+}
+
+func (ts *TournamentService) SetClubSessionTemplate(ctx context.Context, req *pb.ClubSessionTemplateRequest) (*pb.TournamentResponse, error) {
+	err := authenticateDirector(ctx, ts, req.Id, false, req)
 	if err != nil {
 		return nil, err
 	}
+
+	err = ts.audited(ctx, req.Id, "", "SetClubSessionTemplate", req, func(ctx context.Context) error {
+		return SetClubSessionTemplate(ctx, ts.tournamentStore, req.Id, req.Template)
+	})
+	if err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
 	return &pb.TournamentResponse{}, nil
 }
+
+func (ts *TournamentService) SetClubSessionSchedule(ctx context.Context, req *pb.ClubSessionScheduleRequest) (*pb.TournamentResponse, error) {
+	err := authenticateDirector(ctx, ts, req.Id, false, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ts.audited(ctx, req.Id, "", "SetClubSessionSchedule", req, func(ctx context.Context) error {
+		return SetClubSessionSchedule(ctx, ts.tournamentStore, req.Id, req.Schedule)
+	})
+	if err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+	return &pb.TournamentResponse{}, nil
+}
+
+func (ts *TournamentService) GetClubRatings(ctx context.Context, req *pb.ClubRatingsRequest) (*pb.ClubRatingsResponse, error) {
+	resp, err := GetClubRatings(ctx, ts.tournamentStore, req.Id, int(req.Offset), int(req.Limit))
+	if err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+	return resp, nil
+}
+
+func (ts *TournamentService) GetClubStats(ctx context.Context, req *pb.ClubStatsRequest) (*pb.ClubStatsResponse, error) {
+	resp, err := GetClubStats(ctx, ts.tournamentStore, req.Id, int(req.Limit))
+	if err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+	return resp, nil
+}
@@ -0,0 +1,259 @@
+package tournament
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/user"
+	ipc "github.com/domino14/liwords/rpc/api/proto/ipc"
+	pb "github.com/domino14/liwords/rpc/api/proto/tournament_service"
+)
+
+// MaxTournamentSnapshots is how many snapshots are kept for a tournament.
+const MaxTournamentSnapshots = 50
+
+// clonedDivision holds the settings of a division that is cloned.
+type clonedDivision struct {
+	name          string
+	controls      *ipc.DivisionControls
+	roundControls []*ipc.RoundControl
+	players       *ipc.TournamentPersons
+}
+
+// CloneTournament creates a tournament with the settings and divisions of
+// another one. If withPlayers is set, the players who are still in each
+// division are added too, with the ratings they were seeded by. Pairings,
+// results, prize awards and club session schedules are not cloned.
+func CloneTournament(ctx context.Context, ts TournamentStore, us user.Store, id string, name string, slug string,
+	withPlayers bool) (*entity.Tournament, error) {
+
+	t, err := ts.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	t.RLock()
+	description := t.Description
+	directors := proto.Clone(t.Directors).(*ipc.TournamentPersons)
+	ttype := t.Type
+	parentID := t.ParentID
+	extraMeta := &entity.TournamentMeta{}
+	if t.ExtraMeta != nil {
+		*extraMeta = *t.ExtraMeta
+		extraMeta.PrizeAwards = nil
+		if t.ExtraMeta.DefaultClubSettings != nil {
+			extraMeta.DefaultClubSettings = proto.Clone(t.ExtraMeta.DefaultClubSettings).(*ipc.GameRequest)
+		}
+	}
+	var ladder *ipc.Ladder
+	if t.Ladder != nil {
+		ladder = &ipc.Ladder{
+			MaxChallengeDistance: t.Ladder.MaxChallengeDistance,
+			AcceptSeconds:        t.Ladder.AcceptSeconds,
+		}
+		if withPlayers {
+			for _, player := range t.Ladder.Players {
+				ladder.Players = append(ladder.Players, proto.Clone(player).(*ipc.TournamentPerson))
+			}
+		}
+	}
+	var club *ipc.Club
+	if t.Club != nil {
+		club = &ipc.Club{MembersOnly: t.Club.MembersOnly}
+		if t.Club.SessionTemplate != nil {
+			club.SessionTemplate = proto.Clone(t.Club.SessionTemplate).(*ipc.ClubSessionTemplate)
+		}
+		if withPlayers {
+			for _, member := range t.Club.Members {
+				club.Members = append(club.Members, proto.Clone(member).(*ipc.ClubMember))
+			}
+		}
+	}
+	divisions := []*clonedDivision{}
+	for divisionName, divisionObject := range t.Divisions {
+		if divisionObject.DivisionManager == nil {
+			continue
+		}
+		dm := divisionObject.DivisionManager
+		division := &clonedDivision{
+			name:     divisionName,
+			controls: proto.Clone(dm.GetDivisionControls()).(*ipc.DivisionControls),
+			players:  &ipc.TournamentPersons{},
+		}
+		for _, roundControls := range dm.GetRoundControls() {
+			division.roundControls = append(division.roundControls, proto.Clone(roundControls).(*ipc.RoundControl))
+		}
+		if withPlayers {
+			for _, player := range dm.GetPlayers().Persons {
+				if player.Suspended {
+					continue
+				}
+				// AddPlayers takes usernames.
+				division.players.Persons = append(division.players.Persons, &ipc.TournamentPerson{
+					Id:     player.Id[strings.Index(player.Id, ":")+1:],
+					Rating: player.Rating,
+				})
+			}
+		}
+		divisions = append(divisions, division)
+	}
+	t.RUnlock()
+	sort.Slice(divisions, func(i, j int) bool { return divisions[i].name < divisions[j].name })
+
+	clone, err := NewTournament(ctx, ts, name, description, directors, ttype, parentID, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	unlock := lockTournament(ctx, clone)
+	clone.ExtraMeta = extraMeta
+	if ladder != nil {
+		ladder.Id = clone.UUID
+		clone.Ladder = ladder
+	}
+	if club != nil {
+		club.Id = clone.UUID
+		clone.Club = club
+	}
+	err = ts.Set(ctx, clone)
+	unlock()
+	if err != nil {
+		return nil, err
+	}
+	if ladder != nil && len(ladder.Players) > 0 {
+		userUUIDs := []string{}
+		for _, player := range ladder.Players {
+			userUUIDs = append(userUUIDs, strings.Split(player.Id, ":")[0])
+		}
+		err = ts.AddRegistrants(ctx, clone.UUID, userUUIDs, "")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, division := range divisions {
+		err = AddDivision(ctx, ts, clone.UUID, division.name)
+		if err != nil {
+			return nil, err
+		}
+		err = SetDivisionControls(ctx, ts, clone.UUID, division.name, division.controls)
+		if err != nil {
+			return nil, err
+		}
+		if len(division.roundControls) > 0 {
+			err = SetRoundControls(ctx, ts, clone.UUID, division.name, division.roundControls)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if len(division.players.Persons) > 0 {
+			err = AddPlayers(ctx, ts, us, clone.UUID, division.name, division.players)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return clone, nil
+}
+
+// TakeSnapshot saves the full state of the tournament, including the state
+// of every division.
+func TakeSnapshot(ctx context.Context, ts TournamentStore, id string, actor string, label string) (*pb.TournamentSnapshot, error) {
+	t, err := ts.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// The store serializes the divisions into the tournament.
+	defer lockTournament(ctx, t)()
+
+	return ts.AddSnapshot(ctx, t, actor, label, MaxTournamentSnapshots)
+}
+
+// RestoreSnapshot rolls the tournament back to the state it was in when the
+// snapshot was taken. Games that are still being played in rounds that the
+// snapshot had not paired yet will not be able to report their results.
+func RestoreSnapshot(ctx context.Context, ts TournamentStore, id string, snapshotID uint64) error {
+	t, err := ts.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	defer lockTournament(ctx, t)()
+
+	snapshot, err := ts.GetSnapshot(ctx, id, snapshotID)
+	if err != nil {
+		return err
+	}
+
+	t.Name = snapshot.Name
+	t.Description = snapshot.Description
+	t.Directors = snapshot.Directors
+	t.ExecutiveDirector = snapshot.ExecutiveDirector
+	t.IsStarted = snapshot.IsStarted
+	t.IsFinished = snapshot.IsFinished
+	t.Divisions = snapshot.Divisions
+	t.ExtraMeta = snapshot.ExtraMeta
+	t.Ladder = snapshot.Ladder
+	t.Club = snapshot.Club
+
+	// Registrants are kept outside of the tournament, so they are
+	// worked out again from the restored divisions.
+	err = ts.RemoveRegistrantsForTournament(ctx, t.UUID)
+	if err != nil {
+		return err
+	}
+	for divisionName, divisionObject := range t.Divisions {
+		if divisionObject.DivisionManager == nil {
+			continue
+		}
+		userUUIDs := []string{}
+		for _, player := range divisionObject.DivisionManager.GetPlayers().Persons {
+			if !player.Suspended {
+				userUUIDs = append(userUUIDs, strings.Split(player.Id, ":")[0])
+			}
+		}
+		if len(userUUIDs) > 0 {
+			err = ts.AddRegistrants(ctx, t.UUID, userUUIDs, divisionName)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if t.Ladder != nil && len(t.Ladder.Players) > 0 {
+		userUUIDs := []string{}
+		for _, player := range t.Ladder.Players {
+			userUUIDs = append(userUUIDs, strings.Split(player.Id, ":")[0])
+		}
+		err = ts.AddRegistrants(ctx, t.UUID, userUUIDs, "")
+		if err != nil {
+			return err
+		}
+	}
+
+	err = ts.Set(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	response := &ipc.FullTournamentDivisions{Divisions: make(map[string]*ipc.TournamentDivisionDataResponse),
+		Started: t.IsStarted}
+	for divisionName, divisionObject := range t.Divisions {
+		if divisionObject.DivisionManager == nil {
+			continue
+		}
+		xhr, err := divisionObject.DivisionManager.GetXHRResponse()
+		if err != nil {
+			return err
+		}
+		xhr.Id = t.UUID
+		xhr.Division = divisionName
+		response.Divisions[divisionName] = xhr
+	}
+	wrapped := entity.WrapEvent(response, ipc.MessageType_TOURNAMENT_FULL_DIVISIONS_MESSAGE)
+	return SendTournamentMessage(ctx, ts, t.UUID, wrapped)
+}
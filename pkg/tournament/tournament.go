@@ -27,11 +27,18 @@ type TournamentStore interface {
 	GetBySlug(context.Context, string) (*entity.Tournament, error)
 	Set(context.Context, *entity.Tournament) error
 	Create(context.Context, *entity.Tournament) error
+	Delete(ctx context.Context, id string) error
 	GetRecentGames(ctx context.Context, tourneyID string, numGames int, offset int) (*pb.RecentGamesResponse, error)
 	Unload(context.Context, string)
 	SetTournamentEventChan(c chan<- *entity.EventWrapper)
 	TournamentEventChan() chan<- *entity.EventWrapper
 	ListAllIDs(context.Context) ([]string, error)
+	ListScheduledIDs(ctx context.Context, now time.Time) ([]string, error)
+	AddAuditLogEntry(ctx context.Context, tid string, entry *pb.AuditLogEntry) error
+	GetAuditLog(ctx context.Context, tid string, limit int, offset int) (*pb.AuditLogResponse, error)
+	AddSnapshot(ctx context.Context, t *entity.Tournament, actor string, label string, keep int) (*pb.TournamentSnapshot, error)
+	GetSnapshots(ctx context.Context, tid string) (*pb.SnapshotsResponse, error)
+	GetSnapshot(ctx context.Context, tid string, snapshotID uint64) (*entity.Tournament, error)
 
 	GetRecentClubSessions(ctx context.Context, clubID string, numSessions int, offset int) (*pb.ClubSessionsResponse, error)
 	AddRegistrants(ctx context.Context, tid string, userIDs []string, division string) error
@@ -43,6 +50,14 @@ type TournamentStore interface {
 func HandleTournamentGameEnded(ctx context.Context, ts TournamentStore, us user.Store,
 	g *entity.Game) error {
 
+	t, err := ts.Get(ctx, g.TournamentData.Id)
+	if err != nil {
+		return err
+	}
+	if t.Type == entity.TypeLadder {
+		return ladderGameEnded(ctx, ts, t, g)
+	}
+
 	Results := []ipc.TournamentGameResult{ipc.TournamentGameResult_DRAW,
 		ipc.TournamentGameResult_WIN,
 		ipc.TournamentGameResult_LOSS}
@@ -143,12 +158,15 @@ func SetTournamentMetadata(ctx context.Context, ts TournamentStore, meta *pb.Tou
 		return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_FINISHED, t.Name)
 	}
 
-	t.Lock()
-	defer t.Unlock()
+	defer lockTournament(ctx, t)()
 	name := strings.TrimSpace(meta.Name)
 	if name == "" {
 		return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_EMPTY_NAME, t.Name)
 	}
+	err = validatePrizes(t, meta.Prizes)
+	if err != nil {
+		return err
+	}
 	t.Name = name
 	t.Description = meta.Description
 	t.Slug = meta.Slug
@@ -163,6 +181,8 @@ func SetTournamentMetadata(ctx context.Context, ts TournamentStore, meta *pb.Tou
 		Logo:                      meta.Logo,
 		Color:                     meta.Color,
 		PrivateAnalysis:           meta.PrivateAnalysis,
+		Prizes:                    meta.Prizes,
+		PrizeTieRule:              meta.PrizeTieRule,
 	}
 
 	err = ts.Set(ctx, t)
@@ -184,8 +204,7 @@ func SetSingleRoundControls(ctx context.Context, ts TournamentStore, id string,
 		return err
 	}
 
-	t.Lock()
-	defer t.Unlock()
+	defer lockTournament(ctx, t)()
 
 	if t.IsFinished {
 		return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_FINISHED, t.Name, division)
@@ -232,8 +251,7 @@ func SetRoundControls(ctx context.Context, ts TournamentStore, id string, divisi
 		return err
 	}
 
-	t.Lock()
-	defer t.Unlock()
+	defer lockTournament(ctx, t)()
 
 	if t.IsFinished {
 		return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_FINISHED, t.Name, division)
@@ -273,8 +291,7 @@ func SetDivisionControls(ctx context.Context, ts TournamentStore, id string, div
 		return err
 	}
 
-	t.Lock()
-	defer t.Unlock()
+	defer lockTournament(ctx, t)()
 
 	if t.IsFinished {
 		return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_FINISHED, t.Name, division)
@@ -309,8 +326,7 @@ func AddDivision(ctx context.Context, ts TournamentStore, id string, division st
 		return err
 	}
 
-	t.Lock()
-	defer t.Unlock()
+	defer lockTournament(ctx, t)()
 
 	if t.IsFinished {
 		return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_FINISHED, t.Name, division)
@@ -351,8 +367,7 @@ func RemoveDivision(ctx context.Context, ts TournamentStore, id string, division
 		return err
 	}
 
-	t.Lock()
-	defer t.Unlock()
+	defer lockTournament(ctx, t)()
 
 	if t.IsFinished {
 		return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_FINISHED, t.Name, division)
@@ -396,8 +411,7 @@ func AddDirectors(ctx context.Context, ts TournamentStore, us user.Store, id str
 		return err
 	}
 
-	t.Lock()
-	defer t.Unlock()
+	defer lockTournament(ctx, t)()
 
 	if t.IsFinished {
 		return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_FINISHED, t.Name, "")
@@ -448,8 +462,7 @@ func RemoveDirectors(ctx context.Context, ts TournamentStore, us user.Store, id
 		return err
 	}
 
-	t.Lock()
-	defer t.Unlock()
+	defer lockTournament(ctx, t)()
 
 	if t.IsFinished {
 		return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_FINISHED, t.Name, "")
@@ -487,9 +500,12 @@ func AddPlayers(ctx context.Context, ts TournamentStore, us user.Store, id strin
 	if err != nil {
 		return err
 	}
+	roster, err := membersOnlyRoster(ctx, ts, t)
+	if err != nil {
+		return err
+	}
 
-	t.Lock()
-	defer t.Unlock()
+	defer lockTournament(ctx, t)()
 
 	if t.IsFinished {
 		return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_FINISHED, t.Name, division)
@@ -512,6 +528,10 @@ func AddPlayers(ctx context.Context, ts TournamentStore, us user.Store, id strin
 		if err != nil {
 			return err
 		}
+		err = checkClubMembership(t, division, roster, fullID)
+		if err != nil {
+			return err
+		}
 		player.Id = fullID
 		userUUIDs = append(userUUIDs, UUID)
 	}
@@ -550,9 +570,12 @@ func RemovePlayers(ctx context.Context, ts TournamentStore, us user.Store, id st
 	if err != nil {
 		return err
 	}
+	roster, err := membersOnlyRoster(ctx, ts, t)
+	if err != nil {
+		return err
+	}
 
-	t.Lock()
-	defer t.Unlock()
+	defer lockTournament(ctx, t)()
 
 	if t.IsFinished {
 		return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_FINISHED, t.Name, division)
@@ -583,13 +606,21 @@ func RemovePlayers(ctx context.Context, ts TournamentStore, us user.Store, id st
 		return err
 	}
 
-	allCurrentPlayers := divisionObject.DivisionManager.GetPlayers()
-
 	err = ts.RemoveRegistrants(ctx, t.UUID, userUUIDs, division)
 	if err != nil {
 		return err
 	}
 
+	if !t.IsStarted {
+		promotedResp, err := promoteWaitlist(ctx, ts, t, division, roster)
+		if err != nil {
+			return err
+		}
+		pairingsResp = combinePairingMessages(pairingsResp, promotedResp)
+	}
+
+	allCurrentPlayers := divisionObject.DivisionManager.GetPlayers()
+
 	err = ts.Set(ctx, t)
 	if err != nil {
 		return err
@@ -607,6 +638,85 @@ func RemovePlayers(ctx context.Context, ts TournamentStore, us user.Store, id st
 	return SendTournamentMessage(ctx, ts, id, wrapped)
 }
 
+// WithdrawPlayers takes the players out of the pairings of the division.
+// The players are usernames.
+func WithdrawPlayers(ctx context.Context, ts TournamentStore, us user.Store, id string, division string,
+	players []string, policy ipc.WithdrawalPolicy) error {
+
+	return changePlayers(ctx, ts, us, id, division, players, func(dm entity.DivisionManager, persons *ipc.TournamentPersons) (*ipc.DivisionPairingsResponse, error) {
+		return dm.WithdrawPlayers(persons, policy)
+	}, false)
+}
+
+// ReadmitPlayers brings withdrawn players back into the pairings of the
+// division. The players are usernames.
+func ReadmitPlayers(ctx context.Context, ts TournamentStore, us user.Store, id string, division string,
+	players []string, catchUpByes int) error {
+
+	return changePlayers(ctx, ts, us, id, division, players, func(dm entity.DivisionManager, persons *ipc.TournamentPersons) (*ipc.DivisionPairingsResponse, error) {
+		return dm.ReadmitPlayers(persons, catchUpByes)
+	}, true)
+}
+
+// changePlayers makes a change to existing players of the division
+// and keeps the registrants of the division in step with it.
+func changePlayers(ctx context.Context, ts TournamentStore, us user.Store, id string, division string,
+	players []string, change func(entity.DivisionManager, *ipc.TournamentPersons) (*ipc.DivisionPairingsResponse, error),
+	registered bool) error {
+
+	t, err := ts.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	defer lockTournament(ctx, t)()
+
+	if t.IsFinished {
+		return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_FINISHED, t.Name, division)
+	}
+	divisionObject, ok := t.Divisions[division]
+	if !ok {
+		return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NONEXISTENT_DIVISION, t.Name, division)
+	}
+	if divisionObject.DivisionManager == nil {
+		return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NIL_DIVISION_MANAGER, t.Name, division)
+	}
+
+	persons := &ipc.TournamentPersons{Id: id, Division: division}
+	userUUIDs := []string{}
+	for _, player := range players {
+		fullID, UUID, err := constructFullID(t.Name, division, ctx, us, player)
+		if err != nil {
+			return err
+		}
+		persons.Persons = append(persons.Persons, &ipc.TournamentPerson{Id: fullID})
+		userUUIDs = append(userUUIDs, UUID)
+	}
+
+	pairingsResp, err := change(divisionObject.DivisionManager, persons)
+	if err != nil {
+		return err
+	}
+
+	if registered {
+		err = ts.AddRegistrants(ctx, t.UUID, userUUIDs, division)
+	} else {
+		err = ts.RemoveRegistrants(ctx, t.UUID, userUUIDs, division)
+	}
+	if err != nil {
+		return err
+	}
+
+	err = ts.Set(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	pairingsResp.Id = id
+	pairingsResp.Division = division
+	return sendPlayersChange(ctx, ts, t, division, pairingsResp)
+}
+
 // SetPairings is only called by the API
 func SetPairings(ctx context.Context, ts TournamentStore, id string, division string, pairings []*pb.TournamentPairingRequest) error {
 
@@ -615,8 +725,7 @@ func SetPairings(ctx context.Context, ts TournamentStore, id string, division st
 		return err
 	}
 
-	t.Lock()
-	defer t.Unlock()
+	defer lockTournament(ctx, t)()
 
 	if t.IsFinished {
 		return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_FINISHED, t.Name, division)
@@ -682,8 +791,7 @@ func SetResult(ctx context.Context,
 		return err
 	}
 
-	t.Lock()
-	defer t.Unlock()
+	defer lockTournament(ctx, t)()
 
 	if t.IsFinished {
 		return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_FINISHED, t.Name, division)
@@ -786,6 +894,13 @@ func SetResult(ctx context.Context,
 		return err
 	}
 
+	// The result has been recorded even if the ratings could not be saved.
+	// The scheduler tries to save them again.
+	err = possiblyApplyRatingPeriod(ctx, us, t, division)
+	if err != nil {
+		log.Err(err).Str("tid", id).Str("division", division).Msg("apply-rating-period-error")
+	}
+
 	err = ts.Set(ctx, t)
 	if err != nil {
 		return err
@@ -822,11 +937,7 @@ func possiblyEndTournament(ctx context.Context, ts TournamentStore, t *entity.To
 		}
 	}
 	if allended {
-		t.IsFinished = true
-		err := ts.RemoveRegistrantsForTournament(ctx, t.UUID)
-		if err != nil {
-			return err
-		}
+		return finishTournament(ctx, ts, t)
 	}
 	return nil
 }
@@ -897,14 +1008,13 @@ func sendDivisionStart(ts TournamentStore, tuuid string, division string, round
 	return nil
 }
 
-func StartAllRoundCountdowns(ctx context.Context, ts TournamentStore, id string, round int) error {
+func StartAllRoundCountdowns(ctx context.Context, ts TournamentStore, us user.Store, id string, round int) error {
 	t, err := ts.Get(ctx, id)
 	if err != nil {
 		return err
 	}
 
-	t.Lock()
-	defer t.Unlock()
+	defer lockTournament(ctx, t)()
 
 	err = startTournamentChecks(t)
 	if err != nil {
@@ -919,7 +1029,11 @@ func StartAllRoundCountdowns(ctx context.Context, ts TournamentStore, id string,
 	}
 
 	for division := range t.Divisions {
-		err := t.Divisions[division].DivisionManager.StartRound(false)
+		err := snapshotPeriodRatings(ctx, us, t, division)
+		if err != nil {
+			return err
+		}
+		err = t.Divisions[division].DivisionManager.StartRound(false)
 		if err != nil {
 			return err
 		}
@@ -940,15 +1054,14 @@ func StartAllRoundCountdowns(ctx context.Context, ts TournamentStore, id string,
 	return nil
 }
 
-func StartRoundCountdown(ctx context.Context, ts TournamentStore, id string,
+func StartRoundCountdown(ctx context.Context, ts TournamentStore, us user.Store, id string,
 	division string, round int) error {
 	t, err := ts.Get(ctx, id)
 	if err != nil {
 		return err
 	}
 
-	t.Lock()
-	defer t.Unlock()
+	defer lockTournament(ctx, t)()
 
 	err = startTournamentChecks(t)
 	if err != nil {
@@ -960,6 +1073,10 @@ func StartRoundCountdown(ctx context.Context, ts TournamentStore, id string,
 		return err
 	}
 
+	err = snapshotPeriodRatings(ctx, us, t, division)
+	if err != nil {
+		return err
+	}
 	err = t.Divisions[division].DivisionManager.StartRound(false)
 	if err != nil {
 		return err
@@ -985,8 +1102,7 @@ func PairRound(ctx context.Context, ts TournamentStore, id string, division stri
 		return err
 	}
 
-	t.Lock()
-	defer t.Unlock()
+	defer lockTournament(ctx, t)()
 
 	if t.IsFinished {
 		return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_FINISHED, t.Name, division)
@@ -1024,14 +1140,51 @@ func PairRound(ctx context.Context, ts TournamentStore, id string, division stri
 	return SendTournamentMessage(ctx, ts, id, wrapped)
 }
 
+// PreviewPairRound returns the pairings that PairRound would make,
+// without making them.
+func PreviewPairRound(ctx context.Context, ts TournamentStore, id string, division string, round int,
+	preserveByes bool, controls *ipc.RoundControl) (*ipc.PairingPreviewResponse, error) {
+
+	t, err := ts.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	t.RLock()
+	defer t.RUnlock()
+
+	if t.IsFinished {
+		return nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_FINISHED, t.Name, division)
+	}
+	divisionObject, ok := t.Divisions[division]
+	if !ok {
+		return nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NONEXISTENT_DIVISION, t.Name, division)
+	}
+	if divisionObject.DivisionManager == nil {
+		return nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NIL_DIVISION_MANAGER, t.Name, division)
+	}
+
+	currentRound := divisionObject.DivisionManager.GetCurrentRound()
+	if round < currentRound+1 {
+		return nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_PAIR_NON_FUTURE_ROUND, t.Name, division, strconv.Itoa(round+1), strconv.Itoa(currentRound+1))
+	}
+
+	response, err := divisionObject.DivisionManager.PreviewPairRound(round, preserveByes, controls)
+	if err != nil {
+		return nil, err
+	}
+	response.Id = id
+	response.Division = division
+	return response, nil
+}
+
 func DeletePairings(ctx context.Context, ts TournamentStore, id string, division string, round int) error {
 	t, err := ts.Get(ctx, id)
 	if err != nil {
 		return err
 	}
 
-	t.Lock()
-	defer t.Unlock()
+	defer lockTournament(ctx, t)()
 
 	if t.IsFinished {
 		return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_FINISHED, t.Name, division)
@@ -1110,6 +1263,9 @@ func SetFinished(ctx context.Context, ts TournamentStore, id string) error {
 		return err
 	}
 
+	// Getting the standings for the prizes caches them in the divisions.
+	defer lockTournament(ctx, t)()
+
 	if t.IsFinished {
 		return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_FINISHED, t.Name, "")
 	}
@@ -1132,7 +1288,10 @@ func SetFinished(ctx context.Context, ts TournamentStore, id string) error {
 		}
 	}
 
-	t.IsFinished = true
+	err = finishTournament(ctx, ts, t)
+	if err != nil {
+		return err
+	}
 
 	err = ts.Set(ctx, t)
 	if err != nil {
@@ -1178,12 +1337,63 @@ func GetXHRResponse(ctx context.Context, ts TournamentStore, id string) (*ipc.Fu
 	return response, nil
 }
 
+func GetBracket(ctx context.Context, ts TournamentStore, id string, division string) (*ipc.BracketResponse, error) {
+	t, err := ts.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	t.RLock()
+	defer t.RUnlock()
+
+	divisionObject, ok := t.Divisions[division]
+	if !ok {
+		return nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NONEXISTENT_DIVISION, t.Name, division)
+	}
+	if divisionObject.DivisionManager == nil {
+		return nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NIL_DIVISION_MANAGER, t.Name, division)
+	}
+
+	response, err := divisionObject.DivisionManager.GetBracket()
+	if err != nil {
+		return nil, err
+	}
+	response.Id = id
+	response.Division = division
+	return response, nil
+}
+
+func GetPairingReport(ctx context.Context, ts TournamentStore, id string, division string) (*ipc.PairingReport, error) {
+	t, err := ts.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	t.RLock()
+	defer t.RUnlock()
+
+	divisionObject, ok := t.Divisions[division]
+	if !ok {
+		return nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NONEXISTENT_DIVISION, t.Name, division)
+	}
+	if divisionObject.DivisionManager == nil {
+		return nil, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NIL_DIVISION_MANAGER, t.Name, division)
+	}
+
+	response, err := divisionObject.DivisionManager.GetPairingReport()
+	if err != nil {
+		return nil, err
+	}
+	response.Id = id
+	response.Division = division
+	return response, nil
+}
+
 func SetReadyForGame(ctx context.Context, ts TournamentStore, t *entity.Tournament,
 	playerID, connID, division string,
 	round, gameIndex int, unready bool) ([]string, bool, error) {
 
-	t.Lock()
-	defer t.Unlock()
+	defer lockTournament(ctx, t)()
 
 	if t.IsFinished {
 		return nil, false, entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_FINISHED, t.Name, division)
@@ -1203,8 +1413,7 @@ func SetReadyForGame(ctx context.Context, ts TournamentStore, t *entity.Tourname
 func ClearReadyStates(ctx context.Context, ts TournamentStore, t *entity.Tournament,
 	division, userID string, round, gameIndex int) error {
 
-	t.Lock()
-	defer t.Unlock()
+	defer lockTournament(ctx, t)()
 
 	if t.IsFinished {
 		return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_FINISHED, t.Name, division)
@@ -1317,6 +1526,11 @@ func validateTournamentMeta(ttype pb.TType, slug string) (entity.CompetitionType
 		if !strings.HasPrefix(slug, "/tournament/") {
 			return "", twirp.NewError(twirp.InvalidArgument, "tournament slug must start with /tournament/")
 		}
+	case pb.TType_LADDER:
+		tt = entity.TypeLadder
+		if !strings.HasPrefix(slug, "/tournament/") {
+			return "", twirp.NewError(twirp.InvalidArgument, "ladder slug must start with /tournament/")
+		}
 	case pb.TType_CHILD:
 		tt = entity.TypeChild
 		// A Club session type can also be a child tournament (it's essentially just a tournament with a parent ID)
@@ -1329,38 +1543,33 @@ func validateTournamentMeta(ttype pb.TType, slug string) (entity.CompetitionType
 	return tt, nil
 }
 
-func CheckIn(ctx context.Context, ts TournamentStore, tid string, playerid string) error {
-	return errors.New("not implemented")
-}
-
-func UncheckIn(ctx context.Context, ts TournamentStore, tid string) error {
-	return errors.New("not implemented")
-}
-
-/*
-func CheckIn(ctx context.Context, ts TournamentStore, tid string, playerid string) error {
+// CheckIn checks the player in to every division of the
+// tournament that they are playing in.
+func CheckIn(ctx context.Context, ts TournamentStore, tid string, playerid string, now time.Time) error {
 	t, err := ts.Get(ctx, tid)
 	if err != nil {
 		return err
 	}
-	t.Lock()
-	defer t.Unlock()
+	defer lockTournament(ctx, t)()
+
+	if t.IsFinished {
+		return entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_FINISHED, t.Name, "")
+	}
 
-	found := false
 	// really a player should only be in one division but we allow this so let's
 	// make it correct for now
 	divisionsFound := []string{}
 	for dname, d := range t.Divisions {
-		if _, ok := d.Players.Persons[playerid]; ok {
-			err := d.DivisionManager.SetCheckedIn(playerid)
-			if err != nil {
-				return err
-			}
-			found = true
-			divisionsFound = append(divisionsFound, dname)
+		if !isPlayerInDivision(d, playerid) {
+			continue
+		}
+		err := d.DivisionManager.SetCheckedIn(playerid, now)
+		if err != nil {
+			return err
 		}
+		divisionsFound = append(divisionsFound, dname)
 	}
-	if !found {
+	if len(divisionsFound) == 0 {
 		return errors.New("user not in this tournament")
 	}
 	err = ts.Set(ctx, t)
@@ -1369,7 +1578,7 @@ func CheckIn(ctx context.Context, ts TournamentStore, tid string, playerid strin
 	}
 
 	for _, d := range divisionsFound {
-		err = SendTournamentDivisionMessage(ctx, ts, t.UUID, d)
+		err = sendPlayersChange(ctx, ts, t, d, newPairingsMessage())
 		if err != nil {
 			return err
 		}
@@ -1378,22 +1587,33 @@ func CheckIn(ctx context.Context, ts TournamentStore, tid string, playerid strin
 	return nil
 }
 
+// UncheckIn checks out every player in the tournament so that
+// check-in can start over.
 func UncheckIn(ctx context.Context, ts TournamentStore, tid string) error {
 	t, err := ts.Get(ctx, tid)
 	if err != nil {
 		return err
 	}
-	t.Lock()
-	defer t.Unlock()
+	defer lockTournament(ctx, t)()
+
+	for _, d := range t.Divisions {
+		if d.DivisionManager != nil {
+			d.DivisionManager.ClearCheckedIn()
+		}
+	}
+	err = ts.Set(ctx, t)
+	if err != nil {
+		return err
+	}
 
 	for dname, d := range t.Divisions {
-		d.DivisionManager.ClearCheckedIn()
-		err = SendTournamentDivisionMessage(ctx, ts, t.UUID, dname)
+		if d.DivisionManager == nil {
+			continue
+		}
+		err = sendPlayersChange(ctx, ts, t, dname, newPairingsMessage())
 		if err != nil {
 			return err
 		}
 	}
-	return ts.Set(ctx, t)
+	return nil
 }
-
-*/
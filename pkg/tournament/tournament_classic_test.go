@@ -1,17 +1,23 @@
 package tournament
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/matryer/is"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/domino14/liwords/pkg/entity"
+	"github.com/domino14/liwords/pkg/glicko"
 	"github.com/domino14/liwords/pkg/utilities"
 	pb "github.com/domino14/liwords/rpc/api/proto/ipc"
+	tpb "github.com/domino14/liwords/rpc/api/proto/tournament_service"
 	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
 )
 
@@ -1230,6 +1236,247 @@ func TestClassicDivisionSwiss(t *testing.T) {
 	}
 }
 
+func TestClassicDivisionPreviewPairRound(t *testing.T) {
+	is := is.New(t)
+
+	roundControls := []*pb.RoundControl{}
+	for i := 0; i < 3; i++ {
+		roundControls = append(roundControls, &pb.RoundControl{FirstMethod: pb.FirstMethod_MANUAL_FIRST,
+			PairingMethod:               pb.PairingMethod_SWISS,
+			GamesPerRound:               defaultGamesPerRound,
+			Round:                       int32(i),
+			Factor:                      1,
+			MaxRepeats:                  1,
+			AllowOverMaxRepeats:         true,
+			RepeatRelativeWeight:        1,
+			WinDifferenceRelativeWeight: 1})
+	}
+	roundControls[0].PairingMethod = pb.PairingMethod_KING_OF_THE_HILL
+
+	tc, err := compactNewClassicDivision(defaultPlayers, roundControls, true)
+	is.NoErr(err)
+
+	player1 := defaultPlayers.Persons[0].Id
+	player2 := defaultPlayers.Persons[1].Id
+	player3 := defaultPlayers.Persons[2].Id
+	player4 := defaultPlayers.Persons[3].Id
+
+	err = tc.StartRound(true)
+	is.NoErr(err)
+
+	_, err = tc.SubmitResult(0, player1, player2, 900, 500,
+		pb.TournamentGameResult_WIN,
+		pb.TournamentGameResult_LOSS,
+		pb.GameEndReason_STANDARD, false, 0, "")
+	is.NoErr(err)
+
+	_, err = tc.SubmitResult(0, player3, player4, 800, 500,
+		pb.TournamentGameResult_WIN,
+		pb.TournamentGameResult_LOSS,
+		pb.GameEndReason_STANDARD, false, 0, "")
+	is.NoErr(err)
+
+	opponent, err := tc.opponentOf(player1, 1)
+	is.NoErr(err)
+	is.Equal(opponent, player3)
+
+	previewOpponent := func(preview *pb.PairingPreviewResponse, player string) *pb.PairingPreview {
+		for _, pairing := range preview.Pairings {
+			if pairing.Players[0] == player || pairing.Players[1] == player {
+				return pairing
+			}
+		}
+		return nil
+	}
+
+	// The preview makes the same pairings as PairRound
+	preview, err := tc.PreviewPairRound(1, false, nil)
+	is.NoErr(err)
+	is.Equal(len(preview.Pairings), 2)
+	pairing := previewOpponent(preview, player1)
+	is.True(pairing != nil)
+	is.True(pairing.Players[0] == player3 || pairing.Players[1] == player3)
+	is.True(!pairing.Repeat)
+	is.True(!pairing.Bye)
+	is.True(!pairing.Gibson)
+	is.Equal(pairing.Weight.WinDifference, int64(0))
+	is.Equal(pairing.Weight.Spread, int64(-100))
+	is.Equal(pairing.Weight.Repeat, int64(0))
+	is.Equal(pairing.Weight.Total, int64(-100))
+	is.Equal(preview.TotalWeight, int64(-200))
+
+	// Without the win difference, the largest spread differences are paired
+	controls := proto.Clone(roundControls[1]).(*pb.RoundControl)
+	controls.WinDifferenceRelativeWeight = 0
+	preview, err = tc.PreviewPairRound(1, false, controls)
+	is.NoErr(err)
+	pairing = previewOpponent(preview, player1)
+	is.True(pairing.Players[0] == player4 || pairing.Players[1] == player4)
+	is.Equal(pairing.Weight.Spread, int64(-700))
+	pairing = previewOpponent(preview, player2)
+	is.True(pairing.Players[0] == player3 || pairing.Players[1] == player3)
+
+	// Previews do not change the division
+	opponent, err = tc.opponentOf(player1, 1)
+	is.NoErr(err)
+	is.Equal(opponent, player3)
+	is.Equal(tc.RoundControls[1].WinDifferenceRelativeWeight, int32(1))
+
+	_, err = tc.SubmitResult(1, player1, player3, 900, 500,
+		pb.TournamentGameResult_WIN,
+		pb.TournamentGameResult_LOSS,
+		pb.GameEndReason_STANDARD, false, 0, "")
+	is.NoErr(err)
+
+	_, err = tc.SubmitResult(1, player2, player4, 800, 500,
+		pb.TournamentGameResult_WIN,
+		pb.TournamentGameResult_LOSS,
+		pb.GameEndReason_STANDARD, false, 0, "")
+	is.NoErr(err)
+
+	// Avoiding the repeats costs a larger win difference
+	preview, err = tc.PreviewPairRound(2, false, nil)
+	is.NoErr(err)
+	pairing = previewOpponent(preview, player1)
+	is.True(pairing.Players[0] == player4 || pairing.Players[1] == player4)
+	is.True(!pairing.Repeat)
+	is.Equal(pairing.Weight.Repeat, int64(0))
+
+	// Unless repeats have no weight
+	controls = proto.Clone(roundControls[2]).(*pb.RoundControl)
+	controls.RepeatRelativeWeight = 0
+	preview, err = tc.PreviewPairRound(2, false, controls)
+	is.NoErr(err)
+	for _, pairing := range preview.Pairings {
+		is.True(pairing.Repeat)
+		is.Equal(pairing.PreviousGames, int32(1))
+	}
+	is.Equal(tc.RoundControls[2].RepeatRelativeWeight, int32(1))
+
+	// Byes are only kept if PairRound would keep them
+	_, err = tc.SetPairing(player1, player1, 2, pb.TournamentGameResult_BYE)
+	is.NoErr(err)
+	preview, err = tc.PreviewPairRound(2, true, nil)
+	is.NoErr(err)
+	is.True(previewOpponent(preview, player1).Bye)
+	preview, err = tc.PreviewPairRound(2, false, nil)
+	is.NoErr(err)
+	is.True(!previewOpponent(preview, player1).Bye)
+}
+
+func TestClassicDivisionContention(t *testing.T) {
+	is := is.New(t)
+
+	tc, err := compactNewClassicDivision(defaultPlayers, defaultRoundControls(3), true)
+	is.NoErr(err)
+
+	// One round is left to play
+	records := []*pb.PlayerStanding{
+		{PlayerId: "A", Wins: 2, Spread: 200},
+		{PlayerId: "B", Wins: 1, Losses: 1},
+		{PlayerId: "C", Wins: 1, Losses: 1},
+		{PlayerId: "D", Losses: 2},
+	}
+
+	tc.DivisionControls.MinimumPlacement = 0
+	bestPlaces, err := tc.contention(records, 2)
+	is.NoErr(err)
+	is.Equal(bestPlaces, []int{0, 0, 0, -1})
+
+	tc.DivisionControls.MinimumPlacement = 1
+	bestPlaces, err = tc.contention(records, 2)
+	is.NoErr(err)
+	is.Equal(bestPlaces, []int{0, 0, 0, 1})
+
+	// B and C can only tie A on wins and can't make up the spread
+	tc.DivisionControls.MinimumPlacement = 0
+	tc.DivisionControls.GibsonSpread = 100
+	bestPlaces, err = tc.contention(records, 2)
+	is.NoErr(err)
+	is.Equal(bestPlaces, []int{0, -1, -1, -1})
+
+	// Everyone is in contention with more rounds left
+	bestPlaces, err = tc.contention(records, 0)
+	is.NoErr(err)
+	is.Equal(bestPlaces, []int{0, 0, 0, 0})
+
+	// Contention pairing is only for weighted pairing methods
+	controls := defaultRoundControls(1)[0]
+	controls.ContentionPairing = true
+	_, err = tc.SetSingleRoundControls(2, controls)
+	is.True(err != nil)
+	controls.PairingMethod = pb.PairingMethod_SWISS
+	_, err = tc.SetSingleRoundControls(2, controls)
+	is.NoErr(err)
+}
+
+func TestClassicDivisionPairingLag(t *testing.T) {
+	is := is.New(t)
+
+	roundControls := defaultRoundControls(4)
+	roundControls[0].PairingMethod = pb.PairingMethod_KING_OF_THE_HILL
+	for i := 1; i < len(roundControls); i++ {
+		roundControls[i].PairingMethod = pb.PairingMethod_SWISS
+		roundControls[i].PairingLag = 1
+	}
+
+	tc, err := compactNewClassicDivision(defaultPlayers, roundControls, true)
+	is.NoErr(err)
+
+	player1 := defaultPlayers.Persons[0].Id
+	player2 := defaultPlayers.Persons[1].Id
+	player3 := defaultPlayers.Persons[2].Id
+	player4 := defaultPlayers.Persons[3].Id
+
+	// The second round is paired before anyone has played
+	opponent, err := tc.opponentOf(player1, 1)
+	is.NoErr(err)
+	is.True(opponent != "")
+	is.True(opponent != player2)
+	opponent, err = tc.opponentOf(player1, 2)
+	is.NoErr(err)
+	is.Equal(opponent, "")
+
+	err = tc.StartRound(true)
+	is.NoErr(err)
+
+	_, err = tc.SubmitResult(0, player1, player2, 900, 500,
+		pb.TournamentGameResult_WIN,
+		pb.TournamentGameResult_LOSS,
+		pb.GameEndReason_STANDARD, false, 0, "")
+	is.NoErr(err)
+
+	_, err = tc.SubmitResult(0, player3, player4, 800, 500,
+		pb.TournamentGameResult_WIN,
+		pb.TournamentGameResult_LOSS,
+		pb.GameEndReason_STANDARD, false, 0, "")
+	is.NoErr(err)
+
+	// The third round is paired as soon as the first round is over
+	is.Equal(tc.GetCurrentRound(), 1)
+	for _, player := range []string{player1, player2, player3, player4} {
+		opponent, err = tc.opponentOf(player, 2)
+		is.NoErr(err)
+		is.True(opponent != "")
+		opponent, err = tc.opponentOf(player, 3)
+		is.NoErr(err)
+		is.Equal(opponent, "")
+	}
+
+	// Only weighted and king of the hill pairings can lag
+	controls := defaultRoundControls(1)[0]
+	controls.PairingLag = 1
+	_, err = tc.SetSingleRoundControls(3, controls)
+	is.True(err != nil)
+	controls.PairingMethod = pb.PairingMethod_KING_OF_THE_HILL
+	controls.PairingLag = -1
+	_, err = tc.SetSingleRoundControls(3, controls)
+	is.True(err != nil)
+	controls.PairingLag = 2
+	_, err = tc.SetSingleRoundControls(3, controls)
+	is.NoErr(err)
+}
+
 func TestClassicDivisionSwissRemoved(t *testing.T) {
 	is := is.New(t)
 
@@ -2105,6 +2352,383 @@ func TestClassicDivisionElimination(t *testing.T) {
 	is.NoErr(equalStandings(expectedstandings, standings))
 }
 
+func TestClassicDivisionDoubleElimination(t *testing.T) {
+	is := is.New(t)
+
+	player1 := defaultPlayers.Persons[0].Id
+	player2 := defaultPlayers.Persons[1].Id
+	player3 := defaultPlayers.Persons[2].Id
+	player4 := defaultPlayers.Persons[3].Id
+
+	// Four players need four rounds, or five with a grand final reset
+	roundControls := defaultRoundControls(3)
+	for i := 0; i < len(roundControls); i++ {
+		roundControls[i].PairingMethod = pb.PairingMethod_DOUBLE_ELIMINATION
+	}
+	_, err := compactNewClassicDivision(defaultPlayers, roundControls, true)
+	is.True(err.Error() == entity.NewWooglesError(pb.WooglesError_TOURNAMENT_INVALID_DOUBLE_ELIMINATION_ROUNDS, tournamentName, divisionName, "4", "3").Error())
+
+	roundControls = defaultRoundControls(5)
+	for i := 0; i < len(roundControls); i++ {
+		roundControls[i].PairingMethod = pb.PairingMethod_DOUBLE_ELIMINATION
+	}
+	roundControls[2].PairingMethod = pb.PairingMethod_ELIMINATION
+	_, err = compactNewClassicDivision(defaultPlayers, roundControls, true)
+	is.True(err != nil)
+
+	roundControls[2].PairingMethod = pb.PairingMethod_DOUBLE_ELIMINATION
+	tc, err := compactNewClassicDivision(defaultPlayers, roundControls, true)
+	is.NoErr(err)
+	is.True(tc.Bracket != nil)
+
+	// Players that can't fill the bracket can't be added
+	_, err = tc.AddPlayers(&pb.TournamentPersons{Persons: []*pb.TournamentPerson{{Id: "Fifth", Rating: 1000}}})
+	is.True(err.Error() == entity.NewWooglesError(pb.WooglesError_TOURNAMENT_INVALID_DOUBLE_ELIMINATION_ROUNDS, tournamentName, divisionName, "5", "5").Error())
+	tc, err = compactNewClassicDivision(defaultPlayers, roundControls, true)
+	is.NoErr(err)
+
+	err = tc.StartRound(true)
+	is.NoErr(err)
+
+	win := func(round int, winner string, loser string) {
+		_, err := tc.SubmitResult(round, winner, loser, 500, 400,
+			pb.TournamentGameResult_WIN,
+			pb.TournamentGameResult_LOSS,
+			pb.GameEndReason_STANDARD, false, 0, "")
+		is.NoErr(err)
+	}
+
+	isOpponent := func(round int, player string, opponent string) {
+		o, err := tc.opponentOf(player, round)
+		is.NoErr(err)
+		is.Equal(o, opponent)
+	}
+
+	// The top seeds are on opposite sides of the bracket
+	isOpponent(0, player1, player4)
+	isOpponent(0, player2, player3)
+
+	win(0, player1, player4)
+	win(0, player2, player3)
+
+	// The first round losers are not eliminated
+	pairing, err := tc.getPairing(player4, 0)
+	is.NoErr(err)
+	player4Index := 0
+	if tc.Players.Persons[pairing.Players[1]].Id == player4 {
+		player4Index = 1
+	}
+	is.Equal(pairing.Outcomes[player4Index], pb.TournamentGameResult_LOSS)
+
+	isOpponent(1, player1, player2)
+	isOpponent(1, player4, player3)
+
+	win(1, player2, player1)
+	win(1, player3, player4)
+
+	// The winners bracket champion waits for the losers bracket final
+	isOpponent(2, player3, player1)
+	pairing, err = tc.getPairing(player2, 2)
+	is.NoErr(err)
+	is.True(pairing.Players == nil)
+	is.True(pairing.Outcomes[0] == pb.TournamentGameResult_VOID)
+	pairing, err = tc.getPairing(player4, 2)
+	is.NoErr(err)
+	is.True(pairing.Players == nil)
+	is.True(pairing.Outcomes[0] == pb.TournamentGameResult_ELIMINATED)
+
+	win(2, player1, player3)
+
+	// The losers bracket champion wins the grand final,
+	// so the grand final is reset
+	isOpponent(3, player2, player1)
+	win(3, player1, player2)
+	isOpponent(4, player2, player1)
+
+	// Getting the bracket changes nothing in the division.
+	before, err := json.Marshal(tc)
+	is.NoErr(err)
+	bracket, err := tc.GetBracket()
+	is.NoErr(err)
+	is.Equal(bracket.Champion, "")
+	after, err := json.Marshal(tc)
+	is.NoErr(err)
+	is.Equal(string(before), string(after))
+
+	win(4, player1, player2)
+
+	finished, err := tc.IsFinished()
+	is.NoErr(err)
+	is.True(finished)
+
+	bracket, err = tc.GetBracket()
+	is.NoErr(err)
+	is.Equal(bracket.Champion, player1)
+	is.Equal(bracket.Placements, []string{player1, player2, player3, player4})
+
+	standings, _, err := tc.GetStandings(4)
+	is.NoErr(err)
+	is.Equal(standings.Standings[0].PlayerId, player1)
+	is.Equal(standings.Standings[1].PlayerId, player2)
+	is.Equal(standings.Standings[2].PlayerId, player3)
+	is.Equal(standings.Standings[3].PlayerId, player4)
+
+	// Resetting the division rebuilds the bracket
+	err = tc.ResetToBeginning()
+	is.NoErr(err)
+	is.True(tc.Bracket != nil)
+	bracket, err = tc.GetBracket()
+	is.NoErr(err)
+	is.Equal(bracket.Champion, "")
+}
+
+func TestClassicDivisionEliminationConsolation(t *testing.T) {
+	is := is.New(t)
+
+	player1 := defaultPlayers.Persons[0].Id
+	player2 := defaultPlayers.Persons[1].Id
+	player3 := defaultPlayers.Persons[2].Id
+	player4 := defaultPlayers.Persons[3].Id
+
+	roundControls := defaultRoundControls(defaultRounds)
+	for i := 0; i < defaultRounds; i++ {
+		roundControls[i].PairingMethod = pb.PairingMethod_ELIMINATION
+		roundControls[i].Consolation = true
+	}
+
+	// Consolation must be the same for every round
+	roundControls[1].Consolation = false
+	_, err := compactNewClassicDivision(defaultPlayers, roundControls, true)
+	is.True(err != nil)
+
+	roundControls[1].Consolation = true
+	tc, err := compactNewClassicDivision(defaultPlayers, roundControls, true)
+	is.NoErr(err)
+	is.True(tc.Bracket != nil)
+
+	err = tc.StartRound(true)
+	is.NoErr(err)
+
+	// Consolation can't be turned off once the bracket exists
+	_, err = tc.SetSingleRoundControls(1, &pb.RoundControl{FirstMethod: pb.FirstMethod_MANUAL_FIRST,
+		PairingMethod: pb.PairingMethod_ELIMINATION,
+		GamesPerRound: defaultGamesPerRound})
+	is.True(err != nil)
+
+	_, err = tc.SubmitResult(0, player1, player4, 500, 400,
+		pb.TournamentGameResult_WIN,
+		pb.TournamentGameResult_LOSS,
+		pb.GameEndReason_STANDARD, false, 0, "")
+	is.NoErr(err)
+	_, err = tc.SubmitResult(0, player3, player2, 500, 400,
+		pb.TournamentGameResult_WIN,
+		pb.TournamentGameResult_LOSS,
+		pb.GameEndReason_STANDARD, false, 0, "")
+	is.NoErr(err)
+
+	// Every player plays every round
+	o, err := tc.opponentOf(player1, 1)
+	is.NoErr(err)
+	is.Equal(o, player3)
+
+	o, err = tc.opponentOf(player4, 1)
+	is.NoErr(err)
+	is.Equal(o, player2)
+
+	_, err = tc.SubmitResult(1, player3, player1, 500, 400,
+		pb.TournamentGameResult_WIN,
+		pb.TournamentGameResult_LOSS,
+		pb.GameEndReason_STANDARD, false, 0, "")
+	is.NoErr(err)
+	_, err = tc.SubmitResult(1, player4, player2, 500, 400,
+		pb.TournamentGameResult_WIN,
+		pb.TournamentGameResult_LOSS,
+		pb.GameEndReason_STANDARD, false, 0, "")
+	is.NoErr(err)
+
+	bracket, err := tc.GetBracket()
+	is.NoErr(err)
+	is.Equal(bracket.Champion, player3)
+	is.Equal(bracket.Placements, []string{player3, player1, player4, player2})
+
+	standings, _, err := tc.GetStandings(1)
+	is.NoErr(err)
+	is.Equal(standings.Standings[0].PlayerId, player3)
+	is.Equal(standings.Standings[1].PlayerId, player1)
+	is.Equal(standings.Standings[2].PlayerId, player4)
+	is.Equal(standings.Standings[3].PlayerId, player2)
+}
+
+func TestClassicDivisionForfeitNoShows(t *testing.T) {
+	is := is.New(t)
+
+	roundControls := defaultRoundControls(defaultRounds)
+	roundControls[0].PairingMethod = pb.PairingMethod_KING_OF_THE_HILL
+	roundControls[0].ScheduledStartTime = timestamppb.New(time.Now().Add(-time.Hour))
+	roundControls[0].NoShowGraceSeconds = -1
+
+	_, err := compactNewClassicDivision(defaultPlayers, roundControls, true)
+	is.True(err != nil)
+
+	roundControls[0].NoShowGraceSeconds = 300
+	tc, err := compactNewClassicDivision(defaultPlayers, roundControls, true)
+	is.NoErr(err)
+
+	// Nothing is forfeited before the round starts
+	pm, err := tc.ForfeitNoShows(time.Now().Add(time.Hour))
+	is.NoErr(err)
+	is.Equal(len(pm.DivisionPairings), 0)
+	is.True(tc.NextScheduledTime().Equal(roundControls[0].ScheduledStartTime.AsTime()))
+
+	err = tc.StartRound(true)
+	is.NoErr(err)
+	is.True(tc.NextScheduledTime().Equal(time.Unix(tc.RoundStartTime, 0).Add(300 * time.Second)))
+
+	player1 := defaultPlayers.Persons[0].Id
+	player2 := defaultPlayers.Persons[1].Id
+	player3 := defaultPlayers.Persons[2].Id
+	player4 := defaultPlayers.Persons[3].Id
+
+	_, _, err = tc.SetReadyForGame(player1, "conn1", 0, 0, false)
+	is.NoErr(err)
+	_, _, err = tc.SetReadyForGame(player3, "conn3", 0, 0, false)
+	is.NoErr(err)
+	_, _, err = tc.SetReadyForGame(player4, "conn4", 0, 0, false)
+	is.NoErr(err)
+
+	// The round was scheduled an hour ago, but the grace
+	// period starts when the round actually started.
+	pm, err = tc.ForfeitNoShows(time.Now())
+	is.NoErr(err)
+	is.Equal(len(pm.DivisionPairings), 0)
+
+	pm, err = tc.ForfeitNoShows(time.Now().Add(10 * time.Minute))
+	is.NoErr(err)
+	is.True(len(pm.DivisionPairings) > 0)
+
+	// Player two never showed up
+	pairing, err := tc.getPairing(player1, 0)
+	is.NoErr(err)
+	player1Index := 0
+	if tc.Players.Persons[pairing.Players[1]].Id == player1 {
+		player1Index = 1
+	}
+	is.Equal(pairing.Outcomes[player1Index], pb.TournamentGameResult_FORFEIT_WIN)
+	is.Equal(pairing.Outcomes[1-player1Index], pb.TournamentGameResult_FORFEIT_LOSS)
+	is.Equal(pairing.Games[0].GameEndReason, pb.GameEndReason_FORCE_FORFEIT)
+
+	// Both players showed up, so their game is left alone
+	pairing, err = tc.getPairing(player3, 0)
+	is.NoErr(err)
+	is.Equal(pairing.Outcomes[0], pb.TournamentGameResult_NO_RESULT)
+	is.Equal(pairing.Outcomes[1], pb.TournamentGameResult_NO_RESULT)
+	is.True(tc.NextScheduledTime() == nil)
+
+	standings, _, err := tc.GetStandings(0)
+	is.NoErr(err)
+	for _, standing := range standings.Standings {
+		if standing.PlayerId == player2 {
+			is.Equal(standing.Losses, int32(1))
+			is.Equal(standing.Spread, int32(-50))
+		}
+	}
+}
+
+func TestClassicDivisionCheckIn(t *testing.T) {
+	is := is.New(t)
+
+	roundControls := defaultRoundControls(defaultRounds)
+	roundControls[0].PairingMethod = pb.PairingMethod_KING_OF_THE_HILL
+
+	tc, err := compactNewClassicDivision(defaultPlayers, roundControls, true)
+	is.NoErr(err)
+
+	deadline := time.Now().Add(time.Hour)
+	tc.DivisionControls.CheckInDeadline = timestamppb.New(deadline)
+	tc.DivisionControls.CheckInWindowSeconds = 600
+
+	player1 := defaultPlayers.Persons[0].Id
+	player2 := defaultPlayers.Persons[1].Id
+	player3 := defaultPlayers.Persons[2].Id
+	player4 := defaultPlayers.Persons[3].Id
+
+	// Check-in has not opened yet
+	err = tc.SetCheckedIn(player1, deadline.Add(-time.Hour))
+	is.True(err != nil)
+	is.True(tc.NextScheduledTime() == nil)
+
+	// The check-in is not enforced if no one checked in
+	missed, _, err := tc.EnforceCheckIn(deadline.Add(time.Second))
+	is.NoErr(err)
+	is.Equal(len(missed.Persons), 0)
+	is.True(!tc.CheckInEnforced)
+
+	for _, player := range []string{player1, player2, player3} {
+		err = tc.SetCheckedIn(player, deadline.Add(-5*time.Minute))
+		is.NoErr(err)
+	}
+	is.True(tc.NextScheduledTime().Equal(deadline))
+
+	missed, _, err = tc.EnforceCheckIn(deadline.Add(-time.Minute))
+	is.NoErr(err)
+	is.Equal(len(missed.Persons), 0)
+
+	missed, _, err = tc.EnforceCheckIn(deadline.Add(time.Second))
+	is.NoErr(err)
+	is.Equal(len(missed.Persons), 1)
+	is.Equal(missed.Persons[0].Id, player4)
+	is.True(tc.Players.Persons[tc.PlayerIndexMap[player4]].Suspended)
+	is.True(tc.NextScheduledTime() == nil)
+
+	// The first round is paired without the player who missed the check-in
+	opponent, err := tc.opponentOf(player4, 0)
+	is.NoErr(err)
+	is.Equal(opponent, player4)
+	opponent, err = tc.opponentOf(player1, 0)
+	is.NoErr(err)
+	is.Equal(opponent, player2)
+
+	// Check-in is closed and only enforced once
+	err = tc.SetCheckedIn(player4, deadline.Add(time.Minute))
+	is.True(err != nil)
+	missed, _, err = tc.EnforceCheckIn(deadline.Add(time.Minute))
+	is.NoErr(err)
+	is.Equal(len(missed.Persons), 0)
+
+	// Adding the player back before the tournament starts reinstates them
+	_, err = tc.AddPlayers(&pb.TournamentPersons{Persons: []*pb.TournamentPerson{{Id: player4, Rating: 2100}}})
+	is.NoErr(err)
+	is.Equal(len(tc.Players.Persons), 4)
+	is.True(!tc.Players.Persons[tc.PlayerIndexMap[player4]].Suspended)
+	opponent, err = tc.opponentOf(player4, 0)
+	is.NoErr(err)
+	is.True(opponent != player4)
+
+	// Players who miss the check-in can be removed instead
+	tc, err = compactNewClassicDivision(defaultPlayers, roundControls, true)
+	is.NoErr(err)
+	tc.DivisionControls.CheckInDeadline = timestamppb.New(deadline)
+	tc.DivisionControls.RemoveUncheckedIn = true
+
+	err = tc.SetCheckedIn(player1, deadline.Add(-time.Hour))
+	is.NoErr(err)
+	err = tc.SetCheckedIn(player2, deadline.Add(-time.Hour))
+	is.NoErr(err)
+
+	missed, _, err = tc.EnforceCheckIn(deadline)
+	is.NoErr(err)
+	is.Equal(len(missed.Persons), 2)
+	is.Equal(len(tc.Players.Persons), 2)
+	opponent, err = tc.opponentOf(player1, 0)
+	is.NoErr(err)
+	is.Equal(opponent, player2)
+
+	tc.ClearCheckedIn()
+	for _, player := range tc.Players.Persons {
+		is.True(!player.CheckedIn)
+	}
+}
+
 func TestClassicDivisionAddLatecomers(t *testing.T) {
 	is := is.New(t)
 
@@ -2504,6 +3128,384 @@ func TestClassicDivisionRemovePlayers(t *testing.T) {
 	is.NoErr(equalStandings(expectedstandings, standings))
 }
 
+func TestClassicDivisionWithdrawPlayers(t *testing.T) {
+	is := is.New(t)
+
+	roundControls := defaultRoundControls(4)
+	for _, control := range roundControls {
+		control.PairingMethod = pb.PairingMethod_KING_OF_THE_HILL
+	}
+
+	newDivision := func() *ClassicDivision {
+		tc, err := compactNewClassicDivision(defaultPlayersOdd, roundControls, true)
+		is.NoErr(err)
+		// Players who withdraw under the forfeit policy
+		// never get the suspended result.
+		tc.DivisionControls.SuspendedResult = pb.TournamentGameResult_BYE
+		err = tc.StartRound(true)
+		is.NoErr(err)
+		return tc
+	}
+
+	playRound := func(tc *ClassicDivision, round int) {
+		for _, player := range tc.Players.Persons {
+			pairing, err := tc.getPairing(player.Id, round)
+			is.NoErr(err)
+			if pairing.Outcomes[0] != pb.TournamentGameResult_NO_RESULT {
+				continue
+			}
+			opponent, err := tc.opponentOf(player.Id, round)
+			is.NoErr(err)
+			_, err = tc.SubmitResult(round, player.Id, opponent, 500, 400,
+				pb.TournamentGameResult_WIN,
+				pb.TournamentGameResult_LOSS,
+				pb.GameEndReason_STANDARD, false, 0, "")
+			is.NoErr(err)
+		}
+	}
+
+	withdrawn := defaultPlayersOdd.Persons[1].Id
+	withdrawnPersons := &pb.TournamentPersons{Persons: []*pb.TournamentPerson{{Id: withdrawn}}}
+
+	isInStandings := func(tc *ClassicDivision, round int) bool {
+		standings, _, err := tc.GetStandings(round)
+		is.NoErr(err)
+		for _, record := range standings.Standings {
+			if record.PlayerId == withdrawn {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Dropped players leave the standings
+	tc := newDivision()
+	playRound(tc, 0)
+	_, err := tc.WithdrawPlayers(withdrawnPersons, pb.WithdrawalPolicy_WITHDRAW_DROP)
+	is.NoErr(err)
+	is.True(!isInStandings(tc, 0))
+
+	// Players who forfeit the rest of the division stay in the standings
+	tc = newDivision()
+	playRound(tc, 0)
+	_, err = tc.WithdrawPlayers(withdrawnPersons, pb.WithdrawalPolicy_WITHDRAW_FORFEIT)
+	is.NoErr(err)
+	is.True(isInStandings(tc, 0))
+
+	// Their game in the current round is still played, but they
+	// forfeit the next round, which no one else gets a bye for.
+	playRound(tc, 1)
+	is.Equal(tc.GetCurrentRound(), 2)
+	pairing, err := tc.getPairing(withdrawn, 2)
+	is.NoErr(err)
+	is.Equal(pairing.Players[0], pairing.Players[1])
+	is.Equal(pairing.Outcomes[0], pb.TournamentGameResult_FORFEIT_LOSS)
+	for _, player := range tc.Players.Persons {
+		if player.Id != withdrawn {
+			isBye, err := tc.pairingIsBye(player.Id, 2)
+			is.NoErr(err)
+			is.True(!isBye)
+		}
+	}
+	is.True(isInStandings(tc, 2))
+	standings, _, err := tc.GetStandings(2)
+	is.NoErr(err)
+	is.Equal(standings.Standings[len(standings.Standings)-1].PlayerId, withdrawn)
+
+	// Players who are readmitted can have the rounds they missed turned into byes
+	_, err = tc.ReadmitPlayers(withdrawnPersons, -1)
+	is.True(err != nil)
+
+	// Nobody is readmitted if any of the players can't be
+	_, err = tc.ReadmitPlayers(&pb.TournamentPersons{Persons: []*pb.TournamentPerson{
+		{Id: withdrawn}, {Id: defaultPlayersOdd.Persons[0].Id}}}, 1)
+	is.True(err != nil)
+	is.True(tc.Players.Persons[tc.PlayerIndexMap[withdrawn]].Suspended)
+	pairing, err = tc.getPairing(withdrawn, 2)
+	is.NoErr(err)
+	is.Equal(pairing.Outcomes[0], pb.TournamentGameResult_FORFEIT_LOSS)
+
+	_, err = tc.ReadmitPlayers(withdrawnPersons, 1)
+	is.NoErr(err)
+	is.True(!tc.Players.Persons[tc.PlayerIndexMap[withdrawn]].Suspended)
+	is.Equal(tc.Players.Persons[tc.PlayerIndexMap[withdrawn]].WithdrawalPolicy, pb.WithdrawalPolicy_WITHDRAW_DROP)
+	pairing, err = tc.getPairing(withdrawn, 2)
+	is.NoErr(err)
+	is.Equal(pairing.Outcomes[0], pb.TournamentGameResult_BYE)
+	opponent, err := tc.opponentOf(withdrawn, 3)
+	is.NoErr(err)
+	is.True(opponent != "")
+
+	_, err = tc.ReadmitPlayers(withdrawnPersons, 1)
+	is.True(err != nil)
+}
+
+func TestClassicDivisionPairingReport(t *testing.T) {
+	is := is.New(t)
+
+	roundControls := defaultRoundControls(3)
+	roundControls[0].PairingMethod = pb.PairingMethod_SEEDED_FOLD
+	roundControls[1].PairingMethod = pb.PairingMethod_SEEDED_FOLD
+	roundControls[2].PairingMethod = pb.PairingMethod_SWISS
+
+	tc, err := compactNewClassicDivision(defaultPlayers, roundControls, true)
+	is.NoErr(err)
+	err = tc.StartRound(true)
+	is.NoErr(err)
+
+	report, err := tc.GetPairingReport()
+	is.NoErr(err)
+	is.Equal(len(report.Rounds), 1)
+	is.Equal(report.Rounds[0].Games, int32(2))
+
+	// The higher rated player always wins
+	for round := 0; round < 3; round++ {
+		for _, player := range tc.Players.Persons {
+			pairing, err := tc.getPairing(player.Id, round)
+			is.NoErr(err)
+			if pairing.Outcomes[0] != pb.TournamentGameResult_NO_RESULT {
+				continue
+			}
+			opponent, err := tc.opponentOf(player.Id, round)
+			is.NoErr(err)
+			_, err = tc.SubmitResult(round, player.Id, opponent, 500, 400,
+				pb.TournamentGameResult_WIN,
+				pb.TournamentGameResult_LOSS,
+				pb.GameEndReason_STANDARD, false, 0, "")
+			is.NoErr(err)
+		}
+	}
+
+	report, err = tc.GetPairingReport()
+	is.NoErr(err)
+	is.Equal(len(report.Rounds), 3)
+	is.Equal(report.Rounds[0].Repeats, int32(0))
+	is.Equal(report.Rounds[1].Repeats, int32(2))
+	is.Equal(report.Rounds[2].Repeats, int32(0))
+	is.Equal(report.Rounds[2].PairingMethod, pb.PairingMethod_SWISS)
+	is.Equal(report.Rounds[2].MismatchedGames, int32(0))
+
+	// Will played Jesse twice, then Josh
+	will := report.Players[tc.PlayerIndexMap[defaultPlayers.Persons[0].Id]]
+	is.Equal(will.PlayerId, defaultPlayers.Persons[0].Id)
+	is.Equal(will.Games, int32(3))
+	is.Equal(will.Repeats, int32(1))
+	is.Equal(will.Byes, int32(0))
+	is.Equal(will.Firsts+will.Seconds, int32(3))
+	is.Equal(will.AverageOpponentRating, 2400.0)
+	is.Equal(will.OpponentRatingVariance, 180000.0)
+}
+
+func TestRatePeriodGames(t *testing.T) {
+	is := is.New(t)
+
+	roundControls := defaultRoundControls(2)
+	roundControls[0].PairingMethod = pb.PairingMethod_SEEDED_FOLD
+	roundControls[1].PairingMethod = pb.PairingMethod_SEEDED_FOLD
+
+	tc, err := compactNewClassicDivision(defaultPlayers, roundControls, true)
+	is.NoErr(err)
+	err = tc.StartRound(true)
+	is.NoErr(err)
+
+	will := defaultPlayers.Persons[0].Id
+	josh := defaultPlayers.Persons[1].Id
+	conrad := defaultPlayers.Persons[2].Id
+	jesse := defaultPlayers.Persons[3].Id
+
+	// Will plays Jesse and Josh plays Conrad in both rounds. The
+	// second game between Josh and Conrad was not played on the site.
+	results := []struct {
+		round         int
+		winner, loser string
+		gameID        string
+		winnerScore   int
+		loserScore    int
+		gameEndReason pb.GameEndReason
+	}{
+		{0, will, jesse, "game1", 450, 350, pb.GameEndReason_STANDARD},
+		{0, josh, conrad, "game2", 400, 390, pb.GameEndReason_STANDARD},
+		{1, will, jesse, "game3", 300, 320, pb.GameEndReason_RESIGNED},
+		{1, josh, conrad, "", 400, 300, pb.GameEndReason_STANDARD},
+	}
+	for _, result := range results {
+		_, err = tc.SubmitResult(result.round, result.winner, result.loser, result.winnerScore, result.loserScore,
+			pb.TournamentGameResult_WIN,
+			pb.TournamentGameResult_LOSS,
+			result.gameEndReason, false, 0, result.gameID)
+		is.NoErr(err)
+	}
+
+	ratings := map[string]*entity.SingleRating{}
+	for _, person := range defaultPlayers.Persons {
+		ratings[person.Id] = &entity.SingleRating{
+			Rating:          float64(glicko.InitialRating),
+			RatingDeviation: float64(glicko.InitialRatingDeviation),
+			Volatility:      glicko.InitialVolatility,
+		}
+	}
+	ratings[jesse].Rating = 1600
+
+	divisionData, err := tc.GetXHRResponse()
+	is.NoErr(err)
+	projected := ratePeriodGames(divisionData, ratings, 1000)
+	is.Equal(len(projected), 4)
+
+	byPlayer := map[string]*tpb.ProjectedRating{}
+	for _, p := range projected {
+		byPlayer[p.UserId] = p
+	}
+	is.Equal(byPlayer[will].Games, int32(2))
+	is.Equal(byPlayer[jesse].Games, int32(2))
+	is.Equal(byPlayer[josh].Games, int32(1))
+	is.Equal(byPlayer[conrad].Games, int32(1))
+
+	// Both of Will's games are rated against Jesse's rating before the
+	// period, and the resignation counts as a win by the maximum spread.
+	rating, deviation, volatility := glicko.RatePeriod(1500, 350, glicko.InitialVolatility,
+		[]glicko.Result{
+			{OpponentRating: 1600, OpponentRatingDeviation: 350, Spread: 100},
+			{OpponentRating: 1600, OpponentRatingDeviation: 350, Spread: glicko.SpreadScaling},
+		}, 0)
+	is.Equal(byPlayer[will].Rating, 1500.0)
+	is.Equal(byPlayer[will].NewRating, rating)
+	is.Equal(byPlayer[will].NewRatingDeviation, deviation)
+	is.Equal(byPlayer[will].NewVolatility, volatility)
+	is.True(byPlayer[jesse].NewRating < 1600)
+	is.True(byPlayer[josh].NewRating > 1500)
+	is.True(byPlayer[conrad].NewRating < 1500)
+
+	// A finished division whose ratings could not be saved is picked up
+	// by the scheduler, even though the tournament is over.
+	tc.DivisionControls.RateAsPeriod = true
+	finished, err := tc.IsFinished()
+	is.NoErr(err)
+	is.True(finished)
+	divisionObject := &entity.TournamentDivision{DivisionManager: tc}
+	tournament := &entity.Tournament{IsFinished: true,
+		Divisions: map[string]*entity.TournamentDivision{"A": divisionObject}}
+	now := time.Unix(1000, 0)
+	is.True(ratingPeriodPending(divisionObject))
+	is.Equal(*NextScheduledAt(tournament, now), now)
+	divisionObject.RatingPeriodApplied = true
+	is.True(NextScheduledAt(tournament, now) == nil)
+}
+
+func TestPromoteAndRelegate(t *testing.T) {
+	is := is.New(t)
+
+	division := func(names ...string) []*pb.TournamentPerson {
+		persons := []*pb.TournamentPerson{}
+		for _, name := range names {
+			persons = append(persons, &pb.TournamentPerson{Id: name})
+		}
+		return persons
+	}
+	names := func(persons []*pb.TournamentPerson) []string {
+		ids := []string{}
+		for _, person := range persons {
+			ids = append(ids, person.Id)
+		}
+		return ids
+	}
+
+	moved := promoteAndRelegate([][]*pb.TournamentPerson{
+		division("a1", "a2", "a3", "a4"),
+		division("b1", "b2", "b3", "b4"),
+		division("c1", "c2", "c3"),
+	}, 1, 2)
+	is.Equal(len(moved), 3)
+	is.Equal(names(moved[0]), []string{"a1", "a2", "b1"})
+	is.Equal(names(moved[1]), []string{"a3", "a4", "b2", "c1"})
+	is.Equal(names(moved[2]), []string{"b3", "b4", "c2", "c3"})
+
+	// Promotions come first when a division is too small for both
+	moved = promoteAndRelegate([][]*pb.TournamentPerson{
+		division("a1", "a2"),
+		division("b1", "b2"),
+		division("c1"),
+	}, 1, 2)
+	is.Equal(names(moved[0]), []string{"b1"})
+	is.Equal(names(moved[1]), []string{"a1", "a2", "c1"})
+	is.Equal(names(moved[2]), []string{"b2"})
+
+	// Nobody moves
+	moved = promoteAndRelegate([][]*pb.TournamentPerson{
+		division("a1", "a2"),
+		division("b1", "b2"),
+	}, 0, 0)
+	is.Equal(names(moved[0]), []string{"a1", "a2"})
+	is.Equal(names(moved[1]), []string{"b1", "b2"})
+}
+
+func TestMoveUpLadder(t *testing.T) {
+	is := is.New(t)
+
+	ladder := &pb.Ladder{}
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		ladder.Players = append(ladder.Players, &pb.TournamentPerson{Id: name})
+	}
+	ranks := func() []string {
+		ids := []string{}
+		for _, player := range ladder.Players {
+			ids = append(ids, player.Id)
+		}
+		return ids
+	}
+
+	moveUpLadder(ladder, "d", "b")
+	is.Equal(ranks(), []string{"a", "d", "b", "c", "e"})
+
+	moveUpLadder(ladder, "e", "c")
+	is.Equal(ranks(), []string{"a", "d", "b", "e", "c"})
+
+	// Beating a lower ranked player changes nothing
+	moveUpLadder(ladder, "a", "d")
+	is.Equal(ranks(), []string{"a", "d", "b", "e", "c"})
+
+	moveUpLadder(ladder, "c", "nobody")
+	is.Equal(ranks(), []string{"a", "d", "b", "e", "c"})
+
+	is.Equal(ladderRank(ladder, "e"), 3)
+	is.Equal(ladderRank(ladder, "nobody"), -1)
+}
+
+func TestClassicDivisionAccelerationMethod(t *testing.T) {
+	is := is.New(t)
+
+	// Factor pairings don't use the virtual points
+	roundControls := defaultRoundControls(2)
+	roundControls[1].PairingMethod = pb.PairingMethod_FACTOR
+	roundControls[1].Accelerated = true
+	_, err := compactNewClassicDivision(defaultPlayers, roundControls, true)
+	is.True(err != nil)
+
+	roundControls[1].PairingMethod = pb.PairingMethod_SWISS
+	_, err = compactNewClassicDivision(defaultPlayers, roundControls, true)
+	is.NoErr(err)
+}
+
+func TestOpenLadderChallenge(t *testing.T) {
+	is := is.New(t)
+
+	ladder := &pb.Ladder{Challenges: []*pb.LadderChallenge{
+		{Id: "open", Challenger: "b", Challenged: "a"},
+		{Id: "accepted", Challenger: "d", Challenged: "c", AcceptedAt: timestamppb.Now()},
+		{Id: "playing", Challenger: "f", Challenged: "e", GameId: "game"},
+	}}
+
+	is.Equal(openLadderChallenge(ladder, "open").Challenger, "b")
+	// Accepted challenges can't be accepted or declined again
+	is.True(openLadderChallenge(ladder, "accepted") == nil)
+	is.True(openLadderChallenge(ladder, "playing") == nil)
+	is.True(openLadderChallenge(ladder, "nothing") == nil)
+
+	removeLadderChallenge(ladder, "accepted")
+	is.Equal(len(ladder.Challenges), 2)
+	is.True(ladderChallengeOf(ladder, "c") == nil)
+	is.Equal(ladderChallengeOf(ladder, "e").Id, "playing")
+}
+
 func TestClassicDivisionRemovePlayersFactorPair(t *testing.T) {
 
 	is := is.New(t)
@@ -4300,6 +5302,7 @@ func compactNewClassicDivision(players *pb.TournamentPersons, roundControls []*p
 
 	for _, player := range players.Persons {
 		player.Suspended = false
+		player.CheckedIn = false
 	}
 
 	_, err := t.AddPlayers(players)
@@ -4355,18 +5358,6 @@ func printStandings(standings []*pb.PlayerStanding) {
 	}
 }
 
-func logTwo(n int) int {
-	res := 0
-	for n > 1 {
-		if n%2 != 0 {
-			return -1
-		}
-		res++
-		n = n / 2
-	}
-	return res
-}
-
 func newDivisionControls() *pb.DivisionControls {
 	return &pb.DivisionControls{
 		GameRequest: &pb.GameRequest{Lexicon: "CSW21",
@@ -4420,3 +5411,67 @@ func makeTournamentPersons(persons map[string]int32) *pb.TournamentPersons {
 	sort.Sort(PlayerSorter(tp.Persons))
 	return tp
 }
+
+func TestDivisionPrizeAwards(t *testing.T) {
+	is := is.New(t)
+
+	persons := &pb.TournamentPersons{Persons: []*pb.TournamentPerson{
+		{Id: "Will", Rating: 2000},
+		{Id: "Josh", Rating: 1900},
+		{Id: "Conrad", Rating: 1400},
+		{Id: "Jesse", Rating: 1300},
+		{Id: "Matt", Rating: 1200, Suspended: true},
+	}}
+	standings := []*pb.PlayerStanding{
+		{PlayerId: "Will", Wins: 2, Spread: 150},
+		{PlayerId: "Josh", Wins: 2, Spread: 100},
+		{PlayerId: "Matt", Wins: 1, Spread: 50},
+		{PlayerId: "Conrad", Wins: 1, Spread: 20},
+		{PlayerId: "Jesse", Wins: 0, Spread: -270},
+	}
+	win, loss := pb.TournamentGameResult_WIN, pb.TournamentGameResult_LOSS
+	divisionData := &pb.TournamentDivisionDataResponse{
+		Players: persons,
+		PairingMap: map[string]*pb.Pairing{
+			"a": {Players: []int32{0, 2}, Games: []*pb.TournamentGame{
+				{Scores: []int32{520, 400}, Results: []pb.TournamentGameResult{win, loss}}}},
+			"b": {Players: []int32{1, 3}, Games: []*pb.TournamentGame{
+				{Scores: []int32{450, 430}, Results: []pb.TournamentGameResult{win, loss}}}},
+			// Forfeited games do not count for high game.
+			"c": {Players: []int32{0, 3}, Games: []*pb.TournamentGame{
+				{Scores: []int32{600, 0}, Results: []pb.TournamentGameResult{win, loss},
+					GameEndReason: pb.GameEndReason_FORCE_FORFEIT}}},
+			"d": {Players: []int32{2, 3}, Games: []*pb.TournamentGame{
+				{Scores: []int32{430, 450}, Results: []pb.TournamentGameResult{loss, win}}}},
+		},
+	}
+	prizes := []*pb.Prize{
+		{Type: pb.PrizeType_PLACE_PRIZE, Place: 1, Amount: 10000},
+		{Type: pb.PrizeType_PLACE_PRIZE, Place: 2, Amount: 5000},
+		{Type: pb.PrizeType_CLASS_PRIZE, Place: 1, RatingCeiling: 1500, Amount: 2000},
+		{Type: pb.PrizeType_HIGH_GAME_PRIZE, Amount: 1000},
+		{Type: pb.PrizeType_HIGH_LOSS_PRIZE, Amount: 1001},
+	}
+
+	awards := divisionPrizeAwards(divisionName, prizes, pb.PrizeTieRule_SPLIT_BY_WINS_AND_SPREAD, standings, divisionData)
+	is.Equal(len(awards), 5)
+	is.Equal(awards[0].PlayerIds, []string{"Will"})
+	is.Equal(awards[0].Amounts, []int64{10000})
+	is.Equal(awards[1].PlayerIds, []string{"Josh"})
+	// Matt was removed, so Conrad is the best placed player in the class.
+	is.Equal(awards[2].PlayerIds, []string{"Conrad"})
+	is.Equal(awards[3].PlayerIds, []string{"Will"})
+	is.Equal(awards[3].Score, int32(520))
+	// Tied players split the prize in seed order, and the higher seed
+	// gets what is left over.
+	is.Equal(awards[4].PlayerIds, []string{"Conrad", "Jesse"})
+	is.Equal(awards[4].Score, int32(430))
+	is.Equal(awards[4].Amounts, []int64{501, 500})
+
+	// Splitting by wins, Will and Josh share the first two places.
+	awards = divisionPrizeAwards(divisionName, prizes, pb.PrizeTieRule_SPLIT_BY_WINS, standings, divisionData)
+	is.Equal(awards[0].PlayerIds, []string{"Will", "Josh"})
+	is.Equal(awards[0].Amounts, []int64{5000, 5000})
+	is.Equal(awards[1].PlayerIds, []string{"Will", "Josh"})
+	is.Equal(awards[1].Amounts, []int64{2500, 2500})
+}
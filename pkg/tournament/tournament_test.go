@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/matryer/is"
 	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/domino14/liwords/pkg/config"
 	"github.com/domino14/liwords/pkg/entity"
@@ -382,7 +385,7 @@ func TestTournamentSingleDivision(t *testing.T) {
 
 	// Start the tournament
 
-	err = tournament.StartAllRoundCountdowns(ctx, tstore, ty.UUID, 0)
+	err = tournament.StartAllRoundCountdowns(ctx, tstore, us, ty.UUID, 0)
 	is.NoErr(err)
 
 	// Attempt to add a division after the tournament has started
@@ -627,7 +630,7 @@ func TestTournamentMultipleDivisions(t *testing.T) {
 
 	// Start the tournament
 
-	err = tournament.StartAllRoundCountdowns(ctx, tstore, ty.UUID, 0)
+	err = tournament.StartAllRoundCountdowns(ctx, tstore, us, ty.UUID, 0)
 	is.NoErr(err)
 
 	err = tournament.SetResult(ctx,
@@ -715,6 +718,495 @@ func TestTournamentMultipleDivisions(t *testing.T) {
 	gs.(*game.Cache).Disconnect()
 }
 
+func TestTournamentRegistration(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+	recreateDB()
+	us := userStore()
+	_, gs := gameStore(us)
+	cfg, tstore := tournamentStore(gs)
+
+	directors := makeTournamentPersons(map[string]int32{"Kieran:Kieran": 0, "Vince:Vince": 2})
+	ty, err := makeTournament(ctx, tstore, cfg, directors)
+	is.NoErr(err)
+
+	err = tournament.AddDivision(ctx, tstore, ty.UUID, divOneName)
+	is.NoErr(err)
+
+	now := time.Now()
+	registered := func(userID string, expected pb.RegistrationStatus) {
+		status, err := tournament.Register(ctx, tstore, us, ty.UUID, divOneName, userID, now)
+		is.NoErr(err)
+		is.Equal(status, expected)
+	}
+
+	controls := makeControls()
+	err = tournament.SetDivisionControls(ctx, tstore, ty.UUID, divOneName, controls)
+	is.NoErr(err)
+
+	// Registration is closed by default
+	status, err := tournament.Register(ctx, tstore, us, ty.UUID, divOneName, "Will", now)
+	is.Equal(err.Error(), entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_REGISTRATION_CLOSED, tournamentName, divOneName).Error())
+	is.Equal(status, pb.RegistrationStatus_REGISTRATION_UNSPECIFIED)
+
+	// New players have the initial rating, which is above this ceiling
+	controls = makeControls()
+	controls.OpenRegistration = true
+	controls.RatingCeiling = 1000
+	err = tournament.SetDivisionControls(ctx, tstore, ty.UUID, divOneName, controls)
+	is.NoErr(err)
+	_, err = tournament.Register(ctx, tstore, us, ty.UUID, divOneName, "Will", now)
+	is.True(err != nil)
+
+	controls = makeControls()
+	controls.OpenRegistration = true
+	controls.RatingFloor = 1000
+	controls.RatingCeiling = 900
+	err = tournament.SetDivisionControls(ctx, tstore, ty.UUID, divOneName, controls)
+	is.True(err != nil)
+
+	controls = makeControls()
+	controls.OpenRegistration = true
+	controls.MaxPlayers = 2
+	err = tournament.SetDivisionControls(ctx, tstore, ty.UUID, divOneName, controls)
+	is.NoErr(err)
+
+	registered("Will", pb.RegistrationStatus_REGISTERED)
+	registered("Josh", pb.RegistrationStatus_REGISTERED)
+	registered("Conrad", pb.RegistrationStatus_WAITLISTED)
+
+	_, err = tournament.Register(ctx, tstore, us, ty.UUID, divOneName, "Will", now)
+	is.True(err != nil)
+	_, err = tournament.Register(ctx, tstore, us, ty.UUID, divOneName, "Conrad", now)
+	is.True(err != nil)
+
+	registrations, err := tournament.GetRegistrations(ctx, tstore, ty.UUID, divOneName)
+	is.NoErr(err)
+	is.Equal(len(registrations.Waitlist), 1)
+	is.Equal(registrations.Waitlist[0].Id, "Conrad:Conrad")
+
+	// Conrad takes the spot that Josh gives up
+	err = tournament.Unregister(ctx, tstore, us, ty.UUID, divOneName, "Josh")
+	is.NoErr(err)
+	is.NoErr(equalTournamentPersons(makeTournamentPersons(map[string]int32{"Will:Will": 1500, "Conrad:Conrad": 1500}),
+		ty.Divisions[divOneName].DivisionManager.GetPlayers()))
+
+	registrations, err = tournament.GetRegistrations(ctx, tstore, ty.UUID, divOneName)
+	is.NoErr(err)
+	is.Equal(len(registrations.Waitlist), 0)
+
+	err = tournament.Unregister(ctx, tstore, us, ty.UUID, divOneName, "Josh")
+	is.True(err != nil)
+
+	controls = makeControls()
+	controls.OpenRegistration = true
+	controls.MaxPlayers = 4
+	controls.RegistrationDeadline = timestamppb.New(now.Add(-time.Minute))
+	err = tournament.SetDivisionControls(ctx, tstore, ty.UUID, divOneName, controls)
+	is.NoErr(err)
+	_, err = tournament.Register(ctx, tstore, us, ty.UUID, divOneName, "Jesse", now)
+	is.Equal(err.Error(), entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_REGISTRATION_DEADLINE_PASSED, tournamentName, divOneName).Error())
+
+	controls.RegistrationDeadline = nil
+	controls.RegistrationRequiresApproval = true
+	err = tournament.SetDivisionControls(ctx, tstore, ty.UUID, divOneName, controls)
+	is.NoErr(err)
+
+	registered("Jesse", pb.RegistrationStatus_PENDING_APPROVAL)
+	registered("Guy", pb.RegistrationStatus_PENDING_APPROVAL)
+
+	// Only pending registrations can be approved
+	err = tournament.ApproveRegistrations(ctx, tstore, us, ty.UUID, divOneName, makeTournamentPersons(map[string]int32{"Jesse": 0, "Josh": 0}))
+	is.True(err != nil)
+
+	err = tournament.ApproveRegistrations(ctx, tstore, us, ty.UUID, divOneName, makeTournamentPersons(map[string]int32{"Jesse": 0}))
+	is.NoErr(err)
+	err = tournament.RejectRegistrations(ctx, tstore, us, ty.UUID, divOneName, makeTournamentPersons(map[string]int32{"Guy": 0}))
+	is.NoErr(err)
+
+	is.NoErr(equalTournamentPersons(makeTournamentPersons(map[string]int32{"Will:Will": 1500, "Conrad:Conrad": 1500, "Jesse:Jesse": 1500}),
+		ty.Divisions[divOneName].DivisionManager.GetPlayers()))
+
+	registrations, err = tournament.GetRegistrations(ctx, tstore, ty.UUID, divOneName)
+	is.NoErr(err)
+	is.Equal(len(registrations.Pending), 0)
+
+	us.(*user.DBStore).Disconnect()
+	tstore.(*ts.Cache).Disconnect()
+	gs.(*game.Cache).Disconnect()
+}
+
+func TestClubMembership(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+	recreateDB()
+	us := userStore()
+	_, gs := gameStore(us)
+	_, tstore := tournamentStore(gs)
+
+	directors := makeTournamentPersons(map[string]int32{"Kieran:Kieran": 0})
+	club, err := tournament.NewTournament(ctx, tstore, "Club", "This is a test club", directors,
+		entity.TypeClub, "", "/club/test")
+	is.NoErr(err)
+	session, err := tournament.NewTournament(ctx, tstore, "Club Session", "This is a test club session", directors,
+		entity.TypeChild, club.UUID, "/club/test/session")
+	is.NoErr(err)
+
+	err = tournament.AddDivision(ctx, tstore, session.UUID, divOneName)
+	is.NoErr(err)
+	controls := makeControls()
+	controls.OpenRegistration = true
+	err = tournament.SetDivisionControls(ctx, tstore, session.UUID, divOneName, controls)
+	is.NoErr(err)
+
+	now := time.Now()
+	err = tournament.AddClubMembers(ctx, tstore, us, club.UUID, []*ipc.ClubMember{
+		{Id: "Will"}, {Id: "Josh", Role: ipc.ClubRole_CLUB_OFFICER}}, now)
+	is.NoErr(err)
+	// Adding a member again changes their role.
+	err = tournament.AddClubMembers(ctx, tstore, us, club.UUID, []*ipc.ClubMember{
+		{Id: "Will", Role: ipc.ClubRole_CLUB_OFFICER}}, now.Add(time.Hour))
+	is.NoErr(err)
+
+	roster, err := tournament.GetClub(ctx, tstore, club.UUID)
+	is.NoErr(err)
+	is.Equal(len(roster.Members), 2)
+	is.Equal(roster.Members[0].Id, "Will:Will")
+	is.Equal(roster.Members[0].Role, ipc.ClubRole_CLUB_OFFICER)
+	is.Equal(roster.Members[0].JoinedAt.AsTime().Unix(), now.Unix())
+
+	// Sessions are open to everyone until they are for members only.
+	status, err := tournament.Register(ctx, tstore, us, session.UUID, divOneName, "Conrad", now)
+	is.NoErr(err)
+	is.Equal(status, pb.RegistrationStatus_REGISTERED)
+
+	err = tournament.SetClubControls(ctx, tstore, club.UUID, true)
+	is.NoErr(err)
+	_, err = tournament.Register(ctx, tstore, us, session.UUID, divOneName, "Jesse", now)
+	is.Equal(err.Error(), entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_CLUB_MEMBERS_ONLY, "Club Session", divOneName, "Club", "Jesse:Jesse").Error())
+	status, err = tournament.Register(ctx, tstore, us, session.UUID, divOneName, "Will", now)
+	is.NoErr(err)
+	is.Equal(status, pb.RegistrationStatus_REGISTERED)
+
+	err = tournament.RemoveClubMembers(ctx, tstore, us, club.UUID, []*ipc.ClubMember{{Id: "Jesse"}})
+	is.Equal(err.Error(), entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NONEXISTENT_CLUB_MEMBER, "Club", "", "Jesse:Jesse").Error())
+	err = tournament.RemoveClubMembers(ctx, tstore, us, club.UUID, []*ipc.ClubMember{{Id: "Josh"}})
+	is.NoErr(err)
+	_, err = tournament.Register(ctx, tstore, us, session.UUID, divOneName, "Josh", now)
+	is.True(err != nil)
+	// Directors cannot add players who are not members either.
+	err = tournament.AddPlayers(ctx, tstore, us, session.UUID, divOneName, makeTournamentPersons(map[string]int32{"Josh": 1000}))
+	is.Equal(err.Error(), entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_CLUB_MEMBERS_ONLY, "Club Session", divOneName, "Club", "Josh:Josh").Error())
+
+	// Sessions are not clubs.
+	_, err = tournament.GetClub(ctx, tstore, session.UUID)
+	is.True(err != nil)
+
+	stats, err := tournament.GetClubStats(ctx, tstore, club.UUID, 0)
+	is.NoErr(err)
+	is.Equal(len(stats.Sessions), 1)
+	is.Equal(stats.Sessions[0].Players, int32(2))
+	is.Equal(len(stats.MostActive), 1)
+	is.Equal(stats.MostActive[0].UserId, "Will:Will")
+	is.Equal(stats.MostActive[0].Sessions, int32(1))
+
+	// No games have been played yet.
+	ratings, err := tournament.GetClubRatings(ctx, tstore, club.UUID, 0, 0)
+	is.NoErr(err)
+	is.Equal(len(ratings.Ratings), 0)
+	is.Equal(ratings.Total, int32(0))
+
+	us.(*user.DBStore).Disconnect()
+	tstore.(*ts.Cache).Disconnect()
+	gs.(*game.Cache).Disconnect()
+}
+
+func TestClubSessionSchedule(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+	recreateDB()
+	us := userStore()
+	_, gs := gameStore(us)
+	_, tstore := tournamentStore(gs)
+
+	directors := makeTournamentPersons(map[string]int32{"Kieran:Kieran": 0})
+	club, err := tournament.NewTournament(ctx, tstore, "Club", "This is a test club", directors,
+		entity.TypeClub, "", "/club/test")
+	is.NoErr(err)
+
+	chicago, err := time.LoadLocation("America/Chicago")
+	is.NoErr(err)
+	templateStart := time.Date(2022, time.October, 4, 19, 0, 0, 0, chicago)
+	roundControls := makeRoundControls()
+	roundControls[0].ScheduledStartTime = timestamppb.New(templateStart)
+	roundControls[1].ScheduledStartTime = timestamppb.New(templateStart.Add(time.Hour))
+	template := &ipc.ClubSessionTemplate{
+		Divisions: []*ipc.ClubSessionTemplateDivision{
+			{Name: divOneName, Controls: makeControls(), RoundControls: roundControls},
+			{Name: divTwoName, Controls: makeControls()},
+		},
+	}
+	err = tournament.SetClubSessionTemplate(ctx, tstore, club.UUID, template)
+	is.NoErr(err)
+
+	err = tournament.SetClubSessionSchedule(ctx, tstore, club.UUID,
+		&ipc.ClubSessionSchedule{Weekday: 2, Hour: 19, TimeZone: "Mars/Olympus_Mons"})
+	is.Equal(err.Error(), entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_INVALID_CLUB_SESSION_SCHEDULE, "Club", "").Error())
+	err = tournament.SetClubSessionSchedule(ctx, tstore, club.UUID,
+		&ipc.ClubSessionSchedule{Weekday: 2, Hour: 19, TimeZone: "America/Chicago", LeadHours: 24})
+	is.NoErr(err)
+
+	sessions := func() []*pb.ClubSessionResponse {
+		resp, err := tstore.GetRecentClubSessions(ctx, club.UUID, 10, 0)
+		is.NoErr(err)
+		return resp.Sessions
+	}
+
+	// The store schedules the club from the current time,
+	// so the first session is on the coming Tuesday.
+	local := time.Now().In(chicago)
+	sessionStart := time.Date(local.Year(), local.Month(), local.Day()+(int(time.Tuesday)-int(local.Weekday())+7)%7,
+		19, 0, 0, 0, chicago)
+	if !sessionStart.After(local) {
+		sessionStart = sessionStart.AddDate(0, 0, 7)
+	}
+
+	// The Tuesday session is not created until the day before.
+	err = tournament.RunScheduledRounds(ctx, tstore, us, sessionStart.Add(-25*time.Hour))
+	is.NoErr(err)
+	is.Equal(len(sessions()), 0)
+
+	now := sessionStart.Add(-23 * time.Hour)
+
+	// A session that cannot be set up is deleted and created again later.
+	brokenControls := makeControls()
+	brokenControls.MaxPlayers = -1
+	err = tournament.SetClubSessionTemplate(ctx, tstore, club.UUID, &ipc.ClubSessionTemplate{
+		Divisions: []*ipc.ClubSessionTemplateDivision{{Name: divOneName, Controls: brokenControls}}})
+	is.NoErr(err)
+	err = tournament.RunScheduledRounds(ctx, tstore, us, now)
+	is.NoErr(err)
+	is.Equal(len(sessions()), 0)
+	err = tournament.SetClubSessionTemplate(ctx, tstore, club.UUID, template)
+	is.NoErr(err)
+
+	err = tournament.RunScheduledRounds(ctx, tstore, us, now)
+	is.NoErr(err)
+	err = tournament.RunScheduledRounds(ctx, tstore, us, now.Add(time.Minute))
+	is.NoErr(err)
+	is.Equal(len(sessions()), 1)
+
+	session, err := tstore.Get(ctx, sessions()[0].TournamentId)
+	is.NoErr(err)
+	is.Equal(session.ParentID, club.UUID)
+	is.Equal(len(session.Divisions), 2)
+	sessionRounds := session.Divisions[divOneName].DivisionManager.GetRoundControls()
+	is.True(sessionRounds[0].ScheduledStartTime.AsTime().Equal(sessionStart))
+	is.True(sessionRounds[1].ScheduledStartTime.AsTime().Equal(sessionStart.Add(time.Hour)))
+
+	// The following week's session is created a week later.
+	err = tournament.RunScheduledRounds(ctx, tstore, us, now.Add(6*24*time.Hour))
+	is.NoErr(err)
+	is.Equal(len(sessions()), 1)
+	err = tournament.RunScheduledRounds(ctx, tstore, us, now.Add(7*24*time.Hour))
+	is.NoErr(err)
+	is.Equal(len(sessions()), 2)
+
+	us.(*user.DBStore).Disconnect()
+	tstore.(*ts.Cache).Disconnect()
+	gs.(*game.Cache).Disconnect()
+}
+
+func TestTournamentAuditLog(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+	recreateDB()
+	us := userStore()
+	_, gs := gameStore(us)
+	cfg, tstore := tournamentStore(gs)
+
+	directors := makeTournamentPersons(map[string]int32{"Kieran:Kieran": 0, "Vince:Vince": 2})
+	ty, err := makeTournament(ctx, tstore, cfg, directors)
+	is.NoErr(err)
+
+	err = tournament.AddDivision(ctx, tstore, ty.UUID, divOneName)
+	is.NoErr(err)
+
+	players := makeTournamentPersons(map[string]int32{"Will": 1000, "Josh": 3000})
+	err = tournament.AuditedAction(ctx, tstore, "Kieran:Kieran", ty.UUID, divOneName, "AddPlayers", players, func(ctx context.Context) error {
+		return tournament.AddPlayers(ctx, tstore, us, ty.UUID, divOneName, players)
+	})
+	is.NoErr(err)
+
+	// Failed actions are not recorded
+	err = tournament.AuditedAction(ctx, tstore, "Vince:Vince", ty.UUID, divOneName, "AddPlayers", players, func(ctx context.Context) error {
+		return tournament.AddPlayers(ctx, tstore, us, ty.UUID, divOneName, players)
+	})
+	is.True(err != nil)
+
+	controls := makeControls()
+	err = tournament.AuditedAction(ctx, tstore, "Vince:Vince", ty.UUID, divOneName, "SetDivisionControls", controls, func(ctx context.Context) error {
+		return tournament.SetDivisionControls(ctx, tstore, ty.UUID, divOneName, controls)
+	})
+	is.NoErr(err)
+
+	auditLog, err := tstore.GetAuditLog(ctx, ty.UUID, 10, 0)
+	is.NoErr(err)
+	is.Equal(len(auditLog.Entries), 2)
+
+	// Most recent first
+	is.Equal(auditLog.Entries[0].Actor, "Vince:Vince")
+	is.Equal(auditLog.Entries[0].Action, "SetDivisionControls")
+	is.True(strings.Contains(auditLog.Entries[0].Diff, "divisionControls"))
+	is.True(!strings.Contains(auditLog.Entries[0].Diff, "players"))
+
+	is.Equal(auditLog.Entries[1].Actor, "Kieran:Kieran")
+	is.Equal(auditLog.Entries[1].Action, "AddPlayers")
+	is.Equal(auditLog.Entries[1].Division, divOneName)
+	is.True(strings.Contains(auditLog.Entries[1].Payload, "Josh"))
+	is.True(strings.Contains(auditLog.Entries[1].Diff, "players"))
+
+	auditLog, err = tstore.GetAuditLog(ctx, ty.UUID, 10, 1)
+	is.NoErr(err)
+	is.Equal(len(auditLog.Entries), 1)
+	is.Equal(auditLog.Entries[0].Action, "AddPlayers")
+}
+
+func TestAuditedActionsAreRecorded(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+	recreateDB()
+	us := userStore()
+	_, gs := gameStore(us)
+	cfg, tstore := tournamentStore(gs)
+
+	directors := makeTournamentPersons(map[string]int32{"Kieran:Kieran": 0})
+	ty, err := makeTournament(ctx, tstore, cfg, directors)
+	is.NoErr(err)
+	err = tournament.AddDivision(ctx, tstore, ty.UUID, divOneName)
+	is.NoErr(err)
+	controls := makeControls()
+	controls.OpenRegistration = true
+	err = tournament.SetDivisionControls(ctx, tstore, ty.UUID, divOneName, controls)
+	is.NoErr(err)
+
+	// Every audited action has to lock the tournament with lockTournament,
+	// or it leaves no entry in the audit log.
+	entries := 0
+	audited := func(action string, mutate func(ctx context.Context) error) {
+		err := tournament.AuditedAction(ctx, tstore, "Kieran:Kieran", ty.UUID, divOneName, action,
+			&pb.GetTournamentRequest{Id: ty.UUID}, mutate)
+		is.NoErr(err)
+		entries++
+		auditLog, err := tstore.GetAuditLog(ctx, ty.UUID, tournament.MaxAuditLogEntries, 0)
+		is.NoErr(err)
+		is.Equal(len(auditLog.Entries), entries)
+		is.Equal(auditLog.Entries[0].Action, action)
+	}
+
+	audited("Register", func(ctx context.Context) error {
+		_, err := tournament.Register(ctx, tstore, us, ty.UUID, divOneName, "Conrad", time.Now())
+		return err
+	})
+	var snapshot *pb.TournamentSnapshot
+	audited("TakeSnapshot", func(ctx context.Context) error {
+		snapshot, err = tournament.TakeSnapshot(ctx, tstore, ty.UUID, "Kieran:Kieran", "Registered")
+		return err
+	})
+	audited("Unregister", func(ctx context.Context) error {
+		return tournament.Unregister(ctx, tstore, us, ty.UUID, divOneName, "Conrad")
+	})
+	audited("RestoreSnapshot", func(ctx context.Context) error {
+		return tournament.RestoreSnapshot(ctx, tstore, ty.UUID, snapshot.Id)
+	})
+
+	us.(*user.DBStore).Disconnect()
+	tstore.(*ts.Cache).Disconnect()
+	gs.(*game.Cache).Disconnect()
+}
+
+func TestTournamentSnapshots(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+	recreateDB()
+	us := userStore()
+	_, gs := gameStore(us)
+	cfg, tstore := tournamentStore(gs)
+
+	directors := makeTournamentPersons(map[string]int32{"Kieran:Kieran": 0, "Vince:Vince": 2})
+	ty, err := makeTournament(ctx, tstore, cfg, directors)
+	is.NoErr(err)
+
+	err = tournament.AddDivision(ctx, tstore, ty.UUID, divOneName)
+	is.NoErr(err)
+	err = tournament.SetDivisionControls(ctx, tstore, ty.UUID, divOneName, makeControls())
+	is.NoErr(err)
+	err = tournament.SetRoundControls(ctx, tstore, ty.UUID, divOneName, makeRoundControls())
+	is.NoErr(err)
+	err = tournament.AddPlayers(ctx, tstore, us, ty.UUID, divOneName,
+		makeTournamentPersons(map[string]int32{"Will": 1000, "Josh": 3000}))
+	is.NoErr(err)
+
+	snapshot, err := tournament.TakeSnapshot(ctx, tstore, ty.UUID, "Kieran:Kieran", "Two players")
+	is.NoErr(err)
+
+	err = tournament.AddPlayers(ctx, tstore, us, ty.UUID, divOneName,
+		makeTournamentPersons(map[string]int32{"Conrad": 2000}))
+	is.NoErr(err)
+	is.Equal(len(ty.Divisions[divOneName].DivisionManager.GetPlayers().Persons), 3)
+	active, err := tstore.ActiveTournamentsFor(ctx, "Conrad")
+	is.NoErr(err)
+	is.Equal(len(active), 1)
+
+	snapshots, err := tstore.GetSnapshots(ctx, ty.UUID)
+	is.NoErr(err)
+	is.Equal(len(snapshots.Snapshots), 1)
+	is.Equal(snapshots.Snapshots[0].Id, snapshot.Id)
+	is.Equal(snapshots.Snapshots[0].Label, "Two players")
+
+	err = tournament.RestoreSnapshot(ctx, tstore, ty.UUID, snapshot.Id)
+	is.NoErr(err)
+	is.NoErr(equalTournamentPersons(makeTournamentPersons(map[string]int32{"Will:Will": 1000, "Josh:Josh": 3000}),
+		ty.Divisions[divOneName].DivisionManager.GetPlayers()))
+	active, err = tstore.ActiveTournamentsFor(ctx, "Conrad")
+	is.NoErr(err)
+	is.Equal(len(active), 0)
+
+	// The restored state is what is saved.
+	tstore.Unload(ctx, ty.UUID)
+	restored, err := tstore.Get(ctx, ty.UUID)
+	is.NoErr(err)
+	is.Equal(len(restored.Divisions[divOneName].DivisionManager.GetPlayers().Persons), 2)
+
+	// Only the most recent snapshots are kept.
+	for i := 0; i < tournament.MaxTournamentSnapshots; i++ {
+		_, err = tournament.TakeSnapshot(ctx, tstore, ty.UUID, "Kieran:Kieran", "Restored")
+		is.NoErr(err)
+	}
+	snapshots, err = tstore.GetSnapshots(ctx, ty.UUID)
+	is.NoErr(err)
+	is.Equal(len(snapshots.Snapshots), tournament.MaxTournamentSnapshots)
+	for _, s := range snapshots.Snapshots {
+		is.True(s.Id != snapshot.Id)
+	}
+
+	clone, err := tournament.CloneTournament(ctx, tstore, us, ty.UUID, "Clone", "/tournament/clone", false)
+	is.NoErr(err)
+	is.Equal(clone.Description, ty.Description)
+	is.Equal(len(clone.Divisions), 1)
+	is.Equal(len(clone.Divisions[divOneName].DivisionManager.GetPlayers().Persons), 0)
+	is.Equal(len(clone.Divisions[divOneName].DivisionManager.GetRoundControls()), len(makeRoundControls()))
+
+	clone, err = tournament.CloneTournament(ctx, tstore, us, ty.UUID, "Clone With Players", "/tournament/clone-players", true)
+	is.NoErr(err)
+	is.NoErr(equalTournamentPersons(makeTournamentPersons(map[string]int32{"Will:Will": 1000, "Josh:Josh": 3000}),
+		clone.Divisions[divOneName].DivisionManager.GetPlayers()))
+
+	us.(*user.DBStore).Disconnect()
+	tstore.(*ts.Cache).Disconnect()
+	gs.(*game.Cache).Disconnect()
+}
+
 func equalTournamentPersons(tp1 *ipc.TournamentPersons, tp2 *ipc.TournamentPersons) error {
 	tp1String := tournamentPersonsToString(tp1)
 	tp2String := tournamentPersonsToString(tp2)
@@ -748,3 +1240,68 @@ func tournamentPersonsToString(tp *ipc.TournamentPersons) string {
 	}
 	return s + "}"
 }
+
+func TestTournamentPrizes(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+	recreateDB()
+	us := userStore()
+	_, gs := gameStore(us)
+	cfg, tstore := tournamentStore(gs)
+
+	directors := makeTournamentPersons(map[string]int32{"Kieran:Kieran": 0})
+	ty, err := makeTournament(ctx, tstore, cfg, directors)
+	is.NoErr(err)
+
+	err = tournament.AddDivision(ctx, tstore, ty.UUID, divOneName)
+	is.NoErr(err)
+	err = tournament.SetDivisionControls(ctx, tstore, ty.UUID, divOneName, makeControls())
+	is.NoErr(err)
+	err = tournament.SetRoundControls(ctx, tstore, ty.UUID, divOneName, makeRoundControls()[:1])
+	is.NoErr(err)
+	err = tournament.AddPlayers(ctx, tstore, us, ty.UUID, divOneName,
+		makeTournamentPersons(map[string]int32{"Will": 1000, "Josh": 3000}))
+	is.NoErr(err)
+
+	meta := &pb.TournamentMetadata{
+		Id:          ty.UUID,
+		Name:        tournamentName,
+		Description: "This is a test Tournament",
+		Slug:        "/tournament/slug-tourney",
+		Type:        pb.TType_STANDARD,
+		Prizes: []*ipc.Prize{
+			{Type: ipc.PrizeType_PLACE_PRIZE, Division: divTwoName, Place: 1, Amount: 100},
+		},
+	}
+	err = tournament.SetTournamentMetadata(ctx, tstore, meta)
+	is.Equal(err.Error(), entity.NewWooglesError(ipc.WooglesError_TOURNAMENT_NONEXISTENT_DIVISION, tournamentName, divTwoName).Error())
+	meta.Prizes = []*ipc.Prize{
+		{Type: ipc.PrizeType_PLACE_PRIZE, Division: divOneName, Place: 1, Amount: 100},
+		{Type: ipc.PrizeType_HIGH_GAME_PRIZE, Amount: 25},
+	}
+	err = tournament.SetTournamentMetadata(ctx, tstore, meta)
+	is.NoErr(err)
+
+	err = tournament.StartAllRoundCountdowns(ctx, tstore, us, ty.UUID, 0)
+	is.NoErr(err)
+
+	// Reporting the last result finishes the tournament and awards the prizes.
+	err = tournament.SetResult(ctx, tstore, us, ty.UUID, divOneName, "Will", "Josh", 400, 400,
+		ipc.TournamentGameResult_DRAW, ipc.TournamentGameResult_DRAW, ipc.GameEndReason_STANDARD, 0, 0, false, nil)
+	is.NoErr(err)
+	isFinished, err := tournament.IsFinished(ctx, tstore, ty.UUID)
+	is.NoErr(err)
+	is.True(isFinished)
+
+	awards := ty.ExtraMeta.PrizeAwards
+	is.Equal(len(awards), 2)
+	is.Equal(awards[0].PlayerIds, []string{"Josh:Josh", "Will:Will"})
+	is.Equal(awards[0].Amounts, []int64{50, 50})
+	is.Equal(awards[1].PlayerIds, []string{"Josh:Josh", "Will:Will"})
+	is.Equal(awards[1].Score, int32(400))
+	is.Equal(awards[1].Amounts, []int64{13, 12})
+
+	us.(*user.DBStore).Disconnect()
+	tstore.(*ts.Cache).Disconnect()
+	gs.(*game.Cache).Disconnect()
+}
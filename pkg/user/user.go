@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"time"
 
 	"github.com/domino14/liwords/pkg/entity"
 
@@ -25,7 +26,13 @@ type Store interface {
 	GetBriefProfiles(ctx context.Context, uuids []string) (map[string]*upb.BriefProfile, error)
 	SetPersonalInfo(ctx context.Context, uuid string, email string, firstName string, lastName string, birthDate string, countryCode string, about string) error
 	SetRatings(ctx context.Context, p0uuid string, p1uuid string, variant entity.VariantKey,
-		p1Rating entity.SingleRating, p2Rating entity.SingleRating) error
+		p1Rating entity.SingleRating, p2Rating entity.SingleRating, gameID string) error
+	SetManyRatings(ctx context.Context, variant entity.VariantKey,
+		ratings map[string]entity.SingleRating, gameID string) error
+	UpdateProvisionalRatings(ctx context.Context, now int64) ([]*entity.DecayedRating, error)
+	ListRatings(ctx context.Context, variant entity.VariantKey, since int64) ([]*entity.PlayerRating, error)
+	GetRatingHistory(ctx context.Context, uuid string, variant entity.VariantKey,
+		begin time.Time, end time.Time) ([]*upb.RatingHistoryEntry, error)
 	SetStats(ctx context.Context, p0uuid string, p1uuid string, variant entity.VariantKey,
 		p0stats *entity.Stats, p1stats *entity.Stats) error
 	SetNotoriety(ctx context.Context, u *entity.User, notoriety int) error
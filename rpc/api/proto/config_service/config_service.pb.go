@@ -569,6 +569,350 @@ func (x *AnnouncementsResponse) GetAnnouncements() []*Announcement {
 	return nil
 }
 
+// GlickoParameters are the constants games are rated with. Zero values
+// keep the constants that are in use.
+type GlickoParameters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SpreadScaling             int32   `protobuf:"varint,1,opt,name=spread_scaling,json=spreadScaling,proto3" json:"spread_scaling,omitempty"`
+	MinimumWinBoost           float64 `protobuf:"fixed64,2,opt,name=minimum_win_boost,json=minimumWinBoost,proto3" json:"minimum_win_boost,omitempty"`
+	Steepness                 float64 `protobuf:"fixed64,3,opt,name=steepness,proto3" json:"steepness,omitempty"`
+	VolatilityDeltaConstraint float64 `protobuf:"fixed64,4,opt,name=volatility_delta_constraint,json=volatilityDeltaConstraint,proto3" json:"volatility_delta_constraint,omitempty"`
+	MaximumVolatility         float64 `protobuf:"fixed64,5,opt,name=maximum_volatility,json=maximumVolatility,proto3" json:"maximum_volatility,omitempty"`
+	MinimumRatingDeviation    int32   `protobuf:"varint,6,opt,name=minimum_rating_deviation,json=minimumRatingDeviation,proto3" json:"minimum_rating_deviation,omitempty"`
+	MaximumRatingDeviation    int32   `protobuf:"varint,7,opt,name=maximum_rating_deviation,json=maximumRatingDeviation,proto3" json:"maximum_rating_deviation,omitempty"`
+	RatingPeriodSeconds       int32   `protobuf:"varint,8,opt,name=rating_period_seconds,json=ratingPeriodSeconds,proto3" json:"rating_period_seconds,omitempty"`
+}
+
+func (x *GlickoParameters) Reset() {
+	*x = GlickoParameters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_config_service_config_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GlickoParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GlickoParameters) ProtoMessage() {}
+
+func (x *GlickoParameters) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_config_service_config_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GlickoParameters.ProtoReflect.Descriptor instead.
+func (*GlickoParameters) Descriptor() ([]byte, []int) {
+	return file_api_proto_config_service_config_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GlickoParameters) GetSpreadScaling() int32 {
+	if x != nil {
+		return x.SpreadScaling
+	}
+	return 0
+}
+
+func (x *GlickoParameters) GetMinimumWinBoost() float64 {
+	if x != nil {
+		return x.MinimumWinBoost
+	}
+	return 0
+}
+
+func (x *GlickoParameters) GetSteepness() float64 {
+	if x != nil {
+		return x.Steepness
+	}
+	return 0
+}
+
+func (x *GlickoParameters) GetVolatilityDeltaConstraint() float64 {
+	if x != nil {
+		return x.VolatilityDeltaConstraint
+	}
+	return 0
+}
+
+func (x *GlickoParameters) GetMaximumVolatility() float64 {
+	if x != nil {
+		return x.MaximumVolatility
+	}
+	return 0
+}
+
+func (x *GlickoParameters) GetMinimumRatingDeviation() int32 {
+	if x != nil {
+		return x.MinimumRatingDeviation
+	}
+	return 0
+}
+
+func (x *GlickoParameters) GetMaximumRatingDeviation() int32 {
+	if x != nil {
+		return x.MaximumRatingDeviation
+	}
+	return 0
+}
+
+func (x *GlickoParameters) GetRatingPeriodSeconds() int32 {
+	if x != nil {
+		return x.RatingPeriodSeconds
+	}
+	return 0
+}
+
+// The rerate command recomputes the ratings of some variants by replaying
+// every rated game in the order they ended. It runs outside of the API,
+// since it may take a long time.
+type RerateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// variants are the rating keys to recompute, such as NWL20.classic.rapid
+	Variants   []string          `protobuf:"bytes,1,rep,name=variants,proto3" json:"variants,omitempty"`
+	Parameters *GlickoParameters `protobuf:"bytes,2,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	// Only differences of at least min_difference rating points are returned.
+	MinDifference float64 `protobuf:"fixed64,3,opt,name=min_difference,json=minDifference,proto3" json:"min_difference,omitempty"`
+	// If apply is set, the recomputed ratings replace the current ones.
+	// Games must be disabled while they are applied.
+	Apply bool `protobuf:"varint,4,opt,name=apply,proto3" json:"apply,omitempty"`
+}
+
+func (x *RerateRequest) Reset() {
+	*x = RerateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_config_service_config_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RerateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RerateRequest) ProtoMessage() {}
+
+func (x *RerateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_config_service_config_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RerateRequest.ProtoReflect.Descriptor instead.
+func (*RerateRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_config_service_config_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RerateRequest) GetVariants() []string {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *RerateRequest) GetParameters() *GlickoParameters {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *RerateRequest) GetMinDifference() float64 {
+	if x != nil {
+		return x.MinDifference
+	}
+	return 0
+}
+
+func (x *RerateRequest) GetApply() bool {
+	if x != nil {
+		return x.Apply
+	}
+	return false
+}
+
+type RatingDifference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId             string  `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Variant            string  `protobuf:"bytes,2,opt,name=variant,proto3" json:"variant,omitempty"`
+	OldRating          float64 `protobuf:"fixed64,3,opt,name=old_rating,json=oldRating,proto3" json:"old_rating,omitempty"`
+	NewRating          float64 `protobuf:"fixed64,4,opt,name=new_rating,json=newRating,proto3" json:"new_rating,omitempty"`
+	OldRatingDeviation float64 `protobuf:"fixed64,5,opt,name=old_rating_deviation,json=oldRatingDeviation,proto3" json:"old_rating_deviation,omitempty"`
+	NewRatingDeviation float64 `protobuf:"fixed64,6,opt,name=new_rating_deviation,json=newRatingDeviation,proto3" json:"new_rating_deviation,omitempty"`
+}
+
+func (x *RatingDifference) Reset() {
+	*x = RatingDifference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_config_service_config_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RatingDifference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingDifference) ProtoMessage() {}
+
+func (x *RatingDifference) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_config_service_config_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingDifference.ProtoReflect.Descriptor instead.
+func (*RatingDifference) Descriptor() ([]byte, []int) {
+	return file_api_proto_config_service_config_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RatingDifference) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RatingDifference) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *RatingDifference) GetOldRating() float64 {
+	if x != nil {
+		return x.OldRating
+	}
+	return 0
+}
+
+func (x *RatingDifference) GetNewRating() float64 {
+	if x != nil {
+		return x.NewRating
+	}
+	return 0
+}
+
+func (x *RatingDifference) GetOldRatingDeviation() float64 {
+	if x != nil {
+		return x.OldRatingDeviation
+	}
+	return 0
+}
+
+func (x *RatingDifference) GetNewRatingDeviation() float64 {
+	if x != nil {
+		return x.NewRatingDeviation
+	}
+	return 0
+}
+
+type RerateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Games int32 `protobuf:"varint,1,opt,name=games,proto3" json:"games,omitempty"`
+	// skipped_games could not be rated, such as games whose winner does not
+	// match their scores.
+	SkippedGames int32 `protobuf:"varint,2,opt,name=skipped_games,json=skippedGames,proto3" json:"skipped_games,omitempty"`
+	// differences are sorted by the size of the rating change, largest first.
+	Differences []*RatingDifference `protobuf:"bytes,3,rep,name=differences,proto3" json:"differences,omitempty"`
+	Applied     bool                `protobuf:"varint,4,opt,name=applied,proto3" json:"applied,omitempty"`
+}
+
+func (x *RerateResponse) Reset() {
+	*x = RerateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_config_service_config_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RerateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RerateResponse) ProtoMessage() {}
+
+func (x *RerateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_config_service_config_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RerateResponse.ProtoReflect.Descriptor instead.
+func (*RerateResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_config_service_config_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RerateResponse) GetGames() int32 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
+}
+
+func (x *RerateResponse) GetSkippedGames() int32 {
+	if x != nil {
+		return x.SkippedGames
+	}
+	return 0
+}
+
+func (x *RerateResponse) GetDifferences() []*RatingDifference {
+	if x != nil {
+		return x.Differences
+	}
+	return nil
+}
+
+func (x *RerateResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
 var File_api_proto_config_service_config_service_proto protoreflect.FileDescriptor
 
 var file_api_proto_config_service_config_service_proto_rawDesc = []byte{
@@ -633,7 +977,69 @@ var file_api_proto_config_service_config_service_proto_rawDesc = []byte{
 	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f,
 	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65,
 	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x32, 0x9d, 0x04, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0x9a, 0x03, 0x0a, 0x10, 0x47, 0x6c, 0x69, 0x63, 0x6b, 0x6f, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x70, 0x72, 0x65,
+	0x61, 0x64, 0x5f, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0d, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x12,
+	0x2a, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x77, 0x69, 0x6e, 0x5f, 0x62,
+	0x6f, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x69,
+	0x6d, 0x75, 0x6d, 0x57, 0x69, 0x6e, 0x42, 0x6f, 0x6f, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x74, 0x65, 0x65, 0x70, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x73, 0x74, 0x65, 0x65, 0x70, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x1b, 0x76, 0x6f, 0x6c,
+	0x61, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x63, 0x6f,
+	0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x19,
+	0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x43,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x61, 0x78,
+	0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x56, 0x6f,
+	0x6c, 0x61, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x38, 0x0a, 0x18, 0x6d, 0x69, 0x6e, 0x69,
+	0x6d, 0x75, 0x6d, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x16, 0x6d, 0x69, 0x6e, 0x69,
+	0x6d, 0x75, 0x6d, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x18, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x72, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x52, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x15,
+	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x22, 0xaa, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x40,
+	0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x47, 0x6c, 0x69, 0x63, 0x6b, 0x6f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x44, 0x69, 0x66,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x6c, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x22, 0xe7, 0x01,
+	0x0a, 0x10, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x61,
+	0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x6c, 0x64, 0x5f, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6f, 0x6c, 0x64, 0x52, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x72, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x52, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x30, 0x0a, 0x14, 0x6f, 0x6c, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x12, 0x6f, 0x6c, 0x64, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x76, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x6e, 0x65, 0x77, 0x5f, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x12, 0x6e, 0x65, 0x77, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65,
+	0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa9, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x61,
+	0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x67, 0x61, 0x6d, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64,
+	0x47, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x0b, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0b, 0x64, 0x69,
+	0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x65, 0x64, 0x32, 0x9d, 0x04, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65,
 	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x55, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65,
 	0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
 	0x67, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
@@ -686,7 +1092,7 @@ func file_api_proto_config_service_config_service_proto_rawDescGZIP() []byte {
 	return file_api_proto_config_service_config_service_proto_rawDescData
 }
 
-var file_api_proto_config_service_config_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_api_proto_config_service_config_service_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_api_proto_config_service_config_service_proto_goTypes = []interface{}{
 	(*EnableGamesRequest)(nil),      // 0: config_service.EnableGamesRequest
 	(*SetFEHashRequest)(nil),        // 1: config_service.SetFEHashRequest
@@ -698,32 +1104,38 @@ var file_api_proto_config_service_config_service_proto_goTypes = []interface{}{
 	(*SetAnnouncementsRequest)(nil), // 7: config_service.SetAnnouncementsRequest
 	(*GetAnnouncementsRequest)(nil), // 8: config_service.GetAnnouncementsRequest
 	(*AnnouncementsResponse)(nil),   // 9: config_service.AnnouncementsResponse
-	(*wrapperspb.BoolValue)(nil),    // 10: google.protobuf.BoolValue
+	(*GlickoParameters)(nil),        // 10: config_service.GlickoParameters
+	(*RerateRequest)(nil),           // 11: config_service.RerateRequest
+	(*RatingDifference)(nil),        // 12: config_service.RatingDifference
+	(*RerateResponse)(nil),          // 13: config_service.RerateResponse
+	(*wrapperspb.BoolValue)(nil),    // 14: google.protobuf.BoolValue
 }
 var file_api_proto_config_service_config_service_proto_depIdxs = []int32{
-	10, // 0: config_service.PermissionsRequest.director:type_name -> google.protobuf.BoolValue
-	10, // 1: config_service.PermissionsRequest.admin:type_name -> google.protobuf.BoolValue
-	10, // 2: config_service.PermissionsRequest.mod:type_name -> google.protobuf.BoolValue
-	10, // 3: config_service.PermissionsRequest.bot:type_name -> google.protobuf.BoolValue
+	14, // 0: config_service.PermissionsRequest.director:type_name -> google.protobuf.BoolValue
+	14, // 1: config_service.PermissionsRequest.admin:type_name -> google.protobuf.BoolValue
+	14, // 2: config_service.PermissionsRequest.mod:type_name -> google.protobuf.BoolValue
+	14, // 3: config_service.PermissionsRequest.bot:type_name -> google.protobuf.BoolValue
 	6,  // 4: config_service.SetAnnouncementsRequest.announcements:type_name -> config_service.Announcement
 	6,  // 5: config_service.AnnouncementsResponse.announcements:type_name -> config_service.Announcement
-	0,  // 6: config_service.ConfigService.SetGamesEnabled:input_type -> config_service.EnableGamesRequest
-	1,  // 7: config_service.ConfigService.SetFEHash:input_type -> config_service.SetFEHashRequest
-	2,  // 8: config_service.ConfigService.SetUserPermissions:input_type -> config_service.PermissionsRequest
-	3,  // 9: config_service.ConfigService.GetUserDetails:input_type -> config_service.UserRequest
-	7,  // 10: config_service.ConfigService.SetAnnouncements:input_type -> config_service.SetAnnouncementsRequest
-	8,  // 11: config_service.ConfigService.GetAnnouncements:input_type -> config_service.GetAnnouncementsRequest
-	5,  // 12: config_service.ConfigService.SetGamesEnabled:output_type -> config_service.ConfigResponse
-	5,  // 13: config_service.ConfigService.SetFEHash:output_type -> config_service.ConfigResponse
-	5,  // 14: config_service.ConfigService.SetUserPermissions:output_type -> config_service.ConfigResponse
-	4,  // 15: config_service.ConfigService.GetUserDetails:output_type -> config_service.UserResponse
-	5,  // 16: config_service.ConfigService.SetAnnouncements:output_type -> config_service.ConfigResponse
-	9,  // 17: config_service.ConfigService.GetAnnouncements:output_type -> config_service.AnnouncementsResponse
-	12, // [12:18] is the sub-list for method output_type
-	6,  // [6:12] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	10, // 6: config_service.RerateRequest.parameters:type_name -> config_service.GlickoParameters
+	12, // 7: config_service.RerateResponse.differences:type_name -> config_service.RatingDifference
+	0,  // 8: config_service.ConfigService.SetGamesEnabled:input_type -> config_service.EnableGamesRequest
+	1,  // 9: config_service.ConfigService.SetFEHash:input_type -> config_service.SetFEHashRequest
+	2,  // 10: config_service.ConfigService.SetUserPermissions:input_type -> config_service.PermissionsRequest
+	3,  // 11: config_service.ConfigService.GetUserDetails:input_type -> config_service.UserRequest
+	7,  // 12: config_service.ConfigService.SetAnnouncements:input_type -> config_service.SetAnnouncementsRequest
+	8,  // 13: config_service.ConfigService.GetAnnouncements:input_type -> config_service.GetAnnouncementsRequest
+	5,  // 14: config_service.ConfigService.SetGamesEnabled:output_type -> config_service.ConfigResponse
+	5,  // 15: config_service.ConfigService.SetFEHash:output_type -> config_service.ConfigResponse
+	5,  // 16: config_service.ConfigService.SetUserPermissions:output_type -> config_service.ConfigResponse
+	4,  // 17: config_service.ConfigService.GetUserDetails:output_type -> config_service.UserResponse
+	5,  // 18: config_service.ConfigService.SetAnnouncements:output_type -> config_service.ConfigResponse
+	9,  // 19: config_service.ConfigService.GetAnnouncements:output_type -> config_service.AnnouncementsResponse
+	14, // [14:20] is the sub-list for method output_type
+	8,  // [8:14] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_config_service_config_service_proto_init() }
@@ -852,6 +1264,54 @@ func file_api_proto_config_service_config_service_proto_init() {
 				return nil
 			}
 		}
+		file_api_proto_config_service_config_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GlickoParameters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_config_service_config_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RerateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_config_service_config_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RatingDifference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_config_service_config_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RerateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -859,7 +1319,7 @@ func file_api_proto_config_service_config_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_proto_config_service_config_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
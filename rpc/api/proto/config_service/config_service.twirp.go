@@ -2480,43 +2480,69 @@ func callClientError(ctx context.Context, h *twirp.ClientHooks, err twirp.Error)
 }
 
 var twirpFileDescriptor0 = []byte{
-	// 607 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x5f, 0x6b, 0xd3, 0x50,
-	0x14, 0xa7, 0xae, 0xdd, 0xda, 0xd3, 0x6d, 0x8e, 0x8b, 0x63, 0x59, 0x1d, 0xb3, 0x04, 0xd4, 0x09,
-	0x9a, 0xe8, 0x14, 0x11, 0xdf, 0x56, 0x37, 0x2b, 0xe8, 0x40, 0x52, 0x26, 0xe2, 0x90, 0x72, 0x93,
-	0x9c, 0xb5, 0x17, 0x93, 0x7b, 0x63, 0xee, 0x8d, 0xc3, 0x0f, 0xe3, 0x57, 0xf1, 0x63, 0xf9, 0x2c,
-	0xb9, 0xb7, 0x09, 0x6d, 0xd6, 0x2d, 0x3e, 0xf8, 0x76, 0xce, 0xb9, 0xbf, 0xf3, 0xeb, 0xf9, 0xf7,
-	0x6b, 0xe0, 0x09, 0x4d, 0x98, 0x9b, 0xa4, 0x42, 0x09, 0x37, 0x10, 0xfc, 0x82, 0x4d, 0xc6, 0x12,
-	0xd3, 0x1f, 0x2c, 0xc0, 0x8a, 0xeb, 0x68, 0x0c, 0xd9, 0x5c, 0x8c, 0xf6, 0xf6, 0x27, 0x42, 0x4c,
-	0x22, 0x34, 0x0c, 0x7e, 0x76, 0xe1, 0x5e, 0xa6, 0x34, 0x49, 0x30, 0x95, 0x06, 0x6f, 0x3b, 0x40,
-	0x4e, 0x38, 0xf5, 0x23, 0x1c, 0xd2, 0x18, 0xa5, 0x87, 0xdf, 0x33, 0x94, 0x8a, 0x58, 0xb0, 0x86,
-	0x3a, 0x1a, 0x5a, 0x8d, 0x7e, 0xe3, 0xa0, 0xed, 0x15, 0xae, 0xfd, 0x00, 0xb6, 0x46, 0xa8, 0xde,
-	0x9e, 0xbc, 0xa3, 0x72, 0x5a, 0xa0, 0x09, 0x34, 0xa7, 0x54, 0x4e, 0x35, 0xb4, 0xe3, 0x69, 0xdb,
-	0xfe, 0xd3, 0x00, 0xf2, 0x11, 0xd3, 0x98, 0x49, 0xc9, 0x04, 0x2f, 0x89, 0x7b, 0xd0, 0xce, 0x24,
-	0xa6, 0x9c, 0xc6, 0x38, 0x83, 0x97, 0x3e, 0x79, 0x09, 0xed, 0x90, 0xa5, 0x18, 0x28, 0x91, 0x5a,
-	0xb7, 0xfa, 0x8d, 0x83, 0xee, 0x61, 0xcf, 0x31, 0xd5, 0x3b, 0x45, 0xf5, 0xce, 0x40, 0x88, 0xe8,
-	0x13, 0x8d, 0x32, 0xf4, 0x4a, 0x2c, 0x79, 0x0a, 0x2d, 0x1a, 0xc6, 0x8c, 0x5b, 0x2b, 0xb5, 0x49,
-	0x06, 0x48, 0x1e, 0xc3, 0x4a, 0x2c, 0x42, 0xab, 0x59, 0x8b, 0xcf, 0x61, 0x39, 0xda, 0x17, 0xca,
-	0x6a, 0xd5, 0xa3, 0x7d, 0xa1, 0xec, 0x47, 0xd0, 0x3d, 0x93, 0x98, 0xfe, 0x43, 0xc3, 0xf6, 0xef,
-	0x06, 0xac, 0x1b, 0xac, 0x4c, 0x04, 0x97, 0x78, 0xe3, 0x74, 0x08, 0x34, 0xb3, 0x8c, 0x85, 0x7a,
-	0x32, 0x1d, 0x4f, 0xdb, 0xe4, 0x0e, 0xb4, 0x30, 0xa6, 0x2c, 0xd2, 0x9d, 0x77, 0x3c, 0xe3, 0x90,
-	0x6d, 0x58, 0x65, 0x72, 0x9c, 0x97, 0xdc, 0xd4, 0xbb, 0x6b, 0x31, 0x39, 0x10, 0x8a, 0xdc, 0x83,
-	0x2e, 0x93, 0xe3, 0x72, 0xc2, 0x2d, 0xfd, 0x06, 0x4c, 0x1e, 0x17, 0x73, 0x34, 0x79, 0xf9, 0x60,
-	0x56, 0x8b, 0xbc, 0x53, 0x11, 0x92, 0x5d, 0x68, 0x33, 0x39, 0x36, 0x13, 0x5e, 0x33, 0xc7, 0xc0,
-	0xe4, 0x51, 0xee, 0xda, 0x5b, 0xb0, 0xf9, 0x46, 0x9f, 0x5b, 0xd1, 0x81, 0xfd, 0x01, 0xd6, 0x8f,
-	0x38, 0x17, 0x19, 0x0f, 0x30, 0x46, 0xae, 0xf2, 0x0a, 0x15, 0x53, 0x51, 0xd1, 0x8e, 0x71, 0xf2,
-	0x5e, 0x22, 0xc6, 0xbf, 0x15, 0xbd, 0xe4, 0x76, 0x1e, 0xf3, 0x45, 0xf8, 0x73, 0xd6, 0x8a, 0xb6,
-	0xed, 0xaf, 0xb0, 0x33, 0x42, 0x35, 0x4f, 0x58, 0x1e, 0xd2, 0x00, 0x36, 0xe8, 0x7c, 0xdc, 0x6a,
-	0xf4, 0x57, 0x0e, 0xba, 0x87, 0x7b, 0x4e, 0x45, 0x15, 0xf3, 0xc9, 0xde, 0x62, 0x8a, 0xbd, 0x0b,
-	0x3b, 0xc3, 0xe5, 0xf4, 0xf6, 0x39, 0x6c, 0x57, 0xe2, 0xb3, 0x15, 0xfd, 0x87, 0xdf, 0x3d, 0xfc,
-	0xd5, 0x84, 0x0d, 0x33, 0xb7, 0x91, 0x41, 0x93, 0x33, 0xb8, 0x3d, 0x42, 0xa5, 0x25, 0x68, 0xd4,
-	0x18, 0x12, 0xbb, 0xca, 0x78, 0x55, 0xa6, 0xbd, 0xfd, 0x2a, 0x66, 0x71, 0x1b, 0xe4, 0x14, 0x3a,
-	0xa5, 0x58, 0x49, 0xbf, 0x0a, 0xae, 0xea, 0xb8, 0x96, 0xee, 0x33, 0x90, 0x11, 0xaa, 0xfc, 0x62,
-	0xe7, 0x94, 0x7d, 0xb5, 0xd0, 0xab, 0xb2, 0xaf, 0x65, 0x7e, 0x0f, 0x9b, 0x43, 0xc3, 0x7c, 0x8c,
-	0x8a, 0xb2, 0x48, 0x92, 0xbb, 0xd5, 0x8c, 0x39, 0x51, 0xf5, 0xf6, 0x96, 0x3f, 0xce, 0xc8, 0xce,
-	0xf5, 0x5f, 0xd4, 0xc2, 0xfa, 0xc8, 0xc3, 0x25, 0xcd, 0x2f, 0x5b, 0x7c, 0x6d, 0xa5, 0x3e, 0x6c,
-	0x0d, 0x6b, 0xc9, 0xaf, 0xb9, 0xaa, 0xde, 0xfd, 0x9b, 0xae, 0xa4, 0xbc, 0xb1, 0xc1, 0xeb, 0x2f,
-	0xaf, 0x26, 0x4c, 0x4d, 0x33, 0xdf, 0x09, 0x44, 0xec, 0x86, 0x22, 0x66, 0x5c, 0x3c, 0x7b, 0xe1,
-	0x46, 0xec, 0x52, 0xa4, 0xa1, 0x74, 0xd3, 0x24, 0x70, 0xaf, 0xfb, 0x28, 0xf8, 0xab, 0x3a, 0xfa,
-	0xfc, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5b, 0xdf, 0xe3, 0x31, 0x37, 0x06, 0x00, 0x00,
+	// 1024 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xd6, 0x36, 0x71, 0x12, 0x9f, 0xc4, 0x69, 0x3a, 0x24, 0xc4, 0x71, 0x43, 0x89, 0x16, 0x15,
+	0x02, 0xa2, 0x76, 0x08, 0x08, 0x55, 0x5c, 0x20, 0xea, 0xa6, 0x04, 0x04, 0x95, 0xa2, 0xb5, 0x5a,
+	0x10, 0x15, 0x5a, 0x8d, 0x77, 0x4e, 0x9c, 0x51, 0x76, 0x67, 0x96, 0x9d, 0x71, 0x7e, 0x5e, 0x05,
+	0x89, 0x07, 0x80, 0x87, 0xe0, 0x75, 0x78, 0x03, 0xae, 0xd1, 0xfc, 0xec, 0xda, 0xd9, 0xb8, 0x35,
+	0x17, 0xbd, 0xdb, 0x73, 0xbe, 0xef, 0x1c, 0x9f, 0xdf, 0x39, 0x86, 0x47, 0x34, 0xe7, 0xbd, 0xbc,
+	0x90, 0x5a, 0xf6, 0x12, 0x29, 0x4e, 0xf9, 0x28, 0x56, 0x58, 0x5c, 0xf0, 0x04, 0x6b, 0x62, 0xd7,
+	0x72, 0xc8, 0xfa, 0x4d, 0x6d, 0xe7, 0xc1, 0x48, 0xca, 0x51, 0x8a, 0xce, 0xc3, 0x70, 0x7c, 0xda,
+	0xbb, 0x2c, 0x68, 0x9e, 0x63, 0xa1, 0x1c, 0x3f, 0xec, 0x02, 0x79, 0x26, 0xe8, 0x30, 0xc5, 0x63,
+	0x9a, 0xa1, 0x8a, 0xf0, 0xb7, 0x31, 0x2a, 0x4d, 0xda, 0xb0, 0x8c, 0x56, 0xcb, 0xda, 0xc1, 0x5e,
+	0xb0, 0xbf, 0x12, 0x95, 0x62, 0xf8, 0x21, 0x6c, 0x0c, 0x50, 0x7f, 0xfb, 0xec, 0x3b, 0xaa, 0xce,
+	0x4a, 0x36, 0x81, 0xc5, 0x33, 0xaa, 0xce, 0x2c, 0xb5, 0x19, 0xd9, 0xef, 0xf0, 0xdf, 0x00, 0xc8,
+	0x09, 0x16, 0x19, 0x57, 0x8a, 0x4b, 0x51, 0x39, 0xee, 0xc0, 0xca, 0x58, 0x61, 0x21, 0x68, 0x86,
+	0x9e, 0x5e, 0xc9, 0xe4, 0x4b, 0x58, 0x61, 0xbc, 0xc0, 0x44, 0xcb, 0xa2, 0x7d, 0x67, 0x2f, 0xd8,
+	0x5f, 0x3d, 0xec, 0x74, 0x5d, 0xf4, 0xdd, 0x32, 0xfa, 0x6e, 0x5f, 0xca, 0xf4, 0x25, 0x4d, 0xc7,
+	0x18, 0x55, 0x5c, 0x72, 0x00, 0x0d, 0xca, 0x32, 0x2e, 0xda, 0x0b, 0x73, 0x8d, 0x1c, 0x91, 0x7c,
+	0x0a, 0x0b, 0x99, 0x64, 0xed, 0xc5, 0xb9, 0x7c, 0x43, 0x33, 0xec, 0xa1, 0xd4, 0xed, 0xc6, 0x7c,
+	0xf6, 0x50, 0xea, 0xf0, 0x63, 0x58, 0x7d, 0xa1, 0xb0, 0xf8, 0x1f, 0x09, 0x87, 0x7f, 0x07, 0xb0,
+	0xe6, 0xb8, 0x2a, 0x97, 0x42, 0xe1, 0x1b, 0xab, 0x43, 0x60, 0x71, 0x3c, 0xe6, 0xcc, 0x56, 0xa6,
+	0x19, 0xd9, 0x6f, 0xb2, 0x09, 0x0d, 0xcc, 0x28, 0x4f, 0x6d, 0xe6, 0xcd, 0xc8, 0x09, 0x64, 0x0b,
+	0x96, 0xb8, 0x8a, 0x4d, 0xc8, 0x8b, 0xb6, 0x77, 0x0d, 0xae, 0xfa, 0x52, 0x93, 0xf7, 0x61, 0x95,
+	0xab, 0xb8, 0xaa, 0x70, 0xc3, 0x62, 0xc0, 0xd5, 0x51, 0x59, 0x47, 0x67, 0x67, 0x0a, 0xb3, 0x54,
+	0xda, 0x3d, 0x97, 0x8c, 0xec, 0xc0, 0x0a, 0x57, 0xb1, 0xab, 0xf0, 0xb2, 0x1b, 0x06, 0xae, 0x9e,
+	0x18, 0x31, 0xdc, 0x80, 0xf5, 0xa7, 0x76, 0xdc, 0xca, 0x0c, 0xc2, 0x1f, 0x61, 0xed, 0x89, 0x10,
+	0x72, 0x2c, 0x12, 0xcc, 0x50, 0x68, 0x13, 0xa1, 0xe6, 0x3a, 0x2d, 0xd3, 0x71, 0x82, 0xc9, 0x25,
+	0xe5, 0xe2, 0xbc, 0xcc, 0xc5, 0x7c, 0x1b, 0xdd, 0x50, 0xb2, 0x6b, 0x9f, 0x8a, 0xfd, 0x0e, 0x7f,
+	0x85, 0xed, 0x01, 0xea, 0x69, 0x87, 0xd5, 0x20, 0xf5, 0xa1, 0x45, 0xa7, 0xf5, 0xed, 0x60, 0x6f,
+	0x61, 0x7f, 0xf5, 0x70, 0xb7, 0x5b, 0xdb, 0x8a, 0x69, 0xe3, 0xe8, 0xa6, 0x49, 0xb8, 0x03, 0xdb,
+	0xc7, 0xb3, 0xdd, 0x87, 0xaf, 0x60, 0xab, 0xa6, 0xf7, 0x2d, 0x7a, 0x1b, 0xbf, 0xfb, 0xfb, 0x02,
+	0x6c, 0x1c, 0xa7, 0x3c, 0x39, 0x97, 0x27, 0xb4, 0xa0, 0x19, 0x6a, 0x2c, 0x14, 0x79, 0x08, 0xeb,
+	0x2a, 0x2f, 0x90, 0xb2, 0x58, 0x25, 0x34, 0xe5, 0x62, 0x64, 0x4b, 0xd6, 0x88, 0x5a, 0x4e, 0x3b,
+	0x70, 0x4a, 0xf2, 0x09, 0xdc, 0xcb, 0xb8, 0xe0, 0xd9, 0x38, 0x8b, 0x2f, 0xb9, 0x88, 0x87, 0x52,
+	0x2a, 0x6d, 0xeb, 0x18, 0x44, 0x77, 0x3d, 0xf0, 0x13, 0x17, 0x7d, 0xa3, 0x26, 0xbb, 0xd0, 0x54,
+	0x1a, 0x31, 0x17, 0xa8, 0x94, 0xad, 0x6b, 0x10, 0x4d, 0x14, 0xe4, 0x6b, 0xb8, 0x7f, 0x21, 0x53,
+	0xaa, 0x79, 0xca, 0xf5, 0x75, 0xcc, 0x30, 0xd5, 0x34, 0x4e, 0xa4, 0x50, 0xba, 0xa0, 0x5c, 0xb8,
+	0xd9, 0x09, 0xa2, 0x9d, 0x09, 0xe5, 0xc8, 0x30, 0x9e, 0x56, 0x04, 0xf2, 0x08, 0x48, 0x46, 0xaf,
+	0x6c, 0x24, 0x13, 0x92, 0x1d, 0xab, 0x20, 0xba, 0xe7, 0x91, 0x97, 0x15, 0x40, 0x1e, 0x43, 0xbb,
+	0x0c, 0xbc, 0xa0, 0x9a, 0x8b, 0x51, 0xcc, 0xf0, 0x82, 0x53, 0xcd, 0xa5, 0xb0, 0xf3, 0xd6, 0x88,
+	0xde, 0xf5, 0x78, 0x64, 0xe1, 0xa3, 0x12, 0xb5, 0x96, 0xf4, 0x6a, 0xb6, 0xe5, 0xb2, 0xb7, 0xa4,
+	0x57, 0xb3, 0x2c, 0x0f, 0x61, 0xcb, 0x5b, 0xe4, 0x58, 0x70, 0xc9, 0x62, 0x85, 0x89, 0x14, 0x4c,
+	0xb5, 0x57, 0xac, 0xd9, 0x3b, 0x0e, 0x3c, 0xb1, 0xd8, 0xc0, 0x41, 0xe1, 0x5f, 0x01, 0xb4, 0x22,
+	0x2c, 0xa8, 0xc6, 0xa9, 0x15, 0xbe, 0xa0, 0x05, 0xa7, 0x65, 0xb7, 0x9b, 0x51, 0x25, 0x93, 0x6f,
+	0x00, 0xf2, 0xaa, 0x87, 0xfe, 0xd5, 0xda, 0xab, 0xcf, 0x42, 0xbd, 0xd7, 0x11, 0xe4, 0x37, 0xfa,
+	0x9e, 0x71, 0x11, 0x33, 0x7e, 0x7a, 0x8a, 0x05, 0x8a, 0x04, 0x7d, 0xa7, 0x5a, 0x19, 0x17, 0x47,
+	0x95, 0xd2, 0x2c, 0x12, 0xcd, 0xf3, 0xf4, 0xba, 0xdc, 0x69, 0x2b, 0x84, 0xff, 0x04, 0xb0, 0xe1,
+	0x93, 0x9e, 0x50, 0xb7, 0x61, 0xd9, 0xbc, 0x1a, 0x31, 0x67, 0x7e, 0xeb, 0x96, 0x8c, 0xf8, 0x3d,
+	0x33, 0xaf, 0xba, 0x0f, 0xdc, 0x6f, 0x5e, 0x29, 0x92, 0xf7, 0x00, 0x64, 0xca, 0x7c, 0x79, 0xcb,
+	0x51, 0x91, 0x29, 0x73, 0xbe, 0x0d, 0x2c, 0xf0, 0xb2, 0x84, 0xdd, 0x64, 0x34, 0x05, 0x5e, 0x7a,
+	0xf8, 0x00, 0x36, 0x27, 0xd6, 0x53, 0xcd, 0x71, 0xb3, 0x40, 0x2a, 0x3f, 0x93, 0xc6, 0x1c, 0xc0,
+	0xe6, 0xc4, 0x61, 0x6d, 0x10, 0x82, 0x88, 0x54, 0xae, 0x2b, 0x8b, 0xf0, 0xcf, 0x00, 0xd6, 0xcb,
+	0xb6, 0xf8, 0x55, 0xdc, 0x84, 0xc6, 0xc8, 0x1c, 0x2d, 0xbf, 0x28, 0x4e, 0x20, 0x1f, 0x40, 0x4b,
+	0x9d, 0xf3, 0x3c, 0x47, 0x16, 0x3b, 0xf4, 0x8e, 0x45, 0xd7, 0xbc, 0xd2, 0x9e, 0x39, 0xd2, 0x87,
+	0xd5, 0x49, 0xc1, 0xcd, 0x6e, 0x2c, 0xcc, 0xea, 0x5b, 0xbd, 0xb2, 0xd1, 0xb4, 0x91, 0xa9, 0xa6,
+	0x69, 0x02, 0x47, 0xe6, 0x7b, 0x52, 0x8a, 0x87, 0x7f, 0x2c, 0x42, 0xcb, 0xbd, 0x8b, 0x03, 0xe7,
+	0x89, 0xbc, 0x80, 0xbb, 0x03, 0xd4, 0xf6, 0xb7, 0xdd, 0xb5, 0x65, 0x24, 0xac, 0xff, 0xda, 0xed,
+	0x33, 0xdc, 0x79, 0x50, 0xe7, 0xdc, 0x7c, 0x6d, 0xc9, 0x73, 0x68, 0x56, 0xc7, 0x98, 0xdc, 0x0a,
+	0xbf, 0x7e, 0xa7, 0xe7, 0xba, 0xfb, 0x19, 0xc8, 0x00, 0xb5, 0xb9, 0x48, 0x53, 0x97, 0xfb, 0x76,
+	0xa0, 0xb7, 0xcf, 0xfa, 0x5c, 0xcf, 0x3f, 0xc0, 0xfa, 0xb1, 0xf3, 0x7c, 0x84, 0x9a, 0xf2, 0x54,
+	0x91, 0xfb, 0x75, 0x8b, 0xa9, 0xa3, 0xd9, 0xd9, 0x9d, 0x0d, 0x7a, 0x67, 0xaf, 0xec, 0x5f, 0x90,
+	0x1b, 0xcf, 0x33, 0xf9, 0x68, 0x46, 0xf2, 0xb3, 0x1e, 0xf6, 0xb9, 0x91, 0x0e, 0x61, 0xe3, 0x78,
+	0xae, 0xf3, 0xd7, 0x5c, 0x8d, 0xce, 0xc3, 0x37, 0x5d, 0x81, 0xea, 0x86, 0xf4, 0xbf, 0xfa, 0xe5,
+	0xf1, 0x88, 0xeb, 0xb3, 0xf1, 0xb0, 0x9b, 0xc8, 0xac, 0xc7, 0x64, 0xc6, 0x85, 0xfc, 0xec, 0x8b,
+	0x5e, 0xca, 0x2f, 0x65, 0xc1, 0x54, 0xaf, 0xc8, 0x93, 0xde, 0xeb, 0xfe, 0xf4, 0x0d, 0x97, 0xac,
+	0xf6, 0xf3, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x6d, 0xf9, 0x5c, 0x54, 0x17, 0x0a, 0x00, 0x00,
 }
@@ -110,6 +110,41 @@ const (
 	WooglesError_PUZZLE_SET_PUZZLE_VOTE_ID_NOT_FOUND           WooglesError = 1084
 	WooglesError_PUZZLE_SUBMIT_ANSWER_PUZZLE_ATTEMPT_NOT_FOUND WooglesError = 1085
 	WooglesError_PUZZLE_GET_PUZZLE_UPDATE_ATTEMPT              WooglesError = 1086
+	WooglesError_TOURNAMENT_INVALID_DOUBLE_ELIMINATION_ROUNDS  WooglesError = 1088
+	WooglesError_TOURNAMENT_NO_BRACKET                         WooglesError = 1089
+	WooglesError_TOURNAMENT_NEGATIVE_NO_SHOW_GRACE             WooglesError = 1090
+	WooglesError_TOURNAMENT_REGISTRATION_CLOSED                WooglesError = 1091
+	WooglesError_TOURNAMENT_REGISTRATION_DEADLINE_PASSED       WooglesError = 1092
+	WooglesError_TOURNAMENT_ALREADY_REGISTERED                 WooglesError = 1093
+	WooglesError_TOURNAMENT_RATING_OUT_OF_RANGE                WooglesError = 1094
+	WooglesError_TOURNAMENT_NOT_REGISTERED                     WooglesError = 1095
+	WooglesError_TOURNAMENT_INVALID_RATING_RANGE               WooglesError = 1096
+	WooglesError_TOURNAMENT_NEGATIVE_MAX_PLAYERS               WooglesError = 1097
+	WooglesError_TOURNAMENT_CHECK_IN_NOT_OPEN                  WooglesError = 1098
+	WooglesError_TOURNAMENT_CHECK_IN_CLOSED                    WooglesError = 1099
+	WooglesError_TOURNAMENT_NEGATIVE_CHECK_IN_WINDOW           WooglesError = 1100
+	WooglesError_TOURNAMENT_INVALID_CONTENTION_PAIRING         WooglesError = 1101
+	WooglesError_TOURNAMENT_INVALID_PAIRING_LAG                WooglesError = 1102
+	WooglesError_TOURNAMENT_NEGATIVE_CATCH_UP_BYES             WooglesError = 1103
+	WooglesError_TOURNAMENT_PROMOTION_SESSION_NOT_FINISHED     WooglesError = 1104
+	WooglesError_TOURNAMENT_PROMOTION_INVALID_NEXT_SESSION     WooglesError = 1105
+	WooglesError_TOURNAMENT_NEGATIVE_PROMOTIONS                WooglesError = 1106
+	WooglesError_TOURNAMENT_NOT_A_LADDER                       WooglesError = 1107
+	WooglesError_TOURNAMENT_INVALID_LADDER_CONTROLS            WooglesError = 1108
+	WooglesError_TOURNAMENT_LADDER_NO_GAME_SETTINGS            WooglesError = 1109
+	WooglesError_TOURNAMENT_LADDER_CHALLENGE_OUT_OF_RANGE      WooglesError = 1110
+	WooglesError_TOURNAMENT_LADDER_PLAYER_CHALLENGED           WooglesError = 1111
+	WooglesError_TOURNAMENT_LADDER_NONEXISTENT_CHALLENGE       WooglesError = 1112
+	WooglesError_TOURNAMENT_INVALID_ACCELERATION               WooglesError = 1113
+	WooglesError_TOURNAMENT_RATING_PERIOD_STARTED              WooglesError = 1114
+	WooglesError_TOURNAMENT_NOT_RATED_AS_PERIOD                WooglesError = 1115
+	WooglesError_TOURNAMENT_NOT_A_CLUB                         WooglesError = 1116
+	WooglesError_TOURNAMENT_CLUB_MEMBERS_ONLY                  WooglesError = 1117
+	WooglesError_TOURNAMENT_NONEXISTENT_CLUB_MEMBER            WooglesError = 1118
+	WooglesError_TOURNAMENT_INVALID_CLUB_SESSION_SCHEDULE      WooglesError = 1119
+	WooglesError_TOURNAMENT_INVALID_CLUB_SESSION_TEMPLATE      WooglesError = 1120
+	WooglesError_TOURNAMENT_INVALID_PRIZE                      WooglesError = 1121
+	WooglesError_TOURNAMENT_PROMOTION_DUPLICATE_DIVISION       WooglesError = 1122
 )
 
 // Enum value maps for WooglesError.
@@ -202,6 +237,41 @@ var (
 		1084: "PUZZLE_SET_PUZZLE_VOTE_ID_NOT_FOUND",
 		1085: "PUZZLE_SUBMIT_ANSWER_PUZZLE_ATTEMPT_NOT_FOUND",
 		1086: "PUZZLE_GET_PUZZLE_UPDATE_ATTEMPT",
+		1088: "TOURNAMENT_INVALID_DOUBLE_ELIMINATION_ROUNDS",
+		1089: "TOURNAMENT_NO_BRACKET",
+		1090: "TOURNAMENT_NEGATIVE_NO_SHOW_GRACE",
+		1091: "TOURNAMENT_REGISTRATION_CLOSED",
+		1092: "TOURNAMENT_REGISTRATION_DEADLINE_PASSED",
+		1093: "TOURNAMENT_ALREADY_REGISTERED",
+		1094: "TOURNAMENT_RATING_OUT_OF_RANGE",
+		1095: "TOURNAMENT_NOT_REGISTERED",
+		1096: "TOURNAMENT_INVALID_RATING_RANGE",
+		1097: "TOURNAMENT_NEGATIVE_MAX_PLAYERS",
+		1098: "TOURNAMENT_CHECK_IN_NOT_OPEN",
+		1099: "TOURNAMENT_CHECK_IN_CLOSED",
+		1100: "TOURNAMENT_NEGATIVE_CHECK_IN_WINDOW",
+		1101: "TOURNAMENT_INVALID_CONTENTION_PAIRING",
+		1102: "TOURNAMENT_INVALID_PAIRING_LAG",
+		1103: "TOURNAMENT_NEGATIVE_CATCH_UP_BYES",
+		1104: "TOURNAMENT_PROMOTION_SESSION_NOT_FINISHED",
+		1105: "TOURNAMENT_PROMOTION_INVALID_NEXT_SESSION",
+		1106: "TOURNAMENT_NEGATIVE_PROMOTIONS",
+		1107: "TOURNAMENT_NOT_A_LADDER",
+		1108: "TOURNAMENT_INVALID_LADDER_CONTROLS",
+		1109: "TOURNAMENT_LADDER_NO_GAME_SETTINGS",
+		1110: "TOURNAMENT_LADDER_CHALLENGE_OUT_OF_RANGE",
+		1111: "TOURNAMENT_LADDER_PLAYER_CHALLENGED",
+		1112: "TOURNAMENT_LADDER_NONEXISTENT_CHALLENGE",
+		1113: "TOURNAMENT_INVALID_ACCELERATION",
+		1114: "TOURNAMENT_RATING_PERIOD_STARTED",
+		1115: "TOURNAMENT_NOT_RATED_AS_PERIOD",
+		1116: "TOURNAMENT_NOT_A_CLUB",
+		1117: "TOURNAMENT_CLUB_MEMBERS_ONLY",
+		1118: "TOURNAMENT_NONEXISTENT_CLUB_MEMBER",
+		1119: "TOURNAMENT_INVALID_CLUB_SESSION_SCHEDULE",
+		1120: "TOURNAMENT_INVALID_CLUB_SESSION_TEMPLATE",
+		1121: "TOURNAMENT_INVALID_PRIZE",
+		1122: "TOURNAMENT_PROMOTION_DUPLICATE_DIVISION",
 	}
 	WooglesError_value = map[string]int32{
 		"DEFAULT":                                       0,
@@ -291,6 +361,41 @@ var (
 		"PUZZLE_SET_PUZZLE_VOTE_ID_NOT_FOUND":           1084,
 		"PUZZLE_SUBMIT_ANSWER_PUZZLE_ATTEMPT_NOT_FOUND": 1085,
 		"PUZZLE_GET_PUZZLE_UPDATE_ATTEMPT":              1086,
+		"TOURNAMENT_INVALID_DOUBLE_ELIMINATION_ROUNDS":  1088,
+		"TOURNAMENT_NO_BRACKET":                         1089,
+		"TOURNAMENT_NEGATIVE_NO_SHOW_GRACE":             1090,
+		"TOURNAMENT_REGISTRATION_CLOSED":                1091,
+		"TOURNAMENT_REGISTRATION_DEADLINE_PASSED":       1092,
+		"TOURNAMENT_ALREADY_REGISTERED":                 1093,
+		"TOURNAMENT_RATING_OUT_OF_RANGE":                1094,
+		"TOURNAMENT_NOT_REGISTERED":                     1095,
+		"TOURNAMENT_INVALID_RATING_RANGE":               1096,
+		"TOURNAMENT_NEGATIVE_MAX_PLAYERS":               1097,
+		"TOURNAMENT_CHECK_IN_NOT_OPEN":                  1098,
+		"TOURNAMENT_CHECK_IN_CLOSED":                    1099,
+		"TOURNAMENT_NEGATIVE_CHECK_IN_WINDOW":           1100,
+		"TOURNAMENT_INVALID_CONTENTION_PAIRING":         1101,
+		"TOURNAMENT_INVALID_PAIRING_LAG":                1102,
+		"TOURNAMENT_NEGATIVE_CATCH_UP_BYES":             1103,
+		"TOURNAMENT_PROMOTION_SESSION_NOT_FINISHED":     1104,
+		"TOURNAMENT_PROMOTION_INVALID_NEXT_SESSION":     1105,
+		"TOURNAMENT_NEGATIVE_PROMOTIONS":                1106,
+		"TOURNAMENT_NOT_A_LADDER":                       1107,
+		"TOURNAMENT_INVALID_LADDER_CONTROLS":            1108,
+		"TOURNAMENT_LADDER_NO_GAME_SETTINGS":            1109,
+		"TOURNAMENT_LADDER_CHALLENGE_OUT_OF_RANGE":      1110,
+		"TOURNAMENT_LADDER_PLAYER_CHALLENGED":           1111,
+		"TOURNAMENT_LADDER_NONEXISTENT_CHALLENGE":       1112,
+		"TOURNAMENT_INVALID_ACCELERATION":               1113,
+		"TOURNAMENT_RATING_PERIOD_STARTED":              1114,
+		"TOURNAMENT_NOT_RATED_AS_PERIOD":                1115,
+		"TOURNAMENT_NOT_A_CLUB":                         1116,
+		"TOURNAMENT_CLUB_MEMBERS_ONLY":                  1117,
+		"TOURNAMENT_NONEXISTENT_CLUB_MEMBER":            1118,
+		"TOURNAMENT_INVALID_CLUB_SESSION_SCHEDULE":      1119,
+		"TOURNAMENT_INVALID_CLUB_SESSION_TEMPLATE":      1120,
+		"TOURNAMENT_INVALID_PRIZE":                      1121,
+		"TOURNAMENT_PROMOTION_DUPLICATE_DIVISION":       1122,
 	}
 )
 
@@ -375,7 +480,7 @@ var file_api_proto_ipc_errors_proto_rawDesc = []byte{
 	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x69, 0x70,
 	0x63, 0x22, 0x28, 0x0a, 0x0c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
 	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0xef, 0x1a, 0x0a, 0x0c,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0xd6, 0x25, 0x0a, 0x0c,
 	0x57, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0b, 0x0a, 0x07,
 	0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x2a, 0x0a, 0x25, 0x54, 0x4f, 0x55,
 	0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x45, 0x47, 0x41, 0x54, 0x49, 0x56, 0x45,
@@ -590,11 +695,97 @@ var file_api_proto_ipc_errors_proto_rawDesc = []byte{
 	0x45, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x4d, 0x50, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f,
 	0x55, 0x4e, 0x44, 0x10, 0xbd, 0x08, 0x12, 0x25, 0x0a, 0x20, 0x50, 0x55, 0x5a, 0x5a, 0x4c, 0x45,
 	0x5f, 0x47, 0x45, 0x54, 0x5f, 0x50, 0x55, 0x5a, 0x5a, 0x4c, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41,
-	0x54, 0x45, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x4d, 0x50, 0x54, 0x10, 0xbe, 0x08, 0x42, 0x2f, 0x5a,
-	0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x6f, 0x6d, 0x69,
-	0x6e, 0x6f, 0x31, 0x34, 0x2f, 0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2f, 0x72, 0x70, 0x63,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x70, 0x63, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x54, 0x45, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x4d, 0x50, 0x54, 0x10, 0xbe, 0x08, 0x12, 0x31, 0x0a,
+	0x2c, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x5f, 0x44, 0x4f, 0x55, 0x42, 0x4c, 0x45, 0x5f, 0x45, 0x4c, 0x49, 0x4d, 0x49,
+	0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x53, 0x10, 0xc0, 0x08,
+	0x12, 0x1a, 0x0a, 0x15, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e,
+	0x4f, 0x5f, 0x42, 0x52, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x10, 0xc1, 0x08, 0x12, 0x26, 0x0a, 0x21,
+	0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x45, 0x47, 0x41, 0x54,
+	0x49, 0x56, 0x45, 0x5f, 0x4e, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x47, 0x52, 0x41, 0x43,
+	0x45, 0x10, 0xc2, 0x08, 0x12, 0x23, 0x0a, 0x1e, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45,
+	0x4e, 0x54, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0xc3, 0x08, 0x12, 0x2c, 0x0a, 0x27, 0x54, 0x4f, 0x55,
+	0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x52, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x41, 0x44, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x50, 0x41,
+	0x53, 0x53, 0x45, 0x44, 0x10, 0xc4, 0x08, 0x12, 0x22, 0x0a, 0x1d, 0x54, 0x4f, 0x55, 0x52, 0x4e,
+	0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x52, 0x45,
+	0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x45, 0x44, 0x10, 0xc5, 0x08, 0x12, 0x23, 0x0a, 0x1e, 0x54,
+	0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47,
+	0x5f, 0x4f, 0x55, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10, 0xc6, 0x08,
+	0x12, 0x1e, 0x0a, 0x19, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x45, 0x44, 0x10, 0xc7, 0x08,
+	0x12, 0x24, 0x0a, 0x1f, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x41,
+	0x4e, 0x47, 0x45, 0x10, 0xc8, 0x08, 0x12, 0x24, 0x0a, 0x1f, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x45, 0x47, 0x41, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x4d, 0x41,
+	0x58, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x53, 0x10, 0xc9, 0x08, 0x12, 0x21, 0x0a, 0x1c,
+	0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b,
+	0x5f, 0x49, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0xca, 0x08, 0x12,
+	0x1f, 0x0a, 0x1a, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48,
+	0x45, 0x43, 0x4b, 0x5f, 0x49, 0x4e, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0xcb, 0x08,
+	0x12, 0x28, 0x0a, 0x23, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e,
+	0x45, 0x47, 0x41, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x49, 0x4e,
+	0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x10, 0xcc, 0x08, 0x12, 0x2a, 0x0a, 0x25, 0x54, 0x4f,
+	0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x49, 0x52,
+	0x49, 0x4e, 0x47, 0x10, 0xcd, 0x08, 0x12, 0x23, 0x0a, 0x1e, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x41, 0x49,
+	0x52, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x41, 0x47, 0x10, 0xce, 0x08, 0x12, 0x26, 0x0a, 0x21, 0x54,
+	0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x45, 0x47, 0x41, 0x54, 0x49,
+	0x56, 0x45, 0x5f, 0x43, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x55, 0x50, 0x5f, 0x42, 0x59, 0x45, 0x53,
+	0x10, 0xcf, 0x08, 0x12, 0x2e, 0x0a, 0x29, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x53, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44,
+	0x10, 0xd0, 0x08, 0x12, 0x2e, 0x0a, 0x29, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x5f, 0x4e, 0x45, 0x58, 0x54, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e,
+	0x10, 0xd1, 0x08, 0x12, 0x23, 0x0a, 0x1e, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x4e, 0x45, 0x47, 0x41, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x4f,
+	0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0xd2, 0x08, 0x12, 0x1c, 0x0a, 0x17, 0x54, 0x4f, 0x55, 0x52,
+	0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x5f, 0x4c, 0x41, 0x44,
+	0x44, 0x45, 0x52, 0x10, 0xd3, 0x08, 0x12, 0x27, 0x0a, 0x22, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4c, 0x41, 0x44,
+	0x44, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x53, 0x10, 0xd4, 0x08, 0x12,
+	0x27, 0x0a, 0x22, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x41,
+	0x44, 0x44, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x5f, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x45, 0x54,
+	0x54, 0x49, 0x4e, 0x47, 0x53, 0x10, 0xd5, 0x08, 0x12, 0x2d, 0x0a, 0x28, 0x54, 0x4f, 0x55, 0x52,
+	0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x41, 0x44, 0x44, 0x45, 0x52, 0x5f, 0x43, 0x48,
+	0x41, 0x4c, 0x4c, 0x45, 0x4e, 0x47, 0x45, 0x5f, 0x4f, 0x55, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x52,
+	0x41, 0x4e, 0x47, 0x45, 0x10, 0xd6, 0x08, 0x12, 0x28, 0x0a, 0x23, 0x54, 0x4f, 0x55, 0x52, 0x4e,
+	0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x41, 0x44, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x4c, 0x41,
+	0x59, 0x45, 0x52, 0x5f, 0x43, 0x48, 0x41, 0x4c, 0x4c, 0x45, 0x4e, 0x47, 0x45, 0x44, 0x10, 0xd7,
+	0x08, 0x12, 0x2c, 0x0a, 0x27, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x4c, 0x41, 0x44, 0x44, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x58, 0x49, 0x53, 0x54, 0x45,
+	0x4e, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4c, 0x4c, 0x45, 0x4e, 0x47, 0x45, 0x10, 0xd8, 0x08, 0x12,
+	0x24, 0x0a, 0x1f, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x4c, 0x45, 0x52, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0xd9, 0x08, 0x12, 0x25, 0x0a, 0x20, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f,
+	0x44, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0xda, 0x08, 0x12, 0x23, 0x0a, 0x1e,
+	0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x52,
+	0x41, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x53, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x10, 0xdb,
+	0x08, 0x12, 0x1a, 0x0a, 0x15, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x5f, 0x43, 0x4c, 0x55, 0x42, 0x10, 0xdc, 0x08, 0x12, 0x21, 0x0a,
+	0x1c, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4c, 0x55, 0x42,
+	0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x53, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0xdd, 0x08,
+	0x12, 0x27, 0x0a, 0x22, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e,
+	0x4f, 0x4e, 0x45, 0x58, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4c, 0x55, 0x42, 0x5f,
+	0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x10, 0xde, 0x08, 0x12, 0x2d, 0x0a, 0x28, 0x54, 0x4f, 0x55,
+	0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f,
+	0x43, 0x4c, 0x55, 0x42, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x43, 0x48,
+	0x45, 0x44, 0x55, 0x4c, 0x45, 0x10, 0xdf, 0x08, 0x12, 0x2d, 0x0a, 0x28, 0x54, 0x4f, 0x55, 0x52,
+	0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43,
+	0x4c, 0x55, 0x42, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x45, 0x4d, 0x50,
+	0x4c, 0x41, 0x54, 0x45, 0x10, 0xe0, 0x08, 0x12, 0x1d, 0x0a, 0x18, 0x54, 0x4f, 0x55, 0x52, 0x4e,
+	0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x52,
+	0x49, 0x5a, 0x45, 0x10, 0xe1, 0x08, 0x12, 0x2c, 0x0a, 0x27, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44,
+	0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x49, 0x56, 0x49, 0x53, 0x49, 0x4f,
+	0x4e, 0x10, 0xe2, 0x08, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x64, 0x6f, 0x6d, 0x69, 0x6e, 0x6f, 0x31, 0x34, 0x2f, 0x6c, 0x69, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x69, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -74,6 +74,11 @@ const (
 	MessageType_ACTIVE_GAME_ENTRY                            MessageType = 41
 	MessageType_GAME_META_EVENT                              MessageType = 42
 	MessageType_PROFILE_UPDATE_EVENT                         MessageType = 43
+	MessageType_QUICKPAIR_REQUEST                            MessageType = 44
+	MessageType_QUICKPAIR_STATUS                             MessageType = 45
+	MessageType_LADDER_CHALLENGE_ACCEPT                      MessageType = 46
+	MessageType_LADDER_MESSAGE                               MessageType = 47
+	MessageType_LADDER_CHALLENGE_DECLINE                     MessageType = 48
 )
 
 // Enum value maps for MessageType.
@@ -121,6 +126,11 @@ var (
 		41: "ACTIVE_GAME_ENTRY",
 		42: "GAME_META_EVENT",
 		43: "PROFILE_UPDATE_EVENT",
+		44: "QUICKPAIR_REQUEST",
+		45: "QUICKPAIR_STATUS",
+		46: "LADDER_CHALLENGE_ACCEPT",
+		47: "LADDER_MESSAGE",
+		48: "LADDER_CHALLENGE_DECLINE",
 	}
 	MessageType_value = map[string]int32{
 		"SEEK_REQUEST":                                 0,
@@ -165,6 +175,11 @@ var (
 		"ACTIVE_GAME_ENTRY":                            41,
 		"GAME_META_EVENT":                              42,
 		"PROFILE_UPDATE_EVENT":                         43,
+		"QUICKPAIR_REQUEST":                            44,
+		"QUICKPAIR_STATUS":                             45,
+		"LADDER_CHALLENGE_ACCEPT":                      46,
+		"LADDER_MESSAGE":                               47,
+		"LADDER_CHALLENGE_DECLINE":                     48,
 	}
 )
 
@@ -612,7 +627,7 @@ var file_api_proto_ipc_ipc_proto_rawDesc = []byte{
 	0x73, 0x61, 0x67, 0x65, 0x22, 0x1e, 0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x50, 0x61, 0x74, 0x68,
 	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
 	0x70, 0x61, 0x74, 0x68, 0x22, 0x0d, 0x0a, 0x0b, 0x55, 0x6e, 0x6a, 0x6f, 0x69, 0x6e, 0x52, 0x65,
-	0x61, 0x6c, 0x6d, 0x2a, 0xfd, 0x08, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54,
+	0x61, 0x6c, 0x6d, 0x2a, 0xf9, 0x09, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54,
 	0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x45, 0x45, 0x4b, 0x5f, 0x52, 0x45, 0x51, 0x55,
 	0x45, 0x53, 0x54, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52,
 	0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x4f, 0x55, 0x47,
@@ -684,10 +699,18 @@ var file_api_proto_ipc_ipc_proto_rawDesc = []byte{
 	0x52, 0x59, 0x10, 0x29, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x4d, 0x45, 0x54,
 	0x41, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x2a, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x52, 0x4f,
 	0x46, 0x49, 0x4c, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x10, 0x2b, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x64, 0x6f, 0x6d, 0x69, 0x6e, 0x6f, 0x31, 0x34, 0x2f, 0x6c, 0x69, 0x77, 0x6f, 0x72,
-	0x64, 0x73, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x69, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x54, 0x10, 0x2b, 0x12, 0x15, 0x0a, 0x11, 0x51, 0x55, 0x49, 0x43, 0x4b, 0x50, 0x41, 0x49, 0x52,
+	0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x2c, 0x12, 0x14, 0x0a, 0x10, 0x51, 0x55,
+	0x49, 0x43, 0x4b, 0x50, 0x41, 0x49, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x2d,
+	0x12, 0x1b, 0x0a, 0x17, 0x4c, 0x41, 0x44, 0x44, 0x45, 0x52, 0x5f, 0x43, 0x48, 0x41, 0x4c, 0x4c,
+	0x45, 0x4e, 0x47, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x10, 0x2e, 0x12, 0x12, 0x0a,
+	0x0e, 0x4c, 0x41, 0x44, 0x44, 0x45, 0x52, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x10,
+	0x2f, 0x12, 0x1c, 0x0a, 0x18, 0x4c, 0x41, 0x44, 0x44, 0x45, 0x52, 0x5f, 0x43, 0x48, 0x41, 0x4c,
+	0x4c, 0x45, 0x4e, 0x47, 0x45, 0x5f, 0x44, 0x45, 0x43, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x30, 0x42,
+	0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x6f,
+	0x6d, 0x69, 0x6e, 0x6f, 0x31, 0x34, 0x2f, 0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2f, 0x72,
+	0x70, 0x63, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x70, 0x63,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -105,33 +105,49 @@ const (
 	PairingMethod_QUICKPAIR        PairingMethod = 7
 	PairingMethod_MANUAL           PairingMethod = 8
 	PairingMethod_TEAM_ROUND_ROBIN PairingMethod = 9
+	// DOUBLE_ELIMINATION: winners and losers brackets, followed by a grand
+	// final. The grand final reset is played if the division has 2n + 1
+	// rounds for 2^n players.
+	PairingMethod_DOUBLE_ELIMINATION PairingMethod = 10
+	// SEEDED_SPLIT pairs the top half of the players by rating against the
+	// bottom half in order: 1 v n/2+1, 2 v n/2+2 and so on.
+	PairingMethod_SEEDED_SPLIT PairingMethod = 11
+	// SEEDED_FOLD pairs the players by rating from the outside in:
+	// 1 v n, 2 v n-1 and so on.
+	PairingMethod_SEEDED_FOLD PairingMethod = 12
 )
 
 // Enum value maps for PairingMethod.
 var (
 	PairingMethod_name = map[int32]string{
-		0: "RANDOM",
-		1: "ROUND_ROBIN",
-		2: "KING_OF_THE_HILL",
-		3: "ELIMINATION",
-		4: "FACTOR",
-		5: "INITIAL_FONTES",
-		6: "SWISS",
-		7: "QUICKPAIR",
-		8: "MANUAL",
-		9: "TEAM_ROUND_ROBIN",
+		0:  "RANDOM",
+		1:  "ROUND_ROBIN",
+		2:  "KING_OF_THE_HILL",
+		3:  "ELIMINATION",
+		4:  "FACTOR",
+		5:  "INITIAL_FONTES",
+		6:  "SWISS",
+		7:  "QUICKPAIR",
+		8:  "MANUAL",
+		9:  "TEAM_ROUND_ROBIN",
+		10: "DOUBLE_ELIMINATION",
+		11: "SEEDED_SPLIT",
+		12: "SEEDED_FOLD",
 	}
 	PairingMethod_value = map[string]int32{
-		"RANDOM":           0,
-		"ROUND_ROBIN":      1,
-		"KING_OF_THE_HILL": 2,
-		"ELIMINATION":      3,
-		"FACTOR":           4,
-		"INITIAL_FONTES":   5,
-		"SWISS":            6,
-		"QUICKPAIR":        7,
-		"MANUAL":           8,
-		"TEAM_ROUND_ROBIN": 9,
+		"RANDOM":             0,
+		"ROUND_ROBIN":        1,
+		"KING_OF_THE_HILL":   2,
+		"ELIMINATION":        3,
+		"FACTOR":             4,
+		"INITIAL_FONTES":     5,
+		"SWISS":              6,
+		"QUICKPAIR":          7,
+		"MANUAL":             8,
+		"TEAM_ROUND_ROBIN":   9,
+		"DOUBLE_ELIMINATION": 10,
+		"SEEDED_SPLIT":       11,
+		"SEEDED_FOLD":        12,
 	}
 )
 
@@ -211,6 +227,265 @@ func (FirstMethod) EnumDescriptor() ([]byte, []int) {
 	return file_api_proto_ipc_tournament_proto_rawDescGZIP(), []int{2}
 }
 
+// WithdrawalPolicy decides what happens to a player who withdraws from
+// a division after it has started. Either way, their past results are
+// kept and they are no longer paired.
+type WithdrawalPolicy int32
+
+const (
+	// The player is dropped from the standings.
+	WithdrawalPolicy_WITHDRAW_DROP WithdrawalPolicy = 0
+	// The player stays in the standings, with a forfeit loss
+	// for every remaining round.
+	WithdrawalPolicy_WITHDRAW_FORFEIT WithdrawalPolicy = 1
+)
+
+// Enum value maps for WithdrawalPolicy.
+var (
+	WithdrawalPolicy_name = map[int32]string{
+		0: "WITHDRAW_DROP",
+		1: "WITHDRAW_FORFEIT",
+	}
+	WithdrawalPolicy_value = map[string]int32{
+		"WITHDRAW_DROP":    0,
+		"WITHDRAW_FORFEIT": 1,
+	}
+)
+
+func (x WithdrawalPolicy) Enum() *WithdrawalPolicy {
+	p := new(WithdrawalPolicy)
+	*p = x
+	return p
+}
+
+func (x WithdrawalPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WithdrawalPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_ipc_tournament_proto_enumTypes[3].Descriptor()
+}
+
+func (WithdrawalPolicy) Type() protoreflect.EnumType {
+	return &file_api_proto_ipc_tournament_proto_enumTypes[3]
+}
+
+func (x WithdrawalPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WithdrawalPolicy.Descriptor instead.
+func (WithdrawalPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_ipc_tournament_proto_rawDescGZIP(), []int{3}
+}
+
+type BracketSide int32
+
+const (
+	BracketSide_WINNERS_BRACKET     BracketSide = 0
+	BracketSide_LOSERS_BRACKET      BracketSide = 1
+	BracketSide_GRAND_FINAL         BracketSide = 2
+	BracketSide_CONSOLATION_BRACKET BracketSide = 3
+)
+
+// Enum value maps for BracketSide.
+var (
+	BracketSide_name = map[int32]string{
+		0: "WINNERS_BRACKET",
+		1: "LOSERS_BRACKET",
+		2: "GRAND_FINAL",
+		3: "CONSOLATION_BRACKET",
+	}
+	BracketSide_value = map[string]int32{
+		"WINNERS_BRACKET":     0,
+		"LOSERS_BRACKET":      1,
+		"GRAND_FINAL":         2,
+		"CONSOLATION_BRACKET": 3,
+	}
+)
+
+func (x BracketSide) Enum() *BracketSide {
+	p := new(BracketSide)
+	*p = x
+	return p
+}
+
+func (x BracketSide) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BracketSide) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_ipc_tournament_proto_enumTypes[4].Descriptor()
+}
+
+func (BracketSide) Type() protoreflect.EnumType {
+	return &file_api_proto_ipc_tournament_proto_enumTypes[4]
+}
+
+func (x BracketSide) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BracketSide.Descriptor instead.
+func (BracketSide) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_ipc_tournament_proto_rawDescGZIP(), []int{4}
+}
+
+type ClubRole int32
+
+const (
+	ClubRole_CLUB_MEMBER ClubRole = 0
+	// Officers can add and remove the members of the club, which is
+	// otherwise up to its directors.
+	ClubRole_CLUB_OFFICER ClubRole = 1
+)
+
+// Enum value maps for ClubRole.
+var (
+	ClubRole_name = map[int32]string{
+		0: "CLUB_MEMBER",
+		1: "CLUB_OFFICER",
+	}
+	ClubRole_value = map[string]int32{
+		"CLUB_MEMBER":  0,
+		"CLUB_OFFICER": 1,
+	}
+)
+
+func (x ClubRole) Enum() *ClubRole {
+	p := new(ClubRole)
+	*p = x
+	return p
+}
+
+func (x ClubRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClubRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_ipc_tournament_proto_enumTypes[5].Descriptor()
+}
+
+func (ClubRole) Type() protoreflect.EnumType {
+	return &file_api_proto_ipc_tournament_proto_enumTypes[5]
+}
+
+func (x ClubRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClubRole.Descriptor instead.
+func (ClubRole) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_ipc_tournament_proto_rawDescGZIP(), []int{5}
+}
+
+type PrizeType int32
+
+const (
+	PrizeType_PLACE_PRIZE PrizeType = 0
+	// Class prizes go to the best placed players rated within a rating band
+	// who have not won a place prize.
+	PrizeType_CLASS_PRIZE PrizeType = 1
+	// High game and high loss prizes go to the highest score of a game, and
+	// of a lost game. Games that were forfeited, resigned, lost on time or
+	// lost to a triple challenge do not count.
+	PrizeType_HIGH_GAME_PRIZE PrizeType = 2
+	PrizeType_HIGH_LOSS_PRIZE PrizeType = 3
+)
+
+// Enum value maps for PrizeType.
+var (
+	PrizeType_name = map[int32]string{
+		0: "PLACE_PRIZE",
+		1: "CLASS_PRIZE",
+		2: "HIGH_GAME_PRIZE",
+		3: "HIGH_LOSS_PRIZE",
+	}
+	PrizeType_value = map[string]int32{
+		"PLACE_PRIZE":     0,
+		"CLASS_PRIZE":     1,
+		"HIGH_GAME_PRIZE": 2,
+		"HIGH_LOSS_PRIZE": 3,
+	}
+)
+
+func (x PrizeType) Enum() *PrizeType {
+	p := new(PrizeType)
+	*p = x
+	return p
+}
+
+func (x PrizeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PrizeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_ipc_tournament_proto_enumTypes[6].Descriptor()
+}
+
+func (PrizeType) Type() protoreflect.EnumType {
+	return &file_api_proto_ipc_tournament_proto_enumTypes[6]
+}
+
+func (x PrizeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PrizeType.Descriptor instead.
+func (PrizeType) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_ipc_tournament_proto_rawDescGZIP(), []int{6}
+}
+
+type PrizeTieRule int32
+
+const (
+	// Players with the same wins and spread split the prizes for the places
+	// they hold between them.
+	PrizeTieRule_SPLIT_BY_WINS_AND_SPREAD PrizeTieRule = 0
+	// Players with the same wins split the prizes for the places they hold
+	// between them, whatever their spread.
+	PrizeTieRule_SPLIT_BY_WINS PrizeTieRule = 1
+)
+
+// Enum value maps for PrizeTieRule.
+var (
+	PrizeTieRule_name = map[int32]string{
+		0: "SPLIT_BY_WINS_AND_SPREAD",
+		1: "SPLIT_BY_WINS",
+	}
+	PrizeTieRule_value = map[string]int32{
+		"SPLIT_BY_WINS_AND_SPREAD": 0,
+		"SPLIT_BY_WINS":            1,
+	}
+)
+
+func (x PrizeTieRule) Enum() *PrizeTieRule {
+	p := new(PrizeTieRule)
+	*p = x
+	return p
+}
+
+func (x PrizeTieRule) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PrizeTieRule) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_ipc_tournament_proto_enumTypes[7].Descriptor()
+}
+
+func (PrizeTieRule) Type() protoreflect.EnumType {
+	return &file_api_proto_ipc_tournament_proto_enumTypes[7]
+}
+
+func (x PrizeTieRule) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PrizeTieRule.Descriptor instead.
+func (PrizeTieRule) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_ipc_tournament_proto_rawDescGZIP(), []int{7}
+}
+
 // New tournaments will use full tournament
 // messages (specifically, TournamentDivisionDataResponse et al).
 // This event is also used in the tournament_service's RecentGamesResponse,
@@ -490,6 +765,9 @@ type TournamentPerson struct {
 	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Rating    int32  `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`
 	Suspended bool   `protobuf:"varint,3,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	CheckedIn bool   `protobuf:"varint,4,opt,name=checked_in,json=checkedIn,proto3" json:"checked_in,omitempty"`
+	// withdrawal_policy only applies while the player is suspended.
+	WithdrawalPolicy WithdrawalPolicy `protobuf:"varint,5,opt,name=withdrawal_policy,json=withdrawalPolicy,proto3,enum=ipc.WithdrawalPolicy" json:"withdrawal_policy,omitempty"`
 }
 
 func (x *TournamentPerson) Reset() {
@@ -545,6 +823,20 @@ func (x *TournamentPerson) GetSuspended() bool {
 	return false
 }
 
+func (x *TournamentPerson) GetCheckedIn() bool {
+	if x != nil {
+		return x.CheckedIn
+	}
+	return false
+}
+
+func (x *TournamentPerson) GetWithdrawalPolicy() WithdrawalPolicy {
+	if x != nil {
+		return x.WithdrawalPolicy
+	}
+	return WithdrawalPolicy_WITHDRAW_DROP
+}
+
 type TournamentPersons struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -623,6 +915,37 @@ type RoundControl struct {
 	AllowOverMaxRepeats         bool          `protobuf:"varint,8,opt,name=allow_over_max_repeats,json=allowOverMaxRepeats,proto3" json:"allow_over_max_repeats,omitempty"`
 	RepeatRelativeWeight        int32         `protobuf:"varint,9,opt,name=repeat_relative_weight,json=repeatRelativeWeight,proto3" json:"repeat_relative_weight,omitempty"`
 	WinDifferenceRelativeWeight int32         `protobuf:"varint,10,opt,name=win_difference_relative_weight,json=winDifferenceRelativeWeight,proto3" json:"win_difference_relative_weight,omitempty"`
+	// consolation is only used for ELIMINATION divisions. If set, players
+	// that are knocked out keep playing each other for the remaining places.
+	// It applies to the entire division and must be the same for every round.
+	Consolation bool `protobuf:"varint,11,opt,name=consolation,proto3" json:"consolation,omitempty"`
+	// scheduled_start_time, if set, is when the round starts automatically,
+	// provided the previous round is complete.
+	ScheduledStartTime *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=scheduled_start_time,json=scheduledStartTime,proto3" json:"scheduled_start_time,omitempty"`
+	// no_show_grace_seconds is how long after the scheduled start time
+	// players have to show up for their game. Players who have not
+	// shown up by then forfeit. Zero disables automatic forfeits.
+	NoShowGraceSeconds int32 `protobuf:"varint,13,opt,name=no_show_grace_seconds,json=noShowGraceSeconds,proto3" json:"no_show_grace_seconds,omitempty"`
+	// firsts_relative_weight makes SWISS and FACTOR pairings avoid pairing
+	// players who are both due to go first, or both due to go second.
+	// Zero leaves firsts and seconds out of the pairings.
+	FirstsRelativeWeight int32 `protobuf:"varint,14,opt,name=firsts_relative_weight,json=firstsRelativeWeight,proto3" json:"firsts_relative_weight,omitempty"`
+	// contention_pairing makes SWISS and FACTOR pairings pair players who
+	// can still reach a prize place, up to the division's minimum_placement,
+	// against each other, and everyone else against each other.
+	ContentionPairing bool `protobuf:"varint,15,opt,name=contention_pairing,json=contentionPairing,proto3" json:"contention_pairing,omitempty"`
+	// pairing_lag is how many more rounds the pairings of this round lag
+	// behind the standings. Round N is normally paired from the standings
+	// after round N-1, as soon as that round is over. With a lag of k, it is
+	// paired from the standings after round N-1-k instead, as soon as that
+	// round is over, or when the division starts if N-1-k is before round 1.
+	PairingLag int32 `protobuf:"varint,16,opt,name=pairing_lag,json=pairingLag,proto3" json:"pairing_lag,omitempty"`
+	// accelerated makes SWISS pairings use Baku acceleration.
+	// The top half of the players by rating, rounded up to an even number,
+	// get a virtual win in the first half of the accelerated rounds, rounded
+	// up, and a virtual draw in the rest of them, so that they play each
+	// other early on.
+	Accelerated bool `protobuf:"varint,17,opt,name=accelerated,proto3" json:"accelerated,omitempty"`
 }
 
 func (x *RoundControl) Reset() {
@@ -727,6 +1050,55 @@ func (x *RoundControl) GetWinDifferenceRelativeWeight() int32 {
 	return 0
 }
 
+func (x *RoundControl) GetConsolation() bool {
+	if x != nil {
+		return x.Consolation
+	}
+	return false
+}
+
+func (x *RoundControl) GetScheduledStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledStartTime
+	}
+	return nil
+}
+
+func (x *RoundControl) GetNoShowGraceSeconds() int32 {
+	if x != nil {
+		return x.NoShowGraceSeconds
+	}
+	return 0
+}
+
+func (x *RoundControl) GetFirstsRelativeWeight() int32 {
+	if x != nil {
+		return x.FirstsRelativeWeight
+	}
+	return 0
+}
+
+func (x *RoundControl) GetContentionPairing() bool {
+	if x != nil {
+		return x.ContentionPairing
+	}
+	return false
+}
+
+func (x *RoundControl) GetPairingLag() int32 {
+	if x != nil {
+		return x.PairingLag
+	}
+	return 0
+}
+
+func (x *RoundControl) GetAccelerated() bool {
+	if x != nil {
+		return x.Accelerated
+	}
+	return false
+}
+
 type DivisionControls struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -743,6 +1115,32 @@ type DivisionControls struct {
 	GibsonSpread        int32                `protobuf:"varint,9,opt,name=gibson_spread,json=gibsonSpread,proto3" json:"gibson_spread,omitempty"`
 	MinimumPlacement    int32                `protobuf:"varint,10,opt,name=minimum_placement,json=minimumPlacement,proto3" json:"minimum_placement,omitempty"`
 	MaximumByePlacement int32                `protobuf:"varint,11,opt,name=maximum_bye_placement,json=maximumByePlacement,proto3" json:"maximum_bye_placement,omitempty"`
+	// open_registration allows players to register themselves
+	// for the division.
+	OpenRegistration bool `protobuf:"varint,12,opt,name=open_registration,json=openRegistration,proto3" json:"open_registration,omitempty"`
+	// rating_floor and rating_ceiling restrict self-service registration
+	// by the player's rating in the division's game variant.
+	// Zero means there is no limit.
+	RatingFloor   int32 `protobuf:"varint,13,opt,name=rating_floor,json=ratingFloor,proto3" json:"rating_floor,omitempty"`
+	RatingCeiling int32 `protobuf:"varint,14,opt,name=rating_ceiling,json=ratingCeiling,proto3" json:"rating_ceiling,omitempty"`
+	// max_players caps the size of the division. Players who register once
+	// it is full are put on the waitlist. Zero means there is no cap.
+	MaxPlayers           int32                  `protobuf:"varint,15,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
+	RegistrationDeadline *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=registration_deadline,json=registrationDeadline,proto3" json:"registration_deadline,omitempty"`
+	// If registration_requires_approval is set, registrations must be
+	// approved by a director before the player is added to the division.
+	RegistrationRequiresApproval bool `protobuf:"varint,17,opt,name=registration_requires_approval,json=registrationRequiresApproval,proto3" json:"registration_requires_approval,omitempty"`
+	// Players who have not checked in by check_in_deadline are suspended,
+	// or removed if remove_unchecked_in is set, before round 1 starts.
+	CheckInDeadline *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=check_in_deadline,json=checkInDeadline,proto3" json:"check_in_deadline,omitempty"`
+	// check_in_window_seconds is how long before the deadline check-in
+	// opens. Zero means players can check in any time before the deadline.
+	CheckInWindowSeconds int32 `protobuf:"varint,19,opt,name=check_in_window_seconds,json=checkInWindowSeconds,proto3" json:"check_in_window_seconds,omitempty"`
+	RemoveUncheckedIn    bool  `protobuf:"varint,20,opt,name=remove_unchecked_in,json=removeUncheckedIn,proto3" json:"remove_unchecked_in,omitempty"`
+	// If rate_as_period is set, the games of the division are not rated as
+	// they end. Once the division finishes, all of its games are rated
+	// together in one rating period.
+	RateAsPeriod bool `protobuf:"varint,21,opt,name=rate_as_period,json=rateAsPeriod,proto3" json:"rate_as_period,omitempty"`
 }
 
 func (x *DivisionControls) Reset() {
@@ -854,6 +1252,76 @@ func (x *DivisionControls) GetMaximumByePlacement() int32 {
 	return 0
 }
 
+func (x *DivisionControls) GetOpenRegistration() bool {
+	if x != nil {
+		return x.OpenRegistration
+	}
+	return false
+}
+
+func (x *DivisionControls) GetRatingFloor() int32 {
+	if x != nil {
+		return x.RatingFloor
+	}
+	return 0
+}
+
+func (x *DivisionControls) GetRatingCeiling() int32 {
+	if x != nil {
+		return x.RatingCeiling
+	}
+	return 0
+}
+
+func (x *DivisionControls) GetMaxPlayers() int32 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
+}
+
+func (x *DivisionControls) GetRegistrationDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RegistrationDeadline
+	}
+	return nil
+}
+
+func (x *DivisionControls) GetRegistrationRequiresApproval() bool {
+	if x != nil {
+		return x.RegistrationRequiresApproval
+	}
+	return false
+}
+
+func (x *DivisionControls) GetCheckInDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckInDeadline
+	}
+	return nil
+}
+
+func (x *DivisionControls) GetCheckInWindowSeconds() int32 {
+	if x != nil {
+		return x.CheckInWindowSeconds
+	}
+	return 0
+}
+
+func (x *DivisionControls) GetRemoveUncheckedIn() bool {
+	if x != nil {
+		return x.RemoveUncheckedIn
+	}
+	return false
+}
+
+func (x *DivisionControls) GetRateAsPeriod() bool {
+	if x != nil {
+		return x.RateAsPeriod
+	}
+	return false
+}
+
 type TournamentGame struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1856,18 +2324,34 @@ func (x *TournamentDivisionDeletedResponse) GetDivision() string {
 	return ""
 }
 
-type TournamentGameEndedEvent_Player struct {
+// BracketMatch is a single match in an elimination bracket. Players are
+// given as player IDs and are empty until the matches feeding into this
+// one are decided.
+type BracketMatch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string               `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Score    int32                `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
-	Result   TournamentGameResult `protobuf:"varint,3,opt,name=result,proto3,enum=ipc.TournamentGameResult" json:"result,omitempty"`
-}
-
-func (x *TournamentGameEndedEvent_Player) Reset() {
-	*x = TournamentGameEndedEvent_Player{}
+	Id   string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Side BracketSide `protobuf:"varint,2,opt,name=side,proto3,enum=ipc.BracketSide" json:"side,omitempty"`
+	// bracket_round is the round within the side of the bracket.
+	BracketRound int32 `protobuf:"varint,3,opt,name=bracket_round,json=bracketRound,proto3" json:"bracket_round,omitempty"`
+	// round is the (0-indexed) division round in which this match is played.
+	Round   int32    `protobuf:"varint,4,opt,name=round,proto3" json:"round,omitempty"`
+	Players []string `protobuf:"bytes,5,rep,name=players,proto3" json:"players,omitempty"`
+	Winner  string   `protobuf:"bytes,6,opt,name=winner,proto3" json:"winner,omitempty"`
+	Loser   string   `protobuf:"bytes,7,opt,name=loser,proto3" json:"loser,omitempty"`
+	// winner_to and loser_to are the IDs of the matches the winner and the
+	// loser advance to. An empty loser_to means the loser is eliminated.
+	WinnerTo   string            `protobuf:"bytes,8,opt,name=winner_to,json=winnerTo,proto3" json:"winner_to,omitempty"`
+	WinnerSlot int32             `protobuf:"varint,9,opt,name=winner_slot,json=winnerSlot,proto3" json:"winner_slot,omitempty"`
+	LoserTo    string            `protobuf:"bytes,10,opt,name=loser_to,json=loserTo,proto3" json:"loser_to,omitempty"`
+	LoserSlot  int32             `protobuf:"varint,11,opt,name=loser_slot,json=loserSlot,proto3" json:"loser_slot,omitempty"`
+	Games      []*TournamentGame `protobuf:"bytes,12,rep,name=games,proto3" json:"games,omitempty"`
+}
+
+func (x *BracketMatch) Reset() {
+	*x = BracketMatch{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_ipc_tournament_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1875,13 +2359,13 @@ func (x *TournamentGameEndedEvent_Player) Reset() {
 	}
 }
 
-func (x *TournamentGameEndedEvent_Player) String() string {
+func (x *BracketMatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TournamentGameEndedEvent_Player) ProtoMessage() {}
+func (*BracketMatch) ProtoMessage() {}
 
-func (x *TournamentGameEndedEvent_Player) ProtoReflect() protoreflect.Message {
+func (x *BracketMatch) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_ipc_tournament_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1893,33 +2377,1699 @@ func (x *TournamentGameEndedEvent_Player) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TournamentGameEndedEvent_Player.ProtoReflect.Descriptor instead.
-func (*TournamentGameEndedEvent_Player) Descriptor() ([]byte, []int) {
-	return file_api_proto_ipc_tournament_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use BracketMatch.ProtoReflect.Descriptor instead.
+func (*BracketMatch) Descriptor() ([]byte, []int) {
+	return file_api_proto_ipc_tournament_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *TournamentGameEndedEvent_Player) GetUsername() string {
+func (x *BracketMatch) GetId() string {
 	if x != nil {
-		return x.Username
+		return x.Id
 	}
 	return ""
 }
 
-func (x *TournamentGameEndedEvent_Player) GetScore() int32 {
+func (x *BracketMatch) GetSide() BracketSide {
 	if x != nil {
-		return x.Score
+		return x.Side
+	}
+	return BracketSide_WINNERS_BRACKET
+}
+
+func (x *BracketMatch) GetBracketRound() int32 {
+	if x != nil {
+		return x.BracketRound
 	}
 	return 0
 }
 
-func (x *TournamentGameEndedEvent_Player) GetResult() TournamentGameResult {
+func (x *BracketMatch) GetRound() int32 {
 	if x != nil {
-		return x.Result
+		return x.Round
 	}
-	return TournamentGameResult_NO_RESULT
+	return 0
 }
 
-var File_api_proto_ipc_tournament_proto protoreflect.FileDescriptor
+func (x *BracketMatch) GetPlayers() []string {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *BracketMatch) GetWinner() string {
+	if x != nil {
+		return x.Winner
+	}
+	return ""
+}
+
+func (x *BracketMatch) GetLoser() string {
+	if x != nil {
+		return x.Loser
+	}
+	return ""
+}
+
+func (x *BracketMatch) GetWinnerTo() string {
+	if x != nil {
+		return x.WinnerTo
+	}
+	return ""
+}
+
+func (x *BracketMatch) GetWinnerSlot() int32 {
+	if x != nil {
+		return x.WinnerSlot
+	}
+	return 0
+}
+
+func (x *BracketMatch) GetLoserTo() string {
+	if x != nil {
+		return x.LoserTo
+	}
+	return ""
+}
+
+func (x *BracketMatch) GetLoserSlot() int32 {
+	if x != nil {
+		return x.LoserSlot
+	}
+	return 0
+}
+
+func (x *BracketMatch) GetGames() []*TournamentGame {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+type BracketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Division string          `protobuf:"bytes,2,opt,name=division,proto3" json:"division,omitempty"`
+	Matches  []*BracketMatch `protobuf:"bytes,3,rep,name=matches,proto3" json:"matches,omitempty"`
+	// placements are the player IDs in their current bracket order.
+	Placements []string `protobuf:"bytes,4,rep,name=placements,proto3" json:"placements,omitempty"`
+	Champion   string   `protobuf:"bytes,5,opt,name=champion,proto3" json:"champion,omitempty"`
+}
+
+func (x *BracketResponse) Reset() {
+	*x = BracketResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_ipc_tournament_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BracketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BracketResponse) ProtoMessage() {}
+
+func (x *BracketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_ipc_tournament_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BracketResponse.ProtoReflect.Descriptor instead.
+func (*BracketResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_ipc_tournament_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BracketResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BracketResponse) GetDivision() string {
+	if x != nil {
+		return x.Division
+	}
+	return ""
+}
+
+func (x *BracketResponse) GetMatches() []*BracketMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *BracketResponse) GetPlacements() []string {
+	if x != nil {
+		return x.Placements
+	}
+	return nil
+}
+
+func (x *BracketResponse) GetChampion() string {
+	if x != nil {
+		return x.Champion
+	}
+	return ""
+}
+
+// PairingWeight is the weight that swiss pairings give to a pairing,
+// broken down by its parts. Lower weights are preferred.
+type PairingWeight struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WinDifference int64 `protobuf:"varint,1,opt,name=win_difference,json=winDifference,proto3" json:"win_difference,omitempty"`
+	Spread        int64 `protobuf:"varint,2,opt,name=spread,proto3" json:"spread,omitempty"`
+	Repeat        int64 `protobuf:"varint,3,opt,name=repeat,proto3" json:"repeat,omitempty"`
+	Firsts        int64 `protobuf:"varint,4,opt,name=firsts,proto3" json:"firsts,omitempty"`
+	Total         int64 `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	Contention    int64 `protobuf:"varint,6,opt,name=contention,proto3" json:"contention,omitempty"`
+}
+
+func (x *PairingWeight) Reset() {
+	*x = PairingWeight{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_ipc_tournament_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairingWeight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairingWeight) ProtoMessage() {}
+
+func (x *PairingWeight) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_ipc_tournament_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairingWeight.ProtoReflect.Descriptor instead.
+func (*PairingWeight) Descriptor() ([]byte, []int) {
+	return file_api_proto_ipc_tournament_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PairingWeight) GetWinDifference() int64 {
+	if x != nil {
+		return x.WinDifference
+	}
+	return 0
+}
+
+func (x *PairingWeight) GetSpread() int64 {
+	if x != nil {
+		return x.Spread
+	}
+	return 0
+}
+
+func (x *PairingWeight) GetRepeat() int64 {
+	if x != nil {
+		return x.Repeat
+	}
+	return 0
+}
+
+func (x *PairingWeight) GetFirsts() int64 {
+	if x != nil {
+		return x.Firsts
+	}
+	return 0
+}
+
+func (x *PairingWeight) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PairingWeight) GetContention() int64 {
+	if x != nil {
+		return x.Contention
+	}
+	return 0
+}
+
+type PlayerContention struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// rank is the player's place in the standings, starting at 0.
+	Rank int32 `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	// best_place is the best prize place, starting at 0, that the player
+	// can still reach, or -1 if they cannot reach any prize place.
+	BestPlace int32 `protobuf:"varint,3,opt,name=best_place,json=bestPlace,proto3" json:"best_place,omitempty"`
+}
+
+func (x *PlayerContention) Reset() {
+	*x = PlayerContention{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_ipc_tournament_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerContention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerContention) ProtoMessage() {}
+
+func (x *PlayerContention) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_ipc_tournament_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerContention.ProtoReflect.Descriptor instead.
+func (*PlayerContention) Descriptor() ([]byte, []int) {
+	return file_api_proto_ipc_tournament_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PlayerContention) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *PlayerContention) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *PlayerContention) GetBestPlace() int32 {
+	if x != nil {
+		return x.BestPlace
+	}
+	return 0
+}
+
+type PairingPreview struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Players []string `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	// previous_games is how many times the players have already played
+	// each other, or for a bye, how many byes the player already has.
+	PreviousGames int32 `protobuf:"varint,2,opt,name=previous_games,json=previousGames,proto3" json:"previous_games,omitempty"`
+	Repeat        bool  `protobuf:"varint,3,opt,name=repeat,proto3" json:"repeat,omitempty"`
+	Bye           bool  `protobuf:"varint,4,opt,name=bye,proto3" json:"bye,omitempty"`
+	// gibson is set if the pairing was made because of a gibsonization
+	// rather than by the pairing method.
+	Gibson bool `protobuf:"varint,5,opt,name=gibson,proto3" json:"gibson,omitempty"`
+	// weight is only set for pairing methods that weigh pairings.
+	Weight *PairingWeight `protobuf:"bytes,6,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *PairingPreview) Reset() {
+	*x = PairingPreview{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_ipc_tournament_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairingPreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairingPreview) ProtoMessage() {}
+
+func (x *PairingPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_ipc_tournament_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairingPreview.ProtoReflect.Descriptor instead.
+func (*PairingPreview) Descriptor() ([]byte, []int) {
+	return file_api_proto_ipc_tournament_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PairingPreview) GetPlayers() []string {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *PairingPreview) GetPreviousGames() int32 {
+	if x != nil {
+		return x.PreviousGames
+	}
+	return 0
+}
+
+func (x *PairingPreview) GetRepeat() bool {
+	if x != nil {
+		return x.Repeat
+	}
+	return false
+}
+
+func (x *PairingPreview) GetBye() bool {
+	if x != nil {
+		return x.Bye
+	}
+	return false
+}
+
+func (x *PairingPreview) GetGibson() bool {
+	if x != nil {
+		return x.Gibson
+	}
+	return false
+}
+
+func (x *PairingPreview) GetWeight() *PairingWeight {
+	if x != nil {
+		return x.Weight
+	}
+	return nil
+}
+
+// A PairingPreviewResponse shows the pairings that would be made for a
+// round, without making them.
+type PairingPreviewResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Division          string            `protobuf:"bytes,2,opt,name=division,proto3" json:"division,omitempty"`
+	Round             int32             `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	RoundControls     *RoundControl     `protobuf:"bytes,4,opt,name=round_controls,json=roundControls,proto3" json:"round_controls,omitempty"`
+	Pairings          []*PairingPreview `protobuf:"bytes,5,rep,name=pairings,proto3" json:"pairings,omitempty"`
+	GibsonizedPlayers []string          `protobuf:"bytes,6,rep,name=gibsonized_players,json=gibsonizedPlayers,proto3" json:"gibsonized_players,omitempty"`
+	// total_weight is the sum of the weights of the pairings.
+	TotalWeight int64 `protobuf:"varint,7,opt,name=total_weight,json=totalWeight,proto3" json:"total_weight,omitempty"`
+	// contention shows which prize places each player can still reach.
+	Contention []*PlayerContention `protobuf:"bytes,8,rep,name=contention,proto3" json:"contention,omitempty"`
+}
+
+func (x *PairingPreviewResponse) Reset() {
+	*x = PairingPreviewResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_ipc_tournament_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairingPreviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairingPreviewResponse) ProtoMessage() {}
+
+func (x *PairingPreviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_ipc_tournament_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairingPreviewResponse.ProtoReflect.Descriptor instead.
+func (*PairingPreviewResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_ipc_tournament_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PairingPreviewResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PairingPreviewResponse) GetDivision() string {
+	if x != nil {
+		return x.Division
+	}
+	return ""
+}
+
+func (x *PairingPreviewResponse) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *PairingPreviewResponse) GetRoundControls() *RoundControl {
+	if x != nil {
+		return x.RoundControls
+	}
+	return nil
+}
+
+func (x *PairingPreviewResponse) GetPairings() []*PairingPreview {
+	if x != nil {
+		return x.Pairings
+	}
+	return nil
+}
+
+func (x *PairingPreviewResponse) GetGibsonizedPlayers() []string {
+	if x != nil {
+		return x.GibsonizedPlayers
+	}
+	return nil
+}
+
+func (x *PairingPreviewResponse) GetTotalWeight() int64 {
+	if x != nil {
+		return x.TotalWeight
+	}
+	return 0
+}
+
+func (x *PairingPreviewResponse) GetContention() []*PlayerContention {
+	if x != nil {
+		return x.Contention
+	}
+	return nil
+}
+
+type LadderChallenge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// challenger and challenged are player IDs, the challenger being the
+	// lower ranked player.
+	Challenger string                 `protobuf:"bytes,2,opt,name=challenger,proto3" json:"challenger,omitempty"`
+	Challenged string                 `protobuf:"bytes,3,opt,name=challenged,proto3" json:"challenged,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The challenged player forfeits if they do not accept the challenge
+	// by the deadline.
+	Deadline *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// game_id is set once the game of an accepted challenge is created.
+	GameId string `protobuf:"bytes,6,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// accepted_at is set once the challenged player accepts the challenge.
+	AcceptedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=accepted_at,json=acceptedAt,proto3" json:"accepted_at,omitempty"`
+}
+
+func (x *LadderChallenge) Reset() {
+	*x = LadderChallenge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_ipc_tournament_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LadderChallenge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LadderChallenge) ProtoMessage() {}
+
+func (x *LadderChallenge) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_ipc_tournament_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LadderChallenge.ProtoReflect.Descriptor instead.
+func (*LadderChallenge) Descriptor() ([]byte, []int) {
+	return file_api_proto_ipc_tournament_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *LadderChallenge) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LadderChallenge) GetChallenger() string {
+	if x != nil {
+		return x.Challenger
+	}
+	return ""
+}
+
+func (x *LadderChallenge) GetChallenged() string {
+	if x != nil {
+		return x.Challenged
+	}
+	return ""
+}
+
+func (x *LadderChallenge) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *LadderChallenge) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *LadderChallenge) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *LadderChallenge) GetAcceptedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcceptedAt
+	}
+	return nil
+}
+
+// A Ladder is a competition in which players hold ranks and move up by
+// beating the players above them.
+type Ladder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// players are ordered by rank, the first player holding the top rank.
+	Players []*TournamentPerson `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`
+	// max_challenge_distance is how many places above themselves
+	// players can challenge.
+	MaxChallengeDistance int32 `protobuf:"varint,3,opt,name=max_challenge_distance,json=maxChallengeDistance,proto3" json:"max_challenge_distance,omitempty"`
+	// accept_seconds is how long a challenged player has to accept.
+	AcceptSeconds int32              `protobuf:"varint,4,opt,name=accept_seconds,json=acceptSeconds,proto3" json:"accept_seconds,omitempty"`
+	Challenges    []*LadderChallenge `protobuf:"bytes,5,rep,name=challenges,proto3" json:"challenges,omitempty"`
+}
+
+func (x *Ladder) Reset() {
+	*x = Ladder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_ipc_tournament_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ladder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ladder) ProtoMessage() {}
+
+func (x *Ladder) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_ipc_tournament_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ladder.ProtoReflect.Descriptor instead.
+func (*Ladder) Descriptor() ([]byte, []int) {
+	return file_api_proto_ipc_tournament_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Ladder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Ladder) GetPlayers() []*TournamentPerson {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *Ladder) GetMaxChallengeDistance() int32 {
+	if x != nil {
+		return x.MaxChallengeDistance
+	}
+	return 0
+}
+
+func (x *Ladder) GetAcceptSeconds() int32 {
+	if x != nil {
+		return x.AcceptSeconds
+	}
+	return 0
+}
+
+func (x *Ladder) GetChallenges() []*LadderChallenge {
+	if x != nil {
+		return x.Challenges
+	}
+	return nil
+}
+
+type ClubMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the player ID, uuid:username.
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Role     ClubRole               `protobuf:"varint,2,opt,name=role,proto3,enum=ipc.ClubRole" json:"role,omitempty"`
+	JoinedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+}
+
+func (x *ClubMember) Reset() {
+	*x = ClubMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_ipc_tournament_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClubMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClubMember) ProtoMessage() {}
+
+func (x *ClubMember) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_ipc_tournament_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClubMember.ProtoReflect.Descriptor instead.
+func (*ClubMember) Descriptor() ([]byte, []int) {
+	return file_api_proto_ipc_tournament_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ClubMember) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ClubMember) GetRole() ClubRole {
+	if x != nil {
+		return x.Role
+	}
+	return ClubRole_CLUB_MEMBER
+}
+
+func (x *ClubMember) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+// A Club is the membership roster of a club tournament.
+type Club struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Members []*ClubMember `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	// If members_only is set, only members can register themselves for
+	// the sessions of the club.
+	MembersOnly bool `protobuf:"varint,3,opt,name=members_only,json=membersOnly,proto3" json:"members_only,omitempty"`
+	// New sessions of the club are set up from the session template.
+	SessionTemplate *ClubSessionTemplate `protobuf:"bytes,4,opt,name=session_template,json=sessionTemplate,proto3" json:"session_template,omitempty"`
+	SessionSchedule *ClubSessionSchedule `protobuf:"bytes,5,opt,name=session_schedule,json=sessionSchedule,proto3" json:"session_schedule,omitempty"`
+}
+
+func (x *Club) Reset() {
+	*x = Club{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_ipc_tournament_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Club) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Club) ProtoMessage() {}
+
+func (x *Club) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_ipc_tournament_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Club.ProtoReflect.Descriptor instead.
+func (*Club) Descriptor() ([]byte, []int) {
+	return file_api_proto_ipc_tournament_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Club) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Club) GetMembers() []*ClubMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Club) GetMembersOnly() bool {
+	if x != nil {
+		return x.MembersOnly
+	}
+	return false
+}
+
+func (x *Club) GetSessionTemplate() *ClubSessionTemplate {
+	if x != nil {
+		return x.SessionTemplate
+	}
+	return nil
+}
+
+func (x *Club) GetSessionSchedule() *ClubSessionSchedule {
+	if x != nil {
+		return x.SessionSchedule
+	}
+	return nil
+}
+
+type ClubSessionTemplateDivision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Controls *DivisionControls `protobuf:"bytes,2,opt,name=controls,proto3" json:"controls,omitempty"`
+	// If the first round has a scheduled start time, the times of the
+	// division are moved to the start of each session. Otherwise they are
+	// left unset.
+	RoundControls []*RoundControl `protobuf:"bytes,3,rep,name=round_controls,json=roundControls,proto3" json:"round_controls,omitempty"`
+}
+
+func (x *ClubSessionTemplateDivision) Reset() {
+	*x = ClubSessionTemplateDivision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_ipc_tournament_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClubSessionTemplateDivision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClubSessionTemplateDivision) ProtoMessage() {}
+
+func (x *ClubSessionTemplateDivision) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_ipc_tournament_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClubSessionTemplateDivision.ProtoReflect.Descriptor instead.
+func (*ClubSessionTemplateDivision) Descriptor() ([]byte, []int) {
+	return file_api_proto_ipc_tournament_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ClubSessionTemplateDivision) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ClubSessionTemplateDivision) GetControls() *DivisionControls {
+	if x != nil {
+		return x.Controls
+	}
+	return nil
+}
+
+func (x *ClubSessionTemplateDivision) GetRoundControls() []*RoundControl {
+	if x != nil {
+		return x.RoundControls
+	}
+	return nil
+}
+
+type ClubSessionTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Divisions           []*ClubSessionTemplateDivision `protobuf:"bytes,1,rep,name=divisions,proto3" json:"divisions,omitempty"`
+	DefaultClubSettings *GameRequest                   `protobuf:"bytes,2,opt,name=default_club_settings,json=defaultClubSettings,proto3" json:"default_club_settings,omitempty"`
+}
+
+func (x *ClubSessionTemplate) Reset() {
+	*x = ClubSessionTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_ipc_tournament_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClubSessionTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClubSessionTemplate) ProtoMessage() {}
+
+func (x *ClubSessionTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_ipc_tournament_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClubSessionTemplate.ProtoReflect.Descriptor instead.
+func (*ClubSessionTemplate) Descriptor() ([]byte, []int) {
+	return file_api_proto_ipc_tournament_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ClubSessionTemplate) GetDivisions() []*ClubSessionTemplateDivision {
+	if x != nil {
+		return x.Divisions
+	}
+	return nil
+}
+
+func (x *ClubSessionTemplate) GetDefaultClubSettings() *GameRequest {
+	if x != nil {
+		return x.DefaultClubSettings
+	}
+	return nil
+}
+
+// A ClubSessionSchedule creates a session of the club every week.
+type ClubSessionSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// weekday is the day of the week of the sessions, 0 being Sunday.
+	Weekday int32 `protobuf:"varint,1,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	// hour and minute are the start time of the sessions in the time zone.
+	Hour   int32 `protobuf:"varint,2,opt,name=hour,proto3" json:"hour,omitempty"`
+	Minute int32 `protobuf:"varint,3,opt,name=minute,proto3" json:"minute,omitempty"`
+	// time_zone is an IANA time zone name, such as America/Chicago.
+	TimeZone string `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// lead_hours is how long before its start a session is created.
+	LeadHours int32 `protobuf:"varint,5,opt,name=lead_hours,json=leadHours,proto3" json:"lead_hours,omitempty"`
+	// last_session is the start time of the last session that was created.
+	LastSession *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_session,json=lastSession,proto3" json:"last_session,omitempty"`
+}
+
+func (x *ClubSessionSchedule) Reset() {
+	*x = ClubSessionSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_ipc_tournament_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClubSessionSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClubSessionSchedule) ProtoMessage() {}
+
+func (x *ClubSessionSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_ipc_tournament_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClubSessionSchedule.ProtoReflect.Descriptor instead.
+func (*ClubSessionSchedule) Descriptor() ([]byte, []int) {
+	return file_api_proto_ipc_tournament_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ClubSessionSchedule) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *ClubSessionSchedule) GetHour() int32 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
+}
+
+func (x *ClubSessionSchedule) GetMinute() int32 {
+	if x != nil {
+		return x.Minute
+	}
+	return 0
+}
+
+func (x *ClubSessionSchedule) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *ClubSessionSchedule) GetLeadHours() int32 {
+	if x != nil {
+		return x.LeadHours
+	}
+	return 0
+}
+
+func (x *ClubSessionSchedule) GetLastSession() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSession
+	}
+	return nil
+}
+
+type Prize struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type PrizeType `protobuf:"varint,1,opt,name=type,proto3,enum=ipc.PrizeType" json:"type,omitempty"`
+	// division is the division the prize is for. If it is empty, the prize
+	// is awarded in every division.
+	Division string `protobuf:"bytes,2,opt,name=division,proto3" json:"division,omitempty"`
+	// place starts at 1. For class prizes it is the place within the class.
+	// It is not used for high game and high loss prizes.
+	Place int32 `protobuf:"varint,3,opt,name=place,proto3" json:"place,omitempty"`
+	// rating_floor and rating_ceiling bound the ratings the players were
+	// seeded with for class prizes. 0 means there is no bound.
+	RatingFloor   int32 `protobuf:"varint,4,opt,name=rating_floor,json=ratingFloor,proto3" json:"rating_floor,omitempty"`
+	RatingCeiling int32 `protobuf:"varint,5,opt,name=rating_ceiling,json=ratingCeiling,proto3" json:"rating_ceiling,omitempty"`
+	// amount is in the smallest unit of the currency, such as cents.
+	Amount int64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	// description is shown with the prize, for example for a trophy.
+	Description string `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *Prize) Reset() {
+	*x = Prize{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_ipc_tournament_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Prize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Prize) ProtoMessage() {}
+
+func (x *Prize) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_ipc_tournament_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Prize.ProtoReflect.Descriptor instead.
+func (*Prize) Descriptor() ([]byte, []int) {
+	return file_api_proto_ipc_tournament_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Prize) GetType() PrizeType {
+	if x != nil {
+		return x.Type
+	}
+	return PrizeType_PLACE_PRIZE
+}
+
+func (x *Prize) GetDivision() string {
+	if x != nil {
+		return x.Division
+	}
+	return ""
+}
+
+func (x *Prize) GetPlace() int32 {
+	if x != nil {
+		return x.Place
+	}
+	return 0
+}
+
+func (x *Prize) GetRatingFloor() int32 {
+	if x != nil {
+		return x.RatingFloor
+	}
+	return 0
+}
+
+func (x *Prize) GetRatingCeiling() int32 {
+	if x != nil {
+		return x.RatingCeiling
+	}
+	return 0
+}
+
+func (x *Prize) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Prize) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type PrizeAward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prize    *Prize `protobuf:"bytes,1,opt,name=prize,proto3" json:"prize,omitempty"`
+	Division string `protobuf:"bytes,2,opt,name=division,proto3" json:"division,omitempty"`
+	// player_ids are the players who share the prize, in seed order.
+	PlayerIds []string `protobuf:"bytes,3,rep,name=player_ids,json=playerIds,proto3" json:"player_ids,omitempty"`
+	// score is the winning score of high game and high loss prizes.
+	Score int32 `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+	// amounts are what each of the players gets. What is left over from
+	// splitting the prize evenly goes one unit at a time to the first
+	// players.
+	Amounts []int64 `protobuf:"varint,6,rep,packed,name=amounts,proto3" json:"amounts,omitempty"`
+}
+
+func (x *PrizeAward) Reset() {
+	*x = PrizeAward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_ipc_tournament_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrizeAward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrizeAward) ProtoMessage() {}
+
+func (x *PrizeAward) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_ipc_tournament_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrizeAward.ProtoReflect.Descriptor instead.
+func (*PrizeAward) Descriptor() ([]byte, []int) {
+	return file_api_proto_ipc_tournament_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PrizeAward) GetPrize() *Prize {
+	if x != nil {
+		return x.Prize
+	}
+	return nil
+}
+
+func (x *PrizeAward) GetDivision() string {
+	if x != nil {
+		return x.Division
+	}
+	return ""
+}
+
+func (x *PrizeAward) GetPlayerIds() []string {
+	if x != nil {
+		return x.PlayerIds
+	}
+	return nil
+}
+
+func (x *PrizeAward) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *PrizeAward) GetAmounts() []int64 {
+	if x != nil {
+		return x.Amounts
+	}
+	return nil
+}
+
+// This is sent from the challenged player to accept a ladder challenge.
+type LadderChallengeAccept struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TournamentId string `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	ChallengeId  string `protobuf:"bytes,2,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+}
+
+func (x *LadderChallengeAccept) Reset() {
+	*x = LadderChallengeAccept{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_ipc_tournament_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LadderChallengeAccept) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LadderChallengeAccept) ProtoMessage() {}
+
+func (x *LadderChallengeAccept) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_ipc_tournament_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LadderChallengeAccept.ProtoReflect.Descriptor instead.
+func (*LadderChallengeAccept) Descriptor() ([]byte, []int) {
+	return file_api_proto_ipc_tournament_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *LadderChallengeAccept) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *LadderChallengeAccept) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+// This is sent from the challenged player to decline a ladder challenge,
+// which forfeits it, or from the challenger to withdraw it.
+type LadderChallengeDecline struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TournamentId string `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	ChallengeId  string `protobuf:"bytes,2,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+}
+
+func (x *LadderChallengeDecline) Reset() {
+	*x = LadderChallengeDecline{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_ipc_tournament_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LadderChallengeDecline) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LadderChallengeDecline) ProtoMessage() {}
+
+func (x *LadderChallengeDecline) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_ipc_tournament_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LadderChallengeDecline.ProtoReflect.Descriptor instead.
+func (*LadderChallengeDecline) Descriptor() ([]byte, []int) {
+	return file_api_proto_ipc_tournament_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *LadderChallengeDecline) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *LadderChallengeDecline) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+type PlayerPairingReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Games    int32  `protobuf:"varint,2,opt,name=games,proto3" json:"games,omitempty"`
+	// repeats is how many of the player's games were against an opponent
+	// they had already played.
+	Repeats                int32   `protobuf:"varint,3,opt,name=repeats,proto3" json:"repeats,omitempty"`
+	Firsts                 int32   `protobuf:"varint,4,opt,name=firsts,proto3" json:"firsts,omitempty"`
+	Seconds                int32   `protobuf:"varint,5,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	Byes                   int32   `protobuf:"varint,6,opt,name=byes,proto3" json:"byes,omitempty"`
+	AverageOpponentRating  float64 `protobuf:"fixed64,7,opt,name=average_opponent_rating,json=averageOpponentRating,proto3" json:"average_opponent_rating,omitempty"`
+	OpponentRatingVariance float64 `protobuf:"fixed64,8,opt,name=opponent_rating_variance,json=opponentRatingVariance,proto3" json:"opponent_rating_variance,omitempty"`
+}
+
+func (x *PlayerPairingReport) Reset() {
+	*x = PlayerPairingReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_ipc_tournament_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerPairingReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerPairingReport) ProtoMessage() {}
+
+func (x *PlayerPairingReport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_ipc_tournament_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerPairingReport.ProtoReflect.Descriptor instead.
+func (*PlayerPairingReport) Descriptor() ([]byte, []int) {
+	return file_api_proto_ipc_tournament_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PlayerPairingReport) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *PlayerPairingReport) GetGames() int32 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
+}
+
+func (x *PlayerPairingReport) GetRepeats() int32 {
+	if x != nil {
+		return x.Repeats
+	}
+	return 0
+}
+
+func (x *PlayerPairingReport) GetFirsts() int32 {
+	if x != nil {
+		return x.Firsts
+	}
+	return 0
+}
+
+func (x *PlayerPairingReport) GetSeconds() int32 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+func (x *PlayerPairingReport) GetByes() int32 {
+	if x != nil {
+		return x.Byes
+	}
+	return 0
+}
+
+func (x *PlayerPairingReport) GetAverageOpponentRating() float64 {
+	if x != nil {
+		return x.AverageOpponentRating
+	}
+	return 0
+}
+
+func (x *PlayerPairingReport) GetOpponentRatingVariance() float64 {
+	if x != nil {
+		return x.OpponentRatingVariance
+	}
+	return 0
+}
+
+type RoundPairingReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Round         int32         `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	PairingMethod PairingMethod `protobuf:"varint,2,opt,name=pairing_method,json=pairingMethod,proto3,enum=ipc.PairingMethod" json:"pairing_method,omitempty"`
+	Games         int32         `protobuf:"varint,3,opt,name=games,proto3" json:"games,omitempty"`
+	Repeats       int32         `protobuf:"varint,4,opt,name=repeats,proto3" json:"repeats,omitempty"`
+	// mismatched_games are the games of a SWISS round between players who
+	// had different win totals in the standings the round was paired from.
+	MismatchedGames int32 `protobuf:"varint,5,opt,name=mismatched_games,json=mismatchedGames,proto3" json:"mismatched_games,omitempty"`
+}
+
+func (x *RoundPairingReport) Reset() {
+	*x = RoundPairingReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_ipc_tournament_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoundPairingReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundPairingReport) ProtoMessage() {}
+
+func (x *RoundPairingReport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_ipc_tournament_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundPairingReport.ProtoReflect.Descriptor instead.
+func (*RoundPairingReport) Descriptor() ([]byte, []int) {
+	return file_api_proto_ipc_tournament_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RoundPairingReport) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *RoundPairingReport) GetPairingMethod() PairingMethod {
+	if x != nil {
+		return x.PairingMethod
+	}
+	return PairingMethod_RANDOM
+}
+
+func (x *RoundPairingReport) GetGames() int32 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
+}
+
+func (x *RoundPairingReport) GetRepeats() int32 {
+	if x != nil {
+		return x.Repeats
+	}
+	return 0
+}
+
+func (x *RoundPairingReport) GetMismatchedGames() int32 {
+	if x != nil {
+		return x.MismatchedGames
+	}
+	return 0
+}
+
+// A PairingReport shows how fair the pairings of a division were over
+// the rounds that have started.
+type PairingReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Division string                 `protobuf:"bytes,2,opt,name=division,proto3" json:"division,omitempty"`
+	Players  []*PlayerPairingReport `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`
+	Rounds   []*RoundPairingReport  `protobuf:"bytes,4,rep,name=rounds,proto3" json:"rounds,omitempty"`
+}
+
+func (x *PairingReport) Reset() {
+	*x = PairingReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_ipc_tournament_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairingReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairingReport) ProtoMessage() {}
+
+func (x *PairingReport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_ipc_tournament_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairingReport.ProtoReflect.Descriptor instead.
+func (*PairingReport) Descriptor() ([]byte, []int) {
+	return file_api_proto_ipc_tournament_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PairingReport) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PairingReport) GetDivision() string {
+	if x != nil {
+		return x.Division
+	}
+	return ""
+}
+
+func (x *PairingReport) GetPlayers() []*PlayerPairingReport {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *PairingReport) GetRounds() []*RoundPairingReport {
+	if x != nil {
+		return x.Rounds
+	}
+	return nil
+}
+
+type TournamentGameEndedEvent_Player struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string               `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Score    int32                `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	Result   TournamentGameResult `protobuf:"varint,3,opt,name=result,proto3,enum=ipc.TournamentGameResult" json:"result,omitempty"`
+}
+
+func (x *TournamentGameEndedEvent_Player) Reset() {
+	*x = TournamentGameEndedEvent_Player{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_ipc_tournament_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TournamentGameEndedEvent_Player) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentGameEndedEvent_Player) ProtoMessage() {}
+
+func (x *TournamentGameEndedEvent_Player) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_ipc_tournament_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentGameEndedEvent_Player.ProtoReflect.Descriptor instead.
+func (*TournamentGameEndedEvent_Player) Descriptor() ([]byte, []int) {
+	return file_api_proto_ipc_tournament_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *TournamentGameEndedEvent_Player) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *TournamentGameEndedEvent_Player) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TournamentGameEndedEvent_Player) GetResult() TournamentGameResult {
+	if x != nil {
+		return x.Result
+	}
+	return TournamentGameResult_NO_RESULT
+}
+
+var File_api_proto_ipc_tournament_proto protoreflect.FileDescriptor
 
 var file_api_proto_ipc_tournament_proto_rawDesc = []byte{
 	0x0a, 0x1e, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x70, 0x63, 0x2f,
@@ -1977,127 +4127,240 @@ var file_api_proto_ipc_tournament_proto_rawDesc = []byte{
 	0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x67,
 	0x61, 0x6d, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x6e, 0x72, 0x65,
 	0x61, 0x64, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x75, 0x6e, 0x72, 0x65, 0x61,
-	0x64, 0x79, 0x22, 0x58, 0x0a, 0x10, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
-	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1c,
-	0x0a, 0x09, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x09, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x22, 0x70, 0x0a, 0x11,
-	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x64, 0x79, 0x22, 0xbb, 0x01, 0x0a, 0x10, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x6e, 0x12, 0x42, 0x0a, 0x11,
+	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x10,
+	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x22, 0x70, 0x0a, 0x11, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x2f, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x07, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x73, 0x22, 0x97, 0x06, 0x0a, 0x0c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x12, 0x39, 0x0a, 0x0e, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x69, 0x70,
+	0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52,
+	0x0d, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x33,
+	0x0a, 0x0c, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x72, 0x73, 0x74,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0b, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x67, 0x61,
+	0x6d, 0x65, 0x73, 0x50, 0x65, 0x72, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x6c, 0x5f, 0x66, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x46, 0x6f, 0x6e, 0x74, 0x65, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74,
+	0x73, 0x12, 0x33, 0x0a, 0x16, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x5f,
+	0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4f, 0x76, 0x65, 0x72, 0x4d, 0x61, 0x78, 0x52,
+	0x65, 0x70, 0x65, 0x61, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74,
+	0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x43, 0x0a, 0x1e,
+	0x77, 0x69, 0x6e, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x1b, 0x77, 0x69, 0x6e, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x14, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x31, 0x0a, 0x15, 0x6e, 0x6f, 0x5f, 0x73, 0x68, 0x6f, 0x77, 0x5f, 0x67, 0x72, 0x61,
+	0x63, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x12, 0x6e, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x47, 0x72, 0x61, 0x63, 0x65, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x66, 0x69, 0x72, 0x73, 0x74, 0x73, 0x5f, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x66, 0x69, 0x72, 0x73, 0x74, 0x73, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x69,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x61, 0x67, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x61, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63,
+	0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x22, 0xca, 0x07, 0x0a,
+	0x10, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
 	0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
 	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a,
-	0x07, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x69, 0x70, 0x63, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x50,
-	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x07, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x22, 0xcc,
-	0x03, 0x0a, 0x0c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12,
-	0x39, 0x0a, 0x0e, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x50, 0x61,
-	0x69, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0d, 0x70, 0x61, 0x69,
-	0x72, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x33, 0x0a, 0x0c, 0x66, 0x69,
-	0x72, 0x73, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x10, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x68,
-	0x6f, 0x64, 0x52, 0x0b, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
-	0x26, 0x0a, 0x0f, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x72, 0x6f, 0x75,
-	0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x50,
-	0x65, 0x72, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66,
-	0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
-	0x5f, 0x66, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x46, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
-	0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x73, 0x12, 0x33, 0x0a,
-	0x16, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
-	0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x61,
-	0x6c, 0x6c, 0x6f, 0x77, 0x4f, 0x76, 0x65, 0x72, 0x4d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x65, 0x61,
-	0x74, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x5f, 0x72, 0x65, 0x6c,
-	0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x14, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
-	0x76, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x43, 0x0a, 0x1e, 0x77, 0x69, 0x6e, 0x5f,
-	0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74,
-	0x69, 0x76, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x1b, 0x77, 0x69, 0x6e, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
-	0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xc6, 0x03,
-	0x0a, 0x10, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a,
+	0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x67, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x44, 0x0a, 0x10, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x69,
+	0x70, 0x63, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0f, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x75, 0x73, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0f, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x53, 0x70, 0x72,
+	0x65, 0x61, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x61, 0x70,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x43, 0x61,
+	0x70, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x69, 0x62, 0x73, 0x6f, 0x6e, 0x69, 0x7a, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x67, 0x69, 0x62, 0x73, 0x6f, 0x6e, 0x69, 0x7a, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x67, 0x69, 0x62, 0x73, 0x6f, 0x6e, 0x5f, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x67, 0x69, 0x62, 0x73, 0x6f, 0x6e, 0x53, 0x70,
+	0x72, 0x65, 0x61, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x10, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x62, 0x79, 0x65,
+	0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x13, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x79, 0x65, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x10, 0x6f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x6c, 0x6f,
+	0x6f, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x63, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x4f, 0x0a,
+	0x15, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x14, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x44,
+	0x0a, 0x1e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x12, 0x46, 0x0a, 0x11, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x69, 0x6e,
+	0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x49, 0x6e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x35, 0x0a, 0x17,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x75, 0x6e,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x6e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65,
+	0x64, 0x49, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x61, 0x74,
+	0x65, 0x41, 0x73, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0xa9, 0x01, 0x0a, 0x0e, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x0f, 0x67, 0x61, 0x6d,
+	0x65, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x64,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0d, 0x67, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xbe, 0x01, 0x0a, 0x07, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e,
+	0x67, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x29, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x08,
+	0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19,
+	0x2e, 0x69, 0x70, 0x63, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x47,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x22, 0xa7, 0x01, 0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x6f, 0x73, 0x73,
+	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x64, 0x72, 0x61, 0x77, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x70, 0x72, 0x65,
+	0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64,
+	0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x69, 0x62, 0x73, 0x6f, 0x6e, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x67, 0x69, 0x62, 0x73, 0x6f, 0x6e, 0x69, 0x7a, 0x65, 0x64,
+	0x22, 0x43, 0x0a, 0x0e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x31, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x73, 0x74, 0x61, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xc1, 0x02, 0x0a, 0x18, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
 	0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33,
-	0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x67, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x10, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64,
-	0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
-	0x69, 0x70, 0x63, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x61,
-	0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0f, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e,
-	0x64, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x75, 0x73,
-	0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0f, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x53, 0x70,
-	0x72, 0x65, 0x61, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x61,
-	0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x43,
-	0x61, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x69, 0x62, 0x73, 0x6f, 0x6e, 0x69, 0x7a, 0x65, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x67, 0x69, 0x62, 0x73, 0x6f, 0x6e, 0x69, 0x7a, 0x65,
-	0x12, 0x23, 0x0a, 0x0d, 0x67, 0x69, 0x62, 0x73, 0x6f, 0x6e, 0x5f, 0x73, 0x70, 0x72, 0x65, 0x61,
-	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x67, 0x69, 0x62, 0x73, 0x6f, 0x6e, 0x53,
-	0x70, 0x72, 0x65, 0x61, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d,
-	0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x10, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x62, 0x79,
-	0x65, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x79, 0x65, 0x50, 0x6c, 0x61,
-	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xa9, 0x01, 0x0a, 0x0e, 0x54, 0x6f, 0x75, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65,
-	0x73, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
-	0x65, 0x6e, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x0f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x65,
-	0x6e, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x12, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x52, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x52, 0x0d, 0x67, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0xbe, 0x01, 0x0a, 0x07, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x18,
-	0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52,
-	0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x29,
-	0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x69, 0x70, 0x63, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x61,
-	0x6d, 0x65, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x6f, 0x75, 0x74,
-	0x63, 0x6f, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x69, 0x70,
-	0x63, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x61, 0x6d, 0x65,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x73,
-	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73,
-	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x79, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x73, 0x22, 0xa7, 0x01, 0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x73, 0x73, 0x65,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x64, 0x72, 0x61, 0x77, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x12, 0x1e, 0x0a,
-	0x0a, 0x67, 0x69, 0x62, 0x73, 0x6f, 0x6e, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0a, 0x67, 0x69, 0x62, 0x73, 0x6f, 0x6e, 0x69, 0x7a, 0x65, 0x64, 0x22, 0x43, 0x0a,
-	0x0e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12,
-	0x31, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53,
-	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x73, 0x22, 0xc1, 0x02, 0x0a, 0x18, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50,
-	0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x11, 0x64,
-	0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61,
-	0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x63, 0x0a, 0x12, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x34, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39,
+	0x0a, 0x11, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x70, 0x63, 0x2e,
+	0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x63, 0x0a, 0x12, 0x64, 0x69, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x64, 0x69, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x59,
+	0x0a, 0x16, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x70, 0x63, 0x2e,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x63, 0x0a, 0x1f, 0x44, 0x69, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0xfd,
+	0x02, 0x0a, 0x1d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x65, 0x64, 0x4f,
+	0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x07,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x69, 0x70, 0x63, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x39,
+	0x0a, 0x11, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x70, 0x63, 0x2e,
+	0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x68, 0x0a, 0x12, 0x64, 0x69, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x65, 0x64, 0x4f, 0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x11, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x1a, 0x59, 0x0a, 0x16, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x69, 0x70, 0x63, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf5,
+	0x02, 0x0a, 0x15, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0e, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69,
+	0x70, 0x63, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52,
+	0x0d, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x12, 0x39,
+	0x0a, 0x11, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x70, 0x63, 0x2e,
+	0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x60, 0x0a, 0x12, 0x64, 0x69, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x73, 0x2e, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69,
 	0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69,
 	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x59, 0x0a, 0x16, 0x44,
 	0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
@@ -2105,52 +4368,19 @@ var file_api_proto_ipc_tournament_proto_rawDesc = []byte{
 	0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x52, 0x6f, 0x75,
 	0x6e, 0x64, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x63, 0x0a, 0x1f, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x76,
-	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x76,
-	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0xfd, 0x02, 0x0a, 0x1d,
-	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x65, 0x64, 0x4f, 0x72, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a,
-	0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x07, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x70, 0x63,
-	0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f,
-	0x6e, 0x73, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x39, 0x0a, 0x11, 0x64,
-	0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61,
-	0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x68, 0x0a, 0x12, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x39, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
-	0x41, 0x64, 0x64, 0x65, 0x64, 0x4f, 0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53,
-	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x64,
-	0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
-	0x1a, 0x59, 0x0a, 0x16, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x70,
-	0x63, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf5, 0x02, 0x0a, 0x15,
-	0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x38, 0x0a, 0x0e, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x70, 0x63, 0x2e,
-	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x0d, 0x72, 0x6f,
-	0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x12, 0x39, 0x0a, 0x11, 0x64,
-	0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61,
-	0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x60, 0x0a, 0x12, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x31, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x2e, 0x44,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xca, 0x02, 0x0a, 0x18, 0x44, 0x69, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x42, 0x0a, 0x11, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x70, 0x63,
+	0x2e, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x73, 0x52, 0x10, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x73, 0x12, 0x63, 0x0a, 0x12, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x34, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44,
 	0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
 	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53,
 	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x59, 0x0a, 0x16, 0x44, 0x69, 0x76, 0x69,
@@ -2159,133 +4389,389 @@ var file_api_proto_ipc_tournament_proto_rawDesc = []byte{
 	0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53,
 	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0xca, 0x02, 0x0a, 0x18, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x11,
-	0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x44, 0x69,
-	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x52, 0x10,
-	0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73,
-	0x12, 0x63, 0x0a, 0x12, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x69,
-	0x70, 0x63, 0x2e, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x69, 0x76, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x11, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x59, 0x0a, 0x16, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0xd8, 0x04, 0x0a, 0x1e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44,
-	0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x30, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
-	0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x73, 0x12, 0x50, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x73, 0x12, 0x54, 0x0a, 0x0b, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6d,
-	0x61, 0x70, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x54,
-	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x61,
-	0x69, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70,
-	0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x12, 0x31, 0x0a, 0x08, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x70,
-	0x63, 0x2e, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x73, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x12, 0x38, 0x0a, 0x0e,
-	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x18, 0x07,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x0d, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x1a, 0x51, 0x0a, 0x0e, 0x53,
-	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x69, 0x70, 0x63, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4b,
-	0x0a, 0x0f, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72,
+	0x02, 0x38, 0x01, 0x22, 0xd8, 0x04, 0x0a, 0x1e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x12, 0x50, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x54, 0x0a, 0x0b, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x69, 0x70,
+	0x63, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0a, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x12, 0x31, 0x0a, 0x08,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x69, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x73, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x12,
+	0x38, 0x0a, 0x0e, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x0d, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x1a, 0x51,
+	0x0a, 0x0e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x4b, 0x0a, 0x0f, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe1,
+	0x01, 0x0a, 0x17, 0x46, 0x75, 0x6c, 0x6c, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x49, 0x0a, 0x09, 0x64, 0x69,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x69, 0x70, 0x63, 0x2e, 0x46, 0x75, 0x6c, 0x6c, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x44, 0x69, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x64, 0x69, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x1a,
+	0x61, 0x0a, 0x0e, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72,
 	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe1, 0x01, 0x0a, 0x17,
-	0x46, 0x75, 0x6c, 0x6c, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69,
-	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x49, 0x0a, 0x09, 0x64, 0x69, 0x76, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x70, 0x63,
-	0x2e, 0x46, 0x75, 0x6c, 0x6c, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44,
-	0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x1a, 0x61, 0x0a, 0x0e,
-	0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x39, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x23, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
-	0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0x2c, 0x0a, 0x1a, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6e,
-	0x69, 0x73, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x9d, 0x02,
-	0x0a, 0x16, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d,
-	0x0a, 0x12, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x69, 0x76, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x34, 0x0a,
-	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
-	0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65,
-	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x65, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x4f, 0x0a,
-	0x21, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x76, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x6b, 0x65, 0x79, 0x12, 0x39, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x2c, 0x0a, 0x1a, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x9d, 0x02, 0x0a, 0x16, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x12, 0x34, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x09, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x22, 0x4f, 0x0a, 0x21, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0xea, 0x02, 0x0a, 0x0c, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x69,
+	0x64, 0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x62, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x77,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x54, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x69, 0x6e, 0x6e,
+	0x65, 0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x77,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x73,
+	0x65, 0x72, 0x5f, 0x74, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x73,
+	0x65, 0x72, 0x54, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x6c,
+	0x6f, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6c, 0x6f, 0x73, 0x65, 0x72, 0x53,
+	0x6c, 0x6f, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x22, 0xa6,
+	0x01, 0x0a, 0x0f, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
 	0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2a, 0x88,
-	0x01, 0x0a, 0x14, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x61, 0x6d,
-	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x5f, 0x52, 0x45,
-	0x53, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x57, 0x49, 0x4e, 0x10, 0x01, 0x12,
-	0x08, 0x0a, 0x04, 0x4c, 0x4f, 0x53, 0x53, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x52, 0x41,
-	0x57, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x59, 0x45, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b,
-	0x46, 0x4f, 0x52, 0x46, 0x45, 0x49, 0x54, 0x5f, 0x57, 0x49, 0x4e, 0x10, 0x05, 0x12, 0x10, 0x0a,
-	0x0c, 0x46, 0x4f, 0x52, 0x46, 0x45, 0x49, 0x54, 0x5f, 0x4c, 0x4f, 0x53, 0x53, 0x10, 0x06, 0x12,
-	0x0e, 0x0a, 0x0a, 0x45, 0x4c, 0x49, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x45, 0x44, 0x10, 0x07, 0x12,
-	0x08, 0x0a, 0x04, 0x56, 0x4f, 0x49, 0x44, 0x10, 0x08, 0x2a, 0xaf, 0x01, 0x0a, 0x0d, 0x50, 0x61,
-	0x69, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x0a, 0x0a, 0x06, 0x52,
-	0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x4f, 0x55, 0x4e, 0x44,
-	0x5f, 0x52, 0x4f, 0x42, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x4b, 0x49, 0x4e, 0x47,
-	0x5f, 0x4f, 0x46, 0x5f, 0x54, 0x48, 0x45, 0x5f, 0x48, 0x49, 0x4c, 0x4c, 0x10, 0x02, 0x12, 0x0f,
-	0x0a, 0x0b, 0x45, 0x4c, 0x49, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12,
-	0x0a, 0x0a, 0x06, 0x46, 0x41, 0x43, 0x54, 0x4f, 0x52, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x49,
-	0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x46, 0x4f, 0x4e, 0x54, 0x45, 0x53, 0x10, 0x05, 0x12,
-	0x09, 0x0a, 0x05, 0x53, 0x57, 0x49, 0x53, 0x53, 0x10, 0x06, 0x12, 0x0d, 0x0a, 0x09, 0x51, 0x55,
-	0x49, 0x43, 0x4b, 0x50, 0x41, 0x49, 0x52, 0x10, 0x07, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x41, 0x4e,
-	0x55, 0x41, 0x4c, 0x10, 0x08, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x45, 0x41, 0x4d, 0x5f, 0x52, 0x4f,
-	0x55, 0x4e, 0x44, 0x5f, 0x52, 0x4f, 0x42, 0x49, 0x4e, 0x10, 0x09, 0x2a, 0x46, 0x0a, 0x0b, 0x46,
-	0x69, 0x72, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x41,
-	0x4e, 0x55, 0x41, 0x4c, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c,
-	0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x10, 0x01, 0x12, 0x13,
-	0x0a, 0x0f, 0x41, 0x55, 0x54, 0x4f, 0x4d, 0x41, 0x54, 0x49, 0x43, 0x5f, 0x46, 0x49, 0x52, 0x53,
-	0x54, 0x10, 0x02, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x64, 0x6f, 0x6d, 0x69, 0x6e, 0x6f, 0x31, 0x34, 0x2f, 0x6c, 0x69, 0x77, 0x6f, 0x72,
-	0x64, 0x73, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x69, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b,
+	0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x68, 0x61, 0x6d, 0x70, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x68, 0x61, 0x6d, 0x70, 0x69, 0x6f, 0x6e, 0x22, 0xb4, 0x01, 0x0a, 0x0d, 0x50, 0x61, 0x69, 0x72,
+	0x69, 0x6e, 0x67, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x69, 0x6e,
+	0x5f, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x77, 0x69, 0x6e, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x65,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x72, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x66, 0x69, 0x72, 0x73, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x62,
+	0x0a, 0x10, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72,
+	0x61, 0x6e, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x65, 0x73, 0x74, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x22, 0xbf, 0x01, 0x0a, 0x0e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x67, 0x61, 0x6d, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x62, 0x79, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x62, 0x79, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x67, 0x69, 0x62, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x67, 0x69, 0x62, 0x73, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x50,
+	0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0xce, 0x02, 0x0a, 0x16, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67,
+	0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x38, 0x0a, 0x0e, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x70, 0x63, 0x2e,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x0d, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x12, 0x2f, 0x0a, 0x08, 0x70,
+	0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x69, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x08, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12,
+	0x67, 0x69, 0x62, 0x73, 0x6f, 0x6e, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x67, 0x69, 0x62, 0x73, 0x6f, 0x6e,
+	0x69, 0x7a, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x35,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xaa, 0x02, 0x0a, 0x0f, 0x4c, 0x61, 0x64, 0x64, 0x65, 0x72,
+	0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67,
+	0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0xdc, 0x01, 0x0a, 0x06, 0x4c, 0x61, 0x64, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2f, 0x0a,
+	0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x69, 0x70, 0x63, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x50,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x34,
+	0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14,
+	0x6d, 0x61, 0x78, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x63,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x4c, 0x61, 0x64, 0x64, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x73, 0x22, 0x78, 0x0a, 0x0a, 0x43, 0x6c, 0x75, 0x62, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x21, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e,
+	0x69, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x75, 0x62, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x22, 0xee, 0x01, 0x0a, 0x04,
+	0x43, 0x6c, 0x75, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x75, 0x62,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x4f, 0x6e,
+	0x6c, 0x79, 0x12, 0x43, 0x0a, 0x10, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69,
+	0x70, 0x63, 0x2e, 0x43, 0x6c, 0x75, 0x62, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x0f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x43, 0x0a, 0x10, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x75, 0x62, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x0f, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x9e, 0x01, 0x0a,
+	0x1b, 0x43, 0x6c, 0x75, 0x62, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x31, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x73, 0x12, 0x38, 0x0a, 0x0e, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x70,
+	0x63, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x0d,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x22, 0x9b, 0x01,
+	0x0a, 0x13, 0x43, 0x6c, 0x75, 0x62, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x43,
+	0x6c, 0x75, 0x62, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x44, 0x0a, 0x15, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x5f, 0x63, 0x6c, 0x75, 0x62, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43,
+	0x6c, 0x75, 0x62, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xd6, 0x01, 0x0a, 0x13,
+	0x43, 0x6c, 0x75, 0x62, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x68, 0x6f, 0x75,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69,
+	0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x61, 0x64, 0x5f, 0x68,
+	0x6f, 0x75, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6c, 0x65, 0x61, 0x64,
+	0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xe1, 0x01, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x22,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x69,
+	0x70, 0x63, 0x2e, 0x50, 0x72, 0x69, 0x7a, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66,
+	0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x63, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9f, 0x01, 0x0a, 0x0a, 0x50, 0x72, 0x69,
+	0x7a, 0x65, 0x41, 0x77, 0x61, 0x72, 0x64, 0x12, 0x20, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x7a, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x69,
+	0x7a, 0x65, 0x52, 0x05, 0x70, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x49, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0x5f, 0x0a, 0x15, 0x4c, 0x61,
+	0x64, 0x64, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x22, 0x60, 0x0a, 0x16, 0x4c,
+	0x61, 0x64, 0x64, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x44, 0x65,
+	0x63, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x22, 0x9a, 0x02,
+	0x0a, 0x13, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x65,
+	0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x70, 0x65, 0x61,
+	0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x72, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x66, 0x69, 0x72, 0x73, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x79, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x62, 0x79, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x61, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x5f, 0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x61, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x4f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x38, 0x0a, 0x18, 0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x16, 0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xc0, 0x01, 0x0a, 0x12, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x39, 0x0a, 0x0e, 0x70, 0x61, 0x69, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x12, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x52, 0x0d, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x65,
+	0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x70, 0x65, 0x61,
+	0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x5f, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6d, 0x69,
+	0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x22, 0xa0, 0x01,
+	0x0a, 0x0d, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x07, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69,
+	0x70, 0x63, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12,
+	0x2f, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x61, 0x69, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73,
+	0x2a, 0x88, 0x01, 0x0a, 0x14, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x47,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x5f,
+	0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x57, 0x49, 0x4e, 0x10,
+	0x01, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x4f, 0x53, 0x53, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x44,
+	0x52, 0x41, 0x57, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x59, 0x45, 0x10, 0x04, 0x12, 0x0f,
+	0x0a, 0x0b, 0x46, 0x4f, 0x52, 0x46, 0x45, 0x49, 0x54, 0x5f, 0x57, 0x49, 0x4e, 0x10, 0x05, 0x12,
+	0x10, 0x0a, 0x0c, 0x46, 0x4f, 0x52, 0x46, 0x45, 0x49, 0x54, 0x5f, 0x4c, 0x4f, 0x53, 0x53, 0x10,
+	0x06, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x4c, 0x49, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x45, 0x44, 0x10,
+	0x07, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x4f, 0x49, 0x44, 0x10, 0x08, 0x2a, 0xea, 0x01, 0x0a, 0x0d,
+	0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x0a, 0x0a,
+	0x06, 0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x4f, 0x55,
+	0x4e, 0x44, 0x5f, 0x52, 0x4f, 0x42, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x4b, 0x49,
+	0x4e, 0x47, 0x5f, 0x4f, 0x46, 0x5f, 0x54, 0x48, 0x45, 0x5f, 0x48, 0x49, 0x4c, 0x4c, 0x10, 0x02,
+	0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x4c, 0x49, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10,
+	0x03, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x43, 0x54, 0x4f, 0x52, 0x10, 0x04, 0x12, 0x12, 0x0a,
+	0x0e, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x46, 0x4f, 0x4e, 0x54, 0x45, 0x53, 0x10,
+	0x05, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x57, 0x49, 0x53, 0x53, 0x10, 0x06, 0x12, 0x0d, 0x0a, 0x09,
+	0x51, 0x55, 0x49, 0x43, 0x4b, 0x50, 0x41, 0x49, 0x52, 0x10, 0x07, 0x12, 0x0a, 0x0a, 0x06, 0x4d,
+	0x41, 0x4e, 0x55, 0x41, 0x4c, 0x10, 0x08, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x45, 0x41, 0x4d, 0x5f,
+	0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x4f, 0x42, 0x49, 0x4e, 0x10, 0x09, 0x12, 0x16, 0x0a,
+	0x12, 0x44, 0x4f, 0x55, 0x42, 0x4c, 0x45, 0x5f, 0x45, 0x4c, 0x49, 0x4d, 0x49, 0x4e, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0x0a, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x45, 0x45, 0x44, 0x45, 0x44, 0x5f,
+	0x53, 0x50, 0x4c, 0x49, 0x54, 0x10, 0x0b, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x45, 0x45, 0x44, 0x45,
+	0x44, 0x5f, 0x46, 0x4f, 0x4c, 0x44, 0x10, 0x0c, 0x2a, 0x46, 0x0a, 0x0b, 0x46, 0x69, 0x72, 0x73,
+	0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x41, 0x4e, 0x55, 0x41,
+	0x4c, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x41, 0x4e,
+	0x44, 0x4f, 0x4d, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x41,
+	0x55, 0x54, 0x4f, 0x4d, 0x41, 0x54, 0x49, 0x43, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x10, 0x02,
+	0x2a, 0x3b, 0x0a, 0x10, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x49, 0x54, 0x48, 0x44, 0x52, 0x41, 0x57,
+	0x5f, 0x44, 0x52, 0x4f, 0x50, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x57, 0x49, 0x54, 0x48, 0x44,
+	0x52, 0x41, 0x57, 0x5f, 0x46, 0x4f, 0x52, 0x46, 0x45, 0x49, 0x54, 0x10, 0x01, 0x2a, 0x60, 0x0a,
+	0x0b, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x69, 0x64, 0x65, 0x12, 0x13, 0x0a, 0x0f,
+	0x57, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x53, 0x5f, 0x42, 0x52, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x4f, 0x53, 0x45, 0x52, 0x53, 0x5f, 0x42, 0x52, 0x41, 0x43,
+	0x4b, 0x45, 0x54, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x47, 0x52, 0x41, 0x4e, 0x44, 0x5f, 0x46,
+	0x49, 0x4e, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x4e, 0x53, 0x4f, 0x4c,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x52, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x10, 0x03, 0x2a,
+	0x2d, 0x0a, 0x08, 0x43, 0x6c, 0x75, 0x62, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x43,
+	0x4c, 0x55, 0x42, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c,
+	0x43, 0x4c, 0x55, 0x42, 0x5f, 0x4f, 0x46, 0x46, 0x49, 0x43, 0x45, 0x52, 0x10, 0x01, 0x2a, 0x57,
+	0x0a, 0x09, 0x50, 0x72, 0x69, 0x7a, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x50,
+	0x4c, 0x41, 0x43, 0x45, 0x5f, 0x50, 0x52, 0x49, 0x5a, 0x45, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b,
+	0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x50, 0x52, 0x49, 0x5a, 0x45, 0x10, 0x01, 0x12, 0x13, 0x0a,
+	0x0f, 0x48, 0x49, 0x47, 0x48, 0x5f, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x50, 0x52, 0x49, 0x5a, 0x45,
+	0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x48, 0x49, 0x47, 0x48, 0x5f, 0x4c, 0x4f, 0x53, 0x53, 0x5f,
+	0x50, 0x52, 0x49, 0x5a, 0x45, 0x10, 0x03, 0x2a, 0x3f, 0x0a, 0x0c, 0x50, 0x72, 0x69, 0x7a, 0x65,
+	0x54, 0x69, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x50, 0x4c, 0x49, 0x54,
+	0x5f, 0x42, 0x59, 0x5f, 0x57, 0x49, 0x4e, 0x53, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x53, 0x50, 0x52,
+	0x45, 0x41, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x5f, 0x42,
+	0x59, 0x5f, 0x57, 0x49, 0x4e, 0x53, 0x10, 0x01, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x6f, 0x6d, 0x69, 0x6e, 0x6f, 0x31, 0x34, 0x2f,
+	0x6c, 0x69, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -2300,90 +4786,146 @@ func file_api_proto_ipc_tournament_proto_rawDescGZIP() []byte {
 	return file_api_proto_ipc_tournament_proto_rawDescData
 }
 
-var file_api_proto_ipc_tournament_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_api_proto_ipc_tournament_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_api_proto_ipc_tournament_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_api_proto_ipc_tournament_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
 var file_api_proto_ipc_tournament_proto_goTypes = []interface{}{
 	(TournamentGameResult)(0),                 // 0: ipc.TournamentGameResult
 	(PairingMethod)(0),                        // 1: ipc.PairingMethod
 	(FirstMethod)(0),                          // 2: ipc.FirstMethod
-	(*TournamentGameEndedEvent)(nil),          // 3: ipc.TournamentGameEndedEvent
-	(*TournamentRoundStarted)(nil),            // 4: ipc.TournamentRoundStarted
-	(*ReadyForTournamentGame)(nil),            // 5: ipc.ReadyForTournamentGame
-	(*TournamentPerson)(nil),                  // 6: ipc.TournamentPerson
-	(*TournamentPersons)(nil),                 // 7: ipc.TournamentPersons
-	(*RoundControl)(nil),                      // 8: ipc.RoundControl
-	(*DivisionControls)(nil),                  // 9: ipc.DivisionControls
-	(*TournamentGame)(nil),                    // 10: ipc.TournamentGame
-	(*Pairing)(nil),                           // 11: ipc.Pairing
-	(*PlayerStanding)(nil),                    // 12: ipc.PlayerStanding
-	(*RoundStandings)(nil),                    // 13: ipc.RoundStandings
-	(*DivisionPairingsResponse)(nil),          // 14: ipc.DivisionPairingsResponse
-	(*DivisionPairingsDeletedResponse)(nil),   // 15: ipc.DivisionPairingsDeletedResponse
-	(*PlayersAddedOrRemovedResponse)(nil),     // 16: ipc.PlayersAddedOrRemovedResponse
-	(*DivisionRoundControls)(nil),             // 17: ipc.DivisionRoundControls
-	(*DivisionControlsResponse)(nil),          // 18: ipc.DivisionControlsResponse
-	(*TournamentDivisionDataResponse)(nil),    // 19: ipc.TournamentDivisionDataResponse
-	(*FullTournamentDivisions)(nil),           // 20: ipc.FullTournamentDivisions
-	(*TournamentFinishedResponse)(nil),        // 21: ipc.TournamentFinishedResponse
-	(*TournamentDataResponse)(nil),            // 22: ipc.TournamentDataResponse
-	(*TournamentDivisionDeletedResponse)(nil), // 23: ipc.TournamentDivisionDeletedResponse
-	(*TournamentGameEndedEvent_Player)(nil),   // 24: ipc.TournamentGameEndedEvent.Player
-	nil,                                       // 25: ipc.DivisionPairingsResponse.DivisionStandingsEntry
-	nil,                                       // 26: ipc.PlayersAddedOrRemovedResponse.DivisionStandingsEntry
-	nil,                                       // 27: ipc.DivisionRoundControls.DivisionStandingsEntry
-	nil,                                       // 28: ipc.DivisionControlsResponse.DivisionStandingsEntry
-	nil,                                       // 29: ipc.TournamentDivisionDataResponse.StandingsEntry
-	nil,                                       // 30: ipc.TournamentDivisionDataResponse.PairingMapEntry
-	nil,                                       // 31: ipc.FullTournamentDivisions.DivisionsEntry
-	(GameEndReason)(0),                        // 32: ipc.GameEndReason
-	(*timestamppb.Timestamp)(nil),             // 33: google.protobuf.Timestamp
-	(*GameRequest)(nil),                       // 34: ipc.GameRequest
+	(WithdrawalPolicy)(0),                     // 3: ipc.WithdrawalPolicy
+	(BracketSide)(0),                          // 4: ipc.BracketSide
+	(ClubRole)(0),                             // 5: ipc.ClubRole
+	(PrizeType)(0),                            // 6: ipc.PrizeType
+	(PrizeTieRule)(0),                         // 7: ipc.PrizeTieRule
+	(*TournamentGameEndedEvent)(nil),          // 8: ipc.TournamentGameEndedEvent
+	(*TournamentRoundStarted)(nil),            // 9: ipc.TournamentRoundStarted
+	(*ReadyForTournamentGame)(nil),            // 10: ipc.ReadyForTournamentGame
+	(*TournamentPerson)(nil),                  // 11: ipc.TournamentPerson
+	(*TournamentPersons)(nil),                 // 12: ipc.TournamentPersons
+	(*RoundControl)(nil),                      // 13: ipc.RoundControl
+	(*DivisionControls)(nil),                  // 14: ipc.DivisionControls
+	(*TournamentGame)(nil),                    // 15: ipc.TournamentGame
+	(*Pairing)(nil),                           // 16: ipc.Pairing
+	(*PlayerStanding)(nil),                    // 17: ipc.PlayerStanding
+	(*RoundStandings)(nil),                    // 18: ipc.RoundStandings
+	(*DivisionPairingsResponse)(nil),          // 19: ipc.DivisionPairingsResponse
+	(*DivisionPairingsDeletedResponse)(nil),   // 20: ipc.DivisionPairingsDeletedResponse
+	(*PlayersAddedOrRemovedResponse)(nil),     // 21: ipc.PlayersAddedOrRemovedResponse
+	(*DivisionRoundControls)(nil),             // 22: ipc.DivisionRoundControls
+	(*DivisionControlsResponse)(nil),          // 23: ipc.DivisionControlsResponse
+	(*TournamentDivisionDataResponse)(nil),    // 24: ipc.TournamentDivisionDataResponse
+	(*FullTournamentDivisions)(nil),           // 25: ipc.FullTournamentDivisions
+	(*TournamentFinishedResponse)(nil),        // 26: ipc.TournamentFinishedResponse
+	(*TournamentDataResponse)(nil),            // 27: ipc.TournamentDataResponse
+	(*TournamentDivisionDeletedResponse)(nil), // 28: ipc.TournamentDivisionDeletedResponse
+	(*BracketMatch)(nil),                      // 29: ipc.BracketMatch
+	(*BracketResponse)(nil),                   // 30: ipc.BracketResponse
+	(*PairingWeight)(nil),                     // 31: ipc.PairingWeight
+	(*PlayerContention)(nil),                  // 32: ipc.PlayerContention
+	(*PairingPreview)(nil),                    // 33: ipc.PairingPreview
+	(*PairingPreviewResponse)(nil),            // 34: ipc.PairingPreviewResponse
+	(*LadderChallenge)(nil),                   // 35: ipc.LadderChallenge
+	(*Ladder)(nil),                            // 36: ipc.Ladder
+	(*ClubMember)(nil),                        // 37: ipc.ClubMember
+	(*Club)(nil),                              // 38: ipc.Club
+	(*ClubSessionTemplateDivision)(nil),       // 39: ipc.ClubSessionTemplateDivision
+	(*ClubSessionTemplate)(nil),               // 40: ipc.ClubSessionTemplate
+	(*ClubSessionSchedule)(nil),               // 41: ipc.ClubSessionSchedule
+	(*Prize)(nil),                             // 42: ipc.Prize
+	(*PrizeAward)(nil),                        // 43: ipc.PrizeAward
+	(*LadderChallengeAccept)(nil),             // 44: ipc.LadderChallengeAccept
+	(*LadderChallengeDecline)(nil),            // 45: ipc.LadderChallengeDecline
+	(*PlayerPairingReport)(nil),               // 46: ipc.PlayerPairingReport
+	(*RoundPairingReport)(nil),                // 47: ipc.RoundPairingReport
+	(*PairingReport)(nil),                     // 48: ipc.PairingReport
+	(*TournamentGameEndedEvent_Player)(nil),   // 49: ipc.TournamentGameEndedEvent.Player
+	nil,                                       // 50: ipc.DivisionPairingsResponse.DivisionStandingsEntry
+	nil,                                       // 51: ipc.PlayersAddedOrRemovedResponse.DivisionStandingsEntry
+	nil,                                       // 52: ipc.DivisionRoundControls.DivisionStandingsEntry
+	nil,                                       // 53: ipc.DivisionControlsResponse.DivisionStandingsEntry
+	nil,                                       // 54: ipc.TournamentDivisionDataResponse.StandingsEntry
+	nil,                                       // 55: ipc.TournamentDivisionDataResponse.PairingMapEntry
+	nil,                                       // 56: ipc.FullTournamentDivisions.DivisionsEntry
+	(GameEndReason)(0),                        // 57: ipc.GameEndReason
+	(*timestamppb.Timestamp)(nil),             // 58: google.protobuf.Timestamp
+	(*GameRequest)(nil),                       // 59: ipc.GameRequest
 }
 var file_api_proto_ipc_tournament_proto_depIdxs = []int32{
-	24, // 0: ipc.TournamentGameEndedEvent.players:type_name -> ipc.TournamentGameEndedEvent.Player
-	32, // 1: ipc.TournamentGameEndedEvent.end_reason:type_name -> ipc.GameEndReason
-	33, // 2: ipc.TournamentRoundStarted.deadline:type_name -> google.protobuf.Timestamp
-	6,  // 3: ipc.TournamentPersons.persons:type_name -> ipc.TournamentPerson
-	1,  // 4: ipc.RoundControl.pairing_method:type_name -> ipc.PairingMethod
-	2,  // 5: ipc.RoundControl.first_method:type_name -> ipc.FirstMethod
-	34, // 6: ipc.DivisionControls.game_request:type_name -> ipc.GameRequest
-	0,  // 7: ipc.DivisionControls.suspended_result:type_name -> ipc.TournamentGameResult
-	0,  // 8: ipc.TournamentGame.results:type_name -> ipc.TournamentGameResult
-	32, // 9: ipc.TournamentGame.game_end_reason:type_name -> ipc.GameEndReason
-	10, // 10: ipc.Pairing.games:type_name -> ipc.TournamentGame
-	0,  // 11: ipc.Pairing.outcomes:type_name -> ipc.TournamentGameResult
-	12, // 12: ipc.RoundStandings.standings:type_name -> ipc.PlayerStanding
-	11, // 13: ipc.DivisionPairingsResponse.division_pairings:type_name -> ipc.Pairing
-	25, // 14: ipc.DivisionPairingsResponse.division_standings:type_name -> ipc.DivisionPairingsResponse.DivisionStandingsEntry
-	7,  // 15: ipc.PlayersAddedOrRemovedResponse.players:type_name -> ipc.TournamentPersons
-	11, // 16: ipc.PlayersAddedOrRemovedResponse.division_pairings:type_name -> ipc.Pairing
-	26, // 17: ipc.PlayersAddedOrRemovedResponse.division_standings:type_name -> ipc.PlayersAddedOrRemovedResponse.DivisionStandingsEntry
-	8,  // 18: ipc.DivisionRoundControls.round_controls:type_name -> ipc.RoundControl
-	11, // 19: ipc.DivisionRoundControls.division_pairings:type_name -> ipc.Pairing
-	27, // 20: ipc.DivisionRoundControls.division_standings:type_name -> ipc.DivisionRoundControls.DivisionStandingsEntry
-	9,  // 21: ipc.DivisionControlsResponse.division_controls:type_name -> ipc.DivisionControls
-	28, // 22: ipc.DivisionControlsResponse.division_standings:type_name -> ipc.DivisionControlsResponse.DivisionStandingsEntry
-	7,  // 23: ipc.TournamentDivisionDataResponse.players:type_name -> ipc.TournamentPersons
-	29, // 24: ipc.TournamentDivisionDataResponse.standings:type_name -> ipc.TournamentDivisionDataResponse.StandingsEntry
-	30, // 25: ipc.TournamentDivisionDataResponse.pairing_map:type_name -> ipc.TournamentDivisionDataResponse.PairingMapEntry
-	9,  // 26: ipc.TournamentDivisionDataResponse.controls:type_name -> ipc.DivisionControls
-	8,  // 27: ipc.TournamentDivisionDataResponse.round_controls:type_name -> ipc.RoundControl
-	31, // 28: ipc.FullTournamentDivisions.divisions:type_name -> ipc.FullTournamentDivisions.DivisionsEntry
-	7,  // 29: ipc.TournamentDataResponse.directors:type_name -> ipc.TournamentPersons
-	33, // 30: ipc.TournamentDataResponse.start_time:type_name -> google.protobuf.Timestamp
-	0,  // 31: ipc.TournamentGameEndedEvent.Player.result:type_name -> ipc.TournamentGameResult
-	13, // 32: ipc.DivisionPairingsResponse.DivisionStandingsEntry.value:type_name -> ipc.RoundStandings
-	13, // 33: ipc.PlayersAddedOrRemovedResponse.DivisionStandingsEntry.value:type_name -> ipc.RoundStandings
-	13, // 34: ipc.DivisionRoundControls.DivisionStandingsEntry.value:type_name -> ipc.RoundStandings
-	13, // 35: ipc.DivisionControlsResponse.DivisionStandingsEntry.value:type_name -> ipc.RoundStandings
-	13, // 36: ipc.TournamentDivisionDataResponse.StandingsEntry.value:type_name -> ipc.RoundStandings
-	11, // 37: ipc.TournamentDivisionDataResponse.PairingMapEntry.value:type_name -> ipc.Pairing
-	19, // 38: ipc.FullTournamentDivisions.DivisionsEntry.value:type_name -> ipc.TournamentDivisionDataResponse
-	39, // [39:39] is the sub-list for method output_type
-	39, // [39:39] is the sub-list for method input_type
-	39, // [39:39] is the sub-list for extension type_name
-	39, // [39:39] is the sub-list for extension extendee
-	0,  // [0:39] is the sub-list for field type_name
+	49, // 0: ipc.TournamentGameEndedEvent.players:type_name -> ipc.TournamentGameEndedEvent.Player
+	57, // 1: ipc.TournamentGameEndedEvent.end_reason:type_name -> ipc.GameEndReason
+	58, // 2: ipc.TournamentRoundStarted.deadline:type_name -> google.protobuf.Timestamp
+	3,  // 3: ipc.TournamentPerson.withdrawal_policy:type_name -> ipc.WithdrawalPolicy
+	11, // 4: ipc.TournamentPersons.persons:type_name -> ipc.TournamentPerson
+	1,  // 5: ipc.RoundControl.pairing_method:type_name -> ipc.PairingMethod
+	2,  // 6: ipc.RoundControl.first_method:type_name -> ipc.FirstMethod
+	58, // 7: ipc.RoundControl.scheduled_start_time:type_name -> google.protobuf.Timestamp
+	59, // 8: ipc.DivisionControls.game_request:type_name -> ipc.GameRequest
+	0,  // 9: ipc.DivisionControls.suspended_result:type_name -> ipc.TournamentGameResult
+	58, // 10: ipc.DivisionControls.registration_deadline:type_name -> google.protobuf.Timestamp
+	58, // 11: ipc.DivisionControls.check_in_deadline:type_name -> google.protobuf.Timestamp
+	0,  // 12: ipc.TournamentGame.results:type_name -> ipc.TournamentGameResult
+	57, // 13: ipc.TournamentGame.game_end_reason:type_name -> ipc.GameEndReason
+	15, // 14: ipc.Pairing.games:type_name -> ipc.TournamentGame
+	0,  // 15: ipc.Pairing.outcomes:type_name -> ipc.TournamentGameResult
+	17, // 16: ipc.RoundStandings.standings:type_name -> ipc.PlayerStanding
+	16, // 17: ipc.DivisionPairingsResponse.division_pairings:type_name -> ipc.Pairing
+	50, // 18: ipc.DivisionPairingsResponse.division_standings:type_name -> ipc.DivisionPairingsResponse.DivisionStandingsEntry
+	12, // 19: ipc.PlayersAddedOrRemovedResponse.players:type_name -> ipc.TournamentPersons
+	16, // 20: ipc.PlayersAddedOrRemovedResponse.division_pairings:type_name -> ipc.Pairing
+	51, // 21: ipc.PlayersAddedOrRemovedResponse.division_standings:type_name -> ipc.PlayersAddedOrRemovedResponse.DivisionStandingsEntry
+	13, // 22: ipc.DivisionRoundControls.round_controls:type_name -> ipc.RoundControl
+	16, // 23: ipc.DivisionRoundControls.division_pairings:type_name -> ipc.Pairing
+	52, // 24: ipc.DivisionRoundControls.division_standings:type_name -> ipc.DivisionRoundControls.DivisionStandingsEntry
+	14, // 25: ipc.DivisionControlsResponse.division_controls:type_name -> ipc.DivisionControls
+	53, // 26: ipc.DivisionControlsResponse.division_standings:type_name -> ipc.DivisionControlsResponse.DivisionStandingsEntry
+	12, // 27: ipc.TournamentDivisionDataResponse.players:type_name -> ipc.TournamentPersons
+	54, // 28: ipc.TournamentDivisionDataResponse.standings:type_name -> ipc.TournamentDivisionDataResponse.StandingsEntry
+	55, // 29: ipc.TournamentDivisionDataResponse.pairing_map:type_name -> ipc.TournamentDivisionDataResponse.PairingMapEntry
+	14, // 30: ipc.TournamentDivisionDataResponse.controls:type_name -> ipc.DivisionControls
+	13, // 31: ipc.TournamentDivisionDataResponse.round_controls:type_name -> ipc.RoundControl
+	56, // 32: ipc.FullTournamentDivisions.divisions:type_name -> ipc.FullTournamentDivisions.DivisionsEntry
+	12, // 33: ipc.TournamentDataResponse.directors:type_name -> ipc.TournamentPersons
+	58, // 34: ipc.TournamentDataResponse.start_time:type_name -> google.protobuf.Timestamp
+	4,  // 35: ipc.BracketMatch.side:type_name -> ipc.BracketSide
+	15, // 36: ipc.BracketMatch.games:type_name -> ipc.TournamentGame
+	29, // 37: ipc.BracketResponse.matches:type_name -> ipc.BracketMatch
+	31, // 38: ipc.PairingPreview.weight:type_name -> ipc.PairingWeight
+	13, // 39: ipc.PairingPreviewResponse.round_controls:type_name -> ipc.RoundControl
+	33, // 40: ipc.PairingPreviewResponse.pairings:type_name -> ipc.PairingPreview
+	32, // 41: ipc.PairingPreviewResponse.contention:type_name -> ipc.PlayerContention
+	58, // 42: ipc.LadderChallenge.created_at:type_name -> google.protobuf.Timestamp
+	58, // 43: ipc.LadderChallenge.deadline:type_name -> google.protobuf.Timestamp
+	58, // 44: ipc.LadderChallenge.accepted_at:type_name -> google.protobuf.Timestamp
+	11, // 45: ipc.Ladder.players:type_name -> ipc.TournamentPerson
+	35, // 46: ipc.Ladder.challenges:type_name -> ipc.LadderChallenge
+	5,  // 47: ipc.ClubMember.role:type_name -> ipc.ClubRole
+	58, // 48: ipc.ClubMember.joined_at:type_name -> google.protobuf.Timestamp
+	37, // 49: ipc.Club.members:type_name -> ipc.ClubMember
+	40, // 50: ipc.Club.session_template:type_name -> ipc.ClubSessionTemplate
+	41, // 51: ipc.Club.session_schedule:type_name -> ipc.ClubSessionSchedule
+	14, // 52: ipc.ClubSessionTemplateDivision.controls:type_name -> ipc.DivisionControls
+	13, // 53: ipc.ClubSessionTemplateDivision.round_controls:type_name -> ipc.RoundControl
+	39, // 54: ipc.ClubSessionTemplate.divisions:type_name -> ipc.ClubSessionTemplateDivision
+	59, // 55: ipc.ClubSessionTemplate.default_club_settings:type_name -> ipc.GameRequest
+	58, // 56: ipc.ClubSessionSchedule.last_session:type_name -> google.protobuf.Timestamp
+	6,  // 57: ipc.Prize.type:type_name -> ipc.PrizeType
+	42, // 58: ipc.PrizeAward.prize:type_name -> ipc.Prize
+	1,  // 59: ipc.RoundPairingReport.pairing_method:type_name -> ipc.PairingMethod
+	46, // 60: ipc.PairingReport.players:type_name -> ipc.PlayerPairingReport
+	47, // 61: ipc.PairingReport.rounds:type_name -> ipc.RoundPairingReport
+	0,  // 62: ipc.TournamentGameEndedEvent.Player.result:type_name -> ipc.TournamentGameResult
+	18, // 63: ipc.DivisionPairingsResponse.DivisionStandingsEntry.value:type_name -> ipc.RoundStandings
+	18, // 64: ipc.PlayersAddedOrRemovedResponse.DivisionStandingsEntry.value:type_name -> ipc.RoundStandings
+	18, // 65: ipc.DivisionRoundControls.DivisionStandingsEntry.value:type_name -> ipc.RoundStandings
+	18, // 66: ipc.DivisionControlsResponse.DivisionStandingsEntry.value:type_name -> ipc.RoundStandings
+	18, // 67: ipc.TournamentDivisionDataResponse.StandingsEntry.value:type_name -> ipc.RoundStandings
+	16, // 68: ipc.TournamentDivisionDataResponse.PairingMapEntry.value:type_name -> ipc.Pairing
+	24, // 69: ipc.FullTournamentDivisions.DivisionsEntry.value:type_name -> ipc.TournamentDivisionDataResponse
+	70, // [70:70] is the sub-list for method output_type
+	70, // [70:70] is the sub-list for method input_type
+	70, // [70:70] is the sub-list for extension type_name
+	70, // [70:70] is the sub-list for extension extendee
+	0,  // [0:70] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_ipc_tournament_proto_init() }
@@ -2646,6 +5188,246 @@ func file_api_proto_ipc_tournament_proto_init() {
 			}
 		}
 		file_api_proto_ipc_tournament_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BracketMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_ipc_tournament_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BracketResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_ipc_tournament_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairingWeight); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_ipc_tournament_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerContention); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_ipc_tournament_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairingPreview); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_ipc_tournament_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairingPreviewResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_ipc_tournament_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LadderChallenge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_ipc_tournament_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ladder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_ipc_tournament_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClubMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_ipc_tournament_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Club); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_ipc_tournament_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClubSessionTemplateDivision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_ipc_tournament_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClubSessionTemplate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_ipc_tournament_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClubSessionSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_ipc_tournament_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Prize); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_ipc_tournament_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrizeAward); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_ipc_tournament_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LadderChallengeAccept); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_ipc_tournament_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LadderChallengeDecline); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_ipc_tournament_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerPairingReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_ipc_tournament_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoundPairingReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_ipc_tournament_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairingReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_ipc_tournament_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TournamentGameEndedEvent_Player); i {
 			case 0:
 				return &v.state
@@ -2663,8 +5445,8 @@ func file_api_proto_ipc_tournament_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_proto_ipc_tournament_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   29,
+			NumEnums:      8,
+			NumMessages:   49,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
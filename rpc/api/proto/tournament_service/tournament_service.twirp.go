@@ -47,6 +47,10 @@ type TournamentService interface {
 
 	PairRound(context.Context, *PairRoundRequest) (*TournamentResponse, error)
 
+	// PreviewPairRound shows the pairings PairRound would make, and why,
+	// without making them.
+	PreviewPairRound(context.Context, *PairingPreviewRequest) (*ipc1.PairingPreviewResponse, error)
+
 	SetSingleRoundControls(context.Context, *SingleRoundControlsRequest) (*TournamentResponse, error)
 
 	SetRoundControls(context.Context, *ipc1.DivisionRoundControls) (*TournamentResponse, error)
@@ -69,6 +73,12 @@ type TournamentService interface {
 	// Input to RemovePlayers should be player usernames
 	RemovePlayers(context.Context, *ipc1.TournamentPersons) (*TournamentResponse, error)
 
+	// WithdrawPlayers takes players out of the pairings of a division that
+	// has started, and ReadmitPlayers brings them back.
+	WithdrawPlayers(context.Context, *WithdrawPlayersRequest) (*TournamentResponse, error)
+
+	ReadmitPlayers(context.Context, *ReadmitPlayersRequest) (*TournamentResponse, error)
+
 	SetPairing(context.Context, *TournamentPairingsRequest) (*TournamentResponse, error)
 
 	SetResult(context.Context, *TournamentResultOverrideRequest) (*TournamentResponse, error)
@@ -81,6 +91,37 @@ type TournamentService interface {
 
 	GetRecentClubSessions(context.Context, *RecentClubSessionsRequest) (*ClubSessionsResponse, error)
 
+	// PromoteAndRelegate moves the players of a finished club session into
+	// the divisions of the next session, promoting the players at the top of
+	// each division and relegating the players at the bottom.
+	PromoteAndRelegate(context.Context, *PromotionRequest) (*PromotionResponse, error)
+
+	GetClub(context.Context, *GetClubRequest) (*ipc1.Club, error)
+
+	// AddClubMembers adds members to the club, or changes the roles of
+	// existing members.
+	AddClubMembers(context.Context, *ClubMembersRequest) (*TournamentResponse, error)
+
+	RemoveClubMembers(context.Context, *ClubMembersRequest) (*TournamentResponse, error)
+
+	SetClubControls(context.Context, *ClubControlsRequest) (*TournamentResponse, error)
+
+	// SetClubSessionTemplate sets the divisions and settings that new
+	// sessions of the club are created with.
+	SetClubSessionTemplate(context.Context, *ClubSessionTemplateRequest) (*TournamentResponse, error)
+
+	// SetClubSessionSchedule has a session of the club created every week.
+	// An unset schedule stops the sessions from being created.
+	SetClubSessionSchedule(context.Context, *ClubSessionScheduleRequest) (*TournamentResponse, error)
+
+	// GetClubRatings rates the players on the games played in the most
+	// recent sessions of the club only, each session being one rating period.
+	GetClubRatings(context.Context, *ClubRatingsRequest) (*ClubRatingsResponse, error)
+
+	// GetClubStats returns the attendance of the most recent sessions of the
+	// club and its most active members in them.
+	GetClubStats(context.Context, *ClubStatsRequest) (*ClubStatsResponse, error)
+
 	UnstartTournament(context.Context, *UnstartTournamentRequest) (*TournamentResponse, error)
 
 	// Uncheck everyone in. Use this some time before the beginning of a session.
@@ -88,6 +129,66 @@ type TournamentService interface {
 
 	// CheckIn allows players to check themselves in.
 	CheckIn(context.Context, *CheckinRequest) (*TournamentResponse, error)
+
+	// GetBracket returns the full bracket, with results, for an elimination
+	// division.
+	GetBracket(context.Context, *TournamentDivisionRequest) (*ipc1.BracketResponse, error)
+
+	// Register allows players to register themselves for a division
+	// with open registration.
+	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
+
+	// Unregister withdraws a player's registration before the tournament
+	// starts. The first player on the waitlist takes their spot.
+	Unregister(context.Context, *RegisterRequest) (*TournamentResponse, error)
+
+	// Input to ApproveRegistrations should be player usernames
+	ApproveRegistrations(context.Context, *ipc1.TournamentPersons) (*TournamentResponse, error)
+
+	// Input to RejectRegistrations should be player usernames
+	RejectRegistrations(context.Context, *ipc1.TournamentPersons) (*TournamentResponse, error)
+
+	GetRegistrations(context.Context, *TournamentDivisionRequest) (*DivisionRegistrationsResponse, error)
+
+	// GetAuditLog returns the director actions taken in the tournament,
+	// most recent first. Only the executive director and admins can see it.
+	GetAuditLog(context.Context, *AuditLogRequest) (*AuditLogResponse, error)
+
+	// GetPairingReport shows how fair the pairings of a division have been.
+	GetPairingReport(context.Context, *TournamentDivisionRequest) (*ipc1.PairingReport, error)
+
+	// GetRatingPeriodPreview shows the rating changes that the games played
+	// so far would make in a division that is rated as one rating period.
+	GetRatingPeriodPreview(context.Context, *TournamentDivisionRequest) (*RatingPeriodResponse, error)
+
+	// CloneTournament creates a tournament with the settings and divisions
+	// of another one, and optionally its players.
+	CloneTournament(context.Context, *CloneTournamentRequest) (*NewTournamentResponse, error)
+
+	// CreateSnapshot saves the full state of the tournament. Snapshots are
+	// also taken before every PairRound and every SetResult that amends a
+	// result. Only the 50 most recent snapshots are kept.
+	CreateSnapshot(context.Context, *SnapshotRequest) (*TournamentSnapshot, error)
+
+	GetSnapshots(context.Context, *GetTournamentRequest) (*SnapshotsResponse, error)
+
+	// RestoreSnapshot rolls the tournament back to a snapshot.
+	RestoreSnapshot(context.Context, *RestoreSnapshotRequest) (*TournamentResponse, error)
+
+	GetLadder(context.Context, *GetLadderRequest) (*ipc1.Ladder, error)
+
+	SetLadderControls(context.Context, *LadderControlsRequest) (*TournamentResponse, error)
+
+	// Input to AddLadderPlayers should be usernames. They are added at the
+	// bottom of the ladder, in order.
+	AddLadderPlayers(context.Context, *ipc1.TournamentPersons) (*TournamentResponse, error)
+
+	// Input to RemoveLadderPlayers should be usernames.
+	RemoveLadderPlayers(context.Context, *ipc1.TournamentPersons) (*TournamentResponse, error)
+
+	// ChallengeLadderPlayer challenges a higher ranked player on the ladder.
+	// The challenged player accepts with a LadderChallengeAccept message.
+	ChallengeLadderPlayer(context.Context, *LadderChallengeRequest) (*TournamentResponse, error)
 }
 
 // =================================
@@ -96,7 +197,7 @@ type TournamentService interface {
 
 type tournamentServiceProtobufClient struct {
 	client      HTTPClient
-	urls        [24]string
+	urls        [54]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -124,13 +225,14 @@ func NewTournamentServiceProtobufClient(baseURL string, client HTTPClient, opts
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "tournament_service", "TournamentService")
-	urls := [24]string{
+	urls := [54]string{
 		serviceURL + "NewTournament",
 		serviceURL + "GetTournamentMetadata",
 		serviceURL + "GetTournament",
 		serviceURL + "FinishTournament",
 		serviceURL + "SetTournamentMetadata",
 		serviceURL + "PairRound",
+		serviceURL + "PreviewPairRound",
 		serviceURL + "SetSingleRoundControls",
 		serviceURL + "SetRoundControls",
 		serviceURL + "SetDivisionControls",
@@ -140,15 +242,44 @@ func NewTournamentServiceProtobufClient(baseURL string, client HTTPClient, opts
 		serviceURL + "RemoveDivision",
 		serviceURL + "AddPlayers",
 		serviceURL + "RemovePlayers",
+		serviceURL + "WithdrawPlayers",
+		serviceURL + "ReadmitPlayers",
 		serviceURL + "SetPairing",
 		serviceURL + "SetResult",
 		serviceURL + "StartRoundCountdown",
 		serviceURL + "RecentGames",
 		serviceURL + "CreateClubSession",
 		serviceURL + "GetRecentClubSessions",
+		serviceURL + "PromoteAndRelegate",
+		serviceURL + "GetClub",
+		serviceURL + "AddClubMembers",
+		serviceURL + "RemoveClubMembers",
+		serviceURL + "SetClubControls",
+		serviceURL + "SetClubSessionTemplate",
+		serviceURL + "SetClubSessionSchedule",
+		serviceURL + "GetClubRatings",
+		serviceURL + "GetClubStats",
 		serviceURL + "UnstartTournament",
 		serviceURL + "UncheckIn",
 		serviceURL + "CheckIn",
+		serviceURL + "GetBracket",
+		serviceURL + "Register",
+		serviceURL + "Unregister",
+		serviceURL + "ApproveRegistrations",
+		serviceURL + "RejectRegistrations",
+		serviceURL + "GetRegistrations",
+		serviceURL + "GetAuditLog",
+		serviceURL + "GetPairingReport",
+		serviceURL + "GetRatingPeriodPreview",
+		serviceURL + "CloneTournament",
+		serviceURL + "CreateSnapshot",
+		serviceURL + "GetSnapshots",
+		serviceURL + "RestoreSnapshot",
+		serviceURL + "GetLadder",
+		serviceURL + "SetLadderControls",
+		serviceURL + "AddLadderPlayers",
+		serviceURL + "RemoveLadderPlayers",
+		serviceURL + "ChallengeLadderPlayer",
 	}
 
 	return &tournamentServiceProtobufClient{
@@ -435,6 +566,52 @@ func (c *tournamentServiceProtobufClient) callPairRound(ctx context.Context, in
 	return out, nil
 }
 
+func (c *tournamentServiceProtobufClient) PreviewPairRound(ctx context.Context, in *PairingPreviewRequest) (*ipc1.PairingPreviewResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "PreviewPairRound")
+	caller := c.callPreviewPairRound
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *PairingPreviewRequest) (*ipc1.PairingPreviewResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PairingPreviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PairingPreviewRequest) when calling interceptor")
+					}
+					return c.callPreviewPairRound(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ipc1.PairingPreviewResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ipc1.PairingPreviewResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceProtobufClient) callPreviewPairRound(ctx context.Context, in *PairingPreviewRequest) (*ipc1.PairingPreviewResponse, error) {
+	out := new(ipc1.PairingPreviewResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 func (c *tournamentServiceProtobufClient) SetSingleRoundControls(ctx context.Context, in *SingleRoundControlsRequest) (*TournamentResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
 	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
@@ -466,7 +643,7 @@ func (c *tournamentServiceProtobufClient) SetSingleRoundControls(ctx context.Con
 
 func (c *tournamentServiceProtobufClient) callSetSingleRoundControls(ctx context.Context, in *SingleRoundControlsRequest) (*TournamentResponse, error) {
 	out := new(TournamentResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -512,7 +689,7 @@ func (c *tournamentServiceProtobufClient) SetRoundControls(ctx context.Context,
 
 func (c *tournamentServiceProtobufClient) callSetRoundControls(ctx context.Context, in *ipc1.DivisionRoundControls) (*TournamentResponse, error) {
 	out := new(TournamentResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -558,7 +735,7 @@ func (c *tournamentServiceProtobufClient) SetDivisionControls(ctx context.Contex
 
 func (c *tournamentServiceProtobufClient) callSetDivisionControls(ctx context.Context, in *ipc1.DivisionControls) (*TournamentResponse, error) {
 	out := new(TournamentResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -604,7 +781,7 @@ func (c *tournamentServiceProtobufClient) AddDirectors(ctx context.Context, in *
 
 func (c *tournamentServiceProtobufClient) callAddDirectors(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
 	out := new(TournamentResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -650,7 +827,7 @@ func (c *tournamentServiceProtobufClient) RemoveDirectors(ctx context.Context, i
 
 func (c *tournamentServiceProtobufClient) callRemoveDirectors(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
 	out := new(TournamentResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -696,7 +873,7 @@ func (c *tournamentServiceProtobufClient) AddDivision(ctx context.Context, in *T
 
 func (c *tournamentServiceProtobufClient) callAddDivision(ctx context.Context, in *TournamentDivisionRequest) (*TournamentResponse, error) {
 	out := new(TournamentResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -742,7 +919,7 @@ func (c *tournamentServiceProtobufClient) RemoveDivision(ctx context.Context, in
 
 func (c *tournamentServiceProtobufClient) callRemoveDivision(ctx context.Context, in *TournamentDivisionRequest) (*TournamentResponse, error) {
 	out := new(TournamentResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -788,7 +965,7 @@ func (c *tournamentServiceProtobufClient) AddPlayers(ctx context.Context, in *ip
 
 func (c *tournamentServiceProtobufClient) callAddPlayers(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
 	out := new(TournamentResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -834,7 +1011,99 @@ func (c *tournamentServiceProtobufClient) RemovePlayers(ctx context.Context, in
 
 func (c *tournamentServiceProtobufClient) callRemovePlayers(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
 	out := new(TournamentResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceProtobufClient) WithdrawPlayers(ctx context.Context, in *WithdrawPlayersRequest) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "WithdrawPlayers")
+	caller := c.callWithdrawPlayers
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *WithdrawPlayersRequest) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WithdrawPlayersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WithdrawPlayersRequest) when calling interceptor")
+					}
+					return c.callWithdrawPlayers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceProtobufClient) callWithdrawPlayers(ctx context.Context, in *WithdrawPlayersRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[16], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceProtobufClient) ReadmitPlayers(ctx context.Context, in *ReadmitPlayersRequest) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "ReadmitPlayers")
+	caller := c.callReadmitPlayers
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ReadmitPlayersRequest) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReadmitPlayersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReadmitPlayersRequest) when calling interceptor")
+					}
+					return c.callReadmitPlayers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceProtobufClient) callReadmitPlayers(ctx context.Context, in *ReadmitPlayersRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[17], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -880,7 +1149,7 @@ func (c *tournamentServiceProtobufClient) SetPairing(ctx context.Context, in *To
 
 func (c *tournamentServiceProtobufClient) callSetPairing(ctx context.Context, in *TournamentPairingsRequest) (*TournamentResponse, error) {
 	out := new(TournamentResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[18], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -926,7 +1195,7 @@ func (c *tournamentServiceProtobufClient) SetResult(ctx context.Context, in *Tou
 
 func (c *tournamentServiceProtobufClient) callSetResult(ctx context.Context, in *TournamentResultOverrideRequest) (*TournamentResponse, error) {
 	out := new(TournamentResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[16], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[19], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -972,7 +1241,7 @@ func (c *tournamentServiceProtobufClient) StartRoundCountdown(ctx context.Contex
 
 func (c *tournamentServiceProtobufClient) callStartRoundCountdown(ctx context.Context, in *TournamentStartRoundCountdownRequest) (*TournamentResponse, error) {
 	out := new(TournamentResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[17], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[20], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1018,7 +1287,7 @@ func (c *tournamentServiceProtobufClient) RecentGames(ctx context.Context, in *R
 
 func (c *tournamentServiceProtobufClient) callRecentGames(ctx context.Context, in *RecentGamesRequest) (*RecentGamesResponse, error) {
 	out := new(RecentGamesResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[18], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[21], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1064,7 +1333,7 @@ func (c *tournamentServiceProtobufClient) CreateClubSession(ctx context.Context,
 
 func (c *tournamentServiceProtobufClient) callCreateClubSession(ctx context.Context, in *NewClubSessionRequest) (*ClubSessionResponse, error) {
 	out := new(ClubSessionResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[19], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[22], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1110,7 +1379,7 @@ func (c *tournamentServiceProtobufClient) GetRecentClubSessions(ctx context.Cont
 
 func (c *tournamentServiceProtobufClient) callGetRecentClubSessions(ctx context.Context, in *RecentClubSessionsRequest) (*ClubSessionsResponse, error) {
 	out := new(ClubSessionsResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[20], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[23], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1125,26 +1394,26 @@ func (c *tournamentServiceProtobufClient) callGetRecentClubSessions(ctx context.
 	return out, nil
 }
 
-func (c *tournamentServiceProtobufClient) UnstartTournament(ctx context.Context, in *UnstartTournamentRequest) (*TournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) PromoteAndRelegate(ctx context.Context, in *PromotionRequest) (*PromotionResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
 	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
-	ctx = ctxsetters.WithMethodName(ctx, "UnstartTournament")
-	caller := c.callUnstartTournament
+	ctx = ctxsetters.WithMethodName(ctx, "PromoteAndRelegate")
+	caller := c.callPromoteAndRelegate
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *UnstartTournamentRequest) (*TournamentResponse, error) {
+		caller = func(ctx context.Context, req *PromotionRequest) (*PromotionResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*UnstartTournamentRequest)
+					typedReq, ok := req.(*PromotionRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*UnstartTournamentRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*PromotionRequest) when calling interceptor")
 					}
-					return c.callUnstartTournament(ctx, typedReq)
+					return c.callPromoteAndRelegate(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentResponse)
+				typedResp, ok := resp.(*PromotionResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*PromotionResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1154,9 +1423,9 @@ func (c *tournamentServiceProtobufClient) UnstartTournament(ctx context.Context,
 	return caller(ctx, in)
 }
 
-func (c *tournamentServiceProtobufClient) callUnstartTournament(ctx context.Context, in *UnstartTournamentRequest) (*TournamentResponse, error) {
-	out := new(TournamentResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[21], in, out)
+func (c *tournamentServiceProtobufClient) callPromoteAndRelegate(ctx context.Context, in *PromotionRequest) (*PromotionResponse, error) {
+	out := new(PromotionResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[24], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1171,26 +1440,26 @@ func (c *tournamentServiceProtobufClient) callUnstartTournament(ctx context.Cont
 	return out, nil
 }
 
-func (c *tournamentServiceProtobufClient) UncheckIn(ctx context.Context, in *UncheckInRequest) (*TournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) GetClub(ctx context.Context, in *GetClubRequest) (*ipc1.Club, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
 	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
-	ctx = ctxsetters.WithMethodName(ctx, "UncheckIn")
-	caller := c.callUncheckIn
+	ctx = ctxsetters.WithMethodName(ctx, "GetClub")
+	caller := c.callGetClub
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *UncheckInRequest) (*TournamentResponse, error) {
+		caller = func(ctx context.Context, req *GetClubRequest) (*ipc1.Club, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*UncheckInRequest)
+					typedReq, ok := req.(*GetClubRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*UncheckInRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetClubRequest) when calling interceptor")
 					}
-					return c.callUncheckIn(ctx, typedReq)
+					return c.callGetClub(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentResponse)
+				typedResp, ok := resp.(*ipc1.Club)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ipc1.Club) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1200,9 +1469,9 @@ func (c *tournamentServiceProtobufClient) UncheckIn(ctx context.Context, in *Unc
 	return caller(ctx, in)
 }
 
-func (c *tournamentServiceProtobufClient) callUncheckIn(ctx context.Context, in *UncheckInRequest) (*TournamentResponse, error) {
-	out := new(TournamentResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[22], in, out)
+func (c *tournamentServiceProtobufClient) callGetClub(ctx context.Context, in *GetClubRequest) (*ipc1.Club, error) {
+	out := new(ipc1.Club)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[25], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1217,20 +1486,20 @@ func (c *tournamentServiceProtobufClient) callUncheckIn(ctx context.Context, in
 	return out, nil
 }
 
-func (c *tournamentServiceProtobufClient) CheckIn(ctx context.Context, in *CheckinRequest) (*TournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) AddClubMembers(ctx context.Context, in *ClubMembersRequest) (*TournamentResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
 	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
-	ctx = ctxsetters.WithMethodName(ctx, "CheckIn")
-	caller := c.callCheckIn
+	ctx = ctxsetters.WithMethodName(ctx, "AddClubMembers")
+	caller := c.callAddClubMembers
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *CheckinRequest) (*TournamentResponse, error) {
+		caller = func(ctx context.Context, req *ClubMembersRequest) (*TournamentResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CheckinRequest)
+					typedReq, ok := req.(*ClubMembersRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CheckinRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ClubMembersRequest) when calling interceptor")
 					}
-					return c.callCheckIn(ctx, typedReq)
+					return c.callAddClubMembers(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -1246,9 +1515,9 @@ func (c *tournamentServiceProtobufClient) CheckIn(ctx context.Context, in *Check
 	return caller(ctx, in)
 }
 
-func (c *tournamentServiceProtobufClient) callCheckIn(ctx context.Context, in *CheckinRequest) (*TournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) callAddClubMembers(ctx context.Context, in *ClubMembersRequest) (*TournamentResponse, error) {
 	out := new(TournamentResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[23], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[26], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1263,95 +1532,72 @@ func (c *tournamentServiceProtobufClient) callCheckIn(ctx context.Context, in *C
 	return out, nil
 }
 
-// =============================
-// TournamentService JSON Client
-// =============================
-
-type tournamentServiceJSONClient struct {
-	client      HTTPClient
-	urls        [24]string
-	interceptor twirp.Interceptor
-	opts        twirp.ClientOptions
+func (c *tournamentServiceProtobufClient) RemoveClubMembers(ctx context.Context, in *ClubMembersRequest) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveClubMembers")
+	caller := c.callRemoveClubMembers
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ClubMembersRequest) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ClubMembersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ClubMembersRequest) when calling interceptor")
+					}
+					return c.callRemoveClubMembers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
 }
 
-// NewTournamentServiceJSONClient creates a JSON client that implements the TournamentService interface.
-// It communicates using JSON and can be configured with a custom HTTPClient.
-func NewTournamentServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) TournamentService {
-	if c, ok := client.(*http.Client); ok {
-		client = withoutRedirects(c)
+func (c *tournamentServiceProtobufClient) callRemoveClubMembers(ctx context.Context, in *ClubMembersRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[27], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	clientOpts := twirp.ClientOptions{}
-	for _, o := range opts {
-		o(&clientOpts)
-	}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
-	literalURLs := false
-	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
-	var pathPrefix string
-	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
-	}
-
-	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
-	serviceURL := sanitizeBaseURL(baseURL)
-	serviceURL += baseServicePath(pathPrefix, "tournament_service", "TournamentService")
-	urls := [24]string{
-		serviceURL + "NewTournament",
-		serviceURL + "GetTournamentMetadata",
-		serviceURL + "GetTournament",
-		serviceURL + "FinishTournament",
-		serviceURL + "SetTournamentMetadata",
-		serviceURL + "PairRound",
-		serviceURL + "SetSingleRoundControls",
-		serviceURL + "SetRoundControls",
-		serviceURL + "SetDivisionControls",
-		serviceURL + "AddDirectors",
-		serviceURL + "RemoveDirectors",
-		serviceURL + "AddDivision",
-		serviceURL + "RemoveDivision",
-		serviceURL + "AddPlayers",
-		serviceURL + "RemovePlayers",
-		serviceURL + "SetPairing",
-		serviceURL + "SetResult",
-		serviceURL + "StartRoundCountdown",
-		serviceURL + "RecentGames",
-		serviceURL + "CreateClubSession",
-		serviceURL + "GetRecentClubSessions",
-		serviceURL + "UnstartTournament",
-		serviceURL + "UncheckIn",
-		serviceURL + "CheckIn",
-	}
-
-	return &tournamentServiceJSONClient{
-		client:      client,
-		urls:        urls,
-		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
-		opts:        clientOpts,
-	}
+	return out, nil
 }
 
-func (c *tournamentServiceJSONClient) NewTournament(ctx context.Context, in *NewTournamentRequest) (*NewTournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) SetClubControls(ctx context.Context, in *ClubControlsRequest) (*TournamentResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
 	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
-	ctx = ctxsetters.WithMethodName(ctx, "NewTournament")
-	caller := c.callNewTournament
+	ctx = ctxsetters.WithMethodName(ctx, "SetClubControls")
+	caller := c.callSetClubControls
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *NewTournamentRequest) (*NewTournamentResponse, error) {
+		caller = func(ctx context.Context, req *ClubControlsRequest) (*TournamentResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*NewTournamentRequest)
+					typedReq, ok := req.(*ClubControlsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*NewTournamentRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ClubControlsRequest) when calling interceptor")
 					}
-					return c.callNewTournament(ctx, typedReq)
+					return c.callSetClubControls(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*NewTournamentResponse)
+				typedResp, ok := resp.(*TournamentResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*NewTournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1361,9 +1607,9 @@ func (c *tournamentServiceJSONClient) NewTournament(ctx context.Context, in *New
 	return caller(ctx, in)
 }
 
-func (c *tournamentServiceJSONClient) callNewTournament(ctx context.Context, in *NewTournamentRequest) (*NewTournamentResponse, error) {
-	out := new(NewTournamentResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+func (c *tournamentServiceProtobufClient) callSetClubControls(ctx context.Context, in *ClubControlsRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[28], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1378,26 +1624,26 @@ func (c *tournamentServiceJSONClient) callNewTournament(ctx context.Context, in
 	return out, nil
 }
 
-func (c *tournamentServiceJSONClient) GetTournamentMetadata(ctx context.Context, in *GetTournamentMetadataRequest) (*TournamentMetadataResponse, error) {
+func (c *tournamentServiceProtobufClient) SetClubSessionTemplate(ctx context.Context, in *ClubSessionTemplateRequest) (*TournamentResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
 	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
-	ctx = ctxsetters.WithMethodName(ctx, "GetTournamentMetadata")
-	caller := c.callGetTournamentMetadata
+	ctx = ctxsetters.WithMethodName(ctx, "SetClubSessionTemplate")
+	caller := c.callSetClubSessionTemplate
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *GetTournamentMetadataRequest) (*TournamentMetadataResponse, error) {
+		caller = func(ctx context.Context, req *ClubSessionTemplateRequest) (*TournamentResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetTournamentMetadataRequest)
+					typedReq, ok := req.(*ClubSessionTemplateRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetTournamentMetadataRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ClubSessionTemplateRequest) when calling interceptor")
 					}
-					return c.callGetTournamentMetadata(ctx, typedReq)
+					return c.callSetClubSessionTemplate(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentMetadataResponse)
+				typedResp, ok := resp.(*TournamentResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentMetadataResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1407,9 +1653,9 @@ func (c *tournamentServiceJSONClient) GetTournamentMetadata(ctx context.Context,
 	return caller(ctx, in)
 }
 
-func (c *tournamentServiceJSONClient) callGetTournamentMetadata(ctx context.Context, in *GetTournamentMetadataRequest) (*TournamentMetadataResponse, error) {
-	out := new(TournamentMetadataResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+func (c *tournamentServiceProtobufClient) callSetClubSessionTemplate(ctx context.Context, in *ClubSessionTemplateRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[29], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1424,26 +1670,26 @@ func (c *tournamentServiceJSONClient) callGetTournamentMetadata(ctx context.Cont
 	return out, nil
 }
 
-func (c *tournamentServiceJSONClient) GetTournament(ctx context.Context, in *GetTournamentRequest) (*ipc1.FullTournamentDivisions, error) {
+func (c *tournamentServiceProtobufClient) SetClubSessionSchedule(ctx context.Context, in *ClubSessionScheduleRequest) (*TournamentResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
 	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
-	ctx = ctxsetters.WithMethodName(ctx, "GetTournament")
-	caller := c.callGetTournament
+	ctx = ctxsetters.WithMethodName(ctx, "SetClubSessionSchedule")
+	caller := c.callSetClubSessionSchedule
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *GetTournamentRequest) (*ipc1.FullTournamentDivisions, error) {
+		caller = func(ctx context.Context, req *ClubSessionScheduleRequest) (*TournamentResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetTournamentRequest)
+					typedReq, ok := req.(*ClubSessionScheduleRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetTournamentRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ClubSessionScheduleRequest) when calling interceptor")
 					}
-					return c.callGetTournament(ctx, typedReq)
+					return c.callSetClubSessionSchedule(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ipc1.FullTournamentDivisions)
+				typedResp, ok := resp.(*TournamentResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ipc1.FullTournamentDivisions) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1453,9 +1699,9 @@ func (c *tournamentServiceJSONClient) GetTournament(ctx context.Context, in *Get
 	return caller(ctx, in)
 }
 
-func (c *tournamentServiceJSONClient) callGetTournament(ctx context.Context, in *GetTournamentRequest) (*ipc1.FullTournamentDivisions, error) {
-	out := new(ipc1.FullTournamentDivisions)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+func (c *tournamentServiceProtobufClient) callSetClubSessionSchedule(ctx context.Context, in *ClubSessionScheduleRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[30], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1470,26 +1716,26 @@ func (c *tournamentServiceJSONClient) callGetTournament(ctx context.Context, in
 	return out, nil
 }
 
-func (c *tournamentServiceJSONClient) FinishTournament(ctx context.Context, in *FinishTournamentRequest) (*TournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) GetClubRatings(ctx context.Context, in *ClubRatingsRequest) (*ClubRatingsResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
 	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
-	ctx = ctxsetters.WithMethodName(ctx, "FinishTournament")
-	caller := c.callFinishTournament
+	ctx = ctxsetters.WithMethodName(ctx, "GetClubRatings")
+	caller := c.callGetClubRatings
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *FinishTournamentRequest) (*TournamentResponse, error) {
+		caller = func(ctx context.Context, req *ClubRatingsRequest) (*ClubRatingsResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*FinishTournamentRequest)
+					typedReq, ok := req.(*ClubRatingsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*FinishTournamentRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ClubRatingsRequest) when calling interceptor")
 					}
-					return c.callFinishTournament(ctx, typedReq)
+					return c.callGetClubRatings(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentResponse)
+				typedResp, ok := resp.(*ClubRatingsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ClubRatingsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1499,9 +1745,9 @@ func (c *tournamentServiceJSONClient) FinishTournament(ctx context.Context, in *
 	return caller(ctx, in)
 }
 
-func (c *tournamentServiceJSONClient) callFinishTournament(ctx context.Context, in *FinishTournamentRequest) (*TournamentResponse, error) {
-	out := new(TournamentResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+func (c *tournamentServiceProtobufClient) callGetClubRatings(ctx context.Context, in *ClubRatingsRequest) (*ClubRatingsResponse, error) {
+	out := new(ClubRatingsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[31], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1516,26 +1762,26 @@ func (c *tournamentServiceJSONClient) callFinishTournament(ctx context.Context,
 	return out, nil
 }
 
-func (c *tournamentServiceJSONClient) SetTournamentMetadata(ctx context.Context, in *SetTournamentMetadataRequest) (*TournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) GetClubStats(ctx context.Context, in *ClubStatsRequest) (*ClubStatsResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
 	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
-	ctx = ctxsetters.WithMethodName(ctx, "SetTournamentMetadata")
-	caller := c.callSetTournamentMetadata
+	ctx = ctxsetters.WithMethodName(ctx, "GetClubStats")
+	caller := c.callGetClubStats
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *SetTournamentMetadataRequest) (*TournamentResponse, error) {
+		caller = func(ctx context.Context, req *ClubStatsRequest) (*ClubStatsResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*SetTournamentMetadataRequest)
+					typedReq, ok := req.(*ClubStatsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*SetTournamentMetadataRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ClubStatsRequest) when calling interceptor")
 					}
-					return c.callSetTournamentMetadata(ctx, typedReq)
+					return c.callGetClubStats(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentResponse)
+				typedResp, ok := resp.(*ClubStatsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ClubStatsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1545,9 +1791,9 @@ func (c *tournamentServiceJSONClient) SetTournamentMetadata(ctx context.Context,
 	return caller(ctx, in)
 }
 
-func (c *tournamentServiceJSONClient) callSetTournamentMetadata(ctx context.Context, in *SetTournamentMetadataRequest) (*TournamentResponse, error) {
-	out := new(TournamentResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+func (c *tournamentServiceProtobufClient) callGetClubStats(ctx context.Context, in *ClubStatsRequest) (*ClubStatsResponse, error) {
+	out := new(ClubStatsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[32], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1562,20 +1808,20 @@ func (c *tournamentServiceJSONClient) callSetTournamentMetadata(ctx context.Cont
 	return out, nil
 }
 
-func (c *tournamentServiceJSONClient) PairRound(ctx context.Context, in *PairRoundRequest) (*TournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) UnstartTournament(ctx context.Context, in *UnstartTournamentRequest) (*TournamentResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
 	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
-	ctx = ctxsetters.WithMethodName(ctx, "PairRound")
-	caller := c.callPairRound
+	ctx = ctxsetters.WithMethodName(ctx, "UnstartTournament")
+	caller := c.callUnstartTournament
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *PairRoundRequest) (*TournamentResponse, error) {
+		caller = func(ctx context.Context, req *UnstartTournamentRequest) (*TournamentResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*PairRoundRequest)
+					typedReq, ok := req.(*UnstartTournamentRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*PairRoundRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UnstartTournamentRequest) when calling interceptor")
 					}
-					return c.callPairRound(ctx, typedReq)
+					return c.callUnstartTournament(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -1591,9 +1837,9 @@ func (c *tournamentServiceJSONClient) PairRound(ctx context.Context, in *PairRou
 	return caller(ctx, in)
 }
 
-func (c *tournamentServiceJSONClient) callPairRound(ctx context.Context, in *PairRoundRequest) (*TournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) callUnstartTournament(ctx context.Context, in *UnstartTournamentRequest) (*TournamentResponse, error) {
 	out := new(TournamentResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[33], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1608,20 +1854,20 @@ func (c *tournamentServiceJSONClient) callPairRound(ctx context.Context, in *Pai
 	return out, nil
 }
 
-func (c *tournamentServiceJSONClient) SetSingleRoundControls(ctx context.Context, in *SingleRoundControlsRequest) (*TournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) UncheckIn(ctx context.Context, in *UncheckInRequest) (*TournamentResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
 	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
-	ctx = ctxsetters.WithMethodName(ctx, "SetSingleRoundControls")
-	caller := c.callSetSingleRoundControls
+	ctx = ctxsetters.WithMethodName(ctx, "UncheckIn")
+	caller := c.callUncheckIn
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *SingleRoundControlsRequest) (*TournamentResponse, error) {
+		caller = func(ctx context.Context, req *UncheckInRequest) (*TournamentResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*SingleRoundControlsRequest)
+					typedReq, ok := req.(*UncheckInRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*SingleRoundControlsRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UncheckInRequest) when calling interceptor")
 					}
-					return c.callSetSingleRoundControls(ctx, typedReq)
+					return c.callUncheckIn(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -1637,9 +1883,9 @@ func (c *tournamentServiceJSONClient) SetSingleRoundControls(ctx context.Context
 	return caller(ctx, in)
 }
 
-func (c *tournamentServiceJSONClient) callSetSingleRoundControls(ctx context.Context, in *SingleRoundControlsRequest) (*TournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) callUncheckIn(ctx context.Context, in *UncheckInRequest) (*TournamentResponse, error) {
 	out := new(TournamentResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[34], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1654,20 +1900,20 @@ func (c *tournamentServiceJSONClient) callSetSingleRoundControls(ctx context.Con
 	return out, nil
 }
 
-func (c *tournamentServiceJSONClient) SetRoundControls(ctx context.Context, in *ipc1.DivisionRoundControls) (*TournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) CheckIn(ctx context.Context, in *CheckinRequest) (*TournamentResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
 	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
-	ctx = ctxsetters.WithMethodName(ctx, "SetRoundControls")
-	caller := c.callSetRoundControls
+	ctx = ctxsetters.WithMethodName(ctx, "CheckIn")
+	caller := c.callCheckIn
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *ipc1.DivisionRoundControls) (*TournamentResponse, error) {
+		caller = func(ctx context.Context, req *CheckinRequest) (*TournamentResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ipc1.DivisionRoundControls)
+					typedReq, ok := req.(*CheckinRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ipc1.DivisionRoundControls) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*CheckinRequest) when calling interceptor")
 					}
-					return c.callSetRoundControls(ctx, typedReq)
+					return c.callCheckIn(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -1683,9 +1929,9 @@ func (c *tournamentServiceJSONClient) SetRoundControls(ctx context.Context, in *
 	return caller(ctx, in)
 }
 
-func (c *tournamentServiceJSONClient) callSetRoundControls(ctx context.Context, in *ipc1.DivisionRoundControls) (*TournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) callCheckIn(ctx context.Context, in *CheckinRequest) (*TournamentResponse, error) {
 	out := new(TournamentResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[35], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1700,26 +1946,26 @@ func (c *tournamentServiceJSONClient) callSetRoundControls(ctx context.Context,
 	return out, nil
 }
 
-func (c *tournamentServiceJSONClient) SetDivisionControls(ctx context.Context, in *ipc1.DivisionControls) (*TournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) GetBracket(ctx context.Context, in *TournamentDivisionRequest) (*ipc1.BracketResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
 	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
-	ctx = ctxsetters.WithMethodName(ctx, "SetDivisionControls")
-	caller := c.callSetDivisionControls
+	ctx = ctxsetters.WithMethodName(ctx, "GetBracket")
+	caller := c.callGetBracket
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *ipc1.DivisionControls) (*TournamentResponse, error) {
+		caller = func(ctx context.Context, req *TournamentDivisionRequest) (*ipc1.BracketResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ipc1.DivisionControls)
+					typedReq, ok := req.(*TournamentDivisionRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ipc1.DivisionControls) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*TournamentDivisionRequest) when calling interceptor")
 					}
-					return c.callSetDivisionControls(ctx, typedReq)
+					return c.callGetBracket(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentResponse)
+				typedResp, ok := resp.(*ipc1.BracketResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ipc1.BracketResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1729,9 +1975,9 @@ func (c *tournamentServiceJSONClient) SetDivisionControls(ctx context.Context, i
 	return caller(ctx, in)
 }
 
-func (c *tournamentServiceJSONClient) callSetDivisionControls(ctx context.Context, in *ipc1.DivisionControls) (*TournamentResponse, error) {
-	out := new(TournamentResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+func (c *tournamentServiceProtobufClient) callGetBracket(ctx context.Context, in *TournamentDivisionRequest) (*ipc1.BracketResponse, error) {
+	out := new(ipc1.BracketResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[36], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1746,26 +1992,26 @@ func (c *tournamentServiceJSONClient) callSetDivisionControls(ctx context.Contex
 	return out, nil
 }
 
-func (c *tournamentServiceJSONClient) AddDirectors(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) Register(ctx context.Context, in *RegisterRequest) (*RegisterResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
 	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
-	ctx = ctxsetters.WithMethodName(ctx, "AddDirectors")
-	caller := c.callAddDirectors
+	ctx = ctxsetters.WithMethodName(ctx, "Register")
+	caller := c.callRegister
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
+		caller = func(ctx context.Context, req *RegisterRequest) (*RegisterResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ipc1.TournamentPersons)
+					typedReq, ok := req.(*RegisterRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterRequest) when calling interceptor")
 					}
-					return c.callAddDirectors(ctx, typedReq)
+					return c.callRegister(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentResponse)
+				typedResp, ok := resp.(*RegisterResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*RegisterResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1775,9 +2021,9 @@ func (c *tournamentServiceJSONClient) AddDirectors(ctx context.Context, in *ipc1
 	return caller(ctx, in)
 }
 
-func (c *tournamentServiceJSONClient) callAddDirectors(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
-	out := new(TournamentResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+func (c *tournamentServiceProtobufClient) callRegister(ctx context.Context, in *RegisterRequest) (*RegisterResponse, error) {
+	out := new(RegisterResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[37], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1792,20 +2038,20 @@ func (c *tournamentServiceJSONClient) callAddDirectors(ctx context.Context, in *
 	return out, nil
 }
 
-func (c *tournamentServiceJSONClient) RemoveDirectors(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) Unregister(ctx context.Context, in *RegisterRequest) (*TournamentResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
 	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
-	ctx = ctxsetters.WithMethodName(ctx, "RemoveDirectors")
-	caller := c.callRemoveDirectors
+	ctx = ctxsetters.WithMethodName(ctx, "Unregister")
+	caller := c.callUnregister
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
+		caller = func(ctx context.Context, req *RegisterRequest) (*TournamentResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ipc1.TournamentPersons)
+					typedReq, ok := req.(*RegisterRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterRequest) when calling interceptor")
 					}
-					return c.callRemoveDirectors(ctx, typedReq)
+					return c.callUnregister(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -1821,9 +2067,9 @@ func (c *tournamentServiceJSONClient) RemoveDirectors(ctx context.Context, in *i
 	return caller(ctx, in)
 }
 
-func (c *tournamentServiceJSONClient) callRemoveDirectors(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) callUnregister(ctx context.Context, in *RegisterRequest) (*TournamentResponse, error) {
 	out := new(TournamentResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[38], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1838,20 +2084,20 @@ func (c *tournamentServiceJSONClient) callRemoveDirectors(ctx context.Context, i
 	return out, nil
 }
 
-func (c *tournamentServiceJSONClient) AddDivision(ctx context.Context, in *TournamentDivisionRequest) (*TournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) ApproveRegistrations(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
 	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
-	ctx = ctxsetters.WithMethodName(ctx, "AddDivision")
-	caller := c.callAddDivision
+	ctx = ctxsetters.WithMethodName(ctx, "ApproveRegistrations")
+	caller := c.callApproveRegistrations
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *TournamentDivisionRequest) (*TournamentResponse, error) {
+		caller = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*TournamentDivisionRequest)
+					typedReq, ok := req.(*ipc1.TournamentPersons)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*TournamentDivisionRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
 					}
-					return c.callAddDivision(ctx, typedReq)
+					return c.callApproveRegistrations(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -1867,9 +2113,9 @@ func (c *tournamentServiceJSONClient) AddDivision(ctx context.Context, in *Tourn
 	return caller(ctx, in)
 }
 
-func (c *tournamentServiceJSONClient) callAddDivision(ctx context.Context, in *TournamentDivisionRequest) (*TournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) callApproveRegistrations(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
 	out := new(TournamentResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[39], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1884,20 +2130,20 @@ func (c *tournamentServiceJSONClient) callAddDivision(ctx context.Context, in *T
 	return out, nil
 }
 
-func (c *tournamentServiceJSONClient) RemoveDivision(ctx context.Context, in *TournamentDivisionRequest) (*TournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) RejectRegistrations(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
 	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
-	ctx = ctxsetters.WithMethodName(ctx, "RemoveDivision")
-	caller := c.callRemoveDivision
+	ctx = ctxsetters.WithMethodName(ctx, "RejectRegistrations")
+	caller := c.callRejectRegistrations
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *TournamentDivisionRequest) (*TournamentResponse, error) {
+		caller = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*TournamentDivisionRequest)
+					typedReq, ok := req.(*ipc1.TournamentPersons)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*TournamentDivisionRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
 					}
-					return c.callRemoveDivision(ctx, typedReq)
+					return c.callRejectRegistrations(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -1913,9 +2159,9 @@ func (c *tournamentServiceJSONClient) RemoveDivision(ctx context.Context, in *To
 	return caller(ctx, in)
 }
 
-func (c *tournamentServiceJSONClient) callRemoveDivision(ctx context.Context, in *TournamentDivisionRequest) (*TournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) callRejectRegistrations(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
 	out := new(TournamentResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[40], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1930,26 +2176,26 @@ func (c *tournamentServiceJSONClient) callRemoveDivision(ctx context.Context, in
 	return out, nil
 }
 
-func (c *tournamentServiceJSONClient) AddPlayers(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) GetRegistrations(ctx context.Context, in *TournamentDivisionRequest) (*DivisionRegistrationsResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
 	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
-	ctx = ctxsetters.WithMethodName(ctx, "AddPlayers")
-	caller := c.callAddPlayers
+	ctx = ctxsetters.WithMethodName(ctx, "GetRegistrations")
+	caller := c.callGetRegistrations
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
+		caller = func(ctx context.Context, req *TournamentDivisionRequest) (*DivisionRegistrationsResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ipc1.TournamentPersons)
+					typedReq, ok := req.(*TournamentDivisionRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*TournamentDivisionRequest) when calling interceptor")
 					}
-					return c.callAddPlayers(ctx, typedReq)
+					return c.callGetRegistrations(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentResponse)
+				typedResp, ok := resp.(*DivisionRegistrationsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*DivisionRegistrationsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1959,9 +2205,9 @@ func (c *tournamentServiceJSONClient) AddPlayers(ctx context.Context, in *ipc1.T
 	return caller(ctx, in)
 }
 
-func (c *tournamentServiceJSONClient) callAddPlayers(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
-	out := new(TournamentResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+func (c *tournamentServiceProtobufClient) callGetRegistrations(ctx context.Context, in *TournamentDivisionRequest) (*DivisionRegistrationsResponse, error) {
+	out := new(DivisionRegistrationsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[41], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1976,26 +2222,26 @@ func (c *tournamentServiceJSONClient) callAddPlayers(ctx context.Context, in *ip
 	return out, nil
 }
 
-func (c *tournamentServiceJSONClient) RemovePlayers(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) GetAuditLog(ctx context.Context, in *AuditLogRequest) (*AuditLogResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
 	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
-	ctx = ctxsetters.WithMethodName(ctx, "RemovePlayers")
-	caller := c.callRemovePlayers
+	ctx = ctxsetters.WithMethodName(ctx, "GetAuditLog")
+	caller := c.callGetAuditLog
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
+		caller = func(ctx context.Context, req *AuditLogRequest) (*AuditLogResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ipc1.TournamentPersons)
+					typedReq, ok := req.(*AuditLogRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*AuditLogRequest) when calling interceptor")
 					}
-					return c.callRemovePlayers(ctx, typedReq)
+					return c.callGetAuditLog(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentResponse)
+				typedResp, ok := resp.(*AuditLogResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*AuditLogResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2005,9 +2251,9 @@ func (c *tournamentServiceJSONClient) RemovePlayers(ctx context.Context, in *ipc
 	return caller(ctx, in)
 }
 
-func (c *tournamentServiceJSONClient) callRemovePlayers(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
-	out := new(TournamentResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+func (c *tournamentServiceProtobufClient) callGetAuditLog(ctx context.Context, in *AuditLogRequest) (*AuditLogResponse, error) {
+	out := new(AuditLogResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[42], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -2022,26 +2268,26 @@ func (c *tournamentServiceJSONClient) callRemovePlayers(ctx context.Context, in
 	return out, nil
 }
 
-func (c *tournamentServiceJSONClient) SetPairing(ctx context.Context, in *TournamentPairingsRequest) (*TournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) GetPairingReport(ctx context.Context, in *TournamentDivisionRequest) (*ipc1.PairingReport, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
 	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
-	ctx = ctxsetters.WithMethodName(ctx, "SetPairing")
-	caller := c.callSetPairing
+	ctx = ctxsetters.WithMethodName(ctx, "GetPairingReport")
+	caller := c.callGetPairingReport
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *TournamentPairingsRequest) (*TournamentResponse, error) {
+		caller = func(ctx context.Context, req *TournamentDivisionRequest) (*ipc1.PairingReport, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*TournamentPairingsRequest)
+					typedReq, ok := req.(*TournamentDivisionRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*TournamentPairingsRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*TournamentDivisionRequest) when calling interceptor")
 					}
-					return c.callSetPairing(ctx, typedReq)
+					return c.callGetPairingReport(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentResponse)
+				typedResp, ok := resp.(*ipc1.PairingReport)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ipc1.PairingReport) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2051,9 +2297,9 @@ func (c *tournamentServiceJSONClient) SetPairing(ctx context.Context, in *Tourna
 	return caller(ctx, in)
 }
 
-func (c *tournamentServiceJSONClient) callSetPairing(ctx context.Context, in *TournamentPairingsRequest) (*TournamentResponse, error) {
-	out := new(TournamentResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
+func (c *tournamentServiceProtobufClient) callGetPairingReport(ctx context.Context, in *TournamentDivisionRequest) (*ipc1.PairingReport, error) {
+	out := new(ipc1.PairingReport)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[43], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -2068,26 +2314,26 @@ func (c *tournamentServiceJSONClient) callSetPairing(ctx context.Context, in *To
 	return out, nil
 }
 
-func (c *tournamentServiceJSONClient) SetResult(ctx context.Context, in *TournamentResultOverrideRequest) (*TournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) GetRatingPeriodPreview(ctx context.Context, in *TournamentDivisionRequest) (*RatingPeriodResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
 	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
-	ctx = ctxsetters.WithMethodName(ctx, "SetResult")
-	caller := c.callSetResult
+	ctx = ctxsetters.WithMethodName(ctx, "GetRatingPeriodPreview")
+	caller := c.callGetRatingPeriodPreview
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *TournamentResultOverrideRequest) (*TournamentResponse, error) {
+		caller = func(ctx context.Context, req *TournamentDivisionRequest) (*RatingPeriodResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*TournamentResultOverrideRequest)
+					typedReq, ok := req.(*TournamentDivisionRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*TournamentResultOverrideRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*TournamentDivisionRequest) when calling interceptor")
 					}
-					return c.callSetResult(ctx, typedReq)
+					return c.callGetRatingPeriodPreview(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentResponse)
+				typedResp, ok := resp.(*RatingPeriodResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*RatingPeriodResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2097,9 +2343,9 @@ func (c *tournamentServiceJSONClient) SetResult(ctx context.Context, in *Tournam
 	return caller(ctx, in)
 }
 
-func (c *tournamentServiceJSONClient) callSetResult(ctx context.Context, in *TournamentResultOverrideRequest) (*TournamentResponse, error) {
-	out := new(TournamentResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[16], in, out)
+func (c *tournamentServiceProtobufClient) callGetRatingPeriodPreview(ctx context.Context, in *TournamentDivisionRequest) (*RatingPeriodResponse, error) {
+	out := new(RatingPeriodResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[44], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -2114,26 +2360,26 @@ func (c *tournamentServiceJSONClient) callSetResult(ctx context.Context, in *Tou
 	return out, nil
 }
 
-func (c *tournamentServiceJSONClient) StartRoundCountdown(ctx context.Context, in *TournamentStartRoundCountdownRequest) (*TournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) CloneTournament(ctx context.Context, in *CloneTournamentRequest) (*NewTournamentResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
 	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
-	ctx = ctxsetters.WithMethodName(ctx, "StartRoundCountdown")
-	caller := c.callStartRoundCountdown
+	ctx = ctxsetters.WithMethodName(ctx, "CloneTournament")
+	caller := c.callCloneTournament
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *TournamentStartRoundCountdownRequest) (*TournamentResponse, error) {
+		caller = func(ctx context.Context, req *CloneTournamentRequest) (*NewTournamentResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*TournamentStartRoundCountdownRequest)
+					typedReq, ok := req.(*CloneTournamentRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*TournamentStartRoundCountdownRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*CloneTournamentRequest) when calling interceptor")
 					}
-					return c.callStartRoundCountdown(ctx, typedReq)
+					return c.callCloneTournament(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentResponse)
+				typedResp, ok := resp.(*NewTournamentResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*NewTournamentResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2143,9 +2389,9 @@ func (c *tournamentServiceJSONClient) StartRoundCountdown(ctx context.Context, i
 	return caller(ctx, in)
 }
 
-func (c *tournamentServiceJSONClient) callStartRoundCountdown(ctx context.Context, in *TournamentStartRoundCountdownRequest) (*TournamentResponse, error) {
-	out := new(TournamentResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[17], in, out)
+func (c *tournamentServiceProtobufClient) callCloneTournament(ctx context.Context, in *CloneTournamentRequest) (*NewTournamentResponse, error) {
+	out := new(NewTournamentResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[45], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -2160,26 +2406,26 @@ func (c *tournamentServiceJSONClient) callStartRoundCountdown(ctx context.Contex
 	return out, nil
 }
 
-func (c *tournamentServiceJSONClient) RecentGames(ctx context.Context, in *RecentGamesRequest) (*RecentGamesResponse, error) {
+func (c *tournamentServiceProtobufClient) CreateSnapshot(ctx context.Context, in *SnapshotRequest) (*TournamentSnapshot, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
 	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
-	ctx = ctxsetters.WithMethodName(ctx, "RecentGames")
-	caller := c.callRecentGames
+	ctx = ctxsetters.WithMethodName(ctx, "CreateSnapshot")
+	caller := c.callCreateSnapshot
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *RecentGamesRequest) (*RecentGamesResponse, error) {
+		caller = func(ctx context.Context, req *SnapshotRequest) (*TournamentSnapshot, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*RecentGamesRequest)
+					typedReq, ok := req.(*SnapshotRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*RecentGamesRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*SnapshotRequest) when calling interceptor")
 					}
-					return c.callRecentGames(ctx, typedReq)
+					return c.callCreateSnapshot(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*RecentGamesResponse)
+				typedResp, ok := resp.(*TournamentSnapshot)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*RecentGamesResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentSnapshot) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2189,9 +2435,9 @@ func (c *tournamentServiceJSONClient) RecentGames(ctx context.Context, in *Recen
 	return caller(ctx, in)
 }
 
-func (c *tournamentServiceJSONClient) callRecentGames(ctx context.Context, in *RecentGamesRequest) (*RecentGamesResponse, error) {
-	out := new(RecentGamesResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[18], in, out)
+func (c *tournamentServiceProtobufClient) callCreateSnapshot(ctx context.Context, in *SnapshotRequest) (*TournamentSnapshot, error) {
+	out := new(TournamentSnapshot)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[46], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -2206,26 +2452,26 @@ func (c *tournamentServiceJSONClient) callRecentGames(ctx context.Context, in *R
 	return out, nil
 }
 
-func (c *tournamentServiceJSONClient) CreateClubSession(ctx context.Context, in *NewClubSessionRequest) (*ClubSessionResponse, error) {
+func (c *tournamentServiceProtobufClient) GetSnapshots(ctx context.Context, in *GetTournamentRequest) (*SnapshotsResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
 	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
-	ctx = ctxsetters.WithMethodName(ctx, "CreateClubSession")
-	caller := c.callCreateClubSession
+	ctx = ctxsetters.WithMethodName(ctx, "GetSnapshots")
+	caller := c.callGetSnapshots
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *NewClubSessionRequest) (*ClubSessionResponse, error) {
+		caller = func(ctx context.Context, req *GetTournamentRequest) (*SnapshotsResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*NewClubSessionRequest)
+					typedReq, ok := req.(*GetTournamentRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*NewClubSessionRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetTournamentRequest) when calling interceptor")
 					}
-					return c.callCreateClubSession(ctx, typedReq)
+					return c.callGetSnapshots(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ClubSessionResponse)
+				typedResp, ok := resp.(*SnapshotsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ClubSessionResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SnapshotsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2235,9 +2481,9 @@ func (c *tournamentServiceJSONClient) CreateClubSession(ctx context.Context, in
 	return caller(ctx, in)
 }
 
-func (c *tournamentServiceJSONClient) callCreateClubSession(ctx context.Context, in *NewClubSessionRequest) (*ClubSessionResponse, error) {
-	out := new(ClubSessionResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[19], in, out)
+func (c *tournamentServiceProtobufClient) callGetSnapshots(ctx context.Context, in *GetTournamentRequest) (*SnapshotsResponse, error) {
+	out := new(SnapshotsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[47], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -2252,26 +2498,26 @@ func (c *tournamentServiceJSONClient) callCreateClubSession(ctx context.Context,
 	return out, nil
 }
 
-func (c *tournamentServiceJSONClient) GetRecentClubSessions(ctx context.Context, in *RecentClubSessionsRequest) (*ClubSessionsResponse, error) {
+func (c *tournamentServiceProtobufClient) RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest) (*TournamentResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
 	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
-	ctx = ctxsetters.WithMethodName(ctx, "GetRecentClubSessions")
-	caller := c.callGetRecentClubSessions
+	ctx = ctxsetters.WithMethodName(ctx, "RestoreSnapshot")
+	caller := c.callRestoreSnapshot
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *RecentClubSessionsRequest) (*ClubSessionsResponse, error) {
+		caller = func(ctx context.Context, req *RestoreSnapshotRequest) (*TournamentResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*RecentClubSessionsRequest)
+					typedReq, ok := req.(*RestoreSnapshotRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*RecentClubSessionsRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RestoreSnapshotRequest) when calling interceptor")
 					}
-					return c.callGetRecentClubSessions(ctx, typedReq)
+					return c.callRestoreSnapshot(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ClubSessionsResponse)
+				typedResp, ok := resp.(*TournamentResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ClubSessionsResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2281,9 +2527,9 @@ func (c *tournamentServiceJSONClient) GetRecentClubSessions(ctx context.Context,
 	return caller(ctx, in)
 }
 
-func (c *tournamentServiceJSONClient) callGetRecentClubSessions(ctx context.Context, in *RecentClubSessionsRequest) (*ClubSessionsResponse, error) {
-	out := new(ClubSessionsResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[20], in, out)
+func (c *tournamentServiceProtobufClient) callRestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[48], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -2298,26 +2544,26 @@ func (c *tournamentServiceJSONClient) callGetRecentClubSessions(ctx context.Cont
 	return out, nil
 }
 
-func (c *tournamentServiceJSONClient) UnstartTournament(ctx context.Context, in *UnstartTournamentRequest) (*TournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) GetLadder(ctx context.Context, in *GetLadderRequest) (*ipc1.Ladder, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
 	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
-	ctx = ctxsetters.WithMethodName(ctx, "UnstartTournament")
-	caller := c.callUnstartTournament
+	ctx = ctxsetters.WithMethodName(ctx, "GetLadder")
+	caller := c.callGetLadder
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *UnstartTournamentRequest) (*TournamentResponse, error) {
+		caller = func(ctx context.Context, req *GetLadderRequest) (*ipc1.Ladder, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*UnstartTournamentRequest)
+					typedReq, ok := req.(*GetLadderRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*UnstartTournamentRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetLadderRequest) when calling interceptor")
 					}
-					return c.callUnstartTournament(ctx, typedReq)
+					return c.callGetLadder(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentResponse)
+				typedResp, ok := resp.(*ipc1.Ladder)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ipc1.Ladder) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2327,9 +2573,9 @@ func (c *tournamentServiceJSONClient) UnstartTournament(ctx context.Context, in
 	return caller(ctx, in)
 }
 
-func (c *tournamentServiceJSONClient) callUnstartTournament(ctx context.Context, in *UnstartTournamentRequest) (*TournamentResponse, error) {
-	out := new(TournamentResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[21], in, out)
+func (c *tournamentServiceProtobufClient) callGetLadder(ctx context.Context, in *GetLadderRequest) (*ipc1.Ladder, error) {
+	out := new(ipc1.Ladder)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[49], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -2344,20 +2590,20 @@ func (c *tournamentServiceJSONClient) callUnstartTournament(ctx context.Context,
 	return out, nil
 }
 
-func (c *tournamentServiceJSONClient) UncheckIn(ctx context.Context, in *UncheckInRequest) (*TournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) SetLadderControls(ctx context.Context, in *LadderControlsRequest) (*TournamentResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
 	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
-	ctx = ctxsetters.WithMethodName(ctx, "UncheckIn")
-	caller := c.callUncheckIn
+	ctx = ctxsetters.WithMethodName(ctx, "SetLadderControls")
+	caller := c.callSetLadderControls
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *UncheckInRequest) (*TournamentResponse, error) {
+		caller = func(ctx context.Context, req *LadderControlsRequest) (*TournamentResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*UncheckInRequest)
+					typedReq, ok := req.(*LadderControlsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*UncheckInRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*LadderControlsRequest) when calling interceptor")
 					}
-					return c.callUncheckIn(ctx, typedReq)
+					return c.callSetLadderControls(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -2373,9 +2619,9 @@ func (c *tournamentServiceJSONClient) UncheckIn(ctx context.Context, in *Uncheck
 	return caller(ctx, in)
 }
 
-func (c *tournamentServiceJSONClient) callUncheckIn(ctx context.Context, in *UncheckInRequest) (*TournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) callSetLadderControls(ctx context.Context, in *LadderControlsRequest) (*TournamentResponse, error) {
 	out := new(TournamentResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[22], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[50], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -2390,20 +2636,20 @@ func (c *tournamentServiceJSONClient) callUncheckIn(ctx context.Context, in *Unc
 	return out, nil
 }
 
-func (c *tournamentServiceJSONClient) CheckIn(ctx context.Context, in *CheckinRequest) (*TournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) AddLadderPlayers(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
 	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
-	ctx = ctxsetters.WithMethodName(ctx, "CheckIn")
-	caller := c.callCheckIn
+	ctx = ctxsetters.WithMethodName(ctx, "AddLadderPlayers")
+	caller := c.callAddLadderPlayers
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *CheckinRequest) (*TournamentResponse, error) {
+		caller = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CheckinRequest)
+					typedReq, ok := req.(*ipc1.TournamentPersons)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CheckinRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
 					}
-					return c.callCheckIn(ctx, typedReq)
+					return c.callAddLadderPlayers(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -2419,9 +2665,9 @@ func (c *tournamentServiceJSONClient) CheckIn(ctx context.Context, in *CheckinRe
 	return caller(ctx, in)
 }
 
-func (c *tournamentServiceJSONClient) callCheckIn(ctx context.Context, in *CheckinRequest) (*TournamentResponse, error) {
+func (c *tournamentServiceProtobufClient) callAddLadderPlayers(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
 	out := new(TournamentResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[23], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[51], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -2436,183 +2682,8348 @@ func (c *tournamentServiceJSONClient) callCheckIn(ctx context.Context, in *Check
 	return out, nil
 }
 
-// ================================
-// TournamentService Server Handler
-// ================================
-
-type tournamentServiceServer struct {
-	TournamentService
-	interceptor      twirp.Interceptor
-	hooks            *twirp.ServerHooks
-	pathPrefix       string // prefix for routing
-	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
-	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+func (c *tournamentServiceProtobufClient) RemoveLadderPlayers(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveLadderPlayers")
+	caller := c.callRemoveLadderPlayers
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ipc1.TournamentPersons)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
+					}
+					return c.callRemoveLadderPlayers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
 }
 
-// NewTournamentServiceServer builds a TwirpServer that can be used as an http.Handler to handle
-// HTTP requests that are routed to the right method in the provided svc implementation.
-// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
-func NewTournamentServiceServer(svc TournamentService, opts ...interface{}) TwirpServer {
-	serverOpts := newServerOpts(opts)
-
-	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
-	jsonSkipDefaults := false
-	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
-	jsonCamelCase := false
-	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
-	var pathPrefix string
-	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+func (c *tournamentServiceProtobufClient) callRemoveLadderPlayers(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[52], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	return &tournamentServiceServer{
-		TournamentService: svc,
-		hooks:             serverOpts.Hooks,
-		interceptor:       twirp.ChainInterceptors(serverOpts.Interceptors...),
-		pathPrefix:        pathPrefix,
-		jsonSkipDefaults:  jsonSkipDefaults,
-		jsonCamelCase:     jsonCamelCase,
-	}
-}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
-// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
-func (s *tournamentServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
-	writeError(ctx, resp, err, s.hooks)
+	return out, nil
 }
 
-// handleRequestBodyError is used to handle error when the twirp server cannot read request
-func (s *tournamentServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
-	if context.Canceled == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
-		return
+func (c *tournamentServiceProtobufClient) ChallengeLadderPlayer(ctx context.Context, in *LadderChallengeRequest) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "ChallengeLadderPlayer")
+	caller := c.callChallengeLadderPlayer
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *LadderChallengeRequest) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LadderChallengeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LadderChallengeRequest) when calling interceptor")
+					}
+					return c.callChallengeLadderPlayer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
-	if context.DeadlineExceeded == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
-		return
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceProtobufClient) callChallengeLadderPlayer(ctx context.Context, in *LadderChallengeRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[53], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
-	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
 }
 
-// TournamentServicePathPrefix is a convenience constant that may identify URL paths.
-// Should be used with caution, it only matches routes generated by Twirp Go clients,
-// with the default "/twirp" prefix and default CamelCase service and method names.
-// More info: https://twitchtv.github.io/twirp/docs/routing.html
-const TournamentServicePathPrefix = "/twirp/tournament_service.TournamentService/"
+// =============================
+// TournamentService JSON Client
+// =============================
 
-func (s *tournamentServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
-	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
-	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
-	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+type tournamentServiceJSONClient struct {
+	client      HTTPClient
+	urls        [54]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
 
-	var err error
-	ctx, err = callRequestReceived(ctx, s.hooks)
-	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
+// NewTournamentServiceJSONClient creates a JSON client that implements the TournamentService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewTournamentServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) TournamentService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
 	}
 
-	if req.Method != "POST" {
-		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
 	}
 
-	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
-	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
-	if pkgService != "tournament_service.TournamentService" {
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "tournament_service", "TournamentService")
+	urls := [54]string{
+		serviceURL + "NewTournament",
+		serviceURL + "GetTournamentMetadata",
+		serviceURL + "GetTournament",
+		serviceURL + "FinishTournament",
+		serviceURL + "SetTournamentMetadata",
+		serviceURL + "PairRound",
+		serviceURL + "PreviewPairRound",
+		serviceURL + "SetSingleRoundControls",
+		serviceURL + "SetRoundControls",
+		serviceURL + "SetDivisionControls",
+		serviceURL + "AddDirectors",
+		serviceURL + "RemoveDirectors",
+		serviceURL + "AddDivision",
+		serviceURL + "RemoveDivision",
+		serviceURL + "AddPlayers",
+		serviceURL + "RemovePlayers",
+		serviceURL + "WithdrawPlayers",
+		serviceURL + "ReadmitPlayers",
+		serviceURL + "SetPairing",
+		serviceURL + "SetResult",
+		serviceURL + "StartRoundCountdown",
+		serviceURL + "RecentGames",
+		serviceURL + "CreateClubSession",
+		serviceURL + "GetRecentClubSessions",
+		serviceURL + "PromoteAndRelegate",
+		serviceURL + "GetClub",
+		serviceURL + "AddClubMembers",
+		serviceURL + "RemoveClubMembers",
+		serviceURL + "SetClubControls",
+		serviceURL + "SetClubSessionTemplate",
+		serviceURL + "SetClubSessionSchedule",
+		serviceURL + "GetClubRatings",
+		serviceURL + "GetClubStats",
+		serviceURL + "UnstartTournament",
+		serviceURL + "UncheckIn",
+		serviceURL + "CheckIn",
+		serviceURL + "GetBracket",
+		serviceURL + "Register",
+		serviceURL + "Unregister",
+		serviceURL + "ApproveRegistrations",
+		serviceURL + "RejectRegistrations",
+		serviceURL + "GetRegistrations",
+		serviceURL + "GetAuditLog",
+		serviceURL + "GetPairingReport",
+		serviceURL + "GetRatingPeriodPreview",
+		serviceURL + "CloneTournament",
+		serviceURL + "CreateSnapshot",
+		serviceURL + "GetSnapshots",
+		serviceURL + "RestoreSnapshot",
+		serviceURL + "GetLadder",
+		serviceURL + "SetLadderControls",
+		serviceURL + "AddLadderPlayers",
+		serviceURL + "RemoveLadderPlayers",
+		serviceURL + "ChallengeLadderPlayer",
+	}
+
+	return &tournamentServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *tournamentServiceJSONClient) NewTournament(ctx context.Context, in *NewTournamentRequest) (*NewTournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "NewTournament")
+	caller := c.callNewTournament
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *NewTournamentRequest) (*NewTournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*NewTournamentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*NewTournamentRequest) when calling interceptor")
+					}
+					return c.callNewTournament(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*NewTournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*NewTournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callNewTournament(ctx context.Context, in *NewTournamentRequest) (*NewTournamentResponse, error) {
+	out := new(NewTournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) GetTournamentMetadata(ctx context.Context, in *GetTournamentMetadataRequest) (*TournamentMetadataResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetTournamentMetadata")
+	caller := c.callGetTournamentMetadata
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetTournamentMetadataRequest) (*TournamentMetadataResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetTournamentMetadataRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetTournamentMetadataRequest) when calling interceptor")
+					}
+					return c.callGetTournamentMetadata(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentMetadataResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentMetadataResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callGetTournamentMetadata(ctx context.Context, in *GetTournamentMetadataRequest) (*TournamentMetadataResponse, error) {
+	out := new(TournamentMetadataResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) GetTournament(ctx context.Context, in *GetTournamentRequest) (*ipc1.FullTournamentDivisions, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetTournament")
+	caller := c.callGetTournament
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetTournamentRequest) (*ipc1.FullTournamentDivisions, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetTournamentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetTournamentRequest) when calling interceptor")
+					}
+					return c.callGetTournament(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ipc1.FullTournamentDivisions)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ipc1.FullTournamentDivisions) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callGetTournament(ctx context.Context, in *GetTournamentRequest) (*ipc1.FullTournamentDivisions, error) {
+	out := new(ipc1.FullTournamentDivisions)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) FinishTournament(ctx context.Context, in *FinishTournamentRequest) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "FinishTournament")
+	caller := c.callFinishTournament
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *FinishTournamentRequest) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FinishTournamentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FinishTournamentRequest) when calling interceptor")
+					}
+					return c.callFinishTournament(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callFinishTournament(ctx context.Context, in *FinishTournamentRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) SetTournamentMetadata(ctx context.Context, in *SetTournamentMetadataRequest) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "SetTournamentMetadata")
+	caller := c.callSetTournamentMetadata
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetTournamentMetadataRequest) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetTournamentMetadataRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetTournamentMetadataRequest) when calling interceptor")
+					}
+					return c.callSetTournamentMetadata(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callSetTournamentMetadata(ctx context.Context, in *SetTournamentMetadataRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) PairRound(ctx context.Context, in *PairRoundRequest) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "PairRound")
+	caller := c.callPairRound
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *PairRoundRequest) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PairRoundRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PairRoundRequest) when calling interceptor")
+					}
+					return c.callPairRound(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callPairRound(ctx context.Context, in *PairRoundRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) PreviewPairRound(ctx context.Context, in *PairingPreviewRequest) (*ipc1.PairingPreviewResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "PreviewPairRound")
+	caller := c.callPreviewPairRound
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *PairingPreviewRequest) (*ipc1.PairingPreviewResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PairingPreviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PairingPreviewRequest) when calling interceptor")
+					}
+					return c.callPreviewPairRound(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ipc1.PairingPreviewResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ipc1.PairingPreviewResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callPreviewPairRound(ctx context.Context, in *PairingPreviewRequest) (*ipc1.PairingPreviewResponse, error) {
+	out := new(ipc1.PairingPreviewResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) SetSingleRoundControls(ctx context.Context, in *SingleRoundControlsRequest) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "SetSingleRoundControls")
+	caller := c.callSetSingleRoundControls
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SingleRoundControlsRequest) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SingleRoundControlsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SingleRoundControlsRequest) when calling interceptor")
+					}
+					return c.callSetSingleRoundControls(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callSetSingleRoundControls(ctx context.Context, in *SingleRoundControlsRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) SetRoundControls(ctx context.Context, in *ipc1.DivisionRoundControls) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "SetRoundControls")
+	caller := c.callSetRoundControls
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ipc1.DivisionRoundControls) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ipc1.DivisionRoundControls)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ipc1.DivisionRoundControls) when calling interceptor")
+					}
+					return c.callSetRoundControls(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callSetRoundControls(ctx context.Context, in *ipc1.DivisionRoundControls) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) SetDivisionControls(ctx context.Context, in *ipc1.DivisionControls) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "SetDivisionControls")
+	caller := c.callSetDivisionControls
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ipc1.DivisionControls) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ipc1.DivisionControls)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ipc1.DivisionControls) when calling interceptor")
+					}
+					return c.callSetDivisionControls(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callSetDivisionControls(ctx context.Context, in *ipc1.DivisionControls) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) AddDirectors(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "AddDirectors")
+	caller := c.callAddDirectors
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ipc1.TournamentPersons)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
+					}
+					return c.callAddDirectors(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callAddDirectors(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) RemoveDirectors(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveDirectors")
+	caller := c.callRemoveDirectors
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ipc1.TournamentPersons)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
+					}
+					return c.callRemoveDirectors(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callRemoveDirectors(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) AddDivision(ctx context.Context, in *TournamentDivisionRequest) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "AddDivision")
+	caller := c.callAddDivision
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *TournamentDivisionRequest) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TournamentDivisionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TournamentDivisionRequest) when calling interceptor")
+					}
+					return c.callAddDivision(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callAddDivision(ctx context.Context, in *TournamentDivisionRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) RemoveDivision(ctx context.Context, in *TournamentDivisionRequest) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveDivision")
+	caller := c.callRemoveDivision
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *TournamentDivisionRequest) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TournamentDivisionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TournamentDivisionRequest) when calling interceptor")
+					}
+					return c.callRemoveDivision(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callRemoveDivision(ctx context.Context, in *TournamentDivisionRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) AddPlayers(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "AddPlayers")
+	caller := c.callAddPlayers
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ipc1.TournamentPersons)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
+					}
+					return c.callAddPlayers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callAddPlayers(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) RemovePlayers(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "RemovePlayers")
+	caller := c.callRemovePlayers
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ipc1.TournamentPersons)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
+					}
+					return c.callRemovePlayers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callRemovePlayers(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) WithdrawPlayers(ctx context.Context, in *WithdrawPlayersRequest) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "WithdrawPlayers")
+	caller := c.callWithdrawPlayers
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *WithdrawPlayersRequest) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WithdrawPlayersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WithdrawPlayersRequest) when calling interceptor")
+					}
+					return c.callWithdrawPlayers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callWithdrawPlayers(ctx context.Context, in *WithdrawPlayersRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[16], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) ReadmitPlayers(ctx context.Context, in *ReadmitPlayersRequest) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "ReadmitPlayers")
+	caller := c.callReadmitPlayers
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ReadmitPlayersRequest) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReadmitPlayersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReadmitPlayersRequest) when calling interceptor")
+					}
+					return c.callReadmitPlayers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callReadmitPlayers(ctx context.Context, in *ReadmitPlayersRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[17], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) SetPairing(ctx context.Context, in *TournamentPairingsRequest) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "SetPairing")
+	caller := c.callSetPairing
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *TournamentPairingsRequest) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TournamentPairingsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TournamentPairingsRequest) when calling interceptor")
+					}
+					return c.callSetPairing(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callSetPairing(ctx context.Context, in *TournamentPairingsRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[18], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) SetResult(ctx context.Context, in *TournamentResultOverrideRequest) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "SetResult")
+	caller := c.callSetResult
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *TournamentResultOverrideRequest) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TournamentResultOverrideRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TournamentResultOverrideRequest) when calling interceptor")
+					}
+					return c.callSetResult(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callSetResult(ctx context.Context, in *TournamentResultOverrideRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[19], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) StartRoundCountdown(ctx context.Context, in *TournamentStartRoundCountdownRequest) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "StartRoundCountdown")
+	caller := c.callStartRoundCountdown
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *TournamentStartRoundCountdownRequest) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TournamentStartRoundCountdownRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TournamentStartRoundCountdownRequest) when calling interceptor")
+					}
+					return c.callStartRoundCountdown(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callStartRoundCountdown(ctx context.Context, in *TournamentStartRoundCountdownRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[20], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) RecentGames(ctx context.Context, in *RecentGamesRequest) (*RecentGamesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "RecentGames")
+	caller := c.callRecentGames
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RecentGamesRequest) (*RecentGamesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RecentGamesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RecentGamesRequest) when calling interceptor")
+					}
+					return c.callRecentGames(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RecentGamesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RecentGamesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callRecentGames(ctx context.Context, in *RecentGamesRequest) (*RecentGamesResponse, error) {
+	out := new(RecentGamesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[21], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) CreateClubSession(ctx context.Context, in *NewClubSessionRequest) (*ClubSessionResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateClubSession")
+	caller := c.callCreateClubSession
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *NewClubSessionRequest) (*ClubSessionResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*NewClubSessionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*NewClubSessionRequest) when calling interceptor")
+					}
+					return c.callCreateClubSession(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ClubSessionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ClubSessionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callCreateClubSession(ctx context.Context, in *NewClubSessionRequest) (*ClubSessionResponse, error) {
+	out := new(ClubSessionResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[22], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) GetRecentClubSessions(ctx context.Context, in *RecentClubSessionsRequest) (*ClubSessionsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetRecentClubSessions")
+	caller := c.callGetRecentClubSessions
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RecentClubSessionsRequest) (*ClubSessionsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RecentClubSessionsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RecentClubSessionsRequest) when calling interceptor")
+					}
+					return c.callGetRecentClubSessions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ClubSessionsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ClubSessionsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callGetRecentClubSessions(ctx context.Context, in *RecentClubSessionsRequest) (*ClubSessionsResponse, error) {
+	out := new(ClubSessionsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[23], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) PromoteAndRelegate(ctx context.Context, in *PromotionRequest) (*PromotionResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "PromoteAndRelegate")
+	caller := c.callPromoteAndRelegate
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *PromotionRequest) (*PromotionResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PromotionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PromotionRequest) when calling interceptor")
+					}
+					return c.callPromoteAndRelegate(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PromotionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PromotionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callPromoteAndRelegate(ctx context.Context, in *PromotionRequest) (*PromotionResponse, error) {
+	out := new(PromotionResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[24], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) GetClub(ctx context.Context, in *GetClubRequest) (*ipc1.Club, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetClub")
+	caller := c.callGetClub
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetClubRequest) (*ipc1.Club, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetClubRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetClubRequest) when calling interceptor")
+					}
+					return c.callGetClub(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ipc1.Club)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ipc1.Club) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callGetClub(ctx context.Context, in *GetClubRequest) (*ipc1.Club, error) {
+	out := new(ipc1.Club)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[25], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) AddClubMembers(ctx context.Context, in *ClubMembersRequest) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "AddClubMembers")
+	caller := c.callAddClubMembers
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ClubMembersRequest) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ClubMembersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ClubMembersRequest) when calling interceptor")
+					}
+					return c.callAddClubMembers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callAddClubMembers(ctx context.Context, in *ClubMembersRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[26], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) RemoveClubMembers(ctx context.Context, in *ClubMembersRequest) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveClubMembers")
+	caller := c.callRemoveClubMembers
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ClubMembersRequest) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ClubMembersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ClubMembersRequest) when calling interceptor")
+					}
+					return c.callRemoveClubMembers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callRemoveClubMembers(ctx context.Context, in *ClubMembersRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[27], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) SetClubControls(ctx context.Context, in *ClubControlsRequest) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "SetClubControls")
+	caller := c.callSetClubControls
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ClubControlsRequest) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ClubControlsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ClubControlsRequest) when calling interceptor")
+					}
+					return c.callSetClubControls(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callSetClubControls(ctx context.Context, in *ClubControlsRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[28], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) SetClubSessionTemplate(ctx context.Context, in *ClubSessionTemplateRequest) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "SetClubSessionTemplate")
+	caller := c.callSetClubSessionTemplate
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ClubSessionTemplateRequest) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ClubSessionTemplateRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ClubSessionTemplateRequest) when calling interceptor")
+					}
+					return c.callSetClubSessionTemplate(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callSetClubSessionTemplate(ctx context.Context, in *ClubSessionTemplateRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[29], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) SetClubSessionSchedule(ctx context.Context, in *ClubSessionScheduleRequest) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "SetClubSessionSchedule")
+	caller := c.callSetClubSessionSchedule
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ClubSessionScheduleRequest) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ClubSessionScheduleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ClubSessionScheduleRequest) when calling interceptor")
+					}
+					return c.callSetClubSessionSchedule(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callSetClubSessionSchedule(ctx context.Context, in *ClubSessionScheduleRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[30], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) GetClubRatings(ctx context.Context, in *ClubRatingsRequest) (*ClubRatingsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetClubRatings")
+	caller := c.callGetClubRatings
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ClubRatingsRequest) (*ClubRatingsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ClubRatingsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ClubRatingsRequest) when calling interceptor")
+					}
+					return c.callGetClubRatings(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ClubRatingsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ClubRatingsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callGetClubRatings(ctx context.Context, in *ClubRatingsRequest) (*ClubRatingsResponse, error) {
+	out := new(ClubRatingsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[31], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) GetClubStats(ctx context.Context, in *ClubStatsRequest) (*ClubStatsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetClubStats")
+	caller := c.callGetClubStats
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ClubStatsRequest) (*ClubStatsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ClubStatsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ClubStatsRequest) when calling interceptor")
+					}
+					return c.callGetClubStats(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ClubStatsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ClubStatsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callGetClubStats(ctx context.Context, in *ClubStatsRequest) (*ClubStatsResponse, error) {
+	out := new(ClubStatsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[32], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) UnstartTournament(ctx context.Context, in *UnstartTournamentRequest) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "UnstartTournament")
+	caller := c.callUnstartTournament
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UnstartTournamentRequest) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UnstartTournamentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UnstartTournamentRequest) when calling interceptor")
+					}
+					return c.callUnstartTournament(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callUnstartTournament(ctx context.Context, in *UnstartTournamentRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[33], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) UncheckIn(ctx context.Context, in *UncheckInRequest) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "UncheckIn")
+	caller := c.callUncheckIn
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UncheckInRequest) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UncheckInRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UncheckInRequest) when calling interceptor")
+					}
+					return c.callUncheckIn(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callUncheckIn(ctx context.Context, in *UncheckInRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[34], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) CheckIn(ctx context.Context, in *CheckinRequest) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "CheckIn")
+	caller := c.callCheckIn
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CheckinRequest) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CheckinRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CheckinRequest) when calling interceptor")
+					}
+					return c.callCheckIn(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callCheckIn(ctx context.Context, in *CheckinRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[35], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) GetBracket(ctx context.Context, in *TournamentDivisionRequest) (*ipc1.BracketResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetBracket")
+	caller := c.callGetBracket
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *TournamentDivisionRequest) (*ipc1.BracketResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TournamentDivisionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TournamentDivisionRequest) when calling interceptor")
+					}
+					return c.callGetBracket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ipc1.BracketResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ipc1.BracketResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callGetBracket(ctx context.Context, in *TournamentDivisionRequest) (*ipc1.BracketResponse, error) {
+	out := new(ipc1.BracketResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[36], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) Register(ctx context.Context, in *RegisterRequest) (*RegisterResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "Register")
+	caller := c.callRegister
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RegisterRequest) (*RegisterResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RegisterRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterRequest) when calling interceptor")
+					}
+					return c.callRegister(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RegisterResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RegisterResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callRegister(ctx context.Context, in *RegisterRequest) (*RegisterResponse, error) {
+	out := new(RegisterResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[37], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) Unregister(ctx context.Context, in *RegisterRequest) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "Unregister")
+	caller := c.callUnregister
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RegisterRequest) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RegisterRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterRequest) when calling interceptor")
+					}
+					return c.callUnregister(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callUnregister(ctx context.Context, in *RegisterRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[38], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) ApproveRegistrations(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "ApproveRegistrations")
+	caller := c.callApproveRegistrations
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ipc1.TournamentPersons)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
+					}
+					return c.callApproveRegistrations(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callApproveRegistrations(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[39], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) RejectRegistrations(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "RejectRegistrations")
+	caller := c.callRejectRegistrations
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ipc1.TournamentPersons)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
+					}
+					return c.callRejectRegistrations(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callRejectRegistrations(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[40], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) GetRegistrations(ctx context.Context, in *TournamentDivisionRequest) (*DivisionRegistrationsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetRegistrations")
+	caller := c.callGetRegistrations
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *TournamentDivisionRequest) (*DivisionRegistrationsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TournamentDivisionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TournamentDivisionRequest) when calling interceptor")
+					}
+					return c.callGetRegistrations(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DivisionRegistrationsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DivisionRegistrationsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callGetRegistrations(ctx context.Context, in *TournamentDivisionRequest) (*DivisionRegistrationsResponse, error) {
+	out := new(DivisionRegistrationsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[41], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) GetAuditLog(ctx context.Context, in *AuditLogRequest) (*AuditLogResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetAuditLog")
+	caller := c.callGetAuditLog
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AuditLogRequest) (*AuditLogResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AuditLogRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AuditLogRequest) when calling interceptor")
+					}
+					return c.callGetAuditLog(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AuditLogResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AuditLogResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callGetAuditLog(ctx context.Context, in *AuditLogRequest) (*AuditLogResponse, error) {
+	out := new(AuditLogResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[42], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) GetPairingReport(ctx context.Context, in *TournamentDivisionRequest) (*ipc1.PairingReport, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPairingReport")
+	caller := c.callGetPairingReport
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *TournamentDivisionRequest) (*ipc1.PairingReport, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TournamentDivisionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TournamentDivisionRequest) when calling interceptor")
+					}
+					return c.callGetPairingReport(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ipc1.PairingReport)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ipc1.PairingReport) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callGetPairingReport(ctx context.Context, in *TournamentDivisionRequest) (*ipc1.PairingReport, error) {
+	out := new(ipc1.PairingReport)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[43], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) GetRatingPeriodPreview(ctx context.Context, in *TournamentDivisionRequest) (*RatingPeriodResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetRatingPeriodPreview")
+	caller := c.callGetRatingPeriodPreview
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *TournamentDivisionRequest) (*RatingPeriodResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TournamentDivisionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TournamentDivisionRequest) when calling interceptor")
+					}
+					return c.callGetRatingPeriodPreview(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RatingPeriodResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RatingPeriodResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callGetRatingPeriodPreview(ctx context.Context, in *TournamentDivisionRequest) (*RatingPeriodResponse, error) {
+	out := new(RatingPeriodResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[44], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) CloneTournament(ctx context.Context, in *CloneTournamentRequest) (*NewTournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "CloneTournament")
+	caller := c.callCloneTournament
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CloneTournamentRequest) (*NewTournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CloneTournamentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CloneTournamentRequest) when calling interceptor")
+					}
+					return c.callCloneTournament(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*NewTournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*NewTournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callCloneTournament(ctx context.Context, in *CloneTournamentRequest) (*NewTournamentResponse, error) {
+	out := new(NewTournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[45], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) CreateSnapshot(ctx context.Context, in *SnapshotRequest) (*TournamentSnapshot, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateSnapshot")
+	caller := c.callCreateSnapshot
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SnapshotRequest) (*TournamentSnapshot, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SnapshotRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SnapshotRequest) when calling interceptor")
+					}
+					return c.callCreateSnapshot(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentSnapshot)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentSnapshot) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callCreateSnapshot(ctx context.Context, in *SnapshotRequest) (*TournamentSnapshot, error) {
+	out := new(TournamentSnapshot)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[46], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) GetSnapshots(ctx context.Context, in *GetTournamentRequest) (*SnapshotsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetSnapshots")
+	caller := c.callGetSnapshots
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetTournamentRequest) (*SnapshotsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetTournamentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetTournamentRequest) when calling interceptor")
+					}
+					return c.callGetSnapshots(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SnapshotsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SnapshotsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callGetSnapshots(ctx context.Context, in *GetTournamentRequest) (*SnapshotsResponse, error) {
+	out := new(SnapshotsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[47], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "RestoreSnapshot")
+	caller := c.callRestoreSnapshot
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RestoreSnapshotRequest) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RestoreSnapshotRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RestoreSnapshotRequest) when calling interceptor")
+					}
+					return c.callRestoreSnapshot(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callRestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[48], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) GetLadder(ctx context.Context, in *GetLadderRequest) (*ipc1.Ladder, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetLadder")
+	caller := c.callGetLadder
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetLadderRequest) (*ipc1.Ladder, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetLadderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetLadderRequest) when calling interceptor")
+					}
+					return c.callGetLadder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ipc1.Ladder)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ipc1.Ladder) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callGetLadder(ctx context.Context, in *GetLadderRequest) (*ipc1.Ladder, error) {
+	out := new(ipc1.Ladder)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[49], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) SetLadderControls(ctx context.Context, in *LadderControlsRequest) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "SetLadderControls")
+	caller := c.callSetLadderControls
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *LadderControlsRequest) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LadderControlsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LadderControlsRequest) when calling interceptor")
+					}
+					return c.callSetLadderControls(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callSetLadderControls(ctx context.Context, in *LadderControlsRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[50], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) AddLadderPlayers(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "AddLadderPlayers")
+	caller := c.callAddLadderPlayers
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ipc1.TournamentPersons)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
+					}
+					return c.callAddLadderPlayers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callAddLadderPlayers(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[51], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) RemoveLadderPlayers(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveLadderPlayers")
+	caller := c.callRemoveLadderPlayers
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ipc1.TournamentPersons)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
+					}
+					return c.callRemoveLadderPlayers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callRemoveLadderPlayers(ctx context.Context, in *ipc1.TournamentPersons) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[52], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tournamentServiceJSONClient) ChallengeLadderPlayer(ctx context.Context, in *LadderChallengeRequest) (*TournamentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithMethodName(ctx, "ChallengeLadderPlayer")
+	caller := c.callChallengeLadderPlayer
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *LadderChallengeRequest) (*TournamentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LadderChallengeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LadderChallengeRequest) when calling interceptor")
+					}
+					return c.callChallengeLadderPlayer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tournamentServiceJSONClient) callChallengeLadderPlayer(ctx context.Context, in *LadderChallengeRequest) (*TournamentResponse, error) {
+	out := new(TournamentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[53], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ================================
+// TournamentService Server Handler
+// ================================
+
+type tournamentServiceServer struct {
+	TournamentService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewTournamentServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewTournamentServiceServer(svc TournamentService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &tournamentServiceServer{
+		TournamentService: svc,
+		hooks:             serverOpts.Hooks,
+		interceptor:       twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:        pathPrefix,
+		jsonSkipDefaults:  jsonSkipDefaults,
+		jsonCamelCase:     jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *tournamentServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *tournamentServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// TournamentServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const TournamentServicePathPrefix = "/twirp/tournament_service.TournamentService/"
+
+func (s *tournamentServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "tournament_service")
+	ctx = ctxsetters.WithServiceName(ctx, "TournamentService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "tournament_service.TournamentService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "NewTournament":
+		s.serveNewTournament(ctx, resp, req)
+		return
+	case "GetTournamentMetadata":
+		s.serveGetTournamentMetadata(ctx, resp, req)
+		return
+	case "GetTournament":
+		s.serveGetTournament(ctx, resp, req)
+		return
+	case "FinishTournament":
+		s.serveFinishTournament(ctx, resp, req)
+		return
+	case "SetTournamentMetadata":
+		s.serveSetTournamentMetadata(ctx, resp, req)
+		return
+	case "PairRound":
+		s.servePairRound(ctx, resp, req)
+		return
+	case "PreviewPairRound":
+		s.servePreviewPairRound(ctx, resp, req)
+		return
+	case "SetSingleRoundControls":
+		s.serveSetSingleRoundControls(ctx, resp, req)
+		return
+	case "SetRoundControls":
+		s.serveSetRoundControls(ctx, resp, req)
+		return
+	case "SetDivisionControls":
+		s.serveSetDivisionControls(ctx, resp, req)
+		return
+	case "AddDirectors":
+		s.serveAddDirectors(ctx, resp, req)
+		return
+	case "RemoveDirectors":
+		s.serveRemoveDirectors(ctx, resp, req)
+		return
+	case "AddDivision":
+		s.serveAddDivision(ctx, resp, req)
+		return
+	case "RemoveDivision":
+		s.serveRemoveDivision(ctx, resp, req)
+		return
+	case "AddPlayers":
+		s.serveAddPlayers(ctx, resp, req)
+		return
+	case "RemovePlayers":
+		s.serveRemovePlayers(ctx, resp, req)
+		return
+	case "WithdrawPlayers":
+		s.serveWithdrawPlayers(ctx, resp, req)
+		return
+	case "ReadmitPlayers":
+		s.serveReadmitPlayers(ctx, resp, req)
+		return
+	case "SetPairing":
+		s.serveSetPairing(ctx, resp, req)
+		return
+	case "SetResult":
+		s.serveSetResult(ctx, resp, req)
+		return
+	case "StartRoundCountdown":
+		s.serveStartRoundCountdown(ctx, resp, req)
+		return
+	case "RecentGames":
+		s.serveRecentGames(ctx, resp, req)
+		return
+	case "CreateClubSession":
+		s.serveCreateClubSession(ctx, resp, req)
+		return
+	case "GetRecentClubSessions":
+		s.serveGetRecentClubSessions(ctx, resp, req)
+		return
+	case "PromoteAndRelegate":
+		s.servePromoteAndRelegate(ctx, resp, req)
+		return
+	case "GetClub":
+		s.serveGetClub(ctx, resp, req)
+		return
+	case "AddClubMembers":
+		s.serveAddClubMembers(ctx, resp, req)
+		return
+	case "RemoveClubMembers":
+		s.serveRemoveClubMembers(ctx, resp, req)
+		return
+	case "SetClubControls":
+		s.serveSetClubControls(ctx, resp, req)
+		return
+	case "SetClubSessionTemplate":
+		s.serveSetClubSessionTemplate(ctx, resp, req)
+		return
+	case "SetClubSessionSchedule":
+		s.serveSetClubSessionSchedule(ctx, resp, req)
+		return
+	case "GetClubRatings":
+		s.serveGetClubRatings(ctx, resp, req)
+		return
+	case "GetClubStats":
+		s.serveGetClubStats(ctx, resp, req)
+		return
+	case "UnstartTournament":
+		s.serveUnstartTournament(ctx, resp, req)
+		return
+	case "UncheckIn":
+		s.serveUncheckIn(ctx, resp, req)
+		return
+	case "CheckIn":
+		s.serveCheckIn(ctx, resp, req)
+		return
+	case "GetBracket":
+		s.serveGetBracket(ctx, resp, req)
+		return
+	case "Register":
+		s.serveRegister(ctx, resp, req)
+		return
+	case "Unregister":
+		s.serveUnregister(ctx, resp, req)
+		return
+	case "ApproveRegistrations":
+		s.serveApproveRegistrations(ctx, resp, req)
+		return
+	case "RejectRegistrations":
+		s.serveRejectRegistrations(ctx, resp, req)
+		return
+	case "GetRegistrations":
+		s.serveGetRegistrations(ctx, resp, req)
+		return
+	case "GetAuditLog":
+		s.serveGetAuditLog(ctx, resp, req)
+		return
+	case "GetPairingReport":
+		s.serveGetPairingReport(ctx, resp, req)
+		return
+	case "GetRatingPeriodPreview":
+		s.serveGetRatingPeriodPreview(ctx, resp, req)
+		return
+	case "CloneTournament":
+		s.serveCloneTournament(ctx, resp, req)
+		return
+	case "CreateSnapshot":
+		s.serveCreateSnapshot(ctx, resp, req)
+		return
+	case "GetSnapshots":
+		s.serveGetSnapshots(ctx, resp, req)
+		return
+	case "RestoreSnapshot":
+		s.serveRestoreSnapshot(ctx, resp, req)
+		return
+	case "GetLadder":
+		s.serveGetLadder(ctx, resp, req)
+		return
+	case "SetLadderControls":
+		s.serveSetLadderControls(ctx, resp, req)
+		return
+	case "AddLadderPlayers":
+		s.serveAddLadderPlayers(ctx, resp, req)
+		return
+	case "RemoveLadderPlayers":
+		s.serveRemoveLadderPlayers(ctx, resp, req)
+		return
+	case "ChallengeLadderPlayer":
+		s.serveChallengeLadderPlayer(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
 		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
 		return
 	}
-	if prefix != s.pathPrefix {
-		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+}
+
+func (s *tournamentServiceServer) serveNewTournament(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveNewTournamentJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveNewTournamentProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveNewTournamentJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "NewTournament")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(NewTournamentRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TournamentService.NewTournament
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *NewTournamentRequest) (*NewTournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*NewTournamentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*NewTournamentRequest) when calling interceptor")
+					}
+					return s.TournamentService.NewTournament(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*NewTournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*NewTournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *NewTournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *NewTournamentResponse and nil error while calling NewTournament. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveNewTournamentProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "NewTournament")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(NewTournamentRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.NewTournament
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *NewTournamentRequest) (*NewTournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*NewTournamentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*NewTournamentRequest) when calling interceptor")
+					}
+					return s.TournamentService.NewTournament(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*NewTournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*NewTournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *NewTournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *NewTournamentResponse and nil error while calling NewTournament. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveGetTournamentMetadata(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetTournamentMetadataJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetTournamentMetadataProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveGetTournamentMetadataJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetTournamentMetadata")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetTournamentMetadataRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TournamentService.GetTournamentMetadata
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetTournamentMetadataRequest) (*TournamentMetadataResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetTournamentMetadataRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetTournamentMetadataRequest) when calling interceptor")
+					}
+					return s.TournamentService.GetTournamentMetadata(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentMetadataResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentMetadataResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentMetadataResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentMetadataResponse and nil error while calling GetTournamentMetadata. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveGetTournamentMetadataProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetTournamentMetadata")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetTournamentMetadataRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.GetTournamentMetadata
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetTournamentMetadataRequest) (*TournamentMetadataResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetTournamentMetadataRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetTournamentMetadataRequest) when calling interceptor")
+					}
+					return s.TournamentService.GetTournamentMetadata(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentMetadataResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentMetadataResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentMetadataResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentMetadataResponse and nil error while calling GetTournamentMetadata. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveGetTournament(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetTournamentJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetTournamentProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveGetTournamentJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetTournament")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetTournamentRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TournamentService.GetTournament
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetTournamentRequest) (*ipc1.FullTournamentDivisions, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetTournamentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetTournamentRequest) when calling interceptor")
+					}
+					return s.TournamentService.GetTournament(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ipc1.FullTournamentDivisions)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ipc1.FullTournamentDivisions) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ipc1.FullTournamentDivisions
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ipc1.FullTournamentDivisions and nil error while calling GetTournament. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveGetTournamentProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetTournament")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetTournamentRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.GetTournament
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetTournamentRequest) (*ipc1.FullTournamentDivisions, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetTournamentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetTournamentRequest) when calling interceptor")
+					}
+					return s.TournamentService.GetTournament(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ipc1.FullTournamentDivisions)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ipc1.FullTournamentDivisions) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ipc1.FullTournamentDivisions
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ipc1.FullTournamentDivisions and nil error while calling GetTournament. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveFinishTournament(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveFinishTournamentJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveFinishTournamentProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveFinishTournamentJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FinishTournament")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(FinishTournamentRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TournamentService.FinishTournament
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FinishTournamentRequest) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FinishTournamentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FinishTournamentRequest) when calling interceptor")
+					}
+					return s.TournamentService.FinishTournament(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling FinishTournament. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveFinishTournamentProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FinishTournament")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(FinishTournamentRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.FinishTournament
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FinishTournamentRequest) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FinishTournamentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FinishTournamentRequest) when calling interceptor")
+					}
+					return s.TournamentService.FinishTournament(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling FinishTournament. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveSetTournamentMetadata(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSetTournamentMetadataJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSetTournamentMetadataProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveSetTournamentMetadataJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetTournamentMetadata")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SetTournamentMetadataRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TournamentService.SetTournamentMetadata
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SetTournamentMetadataRequest) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetTournamentMetadataRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetTournamentMetadataRequest) when calling interceptor")
+					}
+					return s.TournamentService.SetTournamentMetadata(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetTournamentMetadata. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveSetTournamentMetadataProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetTournamentMetadata")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SetTournamentMetadataRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.SetTournamentMetadata
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SetTournamentMetadataRequest) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetTournamentMetadataRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetTournamentMetadataRequest) when calling interceptor")
+					}
+					return s.TournamentService.SetTournamentMetadata(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetTournamentMetadata. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) servePairRound(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.servePairRoundJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.servePairRoundProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) servePairRoundJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "PairRound")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(PairRoundRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TournamentService.PairRound
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PairRoundRequest) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PairRoundRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PairRoundRequest) when calling interceptor")
+					}
+					return s.TournamentService.PairRound(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling PairRound. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) servePairRoundProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "PairRound")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(PairRoundRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.PairRound
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PairRoundRequest) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PairRoundRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PairRoundRequest) when calling interceptor")
+					}
+					return s.TournamentService.PairRound(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling PairRound. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) servePreviewPairRound(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.servePreviewPairRoundJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.servePreviewPairRoundProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) servePreviewPairRoundJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "PreviewPairRound")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(PairingPreviewRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TournamentService.PreviewPairRound
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PairingPreviewRequest) (*ipc1.PairingPreviewResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PairingPreviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PairingPreviewRequest) when calling interceptor")
+					}
+					return s.TournamentService.PreviewPairRound(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ipc1.PairingPreviewResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ipc1.PairingPreviewResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ipc1.PairingPreviewResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ipc1.PairingPreviewResponse and nil error while calling PreviewPairRound. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) servePreviewPairRoundProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "PreviewPairRound")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(PairingPreviewRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.PreviewPairRound
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PairingPreviewRequest) (*ipc1.PairingPreviewResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PairingPreviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PairingPreviewRequest) when calling interceptor")
+					}
+					return s.TournamentService.PreviewPairRound(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ipc1.PairingPreviewResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ipc1.PairingPreviewResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ipc1.PairingPreviewResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ipc1.PairingPreviewResponse and nil error while calling PreviewPairRound. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveSetSingleRoundControls(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSetSingleRoundControlsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSetSingleRoundControlsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveSetSingleRoundControlsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetSingleRoundControls")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SingleRoundControlsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TournamentService.SetSingleRoundControls
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SingleRoundControlsRequest) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SingleRoundControlsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SingleRoundControlsRequest) when calling interceptor")
+					}
+					return s.TournamentService.SetSingleRoundControls(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetSingleRoundControls. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveSetSingleRoundControlsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetSingleRoundControls")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SingleRoundControlsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.SetSingleRoundControls
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SingleRoundControlsRequest) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SingleRoundControlsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SingleRoundControlsRequest) when calling interceptor")
+					}
+					return s.TournamentService.SetSingleRoundControls(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetSingleRoundControls. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveSetRoundControls(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSetRoundControlsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSetRoundControlsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveSetRoundControlsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetRoundControls")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ipc1.DivisionRoundControls)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TournamentService.SetRoundControls
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ipc1.DivisionRoundControls) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ipc1.DivisionRoundControls)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ipc1.DivisionRoundControls) when calling interceptor")
+					}
+					return s.TournamentService.SetRoundControls(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetRoundControls. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveSetRoundControlsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetRoundControls")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ipc1.DivisionRoundControls)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.SetRoundControls
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ipc1.DivisionRoundControls) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ipc1.DivisionRoundControls)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ipc1.DivisionRoundControls) when calling interceptor")
+					}
+					return s.TournamentService.SetRoundControls(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetRoundControls. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveSetDivisionControls(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSetDivisionControlsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSetDivisionControlsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveSetDivisionControlsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetDivisionControls")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ipc1.DivisionControls)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TournamentService.SetDivisionControls
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ipc1.DivisionControls) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ipc1.DivisionControls)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ipc1.DivisionControls) when calling interceptor")
+					}
+					return s.TournamentService.SetDivisionControls(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetDivisionControls. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveSetDivisionControlsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetDivisionControls")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ipc1.DivisionControls)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.SetDivisionControls
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ipc1.DivisionControls) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ipc1.DivisionControls)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ipc1.DivisionControls) when calling interceptor")
+					}
+					return s.TournamentService.SetDivisionControls(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetDivisionControls. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveAddDirectors(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveAddDirectorsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveAddDirectorsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveAddDirectorsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AddDirectors")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ipc1.TournamentPersons)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TournamentService.AddDirectors
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ipc1.TournamentPersons)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
+					}
+					return s.TournamentService.AddDirectors(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling AddDirectors. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveAddDirectorsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AddDirectors")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ipc1.TournamentPersons)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.AddDirectors
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ipc1.TournamentPersons)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
+					}
+					return s.TournamentService.AddDirectors(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling AddDirectors. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveRemoveDirectors(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRemoveDirectorsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRemoveDirectorsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveRemoveDirectorsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveDirectors")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ipc1.TournamentPersons)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TournamentService.RemoveDirectors
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ipc1.TournamentPersons)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
+					}
+					return s.TournamentService.RemoveDirectors(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling RemoveDirectors. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveRemoveDirectorsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveDirectors")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ipc1.TournamentPersons)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.RemoveDirectors
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ipc1.TournamentPersons)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
+					}
+					return s.TournamentService.RemoveDirectors(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling RemoveDirectors. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveAddDivision(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveAddDivisionJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveAddDivisionProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveAddDivisionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AddDivision")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(TournamentDivisionRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TournamentService.AddDivision
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *TournamentDivisionRequest) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TournamentDivisionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TournamentDivisionRequest) when calling interceptor")
+					}
+					return s.TournamentService.AddDivision(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling AddDivision. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveAddDivisionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AddDivision")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(TournamentDivisionRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.AddDivision
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *TournamentDivisionRequest) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TournamentDivisionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TournamentDivisionRequest) when calling interceptor")
+					}
+					return s.TournamentService.AddDivision(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling AddDivision. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveRemoveDivision(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRemoveDivisionJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRemoveDivisionProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveRemoveDivisionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveDivision")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(TournamentDivisionRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TournamentService.RemoveDivision
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *TournamentDivisionRequest) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TournamentDivisionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TournamentDivisionRequest) when calling interceptor")
+					}
+					return s.TournamentService.RemoveDivision(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling RemoveDivision. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveRemoveDivisionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveDivision")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(TournamentDivisionRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.RemoveDivision
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *TournamentDivisionRequest) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TournamentDivisionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TournamentDivisionRequest) when calling interceptor")
+					}
+					return s.TournamentService.RemoveDivision(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling RemoveDivision. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveAddPlayers(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveAddPlayersJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveAddPlayersProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveAddPlayersJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AddPlayers")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ipc1.TournamentPersons)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TournamentService.AddPlayers
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ipc1.TournamentPersons)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
+					}
+					return s.TournamentService.AddPlayers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling AddPlayers. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveAddPlayersProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AddPlayers")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ipc1.TournamentPersons)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.AddPlayers
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ipc1.TournamentPersons)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
+					}
+					return s.TournamentService.AddPlayers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling AddPlayers. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveRemovePlayers(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRemovePlayersJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRemovePlayersProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveRemovePlayersJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RemovePlayers")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ipc1.TournamentPersons)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TournamentService.RemovePlayers
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ipc1.TournamentPersons)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
+					}
+					return s.TournamentService.RemovePlayers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling RemovePlayers. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveRemovePlayersProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RemovePlayers")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ipc1.TournamentPersons)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.RemovePlayers
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ipc1.TournamentPersons)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
+					}
+					return s.TournamentService.RemovePlayers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling RemovePlayers. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveWithdrawPlayers(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveWithdrawPlayersJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveWithdrawPlayersProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveWithdrawPlayersJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "WithdrawPlayers")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(WithdrawPlayersRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TournamentService.WithdrawPlayers
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *WithdrawPlayersRequest) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WithdrawPlayersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WithdrawPlayersRequest) when calling interceptor")
+					}
+					return s.TournamentService.WithdrawPlayers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling WithdrawPlayers. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveWithdrawPlayersProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "WithdrawPlayers")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(WithdrawPlayersRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.WithdrawPlayers
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *WithdrawPlayersRequest) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WithdrawPlayersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WithdrawPlayersRequest) when calling interceptor")
+					}
+					return s.TournamentService.WithdrawPlayers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling WithdrawPlayers. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveReadmitPlayers(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveReadmitPlayersJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveReadmitPlayersProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveReadmitPlayersJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ReadmitPlayers")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ReadmitPlayersRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TournamentService.ReadmitPlayers
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ReadmitPlayersRequest) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReadmitPlayersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReadmitPlayersRequest) when calling interceptor")
+					}
+					return s.TournamentService.ReadmitPlayers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling ReadmitPlayers. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveReadmitPlayersProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ReadmitPlayers")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ReadmitPlayersRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.ReadmitPlayers
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ReadmitPlayersRequest) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReadmitPlayersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReadmitPlayersRequest) when calling interceptor")
+					}
+					return s.TournamentService.ReadmitPlayers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling ReadmitPlayers. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveSetPairing(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSetPairingJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSetPairingProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveSetPairingJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetPairing")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(TournamentPairingsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TournamentService.SetPairing
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *TournamentPairingsRequest) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TournamentPairingsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TournamentPairingsRequest) when calling interceptor")
+					}
+					return s.TournamentService.SetPairing(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetPairing. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveSetPairingProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetPairing")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(TournamentPairingsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.SetPairing
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *TournamentPairingsRequest) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TournamentPairingsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TournamentPairingsRequest) when calling interceptor")
+					}
+					return s.TournamentService.SetPairing(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetPairing. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveSetResult(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSetResultJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSetResultProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveSetResultJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetResult")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(TournamentResultOverrideRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TournamentService.SetResult
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *TournamentResultOverrideRequest) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TournamentResultOverrideRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TournamentResultOverrideRequest) when calling interceptor")
+					}
+					return s.TournamentService.SetResult(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetResult. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveSetResultProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetResult")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(TournamentResultOverrideRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.SetResult
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *TournamentResultOverrideRequest) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TournamentResultOverrideRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TournamentResultOverrideRequest) when calling interceptor")
+					}
+					return s.TournamentService.SetResult(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetResult. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveStartRoundCountdown(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveStartRoundCountdownJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveStartRoundCountdownProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveStartRoundCountdownJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "StartRoundCountdown")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(TournamentStartRoundCountdownRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TournamentService.StartRoundCountdown
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *TournamentStartRoundCountdownRequest) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TournamentStartRoundCountdownRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TournamentStartRoundCountdownRequest) when calling interceptor")
+					}
+					return s.TournamentService.StartRoundCountdown(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling StartRoundCountdown. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveStartRoundCountdownProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "StartRoundCountdown")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(TournamentStartRoundCountdownRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.StartRoundCountdown
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *TournamentStartRoundCountdownRequest) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TournamentStartRoundCountdownRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TournamentStartRoundCountdownRequest) when calling interceptor")
+					}
+					return s.TournamentService.StartRoundCountdown(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling StartRoundCountdown. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveRecentGames(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRecentGamesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRecentGamesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveRecentGamesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RecentGames")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RecentGamesRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TournamentService.RecentGames
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RecentGamesRequest) (*RecentGamesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RecentGamesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RecentGamesRequest) when calling interceptor")
+					}
+					return s.TournamentService.RecentGames(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RecentGamesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RecentGamesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RecentGamesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RecentGamesResponse and nil error while calling RecentGames. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveRecentGamesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RecentGames")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RecentGamesRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.RecentGames
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RecentGamesRequest) (*RecentGamesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RecentGamesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RecentGamesRequest) when calling interceptor")
+					}
+					return s.TournamentService.RecentGames(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RecentGamesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RecentGamesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RecentGamesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RecentGamesResponse and nil error while calling RecentGames. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveCreateClubSession(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreateClubSessionJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreateClubSessionProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveCreateClubSessionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateClubSession")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(NewClubSessionRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TournamentService.CreateClubSession
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *NewClubSessionRequest) (*ClubSessionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*NewClubSessionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*NewClubSessionRequest) when calling interceptor")
+					}
+					return s.TournamentService.CreateClubSession(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ClubSessionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ClubSessionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ClubSessionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ClubSessionResponse and nil error while calling CreateClubSession. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveCreateClubSessionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateClubSession")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(NewClubSessionRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.CreateClubSession
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *NewClubSessionRequest) (*ClubSessionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*NewClubSessionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*NewClubSessionRequest) when calling interceptor")
+					}
+					return s.TournamentService.CreateClubSession(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ClubSessionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ClubSessionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ClubSessionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ClubSessionResponse and nil error while calling CreateClubSession. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveGetRecentClubSessions(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetRecentClubSessionsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetRecentClubSessionsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveGetRecentClubSessionsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetRecentClubSessions")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RecentClubSessionsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TournamentService.GetRecentClubSessions
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RecentClubSessionsRequest) (*ClubSessionsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RecentClubSessionsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RecentClubSessionsRequest) when calling interceptor")
+					}
+					return s.TournamentService.GetRecentClubSessions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ClubSessionsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ClubSessionsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ClubSessionsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ClubSessionsResponse and nil error while calling GetRecentClubSessions. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveGetRecentClubSessionsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetRecentClubSessions")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RecentClubSessionsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.GetRecentClubSessions
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RecentClubSessionsRequest) (*ClubSessionsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RecentClubSessionsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RecentClubSessionsRequest) when calling interceptor")
+					}
+					return s.TournamentService.GetRecentClubSessions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ClubSessionsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ClubSessionsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ClubSessionsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ClubSessionsResponse and nil error while calling GetRecentClubSessions. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) servePromoteAndRelegate(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.servePromoteAndRelegateJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.servePromoteAndRelegateProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) servePromoteAndRelegateJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "PromoteAndRelegate")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(PromotionRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TournamentService.PromoteAndRelegate
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PromotionRequest) (*PromotionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PromotionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PromotionRequest) when calling interceptor")
+					}
+					return s.TournamentService.PromoteAndRelegate(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PromotionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PromotionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *PromotionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PromotionResponse and nil error while calling PromoteAndRelegate. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) servePromoteAndRelegateProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "PromoteAndRelegate")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(PromotionRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.PromoteAndRelegate
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PromotionRequest) (*PromotionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PromotionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PromotionRequest) when calling interceptor")
+					}
+					return s.TournamentService.PromoteAndRelegate(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PromotionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PromotionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *PromotionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PromotionResponse and nil error while calling PromoteAndRelegate. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveGetClub(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetClubJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetClubProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveGetClubJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetClub")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetClubRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TournamentService.GetClub
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetClubRequest) (*ipc1.Club, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetClubRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetClubRequest) when calling interceptor")
+					}
+					return s.TournamentService.GetClub(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ipc1.Club)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ipc1.Club) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ipc1.Club
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ipc1.Club and nil error while calling GetClub. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveGetClubProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetClub")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetClubRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.GetClub
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetClubRequest) (*ipc1.Club, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetClubRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetClubRequest) when calling interceptor")
+					}
+					return s.TournamentService.GetClub(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ipc1.Club)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ipc1.Club) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ipc1.Club
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ipc1.Club and nil error while calling GetClub. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveAddClubMembers(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveAddClubMembersJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveAddClubMembersProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveAddClubMembersJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AddClubMembers")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ClubMembersRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TournamentService.AddClubMembers
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ClubMembersRequest) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ClubMembersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ClubMembersRequest) when calling interceptor")
+					}
+					return s.TournamentService.AddClubMembers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling AddClubMembers. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveAddClubMembersProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AddClubMembers")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ClubMembersRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.AddClubMembers
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ClubMembersRequest) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ClubMembersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ClubMembersRequest) when calling interceptor")
+					}
+					return s.TournamentService.AddClubMembers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling AddClubMembers. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveRemoveClubMembers(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRemoveClubMembersJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRemoveClubMembersProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveRemoveClubMembersJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveClubMembers")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ClubMembersRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TournamentService.RemoveClubMembers
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ClubMembersRequest) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ClubMembersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ClubMembersRequest) when calling interceptor")
+					}
+					return s.TournamentService.RemoveClubMembers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling RemoveClubMembers. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveRemoveClubMembersProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveClubMembers")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ClubMembersRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TournamentService.RemoveClubMembers
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ClubMembersRequest) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ClubMembersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ClubMembersRequest) when calling interceptor")
+					}
+					return s.TournamentService.RemoveClubMembers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling RemoveClubMembers. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveSetClubControls(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSetClubControlsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSetClubControlsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveSetClubControlsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetClubControls")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
 		return
 	}
 
-	switch method {
-	case "NewTournament":
-		s.serveNewTournament(ctx, resp, req)
-		return
-	case "GetTournamentMetadata":
-		s.serveGetTournamentMetadata(ctx, resp, req)
-		return
-	case "GetTournament":
-		s.serveGetTournament(ctx, resp, req)
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
-	case "FinishTournament":
-		s.serveFinishTournament(ctx, resp, req)
+	}
+	reqContent := new(ClubControlsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
-	case "SetTournamentMetadata":
-		s.serveSetTournamentMetadata(ctx, resp, req)
+	}
+
+	handler := s.TournamentService.SetClubControls
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ClubControlsRequest) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ClubControlsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ClubControlsRequest) when calling interceptor")
+					}
+					return s.TournamentService.SetClubControls(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
 		return
-	case "PairRound":
-		s.servePairRound(ctx, resp, req)
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetClubControls. nil responses are not supported"))
 		return
-	case "SetSingleRoundControls":
-		s.serveSetSingleRoundControls(ctx, resp, req)
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
 		return
-	case "SetRoundControls":
-		s.serveSetRoundControls(ctx, resp, req)
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveSetClubControlsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetClubControls")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
 		return
-	case "SetDivisionControls":
-		s.serveSetDivisionControls(ctx, resp, req)
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
-	case "AddDirectors":
-		s.serveAddDirectors(ctx, resp, req)
+	}
+	reqContent := new(ClubControlsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
-	case "RemoveDirectors":
-		s.serveRemoveDirectors(ctx, resp, req)
+	}
+
+	handler := s.TournamentService.SetClubControls
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ClubControlsRequest) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ClubControlsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ClubControlsRequest) when calling interceptor")
+					}
+					return s.TournamentService.SetClubControls(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
 		return
-	case "AddDivision":
-		s.serveAddDivision(ctx, resp, req)
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetClubControls. nil responses are not supported"))
 		return
-	case "RemoveDivision":
-		s.serveRemoveDivision(ctx, resp, req)
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
 		return
-	case "AddPlayers":
-		s.serveAddPlayers(ctx, resp, req)
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveSetClubSessionTemplate(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSetClubSessionTemplateJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSetClubSessionTemplateProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tournamentServiceServer) serveSetClubSessionTemplateJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetClubSessionTemplate")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
 		return
-	case "RemovePlayers":
-		s.serveRemovePlayers(ctx, resp, req)
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
-	case "SetPairing":
-		s.serveSetPairing(ctx, resp, req)
+	}
+	reqContent := new(ClubSessionTemplateRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
-	case "SetResult":
-		s.serveSetResult(ctx, resp, req)
+	}
+
+	handler := s.TournamentService.SetClubSessionTemplate
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ClubSessionTemplateRequest) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ClubSessionTemplateRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ClubSessionTemplateRequest) when calling interceptor")
+					}
+					return s.TournamentService.SetClubSessionTemplate(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
 		return
-	case "StartRoundCountdown":
-		s.serveStartRoundCountdown(ctx, resp, req)
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetClubSessionTemplate. nil responses are not supported"))
 		return
-	case "RecentGames":
-		s.serveRecentGames(ctx, resp, req)
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
 		return
-	case "CreateClubSession":
-		s.serveCreateClubSession(ctx, resp, req)
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tournamentServiceServer) serveSetClubSessionTemplateProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetClubSessionTemplate")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
 		return
-	case "GetRecentClubSessions":
-		s.serveGetRecentClubSessions(ctx, resp, req)
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
-	case "UnstartTournament":
-		s.serveUnstartTournament(ctx, resp, req)
+	}
+	reqContent := new(ClubSessionTemplateRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
-	case "UncheckIn":
-		s.serveUncheckIn(ctx, resp, req)
+	}
+
+	handler := s.TournamentService.SetClubSessionTemplate
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ClubSessionTemplateRequest) (*TournamentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ClubSessionTemplateRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ClubSessionTemplateRequest) when calling interceptor")
+					}
+					return s.TournamentService.SetClubSessionTemplate(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TournamentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TournamentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
 		return
-	case "CheckIn":
-		s.serveCheckIn(ctx, resp, req)
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetClubSessionTemplate. nil responses are not supported"))
 		return
-	default:
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
 		return
 	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveNewTournament(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveSetClubSessionSchedule(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -2620,9 +11031,9 @@ func (s *tournamentServiceServer) serveNewTournament(ctx context.Context, resp h
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveNewTournamentJSON(ctx, resp, req)
+		s.serveSetClubSessionScheduleJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveNewTournamentProtobuf(ctx, resp, req)
+		s.serveSetClubSessionScheduleProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -2630,9 +11041,9 @@ func (s *tournamentServiceServer) serveNewTournament(ctx context.Context, resp h
 	}
 }
 
-func (s *tournamentServiceServer) serveNewTournamentJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveSetClubSessionScheduleJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "NewTournament")
+	ctx = ctxsetters.WithMethodName(ctx, "SetClubSessionSchedule")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2645,29 +11056,29 @@ func (s *tournamentServiceServer) serveNewTournamentJSON(ctx context.Context, re
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(NewTournamentRequest)
+	reqContent := new(ClubSessionScheduleRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.TournamentService.NewTournament
+	handler := s.TournamentService.SetClubSessionSchedule
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *NewTournamentRequest) (*NewTournamentResponse, error) {
+		handler = func(ctx context.Context, req *ClubSessionScheduleRequest) (*TournamentResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*NewTournamentRequest)
+					typedReq, ok := req.(*ClubSessionScheduleRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*NewTournamentRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ClubSessionScheduleRequest) when calling interceptor")
 					}
-					return s.TournamentService.NewTournament(ctx, typedReq)
+					return s.TournamentService.SetClubSessionSchedule(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*NewTournamentResponse)
+				typedResp, ok := resp.(*TournamentResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*NewTournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2676,7 +11087,7 @@ func (s *tournamentServiceServer) serveNewTournamentJSON(ctx context.Context, re
 	}
 
 	// Call service method
-	var respContent *NewTournamentResponse
+	var respContent *TournamentResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2687,7 +11098,7 @@ func (s *tournamentServiceServer) serveNewTournamentJSON(ctx context.Context, re
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *NewTournamentResponse and nil error while calling NewTournament. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetClubSessionSchedule. nil responses are not supported"))
 		return
 	}
 
@@ -2713,9 +11124,9 @@ func (s *tournamentServiceServer) serveNewTournamentJSON(ctx context.Context, re
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveNewTournamentProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveSetClubSessionScheduleProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "NewTournament")
+	ctx = ctxsetters.WithMethodName(ctx, "SetClubSessionSchedule")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2727,28 +11138,28 @@ func (s *tournamentServiceServer) serveNewTournamentProtobuf(ctx context.Context
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(NewTournamentRequest)
+	reqContent := new(ClubSessionScheduleRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.TournamentService.NewTournament
+	handler := s.TournamentService.SetClubSessionSchedule
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *NewTournamentRequest) (*NewTournamentResponse, error) {
+		handler = func(ctx context.Context, req *ClubSessionScheduleRequest) (*TournamentResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*NewTournamentRequest)
+					typedReq, ok := req.(*ClubSessionScheduleRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*NewTournamentRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ClubSessionScheduleRequest) when calling interceptor")
 					}
-					return s.TournamentService.NewTournament(ctx, typedReq)
+					return s.TournamentService.SetClubSessionSchedule(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*NewTournamentResponse)
+				typedResp, ok := resp.(*TournamentResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*NewTournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2757,7 +11168,7 @@ func (s *tournamentServiceServer) serveNewTournamentProtobuf(ctx context.Context
 	}
 
 	// Call service method
-	var respContent *NewTournamentResponse
+	var respContent *TournamentResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2768,7 +11179,7 @@ func (s *tournamentServiceServer) serveNewTournamentProtobuf(ctx context.Context
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *NewTournamentResponse and nil error while calling NewTournament. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetClubSessionSchedule. nil responses are not supported"))
 		return
 	}
 
@@ -2792,7 +11203,7 @@ func (s *tournamentServiceServer) serveNewTournamentProtobuf(ctx context.Context
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveGetTournamentMetadata(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveGetClubRatings(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -2800,9 +11211,9 @@ func (s *tournamentServiceServer) serveGetTournamentMetadata(ctx context.Context
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveGetTournamentMetadataJSON(ctx, resp, req)
+		s.serveGetClubRatingsJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveGetTournamentMetadataProtobuf(ctx, resp, req)
+		s.serveGetClubRatingsProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -2810,9 +11221,9 @@ func (s *tournamentServiceServer) serveGetTournamentMetadata(ctx context.Context
 	}
 }
 
-func (s *tournamentServiceServer) serveGetTournamentMetadataJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveGetClubRatingsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetTournamentMetadata")
+	ctx = ctxsetters.WithMethodName(ctx, "GetClubRatings")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2825,29 +11236,29 @@ func (s *tournamentServiceServer) serveGetTournamentMetadataJSON(ctx context.Con
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(GetTournamentMetadataRequest)
+	reqContent := new(ClubRatingsRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.TournamentService.GetTournamentMetadata
+	handler := s.TournamentService.GetClubRatings
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetTournamentMetadataRequest) (*TournamentMetadataResponse, error) {
+		handler = func(ctx context.Context, req *ClubRatingsRequest) (*ClubRatingsResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetTournamentMetadataRequest)
+					typedReq, ok := req.(*ClubRatingsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetTournamentMetadataRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ClubRatingsRequest) when calling interceptor")
 					}
-					return s.TournamentService.GetTournamentMetadata(ctx, typedReq)
+					return s.TournamentService.GetClubRatings(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentMetadataResponse)
+				typedResp, ok := resp.(*ClubRatingsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentMetadataResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ClubRatingsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2856,7 +11267,7 @@ func (s *tournamentServiceServer) serveGetTournamentMetadataJSON(ctx context.Con
 	}
 
 	// Call service method
-	var respContent *TournamentMetadataResponse
+	var respContent *ClubRatingsResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2867,7 +11278,7 @@ func (s *tournamentServiceServer) serveGetTournamentMetadataJSON(ctx context.Con
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentMetadataResponse and nil error while calling GetTournamentMetadata. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ClubRatingsResponse and nil error while calling GetClubRatings. nil responses are not supported"))
 		return
 	}
 
@@ -2893,9 +11304,9 @@ func (s *tournamentServiceServer) serveGetTournamentMetadataJSON(ctx context.Con
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveGetTournamentMetadataProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveGetClubRatingsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetTournamentMetadata")
+	ctx = ctxsetters.WithMethodName(ctx, "GetClubRatings")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2907,28 +11318,28 @@ func (s *tournamentServiceServer) serveGetTournamentMetadataProtobuf(ctx context
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(GetTournamentMetadataRequest)
+	reqContent := new(ClubRatingsRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.TournamentService.GetTournamentMetadata
+	handler := s.TournamentService.GetClubRatings
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetTournamentMetadataRequest) (*TournamentMetadataResponse, error) {
+		handler = func(ctx context.Context, req *ClubRatingsRequest) (*ClubRatingsResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetTournamentMetadataRequest)
+					typedReq, ok := req.(*ClubRatingsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetTournamentMetadataRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ClubRatingsRequest) when calling interceptor")
 					}
-					return s.TournamentService.GetTournamentMetadata(ctx, typedReq)
+					return s.TournamentService.GetClubRatings(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentMetadataResponse)
+				typedResp, ok := resp.(*ClubRatingsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentMetadataResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ClubRatingsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2937,7 +11348,7 @@ func (s *tournamentServiceServer) serveGetTournamentMetadataProtobuf(ctx context
 	}
 
 	// Call service method
-	var respContent *TournamentMetadataResponse
+	var respContent *ClubRatingsResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2948,7 +11359,7 @@ func (s *tournamentServiceServer) serveGetTournamentMetadataProtobuf(ctx context
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentMetadataResponse and nil error while calling GetTournamentMetadata. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ClubRatingsResponse and nil error while calling GetClubRatings. nil responses are not supported"))
 		return
 	}
 
@@ -2972,7 +11383,7 @@ func (s *tournamentServiceServer) serveGetTournamentMetadataProtobuf(ctx context
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveGetTournament(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveGetClubStats(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -2980,9 +11391,9 @@ func (s *tournamentServiceServer) serveGetTournament(ctx context.Context, resp h
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveGetTournamentJSON(ctx, resp, req)
+		s.serveGetClubStatsJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveGetTournamentProtobuf(ctx, resp, req)
+		s.serveGetClubStatsProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -2990,9 +11401,9 @@ func (s *tournamentServiceServer) serveGetTournament(ctx context.Context, resp h
 	}
 }
 
-func (s *tournamentServiceServer) serveGetTournamentJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveGetClubStatsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetTournament")
+	ctx = ctxsetters.WithMethodName(ctx, "GetClubStats")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3005,29 +11416,29 @@ func (s *tournamentServiceServer) serveGetTournamentJSON(ctx context.Context, re
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(GetTournamentRequest)
+	reqContent := new(ClubStatsRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.TournamentService.GetTournament
+	handler := s.TournamentService.GetClubStats
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetTournamentRequest) (*ipc1.FullTournamentDivisions, error) {
+		handler = func(ctx context.Context, req *ClubStatsRequest) (*ClubStatsResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetTournamentRequest)
+					typedReq, ok := req.(*ClubStatsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetTournamentRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ClubStatsRequest) when calling interceptor")
 					}
-					return s.TournamentService.GetTournament(ctx, typedReq)
+					return s.TournamentService.GetClubStats(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ipc1.FullTournamentDivisions)
+				typedResp, ok := resp.(*ClubStatsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ipc1.FullTournamentDivisions) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ClubStatsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -3036,7 +11447,7 @@ func (s *tournamentServiceServer) serveGetTournamentJSON(ctx context.Context, re
 	}
 
 	// Call service method
-	var respContent *ipc1.FullTournamentDivisions
+	var respContent *ClubStatsResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -3047,7 +11458,7 @@ func (s *tournamentServiceServer) serveGetTournamentJSON(ctx context.Context, re
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ipc1.FullTournamentDivisions and nil error while calling GetTournament. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ClubStatsResponse and nil error while calling GetClubStats. nil responses are not supported"))
 		return
 	}
 
@@ -3073,9 +11484,9 @@ func (s *tournamentServiceServer) serveGetTournamentJSON(ctx context.Context, re
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveGetTournamentProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveGetClubStatsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetTournament")
+	ctx = ctxsetters.WithMethodName(ctx, "GetClubStats")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3087,28 +11498,28 @@ func (s *tournamentServiceServer) serveGetTournamentProtobuf(ctx context.Context
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(GetTournamentRequest)
+	reqContent := new(ClubStatsRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.TournamentService.GetTournament
+	handler := s.TournamentService.GetClubStats
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetTournamentRequest) (*ipc1.FullTournamentDivisions, error) {
+		handler = func(ctx context.Context, req *ClubStatsRequest) (*ClubStatsResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetTournamentRequest)
+					typedReq, ok := req.(*ClubStatsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetTournamentRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ClubStatsRequest) when calling interceptor")
 					}
-					return s.TournamentService.GetTournament(ctx, typedReq)
+					return s.TournamentService.GetClubStats(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ipc1.FullTournamentDivisions)
+				typedResp, ok := resp.(*ClubStatsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ipc1.FullTournamentDivisions) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ClubStatsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -3117,7 +11528,7 @@ func (s *tournamentServiceServer) serveGetTournamentProtobuf(ctx context.Context
 	}
 
 	// Call service method
-	var respContent *ipc1.FullTournamentDivisions
+	var respContent *ClubStatsResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -3128,7 +11539,7 @@ func (s *tournamentServiceServer) serveGetTournamentProtobuf(ctx context.Context
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ipc1.FullTournamentDivisions and nil error while calling GetTournament. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ClubStatsResponse and nil error while calling GetClubStats. nil responses are not supported"))
 		return
 	}
 
@@ -3152,7 +11563,7 @@ func (s *tournamentServiceServer) serveGetTournamentProtobuf(ctx context.Context
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveFinishTournament(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveUnstartTournament(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -3160,9 +11571,9 @@ func (s *tournamentServiceServer) serveFinishTournament(ctx context.Context, res
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveFinishTournamentJSON(ctx, resp, req)
+		s.serveUnstartTournamentJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveFinishTournamentProtobuf(ctx, resp, req)
+		s.serveUnstartTournamentProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -3170,9 +11581,9 @@ func (s *tournamentServiceServer) serveFinishTournament(ctx context.Context, res
 	}
 }
 
-func (s *tournamentServiceServer) serveFinishTournamentJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveUnstartTournamentJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "FinishTournament")
+	ctx = ctxsetters.WithMethodName(ctx, "UnstartTournament")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3185,23 +11596,23 @@ func (s *tournamentServiceServer) serveFinishTournamentJSON(ctx context.Context,
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(FinishTournamentRequest)
+	reqContent := new(UnstartTournamentRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.TournamentService.FinishTournament
+	handler := s.TournamentService.UnstartTournament
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *FinishTournamentRequest) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *UnstartTournamentRequest) (*TournamentResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*FinishTournamentRequest)
+					typedReq, ok := req.(*UnstartTournamentRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*FinishTournamentRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UnstartTournamentRequest) when calling interceptor")
 					}
-					return s.TournamentService.FinishTournament(ctx, typedReq)
+					return s.TournamentService.UnstartTournament(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -3227,7 +11638,7 @@ func (s *tournamentServiceServer) serveFinishTournamentJSON(ctx context.Context,
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling FinishTournament. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling UnstartTournament. nil responses are not supported"))
 		return
 	}
 
@@ -3253,9 +11664,9 @@ func (s *tournamentServiceServer) serveFinishTournamentJSON(ctx context.Context,
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveFinishTournamentProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveUnstartTournamentProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "FinishTournament")
+	ctx = ctxsetters.WithMethodName(ctx, "UnstartTournament")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3267,22 +11678,22 @@ func (s *tournamentServiceServer) serveFinishTournamentProtobuf(ctx context.Cont
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(FinishTournamentRequest)
+	reqContent := new(UnstartTournamentRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.TournamentService.FinishTournament
+	handler := s.TournamentService.UnstartTournament
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *FinishTournamentRequest) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *UnstartTournamentRequest) (*TournamentResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*FinishTournamentRequest)
+					typedReq, ok := req.(*UnstartTournamentRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*FinishTournamentRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UnstartTournamentRequest) when calling interceptor")
 					}
-					return s.TournamentService.FinishTournament(ctx, typedReq)
+					return s.TournamentService.UnstartTournament(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -3308,7 +11719,7 @@ func (s *tournamentServiceServer) serveFinishTournamentProtobuf(ctx context.Cont
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling FinishTournament. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling UnstartTournament. nil responses are not supported"))
 		return
 	}
 
@@ -3332,7 +11743,7 @@ func (s *tournamentServiceServer) serveFinishTournamentProtobuf(ctx context.Cont
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveSetTournamentMetadata(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveUncheckIn(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -3340,9 +11751,9 @@ func (s *tournamentServiceServer) serveSetTournamentMetadata(ctx context.Context
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveSetTournamentMetadataJSON(ctx, resp, req)
+		s.serveUncheckInJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveSetTournamentMetadataProtobuf(ctx, resp, req)
+		s.serveUncheckInProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -3350,9 +11761,9 @@ func (s *tournamentServiceServer) serveSetTournamentMetadata(ctx context.Context
 	}
 }
 
-func (s *tournamentServiceServer) serveSetTournamentMetadataJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveUncheckInJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SetTournamentMetadata")
+	ctx = ctxsetters.WithMethodName(ctx, "UncheckIn")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3365,23 +11776,23 @@ func (s *tournamentServiceServer) serveSetTournamentMetadataJSON(ctx context.Con
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(SetTournamentMetadataRequest)
+	reqContent := new(UncheckInRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.TournamentService.SetTournamentMetadata
+	handler := s.TournamentService.UncheckIn
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *SetTournamentMetadataRequest) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *UncheckInRequest) (*TournamentResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*SetTournamentMetadataRequest)
+					typedReq, ok := req.(*UncheckInRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*SetTournamentMetadataRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UncheckInRequest) when calling interceptor")
 					}
-					return s.TournamentService.SetTournamentMetadata(ctx, typedReq)
+					return s.TournamentService.UncheckIn(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -3407,7 +11818,7 @@ func (s *tournamentServiceServer) serveSetTournamentMetadataJSON(ctx context.Con
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetTournamentMetadata. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling UncheckIn. nil responses are not supported"))
 		return
 	}
 
@@ -3433,9 +11844,9 @@ func (s *tournamentServiceServer) serveSetTournamentMetadataJSON(ctx context.Con
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveSetTournamentMetadataProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveUncheckInProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SetTournamentMetadata")
+	ctx = ctxsetters.WithMethodName(ctx, "UncheckIn")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3447,22 +11858,22 @@ func (s *tournamentServiceServer) serveSetTournamentMetadataProtobuf(ctx context
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(SetTournamentMetadataRequest)
+	reqContent := new(UncheckInRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.TournamentService.SetTournamentMetadata
+	handler := s.TournamentService.UncheckIn
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *SetTournamentMetadataRequest) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *UncheckInRequest) (*TournamentResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*SetTournamentMetadataRequest)
+					typedReq, ok := req.(*UncheckInRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*SetTournamentMetadataRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UncheckInRequest) when calling interceptor")
 					}
-					return s.TournamentService.SetTournamentMetadata(ctx, typedReq)
+					return s.TournamentService.UncheckIn(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -3488,7 +11899,7 @@ func (s *tournamentServiceServer) serveSetTournamentMetadataProtobuf(ctx context
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetTournamentMetadata. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling UncheckIn. nil responses are not supported"))
 		return
 	}
 
@@ -3512,7 +11923,7 @@ func (s *tournamentServiceServer) serveSetTournamentMetadataProtobuf(ctx context
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) servePairRound(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveCheckIn(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -3520,9 +11931,9 @@ func (s *tournamentServiceServer) servePairRound(ctx context.Context, resp http.
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.servePairRoundJSON(ctx, resp, req)
+		s.serveCheckInJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.servePairRoundProtobuf(ctx, resp, req)
+		s.serveCheckInProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -3530,9 +11941,9 @@ func (s *tournamentServiceServer) servePairRound(ctx context.Context, resp http.
 	}
 }
 
-func (s *tournamentServiceServer) servePairRoundJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveCheckInJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "PairRound")
+	ctx = ctxsetters.WithMethodName(ctx, "CheckIn")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3545,23 +11956,23 @@ func (s *tournamentServiceServer) servePairRoundJSON(ctx context.Context, resp h
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(PairRoundRequest)
+	reqContent := new(CheckinRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.TournamentService.PairRound
+	handler := s.TournamentService.CheckIn
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *PairRoundRequest) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *CheckinRequest) (*TournamentResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*PairRoundRequest)
+					typedReq, ok := req.(*CheckinRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*PairRoundRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*CheckinRequest) when calling interceptor")
 					}
-					return s.TournamentService.PairRound(ctx, typedReq)
+					return s.TournamentService.CheckIn(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -3587,7 +11998,7 @@ func (s *tournamentServiceServer) servePairRoundJSON(ctx context.Context, resp h
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling PairRound. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling CheckIn. nil responses are not supported"))
 		return
 	}
 
@@ -3613,9 +12024,9 @@ func (s *tournamentServiceServer) servePairRoundJSON(ctx context.Context, resp h
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) servePairRoundProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveCheckInProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "PairRound")
+	ctx = ctxsetters.WithMethodName(ctx, "CheckIn")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3627,22 +12038,22 @@ func (s *tournamentServiceServer) servePairRoundProtobuf(ctx context.Context, re
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(PairRoundRequest)
+	reqContent := new(CheckinRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.TournamentService.PairRound
+	handler := s.TournamentService.CheckIn
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *PairRoundRequest) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *CheckinRequest) (*TournamentResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*PairRoundRequest)
+					typedReq, ok := req.(*CheckinRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*PairRoundRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*CheckinRequest) when calling interceptor")
 					}
-					return s.TournamentService.PairRound(ctx, typedReq)
+					return s.TournamentService.CheckIn(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -3668,7 +12079,7 @@ func (s *tournamentServiceServer) servePairRoundProtobuf(ctx context.Context, re
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling PairRound. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling CheckIn. nil responses are not supported"))
 		return
 	}
 
@@ -3692,7 +12103,7 @@ func (s *tournamentServiceServer) servePairRoundProtobuf(ctx context.Context, re
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveSetSingleRoundControls(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveGetBracket(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -3700,9 +12111,9 @@ func (s *tournamentServiceServer) serveSetSingleRoundControls(ctx context.Contex
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveSetSingleRoundControlsJSON(ctx, resp, req)
+		s.serveGetBracketJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveSetSingleRoundControlsProtobuf(ctx, resp, req)
+		s.serveGetBracketProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -3710,9 +12121,9 @@ func (s *tournamentServiceServer) serveSetSingleRoundControls(ctx context.Contex
 	}
 }
 
-func (s *tournamentServiceServer) serveSetSingleRoundControlsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveGetBracketJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SetSingleRoundControls")
+	ctx = ctxsetters.WithMethodName(ctx, "GetBracket")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3725,29 +12136,29 @@ func (s *tournamentServiceServer) serveSetSingleRoundControlsJSON(ctx context.Co
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(SingleRoundControlsRequest)
+	reqContent := new(TournamentDivisionRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.TournamentService.SetSingleRoundControls
+	handler := s.TournamentService.GetBracket
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *SingleRoundControlsRequest) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *TournamentDivisionRequest) (*ipc1.BracketResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*SingleRoundControlsRequest)
+					typedReq, ok := req.(*TournamentDivisionRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*SingleRoundControlsRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*TournamentDivisionRequest) when calling interceptor")
 					}
-					return s.TournamentService.SetSingleRoundControls(ctx, typedReq)
+					return s.TournamentService.GetBracket(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentResponse)
+				typedResp, ok := resp.(*ipc1.BracketResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ipc1.BracketResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -3756,7 +12167,7 @@ func (s *tournamentServiceServer) serveSetSingleRoundControlsJSON(ctx context.Co
 	}
 
 	// Call service method
-	var respContent *TournamentResponse
+	var respContent *ipc1.BracketResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -3767,7 +12178,7 @@ func (s *tournamentServiceServer) serveSetSingleRoundControlsJSON(ctx context.Co
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetSingleRoundControls. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ipc1.BracketResponse and nil error while calling GetBracket. nil responses are not supported"))
 		return
 	}
 
@@ -3793,9 +12204,9 @@ func (s *tournamentServiceServer) serveSetSingleRoundControlsJSON(ctx context.Co
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveSetSingleRoundControlsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveGetBracketProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SetSingleRoundControls")
+	ctx = ctxsetters.WithMethodName(ctx, "GetBracket")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3807,28 +12218,28 @@ func (s *tournamentServiceServer) serveSetSingleRoundControlsProtobuf(ctx contex
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(SingleRoundControlsRequest)
+	reqContent := new(TournamentDivisionRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.TournamentService.SetSingleRoundControls
+	handler := s.TournamentService.GetBracket
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *SingleRoundControlsRequest) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *TournamentDivisionRequest) (*ipc1.BracketResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*SingleRoundControlsRequest)
+					typedReq, ok := req.(*TournamentDivisionRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*SingleRoundControlsRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*TournamentDivisionRequest) when calling interceptor")
 					}
-					return s.TournamentService.SetSingleRoundControls(ctx, typedReq)
+					return s.TournamentService.GetBracket(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentResponse)
+				typedResp, ok := resp.(*ipc1.BracketResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ipc1.BracketResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -3837,7 +12248,7 @@ func (s *tournamentServiceServer) serveSetSingleRoundControlsProtobuf(ctx contex
 	}
 
 	// Call service method
-	var respContent *TournamentResponse
+	var respContent *ipc1.BracketResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -3848,7 +12259,7 @@ func (s *tournamentServiceServer) serveSetSingleRoundControlsProtobuf(ctx contex
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetSingleRoundControls. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ipc1.BracketResponse and nil error while calling GetBracket. nil responses are not supported"))
 		return
 	}
 
@@ -3872,7 +12283,7 @@ func (s *tournamentServiceServer) serveSetSingleRoundControlsProtobuf(ctx contex
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveSetRoundControls(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveRegister(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -3880,9 +12291,9 @@ func (s *tournamentServiceServer) serveSetRoundControls(ctx context.Context, res
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveSetRoundControlsJSON(ctx, resp, req)
+		s.serveRegisterJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveSetRoundControlsProtobuf(ctx, resp, req)
+		s.serveRegisterProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -3890,9 +12301,9 @@ func (s *tournamentServiceServer) serveSetRoundControls(ctx context.Context, res
 	}
 }
 
-func (s *tournamentServiceServer) serveSetRoundControlsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveRegisterJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SetRoundControls")
+	ctx = ctxsetters.WithMethodName(ctx, "Register")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3905,29 +12316,29 @@ func (s *tournamentServiceServer) serveSetRoundControlsJSON(ctx context.Context,
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(ipc1.DivisionRoundControls)
+	reqContent := new(RegisterRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.TournamentService.SetRoundControls
+	handler := s.TournamentService.Register
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ipc1.DivisionRoundControls) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *RegisterRequest) (*RegisterResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ipc1.DivisionRoundControls)
+					typedReq, ok := req.(*RegisterRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ipc1.DivisionRoundControls) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterRequest) when calling interceptor")
 					}
-					return s.TournamentService.SetRoundControls(ctx, typedReq)
+					return s.TournamentService.Register(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentResponse)
+				typedResp, ok := resp.(*RegisterResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*RegisterResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -3936,7 +12347,7 @@ func (s *tournamentServiceServer) serveSetRoundControlsJSON(ctx context.Context,
 	}
 
 	// Call service method
-	var respContent *TournamentResponse
+	var respContent *RegisterResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -3947,7 +12358,7 @@ func (s *tournamentServiceServer) serveSetRoundControlsJSON(ctx context.Context,
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetRoundControls. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RegisterResponse and nil error while calling Register. nil responses are not supported"))
 		return
 	}
 
@@ -3973,9 +12384,9 @@ func (s *tournamentServiceServer) serveSetRoundControlsJSON(ctx context.Context,
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveSetRoundControlsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveRegisterProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SetRoundControls")
+	ctx = ctxsetters.WithMethodName(ctx, "Register")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3987,28 +12398,28 @@ func (s *tournamentServiceServer) serveSetRoundControlsProtobuf(ctx context.Cont
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(ipc1.DivisionRoundControls)
+	reqContent := new(RegisterRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.TournamentService.SetRoundControls
+	handler := s.TournamentService.Register
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ipc1.DivisionRoundControls) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *RegisterRequest) (*RegisterResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ipc1.DivisionRoundControls)
+					typedReq, ok := req.(*RegisterRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ipc1.DivisionRoundControls) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterRequest) when calling interceptor")
 					}
-					return s.TournamentService.SetRoundControls(ctx, typedReq)
+					return s.TournamentService.Register(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentResponse)
+				typedResp, ok := resp.(*RegisterResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*RegisterResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -4017,7 +12428,7 @@ func (s *tournamentServiceServer) serveSetRoundControlsProtobuf(ctx context.Cont
 	}
 
 	// Call service method
-	var respContent *TournamentResponse
+	var respContent *RegisterResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -4028,7 +12439,7 @@ func (s *tournamentServiceServer) serveSetRoundControlsProtobuf(ctx context.Cont
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetRoundControls. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RegisterResponse and nil error while calling Register. nil responses are not supported"))
 		return
 	}
 
@@ -4052,7 +12463,7 @@ func (s *tournamentServiceServer) serveSetRoundControlsProtobuf(ctx context.Cont
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveSetDivisionControls(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveUnregister(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -4060,9 +12471,9 @@ func (s *tournamentServiceServer) serveSetDivisionControls(ctx context.Context,
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveSetDivisionControlsJSON(ctx, resp, req)
+		s.serveUnregisterJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveSetDivisionControlsProtobuf(ctx, resp, req)
+		s.serveUnregisterProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -4070,9 +12481,9 @@ func (s *tournamentServiceServer) serveSetDivisionControls(ctx context.Context,
 	}
 }
 
-func (s *tournamentServiceServer) serveSetDivisionControlsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveUnregisterJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SetDivisionControls")
+	ctx = ctxsetters.WithMethodName(ctx, "Unregister")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -4085,23 +12496,23 @@ func (s *tournamentServiceServer) serveSetDivisionControlsJSON(ctx context.Conte
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(ipc1.DivisionControls)
+	reqContent := new(RegisterRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.TournamentService.SetDivisionControls
+	handler := s.TournamentService.Unregister
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ipc1.DivisionControls) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *RegisterRequest) (*TournamentResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ipc1.DivisionControls)
+					typedReq, ok := req.(*RegisterRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ipc1.DivisionControls) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterRequest) when calling interceptor")
 					}
-					return s.TournamentService.SetDivisionControls(ctx, typedReq)
+					return s.TournamentService.Unregister(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -4127,7 +12538,7 @@ func (s *tournamentServiceServer) serveSetDivisionControlsJSON(ctx context.Conte
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetDivisionControls. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling Unregister. nil responses are not supported"))
 		return
 	}
 
@@ -4153,9 +12564,9 @@ func (s *tournamentServiceServer) serveSetDivisionControlsJSON(ctx context.Conte
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveSetDivisionControlsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveUnregisterProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SetDivisionControls")
+	ctx = ctxsetters.WithMethodName(ctx, "Unregister")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -4167,22 +12578,22 @@ func (s *tournamentServiceServer) serveSetDivisionControlsProtobuf(ctx context.C
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(ipc1.DivisionControls)
+	reqContent := new(RegisterRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.TournamentService.SetDivisionControls
+	handler := s.TournamentService.Unregister
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ipc1.DivisionControls) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *RegisterRequest) (*TournamentResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ipc1.DivisionControls)
+					typedReq, ok := req.(*RegisterRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ipc1.DivisionControls) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterRequest) when calling interceptor")
 					}
-					return s.TournamentService.SetDivisionControls(ctx, typedReq)
+					return s.TournamentService.Unregister(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -4208,7 +12619,7 @@ func (s *tournamentServiceServer) serveSetDivisionControlsProtobuf(ctx context.C
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetDivisionControls. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling Unregister. nil responses are not supported"))
 		return
 	}
 
@@ -4232,7 +12643,7 @@ func (s *tournamentServiceServer) serveSetDivisionControlsProtobuf(ctx context.C
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveAddDirectors(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveApproveRegistrations(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -4240,9 +12651,9 @@ func (s *tournamentServiceServer) serveAddDirectors(ctx context.Context, resp ht
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveAddDirectorsJSON(ctx, resp, req)
+		s.serveApproveRegistrationsJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveAddDirectorsProtobuf(ctx, resp, req)
+		s.serveApproveRegistrationsProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -4250,9 +12661,9 @@ func (s *tournamentServiceServer) serveAddDirectors(ctx context.Context, resp ht
 	}
 }
 
-func (s *tournamentServiceServer) serveAddDirectorsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveApproveRegistrationsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "AddDirectors")
+	ctx = ctxsetters.WithMethodName(ctx, "ApproveRegistrations")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -4272,7 +12683,7 @@ func (s *tournamentServiceServer) serveAddDirectorsJSON(ctx context.Context, res
 		return
 	}
 
-	handler := s.TournamentService.AddDirectors
+	handler := s.TournamentService.ApproveRegistrations
 	if s.interceptor != nil {
 		handler = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
 			resp, err := s.interceptor(
@@ -4281,7 +12692,7 @@ func (s *tournamentServiceServer) serveAddDirectorsJSON(ctx context.Context, res
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
 					}
-					return s.TournamentService.AddDirectors(ctx, typedReq)
+					return s.TournamentService.ApproveRegistrations(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -4307,7 +12718,7 @@ func (s *tournamentServiceServer) serveAddDirectorsJSON(ctx context.Context, res
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling AddDirectors. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling ApproveRegistrations. nil responses are not supported"))
 		return
 	}
 
@@ -4333,9 +12744,9 @@ func (s *tournamentServiceServer) serveAddDirectorsJSON(ctx context.Context, res
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveAddDirectorsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveApproveRegistrationsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "AddDirectors")
+	ctx = ctxsetters.WithMethodName(ctx, "ApproveRegistrations")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -4353,7 +12764,7 @@ func (s *tournamentServiceServer) serveAddDirectorsProtobuf(ctx context.Context,
 		return
 	}
 
-	handler := s.TournamentService.AddDirectors
+	handler := s.TournamentService.ApproveRegistrations
 	if s.interceptor != nil {
 		handler = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
 			resp, err := s.interceptor(
@@ -4362,7 +12773,7 @@ func (s *tournamentServiceServer) serveAddDirectorsProtobuf(ctx context.Context,
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
 					}
-					return s.TournamentService.AddDirectors(ctx, typedReq)
+					return s.TournamentService.ApproveRegistrations(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -4388,7 +12799,7 @@ func (s *tournamentServiceServer) serveAddDirectorsProtobuf(ctx context.Context,
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling AddDirectors. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling ApproveRegistrations. nil responses are not supported"))
 		return
 	}
 
@@ -4412,7 +12823,7 @@ func (s *tournamentServiceServer) serveAddDirectorsProtobuf(ctx context.Context,
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveRemoveDirectors(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveRejectRegistrations(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -4420,9 +12831,9 @@ func (s *tournamentServiceServer) serveRemoveDirectors(ctx context.Context, resp
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveRemoveDirectorsJSON(ctx, resp, req)
+		s.serveRejectRegistrationsJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveRemoveDirectorsProtobuf(ctx, resp, req)
+		s.serveRejectRegistrationsProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -4430,9 +12841,9 @@ func (s *tournamentServiceServer) serveRemoveDirectors(ctx context.Context, resp
 	}
 }
 
-func (s *tournamentServiceServer) serveRemoveDirectorsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveRejectRegistrationsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "RemoveDirectors")
+	ctx = ctxsetters.WithMethodName(ctx, "RejectRegistrations")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -4452,7 +12863,7 @@ func (s *tournamentServiceServer) serveRemoveDirectorsJSON(ctx context.Context,
 		return
 	}
 
-	handler := s.TournamentService.RemoveDirectors
+	handler := s.TournamentService.RejectRegistrations
 	if s.interceptor != nil {
 		handler = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
 			resp, err := s.interceptor(
@@ -4461,7 +12872,7 @@ func (s *tournamentServiceServer) serveRemoveDirectorsJSON(ctx context.Context,
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
 					}
-					return s.TournamentService.RemoveDirectors(ctx, typedReq)
+					return s.TournamentService.RejectRegistrations(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -4487,7 +12898,7 @@ func (s *tournamentServiceServer) serveRemoveDirectorsJSON(ctx context.Context,
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling RemoveDirectors. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling RejectRegistrations. nil responses are not supported"))
 		return
 	}
 
@@ -4513,9 +12924,9 @@ func (s *tournamentServiceServer) serveRemoveDirectorsJSON(ctx context.Context,
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveRemoveDirectorsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveRejectRegistrationsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "RemoveDirectors")
+	ctx = ctxsetters.WithMethodName(ctx, "RejectRegistrations")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -4533,7 +12944,7 @@ func (s *tournamentServiceServer) serveRemoveDirectorsProtobuf(ctx context.Conte
 		return
 	}
 
-	handler := s.TournamentService.RemoveDirectors
+	handler := s.TournamentService.RejectRegistrations
 	if s.interceptor != nil {
 		handler = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
 			resp, err := s.interceptor(
@@ -4542,7 +12953,7 @@ func (s *tournamentServiceServer) serveRemoveDirectorsProtobuf(ctx context.Conte
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
 					}
-					return s.TournamentService.RemoveDirectors(ctx, typedReq)
+					return s.TournamentService.RejectRegistrations(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -4568,7 +12979,7 @@ func (s *tournamentServiceServer) serveRemoveDirectorsProtobuf(ctx context.Conte
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling RemoveDirectors. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling RejectRegistrations. nil responses are not supported"))
 		return
 	}
 
@@ -4592,7 +13003,7 @@ func (s *tournamentServiceServer) serveRemoveDirectorsProtobuf(ctx context.Conte
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveAddDivision(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveGetRegistrations(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -4600,9 +13011,9 @@ func (s *tournamentServiceServer) serveAddDivision(ctx context.Context, resp htt
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveAddDivisionJSON(ctx, resp, req)
+		s.serveGetRegistrationsJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveAddDivisionProtobuf(ctx, resp, req)
+		s.serveGetRegistrationsProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -4610,9 +13021,9 @@ func (s *tournamentServiceServer) serveAddDivision(ctx context.Context, resp htt
 	}
 }
 
-func (s *tournamentServiceServer) serveAddDivisionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveGetRegistrationsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "AddDivision")
+	ctx = ctxsetters.WithMethodName(ctx, "GetRegistrations")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -4632,22 +13043,22 @@ func (s *tournamentServiceServer) serveAddDivisionJSON(ctx context.Context, resp
 		return
 	}
 
-	handler := s.TournamentService.AddDivision
+	handler := s.TournamentService.GetRegistrations
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *TournamentDivisionRequest) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *TournamentDivisionRequest) (*DivisionRegistrationsResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
 					typedReq, ok := req.(*TournamentDivisionRequest)
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*TournamentDivisionRequest) when calling interceptor")
 					}
-					return s.TournamentService.AddDivision(ctx, typedReq)
+					return s.TournamentService.GetRegistrations(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentResponse)
+				typedResp, ok := resp.(*DivisionRegistrationsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*DivisionRegistrationsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -4656,7 +13067,7 @@ func (s *tournamentServiceServer) serveAddDivisionJSON(ctx context.Context, resp
 	}
 
 	// Call service method
-	var respContent *TournamentResponse
+	var respContent *DivisionRegistrationsResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -4667,7 +13078,7 @@ func (s *tournamentServiceServer) serveAddDivisionJSON(ctx context.Context, resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling AddDivision. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DivisionRegistrationsResponse and nil error while calling GetRegistrations. nil responses are not supported"))
 		return
 	}
 
@@ -4693,9 +13104,9 @@ func (s *tournamentServiceServer) serveAddDivisionJSON(ctx context.Context, resp
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveAddDivisionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveGetRegistrationsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "AddDivision")
+	ctx = ctxsetters.WithMethodName(ctx, "GetRegistrations")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -4713,22 +13124,22 @@ func (s *tournamentServiceServer) serveAddDivisionProtobuf(ctx context.Context,
 		return
 	}
 
-	handler := s.TournamentService.AddDivision
+	handler := s.TournamentService.GetRegistrations
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *TournamentDivisionRequest) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *TournamentDivisionRequest) (*DivisionRegistrationsResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
 					typedReq, ok := req.(*TournamentDivisionRequest)
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*TournamentDivisionRequest) when calling interceptor")
 					}
-					return s.TournamentService.AddDivision(ctx, typedReq)
+					return s.TournamentService.GetRegistrations(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentResponse)
+				typedResp, ok := resp.(*DivisionRegistrationsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*DivisionRegistrationsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -4737,7 +13148,7 @@ func (s *tournamentServiceServer) serveAddDivisionProtobuf(ctx context.Context,
 	}
 
 	// Call service method
-	var respContent *TournamentResponse
+	var respContent *DivisionRegistrationsResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -4748,7 +13159,7 @@ func (s *tournamentServiceServer) serveAddDivisionProtobuf(ctx context.Context,
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling AddDivision. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DivisionRegistrationsResponse and nil error while calling GetRegistrations. nil responses are not supported"))
 		return
 	}
 
@@ -4772,7 +13183,7 @@ func (s *tournamentServiceServer) serveAddDivisionProtobuf(ctx context.Context,
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveRemoveDivision(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveGetAuditLog(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -4780,9 +13191,9 @@ func (s *tournamentServiceServer) serveRemoveDivision(ctx context.Context, resp
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveRemoveDivisionJSON(ctx, resp, req)
+		s.serveGetAuditLogJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveRemoveDivisionProtobuf(ctx, resp, req)
+		s.serveGetAuditLogProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -4790,9 +13201,9 @@ func (s *tournamentServiceServer) serveRemoveDivision(ctx context.Context, resp
 	}
 }
 
-func (s *tournamentServiceServer) serveRemoveDivisionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveGetAuditLogJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "RemoveDivision")
+	ctx = ctxsetters.WithMethodName(ctx, "GetAuditLog")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -4805,29 +13216,29 @@ func (s *tournamentServiceServer) serveRemoveDivisionJSON(ctx context.Context, r
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(TournamentDivisionRequest)
+	reqContent := new(AuditLogRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.TournamentService.RemoveDivision
+	handler := s.TournamentService.GetAuditLog
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *TournamentDivisionRequest) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *AuditLogRequest) (*AuditLogResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*TournamentDivisionRequest)
+					typedReq, ok := req.(*AuditLogRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*TournamentDivisionRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*AuditLogRequest) when calling interceptor")
 					}
-					return s.TournamentService.RemoveDivision(ctx, typedReq)
+					return s.TournamentService.GetAuditLog(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentResponse)
+				typedResp, ok := resp.(*AuditLogResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*AuditLogResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -4836,7 +13247,7 @@ func (s *tournamentServiceServer) serveRemoveDivisionJSON(ctx context.Context, r
 	}
 
 	// Call service method
-	var respContent *TournamentResponse
+	var respContent *AuditLogResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -4847,7 +13258,7 @@ func (s *tournamentServiceServer) serveRemoveDivisionJSON(ctx context.Context, r
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling RemoveDivision. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AuditLogResponse and nil error while calling GetAuditLog. nil responses are not supported"))
 		return
 	}
 
@@ -4873,9 +13284,9 @@ func (s *tournamentServiceServer) serveRemoveDivisionJSON(ctx context.Context, r
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveRemoveDivisionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveGetAuditLogProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "RemoveDivision")
+	ctx = ctxsetters.WithMethodName(ctx, "GetAuditLog")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -4887,28 +13298,28 @@ func (s *tournamentServiceServer) serveRemoveDivisionProtobuf(ctx context.Contex
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(TournamentDivisionRequest)
+	reqContent := new(AuditLogRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.TournamentService.RemoveDivision
+	handler := s.TournamentService.GetAuditLog
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *TournamentDivisionRequest) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *AuditLogRequest) (*AuditLogResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*TournamentDivisionRequest)
+					typedReq, ok := req.(*AuditLogRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*TournamentDivisionRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*AuditLogRequest) when calling interceptor")
 					}
-					return s.TournamentService.RemoveDivision(ctx, typedReq)
+					return s.TournamentService.GetAuditLog(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentResponse)
+				typedResp, ok := resp.(*AuditLogResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*AuditLogResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -4917,7 +13328,7 @@ func (s *tournamentServiceServer) serveRemoveDivisionProtobuf(ctx context.Contex
 	}
 
 	// Call service method
-	var respContent *TournamentResponse
+	var respContent *AuditLogResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -4928,7 +13339,7 @@ func (s *tournamentServiceServer) serveRemoveDivisionProtobuf(ctx context.Contex
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling RemoveDivision. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AuditLogResponse and nil error while calling GetAuditLog. nil responses are not supported"))
 		return
 	}
 
@@ -4952,7 +13363,7 @@ func (s *tournamentServiceServer) serveRemoveDivisionProtobuf(ctx context.Contex
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveAddPlayers(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveGetPairingReport(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -4960,9 +13371,9 @@ func (s *tournamentServiceServer) serveAddPlayers(ctx context.Context, resp http
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveAddPlayersJSON(ctx, resp, req)
+		s.serveGetPairingReportJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveAddPlayersProtobuf(ctx, resp, req)
+		s.serveGetPairingReportProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -4970,9 +13381,9 @@ func (s *tournamentServiceServer) serveAddPlayers(ctx context.Context, resp http
 	}
 }
 
-func (s *tournamentServiceServer) serveAddPlayersJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveGetPairingReportJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "AddPlayers")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPairingReport")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -4985,29 +13396,29 @@ func (s *tournamentServiceServer) serveAddPlayersJSON(ctx context.Context, resp
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(ipc1.TournamentPersons)
+	reqContent := new(TournamentDivisionRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.TournamentService.AddPlayers
+	handler := s.TournamentService.GetPairingReport
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *TournamentDivisionRequest) (*ipc1.PairingReport, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ipc1.TournamentPersons)
+					typedReq, ok := req.(*TournamentDivisionRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*TournamentDivisionRequest) when calling interceptor")
 					}
-					return s.TournamentService.AddPlayers(ctx, typedReq)
+					return s.TournamentService.GetPairingReport(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentResponse)
+				typedResp, ok := resp.(*ipc1.PairingReport)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ipc1.PairingReport) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -5016,7 +13427,7 @@ func (s *tournamentServiceServer) serveAddPlayersJSON(ctx context.Context, resp
 	}
 
 	// Call service method
-	var respContent *TournamentResponse
+	var respContent *ipc1.PairingReport
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -5027,7 +13438,7 @@ func (s *tournamentServiceServer) serveAddPlayersJSON(ctx context.Context, resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling AddPlayers. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ipc1.PairingReport and nil error while calling GetPairingReport. nil responses are not supported"))
 		return
 	}
 
@@ -5053,9 +13464,9 @@ func (s *tournamentServiceServer) serveAddPlayersJSON(ctx context.Context, resp
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveAddPlayersProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveGetPairingReportProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "AddPlayers")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPairingReport")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -5067,28 +13478,28 @@ func (s *tournamentServiceServer) serveAddPlayersProtobuf(ctx context.Context, r
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(ipc1.TournamentPersons)
+	reqContent := new(TournamentDivisionRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.TournamentService.AddPlayers
+	handler := s.TournamentService.GetPairingReport
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *TournamentDivisionRequest) (*ipc1.PairingReport, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ipc1.TournamentPersons)
+					typedReq, ok := req.(*TournamentDivisionRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*TournamentDivisionRequest) when calling interceptor")
 					}
-					return s.TournamentService.AddPlayers(ctx, typedReq)
+					return s.TournamentService.GetPairingReport(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentResponse)
+				typedResp, ok := resp.(*ipc1.PairingReport)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ipc1.PairingReport) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -5097,7 +13508,7 @@ func (s *tournamentServiceServer) serveAddPlayersProtobuf(ctx context.Context, r
 	}
 
 	// Call service method
-	var respContent *TournamentResponse
+	var respContent *ipc1.PairingReport
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -5108,7 +13519,7 @@ func (s *tournamentServiceServer) serveAddPlayersProtobuf(ctx context.Context, r
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling AddPlayers. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ipc1.PairingReport and nil error while calling GetPairingReport. nil responses are not supported"))
 		return
 	}
 
@@ -5132,7 +13543,7 @@ func (s *tournamentServiceServer) serveAddPlayersProtobuf(ctx context.Context, r
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveRemovePlayers(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveGetRatingPeriodPreview(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -5140,9 +13551,9 @@ func (s *tournamentServiceServer) serveRemovePlayers(ctx context.Context, resp h
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveRemovePlayersJSON(ctx, resp, req)
+		s.serveGetRatingPeriodPreviewJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveRemovePlayersProtobuf(ctx, resp, req)
+		s.serveGetRatingPeriodPreviewProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -5150,9 +13561,9 @@ func (s *tournamentServiceServer) serveRemovePlayers(ctx context.Context, resp h
 	}
 }
 
-func (s *tournamentServiceServer) serveRemovePlayersJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveGetRatingPeriodPreviewJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "RemovePlayers")
+	ctx = ctxsetters.WithMethodName(ctx, "GetRatingPeriodPreview")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -5165,29 +13576,29 @@ func (s *tournamentServiceServer) serveRemovePlayersJSON(ctx context.Context, re
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(ipc1.TournamentPersons)
+	reqContent := new(TournamentDivisionRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.TournamentService.RemovePlayers
+	handler := s.TournamentService.GetRatingPeriodPreview
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *TournamentDivisionRequest) (*RatingPeriodResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ipc1.TournamentPersons)
+					typedReq, ok := req.(*TournamentDivisionRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*TournamentDivisionRequest) when calling interceptor")
 					}
-					return s.TournamentService.RemovePlayers(ctx, typedReq)
+					return s.TournamentService.GetRatingPeriodPreview(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentResponse)
+				typedResp, ok := resp.(*RatingPeriodResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*RatingPeriodResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -5196,7 +13607,7 @@ func (s *tournamentServiceServer) serveRemovePlayersJSON(ctx context.Context, re
 	}
 
 	// Call service method
-	var respContent *TournamentResponse
+	var respContent *RatingPeriodResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -5207,7 +13618,7 @@ func (s *tournamentServiceServer) serveRemovePlayersJSON(ctx context.Context, re
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling RemovePlayers. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RatingPeriodResponse and nil error while calling GetRatingPeriodPreview. nil responses are not supported"))
 		return
 	}
 
@@ -5233,9 +13644,9 @@ func (s *tournamentServiceServer) serveRemovePlayersJSON(ctx context.Context, re
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveRemovePlayersProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveGetRatingPeriodPreviewProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "RemovePlayers")
+	ctx = ctxsetters.WithMethodName(ctx, "GetRatingPeriodPreview")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -5247,28 +13658,28 @@ func (s *tournamentServiceServer) serveRemovePlayersProtobuf(ctx context.Context
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(ipc1.TournamentPersons)
+	reqContent := new(TournamentDivisionRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.TournamentService.RemovePlayers
+	handler := s.TournamentService.GetRatingPeriodPreview
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *TournamentDivisionRequest) (*RatingPeriodResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ipc1.TournamentPersons)
+					typedReq, ok := req.(*TournamentDivisionRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*TournamentDivisionRequest) when calling interceptor")
 					}
-					return s.TournamentService.RemovePlayers(ctx, typedReq)
+					return s.TournamentService.GetRatingPeriodPreview(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentResponse)
+				typedResp, ok := resp.(*RatingPeriodResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*RatingPeriodResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -5277,7 +13688,7 @@ func (s *tournamentServiceServer) serveRemovePlayersProtobuf(ctx context.Context
 	}
 
 	// Call service method
-	var respContent *TournamentResponse
+	var respContent *RatingPeriodResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -5288,7 +13699,7 @@ func (s *tournamentServiceServer) serveRemovePlayersProtobuf(ctx context.Context
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling RemovePlayers. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RatingPeriodResponse and nil error while calling GetRatingPeriodPreview. nil responses are not supported"))
 		return
 	}
 
@@ -5312,7 +13723,7 @@ func (s *tournamentServiceServer) serveRemovePlayersProtobuf(ctx context.Context
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveSetPairing(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveCloneTournament(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -5320,9 +13731,9 @@ func (s *tournamentServiceServer) serveSetPairing(ctx context.Context, resp http
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveSetPairingJSON(ctx, resp, req)
+		s.serveCloneTournamentJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveSetPairingProtobuf(ctx, resp, req)
+		s.serveCloneTournamentProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -5330,9 +13741,9 @@ func (s *tournamentServiceServer) serveSetPairing(ctx context.Context, resp http
 	}
 }
 
-func (s *tournamentServiceServer) serveSetPairingJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveCloneTournamentJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SetPairing")
+	ctx = ctxsetters.WithMethodName(ctx, "CloneTournament")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -5345,29 +13756,29 @@ func (s *tournamentServiceServer) serveSetPairingJSON(ctx context.Context, resp
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(TournamentPairingsRequest)
+	reqContent := new(CloneTournamentRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.TournamentService.SetPairing
+	handler := s.TournamentService.CloneTournament
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *TournamentPairingsRequest) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *CloneTournamentRequest) (*NewTournamentResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*TournamentPairingsRequest)
+					typedReq, ok := req.(*CloneTournamentRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*TournamentPairingsRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*CloneTournamentRequest) when calling interceptor")
 					}
-					return s.TournamentService.SetPairing(ctx, typedReq)
+					return s.TournamentService.CloneTournament(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentResponse)
+				typedResp, ok := resp.(*NewTournamentResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*NewTournamentResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -5376,7 +13787,7 @@ func (s *tournamentServiceServer) serveSetPairingJSON(ctx context.Context, resp
 	}
 
 	// Call service method
-	var respContent *TournamentResponse
+	var respContent *NewTournamentResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -5387,7 +13798,7 @@ func (s *tournamentServiceServer) serveSetPairingJSON(ctx context.Context, resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetPairing. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *NewTournamentResponse and nil error while calling CloneTournament. nil responses are not supported"))
 		return
 	}
 
@@ -5413,9 +13824,9 @@ func (s *tournamentServiceServer) serveSetPairingJSON(ctx context.Context, resp
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveSetPairingProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveCloneTournamentProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SetPairing")
+	ctx = ctxsetters.WithMethodName(ctx, "CloneTournament")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -5427,28 +13838,28 @@ func (s *tournamentServiceServer) serveSetPairingProtobuf(ctx context.Context, r
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(TournamentPairingsRequest)
+	reqContent := new(CloneTournamentRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.TournamentService.SetPairing
+	handler := s.TournamentService.CloneTournament
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *TournamentPairingsRequest) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *CloneTournamentRequest) (*NewTournamentResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*TournamentPairingsRequest)
+					typedReq, ok := req.(*CloneTournamentRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*TournamentPairingsRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*CloneTournamentRequest) when calling interceptor")
 					}
-					return s.TournamentService.SetPairing(ctx, typedReq)
+					return s.TournamentService.CloneTournament(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentResponse)
+				typedResp, ok := resp.(*NewTournamentResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*NewTournamentResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -5457,7 +13868,7 @@ func (s *tournamentServiceServer) serveSetPairingProtobuf(ctx context.Context, r
 	}
 
 	// Call service method
-	var respContent *TournamentResponse
+	var respContent *NewTournamentResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -5468,7 +13879,7 @@ func (s *tournamentServiceServer) serveSetPairingProtobuf(ctx context.Context, r
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetPairing. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *NewTournamentResponse and nil error while calling CloneTournament. nil responses are not supported"))
 		return
 	}
 
@@ -5492,7 +13903,7 @@ func (s *tournamentServiceServer) serveSetPairingProtobuf(ctx context.Context, r
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveSetResult(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveCreateSnapshot(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -5500,9 +13911,9 @@ func (s *tournamentServiceServer) serveSetResult(ctx context.Context, resp http.
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveSetResultJSON(ctx, resp, req)
+		s.serveCreateSnapshotJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveSetResultProtobuf(ctx, resp, req)
+		s.serveCreateSnapshotProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -5510,9 +13921,9 @@ func (s *tournamentServiceServer) serveSetResult(ctx context.Context, resp http.
 	}
 }
 
-func (s *tournamentServiceServer) serveSetResultJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveCreateSnapshotJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SetResult")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateSnapshot")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -5525,29 +13936,29 @@ func (s *tournamentServiceServer) serveSetResultJSON(ctx context.Context, resp h
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(TournamentResultOverrideRequest)
+	reqContent := new(SnapshotRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.TournamentService.SetResult
+	handler := s.TournamentService.CreateSnapshot
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *TournamentResultOverrideRequest) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *SnapshotRequest) (*TournamentSnapshot, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*TournamentResultOverrideRequest)
+					typedReq, ok := req.(*SnapshotRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*TournamentResultOverrideRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*SnapshotRequest) when calling interceptor")
 					}
-					return s.TournamentService.SetResult(ctx, typedReq)
+					return s.TournamentService.CreateSnapshot(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentResponse)
+				typedResp, ok := resp.(*TournamentSnapshot)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentSnapshot) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -5556,7 +13967,7 @@ func (s *tournamentServiceServer) serveSetResultJSON(ctx context.Context, resp h
 	}
 
 	// Call service method
-	var respContent *TournamentResponse
+	var respContent *TournamentSnapshot
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -5567,7 +13978,7 @@ func (s *tournamentServiceServer) serveSetResultJSON(ctx context.Context, resp h
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetResult. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentSnapshot and nil error while calling CreateSnapshot. nil responses are not supported"))
 		return
 	}
 
@@ -5593,9 +14004,9 @@ func (s *tournamentServiceServer) serveSetResultJSON(ctx context.Context, resp h
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveSetResultProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveCreateSnapshotProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SetResult")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateSnapshot")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -5607,28 +14018,28 @@ func (s *tournamentServiceServer) serveSetResultProtobuf(ctx context.Context, re
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(TournamentResultOverrideRequest)
+	reqContent := new(SnapshotRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.TournamentService.SetResult
+	handler := s.TournamentService.CreateSnapshot
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *TournamentResultOverrideRequest) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *SnapshotRequest) (*TournamentSnapshot, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*TournamentResultOverrideRequest)
+					typedReq, ok := req.(*SnapshotRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*TournamentResultOverrideRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*SnapshotRequest) when calling interceptor")
 					}
-					return s.TournamentService.SetResult(ctx, typedReq)
+					return s.TournamentService.CreateSnapshot(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentResponse)
+				typedResp, ok := resp.(*TournamentSnapshot)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentSnapshot) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -5637,7 +14048,7 @@ func (s *tournamentServiceServer) serveSetResultProtobuf(ctx context.Context, re
 	}
 
 	// Call service method
-	var respContent *TournamentResponse
+	var respContent *TournamentSnapshot
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -5648,7 +14059,7 @@ func (s *tournamentServiceServer) serveSetResultProtobuf(ctx context.Context, re
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetResult. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentSnapshot and nil error while calling CreateSnapshot. nil responses are not supported"))
 		return
 	}
 
@@ -5672,7 +14083,7 @@ func (s *tournamentServiceServer) serveSetResultProtobuf(ctx context.Context, re
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveStartRoundCountdown(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveGetSnapshots(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -5680,9 +14091,9 @@ func (s *tournamentServiceServer) serveStartRoundCountdown(ctx context.Context,
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveStartRoundCountdownJSON(ctx, resp, req)
+		s.serveGetSnapshotsJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveStartRoundCountdownProtobuf(ctx, resp, req)
+		s.serveGetSnapshotsProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -5690,9 +14101,9 @@ func (s *tournamentServiceServer) serveStartRoundCountdown(ctx context.Context,
 	}
 }
 
-func (s *tournamentServiceServer) serveStartRoundCountdownJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveGetSnapshotsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "StartRoundCountdown")
+	ctx = ctxsetters.WithMethodName(ctx, "GetSnapshots")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -5705,29 +14116,29 @@ func (s *tournamentServiceServer) serveStartRoundCountdownJSON(ctx context.Conte
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(TournamentStartRoundCountdownRequest)
+	reqContent := new(GetTournamentRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.TournamentService.StartRoundCountdown
+	handler := s.TournamentService.GetSnapshots
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *TournamentStartRoundCountdownRequest) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *GetTournamentRequest) (*SnapshotsResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*TournamentStartRoundCountdownRequest)
+					typedReq, ok := req.(*GetTournamentRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*TournamentStartRoundCountdownRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetTournamentRequest) when calling interceptor")
 					}
-					return s.TournamentService.StartRoundCountdown(ctx, typedReq)
+					return s.TournamentService.GetSnapshots(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentResponse)
+				typedResp, ok := resp.(*SnapshotsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SnapshotsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -5736,7 +14147,7 @@ func (s *tournamentServiceServer) serveStartRoundCountdownJSON(ctx context.Conte
 	}
 
 	// Call service method
-	var respContent *TournamentResponse
+	var respContent *SnapshotsResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -5747,7 +14158,7 @@ func (s *tournamentServiceServer) serveStartRoundCountdownJSON(ctx context.Conte
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling StartRoundCountdown. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SnapshotsResponse and nil error while calling GetSnapshots. nil responses are not supported"))
 		return
 	}
 
@@ -5773,9 +14184,9 @@ func (s *tournamentServiceServer) serveStartRoundCountdownJSON(ctx context.Conte
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveStartRoundCountdownProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveGetSnapshotsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "StartRoundCountdown")
+	ctx = ctxsetters.WithMethodName(ctx, "GetSnapshots")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -5787,28 +14198,28 @@ func (s *tournamentServiceServer) serveStartRoundCountdownProtobuf(ctx context.C
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(TournamentStartRoundCountdownRequest)
+	reqContent := new(GetTournamentRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.TournamentService.StartRoundCountdown
+	handler := s.TournamentService.GetSnapshots
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *TournamentStartRoundCountdownRequest) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *GetTournamentRequest) (*SnapshotsResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*TournamentStartRoundCountdownRequest)
+					typedReq, ok := req.(*GetTournamentRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*TournamentStartRoundCountdownRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetTournamentRequest) when calling interceptor")
 					}
-					return s.TournamentService.StartRoundCountdown(ctx, typedReq)
+					return s.TournamentService.GetSnapshots(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*TournamentResponse)
+				typedResp, ok := resp.(*SnapshotsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SnapshotsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -5817,7 +14228,7 @@ func (s *tournamentServiceServer) serveStartRoundCountdownProtobuf(ctx context.C
 	}
 
 	// Call service method
-	var respContent *TournamentResponse
+	var respContent *SnapshotsResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -5828,7 +14239,7 @@ func (s *tournamentServiceServer) serveStartRoundCountdownProtobuf(ctx context.C
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling StartRoundCountdown. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SnapshotsResponse and nil error while calling GetSnapshots. nil responses are not supported"))
 		return
 	}
 
@@ -5852,7 +14263,7 @@ func (s *tournamentServiceServer) serveStartRoundCountdownProtobuf(ctx context.C
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveRecentGames(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveRestoreSnapshot(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -5860,9 +14271,9 @@ func (s *tournamentServiceServer) serveRecentGames(ctx context.Context, resp htt
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveRecentGamesJSON(ctx, resp, req)
+		s.serveRestoreSnapshotJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveRecentGamesProtobuf(ctx, resp, req)
+		s.serveRestoreSnapshotProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -5870,9 +14281,9 @@ func (s *tournamentServiceServer) serveRecentGames(ctx context.Context, resp htt
 	}
 }
 
-func (s *tournamentServiceServer) serveRecentGamesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveRestoreSnapshotJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "RecentGames")
+	ctx = ctxsetters.WithMethodName(ctx, "RestoreSnapshot")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -5885,29 +14296,29 @@ func (s *tournamentServiceServer) serveRecentGamesJSON(ctx context.Context, resp
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(RecentGamesRequest)
+	reqContent := new(RestoreSnapshotRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.TournamentService.RecentGames
+	handler := s.TournamentService.RestoreSnapshot
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *RecentGamesRequest) (*RecentGamesResponse, error) {
+		handler = func(ctx context.Context, req *RestoreSnapshotRequest) (*TournamentResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*RecentGamesRequest)
+					typedReq, ok := req.(*RestoreSnapshotRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*RecentGamesRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RestoreSnapshotRequest) when calling interceptor")
 					}
-					return s.TournamentService.RecentGames(ctx, typedReq)
+					return s.TournamentService.RestoreSnapshot(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*RecentGamesResponse)
+				typedResp, ok := resp.(*TournamentResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*RecentGamesResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -5916,7 +14327,7 @@ func (s *tournamentServiceServer) serveRecentGamesJSON(ctx context.Context, resp
 	}
 
 	// Call service method
-	var respContent *RecentGamesResponse
+	var respContent *TournamentResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -5927,7 +14338,7 @@ func (s *tournamentServiceServer) serveRecentGamesJSON(ctx context.Context, resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *RecentGamesResponse and nil error while calling RecentGames. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling RestoreSnapshot. nil responses are not supported"))
 		return
 	}
 
@@ -5953,9 +14364,9 @@ func (s *tournamentServiceServer) serveRecentGamesJSON(ctx context.Context, resp
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveRecentGamesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveRestoreSnapshotProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "RecentGames")
+	ctx = ctxsetters.WithMethodName(ctx, "RestoreSnapshot")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -5967,28 +14378,28 @@ func (s *tournamentServiceServer) serveRecentGamesProtobuf(ctx context.Context,
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(RecentGamesRequest)
+	reqContent := new(RestoreSnapshotRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.TournamentService.RecentGames
+	handler := s.TournamentService.RestoreSnapshot
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *RecentGamesRequest) (*RecentGamesResponse, error) {
+		handler = func(ctx context.Context, req *RestoreSnapshotRequest) (*TournamentResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*RecentGamesRequest)
+					typedReq, ok := req.(*RestoreSnapshotRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*RecentGamesRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RestoreSnapshotRequest) when calling interceptor")
 					}
-					return s.TournamentService.RecentGames(ctx, typedReq)
+					return s.TournamentService.RestoreSnapshot(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*RecentGamesResponse)
+				typedResp, ok := resp.(*TournamentResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*RecentGamesResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -5997,7 +14408,7 @@ func (s *tournamentServiceServer) serveRecentGamesProtobuf(ctx context.Context,
 	}
 
 	// Call service method
-	var respContent *RecentGamesResponse
+	var respContent *TournamentResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -6008,7 +14419,7 @@ func (s *tournamentServiceServer) serveRecentGamesProtobuf(ctx context.Context,
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *RecentGamesResponse and nil error while calling RecentGames. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling RestoreSnapshot. nil responses are not supported"))
 		return
 	}
 
@@ -6032,7 +14443,7 @@ func (s *tournamentServiceServer) serveRecentGamesProtobuf(ctx context.Context,
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveCreateClubSession(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveGetLadder(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -6040,9 +14451,9 @@ func (s *tournamentServiceServer) serveCreateClubSession(ctx context.Context, re
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveCreateClubSessionJSON(ctx, resp, req)
+		s.serveGetLadderJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveCreateClubSessionProtobuf(ctx, resp, req)
+		s.serveGetLadderProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -6050,9 +14461,9 @@ func (s *tournamentServiceServer) serveCreateClubSession(ctx context.Context, re
 	}
 }
 
-func (s *tournamentServiceServer) serveCreateClubSessionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveGetLadderJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "CreateClubSession")
+	ctx = ctxsetters.WithMethodName(ctx, "GetLadder")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -6065,29 +14476,29 @@ func (s *tournamentServiceServer) serveCreateClubSessionJSON(ctx context.Context
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(NewClubSessionRequest)
+	reqContent := new(GetLadderRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.TournamentService.CreateClubSession
+	handler := s.TournamentService.GetLadder
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *NewClubSessionRequest) (*ClubSessionResponse, error) {
+		handler = func(ctx context.Context, req *GetLadderRequest) (*ipc1.Ladder, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*NewClubSessionRequest)
+					typedReq, ok := req.(*GetLadderRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*NewClubSessionRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetLadderRequest) when calling interceptor")
 					}
-					return s.TournamentService.CreateClubSession(ctx, typedReq)
+					return s.TournamentService.GetLadder(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ClubSessionResponse)
+				typedResp, ok := resp.(*ipc1.Ladder)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ClubSessionResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ipc1.Ladder) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -6096,7 +14507,7 @@ func (s *tournamentServiceServer) serveCreateClubSessionJSON(ctx context.Context
 	}
 
 	// Call service method
-	var respContent *ClubSessionResponse
+	var respContent *ipc1.Ladder
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -6107,7 +14518,7 @@ func (s *tournamentServiceServer) serveCreateClubSessionJSON(ctx context.Context
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ClubSessionResponse and nil error while calling CreateClubSession. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ipc1.Ladder and nil error while calling GetLadder. nil responses are not supported"))
 		return
 	}
 
@@ -6133,9 +14544,9 @@ func (s *tournamentServiceServer) serveCreateClubSessionJSON(ctx context.Context
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveCreateClubSessionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveGetLadderProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "CreateClubSession")
+	ctx = ctxsetters.WithMethodName(ctx, "GetLadder")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -6147,28 +14558,28 @@ func (s *tournamentServiceServer) serveCreateClubSessionProtobuf(ctx context.Con
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(NewClubSessionRequest)
+	reqContent := new(GetLadderRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.TournamentService.CreateClubSession
+	handler := s.TournamentService.GetLadder
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *NewClubSessionRequest) (*ClubSessionResponse, error) {
+		handler = func(ctx context.Context, req *GetLadderRequest) (*ipc1.Ladder, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*NewClubSessionRequest)
+					typedReq, ok := req.(*GetLadderRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*NewClubSessionRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetLadderRequest) when calling interceptor")
 					}
-					return s.TournamentService.CreateClubSession(ctx, typedReq)
+					return s.TournamentService.GetLadder(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ClubSessionResponse)
+				typedResp, ok := resp.(*ipc1.Ladder)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ClubSessionResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ipc1.Ladder) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -6177,7 +14588,7 @@ func (s *tournamentServiceServer) serveCreateClubSessionProtobuf(ctx context.Con
 	}
 
 	// Call service method
-	var respContent *ClubSessionResponse
+	var respContent *ipc1.Ladder
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -6188,7 +14599,7 @@ func (s *tournamentServiceServer) serveCreateClubSessionProtobuf(ctx context.Con
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ClubSessionResponse and nil error while calling CreateClubSession. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ipc1.Ladder and nil error while calling GetLadder. nil responses are not supported"))
 		return
 	}
 
@@ -6212,7 +14623,7 @@ func (s *tournamentServiceServer) serveCreateClubSessionProtobuf(ctx context.Con
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveGetRecentClubSessions(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveSetLadderControls(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -6220,9 +14631,9 @@ func (s *tournamentServiceServer) serveGetRecentClubSessions(ctx context.Context
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveGetRecentClubSessionsJSON(ctx, resp, req)
+		s.serveSetLadderControlsJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveGetRecentClubSessionsProtobuf(ctx, resp, req)
+		s.serveSetLadderControlsProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -6230,9 +14641,9 @@ func (s *tournamentServiceServer) serveGetRecentClubSessions(ctx context.Context
 	}
 }
 
-func (s *tournamentServiceServer) serveGetRecentClubSessionsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveSetLadderControlsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetRecentClubSessions")
+	ctx = ctxsetters.WithMethodName(ctx, "SetLadderControls")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -6245,29 +14656,29 @@ func (s *tournamentServiceServer) serveGetRecentClubSessionsJSON(ctx context.Con
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(RecentClubSessionsRequest)
+	reqContent := new(LadderControlsRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.TournamentService.GetRecentClubSessions
+	handler := s.TournamentService.SetLadderControls
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *RecentClubSessionsRequest) (*ClubSessionsResponse, error) {
+		handler = func(ctx context.Context, req *LadderControlsRequest) (*TournamentResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*RecentClubSessionsRequest)
+					typedReq, ok := req.(*LadderControlsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*RecentClubSessionsRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*LadderControlsRequest) when calling interceptor")
 					}
-					return s.TournamentService.GetRecentClubSessions(ctx, typedReq)
+					return s.TournamentService.SetLadderControls(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ClubSessionsResponse)
+				typedResp, ok := resp.(*TournamentResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ClubSessionsResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -6276,7 +14687,7 @@ func (s *tournamentServiceServer) serveGetRecentClubSessionsJSON(ctx context.Con
 	}
 
 	// Call service method
-	var respContent *ClubSessionsResponse
+	var respContent *TournamentResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -6287,7 +14698,7 @@ func (s *tournamentServiceServer) serveGetRecentClubSessionsJSON(ctx context.Con
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ClubSessionsResponse and nil error while calling GetRecentClubSessions. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetLadderControls. nil responses are not supported"))
 		return
 	}
 
@@ -6313,9 +14724,9 @@ func (s *tournamentServiceServer) serveGetRecentClubSessionsJSON(ctx context.Con
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveGetRecentClubSessionsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveSetLadderControlsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetRecentClubSessions")
+	ctx = ctxsetters.WithMethodName(ctx, "SetLadderControls")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -6327,28 +14738,28 @@ func (s *tournamentServiceServer) serveGetRecentClubSessionsProtobuf(ctx context
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(RecentClubSessionsRequest)
+	reqContent := new(LadderControlsRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.TournamentService.GetRecentClubSessions
+	handler := s.TournamentService.SetLadderControls
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *RecentClubSessionsRequest) (*ClubSessionsResponse, error) {
+		handler = func(ctx context.Context, req *LadderControlsRequest) (*TournamentResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*RecentClubSessionsRequest)
+					typedReq, ok := req.(*LadderControlsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*RecentClubSessionsRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*LadderControlsRequest) when calling interceptor")
 					}
-					return s.TournamentService.GetRecentClubSessions(ctx, typedReq)
+					return s.TournamentService.SetLadderControls(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ClubSessionsResponse)
+				typedResp, ok := resp.(*TournamentResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ClubSessionsResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*TournamentResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -6357,7 +14768,7 @@ func (s *tournamentServiceServer) serveGetRecentClubSessionsProtobuf(ctx context
 	}
 
 	// Call service method
-	var respContent *ClubSessionsResponse
+	var respContent *TournamentResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -6368,7 +14779,7 @@ func (s *tournamentServiceServer) serveGetRecentClubSessionsProtobuf(ctx context
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ClubSessionsResponse and nil error while calling GetRecentClubSessions. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling SetLadderControls. nil responses are not supported"))
 		return
 	}
 
@@ -6392,7 +14803,7 @@ func (s *tournamentServiceServer) serveGetRecentClubSessionsProtobuf(ctx context
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveUnstartTournament(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveAddLadderPlayers(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -6400,9 +14811,9 @@ func (s *tournamentServiceServer) serveUnstartTournament(ctx context.Context, re
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveUnstartTournamentJSON(ctx, resp, req)
+		s.serveAddLadderPlayersJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveUnstartTournamentProtobuf(ctx, resp, req)
+		s.serveAddLadderPlayersProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -6410,9 +14821,9 @@ func (s *tournamentServiceServer) serveUnstartTournament(ctx context.Context, re
 	}
 }
 
-func (s *tournamentServiceServer) serveUnstartTournamentJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveAddLadderPlayersJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "UnstartTournament")
+	ctx = ctxsetters.WithMethodName(ctx, "AddLadderPlayers")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -6425,23 +14836,23 @@ func (s *tournamentServiceServer) serveUnstartTournamentJSON(ctx context.Context
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(UnstartTournamentRequest)
+	reqContent := new(ipc1.TournamentPersons)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.TournamentService.UnstartTournament
+	handler := s.TournamentService.AddLadderPlayers
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *UnstartTournamentRequest) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*UnstartTournamentRequest)
+					typedReq, ok := req.(*ipc1.TournamentPersons)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*UnstartTournamentRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
 					}
-					return s.TournamentService.UnstartTournament(ctx, typedReq)
+					return s.TournamentService.AddLadderPlayers(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -6467,7 +14878,7 @@ func (s *tournamentServiceServer) serveUnstartTournamentJSON(ctx context.Context
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling UnstartTournament. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling AddLadderPlayers. nil responses are not supported"))
 		return
 	}
 
@@ -6493,9 +14904,9 @@ func (s *tournamentServiceServer) serveUnstartTournamentJSON(ctx context.Context
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveUnstartTournamentProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveAddLadderPlayersProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "UnstartTournament")
+	ctx = ctxsetters.WithMethodName(ctx, "AddLadderPlayers")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -6507,22 +14918,22 @@ func (s *tournamentServiceServer) serveUnstartTournamentProtobuf(ctx context.Con
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(UnstartTournamentRequest)
+	reqContent := new(ipc1.TournamentPersons)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.TournamentService.UnstartTournament
+	handler := s.TournamentService.AddLadderPlayers
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *UnstartTournamentRequest) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*UnstartTournamentRequest)
+					typedReq, ok := req.(*ipc1.TournamentPersons)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*UnstartTournamentRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
 					}
-					return s.TournamentService.UnstartTournament(ctx, typedReq)
+					return s.TournamentService.AddLadderPlayers(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -6548,7 +14959,7 @@ func (s *tournamentServiceServer) serveUnstartTournamentProtobuf(ctx context.Con
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling UnstartTournament. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling AddLadderPlayers. nil responses are not supported"))
 		return
 	}
 
@@ -6572,7 +14983,7 @@ func (s *tournamentServiceServer) serveUnstartTournamentProtobuf(ctx context.Con
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveUncheckIn(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveRemoveLadderPlayers(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -6580,9 +14991,9 @@ func (s *tournamentServiceServer) serveUncheckIn(ctx context.Context, resp http.
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveUncheckInJSON(ctx, resp, req)
+		s.serveRemoveLadderPlayersJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveUncheckInProtobuf(ctx, resp, req)
+		s.serveRemoveLadderPlayersProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -6590,9 +15001,9 @@ func (s *tournamentServiceServer) serveUncheckIn(ctx context.Context, resp http.
 	}
 }
 
-func (s *tournamentServiceServer) serveUncheckInJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveRemoveLadderPlayersJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "UncheckIn")
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveLadderPlayers")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -6605,23 +15016,23 @@ func (s *tournamentServiceServer) serveUncheckInJSON(ctx context.Context, resp h
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(UncheckInRequest)
+	reqContent := new(ipc1.TournamentPersons)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.TournamentService.UncheckIn
+	handler := s.TournamentService.RemoveLadderPlayers
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *UncheckInRequest) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*UncheckInRequest)
+					typedReq, ok := req.(*ipc1.TournamentPersons)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*UncheckInRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
 					}
-					return s.TournamentService.UncheckIn(ctx, typedReq)
+					return s.TournamentService.RemoveLadderPlayers(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -6647,7 +15058,7 @@ func (s *tournamentServiceServer) serveUncheckInJSON(ctx context.Context, resp h
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling UncheckIn. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling RemoveLadderPlayers. nil responses are not supported"))
 		return
 	}
 
@@ -6673,9 +15084,9 @@ func (s *tournamentServiceServer) serveUncheckInJSON(ctx context.Context, resp h
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveUncheckInProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveRemoveLadderPlayersProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "UncheckIn")
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveLadderPlayers")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -6687,22 +15098,22 @@ func (s *tournamentServiceServer) serveUncheckInProtobuf(ctx context.Context, re
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(UncheckInRequest)
+	reqContent := new(ipc1.TournamentPersons)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.TournamentService.UncheckIn
+	handler := s.TournamentService.RemoveLadderPlayers
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *UncheckInRequest) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *ipc1.TournamentPersons) (*TournamentResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*UncheckInRequest)
+					typedReq, ok := req.(*ipc1.TournamentPersons)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*UncheckInRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ipc1.TournamentPersons) when calling interceptor")
 					}
-					return s.TournamentService.UncheckIn(ctx, typedReq)
+					return s.TournamentService.RemoveLadderPlayers(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -6728,7 +15139,7 @@ func (s *tournamentServiceServer) serveUncheckInProtobuf(ctx context.Context, re
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling UncheckIn. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling RemoveLadderPlayers. nil responses are not supported"))
 		return
 	}
 
@@ -6752,7 +15163,7 @@ func (s *tournamentServiceServer) serveUncheckInProtobuf(ctx context.Context, re
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveCheckIn(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveChallengeLadderPlayer(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -6760,9 +15171,9 @@ func (s *tournamentServiceServer) serveCheckIn(ctx context.Context, resp http.Re
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveCheckInJSON(ctx, resp, req)
+		s.serveChallengeLadderPlayerJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveCheckInProtobuf(ctx, resp, req)
+		s.serveChallengeLadderPlayerProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -6770,9 +15181,9 @@ func (s *tournamentServiceServer) serveCheckIn(ctx context.Context, resp http.Re
 	}
 }
 
-func (s *tournamentServiceServer) serveCheckInJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveChallengeLadderPlayerJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "CheckIn")
+	ctx = ctxsetters.WithMethodName(ctx, "ChallengeLadderPlayer")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -6785,23 +15196,23 @@ func (s *tournamentServiceServer) serveCheckInJSON(ctx context.Context, resp htt
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(CheckinRequest)
+	reqContent := new(LadderChallengeRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.TournamentService.CheckIn
+	handler := s.TournamentService.ChallengeLadderPlayer
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *CheckinRequest) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *LadderChallengeRequest) (*TournamentResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CheckinRequest)
+					typedReq, ok := req.(*LadderChallengeRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CheckinRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*LadderChallengeRequest) when calling interceptor")
 					}
-					return s.TournamentService.CheckIn(ctx, typedReq)
+					return s.TournamentService.ChallengeLadderPlayer(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -6827,7 +15238,7 @@ func (s *tournamentServiceServer) serveCheckInJSON(ctx context.Context, resp htt
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling CheckIn. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling ChallengeLadderPlayer. nil responses are not supported"))
 		return
 	}
 
@@ -6853,9 +15264,9 @@ func (s *tournamentServiceServer) serveCheckInJSON(ctx context.Context, resp htt
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tournamentServiceServer) serveCheckInProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tournamentServiceServer) serveChallengeLadderPlayerProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "CheckIn")
+	ctx = ctxsetters.WithMethodName(ctx, "ChallengeLadderPlayer")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -6867,22 +15278,22 @@ func (s *tournamentServiceServer) serveCheckInProtobuf(ctx context.Context, resp
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(CheckinRequest)
+	reqContent := new(LadderChallengeRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.TournamentService.CheckIn
+	handler := s.TournamentService.ChallengeLadderPlayer
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *CheckinRequest) (*TournamentResponse, error) {
+		handler = func(ctx context.Context, req *LadderChallengeRequest) (*TournamentResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CheckinRequest)
+					typedReq, ok := req.(*LadderChallengeRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CheckinRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*LadderChallengeRequest) when calling interceptor")
 					}
-					return s.TournamentService.CheckIn(ctx, typedReq)
+					return s.TournamentService.ChallengeLadderPlayer(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -6908,7 +15319,7 @@ func (s *tournamentServiceServer) serveCheckInProtobuf(ctx context.Context, resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling CheckIn. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TournamentResponse and nil error while calling ChallengeLadderPlayer. nil responses are not supported"))
 		return
 	}
 
@@ -7510,110 +15921,217 @@ func callClientError(ctx context.Context, h *twirp.ClientHooks, err twirp.Error)
 }
 
 var twirpFileDescriptor0 = []byte{
-	// 1678 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xdf, 0x73, 0xdb, 0x4e,
-	0x11, 0xc7, 0xb1, 0x9d, 0xd8, 0xeb, 0xd8, 0x71, 0x2e, 0x49, 0xab, 0x98, 0xb4, 0xf5, 0xa8, 0x9d,
-	0xd6, 0x2d, 0xd4, 0x86, 0x14, 0x98, 0x0e, 0x30, 0x30, 0x89, 0x93, 0xa6, 0x61, 0x42, 0x1a, 0x64,
-	0x07, 0x26, 0x30, 0x83, 0x90, 0xa5, 0xb3, 0xa3, 0x41, 0xd6, 0xa9, 0xba, 0x53, 0x8c, 0x5f, 0x78,
-	0xe4, 0x1d, 0x9e, 0x78, 0xe6, 0xff, 0x80, 0xff, 0x82, 0x3f, 0xe5, 0xfb, 0xfe, 0x9d, 0x3b, 0xfd,
-	0xb0, 0x6c, 0xcb, 0xb6, 0x9a, 0xe6, 0xfb, 0xe6, 0xdb, 0xdb, 0xfb, 0xec, 0x6a, 0x77, 0x6f, 0xf7,
-	0x73, 0x86, 0x9f, 0x6a, 0x8e, 0xd9, 0x72, 0x5c, 0xc2, 0x48, 0x8b, 0x11, 0xcf, 0xb5, 0xb5, 0x21,
-	0xb6, 0x99, 0x4a, 0xb1, 0x7b, 0x67, 0xea, 0x38, 0x41, 0xd4, 0x14, 0xba, 0x08, 0xcd, 0xef, 0xd4,
-	0x0e, 0x26, 0x50, 0xa6, 0xa3, 0xb7, 0xc8, 0x70, 0x30, 0x22, 0xae, 0x41, 0xfd, 0x13, 0xb5, 0xa7,
-	0xd3, 0xbb, 0x93, 0xf3, 0xc1, 0xfe, 0xb3, 0x01, 0x21, 0x03, 0x0b, 0xfb, 0x2a, 0x3d, 0xaf, 0xdf,
-	0x62, 0xe6, 0x10, 0x53, 0xa6, 0x0d, 0x1d, 0x5f, 0x41, 0xbe, 0x84, 0xed, 0x0e, 0xd3, 0x5c, 0xa6,
-	0x10, 0xcf, 0x36, 0x14, 0xfc, 0xd9, 0xc3, 0x94, 0xa1, 0xe7, 0x50, 0x8e, 0x79, 0x62, 0x1a, 0x52,
-	0xa6, 0x9e, 0x69, 0x14, 0x95, 0xcd, 0x89, 0xf0, 0xdc, 0x40, 0xbb, 0x90, 0x77, 0xf9, 0x21, 0x69,
-	0xad, 0x9e, 0x69, 0xe4, 0x15, 0x7f, 0x21, 0xff, 0x2f, 0x03, 0xbb, 0x97, 0x78, 0xd4, 0x8d, 0x34,
-	0x43, 0x4c, 0x04, 0x39, 0x6a, 0x79, 0x83, 0x00, 0x4a, 0xfc, 0xe6, 0x32, 0xae, 0x24, 0x10, 0x8a,
-	0x8a, 0xf8, 0x8d, 0xea, 0x50, 0x32, 0x30, 0xd5, 0x5d, 0xd3, 0x61, 0x26, 0xb1, 0xa5, 0xac, 0xd8,
-	0x8a, 0x8b, 0xd0, 0x5b, 0x40, 0x86, 0xe9, 0x62, 0x9d, 0x11, 0x57, 0xf5, 0x28, 0x16, 0x76, 0xa8,
-	0x94, 0xab, 0x67, 0x1b, 0x45, 0x65, 0x3b, 0xdc, 0xb9, 0x0e, 0x37, 0xd0, 0x5b, 0xc8, 0xb1, 0xb1,
-	0x83, 0xa5, 0x7c, 0x3d, 0xd3, 0xa8, 0x1c, 0xee, 0x37, 0x13, 0xa2, 0xdf, 0xed, 0x8e, 0x1d, 0xac,
-	0x08, 0x35, 0xf9, 0x9b, 0x2c, 0xa0, 0x89, 0xf7, 0xbf, 0xc5, 0x4c, 0x33, 0x34, 0xa6, 0xa1, 0x0a,
-	0xac, 0x45, 0x71, 0x58, 0x33, 0x8d, 0x7b, 0xba, 0x1e, 0x06, 0x21, 0x17, 0x0b, 0xc2, 0x97, 0xf9,
-	0x87, 0x9e, 0x02, 0x18, 0x26, 0xd5, 0x2d, 0xcd, 0x1c, 0x62, 0x57, 0x5a, 0x17, 0x40, 0x31, 0x09,
-	0x7a, 0x02, 0xc0, 0x4c, 0x0b, 0xab, 0x94, 0x8d, 0x2d, 0x2c, 0x6d, 0x88, 0xfd, 0x22, 0x97, 0x74,
-	0xb8, 0x00, 0x3d, 0x83, 0x52, 0x8f, 0x68, 0xae, 0x11, 0xec, 0x17, 0xfc, 0xf3, 0x42, 0xe4, 0x2b,
-	0x9c, 0xc0, 0x9e, 0x81, 0xfb, 0x9a, 0x67, 0x31, 0x55, 0xb7, 0xbc, 0x9e, 0x4a, 0x31, 0x63, 0xa6,
-	0x3d, 0xa0, 0x52, 0xb1, 0x9e, 0x69, 0x94, 0x0e, 0xab, 0x4d, 0xd3, 0xd1, 0x9b, 0x67, 0xda, 0x10,
-	0x07, 0x89, 0x55, 0x76, 0x02, 0xf5, 0xb6, 0xe5, 0xf5, 0x3a, 0x81, 0x32, 0xfa, 0x35, 0x1c, 0xf4,
-	0x5d, 0x8c, 0xfb, 0xc4, 0x1d, 0x4e, 0xc1, 0xa8, 0x7d, 0x13, 0x5b, 0x06, 0x95, 0x40, 0x64, 0x6b,
-	0x3f, 0xd4, 0x89, 0x9d, 0xfd, 0x20, 0x14, 0x50, 0x0d, 0x0a, 0x8e, 0x46, 0x29, 0xaf, 0x75, 0xa9,
-	0x24, 0x9c, 0x8c, 0xd6, 0x3c, 0x8a, 0x16, 0x19, 0x10, 0x69, 0xd3, 0x8f, 0x22, 0xff, 0xcd, 0xab,
-	0x51, 0x27, 0x16, 0x71, 0xa5, 0xb2, 0x10, 0xfa, 0x0b, 0xf4, 0x1a, 0xaa, 0x8e, 0x6b, 0xde, 0x69,
-	0x0c, 0xab, 0x9a, 0xad, 0x59, 0x63, 0x6a, 0x52, 0xa9, 0x52, 0xcf, 0x34, 0x0a, 0xca, 0x56, 0x20,
-	0x3f, 0x0a, 0xc4, 0x72, 0x0f, 0x0e, 0x3a, 0x98, 0xcd, 0x67, 0x3e, 0xac, 0xdf, 0x63, 0x28, 0x0c,
-	0x03, 0x91, 0x28, 0x83, 0xd2, 0xe1, 0xcb, 0xc4, 0x54, 0xcd, 0x03, 0x44, 0xe7, 0xe4, 0x7f, 0x67,
-	0xa0, 0xd6, 0x31, 0xed, 0x81, 0x85, 0xc5, 0x75, 0x6b, 0x13, 0x9b, 0xb9, 0xc4, 0xa2, 0xa1, 0x89,
-	0xd9, 0x1a, 0xab, 0x41, 0xc1, 0x30, 0xef, 0x4c, 0xca, 0x8b, 0xc9, 0xaf, 0xb3, 0x68, 0x3d, 0xb9,
-	0x7d, 0xd9, 0xd8, 0xed, 0x43, 0xef, 0xa1, 0x22, 0x7e, 0xa8, 0x7a, 0x00, 0x2d, 0x2a, 0xad, 0x74,
-	0xb8, 0x2d, 0xb2, 0x16, 0x37, 0xaa, 0x94, 0xdd, 0xb8, 0x0b, 0xf2, 0x7f, 0x32, 0x50, 0xbd, 0xd2,
-	0x4c, 0x77, 0xaa, 0x0f, 0x7c, 0xbd, 0x43, 0xcf, 0xa1, 0xec, 0xb8, 0x98, 0x07, 0x07, 0xab, 0xbd,
-	0x31, 0xf6, 0xfd, 0x29, 0x28, 0x9b, 0xa1, 0xf0, 0x78, 0x8c, 0x29, 0x7a, 0x05, 0x5b, 0x06, 0xb6,
-	0x30, 0xc3, 0xaa, 0xa3, 0x99, 0xae, 0x28, 0xb6, 0xbc, 0x50, 0xab, 0xf8, 0xe2, 0xab, 0x40, 0x2a,
-	0x9f, 0xc1, 0xfe, 0x24, 0xbe, 0x27, 0x81, 0xe5, 0x7b, 0x38, 0x2b, 0xff, 0x37, 0x03, 0xd2, 0x04,
-	0x29, 0xc0, 0x0f, 0x81, 0x64, 0x28, 0x3b, 0x96, 0x36, 0xc6, 0xae, 0x4a, 0x6c, 0x3c, 0xe9, 0x7e,
-	0x25, 0x5f, 0xf8, 0xc9, 0xc6, 0xe7, 0x46, 0x4c, 0x87, 0x8d, 0x08, 0xd7, 0x59, 0x8b, 0xeb, 0x74,
-	0x47, 0x24, 0xde, 0x20, 0xa7, 0x22, 0xd2, 0x86, 0x2a, 0xc5, 0x56, 0x5f, 0xe5, 0x9a, 0xaa, 0x8b,
-	0xa9, 0x67, 0x31, 0x11, 0x14, 0x7e, 0xf5, 0x79, 0x92, 0x26, 0x6e, 0xf9, 0x97, 0x8c, 0x2b, 0x28,
-	0x15, 0x7e, 0xe4, 0xca, 0xd2, 0xc6, 0xfe, 0x5a, 0xfe, 0x67, 0x26, 0x1e, 0x89, 0x30, 0x3e, 0xf7,
-	0x49, 0xdb, 0x47, 0x7e, 0xcf, 0x82, 0xa0, 0x67, 0xeb, 0xd9, 0x46, 0xe9, 0xf0, 0x87, 0xcb, 0xcb,
-	0x7a, 0x3a, 0x58, 0x4a, 0x74, 0x5a, 0xfe, 0x47, 0x0e, 0x9e, 0xc5, 0xdb, 0x3e, 0x77, 0xf4, 0xd3,
-	0x1d, 0x76, 0x5d, 0xd3, 0xc0, 0xf7, 0xf1, 0x6c, 0x2e, 0x0d, 0xd9, 0x14, 0x69, 0xc8, 0x2d, 0x49,
-	0x43, 0x3e, 0x9e, 0x86, 0x06, 0x54, 0x63, 0xe8, 0x54, 0x27, 0x2e, 0x16, 0xcd, 0x34, 0xaf, 0x54,
-	0x22, 0x03, 0x1d, 0x2e, 0x8d, 0x69, 0x72, 0x1b, 0xbe, 0xe6, 0x46, 0x5c, 0xb3, 0x3b, 0x22, 0xbe,
-	0xe6, 0x29, 0x6c, 0xc7, 0x30, 0x83, 0xdc, 0x16, 0x56, 0xe5, 0x76, 0x2b, 0xb2, 0xe7, 0x0b, 0x62,
-	0x30, 0xdc, 0x60, 0x00, 0x53, 0x4c, 0x09, 0xd3, 0x1d, 0x91, 0x00, 0xe6, 0xe7, 0xb0, 0x35, 0xd0,
-	0x86, 0x58, 0xc5, 0xb6, 0xa1, 0xba, 0x58, 0xa3, 0xc4, 0x96, 0x40, 0x80, 0xa0, 0xa8, 0x85, 0x9f,
-	0xf2, 0xab, 0xce, 0x77, 0x94, 0xf2, 0x20, 0xbe, 0x44, 0x07, 0x50, 0xe4, 0xf8, 0x06, 0xb7, 0x21,
-	0xda, 0x6f, 0x41, 0x99, 0x08, 0xf8, 0x88, 0x11, 0xc8, 0xa6, 0x6d, 0xe0, 0xbf, 0x89, 0x2e, 0x9c,
-	0x57, 0x8a, 0x5c, 0x72, 0xce, 0x05, 0xf2, 0xbf, 0x32, 0xf0, 0x62, 0xe2, 0xe2, 0x84, 0x5d, 0xb4,
-	0x89, 0x67, 0x33, 0x83, 0x8c, 0xec, 0x87, 0x6b, 0x2f, 0x0d, 0xa8, 0x52, 0x8e, 0xaf, 0x6a, 0x96,
-	0xa5, 0x0a, 0x51, 0xd8, 0x61, 0x2a, 0x42, 0x7e, 0x64, 0x59, 0xc2, 0x34, 0x95, 0x77, 0xe3, 0x53,
-	0x5d, 0xc1, 0xd4, 0x21, 0x36, 0xc5, 0xf2, 0x2f, 0x60, 0x6f, 0x86, 0xac, 0xf8, 0x1b, 0x49, 0xe3,
-	0x5e, 0x0c, 0xee, 0xb5, 0xc9, 0xe0, 0x96, 0x8f, 0xe1, 0xe0, 0x6c, 0xd9, 0xc4, 0x48, 0x83, 0xf1,
-	0x12, 0x76, 0xa7, 0x30, 0x16, 0x9c, 0x95, 0x5f, 0xc3, 0xe3, 0x0f, 0xa6, 0x6d, 0xd2, 0xdb, 0xd5,
-	0xaa, 0x7f, 0x87, 0x5a, 0x92, 0x4f, 0xc1, 0x87, 0x3d, 0xc0, 0x18, 0xe3, 0xd5, 0x11, 0xd2, 0x2c,
-	0x2a, 0xad, 0x89, 0x49, 0x3e, 0x11, 0xc8, 0x37, 0x80, 0x14, 0xac, 0x07, 0xc5, 0xb9, 0xb0, 0x27,
-	0x7d, 0x1f, 0x8a, 0xb6, 0x37, 0x54, 0x07, 0x82, 0xbb, 0xf9, 0x0c, 0xb2, 0x60, 0x7b, 0x43, 0x71,
-	0x06, 0x3d, 0x82, 0x75, 0xd2, 0xef, 0x53, 0xcc, 0x82, 0x6c, 0x07, 0x2b, 0xf9, 0x37, 0xb0, 0x33,
-	0x05, 0x1d, 0x7c, 0xd3, 0x3b, 0xc8, 0xfb, 0x38, 0x19, 0xd1, 0xc0, 0x9e, 0x24, 0x5c, 0x92, 0x53,
-	0xdb, 0xc0, 0xc6, 0xe9, 0x1d, 0x0f, 0x9b, 0xaf, 0x2b, 0xbf, 0x01, 0xe9, 0xda, 0x16, 0x45, 0xb2,
-	0x3a, 0xa4, 0x32, 0x54, 0xaf, 0x6d, 0xfd, 0x16, 0xeb, 0x7f, 0x3d, 0x5f, 0x54, 0xbc, 0x72, 0x1d,
-	0x2a, 0x6d, 0xae, 0x61, 0x2e, 0xd4, 0xf8, 0x8b, 0x28, 0x36, 0x9f, 0xea, 0xd0, 0xf8, 0xe4, 0x6a,
-	0x42, 0xce, 0xd0, 0x18, 0x0e, 0xf2, 0x51, 0x6b, 0xfa, 0x9c, 0xbd, 0x19, 0x72, 0xf6, 0x66, 0x37,
-	0xe4, 0xec, 0x8a, 0xd0, 0x43, 0x8f, 0x61, 0x43, 0x70, 0xaa, 0x68, 0xec, 0xac, 0xf3, 0xe5, 0xb9,
-	0x21, 0x5f, 0xc2, 0xce, 0x14, 0x7c, 0x10, 0x9f, 0x54, 0x74, 0x3e, 0xa9, 0x3a, 0x6f, 0x60, 0xdf,
-	0x8f, 0x77, 0x0c, 0x75, 0x61, 0x46, 0x05, 0x03, 0xf3, 0x6c, 0x16, 0xbe, 0x07, 0xc4, 0x62, 0x61,
-	0x2a, 0xff, 0x04, 0xbb, 0xd3, 0xa0, 0x81, 0xaf, 0x6d, 0x28, 0xd0, 0x40, 0x16, 0xa4, 0xf3, 0x55,
-	0x52, 0x7d, 0x26, 0x7c, 0xa6, 0x12, 0x1d, 0x7c, 0xf3, 0x33, 0xc8, 0x0b, 0xca, 0x8c, 0x36, 0xa1,
-	0xd0, 0xe9, 0x1e, 0x5d, 0x9e, 0x1c, 0x29, 0x27, 0xd5, 0xef, 0xa1, 0x02, 0xe4, 0xda, 0x17, 0xd7,
-	0xc7, 0xd5, 0x0c, 0x2a, 0x42, 0xbe, 0xfd, 0xf1, 0xfc, 0xe2, 0xa4, 0xba, 0x86, 0x00, 0xd6, 0x2f,
-	0x4e, 0xcf, 0x8e, 0xda, 0x37, 0xd5, 0xec, 0xe1, 0xff, 0x11, 0x6c, 0xc7, 0x3a, 0x97, 0x6f, 0x0b,
-	0x19, 0x50, 0x9e, 0x6a, 0x12, 0xa8, 0x91, 0xe4, 0x51, 0xd2, 0xa3, 0xa7, 0xf6, 0x3a, 0x85, 0x66,
-	0xf0, 0xe1, 0x63, 0xd8, 0x4b, 0xec, 0x26, 0xe8, 0x47, 0x49, 0x18, 0xcb, 0x1a, 0x4f, 0xad, 0x99,
-	0xf2, 0x46, 0x87, 0xa6, 0xff, 0x00, 0xe5, 0x29, 0xbc, 0xe4, 0x0f, 0x4c, 0xea, 0x53, 0xb5, 0x03,
-	0x71, 0xd7, 0x3e, 0x78, 0x96, 0x35, 0x4f, 0xcc, 0x28, 0x1a, 0x40, 0x75, 0xb6, 0x6b, 0xa1, 0x1f,
-	0x24, 0x61, 0x2f, 0xe8, 0x6d, 0xb5, 0x15, 0xbd, 0x29, 0xfa, 0x82, 0xcf, 0xb0, 0xd7, 0x49, 0x1f,
-	0xbc, 0x65, 0x3c, 0x3f, 0xb5, 0xc9, 0x1b, 0x28, 0x46, 0x7c, 0x19, 0xbd, 0x48, 0x3a, 0x34, 0x4b,
-	0xa7, 0x53, 0x43, 0x3b, 0xf0, 0xa8, 0x83, 0x59, 0xc2, 0x43, 0x01, 0x25, 0x66, 0x76, 0xf1, 0x8b,
-	0x22, 0xb5, 0xc5, 0xdf, 0x43, 0xb5, 0x83, 0xd9, 0xb4, 0xad, 0x9a, 0x48, 0x6d, 0xc4, 0xb2, 0xe3,
-	0x7b, 0xa9, 0x71, 0xbb, 0xb0, 0xd3, 0xc1, 0x51, 0x41, 0x44, 0xd0, 0x7b, 0x53, 0xd0, 0x5f, 0x8c,
-	0x7a, 0x09, 0x9b, 0x47, 0x86, 0x71, 0x12, 0x4e, 0x1c, 0xf4, 0x68, 0xa6, 0xe1, 0x5f, 0x61, 0x97,
-	0x12, 0x3b, 0x3d, 0xde, 0xef, 0x60, 0x4b, 0xc1, 0x43, 0x72, 0x87, 0x1f, 0x0e, 0xd2, 0x80, 0x92,
-	0x70, 0x31, 0x60, 0x2f, 0x6f, 0x97, 0x1f, 0x9b, 0x79, 0xca, 0xa4, 0xb6, 0x32, 0x80, 0x4a, 0xe8,
-	0xf8, 0x77, 0x6b, 0xe8, 0x02, 0xe0, 0xc8, 0x30, 0xae, 0x04, 0xc3, 0xfc, 0xfa, 0xe0, 0x7c, 0x82,
-	0xb2, 0xef, 0xf6, 0x43, 0x01, 0xea, 0x00, 0x1d, 0x1c, 0xbe, 0x4c, 0x56, 0xc5, 0x60, 0xe6, 0xb5,
-	0x94, 0xda, 0xc8, 0x2d, 0x14, 0xf9, 0x1d, 0xf1, 0xc9, 0xf5, 0xbb, 0x95, 0x87, 0xe6, 0x5f, 0x3f,
-	0xa9, 0x2d, 0x8d, 0x60, 0x27, 0x81, 0x35, 0xa3, 0xf7, 0xcb, 0x8f, 0x2f, 0x26, 0xda, 0xa9, 0x0d,
-	0xff, 0x19, 0x4a, 0x31, 0x7e, 0x85, 0x12, 0x8f, 0xcd, 0x73, 0xbb, 0xda, 0xab, 0x95, 0x7a, 0x51,
-	0xbd, 0x6e, 0xb7, 0x5d, 0xac, 0x31, 0x1c, 0x1b, 0xdf, 0x68, 0xd1, 0x8c, 0x9c, 0x27, 0x4a, 0xb5,
-	0xb4, 0x54, 0x00, 0x39, 0x62, 0x98, 0xce, 0x73, 0x97, 0xe4, 0xda, 0x58, 0xc8, 0x71, 0x6a, 0x8d,
-	0x15, 0x06, 0x27, 0x9f, 0x66, 0xc2, 0xf6, 0x1c, 0x9d, 0x44, 0x89, 0x4f, 0xe9, 0x45, 0xac, 0xf3,
-	0x4b, 0x26, 0x4f, 0xc4, 0x46, 0x93, 0x27, 0xcf, 0x2c, 0x59, 0x4d, 0x0d, 0x7d, 0x0d, 0x1b, 0xed,
-	0x00, 0x58, 0x4e, 0xfc, 0xf4, 0x29, 0x86, 0x9b, 0x16, 0xf6, 0xf8, 0x57, 0x7f, 0xfc, 0xe5, 0xc0,
-	0x64, 0xb7, 0x5e, 0xaf, 0xa9, 0x93, 0x61, 0xcb, 0x20, 0x43, 0xd3, 0x26, 0x3f, 0xfe, 0x49, 0xcb,
-	0x32, 0xc5, 0x5f, 0xd9, 0x2d, 0xd7, 0xd1, 0x5b, 0xcb, 0xfe, 0x30, 0xef, 0xad, 0x8b, 0x9d, 0x77,
-	0xdf, 0x06, 0x00, 0x00, 0xff, 0xff, 0x79, 0xd7, 0x9a, 0x81, 0x57, 0x17, 0x00, 0x00,
+	// 3387 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x1b, 0x4d, 0x73, 0x23, 0x47,
+	0x95, 0xb1, 0x25, 0x5b, 0x7a, 0xb2, 0x25, 0xb9, 0xd7, 0x76, 0xb4, 0xca, 0x26, 0xeb, 0xcc, 0x7e,
+	0x79, 0x17, 0xd6, 0x4e, 0x9c, 0x50, 0x59, 0x08, 0x59, 0x4a, 0x6b, 0x7b, 0x1d, 0x51, 0x8e, 0x6d,
+	0x46, 0x76, 0xc2, 0x26, 0x05, 0x93, 0xf1, 0x4c, 0x5b, 0x6e, 0x32, 0x9a, 0x99, 0xcc, 0xb4, 0xac,
+	0x15, 0x05, 0x07, 0xaa, 0x28, 0x8a, 0x2a, 0x8a, 0x03, 0x1c, 0x28, 0xce, 0xfc, 0x81, 0x9c, 0xa8,
+	0xe2, 0x02, 0x07, 0x7e, 0x02, 0x3f, 0x85, 0x2b, 0x17, 0xaa, 0x3f, 0xe6, 0x43, 0xd2, 0x8c, 0x24,
+	0x3b, 0xde, 0x2a, 0x6e, 0xd3, 0xaf, 0x5f, 0xbf, 0xf7, 0xfa, 0xf5, 0xeb, 0xd7, 0xef, 0x43, 0x82,
+	0xef, 0x1a, 0x1e, 0xd9, 0xf4, 0x7c, 0x97, 0xba, 0x9b, 0xd4, 0xed, 0xfa, 0x8e, 0xd1, 0xc1, 0x0e,
+	0xd5, 0x03, 0xec, 0x5f, 0x10, 0x13, 0xa7, 0x80, 0x36, 0x38, 0x2e, 0x42, 0xa3, 0x33, 0xf5, 0x5b,
+	0x31, 0x29, 0xe2, 0x99, 0x9b, 0x6e, 0xa7, 0xdd, 0x73, 0x7d, 0x2b, 0x10, 0x2b, 0xea, 0x6f, 0x0e,
+	0xce, 0xc6, 0xeb, 0xe5, 0xfc, 0xed, 0xb6, 0xeb, 0xb6, 0x6d, 0x2c, 0x50, 0x4e, 0xbb, 0x67, 0x9b,
+	0x94, 0x74, 0x70, 0x40, 0x8d, 0x8e, 0x27, 0x10, 0xd4, 0x03, 0x58, 0x6a, 0x51, 0xc3, 0xa7, 0x9a,
+	0xdb, 0x75, 0x2c, 0x0d, 0x7f, 0xd5, 0xc5, 0x01, 0x45, 0x77, 0x60, 0x31, 0x21, 0x09, 0xb1, 0x6a,
+	0xca, 0x9a, 0xb2, 0x5e, 0xd4, 0x16, 0x62, 0x60, 0xd3, 0x42, 0xcb, 0x90, 0xf7, 0xd9, 0xa2, 0xda,
+	0xcc, 0x9a, 0xb2, 0x9e, 0xd7, 0xc4, 0x40, 0xfd, 0xa7, 0x02, 0xcb, 0x07, 0xb8, 0x77, 0x1c, 0x61,
+	0x86, 0x34, 0x11, 0xe4, 0x02, 0xbb, 0xdb, 0x96, 0xa4, 0xf8, 0x37, 0x83, 0x31, 0x24, 0x4e, 0xa1,
+	0xa8, 0xf1, 0x6f, 0xb4, 0x06, 0x25, 0x0b, 0x07, 0xa6, 0x4f, 0x3c, 0x4a, 0x5c, 0xa7, 0x36, 0xcb,
+	0xa7, 0x92, 0x20, 0xf4, 0x18, 0x90, 0x45, 0x7c, 0x6c, 0x52, 0xd7, 0xd7, 0xbb, 0x01, 0xe6, 0x7c,
+	0x82, 0x5a, 0x6e, 0x6d, 0x76, 0xbd, 0xa8, 0x2d, 0x85, 0x33, 0x27, 0xe1, 0x04, 0x7a, 0x0c, 0x39,
+	0xda, 0xf7, 0x70, 0x2d, 0xbf, 0xa6, 0xac, 0x97, 0xb7, 0x6e, 0x6e, 0xa4, 0x68, 0xff, 0xf8, 0xb8,
+	0xef, 0x61, 0x8d, 0xa3, 0xa9, 0xff, 0xce, 0x01, 0x8a, 0xa5, 0xff, 0x18, 0x53, 0xc3, 0x32, 0xa8,
+	0x81, 0xca, 0x30, 0x13, 0xe9, 0x61, 0x86, 0x58, 0x57, 0x14, 0x3d, 0x54, 0x42, 0x2e, 0xa1, 0x84,
+	0xcb, 0xc9, 0x87, 0xde, 0x04, 0xb0, 0x48, 0x60, 0xda, 0x06, 0xe9, 0x60, 0xbf, 0x36, 0xc7, 0x09,
+	0x25, 0x20, 0xe8, 0x0d, 0x00, 0x4a, 0x6c, 0xac, 0x07, 0xb4, 0x6f, 0xe3, 0xda, 0x3c, 0x9f, 0x2f,
+	0x32, 0x48, 0x8b, 0x01, 0xd0, 0x6d, 0x28, 0x9d, 0xba, 0x86, 0x6f, 0xc9, 0xf9, 0x82, 0x58, 0xcf,
+	0x41, 0x02, 0x61, 0x07, 0x56, 0x2c, 0x7c, 0x66, 0x74, 0x6d, 0xaa, 0x9b, 0x76, 0xf7, 0x54, 0x0f,
+	0x30, 0xa5, 0xc4, 0x69, 0x07, 0xb5, 0xe2, 0x9a, 0xb2, 0x5e, 0xda, 0xaa, 0x6e, 0x10, 0xcf, 0xdc,
+	0xd8, 0x33, 0x3a, 0x58, 0x1e, 0xac, 0x76, 0x43, 0xa2, 0x6f, 0xdb, 0xdd, 0xd3, 0x96, 0x44, 0x46,
+	0x3f, 0x84, 0x5b, 0x67, 0x3e, 0xc6, 0x67, 0xae, 0xdf, 0x19, 0x20, 0xa3, 0x9f, 0x11, 0x6c, 0x5b,
+	0x41, 0x0d, 0xf8, 0x69, 0xdd, 0x0c, 0x71, 0x12, 0x6b, 0x9f, 0x73, 0x04, 0x54, 0x87, 0x82, 0x67,
+	0x04, 0x01, 0xb3, 0xf5, 0x5a, 0x89, 0x0b, 0x19, 0x8d, 0x99, 0x16, 0x6d, 0xb7, 0xed, 0xd6, 0x16,
+	0x84, 0x16, 0xd9, 0x37, 0xb3, 0x46, 0xd3, 0xb5, 0x5d, 0xbf, 0xb6, 0xc8, 0x81, 0x62, 0x80, 0x1e,
+	0x42, 0xd5, 0xf3, 0xc9, 0x85, 0x41, 0xb1, 0x6e, 0x38, 0x86, 0xdd, 0x0f, 0x48, 0x50, 0x2b, 0xaf,
+	0x29, 0xeb, 0x05, 0xad, 0x22, 0xe1, 0x0d, 0x09, 0x46, 0x2a, 0xcc, 0x79, 0x3e, 0xf9, 0x05, 0x0e,
+	0x6a, 0x95, 0xb5, 0xd9, 0xf5, 0xd2, 0x16, 0xf0, 0x8d, 0x1e, 0x31, 0x90, 0x26, 0x67, 0xd0, 0xfb,
+	0x50, 0xe6, 0x5f, 0x3a, 0x25, 0x58, 0xf7, 0xbb, 0x36, 0xae, 0x55, 0xf9, 0xa1, 0x2d, 0xc5, 0xb8,
+	0xc7, 0x04, 0x6b, 0x5d, 0x1b, 0x6b, 0x0b, 0x5e, 0x62, 0xa4, 0x9e, 0xc2, 0xad, 0x16, 0xa6, 0xa3,
+	0x66, 0x15, 0x5e, 0x8e, 0x67, 0x50, 0xe8, 0x48, 0x10, 0xb7, 0xb1, 0xd2, 0xd6, 0xfd, 0x54, 0x3b,
+	0x18, 0x25, 0x10, 0xad, 0x53, 0xff, 0xa2, 0x40, 0xbd, 0x45, 0x9c, 0xb6, 0x8d, 0xf9, 0x5d, 0xde,
+	0x76, 0x1d, 0xea, 0xbb, 0x76, 0x10, 0xb2, 0x18, 0x36, 0xe0, 0x3a, 0x14, 0x2c, 0x72, 0x41, 0x02,
+	0x66, 0xa9, 0xc2, 0x88, 0xa3, 0x71, 0x7c, 0xb5, 0x67, 0x13, 0x57, 0x1b, 0x3d, 0x81, 0x32, 0xff,
+	0xd0, 0x4d, 0x49, 0x9a, 0x9b, 0x71, 0x49, 0xee, 0x3e, 0xc9, 0x54, 0x5b, 0xf4, 0x13, 0xa3, 0x40,
+	0xfd, 0xab, 0x02, 0xd5, 0x23, 0x83, 0xf8, 0x03, 0x4e, 0xe6, 0x9b, 0x0b, 0x74, 0x07, 0x16, 0x3d,
+	0x1f, 0x33, 0xe5, 0x60, 0xfd, 0xb4, 0x8f, 0x85, 0x3c, 0x05, 0x6d, 0x21, 0x04, 0x3e, 0xeb, 0xe3,
+	0x00, 0x3d, 0x80, 0x8a, 0x85, 0x6d, 0x4c, 0xb1, 0xee, 0x19, 0xc4, 0xe7, 0x96, 0x9c, 0xe7, 0x68,
+	0x65, 0x01, 0x3e, 0x92, 0x50, 0xf5, 0xef, 0x0a, 0xac, 0xc8, 0xc1, 0x91, 0x8f, 0x2f, 0x08, 0xee,
+	0xfd, 0x1f, 0xa8, 0x6e, 0x74, 0x8f, 0xf9, 0xd1, 0x3d, 0xaa, 0x7b, 0x70, 0x33, 0x36, 0x8d, 0x1d,
+	0x29, 0xca, 0x15, 0xa4, 0x57, 0xff, 0xa1, 0x40, 0x2d, 0xa6, 0x24, 0xb5, 0x11, 0x12, 0x52, 0x61,
+	0xd1, 0xb3, 0x8d, 0x3e, 0xf6, 0x75, 0xd7, 0xc1, 0xf1, 0xab, 0x50, 0x12, 0xc0, 0x43, 0x07, 0x37,
+	0xad, 0x04, 0x0e, 0xed, 0xb9, 0x0c, 0x67, 0x26, 0x89, 0x73, 0xdc, 0x73, 0x9b, 0x56, 0x86, 0x8a,
+	0xb6, 0xa1, 0x1a, 0x60, 0xfb, 0x4c, 0x67, 0x98, 0xba, 0x8f, 0x83, 0xae, 0x4d, 0x6b, 0x39, 0xe9,
+	0x12, 0x99, 0x92, 0x62, 0xb1, 0x84, 0xf3, 0x61, 0x08, 0x5a, 0x99, 0x2d, 0x39, 0xb2, 0x8d, 0xbe,
+	0x18, 0xab, 0x7f, 0x54, 0xe0, 0xe6, 0x88, 0xfc, 0x57, 0xba, 0x02, 0x1f, 0x31, 0xff, 0x23, 0xed,
+	0x65, 0x96, 0x3b, 0x84, 0xef, 0x8c, 0xbf, 0x91, 0x83, 0xca, 0xd2, 0xa2, 0xd5, 0xea, 0x6f, 0x73,
+	0x70, 0x3b, 0xf9, 0x1c, 0x32, 0x41, 0x0f, 0x2f, 0xb0, 0xef, 0x13, 0x0b, 0x5f, 0x45, 0xb2, 0x91,
+	0x63, 0x98, 0x9d, 0xe2, 0x18, 0x72, 0x63, 0x8e, 0x21, 0x9f, 0x3c, 0x86, 0x75, 0xa8, 0x26, 0xa8,
+	0x07, 0xa6, 0xeb, 0x63, 0xfe, 0xc8, 0xe4, 0xb5, 0x72, 0xc4, 0xa0, 0xc5, 0xa0, 0x09, 0x4c, 0xc6,
+	0x43, 0x60, 0xce, 0x27, 0x31, 0x8f, 0x7b, 0xae, 0xc0, 0xdc, 0x85, 0xa5, 0x04, 0x4d, 0x79, 0xb6,
+	0x85, 0x49, 0x67, 0x5b, 0x89, 0xf8, 0x09, 0x40, 0x82, 0x0c, 0x63, 0x28, 0xc9, 0x14, 0xa7, 0x24,
+	0x73, 0xdc, 0x73, 0x25, 0x99, 0xef, 0x43, 0xa5, 0x6d, 0x74, 0xb0, 0x8e, 0x1d, 0x4b, 0xf7, 0xb1,
+	0x11, 0xb8, 0x4e, 0x0d, 0x38, 0x11, 0x14, 0x3d, 0x6d, 0xbb, 0xcc, 0x4b, 0xb1, 0x19, 0x6d, 0xb1,
+	0x9d, 0x1c, 0xa2, 0x5b, 0x50, 0x64, 0xf4, 0x2d, 0xc6, 0x83, 0x3f, 0x4b, 0x05, 0x2d, 0x06, 0xb0,
+	0xa7, 0x97, 0x53, 0x26, 0x8e, 0x85, 0x5f, 0xf2, 0xd7, 0x29, 0xaf, 0x15, 0x19, 0xa4, 0xc9, 0x00,
+	0xea, 0x9f, 0x14, 0xb8, 0x1b, 0x8b, 0x18, 0x47, 0x5d, 0xdb, 0x6e, 0xd7, 0xa1, 0x96, 0xdb, 0x73,
+	0xae, 0xcf, 0xdf, 0xac, 0x43, 0x35, 0x60, 0xf4, 0x75, 0xc3, 0xb6, 0x75, 0x0e, 0x0a, 0x9d, 0x63,
+	0x99, 0xc3, 0x1b, 0xb6, 0xcd, 0x59, 0x07, 0xea, 0x72, 0x32, 0xda, 0xd1, 0x70, 0xe0, 0xb9, 0x4e,
+	0x80, 0xd5, 0x0f, 0x60, 0x65, 0x28, 0x88, 0x13, 0x13, 0x69, 0x61, 0x10, 0x0f, 0x68, 0x66, 0xe2,
+	0x80, 0x46, 0x7d, 0x06, 0xb7, 0xf6, 0xc6, 0x3d, 0x76, 0xd3, 0xd0, 0xb8, 0x0f, 0xcb, 0x03, 0x34,
+	0x32, 0xd6, 0xaa, 0x0f, 0xe1, 0xb5, 0xe7, 0xc4, 0x21, 0xc1, 0xf9, 0x64, 0xd4, 0x5f, 0x41, 0xb9,
+	0x65, 0x9e, 0x63, 0xab, 0x6b, 0x63, 0x8b, 0x6f, 0x7e, 0x40, 0xaf, 0x4a, 0x96, 0x5e, 0x93, 0xd1,
+	0x2d, 0xfa, 0x1e, 0x80, 0xd0, 0x2b, 0x25, 0x1d, 0xcc, 0x55, 0x5e, 0xda, 0xaa, 0x6f, 0x88, 0x18,
+	0x7b, 0x23, 0x8c, 0xb1, 0x37, 0x8e, 0xc3, 0x18, 0x5b, 0x2b, 0x72, 0x6c, 0x36, 0x56, 0xff, 0xa3,
+	0x40, 0x3d, 0x4d, 0x27, 0x52, 0xb1, 0xd7, 0x10, 0x01, 0x30, 0xeb, 0x0c, 0xc3, 0xdf, 0xa0, 0x36,
+	0xc3, 0x23, 0xac, 0x18, 0x80, 0x9e, 0x42, 0x21, 0x90, 0xfb, 0x97, 0x1e, 0x4d, 0x4d, 0xe3, 0x30,
+	0xa8, 0x23, 0x2d, 0x5a, 0x83, 0xb6, 0x40, 0xc4, 0x34, 0xba, 0xd1, 0x33, 0x7c, 0x4b, 0x04, 0xdc,
+	0xa5, 0xad, 0x4a, 0x1c, 0xfa, 0x34, 0x18, 0x5c, 0x2b, 0x79, 0xd1, 0x77, 0xa0, 0xbe, 0x00, 0xa4,
+	0x61, 0x53, 0x5e, 0xc8, 0x4c, 0x3f, 0xfc, 0x3a, 0x14, 0x9d, 0x6e, 0x47, 0x67, 0x37, 0x25, 0x90,
+	0xfa, 0x2e, 0x38, 0xdd, 0x0e, 0x5f, 0x83, 0x56, 0x61, 0xce, 0x3d, 0x3b, 0x0b, 0x30, 0x95, 0x16,
+	0x2e, 0x47, 0xea, 0x8f, 0xe0, 0xc6, 0x00, 0x69, 0xa9, 0xc7, 0x77, 0x21, 0x2f, 0xe8, 0x28, 0x5c,
+	0xbc, 0x37, 0x52, 0x1c, 0xc3, 0xae, 0x63, 0x61, 0x6b, 0xf7, 0x82, 0x99, 0x8a, 0xc0, 0x55, 0x1f,
+	0x41, 0xed, 0xc4, 0x11, 0x47, 0x35, 0xd1, 0x8c, 0xfe, 0xa0, 0xc0, 0xea, 0xa7, 0x84, 0x9e, 0x5b,
+	0xbe, 0xd1, 0x3b, 0xe2, 0xae, 0xe5, 0x4a, 0xef, 0x4b, 0x0d, 0xe6, 0x85, 0x63, 0x12, 0xcf, 0x4b,
+	0x51, 0x0b, 0x87, 0xe8, 0x31, 0xcc, 0x79, 0xae, 0x4d, 0xcc, 0xbe, 0x7c, 0xfe, 0x56, 0xf8, 0x16,
+	0x42, 0x96, 0x86, 0x7d, 0xc4, 0x27, 0x35, 0x89, 0xa4, 0xfe, 0x5a, 0x81, 0x15, 0x0d, 0x1b, 0x56,
+	0x87, 0xd0, 0x57, 0x22, 0x8e, 0x0a, 0x8b, 0xa6, 0x41, 0xcd, 0x73, 0xbd, 0xeb, 0xc5, 0x41, 0x56,
+	0x5e, 0x2b, 0x71, 0xe0, 0x89, 0xc7, 0xe3, 0x0f, 0x15, 0xaa, 0x27, 0x8e, 0x79, 0x8e, 0xcd, 0x2f,
+	0x9b, 0x59, 0x4e, 0x4c, 0x5d, 0x83, 0xf2, 0x36, 0xc3, 0x20, 0x99, 0x18, 0x1f, 0x42, 0x45, 0xc3,
+	0x6d, 0x12, 0x50, 0xec, 0x5f, 0x25, 0x76, 0xd1, 0xa0, 0x1a, 0x2f, 0x97, 0xd6, 0xf0, 0x14, 0xe6,
+	0x02, 0x6a, 0xd0, 0x6e, 0xc0, 0x69, 0x94, 0xd3, 0xef, 0x94, 0x58, 0xe5, 0x1b, 0x94, 0xb8, 0x4e,
+	0x8b, 0x63, 0x6b, 0x72, 0x95, 0xfa, 0xb5, 0x02, 0x6f, 0xc4, 0xf1, 0x54, 0x8c, 0x16, 0x64, 0x3a,
+	0xc4, 0x71, 0x4a, 0xde, 0x84, 0x79, 0x0f, 0x3b, 0x16, 0x71, 0xda, 0xf2, 0x02, 0xae, 0x0c, 0x59,
+	0xe7, 0x11, 0xf6, 0xd9, 0xa3, 0x13, 0x62, 0xa1, 0x77, 0xa0, 0xd0, 0x33, 0x08, 0xb5, 0x49, 0x40,
+	0x6b, 0xb9, 0x71, 0x2b, 0x22, 0x34, 0xf5, 0x5f, 0x0a, 0x2c, 0x36, 0xba, 0x16, 0xa1, 0xfb, 0x6e,
+	0x7b, 0xd7, 0xa1, 0x7e, 0x9f, 0x79, 0x32, 0x83, 0x79, 0x00, 0x29, 0xa4, 0x18, 0xb0, 0x6b, 0x65,
+	0x98, 0x34, 0x96, 0x52, 0x8e, 0x06, 0xe4, 0x9f, 0x4d, 0x31, 0x12, 0xa3, 0x6f, 0xbb, 0x46, 0x18,
+	0x4f, 0x84, 0x43, 0xe6, 0xc2, 0x2d, 0x72, 0x76, 0xc6, 0x43, 0x89, 0xa2, 0xc6, 0xbf, 0x99, 0xaf,
+	0x34, 0x7d, 0x6c, 0x50, 0x6c, 0xe9, 0x06, 0xad, 0xcd, 0x4d, 0xf6, 0x95, 0x12, 0xbb, 0x41, 0xd5,
+	0x43, 0xa8, 0x84, 0x7b, 0xc8, 0xb2, 0x84, 0x65, 0xc8, 0xdb, 0xa4, 0x43, 0x68, 0xe8, 0x9f, 0xf9,
+	0x20, 0xd3, 0x59, 0x1c, 0x42, 0x35, 0x26, 0x28, 0x4f, 0xee, 0x03, 0x98, 0xc7, 0x0e, 0xf5, 0x49,
+	0xe4, 0x2b, 0xde, 0x4a, 0x33, 0x8e, 0x01, 0x5d, 0x6a, 0xe1, 0x0a, 0xb5, 0x0f, 0xab, 0xdb, 0xb6,
+	0xeb, 0xe0, 0x89, 0xfe, 0x22, 0xb5, 0x50, 0x10, 0xbe, 0x78, 0xb3, 0x89, 0x32, 0xc0, 0x03, 0xa8,
+	0x10, 0xc7, 0xb4, 0xbb, 0x16, 0xd6, 0xc3, 0x9b, 0x28, 0x5f, 0x6c, 0x09, 0x96, 0xb7, 0x5b, 0xfd,
+	0xbd, 0x92, 0x7c, 0xb2, 0x5b, 0x8e, 0xe1, 0x05, 0xe7, 0x6e, 0x92, 0x6f, 0x2e, 0x52, 0x90, 0x71,
+	0x8a, 0x6d, 0xc9, 0x58, 0x0c, 0x62, 0x63, 0x98, 0x4d, 0x1a, 0xc3, 0xe0, 0x51, 0xe5, 0x2e, 0x73,
+	0x54, 0xef, 0x43, 0x25, 0x14, 0x61, 0xdc, 0x51, 0x8d, 0x48, 0xa2, 0xbe, 0x80, 0xa5, 0x70, 0x61,
+	0x7c, 0x9b, 0x76, 0xa0, 0x18, 0x84, 0x40, 0x79, 0x2a, 0x13, 0x9e, 0xc1, 0x88, 0x79, 0xbc, 0x50,
+	0x6d, 0xc2, 0xaa, 0x86, 0x03, 0xea, 0xfa, 0x78, 0x92, 0x68, 0xb7, 0xa1, 0x14, 0x2e, 0x0b, 0x93,
+	0x95, 0x9c, 0x06, 0x21, 0xa8, 0x69, 0xa9, 0xbf, 0x51, 0x60, 0x65, 0xdf, 0xb0, 0x2c, 0xec, 0x4f,
+	0xca, 0xa7, 0xdf, 0x83, 0xd5, 0x8e, 0xf1, 0x52, 0x37, 0xcf, 0x0d, 0xdb, 0xc6, 0x4e, 0x1b, 0xeb,
+	0x16, 0x09, 0xa8, 0xe1, 0x98, 0x58, 0x5a, 0xe8, 0x72, 0xc7, 0x78, 0xb9, 0x1d, 0x4e, 0xee, 0xc8,
+	0x39, 0x74, 0x0f, 0xca, 0x86, 0x69, 0x62, 0x8f, 0x6d, 0xcd, 0x74, 0x59, 0x98, 0x26, 0x0c, 0x77,
+	0x51, 0x40, 0x5b, 0x02, 0xa8, 0xee, 0xc0, 0xaa, 0x94, 0x22, 0xa4, 0x30, 0xc6, 0x43, 0xba, 0x9e,
+	0xe7, 0x3a, 0xd8, 0xa1, 0xa1, 0xff, 0x09, 0xc7, 0xcc, 0x4d, 0xef, 0x61, 0x2a, 0x08, 0x65, 0x39,
+	0xe1, 0xff, 0x2a, 0x50, 0x39, 0xf2, 0xdd, 0x9f, 0x63, 0x93, 0x62, 0x4b, 0x33, 0x58, 0x45, 0x06,
+	0xbd, 0x06, 0xf3, 0xdd, 0x00, 0xfb, 0x71, 0xca, 0x37, 0xc7, 0x86, 0x4d, 0x8b, 0x5d, 0x37, 0x9f,
+	0xa3, 0x70, 0x56, 0x8a, 0x26, 0x47, 0xac, 0xec, 0x22, 0xbe, 0x74, 0x0b, 0x5f, 0x10, 0x23, 0xaa,
+	0x86, 0x29, 0x5a, 0x45, 0xc0, 0x77, 0x42, 0x30, 0x8b, 0x99, 0x1d, 0xdc, 0xd3, 0x25, 0x99, 0x1c,
+	0x47, 0x2a, 0x3a, 0xb8, 0x27, 0x59, 0xbf, 0x0d, 0xcb, 0xf1, 0x74, 0x82, 0x5a, 0x9e, 0x23, 0xa2,
+	0x08, 0x31, 0x26, 0x78, 0x0f, 0xca, 0x6c, 0xc5, 0x85, 0x6b, 0x1b, 0x94, 0xd8, 0x84, 0xf6, 0xb9,
+	0xeb, 0x51, 0xb4, 0x45, 0x07, 0xf7, 0x3e, 0x89, 0x80, 0xcc, 0x28, 0x45, 0x9c, 0x20, 0x52, 0x16,
+	0x31, 0x50, 0xff, 0xa6, 0xc0, 0xb2, 0x20, 0x78, 0x84, 0x7d, 0xe2, 0x5a, 0x57, 0x72, 0xf3, 0x35,
+	0x98, 0xbf, 0x30, 0x7c, 0x62, 0x38, 0x54, 0xde, 0xb2, 0x70, 0xc8, 0x66, 0x0c, 0xcf, 0xb3, 0x09,
+	0xb6, 0xe4, 0xdd, 0x0e, 0x87, 0xe8, 0x43, 0x98, 0x17, 0x7b, 0x64, 0x09, 0x3e, 0x33, 0xfb, 0x3b,
+	0x69, 0x66, 0x3f, 0x74, 0x30, 0x5a, 0xb8, 0x46, 0xfd, 0x82, 0xc7, 0xeb, 0xa2, 0x8a, 0x16, 0x24,
+	0x93, 0xff, 0x0d, 0xc8, 0x59, 0x06, 0xc5, 0x35, 0x65, 0xe2, 0x9d, 0xe6, 0x78, 0xec, 0xa8, 0x79,
+	0xb9, 0x2e, 0xca, 0xdc, 0xe7, 0xd8, 0xb0, 0x69, 0xa9, 0x07, 0x70, 0x63, 0x80, 0xbc, 0xd4, 0xcb,
+	0x54, 0x95, 0xe2, 0xb4, 0x00, 0x9f, 0x97, 0x84, 0x7c, 0xb7, 0xe3, 0xd2, 0x31, 0xa5, 0x8a, 0xfb,
+	0x50, 0x71, 0xf0, 0x4b, 0xb6, 0x7f, 0xce, 0x35, 0x96, 0x6a, 0x91, 0x81, 0xa5, 0x2c, 0x4d, 0x4b,
+	0x04, 0xbe, 0x42, 0xfb, 0x61, 0xfc, 0x12, 0x03, 0x78, 0x29, 0x91, 0x73, 0x92, 0x6a, 0xcf, 0x6b,
+	0xd1, 0x98, 0xad, 0xf4, 0xb1, 0x8d, 0xdb, 0xcc, 0x9b, 0xc9, 0x44, 0x38, 0x06, 0xa8, 0x4d, 0x58,
+	0x4a, 0xc8, 0x28, 0xb7, 0xfc, 0x5e, 0x92, 0x99, 0xf0, 0x51, 0xab, 0xa9, 0xaf, 0x72, 0x90, 0x10,
+	0x42, 0x7d, 0x01, 0x37, 0x45, 0xb8, 0x9a, 0xd0, 0x62, 0x30, 0xc6, 0x63, 0x9a, 0x6e, 0xd7, 0x89,
+	0x1e, 0x37, 0x3e, 0xc8, 0x7c, 0xdc, 0x3e, 0x87, 0xe5, 0x41, 0xa2, 0x52, 0xd0, 0x6d, 0x28, 0x48,
+	0xc5, 0x85, 0x72, 0x3e, 0x48, 0x33, 0xaa, 0x94, 0x63, 0xd5, 0xa2, 0x85, 0xea, 0x21, 0x20, 0x86,
+	0xf0, 0x31, 0xee, 0x9c, 0x8e, 0x09, 0x2d, 0x1f, 0xc2, 0x7c, 0x47, 0x60, 0xd4, 0x66, 0x12, 0x69,
+	0x41, 0xbc, 0x52, 0x0b, 0xe7, 0xd5, 0x8f, 0x84, 0x21, 0x4d, 0x72, 0xa7, 0x6f, 0xc1, 0x82, 0x5c,
+	0xa1, 0xbb, 0x8e, 0xdd, 0xe7, 0x9a, 0x28, 0x68, 0x25, 0x09, 0x3b, 0x74, 0xec, 0xbe, 0x7a, 0x0a,
+	0xf5, 0x84, 0xec, 0xc7, 0xb8, 0xe3, 0xd9, 0x06, 0xc5, 0xd9, 0xfe, 0xb9, 0x40, 0x25, 0x0a, 0x27,
+	0x56, 0xda, 0xaa, 0x45, 0x32, 0x0e, 0x93, 0x88, 0x30, 0x87, 0x78, 0x84, 0xb9, 0xd1, 0x18, 0x1e,
+	0x51, 0x8a, 0x95, 0xc1, 0x23, 0x22, 0x11, 0x61, 0xb2, 0xc8, 0x78, 0x0f, 0x73, 0xbb, 0xc8, 0x72,
+	0xca, 0x7f, 0x56, 0x00, 0xf8, 0xfc, 0xab, 0xf7, 0xc7, 0x91, 0x5f, 0xcc, 0x25, 0xfc, 0x22, 0x23,
+	0x2c, 0x34, 0x2f, 0xcb, 0x8f, 0x72, 0xa4, 0x6a, 0x80, 0x62, 0xb9, 0x32, 0xcf, 0x32, 0x36, 0xdc,
+	0x99, 0xa4, 0xe1, 0xc6, 0x31, 0xdc, 0x6c, 0x22, 0x86, 0x53, 0xbb, 0x70, 0x63, 0x80, 0x66, 0x86,
+	0x07, 0x7e, 0x12, 0x7b, 0x4c, 0x61, 0x72, 0x6f, 0x66, 0x19, 0xf7, 0x90, 0xb3, 0x64, 0x6c, 0xa9,
+	0x4b, 0x0d, 0x3b, 0x64, 0xcb, 0x07, 0xea, 0x13, 0xa8, 0xf2, 0x63, 0xa2, 0x06, 0x0d, 0x2e, 0x15,
+	0x74, 0xaa, 0xbf, 0x84, 0x25, 0x79, 0xb8, 0x0d, 0x4a, 0xb1, 0x63, 0xf1, 0x87, 0xfd, 0xaa, 0x8e,
+	0x71, 0x30, 0x13, 0x63, 0x5c, 0xc2, 0x61, 0xfa, 0xd1, 0xa8, 0x7a, 0xf2, 0x82, 0x36, 0x4c, 0x4a,
+	0x2e, 0xd8, 0xf3, 0x96, 0x69, 0x22, 0xf5, 0x84, 0x53, 0x90, 0xa9, 0x76, 0x38, 0x8e, 0x19, 0xcc,
+	0x26, 0x19, 0x7c, 0xad, 0xc0, 0x52, 0x42, 0x33, 0x19, 0xc7, 0xd1, 0x18, 0xa0, 0xcb, 0xce, 0xe3,
+	0x5e, 0x6a, 0x75, 0x61, 0x58, 0x51, 0x09, 0xf6, 0x7b, 0x50, 0xea, 0xb8, 0x01, 0xd5, 0x59, 0x26,
+	0x72, 0x11, 0xd6, 0x28, 0xee, 0x67, 0x9d, 0xea, 0xe0, 0x86, 0x35, 0x60, 0x4b, 0xf9, 0x08, 0x3f,
+	0x7a, 0x06, 0x79, 0xde, 0x31, 0x43, 0x0b, 0x50, 0x68, 0x1d, 0x37, 0x0e, 0x76, 0x1a, 0xda, 0x4e,
+	0xf5, 0x5b, 0xa8, 0x00, 0xb9, 0xed, 0xfd, 0x93, 0x67, 0x55, 0x05, 0x15, 0x21, 0xbf, 0xfd, 0x51,
+	0x73, 0x7f, 0xa7, 0x3a, 0x83, 0x00, 0xe6, 0xf6, 0x77, 0xf7, 0x1a, 0xdb, 0x2f, 0xaa, 0xb3, 0xfc,
+	0xbb, 0xb1, 0xb3, 0xb3, 0xab, 0x55, 0x73, 0x8f, 0xce, 0x01, 0x8d, 0xe6, 0x85, 0xe8, 0x16, 0xd4,
+	0xb4, 0xdd, 0xbd, 0x66, 0xeb, 0x58, 0x6b, 0x1c, 0x37, 0x0f, 0x0f, 0xf4, 0x93, 0x83, 0xd6, 0xd1,
+	0xee, 0x76, 0xf3, 0x79, 0x73, 0x97, 0x31, 0x28, 0x03, 0x88, 0xd9, 0x5d, 0x6d, 0x77, 0xa7, 0xaa,
+	0xb0, 0xf1, 0xa7, 0x8d, 0xe6, 0xf1, 0x3e, 0x83, 0x30, 0x5e, 0xcb, 0x50, 0x3d, 0xda, 0x3d, 0xd8,
+	0x69, 0x1e, 0xec, 0xe9, 0x8d, 0xa3, 0x23, 0xed, 0xf0, 0x93, 0xc6, 0x7e, 0x75, 0x76, 0xeb, 0x77,
+	0x0f, 0x61, 0x29, 0x11, 0xcf, 0x8a, 0x2d, 0x22, 0x0b, 0x16, 0x07, 0x2a, 0x70, 0x68, 0x3d, 0x4d,
+	0x11, 0x69, 0x9d, 0xd6, 0xfa, 0xc3, 0x29, 0x30, 0xe5, 0x29, 0xf6, 0x61, 0x25, 0xb5, 0x54, 0x87,
+	0xde, 0x4e, 0xa3, 0x31, 0xae, 0xaa, 0x57, 0xdf, 0x98, 0xb2, 0x5c, 0x15, 0xb2, 0xfe, 0x14, 0x16,
+	0x07, 0xe8, 0xa5, 0x6f, 0x30, 0xad, 0x08, 0x58, 0xbf, 0xc5, 0x9d, 0xea, 0xf3, 0xae, 0x6d, 0x8f,
+	0x76, 0x3d, 0x02, 0xd4, 0x86, 0xea, 0x70, 0x49, 0x10, 0x7d, 0x3b, 0x8d, 0x76, 0x46, 0xe1, 0xb0,
+	0x3e, 0x21, 0xe3, 0x88, 0x76, 0xf0, 0x15, 0xac, 0xb4, 0xa6, 0x57, 0xde, 0xb8, 0xfe, 0xdf, 0xd4,
+	0x2c, 0x5f, 0x40, 0x31, 0xea, 0xa3, 0xa1, 0xbb, 0xa9, 0x21, 0xe2, 0x50, 0x9b, 0x6d, 0x6a, 0xd2,
+	0x9f, 0x41, 0x55, 0xb6, 0xbd, 0x62, 0x0e, 0x0f, 0xb3, 0x38, 0x8c, 0xf4, 0xc8, 0xea, 0xaf, 0x8b,
+	0x3a, 0xe0, 0xd0, 0x9c, 0xa4, 0xed, 0xc1, 0x6a, 0x0b, 0xd3, 0x94, 0xe6, 0x24, 0x4a, 0xb5, 0x9a,
+	0xec, 0x2e, 0xe6, 0xd4, 0xbb, 0xf9, 0x04, 0xaa, 0x2d, 0x4c, 0x07, 0x79, 0xd5, 0xb9, 0x88, 0x51,
+	0x39, 0x27, 0x39, 0x37, 0x35, 0xdd, 0x63, 0xb8, 0xd1, 0xc2, 0x91, 0xb1, 0x45, 0xa4, 0x57, 0x06,
+	0x48, 0x5f, 0x9a, 0xea, 0x01, 0x2c, 0x34, 0x2c, 0x6b, 0x27, 0x2a, 0xd5, 0x66, 0xc4, 0x93, 0x53,
+	0xd3, 0xfb, 0x31, 0xab, 0xa4, 0x75, 0xdc, 0x0b, 0x7c, 0x7d, 0x24, 0x2d, 0x28, 0x71, 0x11, 0x65,
+	0x8e, 0xf3, 0x78, 0xfc, 0xb2, 0xa1, 0x1e, 0xe4, 0xd4, 0x5c, 0xda, 0x50, 0x0e, 0x05, 0x7f, 0xb5,
+	0x8c, 0xf6, 0x01, 0x1a, 0x96, 0x25, 0x4b, 0x2a, 0xdf, 0x58, 0x39, 0x87, 0xb0, 0x28, 0xc4, 0xbe,
+	0x2e, 0x82, 0x18, 0x2a, 0x43, 0x35, 0x66, 0xf4, 0x28, 0x6d, 0x69, 0x7a, 0x21, 0x7a, 0x6a, 0x36,
+	0x26, 0x94, 0x07, 0x4b, 0xc7, 0xe9, 0x37, 0x3e, 0xb5, 0xbc, 0x7c, 0x09, 0x26, 0xd0, 0xc2, 0x61,
+	0x7b, 0x74, 0xd2, 0x79, 0x0e, 0xb5, 0x6c, 0xa7, 0x66, 0x72, 0x0e, 0x45, 0x76, 0xdf, 0x45, 0x87,
+	0xef, 0xdd, 0x89, 0x8b, 0x46, 0x5b, 0xb0, 0x53, 0x73, 0xea, 0xc1, 0x8d, 0x94, 0xd6, 0x1d, 0x7a,
+	0x32, 0xa1, 0x4c, 0x95, 0xd9, 0xed, 0x9b, 0x9a, 0xf1, 0xcf, 0xa0, 0x94, 0x68, 0x78, 0xa0, 0x8c,
+	0x52, 0xf6, 0x70, 0xb3, 0xa5, 0xfe, 0x60, 0x22, 0x5e, 0x74, 0xf7, 0x96, 0xb6, 0x79, 0x59, 0x2f,
+	0x91, 0xad, 0xa0, 0xac, 0x58, 0x62, 0xb4, 0xd4, 0x50, 0x9f, 0x36, 0xb9, 0x44, 0x1e, 0x0f, 0x3a,
+	0x46, 0xb3, 0xe1, 0x74, 0xdb, 0xc8, 0xcc, 0x9a, 0xeb, 0xeb, 0x13, 0x18, 0xc6, 0x5b, 0x33, 0x00,
+	0x89, 0x3c, 0x1e, 0x37, 0xd8, 0xc3, 0x28, 0xd2, 0xfb, 0x8c, 0xf7, 0x73, 0xa8, 0x26, 0x51, 0xbf,
+	0x37, 0x01, 0x4b, 0xb2, 0x78, 0x02, 0xf3, 0x32, 0x89, 0x43, 0x6a, 0x46, 0x20, 0x93, 0xc8, 0xf0,
+	0xea, 0xc5, 0x28, 0x2f, 0x44, 0x5f, 0x40, 0xb9, 0x61, 0x59, 0x89, 0x24, 0x1b, 0x4d, 0x88, 0x79,
+	0xaf, 0x70, 0x03, 0x97, 0x84, 0x7b, 0x7a, 0x95, 0x4c, 0x4e, 0xa1, 0xd2, 0x12, 0x7b, 0x8c, 0x5e,
+	0xc5, 0x4c, 0x8b, 0xb8, 0xea, 0xab, 0x2e, 0xe2, 0x88, 0x94, 0x8c, 0x3d, 0x3d, 0x8e, 0xc8, 0xae,
+	0x0e, 0x5c, 0x9d, 0x63, 0x98, 0xbf, 0x4f, 0xe4, 0x38, 0x54, 0x2b, 0x98, 0x9a, 0xa3, 0x11, 0x57,
+	0x03, 0x64, 0xbe, 0x7a, 0x7f, 0x7c, 0x62, 0x1b, 0x4c, 0xbc, 0x80, 0xc3, 0xa9, 0xf4, 0xe7, 0xb0,
+	0x20, 0x59, 0xf0, 0x9c, 0x2e, 0xfd, 0x22, 0x0c, 0x27, 0xc3, 0xf5, 0x7b, 0x13, 0xb0, 0x24, 0x71,
+	0x02, 0x4b, 0x23, 0xbd, 0x54, 0x94, 0xfa, 0xdb, 0x99, 0xac, 0x96, 0xeb, 0x65, 0xa2, 0xe1, 0xa8,
+	0xed, 0x98, 0xbe, 0x89, 0xe1, 0xae, 0xe4, 0xd4, 0xa4, 0x4f, 0x60, 0x7e, 0x5b, 0x12, 0x4e, 0xbd,
+	0xce, 0x83, 0xad, 0xcc, 0x4b, 0x04, 0x66, 0xb0, 0x87, 0xe9, 0x33, 0xdf, 0x30, 0xbf, 0xc4, 0xf4,
+	0xb2, 0xb1, 0xcd, 0x32, 0xf7, 0x19, 0x72, 0x71, 0x42, 0xd2, 0x42, 0xd8, 0xf6, 0x44, 0x77, 0xb2,
+	0xdb, 0x9b, 0x51, 0xc5, 0xbf, 0x7e, 0x77, 0x3c, 0x52, 0xa4, 0x5b, 0x38, 0x71, 0xfc, 0x4b, 0x11,
+	0x9e, 0x3e, 0x36, 0x5f, 0x6e, 0x78, 0x9e, 0xef, 0x5e, 0xe0, 0x81, 0x96, 0xea, 0x37, 0x0e, 0x9a,
+	0x4e, 0xd8, 0x2f, 0x02, 0x58, 0x7d, 0xfc, 0x7a, 0xc9, 0x52, 0xde, 0x35, 0x19, 0xa4, 0x79, 0xc9,
+	0x93, 0x7b, 0x27, 0x0d, 0x7d, 0x7c, 0x5f, 0xf9, 0x27, 0x50, 0xda, 0xc3, 0x34, 0xec, 0x3e, 0xa6,
+	0x1f, 0xc0, 0x50, 0x8f, 0xb4, 0x7e, 0x77, 0x3c, 0x52, 0xa4, 0x26, 0xb6, 0x9f, 0xe8, 0xe7, 0x6a,
+	0x9e, 0xeb, 0x5f, 0xda, 0x12, 0x51, 0x32, 0xd9, 0x93, 0x24, 0xbe, 0x82, 0x55, 0xa6, 0xa6, 0x44,
+	0xf3, 0x44, 0x66, 0x81, 0x97, 0x25, 0x9e, 0xfa, 0xac, 0xa7, 0x36, 0x65, 0xce, 0xa1, 0x32, 0xd4,
+	0x84, 0x4d, 0x8f, 0x92, 0xd3, 0x3b, 0xb5, 0x97, 0xa9, 0x93, 0xfc, 0x14, 0xca, 0x22, 0x36, 0x8a,
+	0xda, 0xad, 0xa9, 0x07, 0x32, 0xd4, 0x6e, 0xac, 0x4f, 0xd9, 0xbb, 0x44, 0x3a, 0x77, 0xc8, 0xe1,
+	0x30, 0xb8, 0x44, 0x29, 0xe4, 0xde, 0x38, 0x31, 0x82, 0x64, 0x3e, 0x31, 0xd4, 0x11, 0x4d, 0xd7,
+	0x54, 0x7a, 0xdb, 0x74, 0xea, 0xab, 0xf2, 0x14, 0x8a, 0x51, 0x83, 0x31, 0xdd, 0x21, 0x0f, 0xf7,
+	0x1f, 0xeb, 0x25, 0x6e, 0x4a, 0x72, 0xc9, 0x19, 0xab, 0xa4, 0xd2, 0xc1, 0x7e, 0x6b, 0x7a, 0x08,
+	0x9a, 0xda, 0x93, 0x9d, 0x5a, 0x4e, 0x0d, 0xaa, 0x0d, 0xcb, 0x12, 0x34, 0xae, 0x2b, 0x65, 0xe3,
+	0xde, 0x87, 0x05, 0x59, 0xd7, 0x4b, 0xf6, 0x4b, 0x58, 0x89, 0x7a, 0xbe, 0x49, 0xca, 0xe9, 0xe7,
+	0x97, 0xde, 0x24, 0x9e, 0x96, 0xd9, 0xb3, 0xa7, 0x9f, 0xfd, 0xa0, 0x4d, 0xe8, 0x79, 0xf7, 0x74,
+	0xc3, 0x74, 0x3b, 0x9b, 0x96, 0xdb, 0x21, 0x8e, 0xfb, 0xce, 0x7b, 0x9b, 0x36, 0xe1, 0x7f, 0x39,
+	0xd9, 0xf4, 0x3d, 0x73, 0x73, 0xdc, 0x1f, 0x5b, 0x4e, 0xe7, 0xf8, 0xcc, 0xbb, 0xff, 0x0b, 0x00,
+	0x00, 0xff, 0xff, 0xbb, 0x03, 0x7a, 0x7d, 0xff, 0x32, 0x00, 0x00,
 }
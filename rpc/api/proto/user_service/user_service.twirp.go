@@ -2699,6 +2699,15 @@ func (s *registrationServiceServer) PathPrefix() string {
 type ProfileService interface {
 	GetRatings(context.Context, *RatingsRequest) (*RatingsResponse, error)
 
+	GetRatingHistory(context.Context, *RatingHistoryRequest) (*RatingHistoryResponse, error)
+
+	GetLeaderboard(context.Context, *LeaderboardRequest) (*LeaderboardResponse, error)
+
+	// GetExpectedScore returns the odds of a game between two players. The
+	// seek form does not show them yet, and tournaments do not use them to
+	// estimate who is still in contention.
+	GetExpectedScore(context.Context, *ExpectedScoreRequest) (*ExpectedScoreResponse, error)
+
 	GetStats(context.Context, *StatsRequest) (*StatsResponse, error)
 
 	GetProfile(context.Context, *ProfileRequest) (*ProfileResponse, error)
@@ -2720,7 +2729,7 @@ type ProfileService interface {
 
 type profileServiceProtobufClient struct {
 	client      HTTPClient
-	urls        [8]string
+	urls        [11]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -2748,8 +2757,11 @@ func NewProfileServiceProtobufClient(baseURL string, client HTTPClient, opts ...
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "user_service", "ProfileService")
-	urls := [8]string{
+	urls := [11]string{
 		serviceURL + "GetRatings",
+		serviceURL + "GetRatingHistory",
+		serviceURL + "GetLeaderboard",
+		serviceURL + "GetExpectedScore",
 		serviceURL + "GetStats",
 		serviceURL + "GetProfile",
 		serviceURL + "GetPersonalInfo",
@@ -2813,6 +2825,144 @@ func (c *profileServiceProtobufClient) callGetRatings(ctx context.Context, in *R
 	return out, nil
 }
 
+func (c *profileServiceProtobufClient) GetRatingHistory(ctx context.Context, in *RatingHistoryRequest) (*RatingHistoryResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "user_service")
+	ctx = ctxsetters.WithServiceName(ctx, "ProfileService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetRatingHistory")
+	caller := c.callGetRatingHistory
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RatingHistoryRequest) (*RatingHistoryResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RatingHistoryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RatingHistoryRequest) when calling interceptor")
+					}
+					return c.callGetRatingHistory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RatingHistoryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RatingHistoryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *profileServiceProtobufClient) callGetRatingHistory(ctx context.Context, in *RatingHistoryRequest) (*RatingHistoryResponse, error) {
+	out := new(RatingHistoryResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *profileServiceProtobufClient) GetLeaderboard(ctx context.Context, in *LeaderboardRequest) (*LeaderboardResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "user_service")
+	ctx = ctxsetters.WithServiceName(ctx, "ProfileService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetLeaderboard")
+	caller := c.callGetLeaderboard
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *LeaderboardRequest) (*LeaderboardResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LeaderboardRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LeaderboardRequest) when calling interceptor")
+					}
+					return c.callGetLeaderboard(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LeaderboardResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LeaderboardResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *profileServiceProtobufClient) callGetLeaderboard(ctx context.Context, in *LeaderboardRequest) (*LeaderboardResponse, error) {
+	out := new(LeaderboardResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *profileServiceProtobufClient) GetExpectedScore(ctx context.Context, in *ExpectedScoreRequest) (*ExpectedScoreResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "user_service")
+	ctx = ctxsetters.WithServiceName(ctx, "ProfileService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetExpectedScore")
+	caller := c.callGetExpectedScore
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ExpectedScoreRequest) (*ExpectedScoreResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ExpectedScoreRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ExpectedScoreRequest) when calling interceptor")
+					}
+					return c.callGetExpectedScore(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ExpectedScoreResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ExpectedScoreResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *profileServiceProtobufClient) callGetExpectedScore(ctx context.Context, in *ExpectedScoreRequest) (*ExpectedScoreResponse, error) {
+	out := new(ExpectedScoreResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 func (c *profileServiceProtobufClient) GetStats(ctx context.Context, in *StatsRequest) (*StatsResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "user_service")
 	ctx = ctxsetters.WithServiceName(ctx, "ProfileService")
@@ -2844,7 +2994,7 @@ func (c *profileServiceProtobufClient) GetStats(ctx context.Context, in *StatsRe
 
 func (c *profileServiceProtobufClient) callGetStats(ctx context.Context, in *StatsRequest) (*StatsResponse, error) {
 	out := new(StatsResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -2890,7 +3040,7 @@ func (c *profileServiceProtobufClient) GetProfile(ctx context.Context, in *Profi
 
 func (c *profileServiceProtobufClient) callGetProfile(ctx context.Context, in *ProfileRequest) (*ProfileResponse, error) {
 	out := new(ProfileResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -2936,7 +3086,7 @@ func (c *profileServiceProtobufClient) GetPersonalInfo(ctx context.Context, in *
 
 func (c *profileServiceProtobufClient) callGetPersonalInfo(ctx context.Context, in *PersonalInfoRequest) (*PersonalInfoResponse, error) {
 	out := new(PersonalInfoResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -2982,7 +3132,7 @@ func (c *profileServiceProtobufClient) UpdatePersonalInfo(ctx context.Context, i
 
 func (c *profileServiceProtobufClient) callUpdatePersonalInfo(ctx context.Context, in *UpdatePersonalInfoRequest) (*UpdatePersonalInfoResponse, error) {
 	out := new(UpdatePersonalInfoResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -3028,7 +3178,7 @@ func (c *profileServiceProtobufClient) UpdateAvatar(ctx context.Context, in *Upd
 
 func (c *profileServiceProtobufClient) callUpdateAvatar(ctx context.Context, in *UpdateAvatarRequest) (*UpdateAvatarResponse, error) {
 	out := new(UpdateAvatarResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -3074,7 +3224,7 @@ func (c *profileServiceProtobufClient) RemoveAvatar(ctx context.Context, in *Rem
 
 func (c *profileServiceProtobufClient) callRemoveAvatar(ctx context.Context, in *RemoveAvatarRequest) (*RemoveAvatarResponse, error) {
 	out := new(RemoveAvatarResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -3120,7 +3270,7 @@ func (c *profileServiceProtobufClient) GetBriefProfiles(ctx context.Context, in
 
 func (c *profileServiceProtobufClient) callGetBriefProfiles(ctx context.Context, in *BriefProfilesRequest) (*BriefProfilesResponse, error) {
 	out := new(BriefProfilesResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -3141,7 +3291,7 @@ func (c *profileServiceProtobufClient) callGetBriefProfiles(ctx context.Context,
 
 type profileServiceJSONClient struct {
 	client      HTTPClient
-	urls        [8]string
+	urls        [11]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -3169,8 +3319,11 @@ func NewProfileServiceJSONClient(baseURL string, client HTTPClient, opts ...twir
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "user_service", "ProfileService")
-	urls := [8]string{
+	urls := [11]string{
 		serviceURL + "GetRatings",
+		serviceURL + "GetRatingHistory",
+		serviceURL + "GetLeaderboard",
+		serviceURL + "GetExpectedScore",
 		serviceURL + "GetStats",
 		serviceURL + "GetProfile",
 		serviceURL + "GetPersonalInfo",
@@ -3234,6 +3387,144 @@ func (c *profileServiceJSONClient) callGetRatings(ctx context.Context, in *Ratin
 	return out, nil
 }
 
+func (c *profileServiceJSONClient) GetRatingHistory(ctx context.Context, in *RatingHistoryRequest) (*RatingHistoryResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "user_service")
+	ctx = ctxsetters.WithServiceName(ctx, "ProfileService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetRatingHistory")
+	caller := c.callGetRatingHistory
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RatingHistoryRequest) (*RatingHistoryResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RatingHistoryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RatingHistoryRequest) when calling interceptor")
+					}
+					return c.callGetRatingHistory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RatingHistoryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RatingHistoryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *profileServiceJSONClient) callGetRatingHistory(ctx context.Context, in *RatingHistoryRequest) (*RatingHistoryResponse, error) {
+	out := new(RatingHistoryResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *profileServiceJSONClient) GetLeaderboard(ctx context.Context, in *LeaderboardRequest) (*LeaderboardResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "user_service")
+	ctx = ctxsetters.WithServiceName(ctx, "ProfileService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetLeaderboard")
+	caller := c.callGetLeaderboard
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *LeaderboardRequest) (*LeaderboardResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LeaderboardRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LeaderboardRequest) when calling interceptor")
+					}
+					return c.callGetLeaderboard(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LeaderboardResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LeaderboardResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *profileServiceJSONClient) callGetLeaderboard(ctx context.Context, in *LeaderboardRequest) (*LeaderboardResponse, error) {
+	out := new(LeaderboardResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *profileServiceJSONClient) GetExpectedScore(ctx context.Context, in *ExpectedScoreRequest) (*ExpectedScoreResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "user_service")
+	ctx = ctxsetters.WithServiceName(ctx, "ProfileService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetExpectedScore")
+	caller := c.callGetExpectedScore
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ExpectedScoreRequest) (*ExpectedScoreResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ExpectedScoreRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ExpectedScoreRequest) when calling interceptor")
+					}
+					return c.callGetExpectedScore(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ExpectedScoreResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ExpectedScoreResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *profileServiceJSONClient) callGetExpectedScore(ctx context.Context, in *ExpectedScoreRequest) (*ExpectedScoreResponse, error) {
+	out := new(ExpectedScoreResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 func (c *profileServiceJSONClient) GetStats(ctx context.Context, in *StatsRequest) (*StatsResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "user_service")
 	ctx = ctxsetters.WithServiceName(ctx, "ProfileService")
@@ -3265,7 +3556,7 @@ func (c *profileServiceJSONClient) GetStats(ctx context.Context, in *StatsReques
 
 func (c *profileServiceJSONClient) callGetStats(ctx context.Context, in *StatsRequest) (*StatsResponse, error) {
 	out := new(StatsResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -3311,7 +3602,7 @@ func (c *profileServiceJSONClient) GetProfile(ctx context.Context, in *ProfileRe
 
 func (c *profileServiceJSONClient) callGetProfile(ctx context.Context, in *ProfileRequest) (*ProfileResponse, error) {
 	out := new(ProfileResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -3357,7 +3648,7 @@ func (c *profileServiceJSONClient) GetPersonalInfo(ctx context.Context, in *Pers
 
 func (c *profileServiceJSONClient) callGetPersonalInfo(ctx context.Context, in *PersonalInfoRequest) (*PersonalInfoResponse, error) {
 	out := new(PersonalInfoResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -3403,7 +3694,7 @@ func (c *profileServiceJSONClient) UpdatePersonalInfo(ctx context.Context, in *U
 
 func (c *profileServiceJSONClient) callUpdatePersonalInfo(ctx context.Context, in *UpdatePersonalInfoRequest) (*UpdatePersonalInfoResponse, error) {
 	out := new(UpdatePersonalInfoResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -3449,7 +3740,7 @@ func (c *profileServiceJSONClient) UpdateAvatar(ctx context.Context, in *UpdateA
 
 func (c *profileServiceJSONClient) callUpdateAvatar(ctx context.Context, in *UpdateAvatarRequest) (*UpdateAvatarResponse, error) {
 	out := new(UpdateAvatarResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -3495,7 +3786,7 @@ func (c *profileServiceJSONClient) RemoveAvatar(ctx context.Context, in *RemoveA
 
 func (c *profileServiceJSONClient) callRemoveAvatar(ctx context.Context, in *RemoveAvatarRequest) (*RemoveAvatarResponse, error) {
 	out := new(RemoveAvatarResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -3541,7 +3832,7 @@ func (c *profileServiceJSONClient) GetBriefProfiles(ctx context.Context, in *Bri
 
 func (c *profileServiceJSONClient) callGetBriefProfiles(ctx context.Context, in *BriefProfilesRequest) (*BriefProfilesResponse, error) {
 	out := new(BriefProfilesResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -3656,6 +3947,15 @@ func (s *profileServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Req
 	case "GetRatings":
 		s.serveGetRatings(ctx, resp, req)
 		return
+	case "GetRatingHistory":
+		s.serveGetRatingHistory(ctx, resp, req)
+		return
+	case "GetLeaderboard":
+		s.serveGetLeaderboard(ctx, resp, req)
+		return
+	case "GetExpectedScore":
+		s.serveGetExpectedScore(ctx, resp, req)
+		return
 	case "GetStats":
 		s.serveGetStats(ctx, resp, req)
 		return
@@ -3864,6 +4164,546 @@ func (s *profileServiceServer) serveGetRatingsProtobuf(ctx context.Context, resp
 	callResponseSent(ctx, s.hooks)
 }
 
+func (s *profileServiceServer) serveGetRatingHistory(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetRatingHistoryJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetRatingHistoryProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *profileServiceServer) serveGetRatingHistoryJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetRatingHistory")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RatingHistoryRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ProfileService.GetRatingHistory
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RatingHistoryRequest) (*RatingHistoryResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RatingHistoryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RatingHistoryRequest) when calling interceptor")
+					}
+					return s.ProfileService.GetRatingHistory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RatingHistoryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RatingHistoryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RatingHistoryResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RatingHistoryResponse and nil error while calling GetRatingHistory. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *profileServiceServer) serveGetRatingHistoryProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetRatingHistory")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RatingHistoryRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ProfileService.GetRatingHistory
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RatingHistoryRequest) (*RatingHistoryResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RatingHistoryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RatingHistoryRequest) when calling interceptor")
+					}
+					return s.ProfileService.GetRatingHistory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RatingHistoryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RatingHistoryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RatingHistoryResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RatingHistoryResponse and nil error while calling GetRatingHistory. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *profileServiceServer) serveGetLeaderboard(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetLeaderboardJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetLeaderboardProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *profileServiceServer) serveGetLeaderboardJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetLeaderboard")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(LeaderboardRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ProfileService.GetLeaderboard
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *LeaderboardRequest) (*LeaderboardResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LeaderboardRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LeaderboardRequest) when calling interceptor")
+					}
+					return s.ProfileService.GetLeaderboard(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LeaderboardResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LeaderboardResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *LeaderboardResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *LeaderboardResponse and nil error while calling GetLeaderboard. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *profileServiceServer) serveGetLeaderboardProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetLeaderboard")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(LeaderboardRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ProfileService.GetLeaderboard
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *LeaderboardRequest) (*LeaderboardResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LeaderboardRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LeaderboardRequest) when calling interceptor")
+					}
+					return s.ProfileService.GetLeaderboard(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LeaderboardResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LeaderboardResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *LeaderboardResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *LeaderboardResponse and nil error while calling GetLeaderboard. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *profileServiceServer) serveGetExpectedScore(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetExpectedScoreJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetExpectedScoreProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *profileServiceServer) serveGetExpectedScoreJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetExpectedScore")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ExpectedScoreRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ProfileService.GetExpectedScore
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ExpectedScoreRequest) (*ExpectedScoreResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ExpectedScoreRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ExpectedScoreRequest) when calling interceptor")
+					}
+					return s.ProfileService.GetExpectedScore(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ExpectedScoreResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ExpectedScoreResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ExpectedScoreResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ExpectedScoreResponse and nil error while calling GetExpectedScore. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *profileServiceServer) serveGetExpectedScoreProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetExpectedScore")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ExpectedScoreRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ProfileService.GetExpectedScore
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ExpectedScoreRequest) (*ExpectedScoreResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ExpectedScoreRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ExpectedScoreRequest) when calling interceptor")
+					}
+					return s.ProfileService.GetExpectedScore(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ExpectedScoreResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ExpectedScoreResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ExpectedScoreResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ExpectedScoreResponse and nil error while calling GetExpectedScore. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
 func (s *profileServiceServer) serveGetStats(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
@@ -9204,133 +10044,169 @@ func callClientError(ctx context.Context, h *twirp.ClientHooks, err twirp.Error)
 }
 
 var twirpFileDescriptor0 = []byte{
-	// 2047 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4f, 0x73, 0x1b, 0xb7,
-	0x15, 0x1f, 0x52, 0xa2, 0x44, 0x3e, 0x52, 0x32, 0x05, 0x51, 0x36, 0xbd, 0xb6, 0x63, 0x79, 0x9d,
-	0xb4, 0xb2, 0x93, 0x4a, 0x16, 0xd3, 0x74, 0xd2, 0xf4, 0x24, 0xc9, 0x36, 0xab, 0xd8, 0x4e, 0x3c,
-	0x54, 0x95, 0x76, 0x92, 0x66, 0x38, 0xd0, 0x2e, 0x48, 0xae, 0xb5, 0x5c, 0x30, 0x0b, 0xac, 0x5c,
-	0xf5, 0xd4, 0x1e, 0x7b, 0x6c, 0xbf, 0x42, 0x3f, 0x40, 0xcf, 0x3d, 0xf5, 0x5b, 0xf5, 0xd4, 0xce,
-	0x74, 0xf0, 0x6f, 0xb9, 0x0b, 0x2e, 0x29, 0x4e, 0x7b, 0xec, 0x8d, 0x78, 0x78, 0x78, 0x78, 0x78,
-	0xf8, 0xbd, 0x87, 0xdf, 0x5b, 0xc2, 0x13, 0x3c, 0x09, 0x0e, 0x26, 0x31, 0xe5, 0xf4, 0x20, 0x61,
-	0x24, 0xee, 0x33, 0x12, 0x5f, 0x05, 0x1e, 0xc9, 0x0d, 0xf6, 0xe5, 0x3c, 0x6a, 0x64, 0x65, 0x4e,
-	0x7b, 0xba, 0x30, 0x98, 0x78, 0x07, 0xde, 0x08, 0x73, 0xa5, 0xe7, 0x7e, 0x09, 0xcd, 0x73, 0x46,
-	0xe2, 0xd7, 0x74, 0x18, 0x44, 0x3d, 0xf2, 0x43, 0x42, 0x18, 0x47, 0x0e, 0x54, 0xc5, 0xea, 0x08,
-	0x8f, 0x49, 0xbb, 0xb4, 0x5b, 0xda, 0xab, 0xf5, 0xd2, 0xb1, 0x98, 0x9b, 0x60, 0xc6, 0xde, 0xd3,
-	0xd8, 0x6f, 0x97, 0xd5, 0x9c, 0x19, 0xbb, 0xdf, 0xc3, 0xce, 0xc9, 0x08, 0x47, 0x43, 0xf2, 0x56,
-	0x4b, 0x8c, 0xc1, 0x47, 0xd0, 0xa0, 0xa1, 0xdf, 0x4f, 0x17, 0x2a, 0xa3, 0x75, 0x1a, 0xfa, 0x46,
-	0x53, 0xa8, 0x44, 0xe4, 0x7d, 0xdf, 0xb2, 0x5d, 0x8f, 0xc8, 0x7b, 0xa3, 0xe2, 0xfe, 0x12, 0x36,
-	0xb4, 0x9b, 0x6c, 0x42, 0x23, 0x46, 0x50, 0x1b, 0xd6, 0xc7, 0x84, 0x31, 0x3c, 0x34, 0x6e, 0x9a,
-	0x21, 0x7a, 0x00, 0xc0, 0x08, 0x63, 0x01, 0x8d, 0xfa, 0x81, 0xb1, 0x55, 0xd3, 0x92, 0x53, 0xdf,
-	0x6d, 0xc3, 0x6d, 0xdb, 0x51, 0x65, 0xd2, 0x3d, 0x84, 0xbb, 0x3d, 0xc2, 0x08, 0xb7, 0x4e, 0x70,
-	0xc6, 0xc9, 0xe4, 0x10, 0xb5, 0xa0, 0x42, 0xc6, 0x38, 0x08, 0xf5, 0x6e, 0x6a, 0xe0, 0x7e, 0x33,
-	0x7f, 0x49, 0x27, 0x17, 0xae, 0x52, 0x3e, 0x5c, 0xc2, 0xc9, 0x58, 0x2c, 0xec, 0x7b, 0xd4, 0x27,
-	0xc6, 0x49, 0x29, 0x39, 0xa1, 0x3e, 0x71, 0xef, 0xc0, 0x8e, 0x65, 0x57, 0xfb, 0xf8, 0x29, 0xd4,
-	0x4f, 0x68, 0x12, 0xf1, 0xf8, 0xfa, 0x65, 0x88, 0x87, 0xa8, 0x09, 0x2b, 0x49, 0x6c, 0x7c, 0x12,
-	0x3f, 0x11, 0x82, 0x55, 0x79, 0x77, 0xca, 0xa4, 0xfc, 0xed, 0x7e, 0x01, 0xcd, 0x57, 0x81, 0x77,
-	0xc9, 0x38, 0x8e, 0x39, 0x89, 0x8f, 0xb1, 0x3f, 0x24, 0x05, 0x2b, 0x5b, 0x50, 0xe1, 0x01, 0x0f,
-	0xcd, 0x52, 0x35, 0x70, 0xff, 0x59, 0x82, 0x86, 0x00, 0x49, 0x17, 0x8f, 0xc9, 0x69, 0x34, 0xa0,
-	0x62, 0x83, 0x24, 0x09, 0xcc, 0x89, 0xe4, 0x6f, 0x71, 0x1a, 0x7c, 0x85, 0x39, 0x8e, 0xfb, 0xc2,
-	0xa6, 0x3e, 0x8d, 0x92, 0x9c, 0x67, 0x2d, 0xaf, 0x64, 0x2c, 0xa3, 0x7b, 0x50, 0x1b, 0x24, 0x61,
-	0xd8, 0x97, 0xee, 0xae, 0xaa, 0xf8, 0x08, 0xc1, 0x57, 0x02, 0x6a, 0x87, 0xb0, 0x3a, 0x08, 0xf1,
-	0xb0, 0x5d, 0xd9, 0x2d, 0xed, 0xd5, 0x3b, 0x77, 0xf7, 0x73, 0x28, 0xcf, 0x44, 0xe0, 0xb8, 0xdc,
-	0x2e, 0xf5, 0xa4, 0x2a, 0x7a, 0x03, 0xe8, 0x72, 0x7a, 0xca, 0xfe, 0x85, 0x38, 0x26, 0x6b, 0xaf,
-	0xed, 0xae, 0xec, 0xd5, 0x3b, 0x1f, 0xe4, 0x0d, 0xd8, 0xd1, 0xe8, 0x6d, 0x5d, 0x5a, 0x12, 0xe6,
-	0xb6, 0x00, 0x9d, 0x51, 0xef, 0x92, 0xf0, 0x5f, 0xd1, 0x4b, 0x62, 0xd2, 0xc3, 0x0d, 0x60, 0x3b,
-	0x27, 0xd5, 0x68, 0x14, 0x27, 0x14, 0x02, 0x83, 0x0e, 0x39, 0x10, 0x31, 0xf6, 0x52, 0x08, 0x8a,
-	0x9f, 0xe8, 0x29, 0x6c, 0x0d, 0x62, 0x1a, 0xf1, 0x3e, 0x89, 0xfc, 0xfe, 0x15, 0x89, 0x05, 0x26,
-	0x75, 0x54, 0x6e, 0xc9, 0x89, 0x17, 0x91, 0xff, 0x8d, 0x12, 0xbb, 0xdb, 0xb0, 0xa5, 0xb3, 0x93,
-	0x26, 0xdc, 0xec, 0xdf, 0x84, 0x4d, 0x23, 0xd0, 0x88, 0xf8, 0x39, 0xdc, 0xfb, 0x8a, 0xf2, 0x60,
-	0x70, 0x7d, 0xe4, 0x79, 0x22, 0x2e, 0x27, 0x21, 0x65, 0x49, 0x4c, 0x32, 0xf9, 0x3c, 0x0f, 0x84,
-	0xee, 0x07, 0x70, 0xbf, 0x78, 0xa9, 0x36, 0xfd, 0x97, 0x32, 0xdc, 0x11, 0x2e, 0xf4, 0xc8, 0x30,
-	0x60, 0x3c, 0xc6, 0x3c, 0xa0, 0xff, 0x6b, 0x9d, 0x98, 0xe6, 0xd1, 0x4a, 0x26, 0x8f, 0xd0, 0xc7,
-	0xb0, 0x15, 0x67, 0x36, 0x51, 0x59, 0xa1, 0x30, 0xd1, 0xcc, 0x4e, 0x88, 0xe4, 0x10, 0x68, 0xbb,
-	0x08, 0x62, 0x3e, 0xea, 0xfb, 0x98, 0x13, 0x89, 0x90, 0x5a, 0xaf, 0x26, 0x25, 0xcf, 0x31, 0x97,
-	0xd3, 0x83, 0x20, 0x66, 0x5c, 0x01, 0x6b, 0x4d, 0x4d, 0x4b, 0x89, 0x44, 0xd6, 0x3d, 0xa8, 0x85,
-	0xd8, 0xcc, 0xae, 0x2b, 0xef, 0x84, 0x40, 0x4e, 0x3e, 0x82, 0x86, 0xa7, 0xc0, 0xa5, 0x5c, 0xa8,
-	0xaa, 0x4a, 0xa4, 0x65, 0x32, 0x35, 0x9f, 0x41, 0x2b, 0x1f, 0x8f, 0x9b, 0x0a, 0x92, 0xfb, 0x09,
-	0x6c, 0xf6, 0x30, 0x0f, 0xa2, 0x21, 0x5b, 0x22, 0x78, 0xee, 0x47, 0x70, 0x2b, 0xd5, 0xd6, 0xa6,
-	0x11, 0xac, 0xbe, 0x63, 0xd4, 0x80, 0x4b, 0xfe, 0x76, 0x9f, 0x42, 0xe3, 0x8c, 0x63, 0xbe, 0x94,
-	0xc9, 0xc7, 0xb0, 0xa1, 0x75, 0x17, 0x18, 0xfc, 0x04, 0x36, 0xdf, 0xc6, 0x74, 0x10, 0x84, 0x64,
-	0x19, 0x93, 0xff, 0x2e, 0xc3, 0xad, 0x54, 0x5d, 0x5b, 0xcd, 0x07, 0xbe, 0xb4, 0x30, 0xf0, 0xe5,
-	0x1b, 0x02, 0xbf, 0x32, 0x13, 0xf8, 0x69, 0x15, 0x59, 0xcd, 0x56, 0x91, 0x16, 0x54, 0xf0, 0x05,
-	0x4d, 0xb8, 0xc6, 0x81, 0x1a, 0x08, 0x73, 0xb1, 0x0a, 0x62, 0x5f, 0x1e, 0x54, 0xa1, 0xa0, 0xae,
-	0x65, 0x5f, 0x32, 0x1a, 0xc9, 0x67, 0x42, 0x04, 0x45, 0x29, 0xac, 0xeb, 0x67, 0x42, 0x48, 0xe4,
-	0xf4, 0x1d, 0x58, 0x97, 0x25, 0x23, 0xf0, 0x35, 0x08, 0xd6, 0xc4, 0xf0, 0xd4, 0xae, 0x75, 0x35,
-	0xbb, 0xd6, 0xe5, 0xaa, 0x1a, 0x58, 0x55, 0xed, 0x09, 0x34, 0x95, 0x26, 0xeb, 0x13, 0x3f, 0xe0,
-	0xf8, 0x22, 0x24, 0xed, 0xfa, 0x6e, 0x69, 0xaf, 0xda, 0xbb, 0xa5, 0xe5, 0x2f, 0xb4, 0xd8, 0x02,
-	0x79, 0xc3, 0x02, 0xb9, 0xbb, 0x03, 0xdb, 0x6f, 0x49, 0xcc, 0x68, 0x84, 0x43, 0x51, 0x95, 0x4d,
-	0x79, 0xf8, 0x57, 0x09, 0x5a, 0x79, 0xf9, 0xb4, 0x40, 0xcd, 0x3e, 0x5f, 0xd6, 0x8d, 0x95, 0x17,
-	0xde, 0xd8, 0xca, 0x0d, 0x37, 0xb6, 0x3a, 0x7b, 0x63, 0xf9, 0x50, 0x55, 0x16, 0x86, 0x6a, 0xcd,
-	0x0a, 0x55, 0x7a, 0xaf, 0xeb, 0xd9, 0x7b, 0xcd, 0x47, 0xa5, 0x6a, 0x47, 0xe5, 0x8f, 0x65, 0xb8,
-	0x7b, 0x3e, 0x11, 0x73, 0x05, 0xc1, 0xf9, 0x3f, 0x89, 0xc1, 0x7d, 0x70, 0x8a, 0x42, 0xa0, 0x4b,
-	0xfa, 0x33, 0xd8, 0x56, 0xb3, 0x47, 0xd2, 0x05, 0x13, 0x9a, 0xbb, 0x50, 0x7d, 0x37, 0x19, 0x0a,
-	0x8b, 0x58, 0x46, 0xa7, 0xd1, 0x5b, 0x7f, 0x37, 0x19, 0x3e, 0xc7, 0x1c, 0xbb, 0x9f, 0x41, 0x2b,
-	0xbf, 0x62, 0x9a, 0xed, 0x99, 0x83, 0x95, 0xac, 0x83, 0x09, 0x80, 0xf6, 0xc8, 0x98, 0x5e, 0xe5,
-	0x37, 0x72, 0x6f, 0x8b, 0xea, 0x99, 0x15, 0xa7, 0xdc, 0xab, 0x75, 0x1c, 0x07, 0x64, 0xa0, 0x6b,
-	0x0a, 0xcb, 0x38, 0xa6, 0xd3, 0x90, 0xb5, 0x4b, 0xbb, 0x2b, 0xa2, 0xac, 0xaa, 0x3c, 0x64, 0xee,
-	0x9f, 0x4a, 0xd0, 0xc8, 0xae, 0x59, 0xf8, 0x24, 0xe5, 0xe2, 0x5c, 0xb6, 0xe2, 0xbc, 0x44, 0xf1,
-	0x59, 0x9c, 0xf5, 0xee, 0x3f, 0x4a, 0xb0, 0x63, 0xf9, 0xaf, 0xc3, 0xf4, 0x06, 0xaa, 0xb1, 0xfe,
-	0x2d, 0x0f, 0x50, 0xef, 0x1c, 0xe6, 0xb9, 0x48, 0xe1, 0xb2, 0x7d, 0xf3, 0xe3, 0x85, 0xf0, 0xa0,
-	0x97, 0x9a, 0x70, 0x7e, 0x0d, 0x1b, 0xb9, 0x29, 0xc1, 0x31, 0x2e, 0xc9, 0xb5, 0xe1, 0x71, 0x97,
-	0xe4, 0x1a, 0x3d, 0x83, 0xca, 0x15, 0x0e, 0x13, 0x75, 0xcc, 0x7a, 0xc7, 0x99, 0xbf, 0x5d, 0x4f,
-	0x29, 0x7e, 0x51, 0xfe, 0xbc, 0xe4, 0x1e, 0xc0, 0xce, 0xb9, 0x0e, 0xd6, 0x19, 0xc1, 0xb1, 0x37,
-	0x32, 0x37, 0x70, 0x1b, 0xd6, 0x26, 0x31, 0x19, 0x04, 0xbf, 0xd3, 0x7b, 0xe8, 0x91, 0xdb, 0x85,
-	0xdb, 0xf6, 0x02, 0x7d, 0xe4, 0x9f, 0x40, 0x45, 0x6c, 0xc9, 0xda, 0x65, 0x79, 0xde, 0x3b, 0x96,
-	0x03, 0x98, 0x05, 0x9e, 0x64, 0x15, 0x4a, 0xcb, 0xfd, 0x11, 0x34, 0x8f, 0x7c, 0xff, 0x25, 0x0d,
-	0x43, 0xfa, 0xde, 0x6c, 0x5a, 0x40, 0x32, 0xdd, 0x27, 0x06, 0x51, 0x37, 0xab, 0x6e, 0xc3, 0x56,
-	0x97, 0x70, 0xa5, 0x67, 0xa0, 0x24, 0x1e, 0xd6, 0x23, 0xdf, 0x3f, 0x0e, 0xa9, 0x77, 0xb9, 0x68,
-	0xed, 0x1e, 0x20, 0xb5, 0xcd, 0x8d, 0x9a, 0x08, 0x9a, 0x5d, 0xc2, 0xa5, 0x1a, 0xcb, 0xe0, 0x5b,
-	0xec, 0x9c, 0x84, 0x61, 0x5e, 0xde, 0x00, 0xf8, 0xfa, 0x55, 0x8a, 0xf6, 0x5f, 0x40, 0x2d, 0x0d,
-	0x43, 0x21, 0xa1, 0xce, 0x42, 0xb9, 0x6c, 0x3d, 0xbd, 0xdf, 0xc3, 0x96, 0x5c, 0xac, 0x8e, 0x47,
-	0xfc, 0xff, 0xc6, 0x88, 0x60, 0x2b, 0xde, 0x08, 0x47, 0x11, 0x11, 0x44, 0x4c, 0xa6, 0x95, 0x1e,
-	0xba, 0x0c, 0xee, 0x77, 0x09, 0x3f, 0xf2, 0x78, 0x70, 0x45, 0x4e, 0x46, 0x98, 0x9f, 0x28, 0x39,
-	0xcb, 0xe0, 0x21, 0x4a, 0xc6, 0x17, 0x24, 0x96, 0x7b, 0x55, 0x7a, 0x7a, 0x24, 0xe4, 0x74, 0x30,
-	0x60, 0x84, 0xcb, 0xbd, 0x2a, 0x3d, 0x3d, 0x42, 0x8f, 0x61, 0x83, 0xd3, 0x44, 0xee, 0x1a, 0x71,
-	0xf1, 0x9c, 0xaa, 0xec, 0x6a, 0x4c, 0x85, 0xa7, 0xbe, 0xfb, 0x87, 0x32, 0xa0, 0xd9, 0x2d, 0xd1,
-	0x73, 0xa8, 0x6a, 0xb7, 0x98, 0x4e, 0x9e, 0xbd, 0x3c, 0x98, 0x66, 0xd7, 0xec, 0xeb, 0x1f, 0xbd,
-	0x74, 0xa5, 0xf3, 0xd7, 0x12, 0xac, 0x6b, 0x69, 0xda, 0x1e, 0x95, 0xa6, 0xed, 0x91, 0x48, 0x7f,
-	0x3f, 0x60, 0x93, 0x10, 0x5f, 0x67, 0xcb, 0x43, 0x5d, 0xcb, 0x64, 0x85, 0x78, 0x08, 0x75, 0xf9,
-	0x0a, 0x24, 0xb2, 0x12, 0xca, 0x23, 0xac, 0xf4, 0x40, 0x88, 0x54, 0x6d, 0x14, 0xf5, 0x61, 0x84,
-	0x99, 0x99, 0x5f, 0x95, 0x6f, 0x7a, 0x6d, 0x84, 0x99, 0x9e, 0x7e, 0x04, 0x0d, 0xb9, 0xde, 0x30,
-	0x44, 0xf5, 0x0e, 0x48, 0x9b, 0x6f, 0x34, 0x4b, 0xfc, 0x18, 0x6e, 0x75, 0x89, 0x38, 0xc6, 0x94,
-	0xd3, 0x65, 0x2e, 0x49, 0x53, 0x4a, 0x73, 0x49, 0xaf, 0x00, 0x65, 0x01, 0xae, 0x13, 0xef, 0x33,
-	0x93, 0x78, 0x2a, 0x56, 0x0f, 0x0b, 0x12, 0x2f, 0x0b, 0x1a, 0x93, 0x80, 0xc7, 0x32, 0x5b, 0x0c,
-	0x5e, 0xed, 0x24, 0x2e, 0x2d, 0x95, 0xc4, 0x1d, 0xd8, 0xb1, 0x70, 0xaf, 0xed, 0x2c, 0x28, 0xe0,
-	0x2a, 0x4b, 0xdf, 0x50, 0xff, 0x75, 0xc0, 0xd2, 0x06, 0xe7, 0xb7, 0xf2, 0x64, 0xa9, 0x50, 0x5b,
-	0xf9, 0x10, 0x36, 0xb1, 0x3f, 0x0e, 0xa2, 0xbe, 0x65, 0xab, 0x21, 0xa5, 0xe7, 0xca, 0x20, 0xda,
-	0x85, 0xc6, 0x98, 0xfa, 0x53, 0x9d, 0xb2, 0xd4, 0x81, 0x31, 0xf5, 0xb5, 0x46, 0xe7, 0xcf, 0x15,
-	0xd8, 0x39, 0x4a, 0xf8, 0x88, 0x44, 0x3c, 0xf0, 0x24, 0x7f, 0x3f, 0x53, 0x27, 0x42, 0xcf, 0xa1,
-	0x22, 0x3f, 0x30, 0x20, 0xab, 0x55, 0xb4, 0x3f, 0x90, 0x38, 0xf7, 0xf2, 0xf3, 0xf9, 0xaf, 0x12,
-	0x5d, 0x58, 0x53, 0xed, 0x19, 0x7a, 0x58, 0x68, 0x66, 0xda, 0xc9, 0x39, 0xf7, 0x67, 0xec, 0x64,
-	0xba, 0x3a, 0x74, 0x0e, 0x9b, 0x5d, 0xc2, 0x33, 0xad, 0x26, 0xda, 0xcd, 0xeb, 0xcf, 0xf6, 0xa6,
-	0xce, 0xa3, 0x05, 0x1a, 0xda, 0xec, 0x85, 0x28, 0x6e, 0x99, 0xef, 0x0a, 0xea, 0xdb, 0xc6, 0x8f,
-	0xf3, 0x0b, 0xe7, 0x7e, 0x04, 0x71, 0x1e, 0x2f, 0x54, 0x5c, 0xb0, 0x47, 0x67, 0xd9, 0x3d, 0x3a,
-	0xcb, 0xed, 0xf1, 0x1d, 0x6c, 0xe6, 0x3f, 0xe2, 0x20, 0x6b, 0x59, 0xe1, 0xb7, 0x28, 0xe7, 0xc3,
-	0xc5, 0x4a, 0xda, 0xf8, 0x18, 0x5a, 0x45, 0x6d, 0x31, 0x7a, 0x92, 0x5f, 0xbd, 0xa0, 0xeb, 0x76,
-	0x9e, 0x2e, 0xa3, 0xaa, 0xb6, 0xeb, 0x84, 0xe2, 0x5d, 0x9b, 0x36, 0x94, 0x06, 0x90, 0xe7, 0x50,
-	0x55, 0x62, 0x12, 0xa3, 0x8f, 0x66, 0xc1, 0x54, 0xd0, 0x93, 0x3b, 0xae, 0x1d, 0xba, 0xd9, 0x36,
-	0xb5, 0xf3, 0xf7, 0x4a, 0xda, 0xe7, 0x99, 0x9d, 0x4e, 0x01, 0xba, 0x84, 0xeb, 0xa6, 0x13, 0x59,
-	0xb8, 0xcc, 0x77, 0xae, 0xce, 0x83, 0x39, 0xb3, 0x3a, 0x74, 0x27, 0x50, 0x15, 0xb0, 0x15, 0x5d,
-	0x14, 0xb2, 0x88, 0x47, 0xb6, 0x5b, 0xb5, 0x93, 0x28, 0xdf, 0x9d, 0x2a, 0x7f, 0x0c, 0xab, 0xb3,
-	0xfc, 0xc9, 0xf7, 0xa8, 0xb6, 0x3f, 0x76, 0x4b, 0xfa, 0x1b, 0x59, 0x54, 0xb3, 0x4c, 0x18, 0x59,
-	0x59, 0x52, 0xd0, 0x28, 0xd8, 0x71, 0x2c, 0x6c, 0xa8, 0x86, 0x80, 0x66, 0x69, 0xb6, 0x8d, 0xf2,
-	0xb9, 0xbd, 0x88, 0xb3, 0x77, 0xb3, 0x62, 0x5a, 0x09, 0x1a, 0x59, 0xfe, 0x6d, 0xfb, 0x5f, 0xc0,
-	0xe6, 0x6d, 0xff, 0x0b, 0xe9, 0xfb, 0x39, 0x34, 0xb2, 0x44, 0xdc, 0x36, 0x5b, 0xc0, 0xdd, 0x67,
-	0xe1, 0x35, 0xcb, 0xe3, 0xd1, 0x77, 0x8a, 0x13, 0x65, 0x39, 0x2d, 0x72, 0x17, 0x12, 0x5e, 0x65,
-	0xfb, 0xf1, 0x12, 0xa4, 0xb8, 0xf3, 0x03, 0x6c, 0x1f, 0x25, 0x9c, 0x7a, 0x74, 0x3c, 0x09, 0x09,
-	0x4f, 0xf1, 0xfb, 0x2d, 0x6c, 0x88, 0x97, 0x53, 0x49, 0x03, 0x1a, 0xd9, 0xb5, 0xa0, 0x90, 0xd7,
-	0xda, 0xb5, 0xa0, 0x98, 0xcb, 0x76, 0xfe, 0xb6, 0x06, 0xcd, 0x33, 0xea, 0x05, 0x38, 0x0c, 0x7e,
-	0x9f, 0x6e, 0xf8, 0x02, 0x6a, 0x29, 0x63, 0xb5, 0xdf, 0x0b, 0x9b, 0xca, 0x3a, 0xed, 0xfc, 0xfc,
-	0x94, 0x05, 0xa2, 0x57, 0xe6, 0x0a, 0xb4, 0xa5, 0xc2, 0x2b, 0x58, 0xd6, 0xd8, 0xd7, 0x32, 0x69,
-	0x34, 0x23, 0xb0, 0x5f, 0x9f, 0x19, 0x32, 0xec, 0xec, 0xce, 0x57, 0x98, 0xa6, 0xb2, 0xa1, 0xcb,
-	0xe8, 0xc1, 0xcc, 0x19, 0xb3, 0xe4, 0x78, 0x81, 0x57, 0xa7, 0x50, 0xcf, 0x90, 0x69, 0xfb, 0x0d,
-	0x9b, 0xe5, 0xd9, 0x0b, 0x4c, 0xbd, 0x86, 0x5a, 0xca, 0x52, 0xec, 0xa0, 0xdb, 0x34, 0xdc, 0x79,
-	0x38, 0x77, 0x3e, 0x2d, 0x0c, 0x1b, 0x39, 0xbe, 0x62, 0x83, 0xb4, 0x88, 0xc4, 0xdb, 0x20, 0x2d,
-	0x26, 0x3c, 0x44, 0x32, 0xa1, 0x02, 0x32, 0xfb, 0x74, 0x66, 0xf5, 0x5c, 0x92, 0x6d, 0x5f, 0x4f,
-	0x81, 0xb5, 0x97, 0x92, 0x27, 0x49, 0xba, 0xf8, 0x92, 0xc6, 0x86, 0xde, 0x3e, 0x98, 0xd9, 0x23,
-	0x4b, 0x28, 0x9d, 0xad, 0xfd, 0x60, 0xe2, 0x89, 0x67, 0xcf, 0xb0, 0x4e, 0xa6, 0x71, 0xa3, 0xf9,
-	0x56, 0x01, 0x6e, 0xf2, 0xf4, 0xac, 0x00, 0x37, 0x16, 0x55, 0x3b, 0xfe, 0xfc, 0xdb, 0x9f, 0x0d,
-	0x03, 0x3e, 0x4a, 0x2e, 0xf6, 0x3d, 0x3a, 0x3e, 0xf0, 0xe9, 0x38, 0x88, 0xe8, 0xe1, 0x4f, 0x0f,
-	0xc2, 0x40, 0xbc, 0xb1, 0xec, 0x20, 0x9e, 0x78, 0x07, 0xc5, 0xff, 0x64, 0x5d, 0xac, 0x49, 0xd9,
-	0xa7, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x66, 0xef, 0xa0, 0xea, 0x1a, 0x00, 0x00,
+	// 2614 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3a, 0x4d, 0x73, 0x1b, 0xc7,
+	0xb1, 0x05, 0x90, 0x20, 0x89, 0x06, 0x44, 0x82, 0x43, 0x50, 0x82, 0x56, 0x92, 0x45, 0x2d, 0xed,
+	0x67, 0x49, 0xf6, 0x23, 0x2d, 0xfa, 0xf9, 0xc5, 0x76, 0x4e, 0x14, 0x25, 0xd1, 0xb4, 0x24, 0x5b,
+	0xb5, 0x34, 0x9d, 0x94, 0x13, 0x17, 0x6a, 0xb0, 0x3b, 0x04, 0x47, 0x5c, 0xec, 0xc0, 0x3b, 0x03,
+	0x4a, 0xcc, 0x29, 0xb9, 0xe6, 0x96, 0x9c, 0x73, 0xcb, 0x2d, 0x97, 0xfc, 0x81, 0x54, 0xe5, 0x9e,
+	0x9c, 0xf2, 0x67, 0x72, 0x4a, 0xaa, 0x52, 0xf3, 0xb5, 0xd8, 0x1d, 0x2c, 0x97, 0xac, 0xe4, 0x98,
+	0xdb, 0x4e, 0x77, 0x4f, 0xcf, 0xf4, 0xe7, 0x74, 0x37, 0x00, 0x0f, 0xf0, 0x98, 0x6e, 0x8f, 0x53,
+	0x26, 0xd8, 0xf6, 0x84, 0x93, 0xb4, 0xcf, 0x49, 0x7a, 0x46, 0x43, 0x52, 0x58, 0x6c, 0x29, 0x3c,
+	0x6a, 0xe7, 0x61, 0x5e, 0x6f, 0xba, 0x91, 0x8e, 0xc3, 0xed, 0xf0, 0x04, 0x0b, 0x4d, 0xe7, 0xdd,
+	0x1d, 0x32, 0x36, 0x8c, 0x89, 0x46, 0x0e, 0x26, 0xc7, 0xdb, 0x82, 0x8e, 0x08, 0x17, 0x78, 0x34,
+	0xd6, 0x04, 0xfe, 0x97, 0xd0, 0x39, 0xe2, 0x24, 0x7d, 0xc1, 0x86, 0x34, 0x09, 0xc8, 0x0f, 0x13,
+	0xc2, 0x05, 0xf2, 0x60, 0x49, 0xb2, 0x4f, 0xf0, 0x88, 0xf4, 0x6a, 0x1b, 0xb5, 0xfb, 0xcd, 0x20,
+	0x5b, 0x4b, 0xdc, 0x18, 0x73, 0xfe, 0x86, 0xa5, 0x51, 0xaf, 0xae, 0x71, 0x76, 0xed, 0x7f, 0x0f,
+	0xeb, 0x7b, 0x27, 0x38, 0x19, 0x92, 0x57, 0x06, 0x62, 0x19, 0xde, 0x83, 0x36, 0x8b, 0xa3, 0x7e,
+	0xb6, 0x51, 0x33, 0x6d, 0xb1, 0x38, 0xb2, 0x94, 0x92, 0x24, 0x21, 0x6f, 0xfa, 0x0e, 0xef, 0x56,
+	0x42, 0xde, 0x58, 0x12, 0xff, 0x0b, 0xb8, 0x66, 0xae, 0xc9, 0xc7, 0x2c, 0xe1, 0x04, 0xf5, 0x60,
+	0x71, 0x44, 0x38, 0xc7, 0x43, 0x7b, 0x4d, 0xbb, 0x44, 0x77, 0x00, 0x38, 0xe1, 0x9c, 0xb2, 0xa4,
+	0x4f, 0x2d, 0xaf, 0xa6, 0x81, 0x1c, 0x44, 0x7e, 0x0f, 0xae, 0xbb, 0x17, 0xd5, 0x2c, 0xfd, 0x47,
+	0x70, 0x33, 0x20, 0x9c, 0x08, 0x47, 0x82, 0x43, 0x41, 0xc6, 0x8f, 0x50, 0x17, 0x1a, 0x64, 0x84,
+	0x69, 0x6c, 0x4e, 0xd3, 0x0b, 0xff, 0xdb, 0x8b, 0xb7, 0xec, 0x14, 0xd4, 0x55, 0x2b, 0xaa, 0x4b,
+	0x5e, 0x32, 0x95, 0x1b, 0xfb, 0x21, 0x8b, 0x88, 0xbd, 0xa4, 0x82, 0xec, 0xb1, 0x88, 0xf8, 0x37,
+	0x60, 0xdd, 0xe1, 0x6b, 0xee, 0xf8, 0x31, 0xb4, 0xf6, 0xd8, 0x24, 0x11, 0xe9, 0xf9, 0xb3, 0x18,
+	0x0f, 0x51, 0x07, 0xe6, 0x26, 0xa9, 0xbd, 0x93, 0xfc, 0x44, 0x08, 0xe6, 0x95, 0xed, 0x34, 0x4b,
+	0xf5, 0xed, 0x7f, 0x0e, 0x9d, 0xe7, 0x34, 0x3c, 0xe5, 0x02, 0xa7, 0x82, 0xa4, 0x8f, 0x71, 0x34,
+	0x24, 0x25, 0x3b, 0xbb, 0xd0, 0x10, 0x54, 0xc4, 0x76, 0xab, 0x5e, 0xf8, 0x7f, 0xaf, 0x41, 0x5b,
+	0x3a, 0xc9, 0x3e, 0x1e, 0x91, 0x83, 0xe4, 0x98, 0xc9, 0x03, 0x26, 0x13, 0x6a, 0x25, 0x52, 0xdf,
+	0x52, 0x1a, 0x7c, 0x86, 0x05, 0x4e, 0xfb, 0x92, 0xa7, 0x91, 0x46, 0x43, 0x8e, 0xf2, 0x9c, 0xe7,
+	0x72, 0x9c, 0xd1, 0x2d, 0x68, 0x1e, 0x4f, 0xe2, 0xb8, 0xaf, 0xae, 0x3b, 0xaf, 0xf5, 0x23, 0x01,
+	0x5f, 0x49, 0x57, 0x7b, 0x04, 0xf3, 0xc7, 0x31, 0x1e, 0xf6, 0x1a, 0x1b, 0xb5, 0xfb, 0xad, 0x9d,
+	0x9b, 0x5b, 0x85, 0x30, 0xc8, 0x69, 0xe0, 0x71, 0xbd, 0x57, 0x0b, 0x14, 0x29, 0x7a, 0x09, 0xe8,
+	0x74, 0x2a, 0x65, 0x7f, 0x20, 0xc5, 0xe4, 0xbd, 0x85, 0x8d, 0xb9, 0xfb, 0xad, 0x9d, 0x77, 0x8a,
+	0x0c, 0x5c, 0x6d, 0x04, 0xab, 0xa7, 0x0e, 0x84, 0xfb, 0x5d, 0x40, 0x87, 0x2c, 0x3c, 0x25, 0xe2,
+	0x1b, 0x76, 0x4a, 0x6c, 0x78, 0xf8, 0x14, 0xd6, 0x0a, 0x50, 0xe3, 0x8d, 0x52, 0x42, 0x09, 0xb0,
+	0xde, 0xa1, 0x16, 0x52, 0xc7, 0x61, 0xe6, 0x82, 0xf2, 0x13, 0x3d, 0x84, 0xd5, 0xe3, 0x94, 0x25,
+	0xa2, 0x4f, 0x92, 0xa8, 0x7f, 0x46, 0x52, 0xe9, 0x93, 0x46, 0x2b, 0x2b, 0x0a, 0xf1, 0x34, 0x89,
+	0xbe, 0xd5, 0x60, 0x7f, 0x0d, 0x56, 0x4d, 0x74, 0xb2, 0x89, 0xb0, 0xe7, 0x77, 0x60, 0xd9, 0x02,
+	0x8c, 0x47, 0x7c, 0x06, 0xb7, 0xbe, 0x62, 0x82, 0x1e, 0x9f, 0xef, 0x86, 0xa1, 0xd4, 0xcb, 0x5e,
+	0xcc, 0xf8, 0x24, 0x25, 0xb9, 0x78, 0xbe, 0xc8, 0x09, 0xfd, 0x77, 0xe0, 0x76, 0xf9, 0x56, 0xc3,
+	0xfa, 0xb7, 0x75, 0xb8, 0x21, 0xaf, 0x10, 0x90, 0x21, 0xe5, 0x22, 0xc5, 0x82, 0xb2, 0xff, 0x34,
+	0x4f, 0x4c, 0xe3, 0x68, 0x2e, 0x17, 0x47, 0xe8, 0x03, 0x58, 0x4d, 0x73, 0x87, 0xe8, 0xa8, 0xd0,
+	0x3e, 0xd1, 0xc9, 0x23, 0x64, 0x70, 0x48, 0x6f, 0x1b, 0xd0, 0x54, 0x9c, 0xf4, 0x23, 0x2c, 0x88,
+	0xf2, 0x90, 0x66, 0xd0, 0x54, 0x90, 0x27, 0x58, 0x28, 0xf4, 0x31, 0x4d, 0xb9, 0xd0, 0x8e, 0xb5,
+	0xa0, 0xd1, 0x0a, 0xa2, 0x3c, 0xeb, 0x16, 0x34, 0x63, 0x6c, 0xb1, 0x8b, 0xfa, 0x76, 0x31, 0x36,
+	0xc8, 0x7b, 0xd0, 0x0e, 0xb5, 0x73, 0xe9, 0x2b, 0x2c, 0xe9, 0x4c, 0x64, 0x60, 0x2a, 0x34, 0x3f,
+	0x82, 0x6e, 0x51, 0x1f, 0x97, 0x25, 0x24, 0xff, 0x43, 0x58, 0x0e, 0xb0, 0xa0, 0xc9, 0x90, 0x5f,
+	0x41, 0x79, 0xfe, 0x7b, 0xb0, 0x92, 0x51, 0x1b, 0xd6, 0x08, 0xe6, 0x5f, 0x73, 0x66, 0x9d, 0x4b,
+	0x7d, 0xfb, 0x7f, 0xad, 0x41, 0x57, 0xd3, 0x7d, 0x41, 0xb9, 0x60, 0xe9, 0xf9, 0x55, 0x0c, 0xd3,
+	0x83, 0xc5, 0x33, 0x9c, 0x52, 0x9c, 0x08, 0x63, 0x17, 0xbb, 0x44, 0x1f, 0x41, 0x63, 0x40, 0x86,
+	0x54, 0x3b, 0x63, 0x6b, 0xc7, 0xdb, 0xd2, 0x6f, 0xc7, 0x96, 0x7d, 0x3b, 0xb6, 0xbe, 0xb1, 0x6f,
+	0x47, 0xa0, 0x09, 0xd1, 0x87, 0x30, 0x47, 0x92, 0xa8, 0x37, 0x7f, 0x29, 0xbd, 0x24, 0x93, 0x46,
+	0x19, 0xe1, 0xb7, 0xfd, 0x31, 0xa3, 0x89, 0xe0, 0xca, 0x66, 0x8d, 0xa0, 0x39, 0xc2, 0x6f, 0x5f,
+	0x29, 0x80, 0x94, 0x06, 0x15, 0xa4, 0x79, 0x2a, 0xf5, 0x8d, 0xae, 0xc3, 0x42, 0xaa, 0xa0, 0x4a,
+	0x92, 0x5a, 0x60, 0x56, 0xe8, 0x01, 0x74, 0xf4, 0x57, 0x3f, 0x22, 0x67, 0x54, 0xd9, 0x41, 0x09,
+	0x54, 0x0b, 0x56, 0x34, 0xfc, 0x89, 0x05, 0xa3, 0x77, 0x00, 0xce, 0x58, 0x8c, 0x05, 0x8d, 0xa9,
+	0x38, 0x57, 0xd2, 0xd5, 0x82, 0x1c, 0x04, 0xdd, 0x80, 0xc5, 0x21, 0x1e, 0x91, 0x3e, 0xd5, 0xa2,
+	0x34, 0x83, 0x05, 0xb9, 0x3c, 0x88, 0xd0, 0x67, 0x00, 0x61, 0x4a, 0xb0, 0x20, 0x51, 0x1f, 0x8b,
+	0x5e, 0xe3, 0x52, 0x31, 0x9b, 0x86, 0x7a, 0x57, 0xf8, 0x87, 0xb0, 0xee, 0x98, 0xc6, 0x18, 0xf2,
+	0x73, 0x58, 0x24, 0x89, 0x48, 0x29, 0xe1, 0xbd, 0x9a, 0xca, 0x4b, 0x1b, 0xc5, 0xbc, 0x34, 0xab,
+	0x82, 0xc0, 0x6e, 0xf0, 0xcf, 0xa1, 0xfb, 0xf4, 0xed, 0x98, 0x84, 0x82, 0x44, 0x87, 0x21, 0x2b,
+	0x04, 0xf8, 0x85, 0xf6, 0xfe, 0x00, 0x56, 0xd9, 0x78, 0xcc, 0x12, 0x92, 0x88, 0x7e, 0x46, 0xa4,
+	0x2d, 0xdf, 0xb1, 0x88, 0xa3, 0x12, 0xe7, 0x98, 0x2b, 0x38, 0x87, 0xff, 0x87, 0x1a, 0xac, 0x3b,
+	0x67, 0x1b, 0x81, 0xde, 0x87, 0x95, 0x37, 0x34, 0xe9, 0x8f, 0x53, 0x36, 0xc0, 0x03, 0xad, 0x62,
+	0x6d, 0xa9, 0xe5, 0x37, 0x34, 0x79, 0x35, 0x85, 0x4a, 0x42, 0x62, 0x38, 0xf4, 0xf9, 0x38, 0x25,
+	0x38, 0x32, 0x06, 0x5b, 0xb6, 0xe0, 0x43, 0x05, 0xcd, 0x99, 0x7c, 0xae, 0x60, 0xf2, 0xf7, 0x61,
+	0x25, 0x13, 0xc5, 0x10, 0xcc, 0x6b, 0x06, 0x16, 0xac, 0x95, 0xe7, 0xff, 0xad, 0x06, 0xe8, 0x05,
+	0xc1, 0x11, 0x49, 0x07, 0x0c, 0x4f, 0xcb, 0x90, 0x9c, 0x74, 0xb5, 0xa2, 0xeb, 0x77, 0xa1, 0x11,
+	0xd3, 0x11, 0xd5, 0x21, 0xd1, 0x08, 0xf4, 0x62, 0x26, 0x13, 0xcc, 0xcd, 0x64, 0x02, 0x74, 0x17,
+	0x5a, 0x38, 0x14, 0xf4, 0x8c, 0xf4, 0x23, 0x7c, 0xce, 0xd5, 0x75, 0x1a, 0x01, 0x68, 0xd0, 0x13,
+	0x7c, 0xce, 0xd1, 0x36, 0xac, 0xd1, 0x24, 0x8c, 0x27, 0x11, 0x91, 0x1a, 0x3a, 0xa3, 0x32, 0xad,
+	0xe3, 0x58, 0xf9, 0xd2, 0x52, 0x80, 0x0c, 0xea, 0xd5, 0x14, 0x23, 0x85, 0x67, 0xc7, 0xc7, 0x9c,
+	0x08, 0x95, 0xb6, 0x1a, 0x81, 0x59, 0xf9, 0xbf, 0xab, 0x43, 0x27, 0x27, 0x93, 0x0e, 0x0e, 0x04,
+	0xf3, 0x29, 0x4e, 0x4e, 0x95, 0x38, 0x8d, 0x40, 0x7d, 0x4b, 0x6f, 0x56, 0x0e, 0x95, 0xbd, 0x3a,
+	0x0b, 0x72, 0x79, 0x10, 0x15, 0xbc, 0x64, 0xce, 0xf1, 0x12, 0x57, 0xd4, 0xf9, 0x59, 0x51, 0xa7,
+	0x56, 0x69, 0x5c, 0x1a, 0x88, 0x0b, 0xe5, 0x81, 0xf8, 0x23, 0x93, 0x77, 0x87, 0x36, 0xef, 0x56,
+	0x87, 0x93, 0xca, 0xc9, 0xb2, 0xe8, 0x40, 0x1b, 0xd0, 0xca, 0x6b, 0x6f, 0x49, 0x69, 0x2f, 0x0f,
+	0xf2, 0x7f, 0x5d, 0x83, 0xb5, 0x82, 0xc9, 0x8d, 0x77, 0x7e, 0xea, 0x86, 0x9b, 0x53, 0x06, 0xb8,
+	0x2a, 0xcd, 0x82, 0x4d, 0x06, 0xff, 0x64, 0x1c, 0xd9, 0xe0, 0xaf, 0x5f, 0x1e, 0xfc, 0x86, 0x7a,
+	0x57, 0xf8, 0x0f, 0xa1, 0x7d, 0x28, 0xb0, 0xb8, 0x52, 0xae, 0xdf, 0x84, 0x6b, 0x86, 0xb6, 0x22,
+	0xd3, 0x7f, 0x08, 0xcb, 0xaf, 0x52, 0x76, 0x4c, 0xe3, 0xab, 0x84, 0xbc, 0xff, 0xcf, 0x3a, 0xac,
+	0x64, 0xe4, 0x86, 0x6b, 0xf1, 0x45, 0xac, 0x55, 0xbe, 0x88, 0xf5, 0x4b, 0x5e, 0xc4, 0x92, 0x38,
+	0xc8, 0xca, 0xbb, 0xf9, 0x7c, 0x79, 0xd7, 0x85, 0x06, 0x1e, 0xb0, 0x89, 0x30, 0x0f, 0xb4, 0x5e,
+	0x48, 0x76, 0xda, 0x31, 0x78, 0xff, 0x35, 0x37, 0xce, 0xd2, 0x0c, 0x5a, 0x06, 0xf6, 0x25, 0x67,
+	0x89, 0xbc, 0x2d, 0x97, 0x4a, 0xd1, 0x04, 0xfa, 0x85, 0x6e, 0x2a, 0x88, 0x42, 0xe7, 0x5c, 0x7c,
+	0xa9, 0xe0, 0xe2, 0xc5, 0x22, 0xb4, 0xe9, 0x16, 0xa1, 0x85, 0x72, 0x13, 0x9c, 0x72, 0xf3, 0x01,
+	0x74, 0x34, 0x25, 0xef, 0x93, 0x88, 0x0a, 0x3c, 0x88, 0x49, 0xaf, 0xa5, 0x1c, 0x6d, 0xc5, 0xc0,
+	0x9f, 0x1a, 0xb0, 0x53, 0x7d, 0xb4, 0x9d, 0xea, 0xc3, 0x5f, 0x87, 0xb5, 0x57, 0x24, 0xe5, 0xd2,
+	0x2f, 0x65, 0xb9, 0x6c, 0xeb, 0xb6, 0x7f, 0xd4, 0xa0, 0x5b, 0x84, 0x4f, 0x2b, 0xc7, 0xd9, 0xbe,
+	0xc2, 0xb1, 0x58, 0xbd, 0xd2, 0x62, 0x73, 0x97, 0x58, 0xac, 0x24, 0x9c, 0x8b, 0xaa, 0x6a, 0x54,
+	0xaa, 0x6a, 0xc1, 0x51, 0x55, 0x66, 0xd7, 0xc5, 0xbc, 0x5d, 0x8b, 0x5a, 0x59, 0x72, 0xb5, 0xf2,
+	0xab, 0x3a, 0xdc, 0x3c, 0x52, 0x21, 0x52, 0xa2, 0x9c, 0xff, 0x12, 0x1d, 0xdc, 0x06, 0xaf, 0x4c,
+	0x05, 0xa6, 0xd6, 0xfe, 0x08, 0xd6, 0x34, 0x76, 0x57, 0x5d, 0xc1, 0xaa, 0xe6, 0x26, 0x2c, 0xbd,
+	0x1e, 0x0f, 0x25, 0x47, 0xac, 0xb4, 0xd3, 0x0e, 0x16, 0x5f, 0x8f, 0x87, 0x4f, 0xb0, 0xc0, 0xfe,
+	0x27, 0xd0, 0x2d, 0xee, 0x98, 0x46, 0x7b, 0x4e, 0xb0, 0x9a, 0x23, 0x98, 0x74, 0xd0, 0x80, 0x8c,
+	0xd8, 0x59, 0xf1, 0x20, 0xff, 0x3a, 0x74, 0x8b, 0xe0, 0xac, 0x29, 0xee, 0x3e, 0x4e, 0x29, 0x39,
+	0x36, 0x39, 0x85, 0xe7, 0x2e, 0x66, 0xc2, 0x50, 0x27, 0xd7, 0x66, 0xb0, 0xa8, 0xe3, 0x90, 0xfb,
+	0x7f, 0xaa, 0x41, 0x3b, 0xbf, 0xa7, 0xb2, 0x44, 0x29, 0xe8, 0xb9, 0xee, 0xe8, 0xf9, 0x0a, 0xc9,
+	0xe7, 0x92, 0xa8, 0x7f, 0x04, 0xdd, 0xdc, 0x4b, 0xd1, 0x37, 0x6f, 0x3e, 0xef, 0x81, 0xba, 0xf2,
+	0x5a, 0x0e, 0xf7, 0xad, 0x41, 0xf9, 0x7f, 0xae, 0xc1, 0xba, 0x23, 0xb2, 0xd1, 0xec, 0x4b, 0x58,
+	0x4a, 0xcd, 0xb7, 0x79, 0x50, 0x1e, 0x15, 0x1f, 0x94, 0xd2, 0x6d, 0x5b, 0xf6, 0x43, 0xbf, 0x31,
+	0x19, 0x0b, 0xef, 0x27, 0x70, 0xad, 0x80, 0x92, 0xfd, 0xe2, 0x29, 0x39, 0xb7, 0x3d, 0xf9, 0x29,
+	0x39, 0x97, 0x65, 0xf9, 0x19, 0x8e, 0x27, 0x24, 0x7b, 0x82, 0x2e, 0x3c, 0x2e, 0xd0, 0x84, 0x9f,
+	0xd7, 0x3f, 0xad, 0xf9, 0xdb, 0xb0, 0x6e, 0xab, 0xba, 0x43, 0x82, 0xd3, 0xf0, 0xc4, 0x1a, 0xed,
+	0x3a, 0x2c, 0x8c, 0x53, 0x72, 0x4c, 0xdf, 0x9a, 0x33, 0xcc, 0xca, 0xdf, 0x87, 0xeb, 0xee, 0x06,
+	0x23, 0xf2, 0xff, 0x42, 0x43, 0x1e, 0xc9, 0x7b, 0x75, 0x25, 0xef, 0x0d, 0xe7, 0x02, 0x98, 0xd3,
+	0x50, 0xee, 0x0c, 0x34, 0x95, 0xff, 0x3f, 0xd0, 0xd9, 0x8d, 0xa2, 0x67, 0x2c, 0x8e, 0xd9, 0x1b,
+	0x7b, 0x68, 0xc9, 0xc0, 0xc0, 0x7f, 0x60, 0x9d, 0xf0, 0x72, 0xd2, 0x35, 0x58, 0xdd, 0x27, 0x42,
+	0xd3, 0x59, 0xef, 0x93, 0x4d, 0xd2, 0x6e, 0x14, 0x3d, 0x8e, 0x59, 0x78, 0x5a, 0xb5, 0xf7, 0x3e,
+	0x20, 0x7d, 0xcc, 0xa5, 0x94, 0x08, 0x3a, 0xfb, 0x44, 0x28, 0x32, 0x9e, 0x0b, 0x09, 0x79, 0xf2,
+	0x24, 0x8e, 0x8b, 0xf0, 0x36, 0xc0, 0xd7, 0xcf, 0xb3, 0x00, 0xf9, 0x31, 0x34, 0x33, 0x35, 0x94,
+	0xb1, 0x2e, 0x78, 0x7f, 0xdd, 0x79, 0xad, 0xbf, 0x87, 0x55, 0xb5, 0x59, 0x8b, 0x47, 0xa2, 0x7f,
+	0x87, 0x89, 0x2c, 0x6d, 0xc3, 0x13, 0x9c, 0x24, 0x44, 0x36, 0xd5, 0x2a, 0x12, 0xcd, 0xd2, 0xe7,
+	0x70, 0x7b, 0x9f, 0x88, 0x5d, 0x55, 0x91, 0xee, 0x9d, 0x60, 0xb1, 0xa7, 0xe1, 0x3c, 0xe7, 0x0f,
+	0xc9, 0x64, 0x34, 0x20, 0xa9, 0x29, 0x22, 0xcd, 0x2a, 0x57, 0x87, 0xd6, 0xf3, 0x75, 0x28, 0xda,
+	0x84, 0x6b, 0x82, 0x4d, 0xd4, 0xa9, 0x89, 0x90, 0x2f, 0xb0, 0x0e, 0xc8, 0xf6, 0x14, 0x78, 0x10,
+	0xf9, 0xbf, 0xac, 0x03, 0x9a, 0x3d, 0x12, 0x3d, 0x81, 0x25, 0x73, 0x2d, 0x5b, 0x8d, 0xdd, 0x2f,
+	0x3a, 0xd3, 0xec, 0x9e, 0x2d, 0xf3, 0x11, 0x64, 0x3b, 0xbd, 0xdf, 0xd7, 0x60, 0xd1, 0x40, 0xb3,
+	0x51, 0x57, 0x6d, 0x3a, 0xea, 0x92, 0x19, 0x23, 0xa2, 0x7c, 0x1c, 0xe3, 0xf3, 0x7c, 0x46, 0x69,
+	0x19, 0x98, 0x4a, 0x2a, 0x77, 0xa1, 0xa5, 0x1e, 0x0e, 0x5d, 0xb2, 0x29, 0x11, 0xe6, 0x02, 0x90,
+	0x20, 0x9d, 0x4e, 0x65, 0x4a, 0x39, 0xc1, 0xdc, 0xe2, 0xe7, 0x55, 0x19, 0xd0, 0x3c, 0xc1, 0xdc,
+	0xa0, 0xef, 0x41, 0x5b, 0xed, 0xb7, 0xdd, 0xbe, 0x7e, 0x3a, 0x14, 0xcf, 0x97, 0x1a, 0xe4, 0x7f,
+	0x00, 0x2b, 0xfb, 0x44, 0x8a, 0x31, 0x2d, 0x03, 0x73, 0x46, 0x32, 0xfd, 0x87, 0x35, 0xd2, 0x73,
+	0x40, 0x79, 0x07, 0x37, 0x81, 0xf7, 0x89, 0x0d, 0x3c, 0xad, 0xab, 0xbb, 0x25, 0x81, 0x97, 0x77,
+	0x1a, 0x1b, 0x80, 0x8f, 0x55, 0xb4, 0x58, 0x7f, 0x75, 0x83, 0xb8, 0x76, 0xa5, 0x20, 0xde, 0x81,
+	0x75, 0xc7, 0xef, 0x0d, 0x9f, 0x8a, 0x9c, 0xaf, 0xa3, 0xf4, 0x25, 0x8b, 0x5e, 0x50, 0x9e, 0x0d,
+	0xab, 0x7e, 0x0e, 0x28, 0x0f, 0x34, 0x5c, 0xde, 0x85, 0x65, 0x1c, 0x8d, 0x68, 0xd2, 0x77, 0x78,
+	0xb5, 0x15, 0xf4, 0x48, 0x33, 0x44, 0x1b, 0xd0, 0x1e, 0xb1, 0x68, 0x4a, 0x53, 0x57, 0x34, 0x30,
+	0x62, 0x91, 0xa1, 0xd8, 0xf9, 0x4d, 0x03, 0xd6, 0x77, 0x27, 0xe2, 0x84, 0x24, 0x82, 0x86, 0xaa,
+	0xc7, 0x38, 0xd4, 0x12, 0xa1, 0x27, 0xd0, 0x50, 0xc3, 0x62, 0xe4, 0xd4, 0xfb, 0xee, 0xb0, 0xdb,
+	0xbb, 0xe5, 0xf4, 0x03, 0x85, 0x09, 0xf3, 0x3e, 0x2c, 0xe8, 0x51, 0x1b, 0xba, 0x5b, 0xca, 0x66,
+	0x3a, 0x95, 0xf3, 0x6e, 0xcf, 0xf0, 0xc9, 0x4d, 0xe8, 0xd0, 0x11, 0x2c, 0xef, 0x13, 0x91, 0x1b,
+	0x1b, 0x22, 0xa7, 0xed, 0x9f, 0x9d, 0x33, 0x7a, 0xf7, 0x2a, 0x28, 0x0c, 0xdb, 0x01, 0xa0, 0xc2,
+	0x8c, 0x58, 0xcf, 0xa9, 0xdf, 0x2f, 0x6e, 0xbc, 0x70, 0xa0, 0xed, 0x6d, 0x56, 0x12, 0x56, 0x9c,
+	0xb1, 0x73, 0xd5, 0x33, 0x76, 0xae, 0x76, 0xc6, 0xcf, 0x60, 0xb9, 0x38, 0x90, 0x47, 0xce, 0xb6,
+	0xd2, 0xdf, 0x15, 0xbc, 0x77, 0xab, 0x89, 0x0c, 0xf3, 0x11, 0x74, 0xcb, 0x46, 0x9c, 0xe8, 0x41,
+	0x71, 0x77, 0xc5, 0x04, 0xd5, 0x7b, 0x78, 0x15, 0x52, 0x7d, 0xdc, 0x4e, 0x0c, 0x6b, 0xf9, 0xe1,
+	0xa0, 0x75, 0xc8, 0x23, 0x58, 0xd2, 0x60, 0x92, 0xa2, 0xf7, 0x66, 0x9d, 0xa9, 0x64, 0xbe, 0xea,
+	0xf9, 0xae, 0xea, 0x66, 0x47, 0x8e, 0x3b, 0x7f, 0x59, 0xcc, 0x5a, 0x43, 0x7b, 0xd2, 0x01, 0xc0,
+	0x3e, 0x31, 0xa3, 0x10, 0x8e, 0x6e, 0x97, 0x8d, 0x97, 0x6c, 0x4a, 0xf2, 0xee, 0x5c, 0x80, 0xcd,
+	0xec, 0xd2, 0xc9, 0x58, 0x99, 0x91, 0x14, 0xf2, 0x2b, 0xe6, 0x55, 0x96, 0xed, 0x66, 0x25, 0x4d,
+	0x21, 0x26, 0x72, 0x0d, 0xb8, 0x1b, 0x13, 0xb3, 0x23, 0x1c, 0xef, 0x5e, 0x05, 0x45, 0xe1, 0xce,
+	0x85, 0x59, 0x95, 0x7b, 0xe7, 0xb2, 0x21, 0x9a, 0xb7, 0x59, 0x49, 0x63, 0x98, 0xef, 0xc1, 0x92,
+	0x8c, 0x63, 0x81, 0x05, 0x47, 0x4e, 0x25, 0x96, 0xef, 0xf8, 0xbd, 0x5b, 0xa5, 0x38, 0xc3, 0x44,
+	0x1b, 0xc8, 0x56, 0xc6, 0x8e, 0x81, 0x8a, 0x7d, 0xbe, 0x77, 0xe7, 0x02, 0xac, 0x61, 0xf5, 0x53,
+	0xf5, 0xca, 0xe4, 0xbb, 0x09, 0xe4, 0xa8, 0xa8, 0xa4, 0xd9, 0xf2, 0xfc, 0x2a, 0x12, 0xc3, 0x79,
+	0x08, 0x68, 0xb6, 0x55, 0x71, 0xc3, 0xfe, 0xc2, 0x7e, 0xce, 0xbb, 0x7f, 0x39, 0x61, 0xe6, 0x06,
+	0xed, 0x7c, 0x0f, 0xe3, 0xde, 0xbf, 0xa4, 0x23, 0xf2, 0xfc, 0x2a, 0x92, 0x29, 0xdb, 0x7c, 0x33,
+	0xe3, 0xb2, 0x2d, 0xe9, 0x7f, 0x3c, 0xbf, 0x8a, 0xa4, 0xe0, 0x5d, 0x85, 0x22, 0xdf, 0xf5, 0xae,
+	0xb2, 0x5e, 0xc9, 0xdb, 0xac, 0xa4, 0x31, 0xc1, 0xfc, 0x03, 0xac, 0xed, 0x4e, 0x04, 0x0b, 0xd9,
+	0x68, 0x1c, 0x13, 0x91, 0x05, 0xf4, 0x77, 0x70, 0x4d, 0x96, 0x12, 0x1a, 0x2a, 0xe7, 0x68, 0x9b,
+	0xb3, 0xf9, 0x63, 0xa6, 0xd0, 0xf7, 0xde, 0xad, 0x26, 0x32, 0x47, 0xfe, 0x71, 0x01, 0x3a, 0x87,
+	0x2c, 0xa4, 0x38, 0xa6, 0xbf, 0xc8, 0x0e, 0x7c, 0x0a, 0xcd, 0xac, 0x84, 0x77, 0x1f, 0x50, 0xb7,
+	0xb6, 0xf7, 0x7a, 0x45, 0xfc, 0xb4, 0x2c, 0x46, 0xcf, 0xad, 0x09, 0x0c, 0xa7, 0x52, 0x13, 0x5c,
+	0x95, 0xd9, 0xd7, 0x2a, 0x68, 0x34, 0x35, 0x77, 0x9f, 0xe3, 0x99, 0xee, 0xc0, 0xdb, 0xb8, 0x98,
+	0x60, 0x1a, 0xca, 0xb6, 0x7f, 0x40, 0x77, 0x66, 0x64, 0xcc, 0x77, 0x0b, 0x15, 0xb7, 0x3a, 0x80,
+	0x56, 0xae, 0xbb, 0x70, 0x13, 0xd8, 0x6c, 0xe3, 0x51, 0xc1, 0xea, 0x05, 0x34, 0xb3, 0xb2, 0xcd,
+	0x55, 0xba, 0xdb, 0x97, 0x78, 0x77, 0x2f, 0xc4, 0x67, 0x89, 0xe1, 0x5a, 0xa1, 0x80, 0x73, 0x9d,
+	0xb4, 0xac, 0xab, 0xf1, 0x36, 0x2b, 0x69, 0x0c, 0x67, 0xa2, 0x4a, 0xc3, 0x92, 0xea, 0xfe, 0xe1,
+	0xcc, 0xee, 0x0b, 0xbb, 0x0e, 0xd7, 0x3c, 0x25, 0xdc, 0x9e, 0xa9, 0xc2, 0x51, 0x82, 0xf8, 0x33,
+	0x96, 0x1a, 0xb0, 0x6b, 0x28, 0xa7, 0xc2, 0xf6, 0x56, 0xb7, 0xe8, 0x38, 0x94, 0x75, 0x80, 0x2d,
+	0xc3, 0xb9, 0xf1, 0x1b, 0x53, 0x80, 0x96, 0xf8, 0x4d, 0xb1, 0x5e, 0xf5, 0x36, 0x2e, 0x26, 0xd0,
+	0xf2, 0x3f, 0xfe, 0xf4, 0xbb, 0xff, 0x1f, 0x52, 0x71, 0x32, 0x19, 0x6c, 0x85, 0x6c, 0xb4, 0x1d,
+	0xb1, 0x11, 0x4d, 0xd8, 0xa3, 0xff, 0xdb, 0x8e, 0xa9, 0x2c, 0x3a, 0xf8, 0x76, 0x3a, 0x0e, 0xb7,
+	0xcb, 0xff, 0xc7, 0x31, 0x58, 0x50, 0xb0, 0x8f, 0xff, 0x15, 0x00, 0x00, 0xff, 0xff, 0x09, 0x21,
+	0xf6, 0xff, 0xe8, 0x21, 0x00, 0x00,
 }